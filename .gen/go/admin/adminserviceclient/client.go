@@ -28,6 +28,7 @@ package adminserviceclient
 import (
 	context "context"
 	admin "github.com/uber/cadence/.gen/go/admin"
+	replicator "github.com/uber/cadence/.gen/go/replicator"
 	shared "github.com/uber/cadence/.gen/go/shared"
 	wire "go.uber.org/thriftrw/wire"
 	yarpc "go.uber.org/yarpc"
@@ -56,12 +57,36 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*shared.DescribeHistoryHostResponse, error)
 
+	DescribeReplication(
+		ctx context.Context,
+		Request *replicator.DescribeReplicationRequest,
+		opts ...yarpc.CallOption,
+	) (*replicator.DescribeReplicationResponse, error)
+
+	DescribeSearchAttributes(
+		ctx context.Context,
+		Request *admin.DescribeSearchAttributesRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.DescribeSearchAttributesResponse, error)
+
 	DescribeWorkflowExecution(
 		ctx context.Context,
 		Request *admin.DescribeWorkflowExecutionRequest,
 		opts ...yarpc.CallOption,
 	) (*admin.DescribeWorkflowExecutionResponse, error)
 
+	DrainTaskList(
+		ctx context.Context,
+		Request *admin.DrainTaskListRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.DrainTaskListResponse, error)
+
+	GetDLQReplicationMessages(
+		ctx context.Context,
+		Request *replicator.GetDLQReplicationMessagesRequest,
+		opts ...yarpc.CallOption,
+	) (*replicator.GetDLQReplicationMessagesResponse, error)
+
 	GetWorkflowExecutionRawHistory(
 		ctx context.Context,
 		GetRequest *admin.GetWorkflowExecutionRawHistoryRequest,
@@ -74,11 +99,59 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*admin.GetWorkflowExecutionRawHistoryV2Response, error)
 
+	MergeDLQMessages(
+		ctx context.Context,
+		Request *replicator.MergeDLQMessagesRequest,
+		opts ...yarpc.CallOption,
+	) (*replicator.MergeDLQMessagesResponse, error)
+
+	PurgeDLQMessages(
+		ctx context.Context,
+		Request *replicator.PurgeDLQMessagesRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	ReadDLQMessages(
+		ctx context.Context,
+		Request *replicator.ReadDLQMessagesRequest,
+		opts ...yarpc.CallOption,
+	) (*replicator.ReadDLQMessagesResponse, error)
+
+	RecordChildExecutionCompleted(
+		ctx context.Context,
+		Request *admin.RecordChildExecutionCompletedRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	RemoveSignalMutableState(
+		ctx context.Context,
+		Request *admin.RemoveSignalMutableStateRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	RemoveTask(
 		ctx context.Context,
 		Request *shared.RemoveTaskRequest,
 		opts ...yarpc.CallOption,
 	) error
+
+	SignalExternalWorkflowExecution(
+		ctx context.Context,
+		Request *admin.SignalExternalWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	StartChildWorkflowExecution(
+		ctx context.Context,
+		Request *admin.StartChildWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.StartWorkflowExecutionResponse, error)
+
+	UpdateSearchAttributes(
+		ctx context.Context,
+		Request *admin.UpdateSearchAttributesRequest,
+		opts ...yarpc.CallOption,
+	) error
 }
 
 // New builds a new client for the AdminService service.
@@ -174,6 +247,52 @@ func (c client) DescribeHistoryHost(
 	return
 }
 
+func (c client) DescribeReplication(
+	ctx context.Context,
+	_Request *replicator.DescribeReplicationRequest,
+	opts ...yarpc.CallOption,
+) (success *replicator.DescribeReplicationResponse, err error) {
+
+	args := admin.AdminService_DescribeReplication_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_DescribeReplication_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_DescribeReplication_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) DescribeSearchAttributes(
+	ctx context.Context,
+	_Request *admin.DescribeSearchAttributesRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.DescribeSearchAttributesResponse, err error) {
+
+	args := admin.AdminService_DescribeSearchAttributes_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_DescribeSearchAttributes_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_DescribeSearchAttributes_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) DescribeWorkflowExecution(
 	ctx context.Context,
 	_Request *admin.DescribeWorkflowExecutionRequest,
@@ -197,6 +316,52 @@ func (c client) DescribeWorkflowExecution(
 	return
 }
 
+func (c client) DrainTaskList(
+	ctx context.Context,
+	_Request *admin.DrainTaskListRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.DrainTaskListResponse, err error) {
+
+	args := admin.AdminService_DrainTaskList_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_DrainTaskList_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_DrainTaskList_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) GetDLQReplicationMessages(
+	ctx context.Context,
+	_Request *replicator.GetDLQReplicationMessagesRequest,
+	opts ...yarpc.CallOption,
+) (success *replicator.GetDLQReplicationMessagesResponse, err error) {
+
+	args := admin.AdminService_GetDLQReplicationMessages_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_GetDLQReplicationMessages_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_GetDLQReplicationMessages_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) GetWorkflowExecutionRawHistory(
 	ctx context.Context,
 	_GetRequest *admin.GetWorkflowExecutionRawHistoryRequest,
@@ -243,6 +408,121 @@ func (c client) GetWorkflowExecutionRawHistoryV2(
 	return
 }
 
+func (c client) MergeDLQMessages(
+	ctx context.Context,
+	_Request *replicator.MergeDLQMessagesRequest,
+	opts ...yarpc.CallOption,
+) (success *replicator.MergeDLQMessagesResponse, err error) {
+
+	args := admin.AdminService_MergeDLQMessages_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_MergeDLQMessages_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_MergeDLQMessages_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) PurgeDLQMessages(
+	ctx context.Context,
+	_Request *replicator.PurgeDLQMessagesRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_PurgeDLQMessages_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_PurgeDLQMessages_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_PurgeDLQMessages_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) ReadDLQMessages(
+	ctx context.Context,
+	_Request *replicator.ReadDLQMessagesRequest,
+	opts ...yarpc.CallOption,
+) (success *replicator.ReadDLQMessagesResponse, err error) {
+
+	args := admin.AdminService_ReadDLQMessages_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_ReadDLQMessages_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_ReadDLQMessages_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) RecordChildExecutionCompleted(
+	ctx context.Context,
+	_Request *admin.RecordChildExecutionCompletedRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_RecordChildExecutionCompleted_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_RecordChildExecutionCompleted_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_RecordChildExecutionCompleted_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) RemoveSignalMutableState(
+	ctx context.Context,
+	_Request *admin.RemoveSignalMutableStateRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_RemoveSignalMutableState_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_RemoveSignalMutableState_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_RemoveSignalMutableState_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) RemoveTask(
 	ctx context.Context,
 	_Request *shared.RemoveTaskRequest,
@@ -265,3 +545,72 @@ func (c client) RemoveTask(
 	err = admin.AdminService_RemoveTask_Helper.UnwrapResponse(&result)
 	return
 }
+
+func (c client) SignalExternalWorkflowExecution(
+	ctx context.Context,
+	_Request *admin.SignalExternalWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_SignalExternalWorkflowExecution_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_SignalExternalWorkflowExecution_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_SignalExternalWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) StartChildWorkflowExecution(
+	ctx context.Context,
+	_Request *admin.StartChildWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.StartWorkflowExecutionResponse, err error) {
+
+	args := admin.AdminService_StartChildWorkflowExecution_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_StartChildWorkflowExecution_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_StartChildWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) UpdateSearchAttributes(
+	ctx context.Context,
+	_Request *admin.UpdateSearchAttributesRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_UpdateSearchAttributes_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_UpdateSearchAttributes_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_UpdateSearchAttributes_Helper.UnwrapResponse(&result)
+	return
+}
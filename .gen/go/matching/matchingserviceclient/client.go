@@ -62,6 +62,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*shared.DescribeTaskListResponse, error)
 
+	DrainTaskList(
+		ctx context.Context,
+		Request *matching.DrainTaskListRequest,
+		opts ...yarpc.CallOption,
+	) (*matching.DrainTaskListResponse, error)
+
 	PollForActivityTask(
 		ctx context.Context,
 		PollRequest *matching.PollForActivityTaskRequest,
@@ -80,6 +86,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*shared.QueryWorkflowResponse, error)
 
+	RecordTaskCompletion(
+		ctx context.Context,
+		Request *matching.RecordTaskCompletionRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	RespondQueryTaskCompleted(
 		ctx context.Context,
 		Request *matching.RespondQueryTaskCompletedRequest,
@@ -203,6 +215,29 @@ func (c client) DescribeTaskList(
 	return
 }
 
+func (c client) DrainTaskList(
+	ctx context.Context,
+	_Request *matching.DrainTaskListRequest,
+	opts ...yarpc.CallOption,
+) (success *matching.DrainTaskListResponse, err error) {
+
+	args := matching.MatchingService_DrainTaskList_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result matching.MatchingService_DrainTaskList_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = matching.MatchingService_DrainTaskList_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) PollForActivityTask(
 	ctx context.Context,
 	_PollRequest *matching.PollForActivityTaskRequest,
@@ -272,6 +307,29 @@ func (c client) QueryWorkflow(
 	return
 }
 
+func (c client) RecordTaskCompletion(
+	ctx context.Context,
+	_Request *matching.RecordTaskCompletionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := matching.MatchingService_RecordTaskCompletion_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result matching.MatchingService_RecordTaskCompletion_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = matching.MatchingService_RecordTaskCompletion_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) RespondQueryTaskCompleted(
 	ctx context.Context,
 	_Request *matching.RespondQueryTaskCompletedRequest,
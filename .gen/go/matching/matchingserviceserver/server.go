@@ -56,6 +56,11 @@ type Interface interface {
 		Request *matching.DescribeTaskListRequest,
 	) (*shared.DescribeTaskListResponse, error)
 
+	DrainTaskList(
+		ctx context.Context,
+		Request *matching.DrainTaskListRequest,
+	) (*matching.DrainTaskListResponse, error)
+
 	PollForActivityTask(
 		ctx context.Context,
 		PollRequest *matching.PollForActivityTaskRequest,
@@ -71,6 +76,11 @@ type Interface interface {
 		QueryRequest *matching.QueryWorkflowRequest,
 	) (*shared.QueryWorkflowResponse, error)
 
+	RecordTaskCompletion(
+		ctx context.Context,
+		Request *matching.RecordTaskCompletionRequest,
+	) error
+
 	RespondQueryTaskCompleted(
 		ctx context.Context,
 		Request *matching.RespondQueryTaskCompletedRequest,
@@ -132,6 +142,17 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: matching.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "DrainTaskList",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.DrainTaskList),
+				},
+				Signature:    "DrainTaskList(Request *matching.DrainTaskListRequest) (*matching.DrainTaskListResponse)",
+				ThriftModule: matching.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "PollForActivityTask",
 				HandlerSpec: thrift.HandlerSpec{
@@ -165,6 +186,17 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: matching.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "RecordTaskCompletion",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.RecordTaskCompletion),
+				},
+				Signature:    "RecordTaskCompletion(Request *matching.RecordTaskCompletionRequest)",
+				ThriftModule: matching.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "RespondQueryTaskCompleted",
 				HandlerSpec: thrift.HandlerSpec{
@@ -178,7 +210,7 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 		},
 	}
 
-	procedures := make([]transport.Procedure, 0, 8)
+	procedures := make([]transport.Procedure, 0, 10)
 	procedures = append(procedures, thrift.BuildProcedures(service, opts...)...)
 	return procedures
 }
@@ -261,6 +293,25 @@ func (h handler) DescribeTaskList(ctx context.Context, body wire.Value) (thrift.
 	return response, err
 }
 
+func (h handler) DrainTaskList(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args matching.MatchingService_DrainTaskList_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.DrainTaskList(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := matching.MatchingService_DrainTaskList_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
 func (h handler) PollForActivityTask(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args matching.MatchingService_PollForActivityTask_Args
 	if err := args.FromWire(body); err != nil {
@@ -318,6 +369,25 @@ func (h handler) QueryWorkflow(ctx context.Context, body wire.Value) (thrift.Res
 	return response, err
 }
 
+func (h handler) RecordTaskCompletion(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args matching.MatchingService_RecordTaskCompletion_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.RecordTaskCompletion(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := matching.MatchingService_RecordTaskCompletion_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
 func (h handler) RespondQueryTaskCompleted(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args matching.MatchingService_RespondQueryTaskCompleted_Args
 	if err := args.FromWire(body); err != nil {
@@ -40,149 +40,136 @@ import (
 	strings "strings"
 )
 
-type DomainOperation int32
+type DLQType int32
 
 const (
-	DomainOperationCreate DomainOperation = 0
-	DomainOperationUpdate DomainOperation = 1
+	DLQTypeReplication DLQType = 0
 )
 
-// DomainOperation_Values returns all recognized values of DomainOperation.
-func DomainOperation_Values() []DomainOperation {
-	return []DomainOperation{
-		DomainOperationCreate,
-		DomainOperationUpdate,
+// DLQType_Values returns all recognized values of DLQType.
+func DLQType_Values() []DLQType {
+	return []DLQType{
+		DLQTypeReplication,
 	}
 }
 
-// UnmarshalText tries to decode DomainOperation from a byte slice
+// UnmarshalText tries to decode DLQType from a byte slice
 // containing its name.
 //
-//   var v DomainOperation
-//   err := v.UnmarshalText([]byte("Create"))
-func (v *DomainOperation) UnmarshalText(value []byte) error {
+//   var v DLQType
+//   err := v.UnmarshalText([]byte("Replication"))
+func (v *DLQType) UnmarshalText(value []byte) error {
 	switch s := string(value); s {
-	case "Create":
-		*v = DomainOperationCreate
-		return nil
-	case "Update":
-		*v = DomainOperationUpdate
+	case "Replication":
+		*v = DLQTypeReplication
 		return nil
 	default:
 		val, err := strconv.ParseInt(s, 10, 32)
 		if err != nil {
-			return fmt.Errorf("unknown enum value %q for %q: %v", s, "DomainOperation", err)
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "DLQType", err)
 		}
-		*v = DomainOperation(val)
+		*v = DLQType(val)
 		return nil
 	}
 }
 
-// MarshalText encodes DomainOperation to text.
+// MarshalText encodes DLQType to text.
 //
 // If the enum value is recognized, its name is returned. Otherwise,
 // its integer value is returned.
 //
 // This implements the TextMarshaler interface.
-func (v DomainOperation) MarshalText() ([]byte, error) {
+func (v DLQType) MarshalText() ([]byte, error) {
 	switch int32(v) {
 	case 0:
-		return []byte("Create"), nil
-	case 1:
-		return []byte("Update"), nil
+		return []byte("Replication"), nil
 	}
 	return []byte(strconv.FormatInt(int64(v), 10)), nil
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of DomainOperation.
+// fast logging of DLQType.
 // Enums are logged as objects, where the value is logged with key "value", and
 // if this value's name is known, the name is logged with key "name".
-func (v DomainOperation) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+func (v DLQType) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	enc.AddInt32("value", int32(v))
 	switch int32(v) {
 	case 0:
-		enc.AddString("name", "Create")
-	case 1:
-		enc.AddString("name", "Update")
+		enc.AddString("name", "Replication")
 	}
 	return nil
 }
 
 // Ptr returns a pointer to this enum value.
-func (v DomainOperation) Ptr() *DomainOperation {
+func (v DLQType) Ptr() *DLQType {
 	return &v
 }
 
-// ToWire translates DomainOperation into a Thrift-level intermediate
+// ToWire translates DLQType into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
 // Enums are represented as 32-bit integers over the wire.
-func (v DomainOperation) ToWire() (wire.Value, error) {
+func (v DLQType) ToWire() (wire.Value, error) {
 	return wire.NewValueI32(int32(v)), nil
 }
 
-// FromWire deserializes DomainOperation from its Thrift-level
+// FromWire deserializes DLQType from its Thrift-level
 // representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TI32)
 //   if err != nil {
-//     return DomainOperation(0), err
+//     return DLQType(0), err
 //   }
 //
-//   var v DomainOperation
+//   var v DLQType
 //   if err := v.FromWire(x); err != nil {
-//     return DomainOperation(0), err
+//     return DLQType(0), err
 //   }
 //   return v, nil
-func (v *DomainOperation) FromWire(w wire.Value) error {
-	*v = (DomainOperation)(w.GetI32())
+func (v *DLQType) FromWire(w wire.Value) error {
+	*v = (DLQType)(w.GetI32())
 	return nil
 }
 
-// String returns a readable string representation of DomainOperation.
-func (v DomainOperation) String() string {
+// String returns a readable string representation of DLQType.
+func (v DLQType) String() string {
 	w := int32(v)
 	switch w {
 	case 0:
-		return "Create"
-	case 1:
-		return "Update"
+		return "Replication"
 	}
-	return fmt.Sprintf("DomainOperation(%d)", w)
+	return fmt.Sprintf("DLQType(%d)", w)
 }
 
-// Equals returns true if this DomainOperation value matches the provided
+// Equals returns true if this DLQType value matches the provided
 // value.
-func (v DomainOperation) Equals(rhs DomainOperation) bool {
+func (v DLQType) Equals(rhs DLQType) bool {
 	return v == rhs
 }
 
-// MarshalJSON serializes DomainOperation into JSON.
+// MarshalJSON serializes DLQType into JSON.
 //
 // If the enum value is recognized, its name is returned. Otherwise,
 // its integer value is returned.
 //
 // This implements json.Marshaler.
-func (v DomainOperation) MarshalJSON() ([]byte, error) {
+func (v DLQType) MarshalJSON() ([]byte, error) {
 	switch int32(v) {
 	case 0:
-		return ([]byte)("\"Create\""), nil
-	case 1:
-		return ([]byte)("\"Update\""), nil
+		return ([]byte)("\"Replication\""), nil
 	}
 	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
 }
 
-// UnmarshalJSON attempts to decode DomainOperation from its JSON
+// UnmarshalJSON attempts to decode DLQType from its JSON
 // representation.
 //
 // This implementation supports both, numeric and string inputs. If a
 // string is provided, it must be a known enum name.
 //
 // This implements json.Unmarshaler.
-func (v *DomainOperation) UnmarshalJSON(text []byte) error {
+func (v *DLQType) UnmarshalJSON(text []byte) error {
 	d := json.NewDecoder(bytes.NewReader(text))
 	d.UseNumber()
 	t, err := d.Token()
@@ -197,31 +184,54 @@ func (v *DomainOperation) UnmarshalJSON(text []byte) error {
 			return err
 		}
 		if x > math.MaxInt32 {
-			return fmt.Errorf("enum overflow from JSON %q for %q", text, "DomainOperation")
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "DLQType")
 		}
 		if x < math.MinInt32 {
-			return fmt.Errorf("enum underflow from JSON %q for %q", text, "DomainOperation")
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "DLQType")
 		}
-		*v = (DomainOperation)(x)
+		*v = (DLQType)(x)
 		return nil
 	case string:
 		return v.UnmarshalText([]byte(w))
 	default:
-		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "DomainOperation")
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "DLQType")
 	}
 }
 
-type DomainTaskAttributes struct {
-	DomainOperation   *DomainOperation                       `json:"domainOperation,omitempty"`
-	ID                *string                                `json:"id,omitempty"`
-	Info              *shared.DomainInfo                     `json:"info,omitempty"`
-	Config            *shared.DomainConfiguration            `json:"config,omitempty"`
-	ReplicationConfig *shared.DomainReplicationConfiguration `json:"replicationConfig,omitempty"`
-	ConfigVersion     *int64                                 `json:"configVersion,omitempty"`
-	FailoverVersion   *int64                                 `json:"failoverVersion,omitempty"`
+type DescribeReplicationRequest struct {
+	SourceCluster *string `json:"sourceCluster,omitempty"`
+	ShardIDs      []int32 `json:"shardIDs,omitempty"`
+	Domain        *string `json:"domain,omitempty"`
+	DomainID      *string `json:"domainID,omitempty"`
 }
 
-// ToWire translates a DomainTaskAttributes struct into a Thrift-level intermediate
+type _List_I32_ValueList []int32
+
+func (v _List_I32_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		w, err := wire.NewValueI32(x), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_I32_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_I32_ValueList) ValueType() wire.Type {
+	return wire.TI32
+}
+
+func (_List_I32_ValueList) Close() {}
+
+// ToWire translates a DescribeReplicationRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -236,103 +246,73 @@ type DomainTaskAttributes struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *DomainTaskAttributes) ToWire() (wire.Value, error) {
+func (v *DescribeReplicationRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [7]wire.Field
+		fields [4]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.DomainOperation != nil {
-		w, err = v.DomainOperation.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 5, Value: w}
-		i++
-	}
-	if v.ID != nil {
-		w, err = wire.NewValueString(*(v.ID)), error(nil)
+	if v.SourceCluster != nil {
+		w, err = wire.NewValueString(*(v.SourceCluster)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.Info != nil {
-		w, err = v.Info.ToWire()
+	if v.ShardIDs != nil {
+		w, err = wire.NewValueList(_List_I32_ValueList(v.ShardIDs)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.Config != nil {
-		w, err = v.Config.ToWire()
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.ReplicationConfig != nil {
-		w, err = v.ReplicationConfig.ToWire()
+	if v.DomainID != nil {
+		w, err = wire.NewValueString(*(v.DomainID)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
-	if v.ConfigVersion != nil {
-		w, err = wire.NewValueI64(*(v.ConfigVersion)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 50, Value: w}
-		i++
-	}
-	if v.FailoverVersion != nil {
-		w, err = wire.NewValueI64(*(v.FailoverVersion)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 60, Value: w}
-		i++
-	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _DomainOperation_Read(w wire.Value) (DomainOperation, error) {
-	var v DomainOperation
-	err := v.FromWire(w)
-	return v, err
-}
-
-func _DomainInfo_Read(w wire.Value) (*shared.DomainInfo, error) {
-	var v shared.DomainInfo
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _DomainConfiguration_Read(w wire.Value) (*shared.DomainConfiguration, error) {
-	var v shared.DomainConfiguration
-	err := v.FromWire(w)
-	return &v, err
-}
+func _List_I32_Read(l wire.ValueList) ([]int32, error) {
+	if l.ValueType() != wire.TI32 {
+		return nil, nil
+	}
 
-func _DomainReplicationConfiguration_Read(w wire.Value) (*shared.DomainReplicationConfiguration, error) {
-	var v shared.DomainReplicationConfiguration
-	err := v.FromWire(w)
-	return &v, err
+	o := make([]int32, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := x.GetI32(), error(nil)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
 }
 
-// FromWire deserializes a DomainTaskAttributes struct from its Thrift-level
+// FromWire deserializes a DescribeReplicationRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a DomainTaskAttributes struct
+// An error is returned if we were unable to build a DescribeReplicationRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -340,75 +320,49 @@ func _DomainReplicationConfiguration_Read(w wire.Value) (*shared.DomainReplicati
 //     return nil, err
 //   }
 //
-//   var v DomainTaskAttributes
+//   var v DescribeReplicationRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *DomainTaskAttributes) FromWire(w wire.Value) error {
+func (v *DescribeReplicationRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 5:
-			if field.Value.Type() == wire.TI32 {
-				var x DomainOperation
-				x, err = _DomainOperation_Read(field.Value)
-				v.DomainOperation = &x
-				if err != nil {
-					return err
-				}
-
-			}
 		case 10:
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.ID = &x
+				v.SourceCluster = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TStruct {
-				v.Info, err = _DomainInfo_Read(field.Value)
+			if field.Value.Type() == wire.TList {
+				v.ShardIDs, err = _List_I32_Read(field.Value.GetList())
 				if err != nil {
 					return err
 				}
 
 			}
 		case 30:
-			if field.Value.Type() == wire.TStruct {
-				v.Config, err = _DomainConfiguration_Read(field.Value)
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 40:
-			if field.Value.Type() == wire.TStruct {
-				v.ReplicationConfig, err = _DomainReplicationConfiguration_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 50:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.ConfigVersion = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 60:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.FailoverVersion = &x
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainID = &x
 				if err != nil {
 					return err
 				}
@@ -420,55 +374,33 @@ func (v *DomainTaskAttributes) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a DomainTaskAttributes
+// String returns a readable string representation of a DescribeReplicationRequest
 // struct.
-func (v *DomainTaskAttributes) String() string {
+func (v *DescribeReplicationRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [7]string
+	var fields [4]string
 	i := 0
-	if v.DomainOperation != nil {
-		fields[i] = fmt.Sprintf("DomainOperation: %v", *(v.DomainOperation))
-		i++
-	}
-	if v.ID != nil {
-		fields[i] = fmt.Sprintf("ID: %v", *(v.ID))
-		i++
-	}
-	if v.Info != nil {
-		fields[i] = fmt.Sprintf("Info: %v", v.Info)
-		i++
-	}
-	if v.Config != nil {
-		fields[i] = fmt.Sprintf("Config: %v", v.Config)
+	if v.SourceCluster != nil {
+		fields[i] = fmt.Sprintf("SourceCluster: %v", *(v.SourceCluster))
 		i++
 	}
-	if v.ReplicationConfig != nil {
-		fields[i] = fmt.Sprintf("ReplicationConfig: %v", v.ReplicationConfig)
+	if v.ShardIDs != nil {
+		fields[i] = fmt.Sprintf("ShardIDs: %v", v.ShardIDs)
 		i++
 	}
-	if v.ConfigVersion != nil {
-		fields[i] = fmt.Sprintf("ConfigVersion: %v", *(v.ConfigVersion))
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
 		i++
 	}
-	if v.FailoverVersion != nil {
-		fields[i] = fmt.Sprintf("FailoverVersion: %v", *(v.FailoverVersion))
+	if v.DomainID != nil {
+		fields[i] = fmt.Sprintf("DomainID: %v", *(v.DomainID))
 		i++
 	}
 
-	return fmt.Sprintf("DomainTaskAttributes{%v}", strings.Join(fields[:i], ", "))
-}
-
-func _DomainOperation_EqualsPtr(lhs, rhs *DomainOperation) bool {
-	if lhs != nil && rhs != nil {
-
-		x := *lhs
-		y := *rhs
-		return x.Equals(y)
-	}
-	return lhs == nil && rhs == nil
+	return fmt.Sprintf("DescribeReplicationRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
 func _String_EqualsPtr(lhs, rhs *string) bool {
@@ -481,193 +413,175 @@ func _String_EqualsPtr(lhs, rhs *string) bool {
 	return lhs == nil && rhs == nil
 }
 
-func _I64_EqualsPtr(lhs, rhs *int64) bool {
-	if lhs != nil && rhs != nil {
+func _List_I32_Equals(lhs, rhs []int32) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
 
-		x := *lhs
-		y := *rhs
-		return (x == y)
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !(lv == rv) {
+			return false
+		}
 	}
-	return lhs == nil && rhs == nil
+
+	return true
 }
 
-// Equals returns true if all the fields of this DomainTaskAttributes match the
-// provided DomainTaskAttributes.
+// Equals returns true if all the fields of this DescribeReplicationRequest match the
+// provided DescribeReplicationRequest.
 //
 // This function performs a deep comparison.
-func (v *DomainTaskAttributes) Equals(rhs *DomainTaskAttributes) bool {
+func (v *DescribeReplicationRequest) Equals(rhs *DescribeReplicationRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_DomainOperation_EqualsPtr(v.DomainOperation, rhs.DomainOperation) {
-		return false
-	}
-	if !_String_EqualsPtr(v.ID, rhs.ID) {
-		return false
-	}
-	if !((v.Info == nil && rhs.Info == nil) || (v.Info != nil && rhs.Info != nil && v.Info.Equals(rhs.Info))) {
-		return false
-	}
-	if !((v.Config == nil && rhs.Config == nil) || (v.Config != nil && rhs.Config != nil && v.Config.Equals(rhs.Config))) {
+	if !_String_EqualsPtr(v.SourceCluster, rhs.SourceCluster) {
 		return false
 	}
-	if !((v.ReplicationConfig == nil && rhs.ReplicationConfig == nil) || (v.ReplicationConfig != nil && rhs.ReplicationConfig != nil && v.ReplicationConfig.Equals(rhs.ReplicationConfig))) {
+	if !((v.ShardIDs == nil && rhs.ShardIDs == nil) || (v.ShardIDs != nil && rhs.ShardIDs != nil && _List_I32_Equals(v.ShardIDs, rhs.ShardIDs))) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.ConfigVersion, rhs.ConfigVersion) {
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.FailoverVersion, rhs.FailoverVersion) {
+	if !_String_EqualsPtr(v.DomainID, rhs.DomainID) {
 		return false
 	}
 
 	return true
 }
 
+type _List_I32_Zapper []int32
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_I32_Zapper.
+func (l _List_I32_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		enc.AppendInt32(v)
+	}
+	return err
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of DomainTaskAttributes.
-func (v *DomainTaskAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of DescribeReplicationRequest.
+func (v *DescribeReplicationRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.DomainOperation != nil {
-		err = multierr.Append(err, enc.AddObject("domainOperation", *v.DomainOperation))
-	}
-	if v.ID != nil {
-		enc.AddString("id", *v.ID)
-	}
-	if v.Info != nil {
-		err = multierr.Append(err, enc.AddObject("info", v.Info))
-	}
-	if v.Config != nil {
-		err = multierr.Append(err, enc.AddObject("config", v.Config))
+	if v.SourceCluster != nil {
+		enc.AddString("sourceCluster", *v.SourceCluster)
 	}
-	if v.ReplicationConfig != nil {
-		err = multierr.Append(err, enc.AddObject("replicationConfig", v.ReplicationConfig))
+	if v.ShardIDs != nil {
+		err = multierr.Append(err, enc.AddArray("shardIDs", (_List_I32_Zapper)(v.ShardIDs)))
 	}
-	if v.ConfigVersion != nil {
-		enc.AddInt64("configVersion", *v.ConfigVersion)
+	if v.Domain != nil {
+		enc.AddString("domain", *v.Domain)
 	}
-	if v.FailoverVersion != nil {
-		enc.AddInt64("failoverVersion", *v.FailoverVersion)
+	if v.DomainID != nil {
+		enc.AddString("domainID", *v.DomainID)
 	}
 	return err
 }
 
-// GetDomainOperation returns the value of DomainOperation if it is set or its
+// GetSourceCluster returns the value of SourceCluster if it is set or its
 // zero value if it is unset.
-func (v *DomainTaskAttributes) GetDomainOperation() (o DomainOperation) {
-	if v != nil && v.DomainOperation != nil {
-		return *v.DomainOperation
+func (v *DescribeReplicationRequest) GetSourceCluster() (o string) {
+	if v != nil && v.SourceCluster != nil {
+		return *v.SourceCluster
 	}
 
 	return
 }
 
-// IsSetDomainOperation returns true if DomainOperation is not nil.
-func (v *DomainTaskAttributes) IsSetDomainOperation() bool {
-	return v != nil && v.DomainOperation != nil
+// IsSetSourceCluster returns true if SourceCluster is not nil.
+func (v *DescribeReplicationRequest) IsSetSourceCluster() bool {
+	return v != nil && v.SourceCluster != nil
 }
 
-// GetID returns the value of ID if it is set or its
+// GetShardIDs returns the value of ShardIDs if it is set or its
 // zero value if it is unset.
-func (v *DomainTaskAttributes) GetID() (o string) {
-	if v != nil && v.ID != nil {
-		return *v.ID
+func (v *DescribeReplicationRequest) GetShardIDs() (o []int32) {
+	if v != nil && v.ShardIDs != nil {
+		return v.ShardIDs
 	}
 
 	return
 }
 
-// IsSetID returns true if ID is not nil.
-func (v *DomainTaskAttributes) IsSetID() bool {
-	return v != nil && v.ID != nil
+// IsSetShardIDs returns true if ShardIDs is not nil.
+func (v *DescribeReplicationRequest) IsSetShardIDs() bool {
+	return v != nil && v.ShardIDs != nil
 }
 
-// GetInfo returns the value of Info if it is set or its
+// GetDomain returns the value of Domain if it is set or its
 // zero value if it is unset.
-func (v *DomainTaskAttributes) GetInfo() (o *shared.DomainInfo) {
-	if v != nil && v.Info != nil {
-		return v.Info
+func (v *DescribeReplicationRequest) GetDomain() (o string) {
+	if v != nil && v.Domain != nil {
+		return *v.Domain
 	}
 
 	return
 }
 
-// IsSetInfo returns true if Info is not nil.
-func (v *DomainTaskAttributes) IsSetInfo() bool {
-	return v != nil && v.Info != nil
+// IsSetDomain returns true if Domain is not nil.
+func (v *DescribeReplicationRequest) IsSetDomain() bool {
+	return v != nil && v.Domain != nil
 }
 
-// GetConfig returns the value of Config if it is set or its
+// GetDomainID returns the value of DomainID if it is set or its
 // zero value if it is unset.
-func (v *DomainTaskAttributes) GetConfig() (o *shared.DomainConfiguration) {
-	if v != nil && v.Config != nil {
-		return v.Config
+func (v *DescribeReplicationRequest) GetDomainID() (o string) {
+	if v != nil && v.DomainID != nil {
+		return *v.DomainID
 	}
 
 	return
 }
 
-// IsSetConfig returns true if Config is not nil.
-func (v *DomainTaskAttributes) IsSetConfig() bool {
-	return v != nil && v.Config != nil
+// IsSetDomainID returns true if DomainID is not nil.
+func (v *DescribeReplicationRequest) IsSetDomainID() bool {
+	return v != nil && v.DomainID != nil
 }
 
-// GetReplicationConfig returns the value of ReplicationConfig if it is set or its
-// zero value if it is unset.
-func (v *DomainTaskAttributes) GetReplicationConfig() (o *shared.DomainReplicationConfiguration) {
-	if v != nil && v.ReplicationConfig != nil {
-		return v.ReplicationConfig
-	}
-
-	return
+type DescribeReplicationResponse struct {
+	SourceCluster   *string                   `json:"sourceCluster,omitempty"`
+	Shards          []*ShardReplicationStatus `json:"shards,omitempty"`
+	MissingShardIDs []int32                   `json:"missingShardIDs,omitempty"`
 }
 
-// IsSetReplicationConfig returns true if ReplicationConfig is not nil.
-func (v *DomainTaskAttributes) IsSetReplicationConfig() bool {
-	return v != nil && v.ReplicationConfig != nil
-}
+type _List_ShardReplicationStatus_ValueList []*ShardReplicationStatus
 
-// GetConfigVersion returns the value of ConfigVersion if it is set or its
-// zero value if it is unset.
-func (v *DomainTaskAttributes) GetConfigVersion() (o int64) {
-	if v != nil && v.ConfigVersion != nil {
-		return *v.ConfigVersion
+func (v _List_ShardReplicationStatus_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
 	}
-
-	return
-}
-
-// IsSetConfigVersion returns true if ConfigVersion is not nil.
-func (v *DomainTaskAttributes) IsSetConfigVersion() bool {
-	return v != nil && v.ConfigVersion != nil
+	return nil
 }
 
-// GetFailoverVersion returns the value of FailoverVersion if it is set or its
-// zero value if it is unset.
-func (v *DomainTaskAttributes) GetFailoverVersion() (o int64) {
-	if v != nil && v.FailoverVersion != nil {
-		return *v.FailoverVersion
-	}
-
-	return
+func (v _List_ShardReplicationStatus_ValueList) Size() int {
+	return len(v)
 }
 
-// IsSetFailoverVersion returns true if FailoverVersion is not nil.
-func (v *DomainTaskAttributes) IsSetFailoverVersion() bool {
-	return v != nil && v.FailoverVersion != nil
+func (_List_ShardReplicationStatus_ValueList) ValueType() wire.Type {
+	return wire.TStruct
 }
 
-type GetDomainReplicationMessagesRequest struct {
-	LastRetrivedMessageId  *int64  `json:"lastRetrivedMessageId,omitempty"`
-	LastProcessedMessageId *int64  `json:"lastProcessedMessageId,omitempty"`
-	ClusterName            *string `json:"clusterName,omitempty"`
-}
+func (_List_ShardReplicationStatus_ValueList) Close() {}
 
-// ToWire translates a GetDomainReplicationMessagesRequest struct into a Thrift-level intermediate
+// ToWire translates a DescribeReplicationResponse struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -682,7 +596,7 @@ type GetDomainReplicationMessagesRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *GetDomainReplicationMessagesRequest) ToWire() (wire.Value, error) {
+func (v *DescribeReplicationResponse) ToWire() (wire.Value, error) {
 	var (
 		fields [3]wire.Field
 		i      int = 0
@@ -690,24 +604,24 @@ func (v *GetDomainReplicationMessagesRequest) ToWire() (wire.Value, error) {
 		err    error
 	)
 
-	if v.LastRetrivedMessageId != nil {
-		w, err = wire.NewValueI64(*(v.LastRetrivedMessageId)), error(nil)
+	if v.SourceCluster != nil {
+		w, err = wire.NewValueString(*(v.SourceCluster)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.LastProcessedMessageId != nil {
-		w, err = wire.NewValueI64(*(v.LastProcessedMessageId)), error(nil)
+	if v.Shards != nil {
+		w, err = wire.NewValueList(_List_ShardReplicationStatus_ValueList(v.Shards)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.ClusterName != nil {
-		w, err = wire.NewValueString(*(v.ClusterName)), error(nil)
+	if v.MissingShardIDs != nil {
+		w, err = wire.NewValueList(_List_I32_ValueList(v.MissingShardIDs)), error(nil)
 		if err != nil {
 			return w, err
 		}
@@ -718,11 +632,35 @@ func (v *GetDomainReplicationMessagesRequest) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a GetDomainReplicationMessagesRequest struct from its Thrift-level
+func _ShardReplicationStatus_Read(w wire.Value) (*ShardReplicationStatus, error) {
+	var v ShardReplicationStatus
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_ShardReplicationStatus_Read(l wire.ValueList) ([]*ShardReplicationStatus, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*ShardReplicationStatus, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _ShardReplicationStatus_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a DescribeReplicationResponse struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a GetDomainReplicationMessagesRequest struct
+// An error is returned if we were unable to build a DescribeReplicationResponse struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -730,41 +668,37 @@ func (v *GetDomainReplicationMessagesRequest) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v GetDomainReplicationMessagesRequest
+//   var v DescribeReplicationResponse
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *GetDomainReplicationMessagesRequest) FromWire(w wire.Value) error {
+func (v *DescribeReplicationResponse) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.LastRetrivedMessageId = &x
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.SourceCluster = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.LastProcessedMessageId = &x
+			if field.Value.Type() == wire.TList {
+				v.Shards, err = _List_ShardReplicationStatus_Read(field.Value.GetList())
 				if err != nil {
 					return err
 				}
 
 			}
 		case 30:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.ClusterName = &x
+			if field.Value.Type() == wire.TList {
+				v.MissingShardIDs, err = _List_I32_Read(field.Value.GetList())
 				if err != nil {
 					return err
 				}
@@ -776,122 +710,324 @@ func (v *GetDomainReplicationMessagesRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a GetDomainReplicationMessagesRequest
+// String returns a readable string representation of a DescribeReplicationResponse
 // struct.
-func (v *GetDomainReplicationMessagesRequest) String() string {
+func (v *DescribeReplicationResponse) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
 	var fields [3]string
 	i := 0
-	if v.LastRetrivedMessageId != nil {
-		fields[i] = fmt.Sprintf("LastRetrivedMessageId: %v", *(v.LastRetrivedMessageId))
+	if v.SourceCluster != nil {
+		fields[i] = fmt.Sprintf("SourceCluster: %v", *(v.SourceCluster))
 		i++
 	}
-	if v.LastProcessedMessageId != nil {
-		fields[i] = fmt.Sprintf("LastProcessedMessageId: %v", *(v.LastProcessedMessageId))
+	if v.Shards != nil {
+		fields[i] = fmt.Sprintf("Shards: %v", v.Shards)
 		i++
 	}
-	if v.ClusterName != nil {
-		fields[i] = fmt.Sprintf("ClusterName: %v", *(v.ClusterName))
+	if v.MissingShardIDs != nil {
+		fields[i] = fmt.Sprintf("MissingShardIDs: %v", v.MissingShardIDs)
 		i++
 	}
 
-	return fmt.Sprintf("GetDomainReplicationMessagesRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("DescribeReplicationResponse{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this GetDomainReplicationMessagesRequest match the
-// provided GetDomainReplicationMessagesRequest.
+func _List_ShardReplicationStatus_Equals(lhs, rhs []*ShardReplicationStatus) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this DescribeReplicationResponse match the
+// provided DescribeReplicationResponse.
 //
 // This function performs a deep comparison.
-func (v *GetDomainReplicationMessagesRequest) Equals(rhs *GetDomainReplicationMessagesRequest) bool {
+func (v *DescribeReplicationResponse) Equals(rhs *DescribeReplicationResponse) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_I64_EqualsPtr(v.LastRetrivedMessageId, rhs.LastRetrivedMessageId) {
+	if !_String_EqualsPtr(v.SourceCluster, rhs.SourceCluster) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.LastProcessedMessageId, rhs.LastProcessedMessageId) {
+	if !((v.Shards == nil && rhs.Shards == nil) || (v.Shards != nil && rhs.Shards != nil && _List_ShardReplicationStatus_Equals(v.Shards, rhs.Shards))) {
 		return false
 	}
-	if !_String_EqualsPtr(v.ClusterName, rhs.ClusterName) {
+	if !((v.MissingShardIDs == nil && rhs.MissingShardIDs == nil) || (v.MissingShardIDs != nil && rhs.MissingShardIDs != nil && _List_I32_Equals(v.MissingShardIDs, rhs.MissingShardIDs))) {
 		return false
 	}
 
 	return true
 }
 
+type _List_ShardReplicationStatus_Zapper []*ShardReplicationStatus
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_ShardReplicationStatus_Zapper.
+func (l _List_ShardReplicationStatus_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of GetDomainReplicationMessagesRequest.
-func (v *GetDomainReplicationMessagesRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of DescribeReplicationResponse.
+func (v *DescribeReplicationResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.LastRetrivedMessageId != nil {
-		enc.AddInt64("lastRetrivedMessageId", *v.LastRetrivedMessageId)
+	if v.SourceCluster != nil {
+		enc.AddString("sourceCluster", *v.SourceCluster)
 	}
-	if v.LastProcessedMessageId != nil {
-		enc.AddInt64("lastProcessedMessageId", *v.LastProcessedMessageId)
+	if v.Shards != nil {
+		err = multierr.Append(err, enc.AddArray("shards", (_List_ShardReplicationStatus_Zapper)(v.Shards)))
 	}
-	if v.ClusterName != nil {
-		enc.AddString("clusterName", *v.ClusterName)
+	if v.MissingShardIDs != nil {
+		err = multierr.Append(err, enc.AddArray("missingShardIDs", (_List_I32_Zapper)(v.MissingShardIDs)))
 	}
 	return err
 }
 
-// GetLastRetrivedMessageId returns the value of LastRetrivedMessageId if it is set or its
+// GetSourceCluster returns the value of SourceCluster if it is set or its
 // zero value if it is unset.
-func (v *GetDomainReplicationMessagesRequest) GetLastRetrivedMessageId() (o int64) {
-	if v != nil && v.LastRetrivedMessageId != nil {
-		return *v.LastRetrivedMessageId
+func (v *DescribeReplicationResponse) GetSourceCluster() (o string) {
+	if v != nil && v.SourceCluster != nil {
+		return *v.SourceCluster
 	}
 
 	return
 }
 
-// IsSetLastRetrivedMessageId returns true if LastRetrivedMessageId is not nil.
-func (v *GetDomainReplicationMessagesRequest) IsSetLastRetrivedMessageId() bool {
-	return v != nil && v.LastRetrivedMessageId != nil
+// IsSetSourceCluster returns true if SourceCluster is not nil.
+func (v *DescribeReplicationResponse) IsSetSourceCluster() bool {
+	return v != nil && v.SourceCluster != nil
 }
 
-// GetLastProcessedMessageId returns the value of LastProcessedMessageId if it is set or its
+// GetShards returns the value of Shards if it is set or its
 // zero value if it is unset.
-func (v *GetDomainReplicationMessagesRequest) GetLastProcessedMessageId() (o int64) {
-	if v != nil && v.LastProcessedMessageId != nil {
-		return *v.LastProcessedMessageId
+func (v *DescribeReplicationResponse) GetShards() (o []*ShardReplicationStatus) {
+	if v != nil && v.Shards != nil {
+		return v.Shards
 	}
 
 	return
 }
 
-// IsSetLastProcessedMessageId returns true if LastProcessedMessageId is not nil.
-func (v *GetDomainReplicationMessagesRequest) IsSetLastProcessedMessageId() bool {
-	return v != nil && v.LastProcessedMessageId != nil
+// IsSetShards returns true if Shards is not nil.
+func (v *DescribeReplicationResponse) IsSetShards() bool {
+	return v != nil && v.Shards != nil
 }
 
-// GetClusterName returns the value of ClusterName if it is set or its
+// GetMissingShardIDs returns the value of MissingShardIDs if it is set or its
 // zero value if it is unset.
-func (v *GetDomainReplicationMessagesRequest) GetClusterName() (o string) {
-	if v != nil && v.ClusterName != nil {
-		return *v.ClusterName
+func (v *DescribeReplicationResponse) GetMissingShardIDs() (o []int32) {
+	if v != nil && v.MissingShardIDs != nil {
+		return v.MissingShardIDs
 	}
 
 	return
 }
 
-// IsSetClusterName returns true if ClusterName is not nil.
-func (v *GetDomainReplicationMessagesRequest) IsSetClusterName() bool {
-	return v != nil && v.ClusterName != nil
+// IsSetMissingShardIDs returns true if MissingShardIDs is not nil.
+func (v *DescribeReplicationResponse) IsSetMissingShardIDs() bool {
+	return v != nil && v.MissingShardIDs != nil
 }
 
-type GetDomainReplicationMessagesResponse struct {
-	Messages *ReplicationMessages `json:"messages,omitempty"`
+type DomainOperation int32
+
+const (
+	DomainOperationCreate DomainOperation = 0
+	DomainOperationUpdate DomainOperation = 1
+)
+
+// DomainOperation_Values returns all recognized values of DomainOperation.
+func DomainOperation_Values() []DomainOperation {
+	return []DomainOperation{
+		DomainOperationCreate,
+		DomainOperationUpdate,
+	}
 }
 
-// ToWire translates a GetDomainReplicationMessagesResponse struct into a Thrift-level intermediate
+// UnmarshalText tries to decode DomainOperation from a byte slice
+// containing its name.
+//
+//   var v DomainOperation
+//   err := v.UnmarshalText([]byte("Create"))
+func (v *DomainOperation) UnmarshalText(value []byte) error {
+	switch s := string(value); s {
+	case "Create":
+		*v = DomainOperationCreate
+		return nil
+	case "Update":
+		*v = DomainOperationUpdate
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "DomainOperation", err)
+		}
+		*v = DomainOperation(val)
+		return nil
+	}
+}
+
+// MarshalText encodes DomainOperation to text.
+//
+// If the enum value is recognized, its name is returned. Otherwise,
+// its integer value is returned.
+//
+// This implements the TextMarshaler interface.
+func (v DomainOperation) MarshalText() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return []byte("Create"), nil
+	case 1:
+		return []byte("Update"), nil
+	}
+	return []byte(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of DomainOperation.
+// Enums are logged as objects, where the value is logged with key "value", and
+// if this value's name is known, the name is logged with key "name".
+func (v DomainOperation) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt32("value", int32(v))
+	switch int32(v) {
+	case 0:
+		enc.AddString("name", "Create")
+	case 1:
+		enc.AddString("name", "Update")
+	}
+	return nil
+}
+
+// Ptr returns a pointer to this enum value.
+func (v DomainOperation) Ptr() *DomainOperation {
+	return &v
+}
+
+// ToWire translates DomainOperation into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// Enums are represented as 32-bit integers over the wire.
+func (v DomainOperation) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
+}
+
+// FromWire deserializes DomainOperation from its Thrift-level
+// representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TI32)
+//   if err != nil {
+//     return DomainOperation(0), err
+//   }
+//
+//   var v DomainOperation
+//   if err := v.FromWire(x); err != nil {
+//     return DomainOperation(0), err
+//   }
+//   return v, nil
+func (v *DomainOperation) FromWire(w wire.Value) error {
+	*v = (DomainOperation)(w.GetI32())
+	return nil
+}
+
+// String returns a readable string representation of DomainOperation.
+func (v DomainOperation) String() string {
+	w := int32(v)
+	switch w {
+	case 0:
+		return "Create"
+	case 1:
+		return "Update"
+	}
+	return fmt.Sprintf("DomainOperation(%d)", w)
+}
+
+// Equals returns true if this DomainOperation value matches the provided
+// value.
+func (v DomainOperation) Equals(rhs DomainOperation) bool {
+	return v == rhs
+}
+
+// MarshalJSON serializes DomainOperation into JSON.
+//
+// If the enum value is recognized, its name is returned. Otherwise,
+// its integer value is returned.
+//
+// This implements json.Marshaler.
+func (v DomainOperation) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return ([]byte)("\"Create\""), nil
+	case 1:
+		return ([]byte)("\"Update\""), nil
+	}
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// UnmarshalJSON attempts to decode DomainOperation from its JSON
+// representation.
+//
+// This implementation supports both, numeric and string inputs. If a
+// string is provided, it must be a known enum name.
+//
+// This implements json.Unmarshaler.
+func (v *DomainOperation) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
+	}
+
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
+		if err != nil {
+			return err
+		}
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "DomainOperation")
+		}
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "DomainOperation")
+		}
+		*v = (DomainOperation)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "DomainOperation")
+	}
+}
+
+type DomainReplicationStatus struct {
+	DomainID             *string           `json:"domainID,omitempty"`
+	LastAppliedTaskID    *int64            `json:"lastAppliedTaskID,omitempty"`
+	LastAppliedTimestamp *int64            `json:"lastAppliedTimestamp,omitempty"`
+	AppliedTaskCount     *int64            `json:"appliedTaskCount,omitempty"`
+	FailedTaskCount      *int64            `json:"failedTaskCount,omitempty"`
+	LastError            *ReplicationError `json:"lastError,omitempty"`
+}
+
+// ToWire translates a DomainReplicationStatus struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -906,37 +1042,77 @@ type GetDomainReplicationMessagesResponse struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *GetDomainReplicationMessagesResponse) ToWire() (wire.Value, error) {
+func (v *DomainReplicationStatus) ToWire() (wire.Value, error) {
 	var (
-		fields [1]wire.Field
+		fields [6]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Messages != nil {
-		w, err = v.Messages.ToWire()
+	if v.DomainID != nil {
+		w, err = wire.NewValueString(*(v.DomainID)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
+	if v.LastAppliedTaskID != nil {
+		w, err = wire.NewValueI64(*(v.LastAppliedTaskID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.LastAppliedTimestamp != nil {
+		w, err = wire.NewValueI64(*(v.LastAppliedTimestamp)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.AppliedTaskCount != nil {
+		w, err = wire.NewValueI64(*(v.AppliedTaskCount)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.FailedTaskCount != nil {
+		w, err = wire.NewValueI64(*(v.FailedTaskCount)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.LastError != nil {
+		w, err = v.LastError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _ReplicationMessages_Read(w wire.Value) (*ReplicationMessages, error) {
-	var v ReplicationMessages
+func _ReplicationError_Read(w wire.Value) (*ReplicationError, error) {
+	var v ReplicationError
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a GetDomainReplicationMessagesResponse struct from its Thrift-level
+// FromWire deserializes a DomainReplicationStatus struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a GetDomainReplicationMessagesResponse struct
+// An error is returned if we were unable to build a DomainReplicationStatus struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -944,58 +1120,153 @@ func _ReplicationMessages_Read(w wire.Value) (*ReplicationMessages, error) {
 //     return nil, err
 //   }
 //
-//   var v GetDomainReplicationMessagesResponse
+//   var v DomainReplicationStatus
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *GetDomainReplicationMessagesResponse) FromWire(w wire.Value) error {
+func (v *DomainReplicationStatus) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TStruct {
-				v.Messages, err = _ReplicationMessages_Read(field.Value)
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainID = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		}
-	}
-
-	return nil
-}
+		case 20:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.LastAppliedTaskID = &x
+				if err != nil {
+					return err
+				}
 
-// String returns a readable string representation of a GetDomainReplicationMessagesResponse
-// struct.
-func (v *GetDomainReplicationMessagesResponse) String() string {
-	if v == nil {
-		return "<nil>"
-	}
+			}
+		case 30:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.LastAppliedTimestamp = &x
+				if err != nil {
+					return err
+				}
 
-	var fields [1]string
-	i := 0
-	if v.Messages != nil {
-		fields[i] = fmt.Sprintf("Messages: %v", v.Messages)
-		i++
-	}
+			}
+		case 40:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.AppliedTaskCount = &x
+				if err != nil {
+					return err
+				}
 
-	return fmt.Sprintf("GetDomainReplicationMessagesResponse{%v}", strings.Join(fields[:i], ", "))
+			}
+		case 50:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.FailedTaskCount = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TStruct {
+				v.LastError, err = _ReplicationError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
 }
 
-// Equals returns true if all the fields of this GetDomainReplicationMessagesResponse match the
-// provided GetDomainReplicationMessagesResponse.
+// String returns a readable string representation of a DomainReplicationStatus
+// struct.
+func (v *DomainReplicationStatus) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [6]string
+	i := 0
+	if v.DomainID != nil {
+		fields[i] = fmt.Sprintf("DomainID: %v", *(v.DomainID))
+		i++
+	}
+	if v.LastAppliedTaskID != nil {
+		fields[i] = fmt.Sprintf("LastAppliedTaskID: %v", *(v.LastAppliedTaskID))
+		i++
+	}
+	if v.LastAppliedTimestamp != nil {
+		fields[i] = fmt.Sprintf("LastAppliedTimestamp: %v", *(v.LastAppliedTimestamp))
+		i++
+	}
+	if v.AppliedTaskCount != nil {
+		fields[i] = fmt.Sprintf("AppliedTaskCount: %v", *(v.AppliedTaskCount))
+		i++
+	}
+	if v.FailedTaskCount != nil {
+		fields[i] = fmt.Sprintf("FailedTaskCount: %v", *(v.FailedTaskCount))
+		i++
+	}
+	if v.LastError != nil {
+		fields[i] = fmt.Sprintf("LastError: %v", v.LastError)
+		i++
+	}
+
+	return fmt.Sprintf("DomainReplicationStatus{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _I64_EqualsPtr(lhs, rhs *int64) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this DomainReplicationStatus match the
+// provided DomainReplicationStatus.
 //
 // This function performs a deep comparison.
-func (v *GetDomainReplicationMessagesResponse) Equals(rhs *GetDomainReplicationMessagesResponse) bool {
+func (v *DomainReplicationStatus) Equals(rhs *DomainReplicationStatus) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.Messages == nil && rhs.Messages == nil) || (v.Messages != nil && rhs.Messages != nil && v.Messages.Equals(rhs.Messages))) {
+	if !_String_EqualsPtr(v.DomainID, rhs.DomainID) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.LastAppliedTaskID, rhs.LastAppliedTaskID) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.LastAppliedTimestamp, rhs.LastAppliedTimestamp) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.AppliedTaskCount, rhs.AppliedTaskCount) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.FailedTaskCount, rhs.FailedTaskCount) {
+		return false
+	}
+	if !((v.LastError == nil && rhs.LastError == nil) || (v.LastError != nil && rhs.LastError != nil && v.LastError.Equals(rhs.LastError))) {
 		return false
 	}
 
@@ -1003,66 +1274,133 @@ func (v *GetDomainReplicationMessagesResponse) Equals(rhs *GetDomainReplicationM
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of GetDomainReplicationMessagesResponse.
-func (v *GetDomainReplicationMessagesResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of DomainReplicationStatus.
+func (v *DomainReplicationStatus) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.Messages != nil {
-		err = multierr.Append(err, enc.AddObject("messages", v.Messages))
+	if v.DomainID != nil {
+		enc.AddString("domainID", *v.DomainID)
+	}
+	if v.LastAppliedTaskID != nil {
+		enc.AddInt64("lastAppliedTaskID", *v.LastAppliedTaskID)
+	}
+	if v.LastAppliedTimestamp != nil {
+		enc.AddInt64("lastAppliedTimestamp", *v.LastAppliedTimestamp)
+	}
+	if v.AppliedTaskCount != nil {
+		enc.AddInt64("appliedTaskCount", *v.AppliedTaskCount)
+	}
+	if v.FailedTaskCount != nil {
+		enc.AddInt64("failedTaskCount", *v.FailedTaskCount)
+	}
+	if v.LastError != nil {
+		err = multierr.Append(err, enc.AddObject("lastError", v.LastError))
 	}
 	return err
 }
 
-// GetMessages returns the value of Messages if it is set or its
+// GetDomainID returns the value of DomainID if it is set or its
 // zero value if it is unset.
-func (v *GetDomainReplicationMessagesResponse) GetMessages() (o *ReplicationMessages) {
-	if v != nil && v.Messages != nil {
-		return v.Messages
+func (v *DomainReplicationStatus) GetDomainID() (o string) {
+	if v != nil && v.DomainID != nil {
+		return *v.DomainID
 	}
 
 	return
 }
 
-// IsSetMessages returns true if Messages is not nil.
-func (v *GetDomainReplicationMessagesResponse) IsSetMessages() bool {
-	return v != nil && v.Messages != nil
+// IsSetDomainID returns true if DomainID is not nil.
+func (v *DomainReplicationStatus) IsSetDomainID() bool {
+	return v != nil && v.DomainID != nil
 }
 
-type GetReplicationMessagesRequest struct {
-	Tokens []*ReplicationToken `json:"tokens,omitempty"`
+// GetLastAppliedTaskID returns the value of LastAppliedTaskID if it is set or its
+// zero value if it is unset.
+func (v *DomainReplicationStatus) GetLastAppliedTaskID() (o int64) {
+	if v != nil && v.LastAppliedTaskID != nil {
+		return *v.LastAppliedTaskID
+	}
+
+	return
 }
 
-type _List_ReplicationToken_ValueList []*ReplicationToken
+// IsSetLastAppliedTaskID returns true if LastAppliedTaskID is not nil.
+func (v *DomainReplicationStatus) IsSetLastAppliedTaskID() bool {
+	return v != nil && v.LastAppliedTaskID != nil
+}
 
-func (v _List_ReplicationToken_ValueList) ForEach(f func(wire.Value) error) error {
-	for i, x := range v {
-		if x == nil {
-			return fmt.Errorf("invalid [%v]: value is nil", i)
-		}
-		w, err := x.ToWire()
-		if err != nil {
-			return err
-		}
-		err = f(w)
-		if err != nil {
-			return err
-		}
+// GetLastAppliedTimestamp returns the value of LastAppliedTimestamp if it is set or its
+// zero value if it is unset.
+func (v *DomainReplicationStatus) GetLastAppliedTimestamp() (o int64) {
+	if v != nil && v.LastAppliedTimestamp != nil {
+		return *v.LastAppliedTimestamp
 	}
-	return nil
+
+	return
 }
 
-func (v _List_ReplicationToken_ValueList) Size() int {
-	return len(v)
+// IsSetLastAppliedTimestamp returns true if LastAppliedTimestamp is not nil.
+func (v *DomainReplicationStatus) IsSetLastAppliedTimestamp() bool {
+	return v != nil && v.LastAppliedTimestamp != nil
 }
 
-func (_List_ReplicationToken_ValueList) ValueType() wire.Type {
-	return wire.TStruct
+// GetAppliedTaskCount returns the value of AppliedTaskCount if it is set or its
+// zero value if it is unset.
+func (v *DomainReplicationStatus) GetAppliedTaskCount() (o int64) {
+	if v != nil && v.AppliedTaskCount != nil {
+		return *v.AppliedTaskCount
+	}
+
+	return
 }
 
-func (_List_ReplicationToken_ValueList) Close() {}
+// IsSetAppliedTaskCount returns true if AppliedTaskCount is not nil.
+func (v *DomainReplicationStatus) IsSetAppliedTaskCount() bool {
+	return v != nil && v.AppliedTaskCount != nil
+}
 
-// ToWire translates a GetReplicationMessagesRequest struct into a Thrift-level intermediate
+// GetFailedTaskCount returns the value of FailedTaskCount if it is set or its
+// zero value if it is unset.
+func (v *DomainReplicationStatus) GetFailedTaskCount() (o int64) {
+	if v != nil && v.FailedTaskCount != nil {
+		return *v.FailedTaskCount
+	}
+
+	return
+}
+
+// IsSetFailedTaskCount returns true if FailedTaskCount is not nil.
+func (v *DomainReplicationStatus) IsSetFailedTaskCount() bool {
+	return v != nil && v.FailedTaskCount != nil
+}
+
+// GetLastError returns the value of LastError if it is set or its
+// zero value if it is unset.
+func (v *DomainReplicationStatus) GetLastError() (o *ReplicationError) {
+	if v != nil && v.LastError != nil {
+		return v.LastError
+	}
+
+	return
+}
+
+// IsSetLastError returns true if LastError is not nil.
+func (v *DomainReplicationStatus) IsSetLastError() bool {
+	return v != nil && v.LastError != nil
+}
+
+type DomainTaskAttributes struct {
+	DomainOperation   *DomainOperation                       `json:"domainOperation,omitempty"`
+	ID                *string                                `json:"id,omitempty"`
+	Info              *shared.DomainInfo                     `json:"info,omitempty"`
+	Config            *shared.DomainConfiguration            `json:"config,omitempty"`
+	ReplicationConfig *shared.DomainReplicationConfiguration `json:"replicationConfig,omitempty"`
+	ConfigVersion     *int64                                 `json:"configVersion,omitempty"`
+	FailoverVersion   *int64                                 `json:"failoverVersion,omitempty"`
+}
+
+// ToWire translates a DomainTaskAttributes struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -1077,55 +1415,103 @@ func (_List_ReplicationToken_ValueList) Close() {}
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *GetReplicationMessagesRequest) ToWire() (wire.Value, error) {
+func (v *DomainTaskAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [1]wire.Field
+		fields [7]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Tokens != nil {
-		w, err = wire.NewValueList(_List_ReplicationToken_ValueList(v.Tokens)), error(nil)
+	if v.DomainOperation != nil {
+		w, err = v.DomainOperation.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
+	if v.ID != nil {
+		w, err = wire.NewValueString(*(v.ID)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
+	if v.Info != nil {
+		w, err = v.Info.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.Config != nil {
+		w, err = v.Config.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.ReplicationConfig != nil {
+		w, err = v.ReplicationConfig.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.ConfigVersion != nil {
+		w, err = wire.NewValueI64(*(v.ConfigVersion)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.FailoverVersion != nil {
+		w, err = wire.NewValueI64(*(v.FailoverVersion)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _ReplicationToken_Read(w wire.Value) (*ReplicationToken, error) {
-	var v ReplicationToken
+func _DomainOperation_Read(w wire.Value) (DomainOperation, error) {
+	var v DomainOperation
+	err := v.FromWire(w)
+	return v, err
+}
+
+func _DomainInfo_Read(w wire.Value) (*shared.DomainInfo, error) {
+	var v shared.DomainInfo
 	err := v.FromWire(w)
 	return &v, err
 }
 
-func _List_ReplicationToken_Read(l wire.ValueList) ([]*ReplicationToken, error) {
-	if l.ValueType() != wire.TStruct {
-		return nil, nil
-	}
+func _DomainConfiguration_Read(w wire.Value) (*shared.DomainConfiguration, error) {
+	var v shared.DomainConfiguration
+	err := v.FromWire(w)
+	return &v, err
+}
 
-	o := make([]*ReplicationToken, 0, l.Size())
-	err := l.ForEach(func(x wire.Value) error {
-		i, err := _ReplicationToken_Read(x)
-		if err != nil {
-			return err
-		}
-		o = append(o, i)
-		return nil
-	})
-	l.Close()
-	return o, err
+func _DomainReplicationConfiguration_Read(w wire.Value) (*shared.DomainReplicationConfiguration, error) {
+	var v shared.DomainReplicationConfiguration
+	err := v.FromWire(w)
+	return &v, err
 }
 
-// FromWire deserializes a GetReplicationMessagesRequest struct from its Thrift-level
+// FromWire deserializes a DomainTaskAttributes struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a GetReplicationMessagesRequest struct
+// An error is returned if we were unable to build a DomainTaskAttributes struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -1133,20 +1519,76 @@ func _List_ReplicationToken_Read(l wire.ValueList) ([]*ReplicationToken, error)
 //     return nil, err
 //   }
 //
-//   var v GetReplicationMessagesRequest
+//   var v DomainTaskAttributes
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *GetReplicationMessagesRequest) FromWire(w wire.Value) error {
+func (v *DomainTaskAttributes) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 10:
-			if field.Value.Type() == wire.TList {
-				v.Tokens, err = _List_ReplicationToken_Read(field.Value.GetList())
-				if err != nil {
+		case 5:
+			if field.Value.Type() == wire.TI32 {
+				var x DomainOperation
+				x, err = _DomainOperation_Read(field.Value)
+				v.DomainOperation = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.Info, err = _DomainInfo_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TStruct {
+				v.Config, err = _DomainConfiguration_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TStruct {
+				v.ReplicationConfig, err = _DomainReplicationConfiguration_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.ConfigVersion = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.FailoverVersion = &x
+				if err != nil {
 					return err
 				}
 
@@ -1157,138 +1599,263 @@ func (v *GetReplicationMessagesRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a GetReplicationMessagesRequest
+// String returns a readable string representation of a DomainTaskAttributes
 // struct.
-func (v *GetReplicationMessagesRequest) String() string {
+func (v *DomainTaskAttributes) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [1]string
+	var fields [7]string
 	i := 0
-	if v.Tokens != nil {
-		fields[i] = fmt.Sprintf("Tokens: %v", v.Tokens)
+	if v.DomainOperation != nil {
+		fields[i] = fmt.Sprintf("DomainOperation: %v", *(v.DomainOperation))
+		i++
+	}
+	if v.ID != nil {
+		fields[i] = fmt.Sprintf("ID: %v", *(v.ID))
+		i++
+	}
+	if v.Info != nil {
+		fields[i] = fmt.Sprintf("Info: %v", v.Info)
+		i++
+	}
+	if v.Config != nil {
+		fields[i] = fmt.Sprintf("Config: %v", v.Config)
+		i++
+	}
+	if v.ReplicationConfig != nil {
+		fields[i] = fmt.Sprintf("ReplicationConfig: %v", v.ReplicationConfig)
+		i++
+	}
+	if v.ConfigVersion != nil {
+		fields[i] = fmt.Sprintf("ConfigVersion: %v", *(v.ConfigVersion))
+		i++
+	}
+	if v.FailoverVersion != nil {
+		fields[i] = fmt.Sprintf("FailoverVersion: %v", *(v.FailoverVersion))
 		i++
 	}
 
-	return fmt.Sprintf("GetReplicationMessagesRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("DomainTaskAttributes{%v}", strings.Join(fields[:i], ", "))
 }
 
-func _List_ReplicationToken_Equals(lhs, rhs []*ReplicationToken) bool {
-	if len(lhs) != len(rhs) {
-		return false
-	}
+func _DomainOperation_EqualsPtr(lhs, rhs *DomainOperation) bool {
+	if lhs != nil && rhs != nil {
 
-	for i, lv := range lhs {
-		rv := rhs[i]
-		if !lv.Equals(rv) {
-			return false
-		}
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
 	}
-
-	return true
+	return lhs == nil && rhs == nil
 }
 
-// Equals returns true if all the fields of this GetReplicationMessagesRequest match the
-// provided GetReplicationMessagesRequest.
+// Equals returns true if all the fields of this DomainTaskAttributes match the
+// provided DomainTaskAttributes.
 //
 // This function performs a deep comparison.
-func (v *GetReplicationMessagesRequest) Equals(rhs *GetReplicationMessagesRequest) bool {
+func (v *DomainTaskAttributes) Equals(rhs *DomainTaskAttributes) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.Tokens == nil && rhs.Tokens == nil) || (v.Tokens != nil && rhs.Tokens != nil && _List_ReplicationToken_Equals(v.Tokens, rhs.Tokens))) {
+	if !_DomainOperation_EqualsPtr(v.DomainOperation, rhs.DomainOperation) {
+		return false
+	}
+	if !_String_EqualsPtr(v.ID, rhs.ID) {
+		return false
+	}
+	if !((v.Info == nil && rhs.Info == nil) || (v.Info != nil && rhs.Info != nil && v.Info.Equals(rhs.Info))) {
+		return false
+	}
+	if !((v.Config == nil && rhs.Config == nil) || (v.Config != nil && rhs.Config != nil && v.Config.Equals(rhs.Config))) {
+		return false
+	}
+	if !((v.ReplicationConfig == nil && rhs.ReplicationConfig == nil) || (v.ReplicationConfig != nil && rhs.ReplicationConfig != nil && v.ReplicationConfig.Equals(rhs.ReplicationConfig))) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.ConfigVersion, rhs.ConfigVersion) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.FailoverVersion, rhs.FailoverVersion) {
 		return false
 	}
 
 	return true
 }
 
-type _List_ReplicationToken_Zapper []*ReplicationToken
-
-// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
-// fast logging of _List_ReplicationToken_Zapper.
-func (l _List_ReplicationToken_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
-	for _, v := range l {
-		err = multierr.Append(err, enc.AppendObject(v))
-	}
-	return err
-}
-
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of GetReplicationMessagesRequest.
-func (v *GetReplicationMessagesRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of DomainTaskAttributes.
+func (v *DomainTaskAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.Tokens != nil {
-		err = multierr.Append(err, enc.AddArray("tokens", (_List_ReplicationToken_Zapper)(v.Tokens)))
+	if v.DomainOperation != nil {
+		err = multierr.Append(err, enc.AddObject("domainOperation", *v.DomainOperation))
+	}
+	if v.ID != nil {
+		enc.AddString("id", *v.ID)
+	}
+	if v.Info != nil {
+		err = multierr.Append(err, enc.AddObject("info", v.Info))
+	}
+	if v.Config != nil {
+		err = multierr.Append(err, enc.AddObject("config", v.Config))
+	}
+	if v.ReplicationConfig != nil {
+		err = multierr.Append(err, enc.AddObject("replicationConfig", v.ReplicationConfig))
+	}
+	if v.ConfigVersion != nil {
+		enc.AddInt64("configVersion", *v.ConfigVersion)
+	}
+	if v.FailoverVersion != nil {
+		enc.AddInt64("failoverVersion", *v.FailoverVersion)
 	}
 	return err
 }
 
-// GetTokens returns the value of Tokens if it is set or its
+// GetDomainOperation returns the value of DomainOperation if it is set or its
 // zero value if it is unset.
-func (v *GetReplicationMessagesRequest) GetTokens() (o []*ReplicationToken) {
-	if v != nil && v.Tokens != nil {
-		return v.Tokens
+func (v *DomainTaskAttributes) GetDomainOperation() (o DomainOperation) {
+	if v != nil && v.DomainOperation != nil {
+		return *v.DomainOperation
 	}
 
 	return
 }
 
-// IsSetTokens returns true if Tokens is not nil.
-func (v *GetReplicationMessagesRequest) IsSetTokens() bool {
-	return v != nil && v.Tokens != nil
+// IsSetDomainOperation returns true if DomainOperation is not nil.
+func (v *DomainTaskAttributes) IsSetDomainOperation() bool {
+	return v != nil && v.DomainOperation != nil
 }
 
-type GetReplicationMessagesResponse struct {
-	MessagesByShard map[int32]*ReplicationMessages `json:"messagesByShard,omitempty"`
-}
+// GetID returns the value of ID if it is set or its
+// zero value if it is unset.
+func (v *DomainTaskAttributes) GetID() (o string) {
+	if v != nil && v.ID != nil {
+		return *v.ID
+	}
 
-type _Map_I32_ReplicationMessages_MapItemList map[int32]*ReplicationMessages
+	return
+}
 
-func (m _Map_I32_ReplicationMessages_MapItemList) ForEach(f func(wire.MapItem) error) error {
-	for k, v := range m {
-		if v == nil {
-			return fmt.Errorf("invalid [%v]: value is nil", k)
-		}
-		kw, err := wire.NewValueI32(k), error(nil)
-		if err != nil {
-			return err
-		}
+// IsSetID returns true if ID is not nil.
+func (v *DomainTaskAttributes) IsSetID() bool {
+	return v != nil && v.ID != nil
+}
 
-		vw, err := v.ToWire()
-		if err != nil {
-			return err
-		}
-		err = f(wire.MapItem{Key: kw, Value: vw})
-		if err != nil {
-			return err
-		}
+// GetInfo returns the value of Info if it is set or its
+// zero value if it is unset.
+func (v *DomainTaskAttributes) GetInfo() (o *shared.DomainInfo) {
+	if v != nil && v.Info != nil {
+		return v.Info
 	}
-	return nil
-}
 
-func (m _Map_I32_ReplicationMessages_MapItemList) Size() int {
-	return len(m)
+	return
 }
 
-func (_Map_I32_ReplicationMessages_MapItemList) KeyType() wire.Type {
-	return wire.TI32
+// IsSetInfo returns true if Info is not nil.
+func (v *DomainTaskAttributes) IsSetInfo() bool {
+	return v != nil && v.Info != nil
 }
 
-func (_Map_I32_ReplicationMessages_MapItemList) ValueType() wire.Type {
-	return wire.TStruct
+// GetConfig returns the value of Config if it is set or its
+// zero value if it is unset.
+func (v *DomainTaskAttributes) GetConfig() (o *shared.DomainConfiguration) {
+	if v != nil && v.Config != nil {
+		return v.Config
+	}
+
+	return
 }
 
-func (_Map_I32_ReplicationMessages_MapItemList) Close() {}
+// IsSetConfig returns true if Config is not nil.
+func (v *DomainTaskAttributes) IsSetConfig() bool {
+	return v != nil && v.Config != nil
+}
 
-// ToWire translates a GetReplicationMessagesResponse struct into a Thrift-level intermediate
-// representation. This intermediate representation may be serialized
-// into bytes using a ThriftRW protocol implementation.
+// GetReplicationConfig returns the value of ReplicationConfig if it is set or its
+// zero value if it is unset.
+func (v *DomainTaskAttributes) GetReplicationConfig() (o *shared.DomainReplicationConfiguration) {
+	if v != nil && v.ReplicationConfig != nil {
+		return v.ReplicationConfig
+	}
+
+	return
+}
+
+// IsSetReplicationConfig returns true if ReplicationConfig is not nil.
+func (v *DomainTaskAttributes) IsSetReplicationConfig() bool {
+	return v != nil && v.ReplicationConfig != nil
+}
+
+// GetConfigVersion returns the value of ConfigVersion if it is set or its
+// zero value if it is unset.
+func (v *DomainTaskAttributes) GetConfigVersion() (o int64) {
+	if v != nil && v.ConfigVersion != nil {
+		return *v.ConfigVersion
+	}
+
+	return
+}
+
+// IsSetConfigVersion returns true if ConfigVersion is not nil.
+func (v *DomainTaskAttributes) IsSetConfigVersion() bool {
+	return v != nil && v.ConfigVersion != nil
+}
+
+// GetFailoverVersion returns the value of FailoverVersion if it is set or its
+// zero value if it is unset.
+func (v *DomainTaskAttributes) GetFailoverVersion() (o int64) {
+	if v != nil && v.FailoverVersion != nil {
+		return *v.FailoverVersion
+	}
+
+	return
+}
+
+// IsSetFailoverVersion returns true if FailoverVersion is not nil.
+func (v *DomainTaskAttributes) IsSetFailoverVersion() bool {
+	return v != nil && v.FailoverVersion != nil
+}
+
+type GetDLQReplicationMessagesRequest struct {
+	TaskInfos []*ReplicationTaskInfo `json:"taskInfos,omitempty"`
+}
+
+type _List_ReplicationTaskInfo_ValueList []*ReplicationTaskInfo
+
+func (v _List_ReplicationTaskInfo_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_ReplicationTaskInfo_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_ReplicationTaskInfo_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_ReplicationTaskInfo_ValueList) Close() {}
+
+// ToWire translates a GetDLQReplicationMessagesRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
 //
 // An error is returned if the struct or any of its fields failed to
 // validate.
@@ -1301,7 +1868,7 @@ func (_Map_I32_ReplicationMessages_MapItemList) Close() {}
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *GetReplicationMessagesResponse) ToWire() (wire.Value, error) {
+func (v *GetDLQReplicationMessagesRequest) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -1309,8 +1876,8 @@ func (v *GetReplicationMessagesResponse) ToWire() (wire.Value, error) {
 		err    error
 	)
 
-	if v.MessagesByShard != nil {
-		w, err = wire.NewValueMap(_Map_I32_ReplicationMessages_MapItemList(v.MessagesByShard)), error(nil)
+	if v.TaskInfos != nil {
+		w, err = wire.NewValueList(_List_ReplicationTaskInfo_ValueList(v.TaskInfos)), error(nil)
 		if err != nil {
 			return w, err
 		}
@@ -1321,39 +1888,35 @@ func (v *GetReplicationMessagesResponse) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _Map_I32_ReplicationMessages_Read(m wire.MapItemList) (map[int32]*ReplicationMessages, error) {
-	if m.KeyType() != wire.TI32 {
-		return nil, nil
-	}
+func _ReplicationTaskInfo_Read(w wire.Value) (*ReplicationTaskInfo, error) {
+	var v ReplicationTaskInfo
+	err := v.FromWire(w)
+	return &v, err
+}
 
-	if m.ValueType() != wire.TStruct {
+func _List_ReplicationTaskInfo_Read(l wire.ValueList) ([]*ReplicationTaskInfo, error) {
+	if l.ValueType() != wire.TStruct {
 		return nil, nil
 	}
 
-	o := make(map[int32]*ReplicationMessages, m.Size())
-	err := m.ForEach(func(x wire.MapItem) error {
-		k, err := x.Key.GetI32(), error(nil)
-		if err != nil {
-			return err
-		}
-
-		v, err := _ReplicationMessages_Read(x.Value)
+	o := make([]*ReplicationTaskInfo, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _ReplicationTaskInfo_Read(x)
 		if err != nil {
 			return err
 		}
-
-		o[k] = v
+		o = append(o, i)
 		return nil
 	})
-	m.Close()
+	l.Close()
 	return o, err
 }
 
-// FromWire deserializes a GetReplicationMessagesResponse struct from its Thrift-level
+// FromWire deserializes a GetDLQReplicationMessagesRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a GetReplicationMessagesResponse struct
+// An error is returned if we were unable to build a GetDLQReplicationMessagesRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -1361,19 +1924,19 @@ func _Map_I32_ReplicationMessages_Read(m wire.MapItemList) (map[int32]*Replicati
 //     return nil, err
 //   }
 //
-//   var v GetReplicationMessagesResponse
+//   var v GetDLQReplicationMessagesRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *GetReplicationMessagesResponse) FromWire(w wire.Value) error {
+func (v *GetDLQReplicationMessagesRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TMap {
-				v.MessagesByShard, err = _Map_I32_ReplicationMessages_Read(field.Value.GetMap())
+			if field.Value.Type() == wire.TList {
+				v.TaskInfos, err = _List_ReplicationTaskInfo_Read(field.Value.GetList())
 				if err != nil {
 					return err
 				}
@@ -1385,122 +1948,105 @@ func (v *GetReplicationMessagesResponse) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a GetReplicationMessagesResponse
+// String returns a readable string representation of a GetDLQReplicationMessagesRequest
 // struct.
-func (v *GetReplicationMessagesResponse) String() string {
+func (v *GetDLQReplicationMessagesRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
 	var fields [1]string
 	i := 0
-	if v.MessagesByShard != nil {
-		fields[i] = fmt.Sprintf("MessagesByShard: %v", v.MessagesByShard)
+	if v.TaskInfos != nil {
+		fields[i] = fmt.Sprintf("TaskInfos: %v", v.TaskInfos)
 		i++
 	}
 
-	return fmt.Sprintf("GetReplicationMessagesResponse{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("GetDLQReplicationMessagesRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-func _Map_I32_ReplicationMessages_Equals(lhs, rhs map[int32]*ReplicationMessages) bool {
+func _List_ReplicationTaskInfo_Equals(lhs, rhs []*ReplicationTaskInfo) bool {
 	if len(lhs) != len(rhs) {
 		return false
 	}
 
-	for lk, lv := range lhs {
-		rv, ok := rhs[lk]
-		if !ok {
-			return false
-		}
+	for i, lv := range lhs {
+		rv := rhs[i]
 		if !lv.Equals(rv) {
 			return false
 		}
 	}
+
 	return true
 }
 
-// Equals returns true if all the fields of this GetReplicationMessagesResponse match the
-// provided GetReplicationMessagesResponse.
+// Equals returns true if all the fields of this GetDLQReplicationMessagesRequest match the
+// provided GetDLQReplicationMessagesRequest.
 //
 // This function performs a deep comparison.
-func (v *GetReplicationMessagesResponse) Equals(rhs *GetReplicationMessagesResponse) bool {
+func (v *GetDLQReplicationMessagesRequest) Equals(rhs *GetDLQReplicationMessagesRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.MessagesByShard == nil && rhs.MessagesByShard == nil) || (v.MessagesByShard != nil && rhs.MessagesByShard != nil && _Map_I32_ReplicationMessages_Equals(v.MessagesByShard, rhs.MessagesByShard))) {
+	if !((v.TaskInfos == nil && rhs.TaskInfos == nil) || (v.TaskInfos != nil && rhs.TaskInfos != nil && _List_ReplicationTaskInfo_Equals(v.TaskInfos, rhs.TaskInfos))) {
 		return false
 	}
 
 	return true
 }
 
-type _Map_I32_ReplicationMessages_Item_Zapper struct {
-	Key   int32
-	Value *ReplicationMessages
-}
-
-// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
-// fast logging of _Map_I32_ReplicationMessages_Item_Zapper.
-func (v _Map_I32_ReplicationMessages_Item_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
-	enc.AddInt32("key", v.Key)
-	err = multierr.Append(err, enc.AddObject("value", v.Value))
-	return err
-}
-
-type _Map_I32_ReplicationMessages_Zapper map[int32]*ReplicationMessages
+type _List_ReplicationTaskInfo_Zapper []*ReplicationTaskInfo
 
 // MarshalLogArray implements zapcore.ArrayMarshaler, enabling
-// fast logging of _Map_I32_ReplicationMessages_Zapper.
-func (m _Map_I32_ReplicationMessages_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
-	for k, v := range m {
-		err = multierr.Append(err, enc.AppendObject(_Map_I32_ReplicationMessages_Item_Zapper{Key: k, Value: v}))
+// fast logging of _List_ReplicationTaskInfo_Zapper.
+func (l _List_ReplicationTaskInfo_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
 	}
 	return err
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of GetReplicationMessagesResponse.
-func (v *GetReplicationMessagesResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of GetDLQReplicationMessagesRequest.
+func (v *GetDLQReplicationMessagesRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.MessagesByShard != nil {
-		err = multierr.Append(err, enc.AddArray("messagesByShard", (_Map_I32_ReplicationMessages_Zapper)(v.MessagesByShard)))
+	if v.TaskInfos != nil {
+		err = multierr.Append(err, enc.AddArray("taskInfos", (_List_ReplicationTaskInfo_Zapper)(v.TaskInfos)))
 	}
 	return err
 }
 
-// GetMessagesByShard returns the value of MessagesByShard if it is set or its
+// GetTaskInfos returns the value of TaskInfos if it is set or its
 // zero value if it is unset.
-func (v *GetReplicationMessagesResponse) GetMessagesByShard() (o map[int32]*ReplicationMessages) {
-	if v != nil && v.MessagesByShard != nil {
-		return v.MessagesByShard
+func (v *GetDLQReplicationMessagesRequest) GetTaskInfos() (o []*ReplicationTaskInfo) {
+	if v != nil && v.TaskInfos != nil {
+		return v.TaskInfos
 	}
 
 	return
 }
 
-// IsSetMessagesByShard returns true if MessagesByShard is not nil.
-func (v *GetReplicationMessagesResponse) IsSetMessagesByShard() bool {
-	return v != nil && v.MessagesByShard != nil
+// IsSetTaskInfos returns true if TaskInfos is not nil.
+func (v *GetDLQReplicationMessagesRequest) IsSetTaskInfos() bool {
+	return v != nil && v.TaskInfos != nil
 }
 
-type HistoryMetadataTaskAttributes struct {
-	TargetClusters []string `json:"targetClusters,omitempty"`
-	DomainId       *string  `json:"domainId,omitempty"`
-	WorkflowId     *string  `json:"workflowId,omitempty"`
-	RunId          *string  `json:"runId,omitempty"`
-	FirstEventId   *int64   `json:"firstEventId,omitempty"`
-	NextEventId    *int64   `json:"nextEventId,omitempty"`
+type GetDLQReplicationMessagesResponse struct {
+	ReplicationTasks []*ReplicationTask `json:"replicationTasks,omitempty"`
 }
 
-type _List_String_ValueList []string
+type _List_ReplicationTask_ValueList []*ReplicationTask
 
-func (v _List_String_ValueList) ForEach(f func(wire.Value) error) error {
-	for _, x := range v {
-		w, err := wire.NewValueString(x), error(nil)
+func (v _List_ReplicationTask_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
 		if err != nil {
 			return err
 		}
@@ -1512,17 +2058,17 @@ func (v _List_String_ValueList) ForEach(f func(wire.Value) error) error {
 	return nil
 }
 
-func (v _List_String_ValueList) Size() int {
+func (v _List_ReplicationTask_ValueList) Size() int {
 	return len(v)
 }
 
-func (_List_String_ValueList) ValueType() wire.Type {
-	return wire.TBinary
+func (_List_ReplicationTask_ValueList) ValueType() wire.Type {
+	return wire.TStruct
 }
 
-func (_List_String_ValueList) Close() {}
+func (_List_ReplicationTask_ValueList) Close() {}
 
-// ToWire translates a HistoryMetadataTaskAttributes struct into a Thrift-level intermediate
+// ToWire translates a GetDLQReplicationMessagesResponse struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -1537,74 +2083,40 @@ func (_List_String_ValueList) Close() {}
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *HistoryMetadataTaskAttributes) ToWire() (wire.Value, error) {
+func (v *GetDLQReplicationMessagesResponse) ToWire() (wire.Value, error) {
 	var (
-		fields [6]wire.Field
+		fields [1]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.TargetClusters != nil {
-		w, err = wire.NewValueList(_List_String_ValueList(v.TargetClusters)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 5, Value: w}
-		i++
-	}
-	if v.DomainId != nil {
-		w, err = wire.NewValueString(*(v.DomainId)), error(nil)
+	if v.ReplicationTasks != nil {
+		w, err = wire.NewValueList(_List_ReplicationTask_ValueList(v.ReplicationTasks)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.WorkflowId != nil {
-		w, err = wire.NewValueString(*(v.WorkflowId)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 20, Value: w}
-		i++
-	}
-	if v.RunId != nil {
-		w, err = wire.NewValueString(*(v.RunId)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 30, Value: w}
-		i++
-	}
-	if v.FirstEventId != nil {
-		w, err = wire.NewValueI64(*(v.FirstEventId)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 40, Value: w}
-		i++
-	}
-	if v.NextEventId != nil {
-		w, err = wire.NewValueI64(*(v.NextEventId)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 50, Value: w}
-		i++
-	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _List_String_Read(l wire.ValueList) ([]string, error) {
-	if l.ValueType() != wire.TBinary {
+func _ReplicationTask_Read(w wire.Value) (*ReplicationTask, error) {
+	var v ReplicationTask
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_ReplicationTask_Read(l wire.ValueList) ([]*ReplicationTask, error) {
+	if l.ValueType() != wire.TStruct {
 		return nil, nil
 	}
 
-	o := make([]string, 0, l.Size())
+	o := make([]*ReplicationTask, 0, l.Size())
 	err := l.ForEach(func(x wire.Value) error {
-		i, err := x.GetString(), error(nil)
+		i, err := _ReplicationTask_Read(x)
 		if err != nil {
 			return err
 		}
@@ -1615,11 +2127,11 @@ func _List_String_Read(l wire.ValueList) ([]string, error) {
 	return o, err
 }
 
-// FromWire deserializes a HistoryMetadataTaskAttributes struct from its Thrift-level
+// FromWire deserializes a GetDLQReplicationMessagesResponse struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a HistoryMetadataTaskAttributes struct
+// An error is returned if we were unable to build a GetDLQReplicationMessagesResponse struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -1627,69 +2139,19 @@ func _List_String_Read(l wire.ValueList) ([]string, error) {
 //     return nil, err
 //   }
 //
-//   var v HistoryMetadataTaskAttributes
+//   var v GetDLQReplicationMessagesResponse
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *HistoryMetadataTaskAttributes) FromWire(w wire.Value) error {
+func (v *GetDLQReplicationMessagesResponse) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 5:
+		case 10:
 			if field.Value.Type() == wire.TList {
-				v.TargetClusters, err = _List_String_Read(field.Value.GetList())
-				if err != nil {
-					return err
-				}
-
-			}
-		case 10:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.DomainId = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 20:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.WorkflowId = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 30:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.RunId = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 40:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.FirstEventId = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 50:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.NextEventId = &x
+				v.ReplicationTasks, err = _List_ReplicationTask_Read(field.Value.GetList())
 				if err != nil {
 					return err
 				}
@@ -1701,51 +2163,31 @@ func (v *HistoryMetadataTaskAttributes) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a HistoryMetadataTaskAttributes
+// String returns a readable string representation of a GetDLQReplicationMessagesResponse
 // struct.
-func (v *HistoryMetadataTaskAttributes) String() string {
+func (v *GetDLQReplicationMessagesResponse) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [6]string
+	var fields [1]string
 	i := 0
-	if v.TargetClusters != nil {
-		fields[i] = fmt.Sprintf("TargetClusters: %v", v.TargetClusters)
-		i++
-	}
-	if v.DomainId != nil {
-		fields[i] = fmt.Sprintf("DomainId: %v", *(v.DomainId))
-		i++
-	}
-	if v.WorkflowId != nil {
-		fields[i] = fmt.Sprintf("WorkflowId: %v", *(v.WorkflowId))
-		i++
-	}
-	if v.RunId != nil {
-		fields[i] = fmt.Sprintf("RunId: %v", *(v.RunId))
-		i++
-	}
-	if v.FirstEventId != nil {
-		fields[i] = fmt.Sprintf("FirstEventId: %v", *(v.FirstEventId))
-		i++
-	}
-	if v.NextEventId != nil {
-		fields[i] = fmt.Sprintf("NextEventId: %v", *(v.NextEventId))
+	if v.ReplicationTasks != nil {
+		fields[i] = fmt.Sprintf("ReplicationTasks: %v", v.ReplicationTasks)
 		i++
 	}
 
-	return fmt.Sprintf("HistoryMetadataTaskAttributes{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("GetDLQReplicationMessagesResponse{%v}", strings.Join(fields[:i], ", "))
 }
 
-func _List_String_Equals(lhs, rhs []string) bool {
+func _List_ReplicationTask_Equals(lhs, rhs []*ReplicationTask) bool {
 	if len(lhs) != len(rhs) {
 		return false
 	}
 
 	for i, lv := range lhs {
 		rv := rhs[i]
-		if !(lv == rv) {
+		if !lv.Equals(rv) {
 			return false
 		}
 	}
@@ -1753,222 +2195,292 @@ func _List_String_Equals(lhs, rhs []string) bool {
 	return true
 }
 
-// Equals returns true if all the fields of this HistoryMetadataTaskAttributes match the
-// provided HistoryMetadataTaskAttributes.
+// Equals returns true if all the fields of this GetDLQReplicationMessagesResponse match the
+// provided GetDLQReplicationMessagesResponse.
 //
 // This function performs a deep comparison.
-func (v *HistoryMetadataTaskAttributes) Equals(rhs *HistoryMetadataTaskAttributes) bool {
+func (v *GetDLQReplicationMessagesResponse) Equals(rhs *GetDLQReplicationMessagesResponse) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.TargetClusters == nil && rhs.TargetClusters == nil) || (v.TargetClusters != nil && rhs.TargetClusters != nil && _List_String_Equals(v.TargetClusters, rhs.TargetClusters))) {
-		return false
-	}
-	if !_String_EqualsPtr(v.DomainId, rhs.DomainId) {
-		return false
-	}
-	if !_String_EqualsPtr(v.WorkflowId, rhs.WorkflowId) {
-		return false
-	}
-	if !_String_EqualsPtr(v.RunId, rhs.RunId) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.FirstEventId, rhs.FirstEventId) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.NextEventId, rhs.NextEventId) {
+	if !((v.ReplicationTasks == nil && rhs.ReplicationTasks == nil) || (v.ReplicationTasks != nil && rhs.ReplicationTasks != nil && _List_ReplicationTask_Equals(v.ReplicationTasks, rhs.ReplicationTasks))) {
 		return false
 	}
 
 	return true
 }
 
-type _List_String_Zapper []string
+type _List_ReplicationTask_Zapper []*ReplicationTask
 
 // MarshalLogArray implements zapcore.ArrayMarshaler, enabling
-// fast logging of _List_String_Zapper.
-func (l _List_String_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+// fast logging of _List_ReplicationTask_Zapper.
+func (l _List_ReplicationTask_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
 	for _, v := range l {
-		enc.AppendString(v)
+		err = multierr.Append(err, enc.AppendObject(v))
 	}
 	return err
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of HistoryMetadataTaskAttributes.
-func (v *HistoryMetadataTaskAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of GetDLQReplicationMessagesResponse.
+func (v *GetDLQReplicationMessagesResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.TargetClusters != nil {
-		err = multierr.Append(err, enc.AddArray("targetClusters", (_List_String_Zapper)(v.TargetClusters)))
-	}
-	if v.DomainId != nil {
-		enc.AddString("domainId", *v.DomainId)
-	}
-	if v.WorkflowId != nil {
-		enc.AddString("workflowId", *v.WorkflowId)
-	}
-	if v.RunId != nil {
-		enc.AddString("runId", *v.RunId)
-	}
-	if v.FirstEventId != nil {
-		enc.AddInt64("firstEventId", *v.FirstEventId)
-	}
-	if v.NextEventId != nil {
-		enc.AddInt64("nextEventId", *v.NextEventId)
+	if v.ReplicationTasks != nil {
+		err = multierr.Append(err, enc.AddArray("replicationTasks", (_List_ReplicationTask_Zapper)(v.ReplicationTasks)))
 	}
 	return err
 }
 
-// GetTargetClusters returns the value of TargetClusters if it is set or its
+// GetReplicationTasks returns the value of ReplicationTasks if it is set or its
 // zero value if it is unset.
-func (v *HistoryMetadataTaskAttributes) GetTargetClusters() (o []string) {
-	if v != nil && v.TargetClusters != nil {
-		return v.TargetClusters
+func (v *GetDLQReplicationMessagesResponse) GetReplicationTasks() (o []*ReplicationTask) {
+	if v != nil && v.ReplicationTasks != nil {
+		return v.ReplicationTasks
 	}
 
 	return
 }
 
-// IsSetTargetClusters returns true if TargetClusters is not nil.
-func (v *HistoryMetadataTaskAttributes) IsSetTargetClusters() bool {
-	return v != nil && v.TargetClusters != nil
+// IsSetReplicationTasks returns true if ReplicationTasks is not nil.
+func (v *GetDLQReplicationMessagesResponse) IsSetReplicationTasks() bool {
+	return v != nil && v.ReplicationTasks != nil
 }
 
-// GetDomainId returns the value of DomainId if it is set or its
-// zero value if it is unset.
-func (v *HistoryMetadataTaskAttributes) GetDomainId() (o string) {
-	if v != nil && v.DomainId != nil {
-		return *v.DomainId
-	}
-
-	return
+type GetDomainReplicationMessagesRequest struct {
+	LastRetrivedMessageId  *int64  `json:"lastRetrivedMessageId,omitempty"`
+	LastProcessedMessageId *int64  `json:"lastProcessedMessageId,omitempty"`
+	ClusterName            *string `json:"clusterName,omitempty"`
 }
 
-// IsSetDomainId returns true if DomainId is not nil.
-func (v *HistoryMetadataTaskAttributes) IsSetDomainId() bool {
-	return v != nil && v.DomainId != nil
-}
+// ToWire translates a GetDomainReplicationMessagesRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *GetDomainReplicationMessagesRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
 
-// GetWorkflowId returns the value of WorkflowId if it is set or its
-// zero value if it is unset.
-func (v *HistoryMetadataTaskAttributes) GetWorkflowId() (o string) {
-	if v != nil && v.WorkflowId != nil {
-		return *v.WorkflowId
+	if v.LastRetrivedMessageId != nil {
+		w, err = wire.NewValueI64(*(v.LastRetrivedMessageId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
 	}
-
-	return
-}
-
-// IsSetWorkflowId returns true if WorkflowId is not nil.
-func (v *HistoryMetadataTaskAttributes) IsSetWorkflowId() bool {
-	return v != nil && v.WorkflowId != nil
-}
-
-// GetRunId returns the value of RunId if it is set or its
-// zero value if it is unset.
-func (v *HistoryMetadataTaskAttributes) GetRunId() (o string) {
-	if v != nil && v.RunId != nil {
-		return *v.RunId
+	if v.LastProcessedMessageId != nil {
+		w, err = wire.NewValueI64(*(v.LastProcessedMessageId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
 	}
-
-	return
-}
-
-// IsSetRunId returns true if RunId is not nil.
-func (v *HistoryMetadataTaskAttributes) IsSetRunId() bool {
-	return v != nil && v.RunId != nil
-}
-
-// GetFirstEventId returns the value of FirstEventId if it is set or its
-// zero value if it is unset.
-func (v *HistoryMetadataTaskAttributes) GetFirstEventId() (o int64) {
-	if v != nil && v.FirstEventId != nil {
-		return *v.FirstEventId
+	if v.ClusterName != nil {
+		w, err = wire.NewValueString(*(v.ClusterName)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
 	}
 
-	return
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// IsSetFirstEventId returns true if FirstEventId is not nil.
-func (v *HistoryMetadataTaskAttributes) IsSetFirstEventId() bool {
-	return v != nil && v.FirstEventId != nil
-}
+// FromWire deserializes a GetDomainReplicationMessagesRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a GetDomainReplicationMessagesRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v GetDomainReplicationMessagesRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *GetDomainReplicationMessagesRequest) FromWire(w wire.Value) error {
+	var err error
 
-// GetNextEventId returns the value of NextEventId if it is set or its
-// zero value if it is unset.
-func (v *HistoryMetadataTaskAttributes) GetNextEventId() (o int64) {
-	if v != nil && v.NextEventId != nil {
-		return *v.NextEventId
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.LastRetrivedMessageId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.LastProcessedMessageId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ClusterName = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
 	}
 
-	return
+	return nil
 }
 
-// IsSetNextEventId returns true if NextEventId is not nil.
-func (v *HistoryMetadataTaskAttributes) IsSetNextEventId() bool {
-	return v != nil && v.NextEventId != nil
+// String returns a readable string representation of a GetDomainReplicationMessagesRequest
+// struct.
+func (v *GetDomainReplicationMessagesRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	if v.LastRetrivedMessageId != nil {
+		fields[i] = fmt.Sprintf("LastRetrivedMessageId: %v", *(v.LastRetrivedMessageId))
+		i++
+	}
+	if v.LastProcessedMessageId != nil {
+		fields[i] = fmt.Sprintf("LastProcessedMessageId: %v", *(v.LastProcessedMessageId))
+		i++
+	}
+	if v.ClusterName != nil {
+		fields[i] = fmt.Sprintf("ClusterName: %v", *(v.ClusterName))
+		i++
+	}
+
+	return fmt.Sprintf("GetDomainReplicationMessagesRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-type HistoryTaskAttributes struct {
-	TargetClusters          []string                           `json:"targetClusters,omitempty"`
-	DomainId                *string                            `json:"domainId,omitempty"`
-	WorkflowId              *string                            `json:"workflowId,omitempty"`
-	RunId                   *string                            `json:"runId,omitempty"`
-	FirstEventId            *int64                             `json:"firstEventId,omitempty"`
-	NextEventId             *int64                             `json:"nextEventId,omitempty"`
-	Version                 *int64                             `json:"version,omitempty"`
-	ReplicationInfo         map[string]*shared.ReplicationInfo `json:"replicationInfo,omitempty"`
-	History                 *shared.History                    `json:"history,omitempty"`
-	NewRunHistory           *shared.History                    `json:"newRunHistory,omitempty"`
-	EventStoreVersion       *int32                             `json:"eventStoreVersion,omitempty"`
-	NewRunEventStoreVersion *int32                             `json:"newRunEventStoreVersion,omitempty"`
-	ResetWorkflow           *bool                              `json:"resetWorkflow,omitempty"`
-	NewRunNDC               *bool                              `json:"newRunNDC,omitempty"`
+// Equals returns true if all the fields of this GetDomainReplicationMessagesRequest match the
+// provided GetDomainReplicationMessagesRequest.
+//
+// This function performs a deep comparison.
+func (v *GetDomainReplicationMessagesRequest) Equals(rhs *GetDomainReplicationMessagesRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_I64_EqualsPtr(v.LastRetrivedMessageId, rhs.LastRetrivedMessageId) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.LastProcessedMessageId, rhs.LastProcessedMessageId) {
+		return false
+	}
+	if !_String_EqualsPtr(v.ClusterName, rhs.ClusterName) {
+		return false
+	}
+
+	return true
 }
 
-type _Map_String_ReplicationInfo_MapItemList map[string]*shared.ReplicationInfo
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of GetDomainReplicationMessagesRequest.
+func (v *GetDomainReplicationMessagesRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.LastRetrivedMessageId != nil {
+		enc.AddInt64("lastRetrivedMessageId", *v.LastRetrivedMessageId)
+	}
+	if v.LastProcessedMessageId != nil {
+		enc.AddInt64("lastProcessedMessageId", *v.LastProcessedMessageId)
+	}
+	if v.ClusterName != nil {
+		enc.AddString("clusterName", *v.ClusterName)
+	}
+	return err
+}
 
-func (m _Map_String_ReplicationInfo_MapItemList) ForEach(f func(wire.MapItem) error) error {
-	for k, v := range m {
-		if v == nil {
-			return fmt.Errorf("invalid [%v]: value is nil", k)
-		}
-		kw, err := wire.NewValueString(k), error(nil)
-		if err != nil {
-			return err
-		}
+// GetLastRetrivedMessageId returns the value of LastRetrivedMessageId if it is set or its
+// zero value if it is unset.
+func (v *GetDomainReplicationMessagesRequest) GetLastRetrivedMessageId() (o int64) {
+	if v != nil && v.LastRetrivedMessageId != nil {
+		return *v.LastRetrivedMessageId
+	}
 
-		vw, err := v.ToWire()
-		if err != nil {
-			return err
-		}
-		err = f(wire.MapItem{Key: kw, Value: vw})
-		if err != nil {
-			return err
-		}
+	return
+}
+
+// IsSetLastRetrivedMessageId returns true if LastRetrivedMessageId is not nil.
+func (v *GetDomainReplicationMessagesRequest) IsSetLastRetrivedMessageId() bool {
+	return v != nil && v.LastRetrivedMessageId != nil
+}
+
+// GetLastProcessedMessageId returns the value of LastProcessedMessageId if it is set or its
+// zero value if it is unset.
+func (v *GetDomainReplicationMessagesRequest) GetLastProcessedMessageId() (o int64) {
+	if v != nil && v.LastProcessedMessageId != nil {
+		return *v.LastProcessedMessageId
 	}
-	return nil
+
+	return
 }
 
-func (m _Map_String_ReplicationInfo_MapItemList) Size() int {
-	return len(m)
+// IsSetLastProcessedMessageId returns true if LastProcessedMessageId is not nil.
+func (v *GetDomainReplicationMessagesRequest) IsSetLastProcessedMessageId() bool {
+	return v != nil && v.LastProcessedMessageId != nil
 }
 
-func (_Map_String_ReplicationInfo_MapItemList) KeyType() wire.Type {
-	return wire.TBinary
+// GetClusterName returns the value of ClusterName if it is set or its
+// zero value if it is unset.
+func (v *GetDomainReplicationMessagesRequest) GetClusterName() (o string) {
+	if v != nil && v.ClusterName != nil {
+		return *v.ClusterName
+	}
+
+	return
 }
 
-func (_Map_String_ReplicationInfo_MapItemList) ValueType() wire.Type {
-	return wire.TStruct
+// IsSetClusterName returns true if ClusterName is not nil.
+func (v *GetDomainReplicationMessagesRequest) IsSetClusterName() bool {
+	return v != nil && v.ClusterName != nil
 }
 
-func (_Map_String_ReplicationInfo_MapItemList) Close() {}
+type GetDomainReplicationMessagesResponse struct {
+	Messages *ReplicationMessages `json:"messages,omitempty"`
+}
 
-// ToWire translates a HistoryTaskAttributes struct into a Thrift-level intermediate
+// ToWire translates a GetDomainReplicationMessagesResponse struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -1983,175 +2495,235 @@ func (_Map_String_ReplicationInfo_MapItemList) Close() {}
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *HistoryTaskAttributes) ToWire() (wire.Value, error) {
+func (v *GetDomainReplicationMessagesResponse) ToWire() (wire.Value, error) {
 	var (
-		fields [14]wire.Field
+		fields [1]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.TargetClusters != nil {
-		w, err = wire.NewValueList(_List_String_ValueList(v.TargetClusters)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 5, Value: w}
-		i++
-	}
-	if v.DomainId != nil {
-		w, err = wire.NewValueString(*(v.DomainId)), error(nil)
+	if v.Messages != nil {
+		w, err = v.Messages.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.WorkflowId != nil {
-		w, err = wire.NewValueString(*(v.WorkflowId)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 20, Value: w}
-		i++
-	}
-	if v.RunId != nil {
-		w, err = wire.NewValueString(*(v.RunId)), error(nil)
-		if err != nil {
-			return w, err
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _ReplicationMessages_Read(w wire.Value) (*ReplicationMessages, error) {
+	var v ReplicationMessages
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a GetDomainReplicationMessagesResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a GetDomainReplicationMessagesResponse struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v GetDomainReplicationMessagesResponse
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *GetDomainReplicationMessagesResponse) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TStruct {
+				v.Messages, err = _ReplicationMessages_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
 		}
-		fields[i] = wire.Field{ID: 30, Value: w}
-		i++
 	}
-	if v.FirstEventId != nil {
-		w, err = wire.NewValueI64(*(v.FirstEventId)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 40, Value: w}
-		i++
+
+	return nil
+}
+
+// String returns a readable string representation of a GetDomainReplicationMessagesResponse
+// struct.
+func (v *GetDomainReplicationMessagesResponse) String() string {
+	if v == nil {
+		return "<nil>"
 	}
-	if v.NextEventId != nil {
-		w, err = wire.NewValueI64(*(v.NextEventId)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 50, Value: w}
+
+	var fields [1]string
+	i := 0
+	if v.Messages != nil {
+		fields[i] = fmt.Sprintf("Messages: %v", v.Messages)
 		i++
 	}
-	if v.Version != nil {
-		w, err = wire.NewValueI64(*(v.Version)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 60, Value: w}
-		i++
+
+	return fmt.Sprintf("GetDomainReplicationMessagesResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this GetDomainReplicationMessagesResponse match the
+// provided GetDomainReplicationMessagesResponse.
+//
+// This function performs a deep comparison.
+func (v *GetDomainReplicationMessagesResponse) Equals(rhs *GetDomainReplicationMessagesResponse) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
 	}
-	if v.ReplicationInfo != nil {
-		w, err = wire.NewValueMap(_Map_String_ReplicationInfo_MapItemList(v.ReplicationInfo)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 70, Value: w}
-		i++
+	if !((v.Messages == nil && rhs.Messages == nil) || (v.Messages != nil && rhs.Messages != nil && v.Messages.Equals(rhs.Messages))) {
+		return false
 	}
-	if v.History != nil {
-		w, err = v.History.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 80, Value: w}
-		i++
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of GetDomainReplicationMessagesResponse.
+func (v *GetDomainReplicationMessagesResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
 	}
-	if v.NewRunHistory != nil {
-		w, err = v.NewRunHistory.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 90, Value: w}
-		i++
+	if v.Messages != nil {
+		err = multierr.Append(err, enc.AddObject("messages", v.Messages))
 	}
-	if v.EventStoreVersion != nil {
-		w, err = wire.NewValueI32(*(v.EventStoreVersion)), error(nil)
+	return err
+}
+
+// GetMessages returns the value of Messages if it is set or its
+// zero value if it is unset.
+func (v *GetDomainReplicationMessagesResponse) GetMessages() (o *ReplicationMessages) {
+	if v != nil && v.Messages != nil {
+		return v.Messages
+	}
+
+	return
+}
+
+// IsSetMessages returns true if Messages is not nil.
+func (v *GetDomainReplicationMessagesResponse) IsSetMessages() bool {
+	return v != nil && v.Messages != nil
+}
+
+type GetReplicationMessagesRequest struct {
+	Tokens      []*ReplicationToken `json:"tokens,omitempty"`
+	ClusterName *string             `json:"clusterName,omitempty"`
+}
+
+type _List_ReplicationToken_ValueList []*ReplicationToken
+
+func (v _List_ReplicationToken_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
 		if err != nil {
-			return w, err
+			return err
 		}
-		fields[i] = wire.Field{ID: 100, Value: w}
-		i++
-	}
-	if v.NewRunEventStoreVersion != nil {
-		w, err = wire.NewValueI32(*(v.NewRunEventStoreVersion)), error(nil)
+		err = f(w)
 		if err != nil {
-			return w, err
+			return err
 		}
-		fields[i] = wire.Field{ID: 110, Value: w}
-		i++
 	}
-	if v.ResetWorkflow != nil {
-		w, err = wire.NewValueBool(*(v.ResetWorkflow)), error(nil)
+	return nil
+}
+
+func (v _List_ReplicationToken_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_ReplicationToken_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_ReplicationToken_ValueList) Close() {}
+
+// ToWire translates a GetReplicationMessagesRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *GetReplicationMessagesRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Tokens != nil {
+		w, err = wire.NewValueList(_List_ReplicationToken_ValueList(v.Tokens)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 120, Value: w}
+		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.NewRunNDC != nil {
-		w, err = wire.NewValueBool(*(v.NewRunNDC)), error(nil)
+	if v.ClusterName != nil {
+		w, err = wire.NewValueString(*(v.ClusterName)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 130, Value: w}
+		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _ReplicationInfo_Read(w wire.Value) (*shared.ReplicationInfo, error) {
-	var v shared.ReplicationInfo
+func _ReplicationToken_Read(w wire.Value) (*ReplicationToken, error) {
+	var v ReplicationToken
 	err := v.FromWire(w)
 	return &v, err
 }
 
-func _Map_String_ReplicationInfo_Read(m wire.MapItemList) (map[string]*shared.ReplicationInfo, error) {
-	if m.KeyType() != wire.TBinary {
-		return nil, nil
-	}
-
-	if m.ValueType() != wire.TStruct {
+func _List_ReplicationToken_Read(l wire.ValueList) ([]*ReplicationToken, error) {
+	if l.ValueType() != wire.TStruct {
 		return nil, nil
 	}
 
-	o := make(map[string]*shared.ReplicationInfo, m.Size())
-	err := m.ForEach(func(x wire.MapItem) error {
-		k, err := x.Key.GetString(), error(nil)
-		if err != nil {
-			return err
-		}
-
-		v, err := _ReplicationInfo_Read(x.Value)
+	o := make([]*ReplicationToken, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _ReplicationToken_Read(x)
 		if err != nil {
 			return err
 		}
-
-		o[k] = v
+		o = append(o, i)
 		return nil
 	})
-	m.Close()
+	l.Close()
 	return o, err
 }
 
-func _History_Read(w wire.Value) (*shared.History, error) {
-	var v shared.History
-	err := v.FromWire(w)
-	return &v, err
-}
-
-// FromWire deserializes a HistoryTaskAttributes struct from its Thrift-level
+// FromWire deserializes a GetReplicationMessagesRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a HistoryTaskAttributes struct
+// An error is returned if we were unable to build a GetReplicationMessagesRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -2159,29 +2731,19 @@ func _History_Read(w wire.Value) (*shared.History, error) {
 //     return nil, err
 //   }
 //
-//   var v HistoryTaskAttributes
+//   var v GetReplicationMessagesRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *HistoryTaskAttributes) FromWire(w wire.Value) error {
+func (v *GetReplicationMessagesRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 5:
-			if field.Value.Type() == wire.TList {
-				v.TargetClusters, err = _List_String_Read(field.Value.GetList())
-				if err != nil {
-					return err
-				}
-
-			}
 		case 10:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.DomainId = &x
+			if field.Value.Type() == wire.TList {
+				v.Tokens, err = _List_ReplicationToken_Read(field.Value.GetList())
 				if err != nil {
 					return err
 				}
@@ -2191,574 +2753,387 @@ func (v *HistoryTaskAttributes) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.WorkflowId = &x
+				v.ClusterName = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		case 30:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.RunId = &x
-				if err != nil {
-					return err
-				}
+		}
+	}
 
-			}
-		case 40:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.FirstEventId = &x
-				if err != nil {
-					return err
-				}
+	return nil
+}
 
-			}
-		case 50:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.NextEventId = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 60:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.Version = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 70:
-			if field.Value.Type() == wire.TMap {
-				v.ReplicationInfo, err = _Map_String_ReplicationInfo_Read(field.Value.GetMap())
-				if err != nil {
-					return err
-				}
-
-			}
-		case 80:
-			if field.Value.Type() == wire.TStruct {
-				v.History, err = _History_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 90:
-			if field.Value.Type() == wire.TStruct {
-				v.NewRunHistory, err = _History_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 100:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.EventStoreVersion = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 110:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.NewRunEventStoreVersion = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 120:
-			if field.Value.Type() == wire.TBool {
-				var x bool
-				x, err = field.Value.GetBool(), error(nil)
-				v.ResetWorkflow = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 130:
-			if field.Value.Type() == wire.TBool {
-				var x bool
-				x, err = field.Value.GetBool(), error(nil)
-				v.NewRunNDC = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		}
-	}
-
-	return nil
-}
-
-// String returns a readable string representation of a HistoryTaskAttributes
+// String returns a readable string representation of a GetReplicationMessagesRequest
 // struct.
-func (v *HistoryTaskAttributes) String() string {
+func (v *GetReplicationMessagesRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [14]string
+	var fields [2]string
 	i := 0
-	if v.TargetClusters != nil {
-		fields[i] = fmt.Sprintf("TargetClusters: %v", v.TargetClusters)
-		i++
-	}
-	if v.DomainId != nil {
-		fields[i] = fmt.Sprintf("DomainId: %v", *(v.DomainId))
-		i++
-	}
-	if v.WorkflowId != nil {
-		fields[i] = fmt.Sprintf("WorkflowId: %v", *(v.WorkflowId))
-		i++
-	}
-	if v.RunId != nil {
-		fields[i] = fmt.Sprintf("RunId: %v", *(v.RunId))
-		i++
-	}
-	if v.FirstEventId != nil {
-		fields[i] = fmt.Sprintf("FirstEventId: %v", *(v.FirstEventId))
-		i++
-	}
-	if v.NextEventId != nil {
-		fields[i] = fmt.Sprintf("NextEventId: %v", *(v.NextEventId))
-		i++
-	}
-	if v.Version != nil {
-		fields[i] = fmt.Sprintf("Version: %v", *(v.Version))
-		i++
-	}
-	if v.ReplicationInfo != nil {
-		fields[i] = fmt.Sprintf("ReplicationInfo: %v", v.ReplicationInfo)
-		i++
-	}
-	if v.History != nil {
-		fields[i] = fmt.Sprintf("History: %v", v.History)
-		i++
-	}
-	if v.NewRunHistory != nil {
-		fields[i] = fmt.Sprintf("NewRunHistory: %v", v.NewRunHistory)
-		i++
-	}
-	if v.EventStoreVersion != nil {
-		fields[i] = fmt.Sprintf("EventStoreVersion: %v", *(v.EventStoreVersion))
-		i++
-	}
-	if v.NewRunEventStoreVersion != nil {
-		fields[i] = fmt.Sprintf("NewRunEventStoreVersion: %v", *(v.NewRunEventStoreVersion))
-		i++
-	}
-	if v.ResetWorkflow != nil {
-		fields[i] = fmt.Sprintf("ResetWorkflow: %v", *(v.ResetWorkflow))
+	if v.Tokens != nil {
+		fields[i] = fmt.Sprintf("Tokens: %v", v.Tokens)
 		i++
 	}
-	if v.NewRunNDC != nil {
-		fields[i] = fmt.Sprintf("NewRunNDC: %v", *(v.NewRunNDC))
+	if v.ClusterName != nil {
+		fields[i] = fmt.Sprintf("ClusterName: %v", *(v.ClusterName))
 		i++
 	}
 
-	return fmt.Sprintf("HistoryTaskAttributes{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("GetReplicationMessagesRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-func _Map_String_ReplicationInfo_Equals(lhs, rhs map[string]*shared.ReplicationInfo) bool {
+func _List_ReplicationToken_Equals(lhs, rhs []*ReplicationToken) bool {
 	if len(lhs) != len(rhs) {
 		return false
 	}
 
-	for lk, lv := range lhs {
-		rv, ok := rhs[lk]
-		if !ok {
-			return false
-		}
+	for i, lv := range lhs {
+		rv := rhs[i]
 		if !lv.Equals(rv) {
 			return false
 		}
 	}
-	return true
-}
-
-func _I32_EqualsPtr(lhs, rhs *int32) bool {
-	if lhs != nil && rhs != nil {
-
-		x := *lhs
-		y := *rhs
-		return (x == y)
-	}
-	return lhs == nil && rhs == nil
-}
 
-func _Bool_EqualsPtr(lhs, rhs *bool) bool {
-	if lhs != nil && rhs != nil {
-
-		x := *lhs
-		y := *rhs
-		return (x == y)
-	}
-	return lhs == nil && rhs == nil
+	return true
 }
 
-// Equals returns true if all the fields of this HistoryTaskAttributes match the
-// provided HistoryTaskAttributes.
+// Equals returns true if all the fields of this GetReplicationMessagesRequest match the
+// provided GetReplicationMessagesRequest.
 //
 // This function performs a deep comparison.
-func (v *HistoryTaskAttributes) Equals(rhs *HistoryTaskAttributes) bool {
+func (v *GetReplicationMessagesRequest) Equals(rhs *GetReplicationMessagesRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.TargetClusters == nil && rhs.TargetClusters == nil) || (v.TargetClusters != nil && rhs.TargetClusters != nil && _List_String_Equals(v.TargetClusters, rhs.TargetClusters))) {
-		return false
-	}
-	if !_String_EqualsPtr(v.DomainId, rhs.DomainId) {
-		return false
-	}
-	if !_String_EqualsPtr(v.WorkflowId, rhs.WorkflowId) {
-		return false
-	}
-	if !_String_EqualsPtr(v.RunId, rhs.RunId) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.FirstEventId, rhs.FirstEventId) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.NextEventId, rhs.NextEventId) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.Version, rhs.Version) {
-		return false
-	}
-	if !((v.ReplicationInfo == nil && rhs.ReplicationInfo == nil) || (v.ReplicationInfo != nil && rhs.ReplicationInfo != nil && _Map_String_ReplicationInfo_Equals(v.ReplicationInfo, rhs.ReplicationInfo))) {
-		return false
-	}
-	if !((v.History == nil && rhs.History == nil) || (v.History != nil && rhs.History != nil && v.History.Equals(rhs.History))) {
-		return false
-	}
-	if !((v.NewRunHistory == nil && rhs.NewRunHistory == nil) || (v.NewRunHistory != nil && rhs.NewRunHistory != nil && v.NewRunHistory.Equals(rhs.NewRunHistory))) {
-		return false
-	}
-	if !_I32_EqualsPtr(v.EventStoreVersion, rhs.EventStoreVersion) {
-		return false
-	}
-	if !_I32_EqualsPtr(v.NewRunEventStoreVersion, rhs.NewRunEventStoreVersion) {
-		return false
-	}
-	if !_Bool_EqualsPtr(v.ResetWorkflow, rhs.ResetWorkflow) {
+	if !((v.Tokens == nil && rhs.Tokens == nil) || (v.Tokens != nil && rhs.Tokens != nil && _List_ReplicationToken_Equals(v.Tokens, rhs.Tokens))) {
 		return false
 	}
-	if !_Bool_EqualsPtr(v.NewRunNDC, rhs.NewRunNDC) {
+	if !_String_EqualsPtr(v.ClusterName, rhs.ClusterName) {
 		return false
 	}
 
 	return true
 }
 
-type _Map_String_ReplicationInfo_Zapper map[string]*shared.ReplicationInfo
+type _List_ReplicationToken_Zapper []*ReplicationToken
 
-// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of _Map_String_ReplicationInfo_Zapper.
-func (m _Map_String_ReplicationInfo_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
-	for k, v := range m {
-		err = multierr.Append(err, enc.AddObject((string)(k), v))
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_ReplicationToken_Zapper.
+func (l _List_ReplicationToken_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
 	}
 	return err
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of HistoryTaskAttributes.
-func (v *HistoryTaskAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of GetReplicationMessagesRequest.
+func (v *GetReplicationMessagesRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.TargetClusters != nil {
-		err = multierr.Append(err, enc.AddArray("targetClusters", (_List_String_Zapper)(v.TargetClusters)))
-	}
-	if v.DomainId != nil {
-		enc.AddString("domainId", *v.DomainId)
-	}
-	if v.WorkflowId != nil {
-		enc.AddString("workflowId", *v.WorkflowId)
-	}
-	if v.RunId != nil {
-		enc.AddString("runId", *v.RunId)
+	if v.Tokens != nil {
+		err = multierr.Append(err, enc.AddArray("tokens", (_List_ReplicationToken_Zapper)(v.Tokens)))
 	}
-	if v.FirstEventId != nil {
-		enc.AddInt64("firstEventId", *v.FirstEventId)
-	}
-	if v.NextEventId != nil {
-		enc.AddInt64("nextEventId", *v.NextEventId)
-	}
-	if v.Version != nil {
-		enc.AddInt64("version", *v.Version)
-	}
-	if v.ReplicationInfo != nil {
-		err = multierr.Append(err, enc.AddObject("replicationInfo", (_Map_String_ReplicationInfo_Zapper)(v.ReplicationInfo)))
-	}
-	if v.History != nil {
-		err = multierr.Append(err, enc.AddObject("history", v.History))
-	}
-	if v.NewRunHistory != nil {
-		err = multierr.Append(err, enc.AddObject("newRunHistory", v.NewRunHistory))
-	}
-	if v.EventStoreVersion != nil {
-		enc.AddInt32("eventStoreVersion", *v.EventStoreVersion)
-	}
-	if v.NewRunEventStoreVersion != nil {
-		enc.AddInt32("newRunEventStoreVersion", *v.NewRunEventStoreVersion)
-	}
-	if v.ResetWorkflow != nil {
-		enc.AddBool("resetWorkflow", *v.ResetWorkflow)
-	}
-	if v.NewRunNDC != nil {
-		enc.AddBool("newRunNDC", *v.NewRunNDC)
+	if v.ClusterName != nil {
+		enc.AddString("clusterName", *v.ClusterName)
 	}
 	return err
 }
 
-// GetTargetClusters returns the value of TargetClusters if it is set or its
+// GetTokens returns the value of Tokens if it is set or its
 // zero value if it is unset.
-func (v *HistoryTaskAttributes) GetTargetClusters() (o []string) {
-	if v != nil && v.TargetClusters != nil {
-		return v.TargetClusters
+func (v *GetReplicationMessagesRequest) GetTokens() (o []*ReplicationToken) {
+	if v != nil && v.Tokens != nil {
+		return v.Tokens
 	}
 
 	return
 }
 
-// IsSetTargetClusters returns true if TargetClusters is not nil.
-func (v *HistoryTaskAttributes) IsSetTargetClusters() bool {
-	return v != nil && v.TargetClusters != nil
+// IsSetTokens returns true if Tokens is not nil.
+func (v *GetReplicationMessagesRequest) IsSetTokens() bool {
+	return v != nil && v.Tokens != nil
 }
 
-// GetDomainId returns the value of DomainId if it is set or its
+// GetClusterName returns the value of ClusterName if it is set or its
 // zero value if it is unset.
-func (v *HistoryTaskAttributes) GetDomainId() (o string) {
-	if v != nil && v.DomainId != nil {
-		return *v.DomainId
+func (v *GetReplicationMessagesRequest) GetClusterName() (o string) {
+	if v != nil && v.ClusterName != nil {
+		return *v.ClusterName
 	}
 
 	return
 }
 
-// IsSetDomainId returns true if DomainId is not nil.
-func (v *HistoryTaskAttributes) IsSetDomainId() bool {
-	return v != nil && v.DomainId != nil
+// IsSetClusterName returns true if ClusterName is not nil.
+func (v *GetReplicationMessagesRequest) IsSetClusterName() bool {
+	return v != nil && v.ClusterName != nil
 }
 
-// GetWorkflowId returns the value of WorkflowId if it is set or its
-// zero value if it is unset.
-func (v *HistoryTaskAttributes) GetWorkflowId() (o string) {
-	if v != nil && v.WorkflowId != nil {
-		return *v.WorkflowId
-	}
-
-	return
+type GetReplicationMessagesResponse struct {
+	MessagesByShard map[int32]*ReplicationMessages `json:"messagesByShard,omitempty"`
 }
 
-// IsSetWorkflowId returns true if WorkflowId is not nil.
-func (v *HistoryTaskAttributes) IsSetWorkflowId() bool {
-	return v != nil && v.WorkflowId != nil
-}
+type _Map_I32_ReplicationMessages_MapItemList map[int32]*ReplicationMessages
 
-// GetRunId returns the value of RunId if it is set or its
-// zero value if it is unset.
-func (v *HistoryTaskAttributes) GetRunId() (o string) {
-	if v != nil && v.RunId != nil {
-		return *v.RunId
-	}
+func (m _Map_I32_ReplicationMessages_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		if v == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", k)
+		}
+		kw, err := wire.NewValueI32(k), error(nil)
+		if err != nil {
+			return err
+		}
 
-	return
+		vw, err := v.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-// IsSetRunId returns true if RunId is not nil.
-func (v *HistoryTaskAttributes) IsSetRunId() bool {
-	return v != nil && v.RunId != nil
+func (m _Map_I32_ReplicationMessages_MapItemList) Size() int {
+	return len(m)
 }
 
-// GetFirstEventId returns the value of FirstEventId if it is set or its
-// zero value if it is unset.
-func (v *HistoryTaskAttributes) GetFirstEventId() (o int64) {
-	if v != nil && v.FirstEventId != nil {
-		return *v.FirstEventId
-	}
-
-	return
+func (_Map_I32_ReplicationMessages_MapItemList) KeyType() wire.Type {
+	return wire.TI32
 }
 
-// IsSetFirstEventId returns true if FirstEventId is not nil.
-func (v *HistoryTaskAttributes) IsSetFirstEventId() bool {
-	return v != nil && v.FirstEventId != nil
+func (_Map_I32_ReplicationMessages_MapItemList) ValueType() wire.Type {
+	return wire.TStruct
 }
 
-// GetNextEventId returns the value of NextEventId if it is set or its
-// zero value if it is unset.
-func (v *HistoryTaskAttributes) GetNextEventId() (o int64) {
-	if v != nil && v.NextEventId != nil {
-		return *v.NextEventId
+func (_Map_I32_ReplicationMessages_MapItemList) Close() {}
+
+// ToWire translates a GetReplicationMessagesResponse struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *GetReplicationMessagesResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.MessagesByShard != nil {
+		w, err = wire.NewValueMap(_Map_I32_ReplicationMessages_MapItemList(v.MessagesByShard)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
 	}
 
-	return
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// IsSetNextEventId returns true if NextEventId is not nil.
-func (v *HistoryTaskAttributes) IsSetNextEventId() bool {
-	return v != nil && v.NextEventId != nil
-}
+func _Map_I32_ReplicationMessages_Read(m wire.MapItemList) (map[int32]*ReplicationMessages, error) {
+	if m.KeyType() != wire.TI32 {
+		return nil, nil
+	}
 
-// GetVersion returns the value of Version if it is set or its
-// zero value if it is unset.
-func (v *HistoryTaskAttributes) GetVersion() (o int64) {
-	if v != nil && v.Version != nil {
-		return *v.Version
+	if m.ValueType() != wire.TStruct {
+		return nil, nil
 	}
 
-	return
-}
+	o := make(map[int32]*ReplicationMessages, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetI32(), error(nil)
+		if err != nil {
+			return err
+		}
 
-// IsSetVersion returns true if Version is not nil.
-func (v *HistoryTaskAttributes) IsSetVersion() bool {
-	return v != nil && v.Version != nil
+		v, err := _ReplicationMessages_Read(x.Value)
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
 }
 
-// GetReplicationInfo returns the value of ReplicationInfo if it is set or its
-// zero value if it is unset.
-func (v *HistoryTaskAttributes) GetReplicationInfo() (o map[string]*shared.ReplicationInfo) {
-	if v != nil && v.ReplicationInfo != nil {
-		return v.ReplicationInfo
-	}
+// FromWire deserializes a GetReplicationMessagesResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a GetReplicationMessagesResponse struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v GetReplicationMessagesResponse
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *GetReplicationMessagesResponse) FromWire(w wire.Value) error {
+	var err error
 
-	return
-}
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TMap {
+				v.MessagesByShard, err = _Map_I32_ReplicationMessages_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
 
-// IsSetReplicationInfo returns true if ReplicationInfo is not nil.
-func (v *HistoryTaskAttributes) IsSetReplicationInfo() bool {
-	return v != nil && v.ReplicationInfo != nil
+			}
+		}
+	}
+
+	return nil
 }
 
-// GetHistory returns the value of History if it is set or its
-// zero value if it is unset.
-func (v *HistoryTaskAttributes) GetHistory() (o *shared.History) {
-	if v != nil && v.History != nil {
-		return v.History
+// String returns a readable string representation of a GetReplicationMessagesResponse
+// struct.
+func (v *GetReplicationMessagesResponse) String() string {
+	if v == nil {
+		return "<nil>"
 	}
 
-	return
-}
+	var fields [1]string
+	i := 0
+	if v.MessagesByShard != nil {
+		fields[i] = fmt.Sprintf("MessagesByShard: %v", v.MessagesByShard)
+		i++
+	}
 
-// IsSetHistory returns true if History is not nil.
-func (v *HistoryTaskAttributes) IsSetHistory() bool {
-	return v != nil && v.History != nil
+	return fmt.Sprintf("GetReplicationMessagesResponse{%v}", strings.Join(fields[:i], ", "))
 }
 
-// GetNewRunHistory returns the value of NewRunHistory if it is set or its
-// zero value if it is unset.
-func (v *HistoryTaskAttributes) GetNewRunHistory() (o *shared.History) {
-	if v != nil && v.NewRunHistory != nil {
-		return v.NewRunHistory
+func _Map_I32_ReplicationMessages_Equals(lhs, rhs map[int32]*ReplicationMessages) bool {
+	if len(lhs) != len(rhs) {
+		return false
 	}
 
-	return
-}
-
-// IsSetNewRunHistory returns true if NewRunHistory is not nil.
-func (v *HistoryTaskAttributes) IsSetNewRunHistory() bool {
-	return v != nil && v.NewRunHistory != nil
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+	return true
 }
 
-// GetEventStoreVersion returns the value of EventStoreVersion if it is set or its
-// zero value if it is unset.
-func (v *HistoryTaskAttributes) GetEventStoreVersion() (o int32) {
-	if v != nil && v.EventStoreVersion != nil {
-		return *v.EventStoreVersion
+// Equals returns true if all the fields of this GetReplicationMessagesResponse match the
+// provided GetReplicationMessagesResponse.
+//
+// This function performs a deep comparison.
+func (v *GetReplicationMessagesResponse) Equals(rhs *GetReplicationMessagesResponse) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.MessagesByShard == nil && rhs.MessagesByShard == nil) || (v.MessagesByShard != nil && rhs.MessagesByShard != nil && _Map_I32_ReplicationMessages_Equals(v.MessagesByShard, rhs.MessagesByShard))) {
+		return false
 	}
 
-	return
+	return true
 }
 
-// IsSetEventStoreVersion returns true if EventStoreVersion is not nil.
-func (v *HistoryTaskAttributes) IsSetEventStoreVersion() bool {
-	return v != nil && v.EventStoreVersion != nil
+type _Map_I32_ReplicationMessages_Item_Zapper struct {
+	Key   int32
+	Value *ReplicationMessages
 }
 
-// GetNewRunEventStoreVersion returns the value of NewRunEventStoreVersion if it is set or its
-// zero value if it is unset.
-func (v *HistoryTaskAttributes) GetNewRunEventStoreVersion() (o int32) {
-	if v != nil && v.NewRunEventStoreVersion != nil {
-		return *v.NewRunEventStoreVersion
-	}
-
-	return
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_I32_ReplicationMessages_Item_Zapper.
+func (v _Map_I32_ReplicationMessages_Item_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	enc.AddInt32("key", v.Key)
+	err = multierr.Append(err, enc.AddObject("value", v.Value))
+	return err
 }
 
-// IsSetNewRunEventStoreVersion returns true if NewRunEventStoreVersion is not nil.
-func (v *HistoryTaskAttributes) IsSetNewRunEventStoreVersion() bool {
-	return v != nil && v.NewRunEventStoreVersion != nil
-}
+type _Map_I32_ReplicationMessages_Zapper map[int32]*ReplicationMessages
 
-// GetResetWorkflow returns the value of ResetWorkflow if it is set or its
-// zero value if it is unset.
-func (v *HistoryTaskAttributes) GetResetWorkflow() (o bool) {
-	if v != nil && v.ResetWorkflow != nil {
-		return *v.ResetWorkflow
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_I32_ReplicationMessages_Zapper.
+func (m _Map_I32_ReplicationMessages_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for k, v := range m {
+		err = multierr.Append(err, enc.AppendObject(_Map_I32_ReplicationMessages_Item_Zapper{Key: k, Value: v}))
 	}
-
-	return
+	return err
 }
 
-// IsSetResetWorkflow returns true if ResetWorkflow is not nil.
-func (v *HistoryTaskAttributes) IsSetResetWorkflow() bool {
-	return v != nil && v.ResetWorkflow != nil
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of GetReplicationMessagesResponse.
+func (v *GetReplicationMessagesResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.MessagesByShard != nil {
+		err = multierr.Append(err, enc.AddArray("messagesByShard", (_Map_I32_ReplicationMessages_Zapper)(v.MessagesByShard)))
+	}
+	return err
 }
 
-// GetNewRunNDC returns the value of NewRunNDC if it is set or its
+// GetMessagesByShard returns the value of MessagesByShard if it is set or its
 // zero value if it is unset.
-func (v *HistoryTaskAttributes) GetNewRunNDC() (o bool) {
-	if v != nil && v.NewRunNDC != nil {
-		return *v.NewRunNDC
+func (v *GetReplicationMessagesResponse) GetMessagesByShard() (o map[int32]*ReplicationMessages) {
+	if v != nil && v.MessagesByShard != nil {
+		return v.MessagesByShard
 	}
 
 	return
 }
 
-// IsSetNewRunNDC returns true if NewRunNDC is not nil.
-func (v *HistoryTaskAttributes) IsSetNewRunNDC() bool {
-	return v != nil && v.NewRunNDC != nil
+// IsSetMessagesByShard returns true if MessagesByShard is not nil.
+func (v *GetReplicationMessagesResponse) IsSetMessagesByShard() bool {
+	return v != nil && v.MessagesByShard != nil
 }
 
-type HistoryTaskV2Attributes struct {
-	TaskId              *int64                       `json:"taskId,omitempty"`
-	DomainId            *string                      `json:"domainId,omitempty"`
-	WorkflowId          *string                      `json:"workflowId,omitempty"`
-	RunId               *string                      `json:"runId,omitempty"`
-	VersionHistoryItems []*shared.VersionHistoryItem `json:"versionHistoryItems,omitempty"`
-	Events              *shared.DataBlob             `json:"events,omitempty"`
-	NewRunEvents        *shared.DataBlob             `json:"newRunEvents,omitempty"`
+type HistoryMetadataTaskAttributes struct {
+	TargetClusters []string `json:"targetClusters,omitempty"`
+	DomainId       *string  `json:"domainId,omitempty"`
+	WorkflowId     *string  `json:"workflowId,omitempty"`
+	RunId          *string  `json:"runId,omitempty"`
+	FirstEventId   *int64   `json:"firstEventId,omitempty"`
+	NextEventId    *int64   `json:"nextEventId,omitempty"`
 }
 
-type _List_VersionHistoryItem_ValueList []*shared.VersionHistoryItem
+type _List_String_ValueList []string
 
-func (v _List_VersionHistoryItem_ValueList) ForEach(f func(wire.Value) error) error {
-	for i, x := range v {
-		if x == nil {
-			return fmt.Errorf("invalid [%v]: value is nil", i)
-		}
-		w, err := x.ToWire()
+func (v _List_String_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		w, err := wire.NewValueString(x), error(nil)
 		if err != nil {
 			return err
 		}
@@ -2770,17 +3145,17 @@ func (v _List_VersionHistoryItem_ValueList) ForEach(f func(wire.Value) error) er
 	return nil
 }
 
-func (v _List_VersionHistoryItem_ValueList) Size() int {
+func (v _List_String_ValueList) Size() int {
 	return len(v)
 }
 
-func (_List_VersionHistoryItem_ValueList) ValueType() wire.Type {
-	return wire.TStruct
+func (_List_String_ValueList) ValueType() wire.Type {
+	return wire.TBinary
 }
 
-func (_List_VersionHistoryItem_ValueList) Close() {}
+func (_List_String_ValueList) Close() {}
 
-// ToWire translates a HistoryTaskV2Attributes struct into a Thrift-level intermediate
+// ToWire translates a HistoryMetadataTaskAttributes struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -2795,16 +3170,16 @@ func (_List_VersionHistoryItem_ValueList) Close() {}
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *HistoryTaskV2Attributes) ToWire() (wire.Value, error) {
+func (v *HistoryMetadataTaskAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [7]wire.Field
+		fields [6]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.TaskId != nil {
-		w, err = wire.NewValueI64(*(v.TaskId)), error(nil)
+	if v.TargetClusters != nil {
+		w, err = wire.NewValueList(_List_String_ValueList(v.TargetClusters)), error(nil)
 		if err != nil {
 			return w, err
 		}
@@ -2835,48 +3210,34 @@ func (v *HistoryTaskV2Attributes) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.VersionHistoryItems != nil {
-		w, err = wire.NewValueList(_List_VersionHistoryItem_ValueList(v.VersionHistoryItems)), error(nil)
+	if v.FirstEventId != nil {
+		w, err = wire.NewValueI64(*(v.FirstEventId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
-	if v.Events != nil {
-		w, err = v.Events.ToWire()
+	if v.NextEventId != nil {
+		w, err = wire.NewValueI64(*(v.NextEventId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 50, Value: w}
 		i++
 	}
-	if v.NewRunEvents != nil {
-		w, err = v.NewRunEvents.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 70, Value: w}
-		i++
-	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _VersionHistoryItem_Read(w wire.Value) (*shared.VersionHistoryItem, error) {
-	var v shared.VersionHistoryItem
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _List_VersionHistoryItem_Read(l wire.ValueList) ([]*shared.VersionHistoryItem, error) {
-	if l.ValueType() != wire.TStruct {
+func _List_String_Read(l wire.ValueList) ([]string, error) {
+	if l.ValueType() != wire.TBinary {
 		return nil, nil
 	}
 
-	o := make([]*shared.VersionHistoryItem, 0, l.Size())
+	o := make([]string, 0, l.Size())
 	err := l.ForEach(func(x wire.Value) error {
-		i, err := _VersionHistoryItem_Read(x)
+		i, err := x.GetString(), error(nil)
 		if err != nil {
 			return err
 		}
@@ -2887,17 +3248,11 @@ func _List_VersionHistoryItem_Read(l wire.ValueList) ([]*shared.VersionHistoryIt
 	return o, err
 }
 
-func _DataBlob_Read(w wire.Value) (*shared.DataBlob, error) {
-	var v shared.DataBlob
-	err := v.FromWire(w)
-	return &v, err
-}
-
-// FromWire deserializes a HistoryTaskV2Attributes struct from its Thrift-level
+// FromWire deserializes a HistoryMetadataTaskAttributes struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a HistoryTaskV2Attributes struct
+// An error is returned if we were unable to build a HistoryMetadataTaskAttributes struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -2905,21 +3260,19 @@ func _DataBlob_Read(w wire.Value) (*shared.DataBlob, error) {
 //     return nil, err
 //   }
 //
-//   var v HistoryTaskV2Attributes
+//   var v HistoryMetadataTaskAttributes
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *HistoryTaskV2Attributes) FromWire(w wire.Value) error {
+func (v *HistoryMetadataTaskAttributes) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 5:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.TaskId = &x
+			if field.Value.Type() == wire.TList {
+				v.TargetClusters, err = _List_String_Read(field.Value.GetList())
 				if err != nil {
 					return err
 				}
@@ -2956,24 +3309,20 @@ func (v *HistoryTaskV2Attributes) FromWire(w wire.Value) error {
 
 			}
 		case 40:
-			if field.Value.Type() == wire.TList {
-				v.VersionHistoryItems, err = _List_VersionHistoryItem_Read(field.Value.GetList())
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.FirstEventId = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 50:
-			if field.Value.Type() == wire.TStruct {
-				v.Events, err = _DataBlob_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 70:
-			if field.Value.Type() == wire.TStruct {
-				v.NewRunEvents, err = _DataBlob_Read(field.Value)
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.NextEventId = &x
 				if err != nil {
 					return err
 				}
@@ -2985,17 +3334,17 @@ func (v *HistoryTaskV2Attributes) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a HistoryTaskV2Attributes
+// String returns a readable string representation of a HistoryMetadataTaskAttributes
 // struct.
-func (v *HistoryTaskV2Attributes) String() string {
+func (v *HistoryMetadataTaskAttributes) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [7]string
+	var fields [6]string
 	i := 0
-	if v.TaskId != nil {
-		fields[i] = fmt.Sprintf("TaskId: %v", *(v.TaskId))
+	if v.TargetClusters != nil {
+		fields[i] = fmt.Sprintf("TargetClusters: %v", v.TargetClusters)
 		i++
 	}
 	if v.DomainId != nil {
@@ -3010,30 +3359,26 @@ func (v *HistoryTaskV2Attributes) String() string {
 		fields[i] = fmt.Sprintf("RunId: %v", *(v.RunId))
 		i++
 	}
-	if v.VersionHistoryItems != nil {
-		fields[i] = fmt.Sprintf("VersionHistoryItems: %v", v.VersionHistoryItems)
-		i++
-	}
-	if v.Events != nil {
-		fields[i] = fmt.Sprintf("Events: %v", v.Events)
+	if v.FirstEventId != nil {
+		fields[i] = fmt.Sprintf("FirstEventId: %v", *(v.FirstEventId))
 		i++
 	}
-	if v.NewRunEvents != nil {
-		fields[i] = fmt.Sprintf("NewRunEvents: %v", v.NewRunEvents)
+	if v.NextEventId != nil {
+		fields[i] = fmt.Sprintf("NextEventId: %v", *(v.NextEventId))
 		i++
 	}
 
-	return fmt.Sprintf("HistoryTaskV2Attributes{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("HistoryMetadataTaskAttributes{%v}", strings.Join(fields[:i], ", "))
 }
 
-func _List_VersionHistoryItem_Equals(lhs, rhs []*shared.VersionHistoryItem) bool {
+func _List_String_Equals(lhs, rhs []string) bool {
 	if len(lhs) != len(rhs) {
 		return false
 	}
 
 	for i, lv := range lhs {
 		rv := rhs[i]
-		if !lv.Equals(rv) {
+		if !(lv == rv) {
 			return false
 		}
 	}
@@ -3041,17 +3386,17 @@ func _List_VersionHistoryItem_Equals(lhs, rhs []*shared.VersionHistoryItem) bool
 	return true
 }
 
-// Equals returns true if all the fields of this HistoryTaskV2Attributes match the
-// provided HistoryTaskV2Attributes.
+// Equals returns true if all the fields of this HistoryMetadataTaskAttributes match the
+// provided HistoryMetadataTaskAttributes.
 //
 // This function performs a deep comparison.
-func (v *HistoryTaskV2Attributes) Equals(rhs *HistoryTaskV2Attributes) bool {
+func (v *HistoryMetadataTaskAttributes) Equals(rhs *HistoryMetadataTaskAttributes) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_I64_EqualsPtr(v.TaskId, rhs.TaskId) {
+	if !((v.TargetClusters == nil && rhs.TargetClusters == nil) || (v.TargetClusters != nil && rhs.TargetClusters != nil && _List_String_Equals(v.TargetClusters, rhs.TargetClusters))) {
 		return false
 	}
 	if !_String_EqualsPtr(v.DomainId, rhs.DomainId) {
@@ -3063,38 +3408,35 @@ func (v *HistoryTaskV2Attributes) Equals(rhs *HistoryTaskV2Attributes) bool {
 	if !_String_EqualsPtr(v.RunId, rhs.RunId) {
 		return false
 	}
-	if !((v.VersionHistoryItems == nil && rhs.VersionHistoryItems == nil) || (v.VersionHistoryItems != nil && rhs.VersionHistoryItems != nil && _List_VersionHistoryItem_Equals(v.VersionHistoryItems, rhs.VersionHistoryItems))) {
-		return false
-	}
-	if !((v.Events == nil && rhs.Events == nil) || (v.Events != nil && rhs.Events != nil && v.Events.Equals(rhs.Events))) {
+	if !_I64_EqualsPtr(v.FirstEventId, rhs.FirstEventId) {
 		return false
 	}
-	if !((v.NewRunEvents == nil && rhs.NewRunEvents == nil) || (v.NewRunEvents != nil && rhs.NewRunEvents != nil && v.NewRunEvents.Equals(rhs.NewRunEvents))) {
+	if !_I64_EqualsPtr(v.NextEventId, rhs.NextEventId) {
 		return false
 	}
 
 	return true
 }
 
-type _List_VersionHistoryItem_Zapper []*shared.VersionHistoryItem
+type _List_String_Zapper []string
 
 // MarshalLogArray implements zapcore.ArrayMarshaler, enabling
-// fast logging of _List_VersionHistoryItem_Zapper.
-func (l _List_VersionHistoryItem_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+// fast logging of _List_String_Zapper.
+func (l _List_String_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
 	for _, v := range l {
-		err = multierr.Append(err, enc.AppendObject(v))
+		enc.AppendString(v)
 	}
 	return err
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of HistoryTaskV2Attributes.
-func (v *HistoryTaskV2Attributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of HistoryMetadataTaskAttributes.
+func (v *HistoryMetadataTaskAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.TaskId != nil {
-		enc.AddInt64("taskId", *v.TaskId)
+	if v.TargetClusters != nil {
+		err = multierr.Append(err, enc.AddArray("targetClusters", (_List_String_Zapper)(v.TargetClusters)))
 	}
 	if v.DomainId != nil {
 		enc.AddString("domainId", *v.DomainId)
@@ -3105,36 +3447,33 @@ func (v *HistoryTaskV2Attributes) MarshalLogObject(enc zapcore.ObjectEncoder) (e
 	if v.RunId != nil {
 		enc.AddString("runId", *v.RunId)
 	}
-	if v.VersionHistoryItems != nil {
-		err = multierr.Append(err, enc.AddArray("versionHistoryItems", (_List_VersionHistoryItem_Zapper)(v.VersionHistoryItems)))
-	}
-	if v.Events != nil {
-		err = multierr.Append(err, enc.AddObject("events", v.Events))
+	if v.FirstEventId != nil {
+		enc.AddInt64("firstEventId", *v.FirstEventId)
 	}
-	if v.NewRunEvents != nil {
-		err = multierr.Append(err, enc.AddObject("newRunEvents", v.NewRunEvents))
+	if v.NextEventId != nil {
+		enc.AddInt64("nextEventId", *v.NextEventId)
 	}
 	return err
 }
 
-// GetTaskId returns the value of TaskId if it is set or its
+// GetTargetClusters returns the value of TargetClusters if it is set or its
 // zero value if it is unset.
-func (v *HistoryTaskV2Attributes) GetTaskId() (o int64) {
-	if v != nil && v.TaskId != nil {
-		return *v.TaskId
+func (v *HistoryMetadataTaskAttributes) GetTargetClusters() (o []string) {
+	if v != nil && v.TargetClusters != nil {
+		return v.TargetClusters
 	}
 
 	return
 }
 
-// IsSetTaskId returns true if TaskId is not nil.
-func (v *HistoryTaskV2Attributes) IsSetTaskId() bool {
-	return v != nil && v.TaskId != nil
+// IsSetTargetClusters returns true if TargetClusters is not nil.
+func (v *HistoryMetadataTaskAttributes) IsSetTargetClusters() bool {
+	return v != nil && v.TargetClusters != nil
 }
 
 // GetDomainId returns the value of DomainId if it is set or its
 // zero value if it is unset.
-func (v *HistoryTaskV2Attributes) GetDomainId() (o string) {
+func (v *HistoryMetadataTaskAttributes) GetDomainId() (o string) {
 	if v != nil && v.DomainId != nil {
 		return *v.DomainId
 	}
@@ -3143,13 +3482,13 @@ func (v *HistoryTaskV2Attributes) GetDomainId() (o string) {
 }
 
 // IsSetDomainId returns true if DomainId is not nil.
-func (v *HistoryTaskV2Attributes) IsSetDomainId() bool {
+func (v *HistoryMetadataTaskAttributes) IsSetDomainId() bool {
 	return v != nil && v.DomainId != nil
 }
 
 // GetWorkflowId returns the value of WorkflowId if it is set or its
 // zero value if it is unset.
-func (v *HistoryTaskV2Attributes) GetWorkflowId() (o string) {
+func (v *HistoryMetadataTaskAttributes) GetWorkflowId() (o string) {
 	if v != nil && v.WorkflowId != nil {
 		return *v.WorkflowId
 	}
@@ -3158,13 +3497,13 @@ func (v *HistoryTaskV2Attributes) GetWorkflowId() (o string) {
 }
 
 // IsSetWorkflowId returns true if WorkflowId is not nil.
-func (v *HistoryTaskV2Attributes) IsSetWorkflowId() bool {
+func (v *HistoryMetadataTaskAttributes) IsSetWorkflowId() bool {
 	return v != nil && v.WorkflowId != nil
 }
 
 // GetRunId returns the value of RunId if it is set or its
 // zero value if it is unset.
-func (v *HistoryTaskV2Attributes) GetRunId() (o string) {
+func (v *HistoryMetadataTaskAttributes) GetRunId() (o string) {
 	if v != nil && v.RunId != nil {
 		return *v.RunId
 	}
@@ -3173,73 +3512,74 @@ func (v *HistoryTaskV2Attributes) GetRunId() (o string) {
 }
 
 // IsSetRunId returns true if RunId is not nil.
-func (v *HistoryTaskV2Attributes) IsSetRunId() bool {
+func (v *HistoryMetadataTaskAttributes) IsSetRunId() bool {
 	return v != nil && v.RunId != nil
 }
 
-// GetVersionHistoryItems returns the value of VersionHistoryItems if it is set or its
-// zero value if it is unset.
-func (v *HistoryTaskV2Attributes) GetVersionHistoryItems() (o []*shared.VersionHistoryItem) {
-	if v != nil && v.VersionHistoryItems != nil {
-		return v.VersionHistoryItems
-	}
-
-	return
-}
-
-// IsSetVersionHistoryItems returns true if VersionHistoryItems is not nil.
-func (v *HistoryTaskV2Attributes) IsSetVersionHistoryItems() bool {
-	return v != nil && v.VersionHistoryItems != nil
-}
-
-// GetEvents returns the value of Events if it is set or its
+// GetFirstEventId returns the value of FirstEventId if it is set or its
 // zero value if it is unset.
-func (v *HistoryTaskV2Attributes) GetEvents() (o *shared.DataBlob) {
-	if v != nil && v.Events != nil {
-		return v.Events
+func (v *HistoryMetadataTaskAttributes) GetFirstEventId() (o int64) {
+	if v != nil && v.FirstEventId != nil {
+		return *v.FirstEventId
 	}
 
 	return
 }
 
-// IsSetEvents returns true if Events is not nil.
-func (v *HistoryTaskV2Attributes) IsSetEvents() bool {
-	return v != nil && v.Events != nil
+// IsSetFirstEventId returns true if FirstEventId is not nil.
+func (v *HistoryMetadataTaskAttributes) IsSetFirstEventId() bool {
+	return v != nil && v.FirstEventId != nil
 }
 
-// GetNewRunEvents returns the value of NewRunEvents if it is set or its
+// GetNextEventId returns the value of NextEventId if it is set or its
 // zero value if it is unset.
-func (v *HistoryTaskV2Attributes) GetNewRunEvents() (o *shared.DataBlob) {
-	if v != nil && v.NewRunEvents != nil {
-		return v.NewRunEvents
+func (v *HistoryMetadataTaskAttributes) GetNextEventId() (o int64) {
+	if v != nil && v.NextEventId != nil {
+		return *v.NextEventId
 	}
 
 	return
 }
 
-// IsSetNewRunEvents returns true if NewRunEvents is not nil.
-func (v *HistoryTaskV2Attributes) IsSetNewRunEvents() bool {
-	return v != nil && v.NewRunEvents != nil
+// IsSetNextEventId returns true if NextEventId is not nil.
+func (v *HistoryMetadataTaskAttributes) IsSetNextEventId() bool {
+	return v != nil && v.NextEventId != nil
 }
 
-type ReplicationMessages struct {
-	ReplicationTasks      []*ReplicationTask `json:"replicationTasks,omitempty"`
-	LastRetrivedMessageId *int64             `json:"lastRetrivedMessageId,omitempty"`
-	HasMore               *bool              `json:"hasMore,omitempty"`
+type HistoryTaskAttributes struct {
+	TargetClusters          []string                           `json:"targetClusters,omitempty"`
+	DomainId                *string                            `json:"domainId,omitempty"`
+	WorkflowId              *string                            `json:"workflowId,omitempty"`
+	RunId                   *string                            `json:"runId,omitempty"`
+	FirstEventId            *int64                             `json:"firstEventId,omitempty"`
+	NextEventId             *int64                             `json:"nextEventId,omitempty"`
+	Version                 *int64                             `json:"version,omitempty"`
+	ReplicationInfo         map[string]*shared.ReplicationInfo `json:"replicationInfo,omitempty"`
+	History                 *shared.History                    `json:"history,omitempty"`
+	NewRunHistory           *shared.History                    `json:"newRunHistory,omitempty"`
+	EventStoreVersion       *int32                             `json:"eventStoreVersion,omitempty"`
+	NewRunEventStoreVersion *int32                             `json:"newRunEventStoreVersion,omitempty"`
+	ResetWorkflow           *bool                              `json:"resetWorkflow,omitempty"`
+	NewRunNDC               *bool                              `json:"newRunNDC,omitempty"`
 }
 
-type _List_ReplicationTask_ValueList []*ReplicationTask
+type _Map_String_ReplicationInfo_MapItemList map[string]*shared.ReplicationInfo
 
-func (v _List_ReplicationTask_ValueList) ForEach(f func(wire.Value) error) error {
-	for i, x := range v {
-		if x == nil {
-			return fmt.Errorf("invalid [%v]: value is nil", i)
+func (m _Map_String_ReplicationInfo_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		if v == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", k)
 		}
-		w, err := x.ToWire()
+		kw, err := wire.NewValueString(k), error(nil)
 		if err != nil {
 			return err
 		}
-		err = f(w)
+
+		vw, err := v.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
 		if err != nil {
 			return err
 		}
@@ -3247,17 +3587,21 @@ func (v _List_ReplicationTask_ValueList) ForEach(f func(wire.Value) error) error
 	return nil
 }
 
-func (v _List_ReplicationTask_ValueList) Size() int {
-	return len(v)
+func (m _Map_String_ReplicationInfo_MapItemList) Size() int {
+	return len(m)
 }
 
-func (_List_ReplicationTask_ValueList) ValueType() wire.Type {
+func (_Map_String_ReplicationInfo_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_ReplicationInfo_MapItemList) ValueType() wire.Type {
 	return wire.TStruct
 }
 
-func (_List_ReplicationTask_ValueList) Close() {}
+func (_Map_String_ReplicationInfo_MapItemList) Close() {}
 
-// ToWire translates a ReplicationMessages struct into a Thrift-level intermediate
+// ToWire translates a HistoryTaskAttributes struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -3272,408 +3616,175 @@ func (_List_ReplicationTask_ValueList) Close() {}
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *ReplicationMessages) ToWire() (wire.Value, error) {
+func (v *HistoryTaskAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [14]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.ReplicationTasks != nil {
-		w, err = wire.NewValueList(_List_ReplicationTask_ValueList(v.ReplicationTasks)), error(nil)
+	if v.TargetClusters != nil {
+		w, err = wire.NewValueList(_List_String_ValueList(v.TargetClusters)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
+	if v.DomainId != nil {
+		w, err = wire.NewValueString(*(v.DomainId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.LastRetrivedMessageId != nil {
-		w, err = wire.NewValueI64(*(v.LastRetrivedMessageId)), error(nil)
+	if v.WorkflowId != nil {
+		w, err = wire.NewValueString(*(v.WorkflowId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.HasMore != nil {
-		w, err = wire.NewValueBool(*(v.HasMore)), error(nil)
+	if v.RunId != nil {
+		w, err = wire.NewValueString(*(v.RunId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-
-	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
-}
-
-func _ReplicationTask_Read(w wire.Value) (*ReplicationTask, error) {
-	var v ReplicationTask
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _List_ReplicationTask_Read(l wire.ValueList) ([]*ReplicationTask, error) {
-	if l.ValueType() != wire.TStruct {
-		return nil, nil
+	if v.FirstEventId != nil {
+		w, err = wire.NewValueI64(*(v.FirstEventId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
 	}
-
-	o := make([]*ReplicationTask, 0, l.Size())
-	err := l.ForEach(func(x wire.Value) error {
-		i, err := _ReplicationTask_Read(x)
+	if v.NextEventId != nil {
+		w, err = wire.NewValueI64(*(v.NextEventId)), error(nil)
 		if err != nil {
-			return err
+			return w, err
 		}
-		o = append(o, i)
-		return nil
-	})
-	l.Close()
-	return o, err
-}
-
-// FromWire deserializes a ReplicationMessages struct from its Thrift-level
-// representation. The Thrift-level representation may be obtained
-// from a ThriftRW protocol implementation.
-//
-// An error is returned if we were unable to build a ReplicationMessages struct
-// from the provided intermediate representation.
-//
-//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
-//   if err != nil {
-//     return nil, err
-//   }
-//
-//   var v ReplicationMessages
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *ReplicationMessages) FromWire(w wire.Value) error {
-	var err error
-
-	for _, field := range w.GetStruct().Fields {
-		switch field.ID {
-		case 10:
-			if field.Value.Type() == wire.TList {
-				v.ReplicationTasks, err = _List_ReplicationTask_Read(field.Value.GetList())
-				if err != nil {
-					return err
-				}
-
-			}
-		case 20:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.LastRetrivedMessageId = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 30:
-			if field.Value.Type() == wire.TBool {
-				var x bool
-				x, err = field.Value.GetBool(), error(nil)
-				v.HasMore = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		}
-	}
-
-	return nil
-}
-
-// String returns a readable string representation of a ReplicationMessages
-// struct.
-func (v *ReplicationMessages) String() string {
-	if v == nil {
-		return "<nil>"
-	}
-
-	var fields [3]string
-	i := 0
-	if v.ReplicationTasks != nil {
-		fields[i] = fmt.Sprintf("ReplicationTasks: %v", v.ReplicationTasks)
-		i++
-	}
-	if v.LastRetrivedMessageId != nil {
-		fields[i] = fmt.Sprintf("LastRetrivedMessageId: %v", *(v.LastRetrivedMessageId))
-		i++
-	}
-	if v.HasMore != nil {
-		fields[i] = fmt.Sprintf("HasMore: %v", *(v.HasMore))
+		fields[i] = wire.Field{ID: 50, Value: w}
 		i++
 	}
-
-	return fmt.Sprintf("ReplicationMessages{%v}", strings.Join(fields[:i], ", "))
-}
-
-func _List_ReplicationTask_Equals(lhs, rhs []*ReplicationTask) bool {
-	if len(lhs) != len(rhs) {
-		return false
-	}
-
-	for i, lv := range lhs {
-		rv := rhs[i]
-		if !lv.Equals(rv) {
-			return false
-		}
-	}
-
-	return true
-}
-
-// Equals returns true if all the fields of this ReplicationMessages match the
-// provided ReplicationMessages.
-//
-// This function performs a deep comparison.
-func (v *ReplicationMessages) Equals(rhs *ReplicationMessages) bool {
-	if v == nil {
-		return rhs == nil
-	} else if rhs == nil {
-		return false
-	}
-	if !((v.ReplicationTasks == nil && rhs.ReplicationTasks == nil) || (v.ReplicationTasks != nil && rhs.ReplicationTasks != nil && _List_ReplicationTask_Equals(v.ReplicationTasks, rhs.ReplicationTasks))) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.LastRetrivedMessageId, rhs.LastRetrivedMessageId) {
-		return false
-	}
-	if !_Bool_EqualsPtr(v.HasMore, rhs.HasMore) {
-		return false
-	}
-
-	return true
-}
-
-type _List_ReplicationTask_Zapper []*ReplicationTask
-
-// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
-// fast logging of _List_ReplicationTask_Zapper.
-func (l _List_ReplicationTask_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
-	for _, v := range l {
-		err = multierr.Append(err, enc.AppendObject(v))
-	}
-	return err
-}
-
-// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of ReplicationMessages.
-func (v *ReplicationMessages) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
-	if v == nil {
-		return nil
-	}
-	if v.ReplicationTasks != nil {
-		err = multierr.Append(err, enc.AddArray("replicationTasks", (_List_ReplicationTask_Zapper)(v.ReplicationTasks)))
-	}
-	if v.LastRetrivedMessageId != nil {
-		enc.AddInt64("lastRetrivedMessageId", *v.LastRetrivedMessageId)
-	}
-	if v.HasMore != nil {
-		enc.AddBool("hasMore", *v.HasMore)
-	}
-	return err
-}
-
-// GetReplicationTasks returns the value of ReplicationTasks if it is set or its
-// zero value if it is unset.
-func (v *ReplicationMessages) GetReplicationTasks() (o []*ReplicationTask) {
-	if v != nil && v.ReplicationTasks != nil {
-		return v.ReplicationTasks
-	}
-
-	return
-}
-
-// IsSetReplicationTasks returns true if ReplicationTasks is not nil.
-func (v *ReplicationMessages) IsSetReplicationTasks() bool {
-	return v != nil && v.ReplicationTasks != nil
-}
-
-// GetLastRetrivedMessageId returns the value of LastRetrivedMessageId if it is set or its
-// zero value if it is unset.
-func (v *ReplicationMessages) GetLastRetrivedMessageId() (o int64) {
-	if v != nil && v.LastRetrivedMessageId != nil {
-		return *v.LastRetrivedMessageId
-	}
-
-	return
-}
-
-// IsSetLastRetrivedMessageId returns true if LastRetrivedMessageId is not nil.
-func (v *ReplicationMessages) IsSetLastRetrivedMessageId() bool {
-	return v != nil && v.LastRetrivedMessageId != nil
-}
-
-// GetHasMore returns the value of HasMore if it is set or its
-// zero value if it is unset.
-func (v *ReplicationMessages) GetHasMore() (o bool) {
-	if v != nil && v.HasMore != nil {
-		return *v.HasMore
-	}
-
-	return
-}
-
-// IsSetHasMore returns true if HasMore is not nil.
-func (v *ReplicationMessages) IsSetHasMore() bool {
-	return v != nil && v.HasMore != nil
-}
-
-type ReplicationTask struct {
-	TaskType                      *ReplicationTaskType           `json:"taskType,omitempty"`
-	SourceTaskId                  *int64                         `json:"sourceTaskId,omitempty"`
-	DomainTaskAttributes          *DomainTaskAttributes          `json:"domainTaskAttributes,omitempty"`
-	HistoryTaskAttributes         *HistoryTaskAttributes         `json:"historyTaskAttributes,omitempty"`
-	SyncShardStatusTaskAttributes *SyncShardStatusTaskAttributes `json:"syncShardStatusTaskAttributes,omitempty"`
-	SyncActicvityTaskAttributes   *SyncActicvityTaskAttributes   `json:"syncActicvityTaskAttributes,omitempty"`
-	HistoryMetadataTaskAttributes *HistoryMetadataTaskAttributes `json:"historyMetadataTaskAttributes,omitempty"`
-	HistoryTaskV2Attributes       *HistoryTaskV2Attributes       `json:"historyTaskV2Attributes,omitempty"`
-}
-
-// ToWire translates a ReplicationTask struct into a Thrift-level intermediate
-// representation. This intermediate representation may be serialized
-// into bytes using a ThriftRW protocol implementation.
-//
-// An error is returned if the struct or any of its fields failed to
-// validate.
-//
-//   x, err := v.ToWire()
-//   if err != nil {
-//     return err
-//   }
-//
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *ReplicationTask) ToWire() (wire.Value, error) {
-	var (
-		fields [8]wire.Field
-		i      int = 0
-		w      wire.Value
-		err    error
-	)
-
-	if v.TaskType != nil {
-		w, err = v.TaskType.ToWire()
+	if v.Version != nil {
+		w, err = wire.NewValueI64(*(v.Version)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 10, Value: w}
+		fields[i] = wire.Field{ID: 60, Value: w}
 		i++
 	}
-	if v.SourceTaskId != nil {
-		w, err = wire.NewValueI64(*(v.SourceTaskId)), error(nil)
+	if v.ReplicationInfo != nil {
+		w, err = wire.NewValueMap(_Map_String_ReplicationInfo_MapItemList(v.ReplicationInfo)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 11, Value: w}
+		fields[i] = wire.Field{ID: 70, Value: w}
 		i++
 	}
-	if v.DomainTaskAttributes != nil {
-		w, err = v.DomainTaskAttributes.ToWire()
+	if v.History != nil {
+		w, err = v.History.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 20, Value: w}
+		fields[i] = wire.Field{ID: 80, Value: w}
 		i++
 	}
-	if v.HistoryTaskAttributes != nil {
-		w, err = v.HistoryTaskAttributes.ToWire()
+	if v.NewRunHistory != nil {
+		w, err = v.NewRunHistory.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 30, Value: w}
+		fields[i] = wire.Field{ID: 90, Value: w}
 		i++
 	}
-	if v.SyncShardStatusTaskAttributes != nil {
-		w, err = v.SyncShardStatusTaskAttributes.ToWire()
+	if v.EventStoreVersion != nil {
+		w, err = wire.NewValueI32(*(v.EventStoreVersion)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 40, Value: w}
+		fields[i] = wire.Field{ID: 100, Value: w}
 		i++
 	}
-	if v.SyncActicvityTaskAttributes != nil {
-		w, err = v.SyncActicvityTaskAttributes.ToWire()
+	if v.NewRunEventStoreVersion != nil {
+		w, err = wire.NewValueI32(*(v.NewRunEventStoreVersion)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 50, Value: w}
+		fields[i] = wire.Field{ID: 110, Value: w}
 		i++
 	}
-	if v.HistoryMetadataTaskAttributes != nil {
-		w, err = v.HistoryMetadataTaskAttributes.ToWire()
+	if v.ResetWorkflow != nil {
+		w, err = wire.NewValueBool(*(v.ResetWorkflow)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 60, Value: w}
+		fields[i] = wire.Field{ID: 120, Value: w}
 		i++
 	}
-	if v.HistoryTaskV2Attributes != nil {
-		w, err = v.HistoryTaskV2Attributes.ToWire()
+	if v.NewRunNDC != nil {
+		w, err = wire.NewValueBool(*(v.NewRunNDC)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 70, Value: w}
+		fields[i] = wire.Field{ID: 130, Value: w}
 		i++
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _ReplicationTaskType_Read(w wire.Value) (ReplicationTaskType, error) {
-	var v ReplicationTaskType
-	err := v.FromWire(w)
-	return v, err
-}
-
-func _DomainTaskAttributes_Read(w wire.Value) (*DomainTaskAttributes, error) {
-	var v DomainTaskAttributes
+func _ReplicationInfo_Read(w wire.Value) (*shared.ReplicationInfo, error) {
+	var v shared.ReplicationInfo
 	err := v.FromWire(w)
 	return &v, err
 }
 
-func _HistoryTaskAttributes_Read(w wire.Value) (*HistoryTaskAttributes, error) {
-	var v HistoryTaskAttributes
-	err := v.FromWire(w)
-	return &v, err
-}
+func _Map_String_ReplicationInfo_Read(m wire.MapItemList) (map[string]*shared.ReplicationInfo, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
 
-func _SyncShardStatusTaskAttributes_Read(w wire.Value) (*SyncShardStatusTaskAttributes, error) {
-	var v SyncShardStatusTaskAttributes
-	err := v.FromWire(w)
-	return &v, err
-}
+	if m.ValueType() != wire.TStruct {
+		return nil, nil
+	}
 
-func _SyncActicvityTaskAttributes_Read(w wire.Value) (*SyncActicvityTaskAttributes, error) {
-	var v SyncActicvityTaskAttributes
-	err := v.FromWire(w)
-	return &v, err
-}
+	o := make(map[string]*shared.ReplicationInfo, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
 
-func _HistoryMetadataTaskAttributes_Read(w wire.Value) (*HistoryMetadataTaskAttributes, error) {
-	var v HistoryMetadataTaskAttributes
-	err := v.FromWire(w)
-	return &v, err
+		v, err := _ReplicationInfo_Read(x.Value)
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
 }
 
-func _HistoryTaskV2Attributes_Read(w wire.Value) (*HistoryTaskV2Attributes, error) {
-	var v HistoryTaskV2Attributes
+func _History_Read(w wire.Value) (*shared.History, error) {
+	var v shared.History
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a ReplicationTask struct from its Thrift-level
+// FromWire deserializes a HistoryTaskAttributes struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a ReplicationTask struct
+// An error is returned if we were unable to build a HistoryTaskAttributes struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -3681,79 +3792,143 @@ func _HistoryTaskV2Attributes_Read(w wire.Value) (*HistoryTaskV2Attributes, erro
 //     return nil, err
 //   }
 //
-//   var v ReplicationTask
+//   var v HistoryTaskAttributes
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *ReplicationTask) FromWire(w wire.Value) error {
+func (v *HistoryTaskAttributes) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 10:
-			if field.Value.Type() == wire.TI32 {
-				var x ReplicationTaskType
-				x, err = _ReplicationTaskType_Read(field.Value)
-				v.TaskType = &x
+		case 5:
+			if field.Value.Type() == wire.TList {
+				v.TargetClusters, err = _List_String_Read(field.Value.GetList())
 				if err != nil {
 					return err
 				}
 
 			}
-		case 11:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.SourceTaskId = &x
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainId = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TStruct {
-				v.DomainTaskAttributes, err = _DomainTaskAttributes_Read(field.Value)
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.WorkflowId = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 30:
-			if field.Value.Type() == wire.TStruct {
-				v.HistoryTaskAttributes, err = _HistoryTaskAttributes_Read(field.Value)
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RunId = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 40:
-			if field.Value.Type() == wire.TStruct {
-				v.SyncShardStatusTaskAttributes, err = _SyncShardStatusTaskAttributes_Read(field.Value)
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.FirstEventId = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 50:
-			if field.Value.Type() == wire.TStruct {
-				v.SyncActicvityTaskAttributes, err = _SyncActicvityTaskAttributes_Read(field.Value)
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.NextEventId = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 60:
-			if field.Value.Type() == wire.TStruct {
-				v.HistoryMetadataTaskAttributes, err = _HistoryMetadataTaskAttributes_Read(field.Value)
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Version = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 70:
+			if field.Value.Type() == wire.TMap {
+				v.ReplicationInfo, err = _Map_String_ReplicationInfo_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 80:
 			if field.Value.Type() == wire.TStruct {
-				v.HistoryTaskV2Attributes, err = _HistoryTaskV2Attributes_Read(field.Value)
+				v.History, err = _History_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 90:
+			if field.Value.Type() == wire.TStruct {
+				v.NewRunHistory, err = _History_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 100:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.EventStoreVersion = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 110:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.NewRunEventStoreVersion = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 120:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.ResetWorkflow = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 130:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.NewRunNDC = &x
 				if err != nil {
 					return err
 				}
@@ -3765,291 +3940,3928 @@ func (v *ReplicationTask) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a ReplicationTask
+// String returns a readable string representation of a HistoryTaskAttributes
 // struct.
-func (v *ReplicationTask) String() string {
+func (v *HistoryTaskAttributes) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [8]string
+	var fields [14]string
 	i := 0
-	if v.TaskType != nil {
-		fields[i] = fmt.Sprintf("TaskType: %v", *(v.TaskType))
+	if v.TargetClusters != nil {
+		fields[i] = fmt.Sprintf("TargetClusters: %v", v.TargetClusters)
 		i++
 	}
-	if v.SourceTaskId != nil {
-		fields[i] = fmt.Sprintf("SourceTaskId: %v", *(v.SourceTaskId))
+	if v.DomainId != nil {
+		fields[i] = fmt.Sprintf("DomainId: %v", *(v.DomainId))
 		i++
 	}
-	if v.DomainTaskAttributes != nil {
-		fields[i] = fmt.Sprintf("DomainTaskAttributes: %v", v.DomainTaskAttributes)
+	if v.WorkflowId != nil {
+		fields[i] = fmt.Sprintf("WorkflowId: %v", *(v.WorkflowId))
 		i++
 	}
-	if v.HistoryTaskAttributes != nil {
-		fields[i] = fmt.Sprintf("HistoryTaskAttributes: %v", v.HistoryTaskAttributes)
+	if v.RunId != nil {
+		fields[i] = fmt.Sprintf("RunId: %v", *(v.RunId))
 		i++
 	}
-	if v.SyncShardStatusTaskAttributes != nil {
-		fields[i] = fmt.Sprintf("SyncShardStatusTaskAttributes: %v", v.SyncShardStatusTaskAttributes)
+	if v.FirstEventId != nil {
+		fields[i] = fmt.Sprintf("FirstEventId: %v", *(v.FirstEventId))
 		i++
 	}
-	if v.SyncActicvityTaskAttributes != nil {
-		fields[i] = fmt.Sprintf("SyncActicvityTaskAttributes: %v", v.SyncActicvityTaskAttributes)
+	if v.NextEventId != nil {
+		fields[i] = fmt.Sprintf("NextEventId: %v", *(v.NextEventId))
 		i++
 	}
-	if v.HistoryMetadataTaskAttributes != nil {
-		fields[i] = fmt.Sprintf("HistoryMetadataTaskAttributes: %v", v.HistoryMetadataTaskAttributes)
+	if v.Version != nil {
+		fields[i] = fmt.Sprintf("Version: %v", *(v.Version))
 		i++
 	}
-	if v.HistoryTaskV2Attributes != nil {
-		fields[i] = fmt.Sprintf("HistoryTaskV2Attributes: %v", v.HistoryTaskV2Attributes)
+	if v.ReplicationInfo != nil {
+		fields[i] = fmt.Sprintf("ReplicationInfo: %v", v.ReplicationInfo)
+		i++
+	}
+	if v.History != nil {
+		fields[i] = fmt.Sprintf("History: %v", v.History)
+		i++
+	}
+	if v.NewRunHistory != nil {
+		fields[i] = fmt.Sprintf("NewRunHistory: %v", v.NewRunHistory)
+		i++
+	}
+	if v.EventStoreVersion != nil {
+		fields[i] = fmt.Sprintf("EventStoreVersion: %v", *(v.EventStoreVersion))
+		i++
+	}
+	if v.NewRunEventStoreVersion != nil {
+		fields[i] = fmt.Sprintf("NewRunEventStoreVersion: %v", *(v.NewRunEventStoreVersion))
+		i++
+	}
+	if v.ResetWorkflow != nil {
+		fields[i] = fmt.Sprintf("ResetWorkflow: %v", *(v.ResetWorkflow))
+		i++
+	}
+	if v.NewRunNDC != nil {
+		fields[i] = fmt.Sprintf("NewRunNDC: %v", *(v.NewRunNDC))
 		i++
 	}
 
-	return fmt.Sprintf("ReplicationTask{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("HistoryTaskAttributes{%v}", strings.Join(fields[:i], ", "))
 }
 
-func _ReplicationTaskType_EqualsPtr(lhs, rhs *ReplicationTaskType) bool {
+func _Map_String_ReplicationInfo_Equals(lhs, rhs map[string]*shared.ReplicationInfo) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+	return true
+}
+
+func _I32_EqualsPtr(lhs, rhs *int32) bool {
 	if lhs != nil && rhs != nil {
 
 		x := *lhs
 		y := *rhs
-		return x.Equals(y)
+		return (x == y)
 	}
 	return lhs == nil && rhs == nil
 }
 
-// Equals returns true if all the fields of this ReplicationTask match the
-// provided ReplicationTask.
+func _Bool_EqualsPtr(lhs, rhs *bool) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this HistoryTaskAttributes match the
+// provided HistoryTaskAttributes.
 //
 // This function performs a deep comparison.
-func (v *ReplicationTask) Equals(rhs *ReplicationTask) bool {
+func (v *HistoryTaskAttributes) Equals(rhs *HistoryTaskAttributes) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_ReplicationTaskType_EqualsPtr(v.TaskType, rhs.TaskType) {
+	if !((v.TargetClusters == nil && rhs.TargetClusters == nil) || (v.TargetClusters != nil && rhs.TargetClusters != nil && _List_String_Equals(v.TargetClusters, rhs.TargetClusters))) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.SourceTaskId, rhs.SourceTaskId) {
+	if !_String_EqualsPtr(v.DomainId, rhs.DomainId) {
 		return false
 	}
-	if !((v.DomainTaskAttributes == nil && rhs.DomainTaskAttributes == nil) || (v.DomainTaskAttributes != nil && rhs.DomainTaskAttributes != nil && v.DomainTaskAttributes.Equals(rhs.DomainTaskAttributes))) {
+	if !_String_EqualsPtr(v.WorkflowId, rhs.WorkflowId) {
 		return false
 	}
-	if !((v.HistoryTaskAttributes == nil && rhs.HistoryTaskAttributes == nil) || (v.HistoryTaskAttributes != nil && rhs.HistoryTaskAttributes != nil && v.HistoryTaskAttributes.Equals(rhs.HistoryTaskAttributes))) {
+	if !_String_EqualsPtr(v.RunId, rhs.RunId) {
 		return false
 	}
-	if !((v.SyncShardStatusTaskAttributes == nil && rhs.SyncShardStatusTaskAttributes == nil) || (v.SyncShardStatusTaskAttributes != nil && rhs.SyncShardStatusTaskAttributes != nil && v.SyncShardStatusTaskAttributes.Equals(rhs.SyncShardStatusTaskAttributes))) {
+	if !_I64_EqualsPtr(v.FirstEventId, rhs.FirstEventId) {
 		return false
 	}
-	if !((v.SyncActicvityTaskAttributes == nil && rhs.SyncActicvityTaskAttributes == nil) || (v.SyncActicvityTaskAttributes != nil && rhs.SyncActicvityTaskAttributes != nil && v.SyncActicvityTaskAttributes.Equals(rhs.SyncActicvityTaskAttributes))) {
+	if !_I64_EqualsPtr(v.NextEventId, rhs.NextEventId) {
 		return false
 	}
-	if !((v.HistoryMetadataTaskAttributes == nil && rhs.HistoryMetadataTaskAttributes == nil) || (v.HistoryMetadataTaskAttributes != nil && rhs.HistoryMetadataTaskAttributes != nil && v.HistoryMetadataTaskAttributes.Equals(rhs.HistoryMetadataTaskAttributes))) {
+	if !_I64_EqualsPtr(v.Version, rhs.Version) {
 		return false
 	}
-	if !((v.HistoryTaskV2Attributes == nil && rhs.HistoryTaskV2Attributes == nil) || (v.HistoryTaskV2Attributes != nil && rhs.HistoryTaskV2Attributes != nil && v.HistoryTaskV2Attributes.Equals(rhs.HistoryTaskV2Attributes))) {
+	if !((v.ReplicationInfo == nil && rhs.ReplicationInfo == nil) || (v.ReplicationInfo != nil && rhs.ReplicationInfo != nil && _Map_String_ReplicationInfo_Equals(v.ReplicationInfo, rhs.ReplicationInfo))) {
+		return false
+	}
+	if !((v.History == nil && rhs.History == nil) || (v.History != nil && rhs.History != nil && v.History.Equals(rhs.History))) {
+		return false
+	}
+	if !((v.NewRunHistory == nil && rhs.NewRunHistory == nil) || (v.NewRunHistory != nil && rhs.NewRunHistory != nil && v.NewRunHistory.Equals(rhs.NewRunHistory))) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.EventStoreVersion, rhs.EventStoreVersion) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.NewRunEventStoreVersion, rhs.NewRunEventStoreVersion) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.ResetWorkflow, rhs.ResetWorkflow) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.NewRunNDC, rhs.NewRunNDC) {
 		return false
 	}
 
 	return true
 }
 
+type _Map_String_ReplicationInfo_Zapper map[string]*shared.ReplicationInfo
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of ReplicationTask.
-func (v *ReplicationTask) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of _Map_String_ReplicationInfo_Zapper.
+func (m _Map_String_ReplicationInfo_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	for k, v := range m {
+		err = multierr.Append(err, enc.AddObject((string)(k), v))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of HistoryTaskAttributes.
+func (v *HistoryTaskAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.TaskType != nil {
-		err = multierr.Append(err, enc.AddObject("taskType", *v.TaskType))
+	if v.TargetClusters != nil {
+		err = multierr.Append(err, enc.AddArray("targetClusters", (_List_String_Zapper)(v.TargetClusters)))
 	}
-	if v.SourceTaskId != nil {
-		enc.AddInt64("sourceTaskId", *v.SourceTaskId)
+	if v.DomainId != nil {
+		enc.AddString("domainId", *v.DomainId)
 	}
-	if v.DomainTaskAttributes != nil {
-		err = multierr.Append(err, enc.AddObject("domainTaskAttributes", v.DomainTaskAttributes))
+	if v.WorkflowId != nil {
+		enc.AddString("workflowId", *v.WorkflowId)
 	}
-	if v.HistoryTaskAttributes != nil {
-		err = multierr.Append(err, enc.AddObject("historyTaskAttributes", v.HistoryTaskAttributes))
+	if v.RunId != nil {
+		enc.AddString("runId", *v.RunId)
 	}
-	if v.SyncShardStatusTaskAttributes != nil {
-		err = multierr.Append(err, enc.AddObject("syncShardStatusTaskAttributes", v.SyncShardStatusTaskAttributes))
+	if v.FirstEventId != nil {
+		enc.AddInt64("firstEventId", *v.FirstEventId)
 	}
-	if v.SyncActicvityTaskAttributes != nil {
-		err = multierr.Append(err, enc.AddObject("syncActicvityTaskAttributes", v.SyncActicvityTaskAttributes))
+	if v.NextEventId != nil {
+		enc.AddInt64("nextEventId", *v.NextEventId)
 	}
-	if v.HistoryMetadataTaskAttributes != nil {
-		err = multierr.Append(err, enc.AddObject("historyMetadataTaskAttributes", v.HistoryMetadataTaskAttributes))
+	if v.Version != nil {
+		enc.AddInt64("version", *v.Version)
 	}
-	if v.HistoryTaskV2Attributes != nil {
-		err = multierr.Append(err, enc.AddObject("historyTaskV2Attributes", v.HistoryTaskV2Attributes))
+	if v.ReplicationInfo != nil {
+		err = multierr.Append(err, enc.AddObject("replicationInfo", (_Map_String_ReplicationInfo_Zapper)(v.ReplicationInfo)))
+	}
+	if v.History != nil {
+		err = multierr.Append(err, enc.AddObject("history", v.History))
+	}
+	if v.NewRunHistory != nil {
+		err = multierr.Append(err, enc.AddObject("newRunHistory", v.NewRunHistory))
+	}
+	if v.EventStoreVersion != nil {
+		enc.AddInt32("eventStoreVersion", *v.EventStoreVersion)
+	}
+	if v.NewRunEventStoreVersion != nil {
+		enc.AddInt32("newRunEventStoreVersion", *v.NewRunEventStoreVersion)
+	}
+	if v.ResetWorkflow != nil {
+		enc.AddBool("resetWorkflow", *v.ResetWorkflow)
+	}
+	if v.NewRunNDC != nil {
+		enc.AddBool("newRunNDC", *v.NewRunNDC)
 	}
 	return err
 }
 
-// GetTaskType returns the value of TaskType if it is set or its
+// GetTargetClusters returns the value of TargetClusters if it is set or its
 // zero value if it is unset.
-func (v *ReplicationTask) GetTaskType() (o ReplicationTaskType) {
-	if v != nil && v.TaskType != nil {
-		return *v.TaskType
+func (v *HistoryTaskAttributes) GetTargetClusters() (o []string) {
+	if v != nil && v.TargetClusters != nil {
+		return v.TargetClusters
 	}
 
 	return
 }
 
-// IsSetTaskType returns true if TaskType is not nil.
-func (v *ReplicationTask) IsSetTaskType() bool {
-	return v != nil && v.TaskType != nil
+// IsSetTargetClusters returns true if TargetClusters is not nil.
+func (v *HistoryTaskAttributes) IsSetTargetClusters() bool {
+	return v != nil && v.TargetClusters != nil
 }
 
-// GetSourceTaskId returns the value of SourceTaskId if it is set or its
+// GetDomainId returns the value of DomainId if it is set or its
 // zero value if it is unset.
-func (v *ReplicationTask) GetSourceTaskId() (o int64) {
-	if v != nil && v.SourceTaskId != nil {
-		return *v.SourceTaskId
+func (v *HistoryTaskAttributes) GetDomainId() (o string) {
+	if v != nil && v.DomainId != nil {
+		return *v.DomainId
 	}
 
 	return
 }
 
-// IsSetSourceTaskId returns true if SourceTaskId is not nil.
-func (v *ReplicationTask) IsSetSourceTaskId() bool {
-	return v != nil && v.SourceTaskId != nil
+// IsSetDomainId returns true if DomainId is not nil.
+func (v *HistoryTaskAttributes) IsSetDomainId() bool {
+	return v != nil && v.DomainId != nil
 }
 
-// GetDomainTaskAttributes returns the value of DomainTaskAttributes if it is set or its
+// GetWorkflowId returns the value of WorkflowId if it is set or its
 // zero value if it is unset.
-func (v *ReplicationTask) GetDomainTaskAttributes() (o *DomainTaskAttributes) {
-	if v != nil && v.DomainTaskAttributes != nil {
-		return v.DomainTaskAttributes
+func (v *HistoryTaskAttributes) GetWorkflowId() (o string) {
+	if v != nil && v.WorkflowId != nil {
+		return *v.WorkflowId
 	}
 
 	return
 }
 
-// IsSetDomainTaskAttributes returns true if DomainTaskAttributes is not nil.
-func (v *ReplicationTask) IsSetDomainTaskAttributes() bool {
-	return v != nil && v.DomainTaskAttributes != nil
+// IsSetWorkflowId returns true if WorkflowId is not nil.
+func (v *HistoryTaskAttributes) IsSetWorkflowId() bool {
+	return v != nil && v.WorkflowId != nil
 }
 
-// GetHistoryTaskAttributes returns the value of HistoryTaskAttributes if it is set or its
+// GetRunId returns the value of RunId if it is set or its
 // zero value if it is unset.
-func (v *ReplicationTask) GetHistoryTaskAttributes() (o *HistoryTaskAttributes) {
-	if v != nil && v.HistoryTaskAttributes != nil {
-		return v.HistoryTaskAttributes
+func (v *HistoryTaskAttributes) GetRunId() (o string) {
+	if v != nil && v.RunId != nil {
+		return *v.RunId
 	}
 
 	return
 }
 
-// IsSetHistoryTaskAttributes returns true if HistoryTaskAttributes is not nil.
-func (v *ReplicationTask) IsSetHistoryTaskAttributes() bool {
-	return v != nil && v.HistoryTaskAttributes != nil
+// IsSetRunId returns true if RunId is not nil.
+func (v *HistoryTaskAttributes) IsSetRunId() bool {
+	return v != nil && v.RunId != nil
 }
 
-// GetSyncShardStatusTaskAttributes returns the value of SyncShardStatusTaskAttributes if it is set or its
+// GetFirstEventId returns the value of FirstEventId if it is set or its
 // zero value if it is unset.
-func (v *ReplicationTask) GetSyncShardStatusTaskAttributes() (o *SyncShardStatusTaskAttributes) {
-	if v != nil && v.SyncShardStatusTaskAttributes != nil {
-		return v.SyncShardStatusTaskAttributes
+func (v *HistoryTaskAttributes) GetFirstEventId() (o int64) {
+	if v != nil && v.FirstEventId != nil {
+		return *v.FirstEventId
 	}
 
 	return
 }
 
-// IsSetSyncShardStatusTaskAttributes returns true if SyncShardStatusTaskAttributes is not nil.
-func (v *ReplicationTask) IsSetSyncShardStatusTaskAttributes() bool {
-	return v != nil && v.SyncShardStatusTaskAttributes != nil
+// IsSetFirstEventId returns true if FirstEventId is not nil.
+func (v *HistoryTaskAttributes) IsSetFirstEventId() bool {
+	return v != nil && v.FirstEventId != nil
 }
 
-// GetSyncActicvityTaskAttributes returns the value of SyncActicvityTaskAttributes if it is set or its
+// GetNextEventId returns the value of NextEventId if it is set or its
 // zero value if it is unset.
-func (v *ReplicationTask) GetSyncActicvityTaskAttributes() (o *SyncActicvityTaskAttributes) {
-	if v != nil && v.SyncActicvityTaskAttributes != nil {
-		return v.SyncActicvityTaskAttributes
+func (v *HistoryTaskAttributes) GetNextEventId() (o int64) {
+	if v != nil && v.NextEventId != nil {
+		return *v.NextEventId
 	}
 
 	return
 }
 
-// IsSetSyncActicvityTaskAttributes returns true if SyncActicvityTaskAttributes is not nil.
-func (v *ReplicationTask) IsSetSyncActicvityTaskAttributes() bool {
-	return v != nil && v.SyncActicvityTaskAttributes != nil
+// IsSetNextEventId returns true if NextEventId is not nil.
+func (v *HistoryTaskAttributes) IsSetNextEventId() bool {
+	return v != nil && v.NextEventId != nil
 }
 
-// GetHistoryMetadataTaskAttributes returns the value of HistoryMetadataTaskAttributes if it is set or its
+// GetVersion returns the value of Version if it is set or its
 // zero value if it is unset.
-func (v *ReplicationTask) GetHistoryMetadataTaskAttributes() (o *HistoryMetadataTaskAttributes) {
-	if v != nil && v.HistoryMetadataTaskAttributes != nil {
-		return v.HistoryMetadataTaskAttributes
+func (v *HistoryTaskAttributes) GetVersion() (o int64) {
+	if v != nil && v.Version != nil {
+		return *v.Version
 	}
 
 	return
 }
 
-// IsSetHistoryMetadataTaskAttributes returns true if HistoryMetadataTaskAttributes is not nil.
-func (v *ReplicationTask) IsSetHistoryMetadataTaskAttributes() bool {
-	return v != nil && v.HistoryMetadataTaskAttributes != nil
+// IsSetVersion returns true if Version is not nil.
+func (v *HistoryTaskAttributes) IsSetVersion() bool {
+	return v != nil && v.Version != nil
 }
 
-// GetHistoryTaskV2Attributes returns the value of HistoryTaskV2Attributes if it is set or its
+// GetReplicationInfo returns the value of ReplicationInfo if it is set or its
 // zero value if it is unset.
-func (v *ReplicationTask) GetHistoryTaskV2Attributes() (o *HistoryTaskV2Attributes) {
-	if v != nil && v.HistoryTaskV2Attributes != nil {
-		return v.HistoryTaskV2Attributes
+func (v *HistoryTaskAttributes) GetReplicationInfo() (o map[string]*shared.ReplicationInfo) {
+	if v != nil && v.ReplicationInfo != nil {
+		return v.ReplicationInfo
 	}
 
 	return
 }
 
-// IsSetHistoryTaskV2Attributes returns true if HistoryTaskV2Attributes is not nil.
-func (v *ReplicationTask) IsSetHistoryTaskV2Attributes() bool {
-	return v != nil && v.HistoryTaskV2Attributes != nil
+// IsSetReplicationInfo returns true if ReplicationInfo is not nil.
+func (v *HistoryTaskAttributes) IsSetReplicationInfo() bool {
+	return v != nil && v.ReplicationInfo != nil
 }
 
-type ReplicationTaskType int32
+// GetHistory returns the value of History if it is set or its
+// zero value if it is unset.
+func (v *HistoryTaskAttributes) GetHistory() (o *shared.History) {
+	if v != nil && v.History != nil {
+		return v.History
+	}
 
-const (
-	ReplicationTaskTypeDomain          ReplicationTaskType = 0
-	ReplicationTaskTypeHistory         ReplicationTaskType = 1
-	ReplicationTaskTypeSyncShardStatus ReplicationTaskType = 2
-	ReplicationTaskTypeSyncActivity    ReplicationTaskType = 3
-	ReplicationTaskTypeHistoryMetadata ReplicationTaskType = 4
-	ReplicationTaskTypeHistoryV2       ReplicationTaskType = 5
-)
+	return
+}
 
-// ReplicationTaskType_Values returns all recognized values of ReplicationTaskType.
-func ReplicationTaskType_Values() []ReplicationTaskType {
-	return []ReplicationTaskType{
-		ReplicationTaskTypeDomain,
-		ReplicationTaskTypeHistory,
-		ReplicationTaskTypeSyncShardStatus,
-		ReplicationTaskTypeSyncActivity,
-		ReplicationTaskTypeHistoryMetadata,
-		ReplicationTaskTypeHistoryV2,
+// IsSetHistory returns true if History is not nil.
+func (v *HistoryTaskAttributes) IsSetHistory() bool {
+	return v != nil && v.History != nil
+}
+
+// GetNewRunHistory returns the value of NewRunHistory if it is set or its
+// zero value if it is unset.
+func (v *HistoryTaskAttributes) GetNewRunHistory() (o *shared.History) {
+	if v != nil && v.NewRunHistory != nil {
+		return v.NewRunHistory
 	}
+
+	return
 }
 
-// UnmarshalText tries to decode ReplicationTaskType from a byte slice
-// containing its name.
-//
-//   var v ReplicationTaskType
-//   err := v.UnmarshalText([]byte("Domain"))
-func (v *ReplicationTaskType) UnmarshalText(value []byte) error {
-	switch s := string(value); s {
-	case "Domain":
-		*v = ReplicationTaskTypeDomain
-		return nil
-	case "History":
-		*v = ReplicationTaskTypeHistory
-		return nil
-	case "SyncShardStatus":
-		*v = ReplicationTaskTypeSyncShardStatus
-		return nil
+// IsSetNewRunHistory returns true if NewRunHistory is not nil.
+func (v *HistoryTaskAttributes) IsSetNewRunHistory() bool {
+	return v != nil && v.NewRunHistory != nil
+}
+
+// GetEventStoreVersion returns the value of EventStoreVersion if it is set or its
+// zero value if it is unset.
+func (v *HistoryTaskAttributes) GetEventStoreVersion() (o int32) {
+	if v != nil && v.EventStoreVersion != nil {
+		return *v.EventStoreVersion
+	}
+
+	return
+}
+
+// IsSetEventStoreVersion returns true if EventStoreVersion is not nil.
+func (v *HistoryTaskAttributes) IsSetEventStoreVersion() bool {
+	return v != nil && v.EventStoreVersion != nil
+}
+
+// GetNewRunEventStoreVersion returns the value of NewRunEventStoreVersion if it is set or its
+// zero value if it is unset.
+func (v *HistoryTaskAttributes) GetNewRunEventStoreVersion() (o int32) {
+	if v != nil && v.NewRunEventStoreVersion != nil {
+		return *v.NewRunEventStoreVersion
+	}
+
+	return
+}
+
+// IsSetNewRunEventStoreVersion returns true if NewRunEventStoreVersion is not nil.
+func (v *HistoryTaskAttributes) IsSetNewRunEventStoreVersion() bool {
+	return v != nil && v.NewRunEventStoreVersion != nil
+}
+
+// GetResetWorkflow returns the value of ResetWorkflow if it is set or its
+// zero value if it is unset.
+func (v *HistoryTaskAttributes) GetResetWorkflow() (o bool) {
+	if v != nil && v.ResetWorkflow != nil {
+		return *v.ResetWorkflow
+	}
+
+	return
+}
+
+// IsSetResetWorkflow returns true if ResetWorkflow is not nil.
+func (v *HistoryTaskAttributes) IsSetResetWorkflow() bool {
+	return v != nil && v.ResetWorkflow != nil
+}
+
+// GetNewRunNDC returns the value of NewRunNDC if it is set or its
+// zero value if it is unset.
+func (v *HistoryTaskAttributes) GetNewRunNDC() (o bool) {
+	if v != nil && v.NewRunNDC != nil {
+		return *v.NewRunNDC
+	}
+
+	return
+}
+
+// IsSetNewRunNDC returns true if NewRunNDC is not nil.
+func (v *HistoryTaskAttributes) IsSetNewRunNDC() bool {
+	return v != nil && v.NewRunNDC != nil
+}
+
+type HistoryTaskV2Attributes struct {
+	TaskId              *int64                       `json:"taskId,omitempty"`
+	DomainId            *string                      `json:"domainId,omitempty"`
+	WorkflowId          *string                      `json:"workflowId,omitempty"`
+	RunId               *string                      `json:"runId,omitempty"`
+	VersionHistoryItems []*shared.VersionHistoryItem `json:"versionHistoryItems,omitempty"`
+	Events              *shared.DataBlob             `json:"events,omitempty"`
+	NewRunEvents        *shared.DataBlob             `json:"newRunEvents,omitempty"`
+}
+
+type _List_VersionHistoryItem_ValueList []*shared.VersionHistoryItem
+
+func (v _List_VersionHistoryItem_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_VersionHistoryItem_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_VersionHistoryItem_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_VersionHistoryItem_ValueList) Close() {}
+
+// ToWire translates a HistoryTaskV2Attributes struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *HistoryTaskV2Attributes) ToWire() (wire.Value, error) {
+	var (
+		fields [7]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.TaskId != nil {
+		w, err = wire.NewValueI64(*(v.TaskId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
+	if v.DomainId != nil {
+		w, err = wire.NewValueString(*(v.DomainId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.WorkflowId != nil {
+		w, err = wire.NewValueString(*(v.WorkflowId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.RunId != nil {
+		w, err = wire.NewValueString(*(v.RunId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.VersionHistoryItems != nil {
+		w, err = wire.NewValueList(_List_VersionHistoryItem_ValueList(v.VersionHistoryItems)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.Events != nil {
+		w, err = v.Events.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.NewRunEvents != nil {
+		w, err = v.NewRunEvents.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _VersionHistoryItem_Read(w wire.Value) (*shared.VersionHistoryItem, error) {
+	var v shared.VersionHistoryItem
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_VersionHistoryItem_Read(l wire.ValueList) ([]*shared.VersionHistoryItem, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*shared.VersionHistoryItem, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _VersionHistoryItem_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _DataBlob_Read(w wire.Value) (*shared.DataBlob, error) {
+	var v shared.DataBlob
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a HistoryTaskV2Attributes struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a HistoryTaskV2Attributes struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v HistoryTaskV2Attributes
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *HistoryTaskV2Attributes) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 5:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.TaskId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.WorkflowId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RunId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TList {
+				v.VersionHistoryItems, err = _List_VersionHistoryItem_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TStruct {
+				v.Events, err = _DataBlob_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 70:
+			if field.Value.Type() == wire.TStruct {
+				v.NewRunEvents, err = _DataBlob_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a HistoryTaskV2Attributes
+// struct.
+func (v *HistoryTaskV2Attributes) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [7]string
+	i := 0
+	if v.TaskId != nil {
+		fields[i] = fmt.Sprintf("TaskId: %v", *(v.TaskId))
+		i++
+	}
+	if v.DomainId != nil {
+		fields[i] = fmt.Sprintf("DomainId: %v", *(v.DomainId))
+		i++
+	}
+	if v.WorkflowId != nil {
+		fields[i] = fmt.Sprintf("WorkflowId: %v", *(v.WorkflowId))
+		i++
+	}
+	if v.RunId != nil {
+		fields[i] = fmt.Sprintf("RunId: %v", *(v.RunId))
+		i++
+	}
+	if v.VersionHistoryItems != nil {
+		fields[i] = fmt.Sprintf("VersionHistoryItems: %v", v.VersionHistoryItems)
+		i++
+	}
+	if v.Events != nil {
+		fields[i] = fmt.Sprintf("Events: %v", v.Events)
+		i++
+	}
+	if v.NewRunEvents != nil {
+		fields[i] = fmt.Sprintf("NewRunEvents: %v", v.NewRunEvents)
+		i++
+	}
+
+	return fmt.Sprintf("HistoryTaskV2Attributes{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_VersionHistoryItem_Equals(lhs, rhs []*shared.VersionHistoryItem) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this HistoryTaskV2Attributes match the
+// provided HistoryTaskV2Attributes.
+//
+// This function performs a deep comparison.
+func (v *HistoryTaskV2Attributes) Equals(rhs *HistoryTaskV2Attributes) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_I64_EqualsPtr(v.TaskId, rhs.TaskId) {
+		return false
+	}
+	if !_String_EqualsPtr(v.DomainId, rhs.DomainId) {
+		return false
+	}
+	if !_String_EqualsPtr(v.WorkflowId, rhs.WorkflowId) {
+		return false
+	}
+	if !_String_EqualsPtr(v.RunId, rhs.RunId) {
+		return false
+	}
+	if !((v.VersionHistoryItems == nil && rhs.VersionHistoryItems == nil) || (v.VersionHistoryItems != nil && rhs.VersionHistoryItems != nil && _List_VersionHistoryItem_Equals(v.VersionHistoryItems, rhs.VersionHistoryItems))) {
+		return false
+	}
+	if !((v.Events == nil && rhs.Events == nil) || (v.Events != nil && rhs.Events != nil && v.Events.Equals(rhs.Events))) {
+		return false
+	}
+	if !((v.NewRunEvents == nil && rhs.NewRunEvents == nil) || (v.NewRunEvents != nil && rhs.NewRunEvents != nil && v.NewRunEvents.Equals(rhs.NewRunEvents))) {
+		return false
+	}
+
+	return true
+}
+
+type _List_VersionHistoryItem_Zapper []*shared.VersionHistoryItem
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_VersionHistoryItem_Zapper.
+func (l _List_VersionHistoryItem_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of HistoryTaskV2Attributes.
+func (v *HistoryTaskV2Attributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.TaskId != nil {
+		enc.AddInt64("taskId", *v.TaskId)
+	}
+	if v.DomainId != nil {
+		enc.AddString("domainId", *v.DomainId)
+	}
+	if v.WorkflowId != nil {
+		enc.AddString("workflowId", *v.WorkflowId)
+	}
+	if v.RunId != nil {
+		enc.AddString("runId", *v.RunId)
+	}
+	if v.VersionHistoryItems != nil {
+		err = multierr.Append(err, enc.AddArray("versionHistoryItems", (_List_VersionHistoryItem_Zapper)(v.VersionHistoryItems)))
+	}
+	if v.Events != nil {
+		err = multierr.Append(err, enc.AddObject("events", v.Events))
+	}
+	if v.NewRunEvents != nil {
+		err = multierr.Append(err, enc.AddObject("newRunEvents", v.NewRunEvents))
+	}
+	return err
+}
+
+// GetTaskId returns the value of TaskId if it is set or its
+// zero value if it is unset.
+func (v *HistoryTaskV2Attributes) GetTaskId() (o int64) {
+	if v != nil && v.TaskId != nil {
+		return *v.TaskId
+	}
+
+	return
+}
+
+// IsSetTaskId returns true if TaskId is not nil.
+func (v *HistoryTaskV2Attributes) IsSetTaskId() bool {
+	return v != nil && v.TaskId != nil
+}
+
+// GetDomainId returns the value of DomainId if it is set or its
+// zero value if it is unset.
+func (v *HistoryTaskV2Attributes) GetDomainId() (o string) {
+	if v != nil && v.DomainId != nil {
+		return *v.DomainId
+	}
+
+	return
+}
+
+// IsSetDomainId returns true if DomainId is not nil.
+func (v *HistoryTaskV2Attributes) IsSetDomainId() bool {
+	return v != nil && v.DomainId != nil
+}
+
+// GetWorkflowId returns the value of WorkflowId if it is set or its
+// zero value if it is unset.
+func (v *HistoryTaskV2Attributes) GetWorkflowId() (o string) {
+	if v != nil && v.WorkflowId != nil {
+		return *v.WorkflowId
+	}
+
+	return
+}
+
+// IsSetWorkflowId returns true if WorkflowId is not nil.
+func (v *HistoryTaskV2Attributes) IsSetWorkflowId() bool {
+	return v != nil && v.WorkflowId != nil
+}
+
+// GetRunId returns the value of RunId if it is set or its
+// zero value if it is unset.
+func (v *HistoryTaskV2Attributes) GetRunId() (o string) {
+	if v != nil && v.RunId != nil {
+		return *v.RunId
+	}
+
+	return
+}
+
+// IsSetRunId returns true if RunId is not nil.
+func (v *HistoryTaskV2Attributes) IsSetRunId() bool {
+	return v != nil && v.RunId != nil
+}
+
+// GetVersionHistoryItems returns the value of VersionHistoryItems if it is set or its
+// zero value if it is unset.
+func (v *HistoryTaskV2Attributes) GetVersionHistoryItems() (o []*shared.VersionHistoryItem) {
+	if v != nil && v.VersionHistoryItems != nil {
+		return v.VersionHistoryItems
+	}
+
+	return
+}
+
+// IsSetVersionHistoryItems returns true if VersionHistoryItems is not nil.
+func (v *HistoryTaskV2Attributes) IsSetVersionHistoryItems() bool {
+	return v != nil && v.VersionHistoryItems != nil
+}
+
+// GetEvents returns the value of Events if it is set or its
+// zero value if it is unset.
+func (v *HistoryTaskV2Attributes) GetEvents() (o *shared.DataBlob) {
+	if v != nil && v.Events != nil {
+		return v.Events
+	}
+
+	return
+}
+
+// IsSetEvents returns true if Events is not nil.
+func (v *HistoryTaskV2Attributes) IsSetEvents() bool {
+	return v != nil && v.Events != nil
+}
+
+// GetNewRunEvents returns the value of NewRunEvents if it is set or its
+// zero value if it is unset.
+func (v *HistoryTaskV2Attributes) GetNewRunEvents() (o *shared.DataBlob) {
+	if v != nil && v.NewRunEvents != nil {
+		return v.NewRunEvents
+	}
+
+	return
+}
+
+// IsSetNewRunEvents returns true if NewRunEvents is not nil.
+func (v *HistoryTaskV2Attributes) IsSetNewRunEvents() bool {
+	return v != nil && v.NewRunEvents != nil
+}
+
+type MergeDLQMessagesRequest struct {
+	Type                  *DLQType `json:"type,omitempty"`
+	ShardID               *int32   `json:"shardID,omitempty"`
+	SourceCluster         *string  `json:"sourceCluster,omitempty"`
+	InclusiveEndMessageID *int64   `json:"inclusiveEndMessageID,omitempty"`
+	MaximumPageSize       *int32   `json:"maximumPageSize,omitempty"`
+	NextPageToken         []byte   `json:"nextPageToken,omitempty"`
+}
+
+// ToWire translates a MergeDLQMessagesRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *MergeDLQMessagesRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [6]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Type != nil {
+		w, err = v.Type.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.ShardID != nil {
+		w, err = wire.NewValueI32(*(v.ShardID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.SourceCluster != nil {
+		w, err = wire.NewValueString(*(v.SourceCluster)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.InclusiveEndMessageID != nil {
+		w, err = wire.NewValueI64(*(v.InclusiveEndMessageID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.MaximumPageSize != nil {
+		w, err = wire.NewValueI32(*(v.MaximumPageSize)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.NextPageToken != nil {
+		w, err = wire.NewValueBinary(v.NextPageToken), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _DLQType_Read(w wire.Value) (DLQType, error) {
+	var v DLQType
+	err := v.FromWire(w)
+	return v, err
+}
+
+// FromWire deserializes a MergeDLQMessagesRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a MergeDLQMessagesRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v MergeDLQMessagesRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *MergeDLQMessagesRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TI32 {
+				var x DLQType
+				x, err = _DLQType_Read(field.Value)
+				v.Type = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ShardID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.SourceCluster = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.InclusiveEndMessageID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.MaximumPageSize = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TBinary {
+				v.NextPageToken, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a MergeDLQMessagesRequest
+// struct.
+func (v *MergeDLQMessagesRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [6]string
+	i := 0
+	if v.Type != nil {
+		fields[i] = fmt.Sprintf("Type: %v", *(v.Type))
+		i++
+	}
+	if v.ShardID != nil {
+		fields[i] = fmt.Sprintf("ShardID: %v", *(v.ShardID))
+		i++
+	}
+	if v.SourceCluster != nil {
+		fields[i] = fmt.Sprintf("SourceCluster: %v", *(v.SourceCluster))
+		i++
+	}
+	if v.InclusiveEndMessageID != nil {
+		fields[i] = fmt.Sprintf("InclusiveEndMessageID: %v", *(v.InclusiveEndMessageID))
+		i++
+	}
+	if v.MaximumPageSize != nil {
+		fields[i] = fmt.Sprintf("MaximumPageSize: %v", *(v.MaximumPageSize))
+		i++
+	}
+	if v.NextPageToken != nil {
+		fields[i] = fmt.Sprintf("NextPageToken: %v", v.NextPageToken)
+		i++
+	}
+
+	return fmt.Sprintf("MergeDLQMessagesRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _DLQType_EqualsPtr(lhs, rhs *DLQType) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this MergeDLQMessagesRequest match the
+// provided MergeDLQMessagesRequest.
+//
+// This function performs a deep comparison.
+func (v *MergeDLQMessagesRequest) Equals(rhs *MergeDLQMessagesRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_DLQType_EqualsPtr(v.Type, rhs.Type) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.ShardID, rhs.ShardID) {
+		return false
+	}
+	if !_String_EqualsPtr(v.SourceCluster, rhs.SourceCluster) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.InclusiveEndMessageID, rhs.InclusiveEndMessageID) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.MaximumPageSize, rhs.MaximumPageSize) {
+		return false
+	}
+	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of MergeDLQMessagesRequest.
+func (v *MergeDLQMessagesRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Type != nil {
+		err = multierr.Append(err, enc.AddObject("type", *v.Type))
+	}
+	if v.ShardID != nil {
+		enc.AddInt32("shardID", *v.ShardID)
+	}
+	if v.SourceCluster != nil {
+		enc.AddString("sourceCluster", *v.SourceCluster)
+	}
+	if v.InclusiveEndMessageID != nil {
+		enc.AddInt64("inclusiveEndMessageID", *v.InclusiveEndMessageID)
+	}
+	if v.MaximumPageSize != nil {
+		enc.AddInt32("maximumPageSize", *v.MaximumPageSize)
+	}
+	if v.NextPageToken != nil {
+		enc.AddString("nextPageToken", base64.StdEncoding.EncodeToString(v.NextPageToken))
+	}
+	return err
+}
+
+// GetType returns the value of Type if it is set or its
+// zero value if it is unset.
+func (v *MergeDLQMessagesRequest) GetType() (o DLQType) {
+	if v != nil && v.Type != nil {
+		return *v.Type
+	}
+
+	return
+}
+
+// IsSetType returns true if Type is not nil.
+func (v *MergeDLQMessagesRequest) IsSetType() bool {
+	return v != nil && v.Type != nil
+}
+
+// GetShardID returns the value of ShardID if it is set or its
+// zero value if it is unset.
+func (v *MergeDLQMessagesRequest) GetShardID() (o int32) {
+	if v != nil && v.ShardID != nil {
+		return *v.ShardID
+	}
+
+	return
+}
+
+// IsSetShardID returns true if ShardID is not nil.
+func (v *MergeDLQMessagesRequest) IsSetShardID() bool {
+	return v != nil && v.ShardID != nil
+}
+
+// GetSourceCluster returns the value of SourceCluster if it is set or its
+// zero value if it is unset.
+func (v *MergeDLQMessagesRequest) GetSourceCluster() (o string) {
+	if v != nil && v.SourceCluster != nil {
+		return *v.SourceCluster
+	}
+
+	return
+}
+
+// IsSetSourceCluster returns true if SourceCluster is not nil.
+func (v *MergeDLQMessagesRequest) IsSetSourceCluster() bool {
+	return v != nil && v.SourceCluster != nil
+}
+
+// GetInclusiveEndMessageID returns the value of InclusiveEndMessageID if it is set or its
+// zero value if it is unset.
+func (v *MergeDLQMessagesRequest) GetInclusiveEndMessageID() (o int64) {
+	if v != nil && v.InclusiveEndMessageID != nil {
+		return *v.InclusiveEndMessageID
+	}
+
+	return
+}
+
+// IsSetInclusiveEndMessageID returns true if InclusiveEndMessageID is not nil.
+func (v *MergeDLQMessagesRequest) IsSetInclusiveEndMessageID() bool {
+	return v != nil && v.InclusiveEndMessageID != nil
+}
+
+// GetMaximumPageSize returns the value of MaximumPageSize if it is set or its
+// zero value if it is unset.
+func (v *MergeDLQMessagesRequest) GetMaximumPageSize() (o int32) {
+	if v != nil && v.MaximumPageSize != nil {
+		return *v.MaximumPageSize
+	}
+
+	return
+}
+
+// IsSetMaximumPageSize returns true if MaximumPageSize is not nil.
+func (v *MergeDLQMessagesRequest) IsSetMaximumPageSize() bool {
+	return v != nil && v.MaximumPageSize != nil
+}
+
+// GetNextPageToken returns the value of NextPageToken if it is set or its
+// zero value if it is unset.
+func (v *MergeDLQMessagesRequest) GetNextPageToken() (o []byte) {
+	if v != nil && v.NextPageToken != nil {
+		return v.NextPageToken
+	}
+
+	return
+}
+
+// IsSetNextPageToken returns true if NextPageToken is not nil.
+func (v *MergeDLQMessagesRequest) IsSetNextPageToken() bool {
+	return v != nil && v.NextPageToken != nil
+}
+
+type MergeDLQMessagesResponse struct {
+	NextPageToken []byte `json:"nextPageToken,omitempty"`
+}
+
+// ToWire translates a MergeDLQMessagesResponse struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *MergeDLQMessagesResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.NextPageToken != nil {
+		w, err = wire.NewValueBinary(v.NextPageToken), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a MergeDLQMessagesResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a MergeDLQMessagesResponse struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v MergeDLQMessagesResponse
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *MergeDLQMessagesResponse) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				v.NextPageToken, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a MergeDLQMessagesResponse
+// struct.
+func (v *MergeDLQMessagesResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.NextPageToken != nil {
+		fields[i] = fmt.Sprintf("NextPageToken: %v", v.NextPageToken)
+		i++
+	}
+
+	return fmt.Sprintf("MergeDLQMessagesResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this MergeDLQMessagesResponse match the
+// provided MergeDLQMessagesResponse.
+//
+// This function performs a deep comparison.
+func (v *MergeDLQMessagesResponse) Equals(rhs *MergeDLQMessagesResponse) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of MergeDLQMessagesResponse.
+func (v *MergeDLQMessagesResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.NextPageToken != nil {
+		enc.AddString("nextPageToken", base64.StdEncoding.EncodeToString(v.NextPageToken))
+	}
+	return err
+}
+
+// GetNextPageToken returns the value of NextPageToken if it is set or its
+// zero value if it is unset.
+func (v *MergeDLQMessagesResponse) GetNextPageToken() (o []byte) {
+	if v != nil && v.NextPageToken != nil {
+		return v.NextPageToken
+	}
+
+	return
+}
+
+// IsSetNextPageToken returns true if NextPageToken is not nil.
+func (v *MergeDLQMessagesResponse) IsSetNextPageToken() bool {
+	return v != nil && v.NextPageToken != nil
+}
+
+type PurgeDLQMessagesRequest struct {
+	Type                  *DLQType `json:"type,omitempty"`
+	ShardID               *int32   `json:"shardID,omitempty"`
+	SourceCluster         *string  `json:"sourceCluster,omitempty"`
+	InclusiveEndMessageID *int64   `json:"inclusiveEndMessageID,omitempty"`
+}
+
+// ToWire translates a PurgeDLQMessagesRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *PurgeDLQMessagesRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Type != nil {
+		w, err = v.Type.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.ShardID != nil {
+		w, err = wire.NewValueI32(*(v.ShardID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.SourceCluster != nil {
+		w, err = wire.NewValueString(*(v.SourceCluster)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.InclusiveEndMessageID != nil {
+		w, err = wire.NewValueI64(*(v.InclusiveEndMessageID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a PurgeDLQMessagesRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a PurgeDLQMessagesRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v PurgeDLQMessagesRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *PurgeDLQMessagesRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TI32 {
+				var x DLQType
+				x, err = _DLQType_Read(field.Value)
+				v.Type = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ShardID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.SourceCluster = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.InclusiveEndMessageID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a PurgeDLQMessagesRequest
+// struct.
+func (v *PurgeDLQMessagesRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	if v.Type != nil {
+		fields[i] = fmt.Sprintf("Type: %v", *(v.Type))
+		i++
+	}
+	if v.ShardID != nil {
+		fields[i] = fmt.Sprintf("ShardID: %v", *(v.ShardID))
+		i++
+	}
+	if v.SourceCluster != nil {
+		fields[i] = fmt.Sprintf("SourceCluster: %v", *(v.SourceCluster))
+		i++
+	}
+	if v.InclusiveEndMessageID != nil {
+		fields[i] = fmt.Sprintf("InclusiveEndMessageID: %v", *(v.InclusiveEndMessageID))
+		i++
+	}
+
+	return fmt.Sprintf("PurgeDLQMessagesRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this PurgeDLQMessagesRequest match the
+// provided PurgeDLQMessagesRequest.
+//
+// This function performs a deep comparison.
+func (v *PurgeDLQMessagesRequest) Equals(rhs *PurgeDLQMessagesRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_DLQType_EqualsPtr(v.Type, rhs.Type) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.ShardID, rhs.ShardID) {
+		return false
+	}
+	if !_String_EqualsPtr(v.SourceCluster, rhs.SourceCluster) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.InclusiveEndMessageID, rhs.InclusiveEndMessageID) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of PurgeDLQMessagesRequest.
+func (v *PurgeDLQMessagesRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Type != nil {
+		err = multierr.Append(err, enc.AddObject("type", *v.Type))
+	}
+	if v.ShardID != nil {
+		enc.AddInt32("shardID", *v.ShardID)
+	}
+	if v.SourceCluster != nil {
+		enc.AddString("sourceCluster", *v.SourceCluster)
+	}
+	if v.InclusiveEndMessageID != nil {
+		enc.AddInt64("inclusiveEndMessageID", *v.InclusiveEndMessageID)
+	}
+	return err
+}
+
+// GetType returns the value of Type if it is set or its
+// zero value if it is unset.
+func (v *PurgeDLQMessagesRequest) GetType() (o DLQType) {
+	if v != nil && v.Type != nil {
+		return *v.Type
+	}
+
+	return
+}
+
+// IsSetType returns true if Type is not nil.
+func (v *PurgeDLQMessagesRequest) IsSetType() bool {
+	return v != nil && v.Type != nil
+}
+
+// GetShardID returns the value of ShardID if it is set or its
+// zero value if it is unset.
+func (v *PurgeDLQMessagesRequest) GetShardID() (o int32) {
+	if v != nil && v.ShardID != nil {
+		return *v.ShardID
+	}
+
+	return
+}
+
+// IsSetShardID returns true if ShardID is not nil.
+func (v *PurgeDLQMessagesRequest) IsSetShardID() bool {
+	return v != nil && v.ShardID != nil
+}
+
+// GetSourceCluster returns the value of SourceCluster if it is set or its
+// zero value if it is unset.
+func (v *PurgeDLQMessagesRequest) GetSourceCluster() (o string) {
+	if v != nil && v.SourceCluster != nil {
+		return *v.SourceCluster
+	}
+
+	return
+}
+
+// IsSetSourceCluster returns true if SourceCluster is not nil.
+func (v *PurgeDLQMessagesRequest) IsSetSourceCluster() bool {
+	return v != nil && v.SourceCluster != nil
+}
+
+// GetInclusiveEndMessageID returns the value of InclusiveEndMessageID if it is set or its
+// zero value if it is unset.
+func (v *PurgeDLQMessagesRequest) GetInclusiveEndMessageID() (o int64) {
+	if v != nil && v.InclusiveEndMessageID != nil {
+		return *v.InclusiveEndMessageID
+	}
+
+	return
+}
+
+// IsSetInclusiveEndMessageID returns true if InclusiveEndMessageID is not nil.
+func (v *PurgeDLQMessagesRequest) IsSetInclusiveEndMessageID() bool {
+	return v != nil && v.InclusiveEndMessageID != nil
+}
+
+type ReadDLQMessagesRequest struct {
+	Type                  *DLQType `json:"type,omitempty"`
+	ShardID               *int32   `json:"shardID,omitempty"`
+	SourceCluster         *string  `json:"sourceCluster,omitempty"`
+	InclusiveEndMessageID *int64   `json:"inclusiveEndMessageID,omitempty"`
+	MaximumPageSize       *int32   `json:"maximumPageSize,omitempty"`
+	NextPageToken         []byte   `json:"nextPageToken,omitempty"`
+}
+
+// ToWire translates a ReadDLQMessagesRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ReadDLQMessagesRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [6]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Type != nil {
+		w, err = v.Type.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.ShardID != nil {
+		w, err = wire.NewValueI32(*(v.ShardID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.SourceCluster != nil {
+		w, err = wire.NewValueString(*(v.SourceCluster)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.InclusiveEndMessageID != nil {
+		w, err = wire.NewValueI64(*(v.InclusiveEndMessageID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.MaximumPageSize != nil {
+		w, err = wire.NewValueI32(*(v.MaximumPageSize)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.NextPageToken != nil {
+		w, err = wire.NewValueBinary(v.NextPageToken), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a ReadDLQMessagesRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ReadDLQMessagesRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ReadDLQMessagesRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ReadDLQMessagesRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TI32 {
+				var x DLQType
+				x, err = _DLQType_Read(field.Value)
+				v.Type = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ShardID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.SourceCluster = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.InclusiveEndMessageID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.MaximumPageSize = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TBinary {
+				v.NextPageToken, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ReadDLQMessagesRequest
+// struct.
+func (v *ReadDLQMessagesRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [6]string
+	i := 0
+	if v.Type != nil {
+		fields[i] = fmt.Sprintf("Type: %v", *(v.Type))
+		i++
+	}
+	if v.ShardID != nil {
+		fields[i] = fmt.Sprintf("ShardID: %v", *(v.ShardID))
+		i++
+	}
+	if v.SourceCluster != nil {
+		fields[i] = fmt.Sprintf("SourceCluster: %v", *(v.SourceCluster))
+		i++
+	}
+	if v.InclusiveEndMessageID != nil {
+		fields[i] = fmt.Sprintf("InclusiveEndMessageID: %v", *(v.InclusiveEndMessageID))
+		i++
+	}
+	if v.MaximumPageSize != nil {
+		fields[i] = fmt.Sprintf("MaximumPageSize: %v", *(v.MaximumPageSize))
+		i++
+	}
+	if v.NextPageToken != nil {
+		fields[i] = fmt.Sprintf("NextPageToken: %v", v.NextPageToken)
+		i++
+	}
+
+	return fmt.Sprintf("ReadDLQMessagesRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this ReadDLQMessagesRequest match the
+// provided ReadDLQMessagesRequest.
+//
+// This function performs a deep comparison.
+func (v *ReadDLQMessagesRequest) Equals(rhs *ReadDLQMessagesRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_DLQType_EqualsPtr(v.Type, rhs.Type) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.ShardID, rhs.ShardID) {
+		return false
+	}
+	if !_String_EqualsPtr(v.SourceCluster, rhs.SourceCluster) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.InclusiveEndMessageID, rhs.InclusiveEndMessageID) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.MaximumPageSize, rhs.MaximumPageSize) {
+		return false
+	}
+	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ReadDLQMessagesRequest.
+func (v *ReadDLQMessagesRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Type != nil {
+		err = multierr.Append(err, enc.AddObject("type", *v.Type))
+	}
+	if v.ShardID != nil {
+		enc.AddInt32("shardID", *v.ShardID)
+	}
+	if v.SourceCluster != nil {
+		enc.AddString("sourceCluster", *v.SourceCluster)
+	}
+	if v.InclusiveEndMessageID != nil {
+		enc.AddInt64("inclusiveEndMessageID", *v.InclusiveEndMessageID)
+	}
+	if v.MaximumPageSize != nil {
+		enc.AddInt32("maximumPageSize", *v.MaximumPageSize)
+	}
+	if v.NextPageToken != nil {
+		enc.AddString("nextPageToken", base64.StdEncoding.EncodeToString(v.NextPageToken))
+	}
+	return err
+}
+
+// GetType returns the value of Type if it is set or its
+// zero value if it is unset.
+func (v *ReadDLQMessagesRequest) GetType() (o DLQType) {
+	if v != nil && v.Type != nil {
+		return *v.Type
+	}
+
+	return
+}
+
+// IsSetType returns true if Type is not nil.
+func (v *ReadDLQMessagesRequest) IsSetType() bool {
+	return v != nil && v.Type != nil
+}
+
+// GetShardID returns the value of ShardID if it is set or its
+// zero value if it is unset.
+func (v *ReadDLQMessagesRequest) GetShardID() (o int32) {
+	if v != nil && v.ShardID != nil {
+		return *v.ShardID
+	}
+
+	return
+}
+
+// IsSetShardID returns true if ShardID is not nil.
+func (v *ReadDLQMessagesRequest) IsSetShardID() bool {
+	return v != nil && v.ShardID != nil
+}
+
+// GetSourceCluster returns the value of SourceCluster if it is set or its
+// zero value if it is unset.
+func (v *ReadDLQMessagesRequest) GetSourceCluster() (o string) {
+	if v != nil && v.SourceCluster != nil {
+		return *v.SourceCluster
+	}
+
+	return
+}
+
+// IsSetSourceCluster returns true if SourceCluster is not nil.
+func (v *ReadDLQMessagesRequest) IsSetSourceCluster() bool {
+	return v != nil && v.SourceCluster != nil
+}
+
+// GetInclusiveEndMessageID returns the value of InclusiveEndMessageID if it is set or its
+// zero value if it is unset.
+func (v *ReadDLQMessagesRequest) GetInclusiveEndMessageID() (o int64) {
+	if v != nil && v.InclusiveEndMessageID != nil {
+		return *v.InclusiveEndMessageID
+	}
+
+	return
+}
+
+// IsSetInclusiveEndMessageID returns true if InclusiveEndMessageID is not nil.
+func (v *ReadDLQMessagesRequest) IsSetInclusiveEndMessageID() bool {
+	return v != nil && v.InclusiveEndMessageID != nil
+}
+
+// GetMaximumPageSize returns the value of MaximumPageSize if it is set or its
+// zero value if it is unset.
+func (v *ReadDLQMessagesRequest) GetMaximumPageSize() (o int32) {
+	if v != nil && v.MaximumPageSize != nil {
+		return *v.MaximumPageSize
+	}
+
+	return
+}
+
+// IsSetMaximumPageSize returns true if MaximumPageSize is not nil.
+func (v *ReadDLQMessagesRequest) IsSetMaximumPageSize() bool {
+	return v != nil && v.MaximumPageSize != nil
+}
+
+// GetNextPageToken returns the value of NextPageToken if it is set or its
+// zero value if it is unset.
+func (v *ReadDLQMessagesRequest) GetNextPageToken() (o []byte) {
+	if v != nil && v.NextPageToken != nil {
+		return v.NextPageToken
+	}
+
+	return
+}
+
+// IsSetNextPageToken returns true if NextPageToken is not nil.
+func (v *ReadDLQMessagesRequest) IsSetNextPageToken() bool {
+	return v != nil && v.NextPageToken != nil
+}
+
+type ReadDLQMessagesResponse struct {
+	Type             *DLQType               `json:"type,omitempty"`
+	ReplicationTasks []*ReplicationTaskInfo `json:"replicationTasks,omitempty"`
+	NextPageToken    []byte                 `json:"nextPageToken,omitempty"`
+}
+
+// ToWire translates a ReadDLQMessagesResponse struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ReadDLQMessagesResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Type != nil {
+		w, err = v.Type.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.ReplicationTasks != nil {
+		w, err = wire.NewValueList(_List_ReplicationTaskInfo_ValueList(v.ReplicationTasks)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.NextPageToken != nil {
+		w, err = wire.NewValueBinary(v.NextPageToken), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a ReadDLQMessagesResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ReadDLQMessagesResponse struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ReadDLQMessagesResponse
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ReadDLQMessagesResponse) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TI32 {
+				var x DLQType
+				x, err = _DLQType_Read(field.Value)
+				v.Type = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TList {
+				v.ReplicationTasks, err = _List_ReplicationTaskInfo_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				v.NextPageToken, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ReadDLQMessagesResponse
+// struct.
+func (v *ReadDLQMessagesResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	if v.Type != nil {
+		fields[i] = fmt.Sprintf("Type: %v", *(v.Type))
+		i++
+	}
+	if v.ReplicationTasks != nil {
+		fields[i] = fmt.Sprintf("ReplicationTasks: %v", v.ReplicationTasks)
+		i++
+	}
+	if v.NextPageToken != nil {
+		fields[i] = fmt.Sprintf("NextPageToken: %v", v.NextPageToken)
+		i++
+	}
+
+	return fmt.Sprintf("ReadDLQMessagesResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this ReadDLQMessagesResponse match the
+// provided ReadDLQMessagesResponse.
+//
+// This function performs a deep comparison.
+func (v *ReadDLQMessagesResponse) Equals(rhs *ReadDLQMessagesResponse) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_DLQType_EqualsPtr(v.Type, rhs.Type) {
+		return false
+	}
+	if !((v.ReplicationTasks == nil && rhs.ReplicationTasks == nil) || (v.ReplicationTasks != nil && rhs.ReplicationTasks != nil && _List_ReplicationTaskInfo_Equals(v.ReplicationTasks, rhs.ReplicationTasks))) {
+		return false
+	}
+	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ReadDLQMessagesResponse.
+func (v *ReadDLQMessagesResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Type != nil {
+		err = multierr.Append(err, enc.AddObject("type", *v.Type))
+	}
+	if v.ReplicationTasks != nil {
+		err = multierr.Append(err, enc.AddArray("replicationTasks", (_List_ReplicationTaskInfo_Zapper)(v.ReplicationTasks)))
+	}
+	if v.NextPageToken != nil {
+		enc.AddString("nextPageToken", base64.StdEncoding.EncodeToString(v.NextPageToken))
+	}
+	return err
+}
+
+// GetType returns the value of Type if it is set or its
+// zero value if it is unset.
+func (v *ReadDLQMessagesResponse) GetType() (o DLQType) {
+	if v != nil && v.Type != nil {
+		return *v.Type
+	}
+
+	return
+}
+
+// IsSetType returns true if Type is not nil.
+func (v *ReadDLQMessagesResponse) IsSetType() bool {
+	return v != nil && v.Type != nil
+}
+
+// GetReplicationTasks returns the value of ReplicationTasks if it is set or its
+// zero value if it is unset.
+func (v *ReadDLQMessagesResponse) GetReplicationTasks() (o []*ReplicationTaskInfo) {
+	if v != nil && v.ReplicationTasks != nil {
+		return v.ReplicationTasks
+	}
+
+	return
+}
+
+// IsSetReplicationTasks returns true if ReplicationTasks is not nil.
+func (v *ReadDLQMessagesResponse) IsSetReplicationTasks() bool {
+	return v != nil && v.ReplicationTasks != nil
+}
+
+// GetNextPageToken returns the value of NextPageToken if it is set or its
+// zero value if it is unset.
+func (v *ReadDLQMessagesResponse) GetNextPageToken() (o []byte) {
+	if v != nil && v.NextPageToken != nil {
+		return v.NextPageToken
+	}
+
+	return
+}
+
+// IsSetNextPageToken returns true if NextPageToken is not nil.
+func (v *ReadDLQMessagesResponse) IsSetNextPageToken() bool {
+	return v != nil && v.NextPageToken != nil
+}
+
+type ReplicationError struct {
+	TaskID     *int64  `json:"taskID,omitempty"`
+	DomainID   *string `json:"domainID,omitempty"`
+	WorkflowID *string `json:"workflowID,omitempty"`
+	RunID      *string `json:"runID,omitempty"`
+	Message    *string `json:"message,omitempty"`
+	Timestamp  *int64  `json:"timestamp,omitempty"`
+}
+
+// ToWire translates a ReplicationError struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ReplicationError) ToWire() (wire.Value, error) {
+	var (
+		fields [6]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.TaskID != nil {
+		w, err = wire.NewValueI64(*(v.TaskID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.DomainID != nil {
+		w, err = wire.NewValueString(*(v.DomainID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.WorkflowID != nil {
+		w, err = wire.NewValueString(*(v.WorkflowID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.RunID != nil {
+		w, err = wire.NewValueString(*(v.RunID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.Message != nil {
+		w, err = wire.NewValueString(*(v.Message)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.Timestamp != nil {
+		w, err = wire.NewValueI64(*(v.Timestamp)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a ReplicationError struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ReplicationError struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ReplicationError
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ReplicationError) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.TaskID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.WorkflowID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RunID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Message = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Timestamp = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ReplicationError
+// struct.
+func (v *ReplicationError) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [6]string
+	i := 0
+	if v.TaskID != nil {
+		fields[i] = fmt.Sprintf("TaskID: %v", *(v.TaskID))
+		i++
+	}
+	if v.DomainID != nil {
+		fields[i] = fmt.Sprintf("DomainID: %v", *(v.DomainID))
+		i++
+	}
+	if v.WorkflowID != nil {
+		fields[i] = fmt.Sprintf("WorkflowID: %v", *(v.WorkflowID))
+		i++
+	}
+	if v.RunID != nil {
+		fields[i] = fmt.Sprintf("RunID: %v", *(v.RunID))
+		i++
+	}
+	if v.Message != nil {
+		fields[i] = fmt.Sprintf("Message: %v", *(v.Message))
+		i++
+	}
+	if v.Timestamp != nil {
+		fields[i] = fmt.Sprintf("Timestamp: %v", *(v.Timestamp))
+		i++
+	}
+
+	return fmt.Sprintf("ReplicationError{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this ReplicationError match the
+// provided ReplicationError.
+//
+// This function performs a deep comparison.
+func (v *ReplicationError) Equals(rhs *ReplicationError) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_I64_EqualsPtr(v.TaskID, rhs.TaskID) {
+		return false
+	}
+	if !_String_EqualsPtr(v.DomainID, rhs.DomainID) {
+		return false
+	}
+	if !_String_EqualsPtr(v.WorkflowID, rhs.WorkflowID) {
+		return false
+	}
+	if !_String_EqualsPtr(v.RunID, rhs.RunID) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Message, rhs.Message) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.Timestamp, rhs.Timestamp) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ReplicationError.
+func (v *ReplicationError) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.TaskID != nil {
+		enc.AddInt64("taskID", *v.TaskID)
+	}
+	if v.DomainID != nil {
+		enc.AddString("domainID", *v.DomainID)
+	}
+	if v.WorkflowID != nil {
+		enc.AddString("workflowID", *v.WorkflowID)
+	}
+	if v.RunID != nil {
+		enc.AddString("runID", *v.RunID)
+	}
+	if v.Message != nil {
+		enc.AddString("message", *v.Message)
+	}
+	if v.Timestamp != nil {
+		enc.AddInt64("timestamp", *v.Timestamp)
+	}
+	return err
+}
+
+// GetTaskID returns the value of TaskID if it is set or its
+// zero value if it is unset.
+func (v *ReplicationError) GetTaskID() (o int64) {
+	if v != nil && v.TaskID != nil {
+		return *v.TaskID
+	}
+
+	return
+}
+
+// IsSetTaskID returns true if TaskID is not nil.
+func (v *ReplicationError) IsSetTaskID() bool {
+	return v != nil && v.TaskID != nil
+}
+
+// GetDomainID returns the value of DomainID if it is set or its
+// zero value if it is unset.
+func (v *ReplicationError) GetDomainID() (o string) {
+	if v != nil && v.DomainID != nil {
+		return *v.DomainID
+	}
+
+	return
+}
+
+// IsSetDomainID returns true if DomainID is not nil.
+func (v *ReplicationError) IsSetDomainID() bool {
+	return v != nil && v.DomainID != nil
+}
+
+// GetWorkflowID returns the value of WorkflowID if it is set or its
+// zero value if it is unset.
+func (v *ReplicationError) GetWorkflowID() (o string) {
+	if v != nil && v.WorkflowID != nil {
+		return *v.WorkflowID
+	}
+
+	return
+}
+
+// IsSetWorkflowID returns true if WorkflowID is not nil.
+func (v *ReplicationError) IsSetWorkflowID() bool {
+	return v != nil && v.WorkflowID != nil
+}
+
+// GetRunID returns the value of RunID if it is set or its
+// zero value if it is unset.
+func (v *ReplicationError) GetRunID() (o string) {
+	if v != nil && v.RunID != nil {
+		return *v.RunID
+	}
+
+	return
+}
+
+// IsSetRunID returns true if RunID is not nil.
+func (v *ReplicationError) IsSetRunID() bool {
+	return v != nil && v.RunID != nil
+}
+
+// GetMessage returns the value of Message if it is set or its
+// zero value if it is unset.
+func (v *ReplicationError) GetMessage() (o string) {
+	if v != nil && v.Message != nil {
+		return *v.Message
+	}
+
+	return
+}
+
+// IsSetMessage returns true if Message is not nil.
+func (v *ReplicationError) IsSetMessage() bool {
+	return v != nil && v.Message != nil
+}
+
+// GetTimestamp returns the value of Timestamp if it is set or its
+// zero value if it is unset.
+func (v *ReplicationError) GetTimestamp() (o int64) {
+	if v != nil && v.Timestamp != nil {
+		return *v.Timestamp
+	}
+
+	return
+}
+
+// IsSetTimestamp returns true if Timestamp is not nil.
+func (v *ReplicationError) IsSetTimestamp() bool {
+	return v != nil && v.Timestamp != nil
+}
+
+type ReplicationMessages struct {
+	ReplicationTasks      []*ReplicationTask `json:"replicationTasks,omitempty"`
+	LastRetrivedMessageId *int64             `json:"lastRetrivedMessageId,omitempty"`
+	HasMore               *bool              `json:"hasMore,omitempty"`
+	MaxReadLevel          *int64             `json:"maxReadLevel,omitempty"`
+}
+
+// ToWire translates a ReplicationMessages struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ReplicationMessages) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.ReplicationTasks != nil {
+		w, err = wire.NewValueList(_List_ReplicationTask_ValueList(v.ReplicationTasks)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.LastRetrivedMessageId != nil {
+		w, err = wire.NewValueI64(*(v.LastRetrivedMessageId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.HasMore != nil {
+		w, err = wire.NewValueBool(*(v.HasMore)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.MaxReadLevel != nil {
+		w, err = wire.NewValueI64(*(v.MaxReadLevel)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a ReplicationMessages struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ReplicationMessages struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ReplicationMessages
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ReplicationMessages) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TList {
+				v.ReplicationTasks, err = _List_ReplicationTask_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.LastRetrivedMessageId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.HasMore = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.MaxReadLevel = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ReplicationMessages
+// struct.
+func (v *ReplicationMessages) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	if v.ReplicationTasks != nil {
+		fields[i] = fmt.Sprintf("ReplicationTasks: %v", v.ReplicationTasks)
+		i++
+	}
+	if v.LastRetrivedMessageId != nil {
+		fields[i] = fmt.Sprintf("LastRetrivedMessageId: %v", *(v.LastRetrivedMessageId))
+		i++
+	}
+	if v.HasMore != nil {
+		fields[i] = fmt.Sprintf("HasMore: %v", *(v.HasMore))
+		i++
+	}
+	if v.MaxReadLevel != nil {
+		fields[i] = fmt.Sprintf("MaxReadLevel: %v", *(v.MaxReadLevel))
+		i++
+	}
+
+	return fmt.Sprintf("ReplicationMessages{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this ReplicationMessages match the
+// provided ReplicationMessages.
+//
+// This function performs a deep comparison.
+func (v *ReplicationMessages) Equals(rhs *ReplicationMessages) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.ReplicationTasks == nil && rhs.ReplicationTasks == nil) || (v.ReplicationTasks != nil && rhs.ReplicationTasks != nil && _List_ReplicationTask_Equals(v.ReplicationTasks, rhs.ReplicationTasks))) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.LastRetrivedMessageId, rhs.LastRetrivedMessageId) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.HasMore, rhs.HasMore) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.MaxReadLevel, rhs.MaxReadLevel) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ReplicationMessages.
+func (v *ReplicationMessages) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.ReplicationTasks != nil {
+		err = multierr.Append(err, enc.AddArray("replicationTasks", (_List_ReplicationTask_Zapper)(v.ReplicationTasks)))
+	}
+	if v.LastRetrivedMessageId != nil {
+		enc.AddInt64("lastRetrivedMessageId", *v.LastRetrivedMessageId)
+	}
+	if v.HasMore != nil {
+		enc.AddBool("hasMore", *v.HasMore)
+	}
+	if v.MaxReadLevel != nil {
+		enc.AddInt64("maxReadLevel", *v.MaxReadLevel)
+	}
+	return err
+}
+
+// GetReplicationTasks returns the value of ReplicationTasks if it is set or its
+// zero value if it is unset.
+func (v *ReplicationMessages) GetReplicationTasks() (o []*ReplicationTask) {
+	if v != nil && v.ReplicationTasks != nil {
+		return v.ReplicationTasks
+	}
+
+	return
+}
+
+// IsSetReplicationTasks returns true if ReplicationTasks is not nil.
+func (v *ReplicationMessages) IsSetReplicationTasks() bool {
+	return v != nil && v.ReplicationTasks != nil
+}
+
+// GetLastRetrivedMessageId returns the value of LastRetrivedMessageId if it is set or its
+// zero value if it is unset.
+func (v *ReplicationMessages) GetLastRetrivedMessageId() (o int64) {
+	if v != nil && v.LastRetrivedMessageId != nil {
+		return *v.LastRetrivedMessageId
+	}
+
+	return
+}
+
+// IsSetLastRetrivedMessageId returns true if LastRetrivedMessageId is not nil.
+func (v *ReplicationMessages) IsSetLastRetrivedMessageId() bool {
+	return v != nil && v.LastRetrivedMessageId != nil
+}
+
+// GetHasMore returns the value of HasMore if it is set or its
+// zero value if it is unset.
+func (v *ReplicationMessages) GetHasMore() (o bool) {
+	if v != nil && v.HasMore != nil {
+		return *v.HasMore
+	}
+
+	return
+}
+
+// IsSetHasMore returns true if HasMore is not nil.
+func (v *ReplicationMessages) IsSetHasMore() bool {
+	return v != nil && v.HasMore != nil
+}
+
+// GetMaxReadLevel returns the value of MaxReadLevel if it is set or its
+// zero value if it is unset.
+func (v *ReplicationMessages) GetMaxReadLevel() (o int64) {
+	if v != nil && v.MaxReadLevel != nil {
+		return *v.MaxReadLevel
+	}
+
+	return
+}
+
+// IsSetMaxReadLevel returns true if MaxReadLevel is not nil.
+func (v *ReplicationMessages) IsSetMaxReadLevel() bool {
+	return v != nil && v.MaxReadLevel != nil
+}
+
+type ReplicationTask struct {
+	TaskType                      *ReplicationTaskType           `json:"taskType,omitempty"`
+	SourceTaskId                  *int64                         `json:"sourceTaskId,omitempty"`
+	DomainTaskAttributes          *DomainTaskAttributes          `json:"domainTaskAttributes,omitempty"`
+	HistoryTaskAttributes         *HistoryTaskAttributes         `json:"historyTaskAttributes,omitempty"`
+	SyncShardStatusTaskAttributes *SyncShardStatusTaskAttributes `json:"syncShardStatusTaskAttributes,omitempty"`
+	SyncActicvityTaskAttributes   *SyncActicvityTaskAttributes   `json:"syncActicvityTaskAttributes,omitempty"`
+	HistoryMetadataTaskAttributes *HistoryMetadataTaskAttributes `json:"historyMetadataTaskAttributes,omitempty"`
+	HistoryTaskV2Attributes       *HistoryTaskV2Attributes       `json:"historyTaskV2Attributes,omitempty"`
+}
+
+// ToWire translates a ReplicationTask struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ReplicationTask) ToWire() (wire.Value, error) {
+	var (
+		fields [8]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.TaskType != nil {
+		w, err = v.TaskType.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.SourceTaskId != nil {
+		w, err = wire.NewValueI64(*(v.SourceTaskId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 11, Value: w}
+		i++
+	}
+	if v.DomainTaskAttributes != nil {
+		w, err = v.DomainTaskAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.HistoryTaskAttributes != nil {
+		w, err = v.HistoryTaskAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.SyncShardStatusTaskAttributes != nil {
+		w, err = v.SyncShardStatusTaskAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.SyncActicvityTaskAttributes != nil {
+		w, err = v.SyncActicvityTaskAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.HistoryMetadataTaskAttributes != nil {
+		w, err = v.HistoryMetadataTaskAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	if v.HistoryTaskV2Attributes != nil {
+		w, err = v.HistoryTaskV2Attributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _ReplicationTaskType_Read(w wire.Value) (ReplicationTaskType, error) {
+	var v ReplicationTaskType
+	err := v.FromWire(w)
+	return v, err
+}
+
+func _DomainTaskAttributes_Read(w wire.Value) (*DomainTaskAttributes, error) {
+	var v DomainTaskAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _HistoryTaskAttributes_Read(w wire.Value) (*HistoryTaskAttributes, error) {
+	var v HistoryTaskAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _SyncShardStatusTaskAttributes_Read(w wire.Value) (*SyncShardStatusTaskAttributes, error) {
+	var v SyncShardStatusTaskAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _SyncActicvityTaskAttributes_Read(w wire.Value) (*SyncActicvityTaskAttributes, error) {
+	var v SyncActicvityTaskAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _HistoryMetadataTaskAttributes_Read(w wire.Value) (*HistoryMetadataTaskAttributes, error) {
+	var v HistoryMetadataTaskAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _HistoryTaskV2Attributes_Read(w wire.Value) (*HistoryTaskV2Attributes, error) {
+	var v HistoryTaskV2Attributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a ReplicationTask struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ReplicationTask struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ReplicationTask
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ReplicationTask) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TI32 {
+				var x ReplicationTaskType
+				x, err = _ReplicationTaskType_Read(field.Value)
+				v.TaskType = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 11:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.SourceTaskId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.DomainTaskAttributes, err = _DomainTaskAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TStruct {
+				v.HistoryTaskAttributes, err = _HistoryTaskAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TStruct {
+				v.SyncShardStatusTaskAttributes, err = _SyncShardStatusTaskAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TStruct {
+				v.SyncActicvityTaskAttributes, err = _SyncActicvityTaskAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TStruct {
+				v.HistoryMetadataTaskAttributes, err = _HistoryMetadataTaskAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 70:
+			if field.Value.Type() == wire.TStruct {
+				v.HistoryTaskV2Attributes, err = _HistoryTaskV2Attributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ReplicationTask
+// struct.
+func (v *ReplicationTask) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [8]string
+	i := 0
+	if v.TaskType != nil {
+		fields[i] = fmt.Sprintf("TaskType: %v", *(v.TaskType))
+		i++
+	}
+	if v.SourceTaskId != nil {
+		fields[i] = fmt.Sprintf("SourceTaskId: %v", *(v.SourceTaskId))
+		i++
+	}
+	if v.DomainTaskAttributes != nil {
+		fields[i] = fmt.Sprintf("DomainTaskAttributes: %v", v.DomainTaskAttributes)
+		i++
+	}
+	if v.HistoryTaskAttributes != nil {
+		fields[i] = fmt.Sprintf("HistoryTaskAttributes: %v", v.HistoryTaskAttributes)
+		i++
+	}
+	if v.SyncShardStatusTaskAttributes != nil {
+		fields[i] = fmt.Sprintf("SyncShardStatusTaskAttributes: %v", v.SyncShardStatusTaskAttributes)
+		i++
+	}
+	if v.SyncActicvityTaskAttributes != nil {
+		fields[i] = fmt.Sprintf("SyncActicvityTaskAttributes: %v", v.SyncActicvityTaskAttributes)
+		i++
+	}
+	if v.HistoryMetadataTaskAttributes != nil {
+		fields[i] = fmt.Sprintf("HistoryMetadataTaskAttributes: %v", v.HistoryMetadataTaskAttributes)
+		i++
+	}
+	if v.HistoryTaskV2Attributes != nil {
+		fields[i] = fmt.Sprintf("HistoryTaskV2Attributes: %v", v.HistoryTaskV2Attributes)
+		i++
+	}
+
+	return fmt.Sprintf("ReplicationTask{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _ReplicationTaskType_EqualsPtr(lhs, rhs *ReplicationTaskType) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this ReplicationTask match the
+// provided ReplicationTask.
+//
+// This function performs a deep comparison.
+func (v *ReplicationTask) Equals(rhs *ReplicationTask) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_ReplicationTaskType_EqualsPtr(v.TaskType, rhs.TaskType) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.SourceTaskId, rhs.SourceTaskId) {
+		return false
+	}
+	if !((v.DomainTaskAttributes == nil && rhs.DomainTaskAttributes == nil) || (v.DomainTaskAttributes != nil && rhs.DomainTaskAttributes != nil && v.DomainTaskAttributes.Equals(rhs.DomainTaskAttributes))) {
+		return false
+	}
+	if !((v.HistoryTaskAttributes == nil && rhs.HistoryTaskAttributes == nil) || (v.HistoryTaskAttributes != nil && rhs.HistoryTaskAttributes != nil && v.HistoryTaskAttributes.Equals(rhs.HistoryTaskAttributes))) {
+		return false
+	}
+	if !((v.SyncShardStatusTaskAttributes == nil && rhs.SyncShardStatusTaskAttributes == nil) || (v.SyncShardStatusTaskAttributes != nil && rhs.SyncShardStatusTaskAttributes != nil && v.SyncShardStatusTaskAttributes.Equals(rhs.SyncShardStatusTaskAttributes))) {
+		return false
+	}
+	if !((v.SyncActicvityTaskAttributes == nil && rhs.SyncActicvityTaskAttributes == nil) || (v.SyncActicvityTaskAttributes != nil && rhs.SyncActicvityTaskAttributes != nil && v.SyncActicvityTaskAttributes.Equals(rhs.SyncActicvityTaskAttributes))) {
+		return false
+	}
+	if !((v.HistoryMetadataTaskAttributes == nil && rhs.HistoryMetadataTaskAttributes == nil) || (v.HistoryMetadataTaskAttributes != nil && rhs.HistoryMetadataTaskAttributes != nil && v.HistoryMetadataTaskAttributes.Equals(rhs.HistoryMetadataTaskAttributes))) {
+		return false
+	}
+	if !((v.HistoryTaskV2Attributes == nil && rhs.HistoryTaskV2Attributes == nil) || (v.HistoryTaskV2Attributes != nil && rhs.HistoryTaskV2Attributes != nil && v.HistoryTaskV2Attributes.Equals(rhs.HistoryTaskV2Attributes))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ReplicationTask.
+func (v *ReplicationTask) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.TaskType != nil {
+		err = multierr.Append(err, enc.AddObject("taskType", *v.TaskType))
+	}
+	if v.SourceTaskId != nil {
+		enc.AddInt64("sourceTaskId", *v.SourceTaskId)
+	}
+	if v.DomainTaskAttributes != nil {
+		err = multierr.Append(err, enc.AddObject("domainTaskAttributes", v.DomainTaskAttributes))
+	}
+	if v.HistoryTaskAttributes != nil {
+		err = multierr.Append(err, enc.AddObject("historyTaskAttributes", v.HistoryTaskAttributes))
+	}
+	if v.SyncShardStatusTaskAttributes != nil {
+		err = multierr.Append(err, enc.AddObject("syncShardStatusTaskAttributes", v.SyncShardStatusTaskAttributes))
+	}
+	if v.SyncActicvityTaskAttributes != nil {
+		err = multierr.Append(err, enc.AddObject("syncActicvityTaskAttributes", v.SyncActicvityTaskAttributes))
+	}
+	if v.HistoryMetadataTaskAttributes != nil {
+		err = multierr.Append(err, enc.AddObject("historyMetadataTaskAttributes", v.HistoryMetadataTaskAttributes))
+	}
+	if v.HistoryTaskV2Attributes != nil {
+		err = multierr.Append(err, enc.AddObject("historyTaskV2Attributes", v.HistoryTaskV2Attributes))
+	}
+	return err
+}
+
+// GetTaskType returns the value of TaskType if it is set or its
+// zero value if it is unset.
+func (v *ReplicationTask) GetTaskType() (o ReplicationTaskType) {
+	if v != nil && v.TaskType != nil {
+		return *v.TaskType
+	}
+
+	return
+}
+
+// IsSetTaskType returns true if TaskType is not nil.
+func (v *ReplicationTask) IsSetTaskType() bool {
+	return v != nil && v.TaskType != nil
+}
+
+// GetSourceTaskId returns the value of SourceTaskId if it is set or its
+// zero value if it is unset.
+func (v *ReplicationTask) GetSourceTaskId() (o int64) {
+	if v != nil && v.SourceTaskId != nil {
+		return *v.SourceTaskId
+	}
+
+	return
+}
+
+// IsSetSourceTaskId returns true if SourceTaskId is not nil.
+func (v *ReplicationTask) IsSetSourceTaskId() bool {
+	return v != nil && v.SourceTaskId != nil
+}
+
+// GetDomainTaskAttributes returns the value of DomainTaskAttributes if it is set or its
+// zero value if it is unset.
+func (v *ReplicationTask) GetDomainTaskAttributes() (o *DomainTaskAttributes) {
+	if v != nil && v.DomainTaskAttributes != nil {
+		return v.DomainTaskAttributes
+	}
+
+	return
+}
+
+// IsSetDomainTaskAttributes returns true if DomainTaskAttributes is not nil.
+func (v *ReplicationTask) IsSetDomainTaskAttributes() bool {
+	return v != nil && v.DomainTaskAttributes != nil
+}
+
+// GetHistoryTaskAttributes returns the value of HistoryTaskAttributes if it is set or its
+// zero value if it is unset.
+func (v *ReplicationTask) GetHistoryTaskAttributes() (o *HistoryTaskAttributes) {
+	if v != nil && v.HistoryTaskAttributes != nil {
+		return v.HistoryTaskAttributes
+	}
+
+	return
+}
+
+// IsSetHistoryTaskAttributes returns true if HistoryTaskAttributes is not nil.
+func (v *ReplicationTask) IsSetHistoryTaskAttributes() bool {
+	return v != nil && v.HistoryTaskAttributes != nil
+}
+
+// GetSyncShardStatusTaskAttributes returns the value of SyncShardStatusTaskAttributes if it is set or its
+// zero value if it is unset.
+func (v *ReplicationTask) GetSyncShardStatusTaskAttributes() (o *SyncShardStatusTaskAttributes) {
+	if v != nil && v.SyncShardStatusTaskAttributes != nil {
+		return v.SyncShardStatusTaskAttributes
+	}
+
+	return
+}
+
+// IsSetSyncShardStatusTaskAttributes returns true if SyncShardStatusTaskAttributes is not nil.
+func (v *ReplicationTask) IsSetSyncShardStatusTaskAttributes() bool {
+	return v != nil && v.SyncShardStatusTaskAttributes != nil
+}
+
+// GetSyncActicvityTaskAttributes returns the value of SyncActicvityTaskAttributes if it is set or its
+// zero value if it is unset.
+func (v *ReplicationTask) GetSyncActicvityTaskAttributes() (o *SyncActicvityTaskAttributes) {
+	if v != nil && v.SyncActicvityTaskAttributes != nil {
+		return v.SyncActicvityTaskAttributes
+	}
+
+	return
+}
+
+// IsSetSyncActicvityTaskAttributes returns true if SyncActicvityTaskAttributes is not nil.
+func (v *ReplicationTask) IsSetSyncActicvityTaskAttributes() bool {
+	return v != nil && v.SyncActicvityTaskAttributes != nil
+}
+
+// GetHistoryMetadataTaskAttributes returns the value of HistoryMetadataTaskAttributes if it is set or its
+// zero value if it is unset.
+func (v *ReplicationTask) GetHistoryMetadataTaskAttributes() (o *HistoryMetadataTaskAttributes) {
+	if v != nil && v.HistoryMetadataTaskAttributes != nil {
+		return v.HistoryMetadataTaskAttributes
+	}
+
+	return
+}
+
+// IsSetHistoryMetadataTaskAttributes returns true if HistoryMetadataTaskAttributes is not nil.
+func (v *ReplicationTask) IsSetHistoryMetadataTaskAttributes() bool {
+	return v != nil && v.HistoryMetadataTaskAttributes != nil
+}
+
+// GetHistoryTaskV2Attributes returns the value of HistoryTaskV2Attributes if it is set or its
+// zero value if it is unset.
+func (v *ReplicationTask) GetHistoryTaskV2Attributes() (o *HistoryTaskV2Attributes) {
+	if v != nil && v.HistoryTaskV2Attributes != nil {
+		return v.HistoryTaskV2Attributes
+	}
+
+	return
+}
+
+// IsSetHistoryTaskV2Attributes returns true if HistoryTaskV2Attributes is not nil.
+func (v *ReplicationTask) IsSetHistoryTaskV2Attributes() bool {
+	return v != nil && v.HistoryTaskV2Attributes != nil
+}
+
+type ReplicationTaskInfo struct {
+	DomainID     *string `json:"domainID,omitempty"`
+	WorkflowID   *string `json:"workflowID,omitempty"`
+	RunID        *string `json:"runID,omitempty"`
+	TaskType     *int16  `json:"taskType,omitempty"`
+	TaskID       *int64  `json:"taskID,omitempty"`
+	Version      *int64  `json:"version,omitempty"`
+	FirstEventID *int64  `json:"firstEventID,omitempty"`
+	NextEventID  *int64  `json:"nextEventID,omitempty"`
+	ScheduledID  *int64  `json:"scheduledID,omitempty"`
+}
+
+// ToWire translates a ReplicationTaskInfo struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ReplicationTaskInfo) ToWire() (wire.Value, error) {
+	var (
+		fields [9]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.DomainID != nil {
+		w, err = wire.NewValueString(*(v.DomainID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.WorkflowID != nil {
+		w, err = wire.NewValueString(*(v.WorkflowID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.RunID != nil {
+		w, err = wire.NewValueString(*(v.RunID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.TaskType != nil {
+		w, err = wire.NewValueI16(*(v.TaskType)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.TaskID != nil {
+		w, err = wire.NewValueI64(*(v.TaskID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.Version != nil {
+		w, err = wire.NewValueI64(*(v.Version)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	if v.FirstEventID != nil {
+		w, err = wire.NewValueI64(*(v.FirstEventID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
+	if v.NextEventID != nil {
+		w, err = wire.NewValueI64(*(v.NextEventID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
+	if v.ScheduledID != nil {
+		w, err = wire.NewValueI64(*(v.ScheduledID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 90, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a ReplicationTaskInfo struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ReplicationTaskInfo struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ReplicationTaskInfo
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ReplicationTaskInfo) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.WorkflowID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RunID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI16 {
+				var x int16
+				x, err = field.Value.GetI16(), error(nil)
+				v.TaskType = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.TaskID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Version = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 70:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.FirstEventID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 80:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.NextEventID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 90:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.ScheduledID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ReplicationTaskInfo
+// struct.
+func (v *ReplicationTaskInfo) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [9]string
+	i := 0
+	if v.DomainID != nil {
+		fields[i] = fmt.Sprintf("DomainID: %v", *(v.DomainID))
+		i++
+	}
+	if v.WorkflowID != nil {
+		fields[i] = fmt.Sprintf("WorkflowID: %v", *(v.WorkflowID))
+		i++
+	}
+	if v.RunID != nil {
+		fields[i] = fmt.Sprintf("RunID: %v", *(v.RunID))
+		i++
+	}
+	if v.TaskType != nil {
+		fields[i] = fmt.Sprintf("TaskType: %v", *(v.TaskType))
+		i++
+	}
+	if v.TaskID != nil {
+		fields[i] = fmt.Sprintf("TaskID: %v", *(v.TaskID))
+		i++
+	}
+	if v.Version != nil {
+		fields[i] = fmt.Sprintf("Version: %v", *(v.Version))
+		i++
+	}
+	if v.FirstEventID != nil {
+		fields[i] = fmt.Sprintf("FirstEventID: %v", *(v.FirstEventID))
+		i++
+	}
+	if v.NextEventID != nil {
+		fields[i] = fmt.Sprintf("NextEventID: %v", *(v.NextEventID))
+		i++
+	}
+	if v.ScheduledID != nil {
+		fields[i] = fmt.Sprintf("ScheduledID: %v", *(v.ScheduledID))
+		i++
+	}
+
+	return fmt.Sprintf("ReplicationTaskInfo{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _I16_EqualsPtr(lhs, rhs *int16) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this ReplicationTaskInfo match the
+// provided ReplicationTaskInfo.
+//
+// This function performs a deep comparison.
+func (v *ReplicationTaskInfo) Equals(rhs *ReplicationTaskInfo) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.DomainID, rhs.DomainID) {
+		return false
+	}
+	if !_String_EqualsPtr(v.WorkflowID, rhs.WorkflowID) {
+		return false
+	}
+	if !_String_EqualsPtr(v.RunID, rhs.RunID) {
+		return false
+	}
+	if !_I16_EqualsPtr(v.TaskType, rhs.TaskType) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.TaskID, rhs.TaskID) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.Version, rhs.Version) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.FirstEventID, rhs.FirstEventID) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.NextEventID, rhs.NextEventID) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.ScheduledID, rhs.ScheduledID) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ReplicationTaskInfo.
+func (v *ReplicationTaskInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.DomainID != nil {
+		enc.AddString("domainID", *v.DomainID)
+	}
+	if v.WorkflowID != nil {
+		enc.AddString("workflowID", *v.WorkflowID)
+	}
+	if v.RunID != nil {
+		enc.AddString("runID", *v.RunID)
+	}
+	if v.TaskType != nil {
+		enc.AddInt16("taskType", *v.TaskType)
+	}
+	if v.TaskID != nil {
+		enc.AddInt64("taskID", *v.TaskID)
+	}
+	if v.Version != nil {
+		enc.AddInt64("version", *v.Version)
+	}
+	if v.FirstEventID != nil {
+		enc.AddInt64("firstEventID", *v.FirstEventID)
+	}
+	if v.NextEventID != nil {
+		enc.AddInt64("nextEventID", *v.NextEventID)
+	}
+	if v.ScheduledID != nil {
+		enc.AddInt64("scheduledID", *v.ScheduledID)
+	}
+	return err
+}
+
+// GetDomainID returns the value of DomainID if it is set or its
+// zero value if it is unset.
+func (v *ReplicationTaskInfo) GetDomainID() (o string) {
+	if v != nil && v.DomainID != nil {
+		return *v.DomainID
+	}
+
+	return
+}
+
+// IsSetDomainID returns true if DomainID is not nil.
+func (v *ReplicationTaskInfo) IsSetDomainID() bool {
+	return v != nil && v.DomainID != nil
+}
+
+// GetWorkflowID returns the value of WorkflowID if it is set or its
+// zero value if it is unset.
+func (v *ReplicationTaskInfo) GetWorkflowID() (o string) {
+	if v != nil && v.WorkflowID != nil {
+		return *v.WorkflowID
+	}
+
+	return
+}
+
+// IsSetWorkflowID returns true if WorkflowID is not nil.
+func (v *ReplicationTaskInfo) IsSetWorkflowID() bool {
+	return v != nil && v.WorkflowID != nil
+}
+
+// GetRunID returns the value of RunID if it is set or its
+// zero value if it is unset.
+func (v *ReplicationTaskInfo) GetRunID() (o string) {
+	if v != nil && v.RunID != nil {
+		return *v.RunID
+	}
+
+	return
+}
+
+// IsSetRunID returns true if RunID is not nil.
+func (v *ReplicationTaskInfo) IsSetRunID() bool {
+	return v != nil && v.RunID != nil
+}
+
+// GetTaskType returns the value of TaskType if it is set or its
+// zero value if it is unset.
+func (v *ReplicationTaskInfo) GetTaskType() (o int16) {
+	if v != nil && v.TaskType != nil {
+		return *v.TaskType
+	}
+
+	return
+}
+
+// IsSetTaskType returns true if TaskType is not nil.
+func (v *ReplicationTaskInfo) IsSetTaskType() bool {
+	return v != nil && v.TaskType != nil
+}
+
+// GetTaskID returns the value of TaskID if it is set or its
+// zero value if it is unset.
+func (v *ReplicationTaskInfo) GetTaskID() (o int64) {
+	if v != nil && v.TaskID != nil {
+		return *v.TaskID
+	}
+
+	return
+}
+
+// IsSetTaskID returns true if TaskID is not nil.
+func (v *ReplicationTaskInfo) IsSetTaskID() bool {
+	return v != nil && v.TaskID != nil
+}
+
+// GetVersion returns the value of Version if it is set or its
+// zero value if it is unset.
+func (v *ReplicationTaskInfo) GetVersion() (o int64) {
+	if v != nil && v.Version != nil {
+		return *v.Version
+	}
+
+	return
+}
+
+// IsSetVersion returns true if Version is not nil.
+func (v *ReplicationTaskInfo) IsSetVersion() bool {
+	return v != nil && v.Version != nil
+}
+
+// GetFirstEventID returns the value of FirstEventID if it is set or its
+// zero value if it is unset.
+func (v *ReplicationTaskInfo) GetFirstEventID() (o int64) {
+	if v != nil && v.FirstEventID != nil {
+		return *v.FirstEventID
+	}
+
+	return
+}
+
+// IsSetFirstEventID returns true if FirstEventID is not nil.
+func (v *ReplicationTaskInfo) IsSetFirstEventID() bool {
+	return v != nil && v.FirstEventID != nil
+}
+
+// GetNextEventID returns the value of NextEventID if it is set or its
+// zero value if it is unset.
+func (v *ReplicationTaskInfo) GetNextEventID() (o int64) {
+	if v != nil && v.NextEventID != nil {
+		return *v.NextEventID
+	}
+
+	return
+}
+
+// IsSetNextEventID returns true if NextEventID is not nil.
+func (v *ReplicationTaskInfo) IsSetNextEventID() bool {
+	return v != nil && v.NextEventID != nil
+}
+
+// GetScheduledID returns the value of ScheduledID if it is set or its
+// zero value if it is unset.
+func (v *ReplicationTaskInfo) GetScheduledID() (o int64) {
+	if v != nil && v.ScheduledID != nil {
+		return *v.ScheduledID
+	}
+
+	return
+}
+
+// IsSetScheduledID returns true if ScheduledID is not nil.
+func (v *ReplicationTaskInfo) IsSetScheduledID() bool {
+	return v != nil && v.ScheduledID != nil
+}
+
+type ReplicationTaskType int32
+
+const (
+	ReplicationTaskTypeDomain          ReplicationTaskType = 0
+	ReplicationTaskTypeHistory         ReplicationTaskType = 1
+	ReplicationTaskTypeSyncShardStatus ReplicationTaskType = 2
+	ReplicationTaskTypeSyncActivity    ReplicationTaskType = 3
+	ReplicationTaskTypeHistoryMetadata ReplicationTaskType = 4
+	ReplicationTaskTypeHistoryV2       ReplicationTaskType = 5
+)
+
+// ReplicationTaskType_Values returns all recognized values of ReplicationTaskType.
+func ReplicationTaskType_Values() []ReplicationTaskType {
+	return []ReplicationTaskType{
+		ReplicationTaskTypeDomain,
+		ReplicationTaskTypeHistory,
+		ReplicationTaskTypeSyncShardStatus,
+		ReplicationTaskTypeSyncActivity,
+		ReplicationTaskTypeHistoryMetadata,
+		ReplicationTaskTypeHistoryV2,
+	}
+}
+
+// UnmarshalText tries to decode ReplicationTaskType from a byte slice
+// containing its name.
+//
+//   var v ReplicationTaskType
+//   err := v.UnmarshalText([]byte("Domain"))
+func (v *ReplicationTaskType) UnmarshalText(value []byte) error {
+	switch s := string(value); s {
+	case "Domain":
+		*v = ReplicationTaskTypeDomain
+		return nil
+	case "History":
+		*v = ReplicationTaskTypeHistory
+		return nil
+	case "SyncShardStatus":
+		*v = ReplicationTaskTypeSyncShardStatus
+		return nil
 	case "SyncActivity":
 		*v = ReplicationTaskTypeSyncActivity
 		return nil
@@ -4060,187 +7872,449 @@ func (v *ReplicationTaskType) UnmarshalText(value []byte) error {
 		*v = ReplicationTaskTypeHistoryV2
 		return nil
 	default:
-		val, err := strconv.ParseInt(s, 10, 32)
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "ReplicationTaskType", err)
+		}
+		*v = ReplicationTaskType(val)
+		return nil
+	}
+}
+
+// MarshalText encodes ReplicationTaskType to text.
+//
+// If the enum value is recognized, its name is returned. Otherwise,
+// its integer value is returned.
+//
+// This implements the TextMarshaler interface.
+func (v ReplicationTaskType) MarshalText() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return []byte("Domain"), nil
+	case 1:
+		return []byte("History"), nil
+	case 2:
+		return []byte("SyncShardStatus"), nil
+	case 3:
+		return []byte("SyncActivity"), nil
+	case 4:
+		return []byte("HistoryMetadata"), nil
+	case 5:
+		return []byte("HistoryV2"), nil
+	}
+	return []byte(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ReplicationTaskType.
+// Enums are logged as objects, where the value is logged with key "value", and
+// if this value's name is known, the name is logged with key "name".
+func (v ReplicationTaskType) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt32("value", int32(v))
+	switch int32(v) {
+	case 0:
+		enc.AddString("name", "Domain")
+	case 1:
+		enc.AddString("name", "History")
+	case 2:
+		enc.AddString("name", "SyncShardStatus")
+	case 3:
+		enc.AddString("name", "SyncActivity")
+	case 4:
+		enc.AddString("name", "HistoryMetadata")
+	case 5:
+		enc.AddString("name", "HistoryV2")
+	}
+	return nil
+}
+
+// Ptr returns a pointer to this enum value.
+func (v ReplicationTaskType) Ptr() *ReplicationTaskType {
+	return &v
+}
+
+// ToWire translates ReplicationTaskType into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// Enums are represented as 32-bit integers over the wire.
+func (v ReplicationTaskType) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
+}
+
+// FromWire deserializes ReplicationTaskType from its Thrift-level
+// representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TI32)
+//   if err != nil {
+//     return ReplicationTaskType(0), err
+//   }
+//
+//   var v ReplicationTaskType
+//   if err := v.FromWire(x); err != nil {
+//     return ReplicationTaskType(0), err
+//   }
+//   return v, nil
+func (v *ReplicationTaskType) FromWire(w wire.Value) error {
+	*v = (ReplicationTaskType)(w.GetI32())
+	return nil
+}
+
+// String returns a readable string representation of ReplicationTaskType.
+func (v ReplicationTaskType) String() string {
+	w := int32(v)
+	switch w {
+	case 0:
+		return "Domain"
+	case 1:
+		return "History"
+	case 2:
+		return "SyncShardStatus"
+	case 3:
+		return "SyncActivity"
+	case 4:
+		return "HistoryMetadata"
+	case 5:
+		return "HistoryV2"
+	}
+	return fmt.Sprintf("ReplicationTaskType(%d)", w)
+}
+
+// Equals returns true if this ReplicationTaskType value matches the provided
+// value.
+func (v ReplicationTaskType) Equals(rhs ReplicationTaskType) bool {
+	return v == rhs
+}
+
+// MarshalJSON serializes ReplicationTaskType into JSON.
+//
+// If the enum value is recognized, its name is returned. Otherwise,
+// its integer value is returned.
+//
+// This implements json.Marshaler.
+func (v ReplicationTaskType) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return ([]byte)("\"Domain\""), nil
+	case 1:
+		return ([]byte)("\"History\""), nil
+	case 2:
+		return ([]byte)("\"SyncShardStatus\""), nil
+	case 3:
+		return ([]byte)("\"SyncActivity\""), nil
+	case 4:
+		return ([]byte)("\"HistoryMetadata\""), nil
+	case 5:
+		return ([]byte)("\"HistoryV2\""), nil
+	}
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// UnmarshalJSON attempts to decode ReplicationTaskType from its JSON
+// representation.
+//
+// This implementation supports both, numeric and string inputs. If a
+// string is provided, it must be a known enum name.
+//
+// This implements json.Unmarshaler.
+func (v *ReplicationTaskType) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
+	}
+
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
+		if err != nil {
+			return err
+		}
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "ReplicationTaskType")
+		}
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "ReplicationTaskType")
+		}
+		*v = (ReplicationTaskType)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "ReplicationTaskType")
+	}
+}
+
+type ReplicationToken struct {
+	ShardID                *int32 `json:"shardID,omitempty"`
+	LastRetrivedMessageId  *int64 `json:"lastRetrivedMessageId,omitempty"`
+	LastProcessedMessageId *int64 `json:"lastProcessedMessageId,omitempty"`
+}
+
+// ToWire translates a ReplicationToken struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ReplicationToken) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.ShardID != nil {
+		w, err = wire.NewValueI32(*(v.ShardID)), error(nil)
 		if err != nil {
-			return fmt.Errorf("unknown enum value %q for %q: %v", s, "ReplicationTaskType", err)
+			return w, err
 		}
-		*v = ReplicationTaskType(val)
-		return nil
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.LastRetrivedMessageId != nil {
+		w, err = wire.NewValueI64(*(v.LastRetrivedMessageId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.LastProcessedMessageId != nil {
+		w, err = wire.NewValueI64(*(v.LastProcessedMessageId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a ReplicationToken struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ReplicationToken struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ReplicationToken
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ReplicationToken) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ShardID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.LastRetrivedMessageId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.LastProcessedMessageId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ReplicationToken
+// struct.
+func (v *ReplicationToken) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	if v.ShardID != nil {
+		fields[i] = fmt.Sprintf("ShardID: %v", *(v.ShardID))
+		i++
+	}
+	if v.LastRetrivedMessageId != nil {
+		fields[i] = fmt.Sprintf("LastRetrivedMessageId: %v", *(v.LastRetrivedMessageId))
+		i++
+	}
+	if v.LastProcessedMessageId != nil {
+		fields[i] = fmt.Sprintf("LastProcessedMessageId: %v", *(v.LastProcessedMessageId))
+		i++
 	}
+
+	return fmt.Sprintf("ReplicationToken{%v}", strings.Join(fields[:i], ", "))
 }
 
-// MarshalText encodes ReplicationTaskType to text.
-//
-// If the enum value is recognized, its name is returned. Otherwise,
-// its integer value is returned.
+// Equals returns true if all the fields of this ReplicationToken match the
+// provided ReplicationToken.
 //
-// This implements the TextMarshaler interface.
-func (v ReplicationTaskType) MarshalText() ([]byte, error) {
-	switch int32(v) {
-	case 0:
-		return []byte("Domain"), nil
-	case 1:
-		return []byte("History"), nil
-	case 2:
-		return []byte("SyncShardStatus"), nil
-	case 3:
-		return []byte("SyncActivity"), nil
-	case 4:
-		return []byte("HistoryMetadata"), nil
-	case 5:
-		return []byte("HistoryV2"), nil
+// This function performs a deep comparison.
+func (v *ReplicationToken) Equals(rhs *ReplicationToken) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
 	}
-	return []byte(strconv.FormatInt(int64(v), 10)), nil
+	if !_I32_EqualsPtr(v.ShardID, rhs.ShardID) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.LastRetrivedMessageId, rhs.LastRetrivedMessageId) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.LastProcessedMessageId, rhs.LastProcessedMessageId) {
+		return false
+	}
+
+	return true
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of ReplicationTaskType.
-// Enums are logged as objects, where the value is logged with key "value", and
-// if this value's name is known, the name is logged with key "name".
-func (v ReplicationTaskType) MarshalLogObject(enc zapcore.ObjectEncoder) error {
-	enc.AddInt32("value", int32(v))
-	switch int32(v) {
-	case 0:
-		enc.AddString("name", "Domain")
-	case 1:
-		enc.AddString("name", "History")
-	case 2:
-		enc.AddString("name", "SyncShardStatus")
-	case 3:
-		enc.AddString("name", "SyncActivity")
-	case 4:
-		enc.AddString("name", "HistoryMetadata")
-	case 5:
-		enc.AddString("name", "HistoryV2")
+// fast logging of ReplicationToken.
+func (v *ReplicationToken) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
 	}
-	return nil
+	if v.ShardID != nil {
+		enc.AddInt32("shardID", *v.ShardID)
+	}
+	if v.LastRetrivedMessageId != nil {
+		enc.AddInt64("lastRetrivedMessageId", *v.LastRetrivedMessageId)
+	}
+	if v.LastProcessedMessageId != nil {
+		enc.AddInt64("lastProcessedMessageId", *v.LastProcessedMessageId)
+	}
+	return err
 }
 
-// Ptr returns a pointer to this enum value.
-func (v ReplicationTaskType) Ptr() *ReplicationTaskType {
-	return &v
-}
+// GetShardID returns the value of ShardID if it is set or its
+// zero value if it is unset.
+func (v *ReplicationToken) GetShardID() (o int32) {
+	if v != nil && v.ShardID != nil {
+		return *v.ShardID
+	}
 
-// ToWire translates ReplicationTaskType into a Thrift-level intermediate
-// representation. This intermediate representation may be serialized
-// into bytes using a ThriftRW protocol implementation.
-//
-// Enums are represented as 32-bit integers over the wire.
-func (v ReplicationTaskType) ToWire() (wire.Value, error) {
-	return wire.NewValueI32(int32(v)), nil
+	return
 }
 
-// FromWire deserializes ReplicationTaskType from its Thrift-level
-// representation.
-//
-//   x, err := binaryProtocol.Decode(reader, wire.TI32)
-//   if err != nil {
-//     return ReplicationTaskType(0), err
-//   }
-//
-//   var v ReplicationTaskType
-//   if err := v.FromWire(x); err != nil {
-//     return ReplicationTaskType(0), err
-//   }
-//   return v, nil
-func (v *ReplicationTaskType) FromWire(w wire.Value) error {
-	*v = (ReplicationTaskType)(w.GetI32())
-	return nil
+// IsSetShardID returns true if ShardID is not nil.
+func (v *ReplicationToken) IsSetShardID() bool {
+	return v != nil && v.ShardID != nil
 }
 
-// String returns a readable string representation of ReplicationTaskType.
-func (v ReplicationTaskType) String() string {
-	w := int32(v)
-	switch w {
-	case 0:
-		return "Domain"
-	case 1:
-		return "History"
-	case 2:
-		return "SyncShardStatus"
-	case 3:
-		return "SyncActivity"
-	case 4:
-		return "HistoryMetadata"
-	case 5:
-		return "HistoryV2"
+// GetLastRetrivedMessageId returns the value of LastRetrivedMessageId if it is set or its
+// zero value if it is unset.
+func (v *ReplicationToken) GetLastRetrivedMessageId() (o int64) {
+	if v != nil && v.LastRetrivedMessageId != nil {
+		return *v.LastRetrivedMessageId
 	}
-	return fmt.Sprintf("ReplicationTaskType(%d)", w)
+
+	return
 }
 
-// Equals returns true if this ReplicationTaskType value matches the provided
-// value.
-func (v ReplicationTaskType) Equals(rhs ReplicationTaskType) bool {
-	return v == rhs
+// IsSetLastRetrivedMessageId returns true if LastRetrivedMessageId is not nil.
+func (v *ReplicationToken) IsSetLastRetrivedMessageId() bool {
+	return v != nil && v.LastRetrivedMessageId != nil
 }
 
-// MarshalJSON serializes ReplicationTaskType into JSON.
-//
-// If the enum value is recognized, its name is returned. Otherwise,
-// its integer value is returned.
-//
-// This implements json.Marshaler.
-func (v ReplicationTaskType) MarshalJSON() ([]byte, error) {
-	switch int32(v) {
-	case 0:
-		return ([]byte)("\"Domain\""), nil
-	case 1:
-		return ([]byte)("\"History\""), nil
-	case 2:
-		return ([]byte)("\"SyncShardStatus\""), nil
-	case 3:
-		return ([]byte)("\"SyncActivity\""), nil
-	case 4:
-		return ([]byte)("\"HistoryMetadata\""), nil
-	case 5:
-		return ([]byte)("\"HistoryV2\""), nil
+// GetLastProcessedMessageId returns the value of LastProcessedMessageId if it is set or its
+// zero value if it is unset.
+func (v *ReplicationToken) GetLastProcessedMessageId() (o int64) {
+	if v != nil && v.LastProcessedMessageId != nil {
+		return *v.LastProcessedMessageId
 	}
-	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+
+	return
 }
 
-// UnmarshalJSON attempts to decode ReplicationTaskType from its JSON
-// representation.
-//
-// This implementation supports both, numeric and string inputs. If a
-// string is provided, it must be a known enum name.
-//
-// This implements json.Unmarshaler.
-func (v *ReplicationTaskType) UnmarshalJSON(text []byte) error {
-	d := json.NewDecoder(bytes.NewReader(text))
-	d.UseNumber()
-	t, err := d.Token()
-	if err != nil {
-		return err
-	}
+// IsSetLastProcessedMessageId returns true if LastProcessedMessageId is not nil.
+func (v *ReplicationToken) IsSetLastProcessedMessageId() bool {
+	return v != nil && v.LastProcessedMessageId != nil
+}
 
-	switch w := t.(type) {
-	case json.Number:
-		x, err := w.Int64()
+type ShardReplicationStatus struct {
+	ShardID                *int32                     `json:"shardID,omitempty"`
+	LastRetrievedMessageID *int64                     `json:"lastRetrievedMessageID,omitempty"`
+	LastProcessedMessageID *int64                     `json:"lastProcessedMessageID,omitempty"`
+	SourceMaxReadLevel     *int64                     `json:"sourceMaxReadLevel,omitempty"`
+	TaskIDLag              *int64                     `json:"taskIDLag,omitempty"`
+	LastCaughtUpTimestamp  *int64                     `json:"lastCaughtUpTimestamp,omitempty"`
+	WallClockLagInMillis   *int64                     `json:"wallClockLagInMillis,omitempty"`
+	DlqSize                *int64                     `json:"dlqSize,omitempty"`
+	LastError              *ReplicationError          `json:"lastError,omitempty"`
+	Domains                []*DomainReplicationStatus `json:"domains,omitempty"`
+}
+
+type _List_DomainReplicationStatus_ValueList []*DomainReplicationStatus
+
+func (v _List_DomainReplicationStatus_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
 		if err != nil {
 			return err
 		}
-		if x > math.MaxInt32 {
-			return fmt.Errorf("enum overflow from JSON %q for %q", text, "ReplicationTaskType")
-		}
-		if x < math.MinInt32 {
-			return fmt.Errorf("enum underflow from JSON %q for %q", text, "ReplicationTaskType")
+		err = f(w)
+		if err != nil {
+			return err
 		}
-		*v = (ReplicationTaskType)(x)
-		return nil
-	case string:
-		return v.UnmarshalText([]byte(w))
-	default:
-		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "ReplicationTaskType")
 	}
+	return nil
 }
 
-type ReplicationToken struct {
-	ShardID                *int32 `json:"shardID,omitempty"`
-	LastRetrivedMessageId  *int64 `json:"lastRetrivedMessageId,omitempty"`
-	LastProcessedMessageId *int64 `json:"lastProcessedMessageId,omitempty"`
+func (v _List_DomainReplicationStatus_ValueList) Size() int {
+	return len(v)
 }
 
-// ToWire translates a ReplicationToken struct into a Thrift-level intermediate
+func (_List_DomainReplicationStatus_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_DomainReplicationStatus_ValueList) Close() {}
+
+// ToWire translates a ShardReplicationStatus struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -4255,9 +8329,9 @@ type ReplicationToken struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *ReplicationToken) ToWire() (wire.Value, error) {
+func (v *ShardReplicationStatus) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [10]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -4268,34 +8342,114 @@ func (v *ReplicationToken) ToWire() (wire.Value, error) {
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 10, Value: w}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.LastRetrievedMessageID != nil {
+		w, err = wire.NewValueI64(*(v.LastRetrievedMessageID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.LastProcessedMessageID != nil {
+		w, err = wire.NewValueI64(*(v.LastProcessedMessageID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.SourceMaxReadLevel != nil {
+		w, err = wire.NewValueI64(*(v.SourceMaxReadLevel)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.TaskIDLag != nil {
+		w, err = wire.NewValueI64(*(v.TaskIDLag)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.LastCaughtUpTimestamp != nil {
+		w, err = wire.NewValueI64(*(v.LastCaughtUpTimestamp)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
 		i++
 	}
-	if v.LastRetrivedMessageId != nil {
-		w, err = wire.NewValueI64(*(v.LastRetrivedMessageId)), error(nil)
+	if v.WallClockLagInMillis != nil {
+		w, err = wire.NewValueI64(*(v.WallClockLagInMillis)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 20, Value: w}
+		fields[i] = wire.Field{ID: 70, Value: w}
 		i++
 	}
-	if v.LastProcessedMessageId != nil {
-		w, err = wire.NewValueI64(*(v.LastProcessedMessageId)), error(nil)
+	if v.DlqSize != nil {
+		w, err = wire.NewValueI64(*(v.DlqSize)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 30, Value: w}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
+	if v.LastError != nil {
+		w, err = v.LastError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 90, Value: w}
+		i++
+	}
+	if v.Domains != nil {
+		w, err = wire.NewValueList(_List_DomainReplicationStatus_ValueList(v.Domains)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 100, Value: w}
 		i++
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a ReplicationToken struct from its Thrift-level
+func _DomainReplicationStatus_Read(w wire.Value) (*DomainReplicationStatus, error) {
+	var v DomainReplicationStatus
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_DomainReplicationStatus_Read(l wire.ValueList) ([]*DomainReplicationStatus, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*DomainReplicationStatus, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _DomainReplicationStatus_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a ShardReplicationStatus struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a ReplicationToken struct
+// An error is returned if we were unable to build a ShardReplicationStatus struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -4303,12 +8457,12 @@ func (v *ReplicationToken) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v ReplicationToken
+//   var v ShardReplicationStatus
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *ReplicationToken) FromWire(w wire.Value) error {
+func (v *ShardReplicationStatus) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -4327,7 +8481,7 @@ func (v *ReplicationToken) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TI64 {
 				var x int64
 				x, err = field.Value.GetI64(), error(nil)
-				v.LastRetrivedMessageId = &x
+				v.LastRetrievedMessageID = &x
 				if err != nil {
 					return err
 				}
@@ -4337,7 +8491,73 @@ func (v *ReplicationToken) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TI64 {
 				var x int64
 				x, err = field.Value.GetI64(), error(nil)
-				v.LastProcessedMessageId = &x
+				v.LastProcessedMessageID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.SourceMaxReadLevel = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.TaskIDLag = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.LastCaughtUpTimestamp = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 70:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.WallClockLagInMillis = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 80:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.DlqSize = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 90:
+			if field.Value.Type() == wire.TStruct {
+				v.LastError, err = _ReplicationError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 100:
+			if field.Value.Type() == wire.TList {
+				v.Domains, err = _List_DomainReplicationStatus_Read(field.Value.GetList())
 				if err != nil {
 					return err
 				}
@@ -4349,36 +8569,79 @@ func (v *ReplicationToken) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a ReplicationToken
+// String returns a readable string representation of a ShardReplicationStatus
 // struct.
-func (v *ReplicationToken) String() string {
+func (v *ShardReplicationStatus) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [3]string
+	var fields [10]string
 	i := 0
 	if v.ShardID != nil {
 		fields[i] = fmt.Sprintf("ShardID: %v", *(v.ShardID))
 		i++
 	}
-	if v.LastRetrivedMessageId != nil {
-		fields[i] = fmt.Sprintf("LastRetrivedMessageId: %v", *(v.LastRetrivedMessageId))
+	if v.LastRetrievedMessageID != nil {
+		fields[i] = fmt.Sprintf("LastRetrievedMessageID: %v", *(v.LastRetrievedMessageID))
 		i++
 	}
-	if v.LastProcessedMessageId != nil {
-		fields[i] = fmt.Sprintf("LastProcessedMessageId: %v", *(v.LastProcessedMessageId))
+	if v.LastProcessedMessageID != nil {
+		fields[i] = fmt.Sprintf("LastProcessedMessageID: %v", *(v.LastProcessedMessageID))
+		i++
+	}
+	if v.SourceMaxReadLevel != nil {
+		fields[i] = fmt.Sprintf("SourceMaxReadLevel: %v", *(v.SourceMaxReadLevel))
+		i++
+	}
+	if v.TaskIDLag != nil {
+		fields[i] = fmt.Sprintf("TaskIDLag: %v", *(v.TaskIDLag))
+		i++
+	}
+	if v.LastCaughtUpTimestamp != nil {
+		fields[i] = fmt.Sprintf("LastCaughtUpTimestamp: %v", *(v.LastCaughtUpTimestamp))
+		i++
+	}
+	if v.WallClockLagInMillis != nil {
+		fields[i] = fmt.Sprintf("WallClockLagInMillis: %v", *(v.WallClockLagInMillis))
+		i++
+	}
+	if v.DlqSize != nil {
+		fields[i] = fmt.Sprintf("DlqSize: %v", *(v.DlqSize))
+		i++
+	}
+	if v.LastError != nil {
+		fields[i] = fmt.Sprintf("LastError: %v", v.LastError)
+		i++
+	}
+	if v.Domains != nil {
+		fields[i] = fmt.Sprintf("Domains: %v", v.Domains)
 		i++
 	}
 
-	return fmt.Sprintf("ReplicationToken{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("ShardReplicationStatus{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this ReplicationToken match the
-// provided ReplicationToken.
+func _List_DomainReplicationStatus_Equals(lhs, rhs []*DomainReplicationStatus) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this ShardReplicationStatus match the
+// provided ShardReplicationStatus.
 //
 // This function performs a deep comparison.
-func (v *ReplicationToken) Equals(rhs *ReplicationToken) bool {
+func (v *ShardReplicationStatus) Equals(rhs *ShardReplicationStatus) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -4387,37 +8650,90 @@ func (v *ReplicationToken) Equals(rhs *ReplicationToken) bool {
 	if !_I32_EqualsPtr(v.ShardID, rhs.ShardID) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.LastRetrivedMessageId, rhs.LastRetrivedMessageId) {
+	if !_I64_EqualsPtr(v.LastRetrievedMessageID, rhs.LastRetrievedMessageID) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.LastProcessedMessageId, rhs.LastProcessedMessageId) {
+	if !_I64_EqualsPtr(v.LastProcessedMessageID, rhs.LastProcessedMessageID) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.SourceMaxReadLevel, rhs.SourceMaxReadLevel) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.TaskIDLag, rhs.TaskIDLag) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.LastCaughtUpTimestamp, rhs.LastCaughtUpTimestamp) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.WallClockLagInMillis, rhs.WallClockLagInMillis) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.DlqSize, rhs.DlqSize) {
+		return false
+	}
+	if !((v.LastError == nil && rhs.LastError == nil) || (v.LastError != nil && rhs.LastError != nil && v.LastError.Equals(rhs.LastError))) {
+		return false
+	}
+	if !((v.Domains == nil && rhs.Domains == nil) || (v.Domains != nil && rhs.Domains != nil && _List_DomainReplicationStatus_Equals(v.Domains, rhs.Domains))) {
 		return false
 	}
 
 	return true
 }
 
+type _List_DomainReplicationStatus_Zapper []*DomainReplicationStatus
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_DomainReplicationStatus_Zapper.
+func (l _List_DomainReplicationStatus_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of ReplicationToken.
-func (v *ReplicationToken) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of ShardReplicationStatus.
+func (v *ShardReplicationStatus) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
 	if v.ShardID != nil {
 		enc.AddInt32("shardID", *v.ShardID)
 	}
-	if v.LastRetrivedMessageId != nil {
-		enc.AddInt64("lastRetrivedMessageId", *v.LastRetrivedMessageId)
+	if v.LastRetrievedMessageID != nil {
+		enc.AddInt64("lastRetrievedMessageID", *v.LastRetrievedMessageID)
 	}
-	if v.LastProcessedMessageId != nil {
-		enc.AddInt64("lastProcessedMessageId", *v.LastProcessedMessageId)
+	if v.LastProcessedMessageID != nil {
+		enc.AddInt64("lastProcessedMessageID", *v.LastProcessedMessageID)
+	}
+	if v.SourceMaxReadLevel != nil {
+		enc.AddInt64("sourceMaxReadLevel", *v.SourceMaxReadLevel)
+	}
+	if v.TaskIDLag != nil {
+		enc.AddInt64("taskIDLag", *v.TaskIDLag)
+	}
+	if v.LastCaughtUpTimestamp != nil {
+		enc.AddInt64("lastCaughtUpTimestamp", *v.LastCaughtUpTimestamp)
+	}
+	if v.WallClockLagInMillis != nil {
+		enc.AddInt64("wallClockLagInMillis", *v.WallClockLagInMillis)
+	}
+	if v.DlqSize != nil {
+		enc.AddInt64("dlqSize", *v.DlqSize)
+	}
+	if v.LastError != nil {
+		err = multierr.Append(err, enc.AddObject("lastError", v.LastError))
+	}
+	if v.Domains != nil {
+		err = multierr.Append(err, enc.AddArray("domains", (_List_DomainReplicationStatus_Zapper)(v.Domains)))
 	}
 	return err
 }
 
 // GetShardID returns the value of ShardID if it is set or its
 // zero value if it is unset.
-func (v *ReplicationToken) GetShardID() (o int32) {
+func (v *ShardReplicationStatus) GetShardID() (o int32) {
 	if v != nil && v.ShardID != nil {
 		return *v.ShardID
 	}
@@ -4426,38 +8742,143 @@ func (v *ReplicationToken) GetShardID() (o int32) {
 }
 
 // IsSetShardID returns true if ShardID is not nil.
-func (v *ReplicationToken) IsSetShardID() bool {
+func (v *ShardReplicationStatus) IsSetShardID() bool {
 	return v != nil && v.ShardID != nil
 }
 
-// GetLastRetrivedMessageId returns the value of LastRetrivedMessageId if it is set or its
+// GetLastRetrievedMessageID returns the value of LastRetrievedMessageID if it is set or its
 // zero value if it is unset.
-func (v *ReplicationToken) GetLastRetrivedMessageId() (o int64) {
-	if v != nil && v.LastRetrivedMessageId != nil {
-		return *v.LastRetrivedMessageId
+func (v *ShardReplicationStatus) GetLastRetrievedMessageID() (o int64) {
+	if v != nil && v.LastRetrievedMessageID != nil {
+		return *v.LastRetrievedMessageID
 	}
 
 	return
 }
 
-// IsSetLastRetrivedMessageId returns true if LastRetrivedMessageId is not nil.
-func (v *ReplicationToken) IsSetLastRetrivedMessageId() bool {
-	return v != nil && v.LastRetrivedMessageId != nil
+// IsSetLastRetrievedMessageID returns true if LastRetrievedMessageID is not nil.
+func (v *ShardReplicationStatus) IsSetLastRetrievedMessageID() bool {
+	return v != nil && v.LastRetrievedMessageID != nil
 }
 
-// GetLastProcessedMessageId returns the value of LastProcessedMessageId if it is set or its
+// GetLastProcessedMessageID returns the value of LastProcessedMessageID if it is set or its
 // zero value if it is unset.
-func (v *ReplicationToken) GetLastProcessedMessageId() (o int64) {
-	if v != nil && v.LastProcessedMessageId != nil {
-		return *v.LastProcessedMessageId
+func (v *ShardReplicationStatus) GetLastProcessedMessageID() (o int64) {
+	if v != nil && v.LastProcessedMessageID != nil {
+		return *v.LastProcessedMessageID
 	}
 
 	return
 }
 
-// IsSetLastProcessedMessageId returns true if LastProcessedMessageId is not nil.
-func (v *ReplicationToken) IsSetLastProcessedMessageId() bool {
-	return v != nil && v.LastProcessedMessageId != nil
+// IsSetLastProcessedMessageID returns true if LastProcessedMessageID is not nil.
+func (v *ShardReplicationStatus) IsSetLastProcessedMessageID() bool {
+	return v != nil && v.LastProcessedMessageID != nil
+}
+
+// GetSourceMaxReadLevel returns the value of SourceMaxReadLevel if it is set or its
+// zero value if it is unset.
+func (v *ShardReplicationStatus) GetSourceMaxReadLevel() (o int64) {
+	if v != nil && v.SourceMaxReadLevel != nil {
+		return *v.SourceMaxReadLevel
+	}
+
+	return
+}
+
+// IsSetSourceMaxReadLevel returns true if SourceMaxReadLevel is not nil.
+func (v *ShardReplicationStatus) IsSetSourceMaxReadLevel() bool {
+	return v != nil && v.SourceMaxReadLevel != nil
+}
+
+// GetTaskIDLag returns the value of TaskIDLag if it is set or its
+// zero value if it is unset.
+func (v *ShardReplicationStatus) GetTaskIDLag() (o int64) {
+	if v != nil && v.TaskIDLag != nil {
+		return *v.TaskIDLag
+	}
+
+	return
+}
+
+// IsSetTaskIDLag returns true if TaskIDLag is not nil.
+func (v *ShardReplicationStatus) IsSetTaskIDLag() bool {
+	return v != nil && v.TaskIDLag != nil
+}
+
+// GetLastCaughtUpTimestamp returns the value of LastCaughtUpTimestamp if it is set or its
+// zero value if it is unset.
+func (v *ShardReplicationStatus) GetLastCaughtUpTimestamp() (o int64) {
+	if v != nil && v.LastCaughtUpTimestamp != nil {
+		return *v.LastCaughtUpTimestamp
+	}
+
+	return
+}
+
+// IsSetLastCaughtUpTimestamp returns true if LastCaughtUpTimestamp is not nil.
+func (v *ShardReplicationStatus) IsSetLastCaughtUpTimestamp() bool {
+	return v != nil && v.LastCaughtUpTimestamp != nil
+}
+
+// GetWallClockLagInMillis returns the value of WallClockLagInMillis if it is set or its
+// zero value if it is unset.
+func (v *ShardReplicationStatus) GetWallClockLagInMillis() (o int64) {
+	if v != nil && v.WallClockLagInMillis != nil {
+		return *v.WallClockLagInMillis
+	}
+
+	return
+}
+
+// IsSetWallClockLagInMillis returns true if WallClockLagInMillis is not nil.
+func (v *ShardReplicationStatus) IsSetWallClockLagInMillis() bool {
+	return v != nil && v.WallClockLagInMillis != nil
+}
+
+// GetDlqSize returns the value of DlqSize if it is set or its
+// zero value if it is unset.
+func (v *ShardReplicationStatus) GetDlqSize() (o int64) {
+	if v != nil && v.DlqSize != nil {
+		return *v.DlqSize
+	}
+
+	return
+}
+
+// IsSetDlqSize returns true if DlqSize is not nil.
+func (v *ShardReplicationStatus) IsSetDlqSize() bool {
+	return v != nil && v.DlqSize != nil
+}
+
+// GetLastError returns the value of LastError if it is set or its
+// zero value if it is unset.
+func (v *ShardReplicationStatus) GetLastError() (o *ReplicationError) {
+	if v != nil && v.LastError != nil {
+		return v.LastError
+	}
+
+	return
+}
+
+// IsSetLastError returns true if LastError is not nil.
+func (v *ShardReplicationStatus) IsSetLastError() bool {
+	return v != nil && v.LastError != nil
+}
+
+// GetDomains returns the value of Domains if it is set or its
+// zero value if it is unset.
+func (v *ShardReplicationStatus) GetDomains() (o []*DomainReplicationStatus) {
+	if v != nil && v.Domains != nil {
+		return v.Domains
+	}
+
+	return
+}
+
+// IsSetDomains returns true if Domains is not nil.
+func (v *ShardReplicationStatus) IsSetDomains() bool {
+	return v != nil && v.Domains != nil
 }
 
 type SyncActicvityTaskAttributes struct {
@@ -5445,11 +9866,11 @@ var ThriftModule = &thriftreflect.ThriftModule{
 	Name:     "replicator",
 	Package:  "github.com/uber/cadence/.gen/go/replicator",
 	FilePath: "replicator.thrift",
-	SHA1:     "9a2a9509660f415205bc72802d2a850a2a438a1b",
+	SHA1:     "4a65b514f2d2cfab9b880902e2f5a832b0d275e3",
 	Includes: []*thriftreflect.ThriftModule{
 		shared.ThriftModule,
 	},
 	Raw: rawIDL,
 }
 
-const rawIDL = "// Copyright (c) 2017 Uber Technologies, Inc.\n//\n// Permission is hereby granted, free of charge, to any person obtaining a copy\n// of this software and associated documentation files (the \"Software\"), to deal\n// in the Software without restriction, including without limitation the rights\n// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell\n// copies of the Software, and to permit persons to whom the Software is\n// furnished to do so, subject to the following conditions:\n//\n// The above copyright notice and this permission notice shall be included in\n// all copies or substantial portions of the Software.\n//\n// THE SOFTWARE IS PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR\n// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,\n// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE\n// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER\n// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,\n// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN\n// THE SOFTWARE.\n\nnamespace java com.uber.cadence.replicator\n\ninclude \"shared.thrift\"\n\nenum ReplicationTaskType {\n  Domain\n  History\n  SyncShardStatus\n  SyncActivity\n  HistoryMetadata\n  HistoryV2\n}\n\nenum DomainOperation {\n  Create\n  Update\n}\n\nstruct DomainTaskAttributes {\n  05: optional DomainOperation domainOperation\n  10: optional string id\n  20: optional shared.DomainInfo info\n  30: optional shared.DomainConfiguration config\n  40: optional shared.DomainReplicationConfiguration replicationConfig\n  50: optional i64 (js.type = \"Long\") configVersion\n  60: optional i64 (js.type = \"Long\") failoverVersion\n}\n\nstruct HistoryTaskAttributes {\n  05: optional list<string> targetClusters\n  10: optional string domainId\n  20: optional string workflowId\n  30: optional string runId\n  40: optional i64 (js.type = \"Long\") firstEventId\n  50: optional i64 (js.type = \"Long\") nextEventId\n  60: optional i64 (js.type = \"Long\") version\n  70: optional map<string, shared.ReplicationInfo> replicationInfo\n  80: optional shared.History history\n  90: optional shared.History newRunHistory\n  100: optional i32 eventStoreVersion\n  110: optional i32 newRunEventStoreVersion\n  120: optional bool resetWorkflow\n  130: optional bool newRunNDC\n}\n\nstruct HistoryMetadataTaskAttributes {\n  05: optional list<string> targetClusters\n  10: optional string domainId\n  20: optional string workflowId\n  30: optional string runId\n  40: optional i64 (js.type = \"Long\") firstEventId\n  50: optional i64 (js.type = \"Long\") nextEventId\n}\n\nstruct SyncShardStatusTaskAttributes {\n  10: optional string sourceCluster\n  20: optional i64 (js.type = \"Long\") shardId\n  30: optional i64 (js.type = \"Long\") timestamp\n}\n\nstruct SyncActicvityTaskAttributes {\n  10: optional string domainId\n  20: optional string workflowId\n  30: optional string runId\n  40: optional i64 (js.type = \"Long\") version\n  50: optional i64 (js.type = \"Long\") scheduledId\n  60: optional i64 (js.type = \"Long\") scheduledTime\n  70: optional i64 (js.type = \"Long\") startedId\n  80: optional i64 (js.type = \"Long\") startedTime\n  90: optional i64 (js.type = \"Long\") lastHeartbeatTime\n  100: optional binary details\n  110: optional i32 attempt\n  120: optional string lastFailureReason\n  130: optional string lastWorkerIdentity\n  140: optional binary lastFailureDetails\n  150: optional shared.VersionHistory versionHistory\n}\n\nstruct HistoryTaskV2Attributes {\n  05: optional i64 (js.type = \"Long\") taskId\n  10: optional string domainId\n  20: optional string workflowId\n  30: optional string runId\n  40: optional list<shared.VersionHistoryItem> versionHistoryItems\n  50: optional shared.DataBlob events\n  // new run events does not need version history since there is no prior events\n  70: optional shared.DataBlob newRunEvents\n}\n\nstruct ReplicationTask {\n  10: optional ReplicationTaskType taskType\n  11: optional i64 (js.type = \"Long\") sourceTaskId\n  20: optional DomainTaskAttributes domainTaskAttributes\n  30: optional HistoryTaskAttributes historyTaskAttributes  // TODO deprecate once NDC migration is done\n  40: optional SyncShardStatusTaskAttributes syncShardStatusTaskAttributes\n  50: optional SyncActicvityTaskAttributes syncActicvityTaskAttributes\n  60: optional HistoryMetadataTaskAttributes historyMetadataTaskAttributes // TODO deprecate once kafka deprecation is done\n  70: optional HistoryTaskV2Attributes historyTaskV2Attributes\n}\n\nstruct ReplicationToken {\n  10: optional i32 shardID\n  // lastRetrivedMessageId is where the next fetch should begin with\n  20: optional i64 (js.type = \"Long\") lastRetrivedMessageId\n  // lastProcessedMessageId is the last messageId that is processed on the passive side.\n  // This can be different than lastRetrivedMessageId if passive side supports prefetching messages.\n  30: optional i64 (js.type = \"Long\") lastProcessedMessageId\n}\n\nstruct ReplicationMessages {\n  10: optional list<ReplicationTask> replicationTasks\n  // This can be different than the last taskId in the above list, because sender can decide to skip tasks (e.g. for completed workflows).\n  20: optional i64 (js.type = \"Long\") lastRetrivedMessageId\n  30: optional bool hasMore // Hint for flow control\n}\n\nstruct GetReplicationMessagesRequest {\n  10: optional list<ReplicationToken> tokens\n}\n\nstruct GetReplicationMessagesResponse {\n  10: optional map<i32, ReplicationMessages> messagesByShard\n}\n\nstruct GetDomainReplicationMessagesRequest {\n  // lastRetrivedMessageId is where the next fetch should begin with\n  10: optional i64 (js.type = \"Long\") lastRetrivedMessageId\n  // lastProcessedMessageId is the last messageId that is processed on the passive side.\n  // This can be different than lastRetrivedMessageId if passive side supports prefetching messages.\n  20: optional i64 (js.type = \"Long\") lastProcessedMessageId\n  // clusterName is the name of the pulling cluster\n  30: optional string clusterName\n}\n\nstruct GetDomainReplicationMessagesResponse {\n  10: optional ReplicationMessages messages\n}"
+const rawIDL = "// Copyright (c) 2017 Uber Technologies, Inc.\n//\n// Permission is hereby granted, free of charge, to any person obtaining a copy\n// of this software and associated documentation files (the \"Software\"), to deal\n// in the Software without restriction, including without limitation the rights\n// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell\n// copies of the Software, and to permit persons to whom the Software is\n// furnished to do so, subject to the following conditions:\n//\n// The above copyright notice and this permission notice shall be included in\n// all copies or substantial portions of the Software.\n//\n// THE SOFTWARE IS PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR\n// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,\n// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE\n// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER\n// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,\n// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN\n// THE SOFTWARE.\n\nnamespace java com.uber.cadence.replicator\n\ninclude \"shared.thrift\"\n\nenum ReplicationTaskType {\n  Domain\n  History\n  SyncShardStatus\n  SyncActivity\n  HistoryMetadata\n  HistoryV2\n}\n\nenum DomainOperation {\n  Create\n  Update\n}\n\nstruct DomainTaskAttributes {\n  05: optional DomainOperation domainOperation\n  10: optional string id\n  20: optional shared.DomainInfo info\n  30: optional shared.DomainConfiguration config\n  40: optional shared.DomainReplicationConfiguration replicationConfig\n  50: optional i64 (js.type = \"Long\") configVersion\n  60: optional i64 (js.type = \"Long\") failoverVersion\n}\n\nstruct HistoryTaskAttributes {\n  05: optional list<string> targetClusters\n  10: optional string domainId\n  20: optional string workflowId\n  30: optional string runId\n  40: optional i64 (js.type = \"Long\") firstEventId\n  50: optional i64 (js.type = \"Long\") nextEventId\n  60: optional i64 (js.type = \"Long\") version\n  70: optional map<string, shared.ReplicationInfo> replicationInfo\n  80: optional shared.History history\n  90: optional shared.History newRunHistory\n  100: optional i32 eventStoreVersion\n  110: optional i32 newRunEventStoreVersion\n  120: optional bool resetWorkflow\n  130: optional bool newRunNDC\n}\n\nstruct HistoryMetadataTaskAttributes {\n  05: optional list<string> targetClusters\n  10: optional string domainId\n  20: optional string workflowId\n  30: optional string runId\n  40: optional i64 (js.type = \"Long\") firstEventId\n  50: optional i64 (js.type = \"Long\") nextEventId\n}\n\nstruct SyncShardStatusTaskAttributes {\n  10: optional string sourceCluster\n  20: optional i64 (js.type = \"Long\") shardId\n  30: optional i64 (js.type = \"Long\") timestamp\n}\n\nstruct SyncActicvityTaskAttributes {\n  10: optional string domainId\n  20: optional string workflowId\n  30: optional string runId\n  40: optional i64 (js.type = \"Long\") version\n  50: optional i64 (js.type = \"Long\") scheduledId\n  60: optional i64 (js.type = \"Long\") scheduledTime\n  70: optional i64 (js.type = \"Long\") startedId\n  80: optional i64 (js.type = \"Long\") startedTime\n  90: optional i64 (js.type = \"Long\") lastHeartbeatTime\n  100: optional binary details\n  110: optional i32 attempt\n  120: optional string lastFailureReason\n  130: optional string lastWorkerIdentity\n  140: optional binary lastFailureDetails\n  150: optional shared.VersionHistory versionHistory\n}\n\nstruct HistoryTaskV2Attributes {\n  05: optional i64 (js.type = \"Long\") taskId\n  10: optional string domainId\n  20: optional string workflowId\n  30: optional string runId\n  40: optional list<shared.VersionHistoryItem> versionHistoryItems\n  50: optional shared.DataBlob events\n  // new run events does not need version history since there is no prior events\n  70: optional shared.DataBlob newRunEvents\n}\n\nstruct ReplicationTask {\n  10: optional ReplicationTaskType taskType\n  11: optional i64 (js.type = \"Long\") sourceTaskId\n  20: optional DomainTaskAttributes domainTaskAttributes\n  30: optional HistoryTaskAttributes historyTaskAttributes  // TODO deprecate once NDC migration is done\n  40: optional SyncShardStatusTaskAttributes syncShardStatusTaskAttributes\n  50: optional SyncActicvityTaskAttributes syncActicvityTaskAttributes\n  60: optional HistoryMetadataTaskAttributes historyMetadataTaskAttributes // TODO deprecate once kafka deprecation is done\n  70: optional HistoryTaskV2Attributes historyTaskV2Attributes\n}\n\nstruct ReplicationToken {\n  10: optional i32 shardID\n  // lastRetrivedMessageId is where the next fetch should begin with\n  20: optional i64 (js.type = \"Long\") lastRetrivedMessageId\n  // lastProcessedMessageId is the last messageId that is processed on the passive side.\n  // This can be different than lastRetrivedMessageId if passive side supports prefetching messages.\n  30: optional i64 (js.type = \"Long\") lastProcessedMessageId\n}\n\nstruct ReplicationMessages {\n  10: optional list<ReplicationTask> replicationTasks\n  // This can be different than the last taskId in the above list, because sender can decide to skip tasks (e.g. for completed workflows).\n  20: optional i64 (js.type = \"Long\") lastRetrivedMessageId\n  30: optional bool hasMore // Hint for flow control\n  // maxReadLevel is the max task ID the sender could read when serving the request, used to estimate the lag\n  40: optional i64 (js.type = \"Long\") maxReadLevel\n}\n\nstruct GetReplicationMessagesRequest {\n  10: optional list<ReplicationToken> tokens\n  20: optional string clusterName\n}\n\nstruct GetReplicationMessagesResponse {\n  10: optional map<i32, ReplicationMessages> messagesByShard\n}\n\nstruct GetDomainReplicationMessagesRequest {\n  // lastRetrivedMessageId is where the next fetch should begin with\n  10: optional i64 (js.type = \"Long\") lastRetrivedMessageId\n  // lastProcessedMessageId is the last messageId that is processed on the passive side.\n  // This can be different than lastRetrivedMessageId if passive side supports prefetching messages.\n  20: optional i64 (js.type = \"Long\") lastProcessedMessageId\n  // clusterName is the name of the pulling cluster\n  30: optional string clusterName\n}\n\nstruct GetDomainReplicationMessagesResponse {\n  10: optional ReplicationMessages messages\n}\nenum DLQType {\n  Replication,\n}\n\nstruct ReplicationTaskInfo {\n  10: optional string domainID\n  20: optional string workflowID\n  30: optional string runID\n  40: optional i16 taskType\n  50: optional i64 (js.type = \"Long\") taskID\n  60: optional i64 (js.type = \"Long\") version\n  70: optional i64 (js.type = \"Long\") firstEventID\n  80: optional i64 (js.type = \"Long\") nextEventID\n  90: optional i64 (js.type = \"Long\") scheduledID\n}\n\nstruct GetDLQReplicationMessagesRequest {\n  10: optional list<ReplicationTaskInfo> taskInfos\n}\n\nstruct GetDLQReplicationMessagesResponse {\n  10: optional list<ReplicationTask> replicationTasks\n}\n\nstruct ReadDLQMessagesRequest {\n  10: optional DLQType type\n  20: optional i32 shardID\n  30: optional string sourceCluster\n  40: optional i64 (js.type = \"Long\") inclusiveEndMessageID\n  50: optional i32 maximumPageSize\n  60: optional binary nextPageToken\n}\n\nstruct ReadDLQMessagesResponse {\n  10: optional DLQType type\n  20: optional list<ReplicationTaskInfo> replicationTasks\n  30: optional binary nextPageToken\n}\n\nstruct PurgeDLQMessagesRequest {\n  10: optional DLQType type\n  20: optional i32 shardID\n  30: optional string sourceCluster\n  40: optional i64 (js.type = \"Long\") inclusiveEndMessageID\n}\n\nstruct MergeDLQMessagesRequest {\n  10: optional DLQType type\n  20: optional i32 shardID\n  30: optional string sourceCluster\n  40: optional i64 (js.type = \"Long\") inclusiveEndMessageID\n  50: optional i32 maximumPageSize\n  60: optional binary nextPageToken\n}\n\nstruct MergeDLQMessagesResponse {\n  10: optional binary nextPageToken\n}\n\nstruct ReplicationError {\n  10: optional i64 (js.type = \"Long\") taskID\n  20: optional string domainID\n  30: optional string workflowID\n  40: optional string runID\n  50: optional string message\n  60: optional i64 (js.type = \"Long\") timestamp\n}\n\nstruct DomainReplicationStatus {\n  10: optional string domainID\n  20: optional i64 (js.type = \"Long\") lastAppliedTaskID\n  30: optional i64 (js.type = \"Long\") lastAppliedTimestamp\n  40: optional i64 (js.type = \"Long\") appliedTaskCount\n  50: optional i64 (js.type = \"Long\") failedTaskCount\n  60: optional ReplicationError lastError\n}\n\nstruct ShardReplicationStatus {\n  10: optional i32 shardID\n  20: optional i64 (js.type = \"Long\") lastRetrievedMessageID\n  30: optional i64 (js.type = \"Long\") lastProcessedMessageID\n  40: optional i64 (js.type = \"Long\") sourceMaxReadLevel\n  // taskIDLag is the distance in task IDs between the source max read level and the last processed message\n  50: optional i64 (js.type = \"Long\") taskIDLag\n  // lastCaughtUpTimestamp is the last time the shard processed all replication tasks of the source\n  60: optional i64 (js.type = \"Long\") lastCaughtUpTimestamp\n  70: optional i64 (js.type = \"Long\") wallClockLagInMillis\n  80: optional i64 (js.type = \"Long\") dlqSize\n  90: optional ReplicationError lastError\n  100: optional list<DomainReplicationStatus> domains\n}\n\nstruct DescribeReplicationRequest {\n  10: optional string sourceCluster\n  // empty shardIDs means all shards\n  20: optional list<i32> shardIDs\n  // domain is the optional domain name to report the per domain status for\n  30: optional string domain\n  // domainID is resolved from the domain by frontend\n  40: optional string domainID\n}\n\nstruct DescribeReplicationResponse {\n  10: optional string sourceCluster\n  20: optional list<ShardReplicationStatus> shards\n  // missingShardIDs are the shards whose status cannot be retrieved\n  30: optional list<i32> missingShardIDs\n}\n"
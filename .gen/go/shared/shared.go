@@ -1281,6 +1281,7 @@ type ActivityTaskScheduledEventAttributes struct {
 	DecisionTaskCompletedEventId  *int64        `json:"decisionTaskCompletedEventId,omitempty"`
 	RetryPolicy                   *RetryPolicy  `json:"retryPolicy,omitempty"`
 	Header                        *Header       `json:"header,omitempty"`
+	AffinityToken                 *string       `json:"affinityToken,omitempty"`
 }
 
 // ToWire translates a ActivityTaskScheduledEventAttributes struct into a Thrift-level intermediate
@@ -1300,7 +1301,7 @@ type ActivityTaskScheduledEventAttributes struct {
 //   }
 func (v *ActivityTaskScheduledEventAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [12]wire.Field
+		fields [13]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -1402,6 +1403,14 @@ func (v *ActivityTaskScheduledEventAttributes) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 120, Value: w}
 		i++
 	}
+	if v.AffinityToken != nil {
+		w, err = wire.NewValueString(*(v.AffinityToken)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 130, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -1561,6 +1570,16 @@ func (v *ActivityTaskScheduledEventAttributes) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 130:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.AffinityToken = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -1575,7 +1594,7 @@ func (v *ActivityTaskScheduledEventAttributes) String() string {
 		return "<nil>"
 	}
 
-	var fields [12]string
+	var fields [13]string
 	i := 0
 	if v.ActivityId != nil {
 		fields[i] = fmt.Sprintf("ActivityId: %v", *(v.ActivityId))
@@ -1625,6 +1644,10 @@ func (v *ActivityTaskScheduledEventAttributes) String() string {
 		fields[i] = fmt.Sprintf("Header: %v", v.Header)
 		i++
 	}
+	if v.AffinityToken != nil {
+		fields[i] = fmt.Sprintf("AffinityToken: %v", *(v.AffinityToken))
+		i++
+	}
 
 	return fmt.Sprintf("ActivityTaskScheduledEventAttributes{%v}", strings.Join(fields[:i], ", "))
 }
@@ -1685,6 +1708,9 @@ func (v *ActivityTaskScheduledEventAttributes) Equals(rhs *ActivityTaskScheduled
 	if !((v.Header == nil && rhs.Header == nil) || (v.Header != nil && rhs.Header != nil && v.Header.Equals(rhs.Header))) {
 		return false
 	}
+	if !_String_EqualsPtr(v.AffinityToken, rhs.AffinityToken) {
+		return false
+	}
 
 	return true
 }
@@ -1731,6 +1757,9 @@ func (v *ActivityTaskScheduledEventAttributes) MarshalLogObject(enc zapcore.Obje
 	if v.Header != nil {
 		err = multierr.Append(err, enc.AddObject("header", v.Header))
 	}
+	if v.AffinityToken != nil {
+		enc.AddString("affinityToken", *v.AffinityToken)
+	}
 	return err
 }
 
@@ -1914,6 +1943,21 @@ func (v *ActivityTaskScheduledEventAttributes) IsSetHeader() bool {
 	return v != nil && v.Header != nil
 }
 
+// GetAffinityToken returns the value of AffinityToken if it is set or its
+// zero value if it is unset.
+func (v *ActivityTaskScheduledEventAttributes) GetAffinityToken() (o string) {
+	if v != nil && v.AffinityToken != nil {
+		return *v.AffinityToken
+	}
+
+	return
+}
+
+// IsSetAffinityToken returns true if AffinityToken is not nil.
+func (v *ActivityTaskScheduledEventAttributes) IsSetAffinityToken() bool {
+	return v != nil && v.AffinityToken != nil
+}
+
 type ActivityTaskStartedEventAttributes struct {
 	ScheduledEventId *int64  `json:"scheduledEventId,omitempty"`
 	Identity         *string `json:"identity,omitempty"`
@@ -2692,6 +2736,286 @@ func (v *ActivityType) IsSetName() bool {
 	return v != nil && v.Name != nil
 }
 
+type ActivityTypeLimitInfo struct {
+	ActivityType   *string  `json:"activityType,omitempty"`
+	RatePerSecond  *float64 `json:"ratePerSecond,omitempty"`
+	MaxOutstanding *int32   `json:"maxOutstanding,omitempty"`
+	Outstanding    *int32   `json:"outstanding,omitempty"`
+}
+
+// ToWire translates a ActivityTypeLimitInfo struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ActivityTypeLimitInfo) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.ActivityType != nil {
+		w, err = wire.NewValueString(*(v.ActivityType)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.RatePerSecond != nil {
+		w, err = wire.NewValueDouble(*(v.RatePerSecond)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.MaxOutstanding != nil {
+		w, err = wire.NewValueI32(*(v.MaxOutstanding)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.Outstanding != nil {
+		w, err = wire.NewValueI32(*(v.Outstanding)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a ActivityTypeLimitInfo struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ActivityTypeLimitInfo struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ActivityTypeLimitInfo
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ActivityTypeLimitInfo) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ActivityType = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TDouble {
+				var x float64
+				x, err = field.Value.GetDouble(), error(nil)
+				v.RatePerSecond = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.MaxOutstanding = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Outstanding = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ActivityTypeLimitInfo
+// struct.
+func (v *ActivityTypeLimitInfo) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	if v.ActivityType != nil {
+		fields[i] = fmt.Sprintf("ActivityType: %v", *(v.ActivityType))
+		i++
+	}
+	if v.RatePerSecond != nil {
+		fields[i] = fmt.Sprintf("RatePerSecond: %v", *(v.RatePerSecond))
+		i++
+	}
+	if v.MaxOutstanding != nil {
+		fields[i] = fmt.Sprintf("MaxOutstanding: %v", *(v.MaxOutstanding))
+		i++
+	}
+	if v.Outstanding != nil {
+		fields[i] = fmt.Sprintf("Outstanding: %v", *(v.Outstanding))
+		i++
+	}
+
+	return fmt.Sprintf("ActivityTypeLimitInfo{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _Double_EqualsPtr(lhs, rhs *float64) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this ActivityTypeLimitInfo match the
+// provided ActivityTypeLimitInfo.
+//
+// This function performs a deep comparison.
+func (v *ActivityTypeLimitInfo) Equals(rhs *ActivityTypeLimitInfo) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.ActivityType, rhs.ActivityType) {
+		return false
+	}
+	if !_Double_EqualsPtr(v.RatePerSecond, rhs.RatePerSecond) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.MaxOutstanding, rhs.MaxOutstanding) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.Outstanding, rhs.Outstanding) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ActivityTypeLimitInfo.
+func (v *ActivityTypeLimitInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.ActivityType != nil {
+		enc.AddString("activityType", *v.ActivityType)
+	}
+	if v.RatePerSecond != nil {
+		enc.AddFloat64("ratePerSecond", *v.RatePerSecond)
+	}
+	if v.MaxOutstanding != nil {
+		enc.AddInt32("maxOutstanding", *v.MaxOutstanding)
+	}
+	if v.Outstanding != nil {
+		enc.AddInt32("outstanding", *v.Outstanding)
+	}
+	return err
+}
+
+// GetActivityType returns the value of ActivityType if it is set or its
+// zero value if it is unset.
+func (v *ActivityTypeLimitInfo) GetActivityType() (o string) {
+	if v != nil && v.ActivityType != nil {
+		return *v.ActivityType
+	}
+
+	return
+}
+
+// IsSetActivityType returns true if ActivityType is not nil.
+func (v *ActivityTypeLimitInfo) IsSetActivityType() bool {
+	return v != nil && v.ActivityType != nil
+}
+
+// GetRatePerSecond returns the value of RatePerSecond if it is set or its
+// zero value if it is unset.
+func (v *ActivityTypeLimitInfo) GetRatePerSecond() (o float64) {
+	if v != nil && v.RatePerSecond != nil {
+		return *v.RatePerSecond
+	}
+
+	return
+}
+
+// IsSetRatePerSecond returns true if RatePerSecond is not nil.
+func (v *ActivityTypeLimitInfo) IsSetRatePerSecond() bool {
+	return v != nil && v.RatePerSecond != nil
+}
+
+// GetMaxOutstanding returns the value of MaxOutstanding if it is set or its
+// zero value if it is unset.
+func (v *ActivityTypeLimitInfo) GetMaxOutstanding() (o int32) {
+	if v != nil && v.MaxOutstanding != nil {
+		return *v.MaxOutstanding
+	}
+
+	return
+}
+
+// IsSetMaxOutstanding returns true if MaxOutstanding is not nil.
+func (v *ActivityTypeLimitInfo) IsSetMaxOutstanding() bool {
+	return v != nil && v.MaxOutstanding != nil
+}
+
+// GetOutstanding returns the value of Outstanding if it is set or its
+// zero value if it is unset.
+func (v *ActivityTypeLimitInfo) GetOutstanding() (o int32) {
+	if v != nil && v.Outstanding != nil {
+		return *v.Outstanding
+	}
+
+	return
+}
+
+// IsSetOutstanding returns true if Outstanding is not nil.
+func (v *ActivityTypeLimitInfo) IsSetOutstanding() bool {
+	return v != nil && v.Outstanding != nil
+}
+
 type ArchivalStatus int32
 
 const (
@@ -5014,13 +5338,17 @@ func (v *ChildWorkflowExecutionCompletedEventAttributes) IsSetStartedEventId() b
 type ChildWorkflowExecutionFailedCause int32
 
 const (
-	ChildWorkflowExecutionFailedCauseWorkflowAlreadyRunning ChildWorkflowExecutionFailedCause = 0
+	ChildWorkflowExecutionFailedCauseWorkflowAlreadyRunning     ChildWorkflowExecutionFailedCause = 0
+	ChildWorkflowExecutionFailedCauseTargetClusterUnreachable   ChildWorkflowExecutionFailedCause = 1
+	ChildWorkflowExecutionFailedCauseOpenWorkflowsLimitExceeded ChildWorkflowExecutionFailedCause = 2
 )
 
 // ChildWorkflowExecutionFailedCause_Values returns all recognized values of ChildWorkflowExecutionFailedCause.
 func ChildWorkflowExecutionFailedCause_Values() []ChildWorkflowExecutionFailedCause {
 	return []ChildWorkflowExecutionFailedCause{
 		ChildWorkflowExecutionFailedCauseWorkflowAlreadyRunning,
+		ChildWorkflowExecutionFailedCauseTargetClusterUnreachable,
+		ChildWorkflowExecutionFailedCauseOpenWorkflowsLimitExceeded,
 	}
 }
 
@@ -5034,6 +5362,12 @@ func (v *ChildWorkflowExecutionFailedCause) UnmarshalText(value []byte) error {
 	case "WORKFLOW_ALREADY_RUNNING":
 		*v = ChildWorkflowExecutionFailedCauseWorkflowAlreadyRunning
 		return nil
+	case "TARGET_CLUSTER_UNREACHABLE":
+		*v = ChildWorkflowExecutionFailedCauseTargetClusterUnreachable
+		return nil
+	case "OPEN_WORKFLOWS_LIMIT_EXCEEDED":
+		*v = ChildWorkflowExecutionFailedCauseOpenWorkflowsLimitExceeded
+		return nil
 	default:
 		val, err := strconv.ParseInt(s, 10, 32)
 		if err != nil {
@@ -5054,6 +5388,10 @@ func (v ChildWorkflowExecutionFailedCause) MarshalText() ([]byte, error) {
 	switch int32(v) {
 	case 0:
 		return []byte("WORKFLOW_ALREADY_RUNNING"), nil
+	case 1:
+		return []byte("TARGET_CLUSTER_UNREACHABLE"), nil
+	case 2:
+		return []byte("OPEN_WORKFLOWS_LIMIT_EXCEEDED"), nil
 	}
 	return []byte(strconv.FormatInt(int64(v), 10)), nil
 }
@@ -5067,6 +5405,10 @@ func (v ChildWorkflowExecutionFailedCause) MarshalLogObject(enc zapcore.ObjectEn
 	switch int32(v) {
 	case 0:
 		enc.AddString("name", "WORKFLOW_ALREADY_RUNNING")
+	case 1:
+		enc.AddString("name", "TARGET_CLUSTER_UNREACHABLE")
+	case 2:
+		enc.AddString("name", "OPEN_WORKFLOWS_LIMIT_EXCEEDED")
 	}
 	return nil
 }
@@ -5109,6 +5451,10 @@ func (v ChildWorkflowExecutionFailedCause) String() string {
 	switch w {
 	case 0:
 		return "WORKFLOW_ALREADY_RUNNING"
+	case 1:
+		return "TARGET_CLUSTER_UNREACHABLE"
+	case 2:
+		return "OPEN_WORKFLOWS_LIMIT_EXCEEDED"
 	}
 	return fmt.Sprintf("ChildWorkflowExecutionFailedCause(%d)", w)
 }
@@ -5129,6 +5475,10 @@ func (v ChildWorkflowExecutionFailedCause) MarshalJSON() ([]byte, error) {
 	switch int32(v) {
 	case 0:
 		return ([]byte)("\"WORKFLOW_ALREADY_RUNNING\""), nil
+	case 1:
+		return ([]byte)("\"TARGET_CLUSTER_UNREACHABLE\""), nil
+	case 2:
+		return ([]byte)("\"OPEN_WORKFLOWS_LIMIT_EXCEEDED\""), nil
 	}
 	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
 }
@@ -8103,6 +8453,280 @@ func (v *ContinueAsNewWorkflowExecutionDecisionAttributes) IsSetSearchAttributes
 	return v != nil && v.SearchAttributes != nil
 }
 
+type CountWorkflowExecutionsGroup struct {
+	GroupValues map[string][]byte `json:"groupValues,omitempty"`
+	Count       *int64            `json:"count,omitempty"`
+}
+
+type _Map_String_Binary_MapItemList map[string][]byte
+
+func (m _Map_String_Binary_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		if v == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", k)
+		}
+		kw, err := wire.NewValueString(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := wire.NewValueBinary(v), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_String_Binary_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_String_Binary_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_Binary_MapItemList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_Binary_MapItemList) Close() {}
+
+// ToWire translates a CountWorkflowExecutionsGroup struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *CountWorkflowExecutionsGroup) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.GroupValues != nil {
+		w, err = wire.NewValueMap(_Map_String_Binary_MapItemList(v.GroupValues)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Count != nil {
+		w, err = wire.NewValueI64(*(v.Count)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Map_String_Binary_Read(m wire.MapItemList) (map[string][]byte, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make(map[string][]byte, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		v, err := x.Value.GetBinary(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+// FromWire deserializes a CountWorkflowExecutionsGroup struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a CountWorkflowExecutionsGroup struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v CountWorkflowExecutionsGroup
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *CountWorkflowExecutionsGroup) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TMap {
+				v.GroupValues, err = _Map_String_Binary_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Count = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a CountWorkflowExecutionsGroup
+// struct.
+func (v *CountWorkflowExecutionsGroup) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.GroupValues != nil {
+		fields[i] = fmt.Sprintf("GroupValues: %v", v.GroupValues)
+		i++
+	}
+	if v.Count != nil {
+		fields[i] = fmt.Sprintf("Count: %v", *(v.Count))
+		i++
+	}
+
+	return fmt.Sprintf("CountWorkflowExecutionsGroup{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _Map_String_Binary_Equals(lhs, rhs map[string][]byte) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !bytes.Equal(lv, rv) {
+			return false
+		}
+	}
+	return true
+}
+
+// Equals returns true if all the fields of this CountWorkflowExecutionsGroup match the
+// provided CountWorkflowExecutionsGroup.
+//
+// This function performs a deep comparison.
+func (v *CountWorkflowExecutionsGroup) Equals(rhs *CountWorkflowExecutionsGroup) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.GroupValues == nil && rhs.GroupValues == nil) || (v.GroupValues != nil && rhs.GroupValues != nil && _Map_String_Binary_Equals(v.GroupValues, rhs.GroupValues))) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.Count, rhs.Count) {
+		return false
+	}
+
+	return true
+}
+
+type _Map_String_Binary_Zapper map[string][]byte
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_String_Binary_Zapper.
+func (m _Map_String_Binary_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	for k, v := range m {
+		enc.AddString((string)(k), base64.StdEncoding.EncodeToString(v))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of CountWorkflowExecutionsGroup.
+func (v *CountWorkflowExecutionsGroup) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.GroupValues != nil {
+		err = multierr.Append(err, enc.AddObject("groupValues", (_Map_String_Binary_Zapper)(v.GroupValues)))
+	}
+	if v.Count != nil {
+		enc.AddInt64("count", *v.Count)
+	}
+	return err
+}
+
+// GetGroupValues returns the value of GroupValues if it is set or its
+// zero value if it is unset.
+func (v *CountWorkflowExecutionsGroup) GetGroupValues() (o map[string][]byte) {
+	if v != nil && v.GroupValues != nil {
+		return v.GroupValues
+	}
+
+	return
+}
+
+// IsSetGroupValues returns true if GroupValues is not nil.
+func (v *CountWorkflowExecutionsGroup) IsSetGroupValues() bool {
+	return v != nil && v.GroupValues != nil
+}
+
+// GetCount returns the value of Count if it is set or its
+// zero value if it is unset.
+func (v *CountWorkflowExecutionsGroup) GetCount() (o int64) {
+	if v != nil && v.Count != nil {
+		return *v.Count
+	}
+
+	return
+}
+
+// IsSetCount returns true if Count is not nil.
+func (v *CountWorkflowExecutionsGroup) IsSetCount() bool {
+	return v != nil && v.Count != nil
+}
+
 type CountWorkflowExecutionsRequest struct {
 	Domain *string `json:"domain,omitempty"`
 	Query  *string `json:"query,omitempty"`
@@ -8286,9 +8910,40 @@ func (v *CountWorkflowExecutionsRequest) IsSetQuery() bool {
 }
 
 type CountWorkflowExecutionsResponse struct {
-	Count *int64 `json:"count,omitempty"`
+	Count          *int64                          `json:"count,omitempty"`
+	Groups         []*CountWorkflowExecutionsGroup `json:"groups,omitempty"`
+	TruncatedCount *int64                          `json:"truncatedCount,omitempty"`
+}
+
+type _List_CountWorkflowExecutionsGroup_ValueList []*CountWorkflowExecutionsGroup
+
+func (v _List_CountWorkflowExecutionsGroup_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_CountWorkflowExecutionsGroup_ValueList) Size() int {
+	return len(v)
 }
 
+func (_List_CountWorkflowExecutionsGroup_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_CountWorkflowExecutionsGroup_ValueList) Close() {}
+
 // ToWire translates a CountWorkflowExecutionsResponse struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
@@ -8306,7 +8961,7 @@ type CountWorkflowExecutionsResponse struct {
 //   }
 func (v *CountWorkflowExecutionsResponse) ToWire() (wire.Value, error) {
 	var (
-		fields [1]wire.Field
+		fields [3]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -8320,10 +8975,50 @@ func (v *CountWorkflowExecutionsResponse) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
+	if v.Groups != nil {
+		w, err = wire.NewValueList(_List_CountWorkflowExecutionsGroup_ValueList(v.Groups)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.TruncatedCount != nil {
+		w, err = wire.NewValueI64(*(v.TruncatedCount)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
+func _CountWorkflowExecutionsGroup_Read(w wire.Value) (*CountWorkflowExecutionsGroup, error) {
+	var v CountWorkflowExecutionsGroup
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_CountWorkflowExecutionsGroup_Read(l wire.ValueList) ([]*CountWorkflowExecutionsGroup, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*CountWorkflowExecutionsGroup, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _CountWorkflowExecutionsGroup_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
 // FromWire deserializes a CountWorkflowExecutionsResponse struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
@@ -8355,6 +9050,24 @@ func (v *CountWorkflowExecutionsResponse) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 20:
+			if field.Value.Type() == wire.TList {
+				v.Groups, err = _List_CountWorkflowExecutionsGroup_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.TruncatedCount = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -8369,16 +9082,39 @@ func (v *CountWorkflowExecutionsResponse) String() string {
 		return "<nil>"
 	}
 
-	var fields [1]string
+	var fields [3]string
 	i := 0
 	if v.Count != nil {
 		fields[i] = fmt.Sprintf("Count: %v", *(v.Count))
 		i++
 	}
+	if v.Groups != nil {
+		fields[i] = fmt.Sprintf("Groups: %v", v.Groups)
+		i++
+	}
+	if v.TruncatedCount != nil {
+		fields[i] = fmt.Sprintf("TruncatedCount: %v", *(v.TruncatedCount))
+		i++
+	}
 
 	return fmt.Sprintf("CountWorkflowExecutionsResponse{%v}", strings.Join(fields[:i], ", "))
 }
 
+func _List_CountWorkflowExecutionsGroup_Equals(lhs, rhs []*CountWorkflowExecutionsGroup) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // Equals returns true if all the fields of this CountWorkflowExecutionsResponse match the
 // provided CountWorkflowExecutionsResponse.
 //
@@ -8392,10 +9128,27 @@ func (v *CountWorkflowExecutionsResponse) Equals(rhs *CountWorkflowExecutionsRes
 	if !_I64_EqualsPtr(v.Count, rhs.Count) {
 		return false
 	}
+	if !((v.Groups == nil && rhs.Groups == nil) || (v.Groups != nil && rhs.Groups != nil && _List_CountWorkflowExecutionsGroup_Equals(v.Groups, rhs.Groups))) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.TruncatedCount, rhs.TruncatedCount) {
+		return false
+	}
 
 	return true
 }
 
+type _List_CountWorkflowExecutionsGroup_Zapper []*CountWorkflowExecutionsGroup
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_CountWorkflowExecutionsGroup_Zapper.
+func (l _List_CountWorkflowExecutionsGroup_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of CountWorkflowExecutionsResponse.
 func (v *CountWorkflowExecutionsResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -8405,6 +9158,12 @@ func (v *CountWorkflowExecutionsResponse) MarshalLogObject(enc zapcore.ObjectEnc
 	if v.Count != nil {
 		enc.AddInt64("count", *v.Count)
 	}
+	if v.Groups != nil {
+		err = multierr.Append(err, enc.AddArray("groups", (_List_CountWorkflowExecutionsGroup_Zapper)(v.Groups)))
+	}
+	if v.TruncatedCount != nil {
+		enc.AddInt64("truncatedCount", *v.TruncatedCount)
+	}
 	return err
 }
 
@@ -8423,6 +9182,36 @@ func (v *CountWorkflowExecutionsResponse) IsSetCount() bool {
 	return v != nil && v.Count != nil
 }
 
+// GetGroups returns the value of Groups if it is set or its
+// zero value if it is unset.
+func (v *CountWorkflowExecutionsResponse) GetGroups() (o []*CountWorkflowExecutionsGroup) {
+	if v != nil && v.Groups != nil {
+		return v.Groups
+	}
+
+	return
+}
+
+// IsSetGroups returns true if Groups is not nil.
+func (v *CountWorkflowExecutionsResponse) IsSetGroups() bool {
+	return v != nil && v.Groups != nil
+}
+
+// GetTruncatedCount returns the value of TruncatedCount if it is set or its
+// zero value if it is unset.
+func (v *CountWorkflowExecutionsResponse) GetTruncatedCount() (o int64) {
+	if v != nil && v.TruncatedCount != nil {
+		return *v.TruncatedCount
+	}
+
+	return
+}
+
+// IsSetTruncatedCount returns true if TruncatedCount is not nil.
+func (v *CountWorkflowExecutionsResponse) IsSetTruncatedCount() bool {
+	return v != nil && v.TruncatedCount != nil
+}
+
 type CurrentBranchChangedError struct {
 	Message            string `json:"message,required"`
 	CurrentBranchToken []byte `json:"currentBranchToken,required"`
@@ -9912,6 +10701,9 @@ const (
 	DecisionTaskFailedCauseBadBinary                                           DecisionTaskFailedCause = 20
 	DecisionTaskFailedCauseScheduleActivityDuplicateID                         DecisionTaskFailedCause = 21
 	DecisionTaskFailedCauseBadSearchAttributes                                 DecisionTaskFailedCause = 22
+	DecisionTaskFailedCausePendingActivitiesLimitExceeded                      DecisionTaskFailedCause = 23
+	DecisionTaskFailedCausePendingChildWorkflowsLimitExceeded                  DecisionTaskFailedCause = 24
+	DecisionTaskFailedCausePendingTimersLimitExceeded                          DecisionTaskFailedCause = 25
 )
 
 // DecisionTaskFailedCause_Values returns all recognized values of DecisionTaskFailedCause.
@@ -9940,6 +10732,9 @@ func DecisionTaskFailedCause_Values() []DecisionTaskFailedCause {
 		DecisionTaskFailedCauseBadBinary,
 		DecisionTaskFailedCauseScheduleActivityDuplicateID,
 		DecisionTaskFailedCauseBadSearchAttributes,
+		DecisionTaskFailedCausePendingActivitiesLimitExceeded,
+		DecisionTaskFailedCausePendingChildWorkflowsLimitExceeded,
+		DecisionTaskFailedCausePendingTimersLimitExceeded,
 	}
 }
 
@@ -10019,6 +10814,15 @@ func (v *DecisionTaskFailedCause) UnmarshalText(value []byte) error {
 	case "BAD_SEARCH_ATTRIBUTES":
 		*v = DecisionTaskFailedCauseBadSearchAttributes
 		return nil
+	case "PENDING_ACTIVITIES_LIMIT_EXCEEDED":
+		*v = DecisionTaskFailedCausePendingActivitiesLimitExceeded
+		return nil
+	case "PENDING_CHILD_WORKFLOWS_LIMIT_EXCEEDED":
+		*v = DecisionTaskFailedCausePendingChildWorkflowsLimitExceeded
+		return nil
+	case "PENDING_TIMERS_LIMIT_EXCEEDED":
+		*v = DecisionTaskFailedCausePendingTimersLimitExceeded
+		return nil
 	default:
 		val, err := strconv.ParseInt(s, 10, 32)
 		if err != nil {
@@ -10083,6 +10887,12 @@ func (v DecisionTaskFailedCause) MarshalText() ([]byte, error) {
 		return []byte("SCHEDULE_ACTIVITY_DUPLICATE_ID"), nil
 	case 22:
 		return []byte("BAD_SEARCH_ATTRIBUTES"), nil
+	case 23:
+		return []byte("PENDING_ACTIVITIES_LIMIT_EXCEEDED"), nil
+	case 24:
+		return []byte("PENDING_CHILD_WORKFLOWS_LIMIT_EXCEEDED"), nil
+	case 25:
+		return []byte("PENDING_TIMERS_LIMIT_EXCEEDED"), nil
 	}
 	return []byte(strconv.FormatInt(int64(v), 10)), nil
 }
@@ -10140,6 +10950,12 @@ func (v DecisionTaskFailedCause) MarshalLogObject(enc zapcore.ObjectEncoder) err
 		enc.AddString("name", "SCHEDULE_ACTIVITY_DUPLICATE_ID")
 	case 22:
 		enc.AddString("name", "BAD_SEARCH_ATTRIBUTES")
+	case 23:
+		enc.AddString("name", "PENDING_ACTIVITIES_LIMIT_EXCEEDED")
+	case 24:
+		enc.AddString("name", "PENDING_CHILD_WORKFLOWS_LIMIT_EXCEEDED")
+	case 25:
+		enc.AddString("name", "PENDING_TIMERS_LIMIT_EXCEEDED")
 	}
 	return nil
 }
@@ -10226,6 +11042,12 @@ func (v DecisionTaskFailedCause) String() string {
 		return "SCHEDULE_ACTIVITY_DUPLICATE_ID"
 	case 22:
 		return "BAD_SEARCH_ATTRIBUTES"
+	case 23:
+		return "PENDING_ACTIVITIES_LIMIT_EXCEEDED"
+	case 24:
+		return "PENDING_CHILD_WORKFLOWS_LIMIT_EXCEEDED"
+	case 25:
+		return "PENDING_TIMERS_LIMIT_EXCEEDED"
 	}
 	return fmt.Sprintf("DecisionTaskFailedCause(%d)", w)
 }
@@ -10290,6 +11112,12 @@ func (v DecisionTaskFailedCause) MarshalJSON() ([]byte, error) {
 		return ([]byte)("\"SCHEDULE_ACTIVITY_DUPLICATE_ID\""), nil
 	case 22:
 		return ([]byte)("\"BAD_SEARCH_ATTRIBUTES\""), nil
+	case 23:
+		return ([]byte)("\"PENDING_ACTIVITIES_LIMIT_EXCEEDED\""), nil
+	case 24:
+		return ([]byte)("\"PENDING_CHILD_WORKFLOWS_LIMIT_EXCEEDED\""), nil
+	case 25:
+		return ([]byte)("\"PENDING_TIMERS_LIMIT_EXCEEDED\""), nil
 	}
 	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
 }
@@ -12194,8 +13022,39 @@ type DescribeDomainResponse struct {
 	ReplicationConfiguration *DomainReplicationConfiguration `json:"replicationConfiguration,omitempty"`
 	FailoverVersion          *int64                          `json:"failoverVersion,omitempty"`
 	IsGlobalDomain           *bool                           `json:"isGlobalDomain,omitempty"`
+	FailoverInfo             *DomainFailoverInfo             `json:"failoverInfo,omitempty"`
+	FailoverHistory          []*DomainFailoverRecord         `json:"failoverHistory,omitempty"`
+}
+
+type _List_DomainFailoverRecord_ValueList []*DomainFailoverRecord
+
+func (v _List_DomainFailoverRecord_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_DomainFailoverRecord_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_DomainFailoverRecord_ValueList) ValueType() wire.Type {
+	return wire.TStruct
 }
 
+func (_List_DomainFailoverRecord_ValueList) Close() {}
+
 // ToWire translates a DescribeDomainResponse struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
@@ -12213,7 +13072,7 @@ type DescribeDomainResponse struct {
 //   }
 func (v *DescribeDomainResponse) ToWire() (wire.Value, error) {
 	var (
-		fields [5]wire.Field
+		fields [7]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -12259,6 +13118,22 @@ func (v *DescribeDomainResponse) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 50, Value: w}
 		i++
 	}
+	if v.FailoverInfo != nil {
+		w, err = v.FailoverInfo.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	if v.FailoverHistory != nil {
+		w, err = wire.NewValueList(_List_DomainFailoverRecord_ValueList(v.FailoverHistory)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -12281,6 +13156,36 @@ func _DomainReplicationConfiguration_Read(w wire.Value) (*DomainReplicationConfi
 	return &v, err
 }
 
+func _DomainFailoverInfo_Read(w wire.Value) (*DomainFailoverInfo, error) {
+	var v DomainFailoverInfo
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _DomainFailoverRecord_Read(w wire.Value) (*DomainFailoverRecord, error) {
+	var v DomainFailoverRecord
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_DomainFailoverRecord_Read(l wire.ValueList) ([]*DomainFailoverRecord, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*DomainFailoverRecord, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _DomainFailoverRecord_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
 // FromWire deserializes a DescribeDomainResponse struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
@@ -12346,6 +13251,22 @@ func (v *DescribeDomainResponse) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 60:
+			if field.Value.Type() == wire.TStruct {
+				v.FailoverInfo, err = _DomainFailoverInfo_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 70:
+			if field.Value.Type() == wire.TList {
+				v.FailoverHistory, err = _List_DomainFailoverRecord_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -12360,7 +13281,7 @@ func (v *DescribeDomainResponse) String() string {
 		return "<nil>"
 	}
 
-	var fields [5]string
+	var fields [7]string
 	i := 0
 	if v.DomainInfo != nil {
 		fields[i] = fmt.Sprintf("DomainInfo: %v", v.DomainInfo)
@@ -12382,6 +13303,14 @@ func (v *DescribeDomainResponse) String() string {
 		fields[i] = fmt.Sprintf("IsGlobalDomain: %v", *(v.IsGlobalDomain))
 		i++
 	}
+	if v.FailoverInfo != nil {
+		fields[i] = fmt.Sprintf("FailoverInfo: %v", v.FailoverInfo)
+		i++
+	}
+	if v.FailoverHistory != nil {
+		fields[i] = fmt.Sprintf("FailoverHistory: %v", v.FailoverHistory)
+		i++
+	}
 
 	return fmt.Sprintf("DescribeDomainResponse{%v}", strings.Join(fields[:i], ", "))
 }
@@ -12396,6 +13325,21 @@ func _Bool_EqualsPtr(lhs, rhs *bool) bool {
 	return lhs == nil && rhs == nil
 }
 
+func _List_DomainFailoverRecord_Equals(lhs, rhs []*DomainFailoverRecord) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // Equals returns true if all the fields of this DescribeDomainResponse match the
 // provided DescribeDomainResponse.
 //
@@ -12421,10 +13365,27 @@ func (v *DescribeDomainResponse) Equals(rhs *DescribeDomainResponse) bool {
 	if !_Bool_EqualsPtr(v.IsGlobalDomain, rhs.IsGlobalDomain) {
 		return false
 	}
+	if !((v.FailoverInfo == nil && rhs.FailoverInfo == nil) || (v.FailoverInfo != nil && rhs.FailoverInfo != nil && v.FailoverInfo.Equals(rhs.FailoverInfo))) {
+		return false
+	}
+	if !((v.FailoverHistory == nil && rhs.FailoverHistory == nil) || (v.FailoverHistory != nil && rhs.FailoverHistory != nil && _List_DomainFailoverRecord_Equals(v.FailoverHistory, rhs.FailoverHistory))) {
+		return false
+	}
 
 	return true
 }
 
+type _List_DomainFailoverRecord_Zapper []*DomainFailoverRecord
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_DomainFailoverRecord_Zapper.
+func (l _List_DomainFailoverRecord_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of DescribeDomainResponse.
 func (v *DescribeDomainResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -12446,6 +13407,12 @@ func (v *DescribeDomainResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (er
 	if v.IsGlobalDomain != nil {
 		enc.AddBool("isGlobalDomain", *v.IsGlobalDomain)
 	}
+	if v.FailoverInfo != nil {
+		err = multierr.Append(err, enc.AddObject("failoverInfo", v.FailoverInfo))
+	}
+	if v.FailoverHistory != nil {
+		err = multierr.Append(err, enc.AddArray("failoverHistory", (_List_DomainFailoverRecord_Zapper)(v.FailoverHistory)))
+	}
 	return err
 }
 
@@ -12524,6 +13491,36 @@ func (v *DescribeDomainResponse) IsSetIsGlobalDomain() bool {
 	return v != nil && v.IsGlobalDomain != nil
 }
 
+// GetFailoverInfo returns the value of FailoverInfo if it is set or its
+// zero value if it is unset.
+func (v *DescribeDomainResponse) GetFailoverInfo() (o *DomainFailoverInfo) {
+	if v != nil && v.FailoverInfo != nil {
+		return v.FailoverInfo
+	}
+
+	return
+}
+
+// IsSetFailoverInfo returns true if FailoverInfo is not nil.
+func (v *DescribeDomainResponse) IsSetFailoverInfo() bool {
+	return v != nil && v.FailoverInfo != nil
+}
+
+// GetFailoverHistory returns the value of FailoverHistory if it is set or its
+// zero value if it is unset.
+func (v *DescribeDomainResponse) GetFailoverHistory() (o []*DomainFailoverRecord) {
+	if v != nil && v.FailoverHistory != nil {
+		return v.FailoverHistory
+	}
+
+	return
+}
+
+// IsSetFailoverHistory returns true if FailoverHistory is not nil.
+func (v *DescribeDomainResponse) IsSetFailoverHistory() bool {
+	return v != nil && v.FailoverHistory != nil
+}
+
 type DescribeHistoryHostRequest struct {
 	HostAddress      *string            `json:"hostAddress,omitempty"`
 	ShardIdForHost   *int32             `json:"shardIdForHost,omitempty"`
@@ -13419,8 +14416,10 @@ func (v *DescribeTaskListRequest) IsSetIncludeTaskListStatus() bool {
 }
 
 type DescribeTaskListResponse struct {
-	Pollers        []*PollerInfo   `json:"pollers,omitempty"`
-	TaskListStatus *TaskListStatus `json:"taskListStatus,omitempty"`
+	Pollers            []*PollerInfo            `json:"pollers,omitempty"`
+	TaskListStatus     *TaskListStatus          `json:"taskListStatus,omitempty"`
+	InactivePollers    []*PollerInfo            `json:"inactivePollers,omitempty"`
+	ActivityTypeLimits []*ActivityTypeLimitInfo `json:"activityTypeLimits,omitempty"`
 }
 
 type _List_PollerInfo_ValueList []*PollerInfo
@@ -13452,6 +14451,35 @@ func (_List_PollerInfo_ValueList) ValueType() wire.Type {
 
 func (_List_PollerInfo_ValueList) Close() {}
 
+type _List_ActivityTypeLimitInfo_ValueList []*ActivityTypeLimitInfo
+
+func (v _List_ActivityTypeLimitInfo_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_ActivityTypeLimitInfo_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_ActivityTypeLimitInfo_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_ActivityTypeLimitInfo_ValueList) Close() {}
+
 // ToWire translates a DescribeTaskListResponse struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
@@ -13469,7 +14497,7 @@ func (_List_PollerInfo_ValueList) Close() {}
 //   }
 func (v *DescribeTaskListResponse) ToWire() (wire.Value, error) {
 	var (
-		fields [2]wire.Field
+		fields [4]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -13491,6 +14519,22 @@ func (v *DescribeTaskListResponse) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
+	if v.InactivePollers != nil {
+		w, err = wire.NewValueList(_List_PollerInfo_ValueList(v.InactivePollers)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.ActivityTypeLimits != nil {
+		w, err = wire.NewValueList(_List_ActivityTypeLimitInfo_ValueList(v.ActivityTypeLimits)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -13525,6 +14569,30 @@ func _TaskListStatus_Read(w wire.Value) (*TaskListStatus, error) {
 	return &v, err
 }
 
+func _ActivityTypeLimitInfo_Read(w wire.Value) (*ActivityTypeLimitInfo, error) {
+	var v ActivityTypeLimitInfo
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_ActivityTypeLimitInfo_Read(l wire.ValueList) ([]*ActivityTypeLimitInfo, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*ActivityTypeLimitInfo, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _ActivityTypeLimitInfo_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
 // FromWire deserializes a DescribeTaskListResponse struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
@@ -13562,6 +14630,22 @@ func (v *DescribeTaskListResponse) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 30:
+			if field.Value.Type() == wire.TList {
+				v.InactivePollers, err = _List_PollerInfo_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TList {
+				v.ActivityTypeLimits, err = _List_ActivityTypeLimitInfo_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -13576,7 +14660,7 @@ func (v *DescribeTaskListResponse) String() string {
 		return "<nil>"
 	}
 
-	var fields [2]string
+	var fields [4]string
 	i := 0
 	if v.Pollers != nil {
 		fields[i] = fmt.Sprintf("Pollers: %v", v.Pollers)
@@ -13586,6 +14670,14 @@ func (v *DescribeTaskListResponse) String() string {
 		fields[i] = fmt.Sprintf("TaskListStatus: %v", v.TaskListStatus)
 		i++
 	}
+	if v.InactivePollers != nil {
+		fields[i] = fmt.Sprintf("InactivePollers: %v", v.InactivePollers)
+		i++
+	}
+	if v.ActivityTypeLimits != nil {
+		fields[i] = fmt.Sprintf("ActivityTypeLimits: %v", v.ActivityTypeLimits)
+		i++
+	}
 
 	return fmt.Sprintf("DescribeTaskListResponse{%v}", strings.Join(fields[:i], ", "))
 }
@@ -13605,6 +14697,21 @@ func _List_PollerInfo_Equals(lhs, rhs []*PollerInfo) bool {
 	return true
 }
 
+func _List_ActivityTypeLimitInfo_Equals(lhs, rhs []*ActivityTypeLimitInfo) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // Equals returns true if all the fields of this DescribeTaskListResponse match the
 // provided DescribeTaskListResponse.
 //
@@ -13621,6 +14728,12 @@ func (v *DescribeTaskListResponse) Equals(rhs *DescribeTaskListResponse) bool {
 	if !((v.TaskListStatus == nil && rhs.TaskListStatus == nil) || (v.TaskListStatus != nil && rhs.TaskListStatus != nil && v.TaskListStatus.Equals(rhs.TaskListStatus))) {
 		return false
 	}
+	if !((v.InactivePollers == nil && rhs.InactivePollers == nil) || (v.InactivePollers != nil && rhs.InactivePollers != nil && _List_PollerInfo_Equals(v.InactivePollers, rhs.InactivePollers))) {
+		return false
+	}
+	if !((v.ActivityTypeLimits == nil && rhs.ActivityTypeLimits == nil) || (v.ActivityTypeLimits != nil && rhs.ActivityTypeLimits != nil && _List_ActivityTypeLimitInfo_Equals(v.ActivityTypeLimits, rhs.ActivityTypeLimits))) {
+		return false
+	}
 
 	return true
 }
@@ -13636,6 +14749,17 @@ func (l _List_PollerInfo_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err
 	return err
 }
 
+type _List_ActivityTypeLimitInfo_Zapper []*ActivityTypeLimitInfo
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_ActivityTypeLimitInfo_Zapper.
+func (l _List_ActivityTypeLimitInfo_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of DescribeTaskListResponse.
 func (v *DescribeTaskListResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -13648,6 +14772,12 @@ func (v *DescribeTaskListResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (
 	if v.TaskListStatus != nil {
 		err = multierr.Append(err, enc.AddObject("taskListStatus", v.TaskListStatus))
 	}
+	if v.InactivePollers != nil {
+		err = multierr.Append(err, enc.AddArray("inactivePollers", (_List_PollerInfo_Zapper)(v.InactivePollers)))
+	}
+	if v.ActivityTypeLimits != nil {
+		err = multierr.Append(err, enc.AddArray("activityTypeLimits", (_List_ActivityTypeLimitInfo_Zapper)(v.ActivityTypeLimits)))
+	}
 	return err
 }
 
@@ -13681,6 +14811,36 @@ func (v *DescribeTaskListResponse) IsSetTaskListStatus() bool {
 	return v != nil && v.TaskListStatus != nil
 }
 
+// GetInactivePollers returns the value of InactivePollers if it is set or its
+// zero value if it is unset.
+func (v *DescribeTaskListResponse) GetInactivePollers() (o []*PollerInfo) {
+	if v != nil && v.InactivePollers != nil {
+		return v.InactivePollers
+	}
+
+	return
+}
+
+// IsSetInactivePollers returns true if InactivePollers is not nil.
+func (v *DescribeTaskListResponse) IsSetInactivePollers() bool {
+	return v != nil && v.InactivePollers != nil
+}
+
+// GetActivityTypeLimits returns the value of ActivityTypeLimits if it is set or its
+// zero value if it is unset.
+func (v *DescribeTaskListResponse) GetActivityTypeLimits() (o []*ActivityTypeLimitInfo) {
+	if v != nil && v.ActivityTypeLimits != nil {
+		return v.ActivityTypeLimits
+	}
+
+	return
+}
+
+// IsSetActivityTypeLimits returns true if ActivityTypeLimits is not nil.
+func (v *DescribeTaskListResponse) IsSetActivityTypeLimits() bool {
+	return v != nil && v.ActivityTypeLimits != nil
+}
+
 type DescribeWorkflowExecutionRequest struct {
 	Domain    *string            `json:"domain,omitempty"`
 	Execution *WorkflowExecution `json:"execution,omitempty"`
@@ -15031,6 +16191,1102 @@ func (v *DomainConfiguration) IsSetVisibilityArchivalURI() bool {
 	return v != nil && v.VisibilityArchivalURI != nil
 }
 
+type DomainFailoverHistory struct {
+	Records []*DomainFailoverRecord `json:"records,omitempty"`
+}
+
+// ToWire translates a DomainFailoverHistory struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *DomainFailoverHistory) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Records != nil {
+		w, err = wire.NewValueList(_List_DomainFailoverRecord_ValueList(v.Records)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a DomainFailoverHistory struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a DomainFailoverHistory struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v DomainFailoverHistory
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *DomainFailoverHistory) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TList {
+				v.Records, err = _List_DomainFailoverRecord_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a DomainFailoverHistory
+// struct.
+func (v *DomainFailoverHistory) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Records != nil {
+		fields[i] = fmt.Sprintf("Records: %v", v.Records)
+		i++
+	}
+
+	return fmt.Sprintf("DomainFailoverHistory{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this DomainFailoverHistory match the
+// provided DomainFailoverHistory.
+//
+// This function performs a deep comparison.
+func (v *DomainFailoverHistory) Equals(rhs *DomainFailoverHistory) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Records == nil && rhs.Records == nil) || (v.Records != nil && rhs.Records != nil && _List_DomainFailoverRecord_Equals(v.Records, rhs.Records))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of DomainFailoverHistory.
+func (v *DomainFailoverHistory) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Records != nil {
+		err = multierr.Append(err, enc.AddArray("records", (_List_DomainFailoverRecord_Zapper)(v.Records)))
+	}
+	return err
+}
+
+// GetRecords returns the value of Records if it is set or its
+// zero value if it is unset.
+func (v *DomainFailoverHistory) GetRecords() (o []*DomainFailoverRecord) {
+	if v != nil && v.Records != nil {
+		return v.Records
+	}
+
+	return
+}
+
+// IsSetRecords returns true if Records is not nil.
+func (v *DomainFailoverHistory) IsSetRecords() bool {
+	return v != nil && v.Records != nil
+}
+
+type DomainFailoverInfo struct {
+	State           *DomainFailoverState `json:"state,omitempty"`
+	SourceCluster   *string              `json:"sourceCluster,omitempty"`
+	TargetCluster   *string              `json:"targetCluster,omitempty"`
+	StartTimestamp  *int64               `json:"startTimestamp,omitempty"`
+	ExpireTimestamp *int64               `json:"expireTimestamp,omitempty"`
+	EndTimestamp    *int64               `json:"endTimestamp,omitempty"`
+	Reason          *string              `json:"reason,omitempty"`
+}
+
+// ToWire translates a DomainFailoverInfo struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *DomainFailoverInfo) ToWire() (wire.Value, error) {
+	var (
+		fields [7]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.State != nil {
+		w, err = v.State.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.SourceCluster != nil {
+		w, err = wire.NewValueString(*(v.SourceCluster)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.TargetCluster != nil {
+		w, err = wire.NewValueString(*(v.TargetCluster)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.StartTimestamp != nil {
+		w, err = wire.NewValueI64(*(v.StartTimestamp)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.ExpireTimestamp != nil {
+		w, err = wire.NewValueI64(*(v.ExpireTimestamp)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.EndTimestamp != nil {
+		w, err = wire.NewValueI64(*(v.EndTimestamp)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	if v.Reason != nil {
+		w, err = wire.NewValueString(*(v.Reason)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _DomainFailoverState_Read(w wire.Value) (DomainFailoverState, error) {
+	var v DomainFailoverState
+	err := v.FromWire(w)
+	return v, err
+}
+
+// FromWire deserializes a DomainFailoverInfo struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a DomainFailoverInfo struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v DomainFailoverInfo
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *DomainFailoverInfo) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TI32 {
+				var x DomainFailoverState
+				x, err = _DomainFailoverState_Read(field.Value)
+				v.State = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.SourceCluster = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.TargetCluster = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.StartTimestamp = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.ExpireTimestamp = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.EndTimestamp = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 70:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Reason = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a DomainFailoverInfo
+// struct.
+func (v *DomainFailoverInfo) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [7]string
+	i := 0
+	if v.State != nil {
+		fields[i] = fmt.Sprintf("State: %v", *(v.State))
+		i++
+	}
+	if v.SourceCluster != nil {
+		fields[i] = fmt.Sprintf("SourceCluster: %v", *(v.SourceCluster))
+		i++
+	}
+	if v.TargetCluster != nil {
+		fields[i] = fmt.Sprintf("TargetCluster: %v", *(v.TargetCluster))
+		i++
+	}
+	if v.StartTimestamp != nil {
+		fields[i] = fmt.Sprintf("StartTimestamp: %v", *(v.StartTimestamp))
+		i++
+	}
+	if v.ExpireTimestamp != nil {
+		fields[i] = fmt.Sprintf("ExpireTimestamp: %v", *(v.ExpireTimestamp))
+		i++
+	}
+	if v.EndTimestamp != nil {
+		fields[i] = fmt.Sprintf("EndTimestamp: %v", *(v.EndTimestamp))
+		i++
+	}
+	if v.Reason != nil {
+		fields[i] = fmt.Sprintf("Reason: %v", *(v.Reason))
+		i++
+	}
+
+	return fmt.Sprintf("DomainFailoverInfo{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _DomainFailoverState_EqualsPtr(lhs, rhs *DomainFailoverState) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this DomainFailoverInfo match the
+// provided DomainFailoverInfo.
+//
+// This function performs a deep comparison.
+func (v *DomainFailoverInfo) Equals(rhs *DomainFailoverInfo) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_DomainFailoverState_EqualsPtr(v.State, rhs.State) {
+		return false
+	}
+	if !_String_EqualsPtr(v.SourceCluster, rhs.SourceCluster) {
+		return false
+	}
+	if !_String_EqualsPtr(v.TargetCluster, rhs.TargetCluster) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.StartTimestamp, rhs.StartTimestamp) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.ExpireTimestamp, rhs.ExpireTimestamp) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.EndTimestamp, rhs.EndTimestamp) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Reason, rhs.Reason) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of DomainFailoverInfo.
+func (v *DomainFailoverInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.State != nil {
+		err = multierr.Append(err, enc.AddObject("state", *v.State))
+	}
+	if v.SourceCluster != nil {
+		enc.AddString("sourceCluster", *v.SourceCluster)
+	}
+	if v.TargetCluster != nil {
+		enc.AddString("targetCluster", *v.TargetCluster)
+	}
+	if v.StartTimestamp != nil {
+		enc.AddInt64("startTimestamp", *v.StartTimestamp)
+	}
+	if v.ExpireTimestamp != nil {
+		enc.AddInt64("expireTimestamp", *v.ExpireTimestamp)
+	}
+	if v.EndTimestamp != nil {
+		enc.AddInt64("endTimestamp", *v.EndTimestamp)
+	}
+	if v.Reason != nil {
+		enc.AddString("reason", *v.Reason)
+	}
+	return err
+}
+
+// GetState returns the value of State if it is set or its
+// zero value if it is unset.
+func (v *DomainFailoverInfo) GetState() (o DomainFailoverState) {
+	if v != nil && v.State != nil {
+		return *v.State
+	}
+
+	return
+}
+
+// IsSetState returns true if State is not nil.
+func (v *DomainFailoverInfo) IsSetState() bool {
+	return v != nil && v.State != nil
+}
+
+// GetSourceCluster returns the value of SourceCluster if it is set or its
+// zero value if it is unset.
+func (v *DomainFailoverInfo) GetSourceCluster() (o string) {
+	if v != nil && v.SourceCluster != nil {
+		return *v.SourceCluster
+	}
+
+	return
+}
+
+// IsSetSourceCluster returns true if SourceCluster is not nil.
+func (v *DomainFailoverInfo) IsSetSourceCluster() bool {
+	return v != nil && v.SourceCluster != nil
+}
+
+// GetTargetCluster returns the value of TargetCluster if it is set or its
+// zero value if it is unset.
+func (v *DomainFailoverInfo) GetTargetCluster() (o string) {
+	if v != nil && v.TargetCluster != nil {
+		return *v.TargetCluster
+	}
+
+	return
+}
+
+// IsSetTargetCluster returns true if TargetCluster is not nil.
+func (v *DomainFailoverInfo) IsSetTargetCluster() bool {
+	return v != nil && v.TargetCluster != nil
+}
+
+// GetStartTimestamp returns the value of StartTimestamp if it is set or its
+// zero value if it is unset.
+func (v *DomainFailoverInfo) GetStartTimestamp() (o int64) {
+	if v != nil && v.StartTimestamp != nil {
+		return *v.StartTimestamp
+	}
+
+	return
+}
+
+// IsSetStartTimestamp returns true if StartTimestamp is not nil.
+func (v *DomainFailoverInfo) IsSetStartTimestamp() bool {
+	return v != nil && v.StartTimestamp != nil
+}
+
+// GetExpireTimestamp returns the value of ExpireTimestamp if it is set or its
+// zero value if it is unset.
+func (v *DomainFailoverInfo) GetExpireTimestamp() (o int64) {
+	if v != nil && v.ExpireTimestamp != nil {
+		return *v.ExpireTimestamp
+	}
+
+	return
+}
+
+// IsSetExpireTimestamp returns true if ExpireTimestamp is not nil.
+func (v *DomainFailoverInfo) IsSetExpireTimestamp() bool {
+	return v != nil && v.ExpireTimestamp != nil
+}
+
+// GetEndTimestamp returns the value of EndTimestamp if it is set or its
+// zero value if it is unset.
+func (v *DomainFailoverInfo) GetEndTimestamp() (o int64) {
+	if v != nil && v.EndTimestamp != nil {
+		return *v.EndTimestamp
+	}
+
+	return
+}
+
+// IsSetEndTimestamp returns true if EndTimestamp is not nil.
+func (v *DomainFailoverInfo) IsSetEndTimestamp() bool {
+	return v != nil && v.EndTimestamp != nil
+}
+
+// GetReason returns the value of Reason if it is set or its
+// zero value if it is unset.
+func (v *DomainFailoverInfo) GetReason() (o string) {
+	if v != nil && v.Reason != nil {
+		return *v.Reason
+	}
+
+	return
+}
+
+// IsSetReason returns true if Reason is not nil.
+func (v *DomainFailoverInfo) IsSetReason() bool {
+	return v != nil && v.Reason != nil
+}
+
+type DomainFailoverRecord struct {
+	FailoverVersion *int64  `json:"failoverVersion,omitempty"`
+	FromCluster     *string `json:"fromCluster,omitempty"`
+	ToCluster       *string `json:"toCluster,omitempty"`
+	Timestamp       *int64  `json:"timestamp,omitempty"`
+	Graceful        *bool   `json:"graceful,omitempty"`
+	Reason          *string `json:"reason,omitempty"`
+}
+
+// ToWire translates a DomainFailoverRecord struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *DomainFailoverRecord) ToWire() (wire.Value, error) {
+	var (
+		fields [6]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.FailoverVersion != nil {
+		w, err = wire.NewValueI64(*(v.FailoverVersion)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.FromCluster != nil {
+		w, err = wire.NewValueString(*(v.FromCluster)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.ToCluster != nil {
+		w, err = wire.NewValueString(*(v.ToCluster)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.Timestamp != nil {
+		w, err = wire.NewValueI64(*(v.Timestamp)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.Graceful != nil {
+		w, err = wire.NewValueBool(*(v.Graceful)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.Reason != nil {
+		w, err = wire.NewValueString(*(v.Reason)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a DomainFailoverRecord struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a DomainFailoverRecord struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v DomainFailoverRecord
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *DomainFailoverRecord) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.FailoverVersion = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.FromCluster = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ToCluster = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Timestamp = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.Graceful = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Reason = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a DomainFailoverRecord
+// struct.
+func (v *DomainFailoverRecord) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [6]string
+	i := 0
+	if v.FailoverVersion != nil {
+		fields[i] = fmt.Sprintf("FailoverVersion: %v", *(v.FailoverVersion))
+		i++
+	}
+	if v.FromCluster != nil {
+		fields[i] = fmt.Sprintf("FromCluster: %v", *(v.FromCluster))
+		i++
+	}
+	if v.ToCluster != nil {
+		fields[i] = fmt.Sprintf("ToCluster: %v", *(v.ToCluster))
+		i++
+	}
+	if v.Timestamp != nil {
+		fields[i] = fmt.Sprintf("Timestamp: %v", *(v.Timestamp))
+		i++
+	}
+	if v.Graceful != nil {
+		fields[i] = fmt.Sprintf("Graceful: %v", *(v.Graceful))
+		i++
+	}
+	if v.Reason != nil {
+		fields[i] = fmt.Sprintf("Reason: %v", *(v.Reason))
+		i++
+	}
+
+	return fmt.Sprintf("DomainFailoverRecord{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this DomainFailoverRecord match the
+// provided DomainFailoverRecord.
+//
+// This function performs a deep comparison.
+func (v *DomainFailoverRecord) Equals(rhs *DomainFailoverRecord) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_I64_EqualsPtr(v.FailoverVersion, rhs.FailoverVersion) {
+		return false
+	}
+	if !_String_EqualsPtr(v.FromCluster, rhs.FromCluster) {
+		return false
+	}
+	if !_String_EqualsPtr(v.ToCluster, rhs.ToCluster) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.Timestamp, rhs.Timestamp) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.Graceful, rhs.Graceful) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Reason, rhs.Reason) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of DomainFailoverRecord.
+func (v *DomainFailoverRecord) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.FailoverVersion != nil {
+		enc.AddInt64("failoverVersion", *v.FailoverVersion)
+	}
+	if v.FromCluster != nil {
+		enc.AddString("fromCluster", *v.FromCluster)
+	}
+	if v.ToCluster != nil {
+		enc.AddString("toCluster", *v.ToCluster)
+	}
+	if v.Timestamp != nil {
+		enc.AddInt64("timestamp", *v.Timestamp)
+	}
+	if v.Graceful != nil {
+		enc.AddBool("graceful", *v.Graceful)
+	}
+	if v.Reason != nil {
+		enc.AddString("reason", *v.Reason)
+	}
+	return err
+}
+
+// GetFailoverVersion returns the value of FailoverVersion if it is set or its
+// zero value if it is unset.
+func (v *DomainFailoverRecord) GetFailoverVersion() (o int64) {
+	if v != nil && v.FailoverVersion != nil {
+		return *v.FailoverVersion
+	}
+
+	return
+}
+
+// IsSetFailoverVersion returns true if FailoverVersion is not nil.
+func (v *DomainFailoverRecord) IsSetFailoverVersion() bool {
+	return v != nil && v.FailoverVersion != nil
+}
+
+// GetFromCluster returns the value of FromCluster if it is set or its
+// zero value if it is unset.
+func (v *DomainFailoverRecord) GetFromCluster() (o string) {
+	if v != nil && v.FromCluster != nil {
+		return *v.FromCluster
+	}
+
+	return
+}
+
+// IsSetFromCluster returns true if FromCluster is not nil.
+func (v *DomainFailoverRecord) IsSetFromCluster() bool {
+	return v != nil && v.FromCluster != nil
+}
+
+// GetToCluster returns the value of ToCluster if it is set or its
+// zero value if it is unset.
+func (v *DomainFailoverRecord) GetToCluster() (o string) {
+	if v != nil && v.ToCluster != nil {
+		return *v.ToCluster
+	}
+
+	return
+}
+
+// IsSetToCluster returns true if ToCluster is not nil.
+func (v *DomainFailoverRecord) IsSetToCluster() bool {
+	return v != nil && v.ToCluster != nil
+}
+
+// GetTimestamp returns the value of Timestamp if it is set or its
+// zero value if it is unset.
+func (v *DomainFailoverRecord) GetTimestamp() (o int64) {
+	if v != nil && v.Timestamp != nil {
+		return *v.Timestamp
+	}
+
+	return
+}
+
+// IsSetTimestamp returns true if Timestamp is not nil.
+func (v *DomainFailoverRecord) IsSetTimestamp() bool {
+	return v != nil && v.Timestamp != nil
+}
+
+// GetGraceful returns the value of Graceful if it is set or its
+// zero value if it is unset.
+func (v *DomainFailoverRecord) GetGraceful() (o bool) {
+	if v != nil && v.Graceful != nil {
+		return *v.Graceful
+	}
+
+	return
+}
+
+// IsSetGraceful returns true if Graceful is not nil.
+func (v *DomainFailoverRecord) IsSetGraceful() bool {
+	return v != nil && v.Graceful != nil
+}
+
+// GetReason returns the value of Reason if it is set or its
+// zero value if it is unset.
+func (v *DomainFailoverRecord) GetReason() (o string) {
+	if v != nil && v.Reason != nil {
+		return *v.Reason
+	}
+
+	return
+}
+
+// IsSetReason returns true if Reason is not nil.
+func (v *DomainFailoverRecord) IsSetReason() bool {
+	return v != nil && v.Reason != nil
+}
+
+type DomainFailoverState int32
+
+const (
+	DomainFailoverStateDraining  DomainFailoverState = 0
+	DomainFailoverStateCompleted DomainFailoverState = 1
+	DomainFailoverStateTimedOut  DomainFailoverState = 2
+)
+
+// DomainFailoverState_Values returns all recognized values of DomainFailoverState.
+func DomainFailoverState_Values() []DomainFailoverState {
+	return []DomainFailoverState{
+		DomainFailoverStateDraining,
+		DomainFailoverStateCompleted,
+		DomainFailoverStateTimedOut,
+	}
+}
+
+// UnmarshalText tries to decode DomainFailoverState from a byte slice
+// containing its name.
+//
+//   var v DomainFailoverState
+//   err := v.UnmarshalText([]byte("DRAINING"))
+func (v *DomainFailoverState) UnmarshalText(value []byte) error {
+	switch s := string(value); s {
+	case "DRAINING":
+		*v = DomainFailoverStateDraining
+		return nil
+	case "COMPLETED":
+		*v = DomainFailoverStateCompleted
+		return nil
+	case "TIMED_OUT":
+		*v = DomainFailoverStateTimedOut
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "DomainFailoverState", err)
+		}
+		*v = DomainFailoverState(val)
+		return nil
+	}
+}
+
+// MarshalText encodes DomainFailoverState to text.
+//
+// If the enum value is recognized, its name is returned. Otherwise,
+// its integer value is returned.
+//
+// This implements the TextMarshaler interface.
+func (v DomainFailoverState) MarshalText() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return []byte("DRAINING"), nil
+	case 1:
+		return []byte("COMPLETED"), nil
+	case 2:
+		return []byte("TIMED_OUT"), nil
+	}
+	return []byte(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of DomainFailoverState.
+// Enums are logged as objects, where the value is logged with key "value", and
+// if this value's name is known, the name is logged with key "name".
+func (v DomainFailoverState) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt32("value", int32(v))
+	switch int32(v) {
+	case 0:
+		enc.AddString("name", "DRAINING")
+	case 1:
+		enc.AddString("name", "COMPLETED")
+	case 2:
+		enc.AddString("name", "TIMED_OUT")
+	}
+	return nil
+}
+
+// Ptr returns a pointer to this enum value.
+func (v DomainFailoverState) Ptr() *DomainFailoverState {
+	return &v
+}
+
+// ToWire translates DomainFailoverState into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// Enums are represented as 32-bit integers over the wire.
+func (v DomainFailoverState) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
+}
+
+// FromWire deserializes DomainFailoverState from its Thrift-level
+// representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TI32)
+//   if err != nil {
+//     return DomainFailoverState(0), err
+//   }
+//
+//   var v DomainFailoverState
+//   if err := v.FromWire(x); err != nil {
+//     return DomainFailoverState(0), err
+//   }
+//   return v, nil
+func (v *DomainFailoverState) FromWire(w wire.Value) error {
+	*v = (DomainFailoverState)(w.GetI32())
+	return nil
+}
+
+// String returns a readable string representation of DomainFailoverState.
+func (v DomainFailoverState) String() string {
+	w := int32(v)
+	switch w {
+	case 0:
+		return "DRAINING"
+	case 1:
+		return "COMPLETED"
+	case 2:
+		return "TIMED_OUT"
+	}
+	return fmt.Sprintf("DomainFailoverState(%d)", w)
+}
+
+// Equals returns true if this DomainFailoverState value matches the provided
+// value.
+func (v DomainFailoverState) Equals(rhs DomainFailoverState) bool {
+	return v == rhs
+}
+
+// MarshalJSON serializes DomainFailoverState into JSON.
+//
+// If the enum value is recognized, its name is returned. Otherwise,
+// its integer value is returned.
+//
+// This implements json.Marshaler.
+func (v DomainFailoverState) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return ([]byte)("\"DRAINING\""), nil
+	case 1:
+		return ([]byte)("\"COMPLETED\""), nil
+	case 2:
+		return ([]byte)("\"TIMED_OUT\""), nil
+	}
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// UnmarshalJSON attempts to decode DomainFailoverState from its JSON
+// representation.
+//
+// This implementation supports both, numeric and string inputs. If a
+// string is provided, it must be a known enum name.
+//
+// This implements json.Unmarshaler.
+func (v *DomainFailoverState) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
+	}
+
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
+		if err != nil {
+			return err
+		}
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "DomainFailoverState")
+		}
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "DomainFailoverState")
+		}
+		*v = (DomainFailoverState)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "DomainFailoverState")
+	}
+}
+
 type DomainInfo struct {
 	Name        *string           `json:"name,omitempty"`
 	Status      *DomainStatus     `json:"status,omitempty"`
@@ -15739,6 +17995,7 @@ func (v *DomainNotActiveError) Error() string {
 type DomainReplicationConfiguration struct {
 	ActiveClusterName *string                            `json:"activeClusterName,omitempty"`
 	Clusters          []*ClusterReplicationConfiguration `json:"clusters,omitempty"`
+	ReplicationFilter *DomainReplicationFilter           `json:"replicationFilter,omitempty"`
 }
 
 type _List_ClusterReplicationConfiguration_ValueList []*ClusterReplicationConfiguration
@@ -15787,7 +18044,7 @@ func (_List_ClusterReplicationConfiguration_ValueList) Close() {}
 //   }
 func (v *DomainReplicationConfiguration) ToWire() (wire.Value, error) {
 	var (
-		fields [2]wire.Field
+		fields [3]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -15809,6 +18066,14 @@ func (v *DomainReplicationConfiguration) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
+	if v.ReplicationFilter != nil {
+		w, err = v.ReplicationFilter.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -15837,6 +18102,12 @@ func _List_ClusterReplicationConfiguration_Read(l wire.ValueList) ([]*ClusterRep
 	return o, err
 }
 
+func _DomainReplicationFilter_Read(w wire.Value) (*DomainReplicationFilter, error) {
+	var v DomainReplicationFilter
+	err := v.FromWire(w)
+	return &v, err
+}
+
 // FromWire deserializes a DomainReplicationConfiguration struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
@@ -15876,6 +18147,14 @@ func (v *DomainReplicationConfiguration) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 30:
+			if field.Value.Type() == wire.TStruct {
+				v.ReplicationFilter, err = _DomainReplicationFilter_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -15890,7 +18169,7 @@ func (v *DomainReplicationConfiguration) String() string {
 		return "<nil>"
 	}
 
-	var fields [2]string
+	var fields [3]string
 	i := 0
 	if v.ActiveClusterName != nil {
 		fields[i] = fmt.Sprintf("ActiveClusterName: %v", *(v.ActiveClusterName))
@@ -15900,6 +18179,10 @@ func (v *DomainReplicationConfiguration) String() string {
 		fields[i] = fmt.Sprintf("Clusters: %v", v.Clusters)
 		i++
 	}
+	if v.ReplicationFilter != nil {
+		fields[i] = fmt.Sprintf("ReplicationFilter: %v", v.ReplicationFilter)
+		i++
+	}
 
 	return fmt.Sprintf("DomainReplicationConfiguration{%v}", strings.Join(fields[:i], ", "))
 }
@@ -15935,6 +18218,9 @@ func (v *DomainReplicationConfiguration) Equals(rhs *DomainReplicationConfigurat
 	if !((v.Clusters == nil && rhs.Clusters == nil) || (v.Clusters != nil && rhs.Clusters != nil && _List_ClusterReplicationConfiguration_Equals(v.Clusters, rhs.Clusters))) {
 		return false
 	}
+	if !((v.ReplicationFilter == nil && rhs.ReplicationFilter == nil) || (v.ReplicationFilter != nil && rhs.ReplicationFilter != nil && v.ReplicationFilter.Equals(rhs.ReplicationFilter))) {
+		return false
+	}
 
 	return true
 }
@@ -15962,6 +18248,9 @@ func (v *DomainReplicationConfiguration) MarshalLogObject(enc zapcore.ObjectEnco
 	if v.Clusters != nil {
 		err = multierr.Append(err, enc.AddArray("clusters", (_List_ClusterReplicationConfiguration_Zapper)(v.Clusters)))
 	}
+	if v.ReplicationFilter != nil {
+		err = multierr.Append(err, enc.AddObject("replicationFilter", v.ReplicationFilter))
+	}
 	return err
 }
 
@@ -15995,6 +18284,271 @@ func (v *DomainReplicationConfiguration) IsSetClusters() bool {
 	return v != nil && v.Clusters != nil
 }
 
+// GetReplicationFilter returns the value of ReplicationFilter if it is set or its
+// zero value if it is unset.
+func (v *DomainReplicationConfiguration) GetReplicationFilter() (o *DomainReplicationFilter) {
+	if v != nil && v.ReplicationFilter != nil {
+		return v.ReplicationFilter
+	}
+
+	return
+}
+
+// IsSetReplicationFilter returns true if ReplicationFilter is not nil.
+func (v *DomainReplicationConfiguration) IsSetReplicationFilter() bool {
+	return v != nil && v.ReplicationFilter != nil
+}
+
+type DomainReplicationFilter struct {
+	WorkflowTypes          []string `json:"workflowTypes,omitempty"`
+	SkipVisibilityPayloads *bool    `json:"skipVisibilityPayloads,omitempty"`
+}
+
+type _List_String_ValueList []string
+
+func (v _List_String_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		w, err := wire.NewValueString(x), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_String_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_String_ValueList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_List_String_ValueList) Close() {}
+
+// ToWire translates a DomainReplicationFilter struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *DomainReplicationFilter) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.WorkflowTypes != nil {
+		w, err = wire.NewValueList(_List_String_ValueList(v.WorkflowTypes)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.SkipVisibilityPayloads != nil {
+		w, err = wire.NewValueBool(*(v.SkipVisibilityPayloads)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _List_String_Read(l wire.ValueList) ([]string, error) {
+	if l.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make([]string, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := x.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a DomainReplicationFilter struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a DomainReplicationFilter struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v DomainReplicationFilter
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *DomainReplicationFilter) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TList {
+				v.WorkflowTypes, err = _List_String_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.SkipVisibilityPayloads = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a DomainReplicationFilter
+// struct.
+func (v *DomainReplicationFilter) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.WorkflowTypes != nil {
+		fields[i] = fmt.Sprintf("WorkflowTypes: %v", v.WorkflowTypes)
+		i++
+	}
+	if v.SkipVisibilityPayloads != nil {
+		fields[i] = fmt.Sprintf("SkipVisibilityPayloads: %v", *(v.SkipVisibilityPayloads))
+		i++
+	}
+
+	return fmt.Sprintf("DomainReplicationFilter{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_String_Equals(lhs, rhs []string) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !(lv == rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this DomainReplicationFilter match the
+// provided DomainReplicationFilter.
+//
+// This function performs a deep comparison.
+func (v *DomainReplicationFilter) Equals(rhs *DomainReplicationFilter) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.WorkflowTypes == nil && rhs.WorkflowTypes == nil) || (v.WorkflowTypes != nil && rhs.WorkflowTypes != nil && _List_String_Equals(v.WorkflowTypes, rhs.WorkflowTypes))) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.SkipVisibilityPayloads, rhs.SkipVisibilityPayloads) {
+		return false
+	}
+
+	return true
+}
+
+type _List_String_Zapper []string
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_String_Zapper.
+func (l _List_String_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		enc.AppendString(v)
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of DomainReplicationFilter.
+func (v *DomainReplicationFilter) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.WorkflowTypes != nil {
+		err = multierr.Append(err, enc.AddArray("workflowTypes", (_List_String_Zapper)(v.WorkflowTypes)))
+	}
+	if v.SkipVisibilityPayloads != nil {
+		enc.AddBool("skipVisibilityPayloads", *v.SkipVisibilityPayloads)
+	}
+	return err
+}
+
+// GetWorkflowTypes returns the value of WorkflowTypes if it is set or its
+// zero value if it is unset.
+func (v *DomainReplicationFilter) GetWorkflowTypes() (o []string) {
+	if v != nil && v.WorkflowTypes != nil {
+		return v.WorkflowTypes
+	}
+
+	return
+}
+
+// IsSetWorkflowTypes returns true if WorkflowTypes is not nil.
+func (v *DomainReplicationFilter) IsSetWorkflowTypes() bool {
+	return v != nil && v.WorkflowTypes != nil
+}
+
+// GetSkipVisibilityPayloads returns the value of SkipVisibilityPayloads if it is set or its
+// zero value if it is unset.
+func (v *DomainReplicationFilter) GetSkipVisibilityPayloads() (o bool) {
+	if v != nil && v.SkipVisibilityPayloads != nil {
+		return *v.SkipVisibilityPayloads
+	}
+
+	return
+}
+
+// IsSetSkipVisibilityPayloads returns true if SkipVisibilityPayloads is not nil.
+func (v *DomainReplicationFilter) IsSetSkipVisibilityPayloads() bool {
+	return v != nil && v.SkipVisibilityPayloads != nil
+}
+
 type DomainStatus int32
 
 const (
@@ -18680,44 +21234,6 @@ type Header struct {
 	Fields map[string][]byte `json:"fields,omitempty"`
 }
 
-type _Map_String_Binary_MapItemList map[string][]byte
-
-func (m _Map_String_Binary_MapItemList) ForEach(f func(wire.MapItem) error) error {
-	for k, v := range m {
-		if v == nil {
-			return fmt.Errorf("invalid [%v]: value is nil", k)
-		}
-		kw, err := wire.NewValueString(k), error(nil)
-		if err != nil {
-			return err
-		}
-
-		vw, err := wire.NewValueBinary(v), error(nil)
-		if err != nil {
-			return err
-		}
-		err = f(wire.MapItem{Key: kw, Value: vw})
-		if err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-func (m _Map_String_Binary_MapItemList) Size() int {
-	return len(m)
-}
-
-func (_Map_String_Binary_MapItemList) KeyType() wire.Type {
-	return wire.TBinary
-}
-
-func (_Map_String_Binary_MapItemList) ValueType() wire.Type {
-	return wire.TBinary
-}
-
-func (_Map_String_Binary_MapItemList) Close() {}
-
 // ToWire translates a Header struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
@@ -18753,34 +21269,6 @@ func (v *Header) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _Map_String_Binary_Read(m wire.MapItemList) (map[string][]byte, error) {
-	if m.KeyType() != wire.TBinary {
-		return nil, nil
-	}
-
-	if m.ValueType() != wire.TBinary {
-		return nil, nil
-	}
-
-	o := make(map[string][]byte, m.Size())
-	err := m.ForEach(func(x wire.MapItem) error {
-		k, err := x.Key.GetString(), error(nil)
-		if err != nil {
-			return err
-		}
-
-		v, err := x.Value.GetBinary(), error(nil)
-		if err != nil {
-			return err
-		}
-
-		o[k] = v
-		return nil
-	})
-	m.Close()
-	return o, err
-}
-
 // FromWire deserializes a Header struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
@@ -18834,23 +21322,6 @@ func (v *Header) String() string {
 	return fmt.Sprintf("Header{%v}", strings.Join(fields[:i], ", "))
 }
 
-func _Map_String_Binary_Equals(lhs, rhs map[string][]byte) bool {
-	if len(lhs) != len(rhs) {
-		return false
-	}
-
-	for lk, lv := range lhs {
-		rv, ok := rhs[lk]
-		if !ok {
-			return false
-		}
-		if !bytes.Equal(lv, rv) {
-			return false
-		}
-	}
-	return true
-}
-
 // Equals returns true if all the fields of this Header match the
 // provided Header.
 //
@@ -18868,17 +21339,6 @@ func (v *Header) Equals(rhs *Header) bool {
 	return true
 }
 
-type _Map_String_Binary_Zapper map[string][]byte
-
-// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of _Map_String_Binary_Zapper.
-func (m _Map_String_Binary_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
-	for k, v := range m {
-		enc.AddString((string)(k), base64.StdEncoding.EncodeToString(v))
-	}
-	return err
-}
-
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of Header.
 func (v *Header) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -22170,12 +24630,14 @@ func (v *HistoryEventFilterType) UnmarshalJSON(text []byte) error {
 type IndexedValueType int32
 
 const (
-	IndexedValueTypeString   IndexedValueType = 0
-	IndexedValueTypeKeyword  IndexedValueType = 1
-	IndexedValueTypeInt      IndexedValueType = 2
-	IndexedValueTypeDouble   IndexedValueType = 3
-	IndexedValueTypeBool     IndexedValueType = 4
-	IndexedValueTypeDatetime IndexedValueType = 5
+	IndexedValueTypeString       IndexedValueType = 0
+	IndexedValueTypeKeyword      IndexedValueType = 1
+	IndexedValueTypeInt          IndexedValueType = 2
+	IndexedValueTypeDouble       IndexedValueType = 3
+	IndexedValueTypeBool         IndexedValueType = 4
+	IndexedValueTypeDatetime     IndexedValueType = 5
+	IndexedValueTypeKeywordList  IndexedValueType = 6
+	IndexedValueTypeDatetimeList IndexedValueType = 7
 )
 
 // IndexedValueType_Values returns all recognized values of IndexedValueType.
@@ -22187,6 +24649,8 @@ func IndexedValueType_Values() []IndexedValueType {
 		IndexedValueTypeDouble,
 		IndexedValueTypeBool,
 		IndexedValueTypeDatetime,
+		IndexedValueTypeKeywordList,
+		IndexedValueTypeDatetimeList,
 	}
 }
 
@@ -22215,6 +24679,12 @@ func (v *IndexedValueType) UnmarshalText(value []byte) error {
 	case "DATETIME":
 		*v = IndexedValueTypeDatetime
 		return nil
+	case "KEYWORD_LIST":
+		*v = IndexedValueTypeKeywordList
+		return nil
+	case "DATETIME_LIST":
+		*v = IndexedValueTypeDatetimeList
+		return nil
 	default:
 		val, err := strconv.ParseInt(s, 10, 32)
 		if err != nil {
@@ -22245,6 +24715,10 @@ func (v IndexedValueType) MarshalText() ([]byte, error) {
 		return []byte("BOOL"), nil
 	case 5:
 		return []byte("DATETIME"), nil
+	case 6:
+		return []byte("KEYWORD_LIST"), nil
+	case 7:
+		return []byte("DATETIME_LIST"), nil
 	}
 	return []byte(strconv.FormatInt(int64(v), 10)), nil
 }
@@ -22268,6 +24742,10 @@ func (v IndexedValueType) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 		enc.AddString("name", "BOOL")
 	case 5:
 		enc.AddString("name", "DATETIME")
+	case 6:
+		enc.AddString("name", "KEYWORD_LIST")
+	case 7:
+		enc.AddString("name", "DATETIME_LIST")
 	}
 	return nil
 }
@@ -22320,6 +24798,10 @@ func (v IndexedValueType) String() string {
 		return "BOOL"
 	case 5:
 		return "DATETIME"
+	case 6:
+		return "KEYWORD_LIST"
+	case 7:
+		return "DATETIME_LIST"
 	}
 	return fmt.Sprintf("IndexedValueType(%d)", w)
 }
@@ -22350,6 +24832,10 @@ func (v IndexedValueType) MarshalJSON() ([]byte, error) {
 		return ([]byte)("\"BOOL\""), nil
 	case 5:
 		return ([]byte)("\"DATETIME\""), nil
+	case 6:
+		return ([]byte)("\"KEYWORD_LIST\""), nil
+	case 7:
+		return ([]byte)("\"DATETIME_LIST\""), nil
 	}
 	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
 }
@@ -26975,6 +29461,7 @@ type PollForActivityTaskRequest struct {
 	TaskList         *TaskList         `json:"taskList,omitempty"`
 	Identity         *string           `json:"identity,omitempty"`
 	TaskListMetadata *TaskListMetadata `json:"taskListMetadata,omitempty"`
+	AffinityToken    *string           `json:"affinityToken,omitempty"`
 }
 
 // ToWire translates a PollForActivityTaskRequest struct into a Thrift-level intermediate
@@ -26994,7 +29481,7 @@ type PollForActivityTaskRequest struct {
 //   }
 func (v *PollForActivityTaskRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [4]wire.Field
+		fields [5]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -27032,6 +29519,14 @@ func (v *PollForActivityTaskRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
+	if v.AffinityToken != nil {
+		w, err = wire.NewValueString(*(v.AffinityToken)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -27099,6 +29594,16 @@ func (v *PollForActivityTaskRequest) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 50:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.AffinityToken = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -27113,7 +29618,7 @@ func (v *PollForActivityTaskRequest) String() string {
 		return "<nil>"
 	}
 
-	var fields [4]string
+	var fields [5]string
 	i := 0
 	if v.Domain != nil {
 		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
@@ -27131,6 +29636,10 @@ func (v *PollForActivityTaskRequest) String() string {
 		fields[i] = fmt.Sprintf("TaskListMetadata: %v", v.TaskListMetadata)
 		i++
 	}
+	if v.AffinityToken != nil {
+		fields[i] = fmt.Sprintf("AffinityToken: %v", *(v.AffinityToken))
+		i++
+	}
 
 	return fmt.Sprintf("PollForActivityTaskRequest{%v}", strings.Join(fields[:i], ", "))
 }
@@ -27157,6 +29666,9 @@ func (v *PollForActivityTaskRequest) Equals(rhs *PollForActivityTaskRequest) boo
 	if !((v.TaskListMetadata == nil && rhs.TaskListMetadata == nil) || (v.TaskListMetadata != nil && rhs.TaskListMetadata != nil && v.TaskListMetadata.Equals(rhs.TaskListMetadata))) {
 		return false
 	}
+	if !_String_EqualsPtr(v.AffinityToken, rhs.AffinityToken) {
+		return false
+	}
 
 	return true
 }
@@ -27179,6 +29691,9 @@ func (v *PollForActivityTaskRequest) MarshalLogObject(enc zapcore.ObjectEncoder)
 	if v.TaskListMetadata != nil {
 		err = multierr.Append(err, enc.AddObject("taskListMetadata", v.TaskListMetadata))
 	}
+	if v.AffinityToken != nil {
+		enc.AddString("affinityToken", *v.AffinityToken)
+	}
 	return err
 }
 
@@ -27242,6 +29757,21 @@ func (v *PollForActivityTaskRequest) IsSetTaskListMetadata() bool {
 	return v != nil && v.TaskListMetadata != nil
 }
 
+// GetAffinityToken returns the value of AffinityToken if it is set or its
+// zero value if it is unset.
+func (v *PollForActivityTaskRequest) GetAffinityToken() (o string) {
+	if v != nil && v.AffinityToken != nil {
+		return *v.AffinityToken
+	}
+
+	return
+}
+
+// IsSetAffinityToken returns true if AffinityToken is not nil.
+func (v *PollForActivityTaskRequest) IsSetAffinityToken() bool {
+	return v != nil && v.AffinityToken != nil
+}
+
 type PollForActivityTaskResponse struct {
 	TaskToken                       []byte             `json:"taskToken,omitempty"`
 	WorkflowExecution               *WorkflowExecution `json:"workflowExecution,omitempty"`
@@ -29089,9 +31619,18 @@ func (v *PollForDecisionTaskResponse) IsSetQueries() bool {
 }
 
 type PollerInfo struct {
-	LastAccessTime *int64   `json:"lastAccessTime,omitempty"`
-	Identity       *string  `json:"identity,omitempty"`
-	RatePerSecond  *float64 `json:"ratePerSecond,omitempty"`
+	LastAccessTime       *int64   `json:"lastAccessTime,omitempty"`
+	Identity             *string  `json:"identity,omitempty"`
+	RatePerSecond        *float64 `json:"ratePerSecond,omitempty"`
+	ClientImpl           *string  `json:"clientImpl,omitempty"`
+	ClientFeatureVersion *string  `json:"clientFeatureVersion,omitempty"`
+	ClientLibraryVersion *string  `json:"clientLibraryVersion,omitempty"`
+	OutstandingPollCount *int32   `json:"outstandingPollCount,omitempty"`
+	TasksDispatched      *int64   `json:"tasksDispatched,omitempty"`
+	TasksCompleted       *int64   `json:"tasksCompleted,omitempty"`
+	TasksFailed          *int64   `json:"tasksFailed,omitempty"`
+	StickyCacheHits      *int64   `json:"stickyCacheHits,omitempty"`
+	StickyCacheMisses    *int64   `json:"stickyCacheMisses,omitempty"`
 }
 
 // ToWire translates a PollerInfo struct into a Thrift-level intermediate
@@ -29111,7 +31650,7 @@ type PollerInfo struct {
 //   }
 func (v *PollerInfo) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [12]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -29141,6 +31680,78 @@ func (v *PollerInfo) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
+	if v.ClientImpl != nil {
+		w, err = wire.NewValueString(*(v.ClientImpl)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.ClientFeatureVersion != nil {
+		w, err = wire.NewValueString(*(v.ClientFeatureVersion)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.ClientLibraryVersion != nil {
+		w, err = wire.NewValueString(*(v.ClientLibraryVersion)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	if v.OutstandingPollCount != nil {
+		w, err = wire.NewValueI32(*(v.OutstandingPollCount)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
+	if v.TasksDispatched != nil {
+		w, err = wire.NewValueI64(*(v.TasksDispatched)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
+	if v.TasksCompleted != nil {
+		w, err = wire.NewValueI64(*(v.TasksCompleted)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 90, Value: w}
+		i++
+	}
+	if v.TasksFailed != nil {
+		w, err = wire.NewValueI64(*(v.TasksFailed)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 100, Value: w}
+		i++
+	}
+	if v.StickyCacheHits != nil {
+		w, err = wire.NewValueI64(*(v.StickyCacheHits)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 110, Value: w}
+		i++
+	}
+	if v.StickyCacheMisses != nil {
+		w, err = wire.NewValueI64(*(v.StickyCacheMisses)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 120, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -29196,6 +31807,96 @@ func (v *PollerInfo) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 40:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ClientImpl = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ClientFeatureVersion = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ClientLibraryVersion = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 70:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.OutstandingPollCount = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 80:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.TasksDispatched = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 90:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.TasksCompleted = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 100:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.TasksFailed = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 110:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.StickyCacheHits = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 120:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.StickyCacheMisses = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -29210,7 +31911,7 @@ func (v *PollerInfo) String() string {
 		return "<nil>"
 	}
 
-	var fields [3]string
+	var fields [12]string
 	i := 0
 	if v.LastAccessTime != nil {
 		fields[i] = fmt.Sprintf("LastAccessTime: %v", *(v.LastAccessTime))
@@ -29224,20 +31925,46 @@ func (v *PollerInfo) String() string {
 		fields[i] = fmt.Sprintf("RatePerSecond: %v", *(v.RatePerSecond))
 		i++
 	}
+	if v.ClientImpl != nil {
+		fields[i] = fmt.Sprintf("ClientImpl: %v", *(v.ClientImpl))
+		i++
+	}
+	if v.ClientFeatureVersion != nil {
+		fields[i] = fmt.Sprintf("ClientFeatureVersion: %v", *(v.ClientFeatureVersion))
+		i++
+	}
+	if v.ClientLibraryVersion != nil {
+		fields[i] = fmt.Sprintf("ClientLibraryVersion: %v", *(v.ClientLibraryVersion))
+		i++
+	}
+	if v.OutstandingPollCount != nil {
+		fields[i] = fmt.Sprintf("OutstandingPollCount: %v", *(v.OutstandingPollCount))
+		i++
+	}
+	if v.TasksDispatched != nil {
+		fields[i] = fmt.Sprintf("TasksDispatched: %v", *(v.TasksDispatched))
+		i++
+	}
+	if v.TasksCompleted != nil {
+		fields[i] = fmt.Sprintf("TasksCompleted: %v", *(v.TasksCompleted))
+		i++
+	}
+	if v.TasksFailed != nil {
+		fields[i] = fmt.Sprintf("TasksFailed: %v", *(v.TasksFailed))
+		i++
+	}
+	if v.StickyCacheHits != nil {
+		fields[i] = fmt.Sprintf("StickyCacheHits: %v", *(v.StickyCacheHits))
+		i++
+	}
+	if v.StickyCacheMisses != nil {
+		fields[i] = fmt.Sprintf("StickyCacheMisses: %v", *(v.StickyCacheMisses))
+		i++
+	}
 
 	return fmt.Sprintf("PollerInfo{%v}", strings.Join(fields[:i], ", "))
 }
 
-func _Double_EqualsPtr(lhs, rhs *float64) bool {
-	if lhs != nil && rhs != nil {
-
-		x := *lhs
-		y := *rhs
-		return (x == y)
-	}
-	return lhs == nil && rhs == nil
-}
-
 // Equals returns true if all the fields of this PollerInfo match the
 // provided PollerInfo.
 //
@@ -29257,6 +31984,33 @@ func (v *PollerInfo) Equals(rhs *PollerInfo) bool {
 	if !_Double_EqualsPtr(v.RatePerSecond, rhs.RatePerSecond) {
 		return false
 	}
+	if !_String_EqualsPtr(v.ClientImpl, rhs.ClientImpl) {
+		return false
+	}
+	if !_String_EqualsPtr(v.ClientFeatureVersion, rhs.ClientFeatureVersion) {
+		return false
+	}
+	if !_String_EqualsPtr(v.ClientLibraryVersion, rhs.ClientLibraryVersion) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.OutstandingPollCount, rhs.OutstandingPollCount) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.TasksDispatched, rhs.TasksDispatched) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.TasksCompleted, rhs.TasksCompleted) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.TasksFailed, rhs.TasksFailed) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.StickyCacheHits, rhs.StickyCacheHits) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.StickyCacheMisses, rhs.StickyCacheMisses) {
+		return false
+	}
 
 	return true
 }
@@ -29276,6 +32030,33 @@ func (v *PollerInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v.RatePerSecond != nil {
 		enc.AddFloat64("ratePerSecond", *v.RatePerSecond)
 	}
+	if v.ClientImpl != nil {
+		enc.AddString("clientImpl", *v.ClientImpl)
+	}
+	if v.ClientFeatureVersion != nil {
+		enc.AddString("clientFeatureVersion", *v.ClientFeatureVersion)
+	}
+	if v.ClientLibraryVersion != nil {
+		enc.AddString("clientLibraryVersion", *v.ClientLibraryVersion)
+	}
+	if v.OutstandingPollCount != nil {
+		enc.AddInt32("outstandingPollCount", *v.OutstandingPollCount)
+	}
+	if v.TasksDispatched != nil {
+		enc.AddInt64("tasksDispatched", *v.TasksDispatched)
+	}
+	if v.TasksCompleted != nil {
+		enc.AddInt64("tasksCompleted", *v.TasksCompleted)
+	}
+	if v.TasksFailed != nil {
+		enc.AddInt64("tasksFailed", *v.TasksFailed)
+	}
+	if v.StickyCacheHits != nil {
+		enc.AddInt64("stickyCacheHits", *v.StickyCacheHits)
+	}
+	if v.StickyCacheMisses != nil {
+		enc.AddInt64("stickyCacheMisses", *v.StickyCacheMisses)
+	}
 	return err
 }
 
@@ -29324,6 +32105,141 @@ func (v *PollerInfo) IsSetRatePerSecond() bool {
 	return v != nil && v.RatePerSecond != nil
 }
 
+// GetClientImpl returns the value of ClientImpl if it is set or its
+// zero value if it is unset.
+func (v *PollerInfo) GetClientImpl() (o string) {
+	if v != nil && v.ClientImpl != nil {
+		return *v.ClientImpl
+	}
+
+	return
+}
+
+// IsSetClientImpl returns true if ClientImpl is not nil.
+func (v *PollerInfo) IsSetClientImpl() bool {
+	return v != nil && v.ClientImpl != nil
+}
+
+// GetClientFeatureVersion returns the value of ClientFeatureVersion if it is set or its
+// zero value if it is unset.
+func (v *PollerInfo) GetClientFeatureVersion() (o string) {
+	if v != nil && v.ClientFeatureVersion != nil {
+		return *v.ClientFeatureVersion
+	}
+
+	return
+}
+
+// IsSetClientFeatureVersion returns true if ClientFeatureVersion is not nil.
+func (v *PollerInfo) IsSetClientFeatureVersion() bool {
+	return v != nil && v.ClientFeatureVersion != nil
+}
+
+// GetClientLibraryVersion returns the value of ClientLibraryVersion if it is set or its
+// zero value if it is unset.
+func (v *PollerInfo) GetClientLibraryVersion() (o string) {
+	if v != nil && v.ClientLibraryVersion != nil {
+		return *v.ClientLibraryVersion
+	}
+
+	return
+}
+
+// IsSetClientLibraryVersion returns true if ClientLibraryVersion is not nil.
+func (v *PollerInfo) IsSetClientLibraryVersion() bool {
+	return v != nil && v.ClientLibraryVersion != nil
+}
+
+// GetOutstandingPollCount returns the value of OutstandingPollCount if it is set or its
+// zero value if it is unset.
+func (v *PollerInfo) GetOutstandingPollCount() (o int32) {
+	if v != nil && v.OutstandingPollCount != nil {
+		return *v.OutstandingPollCount
+	}
+
+	return
+}
+
+// IsSetOutstandingPollCount returns true if OutstandingPollCount is not nil.
+func (v *PollerInfo) IsSetOutstandingPollCount() bool {
+	return v != nil && v.OutstandingPollCount != nil
+}
+
+// GetTasksDispatched returns the value of TasksDispatched if it is set or its
+// zero value if it is unset.
+func (v *PollerInfo) GetTasksDispatched() (o int64) {
+	if v != nil && v.TasksDispatched != nil {
+		return *v.TasksDispatched
+	}
+
+	return
+}
+
+// IsSetTasksDispatched returns true if TasksDispatched is not nil.
+func (v *PollerInfo) IsSetTasksDispatched() bool {
+	return v != nil && v.TasksDispatched != nil
+}
+
+// GetTasksCompleted returns the value of TasksCompleted if it is set or its
+// zero value if it is unset.
+func (v *PollerInfo) GetTasksCompleted() (o int64) {
+	if v != nil && v.TasksCompleted != nil {
+		return *v.TasksCompleted
+	}
+
+	return
+}
+
+// IsSetTasksCompleted returns true if TasksCompleted is not nil.
+func (v *PollerInfo) IsSetTasksCompleted() bool {
+	return v != nil && v.TasksCompleted != nil
+}
+
+// GetTasksFailed returns the value of TasksFailed if it is set or its
+// zero value if it is unset.
+func (v *PollerInfo) GetTasksFailed() (o int64) {
+	if v != nil && v.TasksFailed != nil {
+		return *v.TasksFailed
+	}
+
+	return
+}
+
+// IsSetTasksFailed returns true if TasksFailed is not nil.
+func (v *PollerInfo) IsSetTasksFailed() bool {
+	return v != nil && v.TasksFailed != nil
+}
+
+// GetStickyCacheHits returns the value of StickyCacheHits if it is set or its
+// zero value if it is unset.
+func (v *PollerInfo) GetStickyCacheHits() (o int64) {
+	if v != nil && v.StickyCacheHits != nil {
+		return *v.StickyCacheHits
+	}
+
+	return
+}
+
+// IsSetStickyCacheHits returns true if StickyCacheHits is not nil.
+func (v *PollerInfo) IsSetStickyCacheHits() bool {
+	return v != nil && v.StickyCacheHits != nil
+}
+
+// GetStickyCacheMisses returns the value of StickyCacheMisses if it is set or its
+// zero value if it is unset.
+func (v *PollerInfo) GetStickyCacheMisses() (o int64) {
+	if v != nil && v.StickyCacheMisses != nil {
+		return *v.StickyCacheMisses
+	}
+
+	return
+}
+
+// IsSetStickyCacheMisses returns true if StickyCacheMisses is not nil.
+func (v *PollerInfo) IsSetStickyCacheMisses() bool {
+	return v != nil && v.StickyCacheMisses != nil
+}
+
 type QueryConsistencyLevel int32
 
 const (
@@ -31987,6 +34903,7 @@ type RegisterDomainRequest struct {
 	HistoryArchivalURI                     *string                            `json:"historyArchivalURI,omitempty"`
 	VisibilityArchivalStatus               *ArchivalStatus                    `json:"visibilityArchivalStatus,omitempty"`
 	VisibilityArchivalURI                  *string                            `json:"visibilityArchivalURI,omitempty"`
+	ReplicationFilter                      *DomainReplicationFilter           `json:"replicationFilter,omitempty"`
 }
 
 // ToWire translates a RegisterDomainRequest struct into a Thrift-level intermediate
@@ -32006,7 +34923,7 @@ type RegisterDomainRequest struct {
 //   }
 func (v *RegisterDomainRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [14]wire.Field
+		fields [15]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -32124,6 +35041,14 @@ func (v *RegisterDomainRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 160, Value: w}
 		i++
 	}
+	if v.ReplicationFilter != nil {
+		w, err = v.ReplicationFilter.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 170, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -32285,6 +35210,14 @@ func (v *RegisterDomainRequest) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 170:
+			if field.Value.Type() == wire.TStruct {
+				v.ReplicationFilter, err = _DomainReplicationFilter_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -32299,7 +35232,7 @@ func (v *RegisterDomainRequest) String() string {
 		return "<nil>"
 	}
 
-	var fields [14]string
+	var fields [15]string
 	i := 0
 	if v.Name != nil {
 		fields[i] = fmt.Sprintf("Name: %v", *(v.Name))
@@ -32357,6 +35290,10 @@ func (v *RegisterDomainRequest) String() string {
 		fields[i] = fmt.Sprintf("VisibilityArchivalURI: %v", *(v.VisibilityArchivalURI))
 		i++
 	}
+	if v.ReplicationFilter != nil {
+		fields[i] = fmt.Sprintf("ReplicationFilter: %v", v.ReplicationFilter)
+		i++
+	}
 
 	return fmt.Sprintf("RegisterDomainRequest{%v}", strings.Join(fields[:i], ", "))
 }
@@ -32413,6 +35350,9 @@ func (v *RegisterDomainRequest) Equals(rhs *RegisterDomainRequest) bool {
 	if !_String_EqualsPtr(v.VisibilityArchivalURI, rhs.VisibilityArchivalURI) {
 		return false
 	}
+	if !((v.ReplicationFilter == nil && rhs.ReplicationFilter == nil) || (v.ReplicationFilter != nil && rhs.ReplicationFilter != nil && v.ReplicationFilter.Equals(rhs.ReplicationFilter))) {
+		return false
+	}
 
 	return true
 }
@@ -32465,6 +35405,9 @@ func (v *RegisterDomainRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err
 	if v.VisibilityArchivalURI != nil {
 		enc.AddString("visibilityArchivalURI", *v.VisibilityArchivalURI)
 	}
+	if v.ReplicationFilter != nil {
+		err = multierr.Append(err, enc.AddObject("replicationFilter", v.ReplicationFilter))
+	}
 	return err
 }
 
@@ -32678,6 +35621,21 @@ func (v *RegisterDomainRequest) IsSetVisibilityArchivalURI() bool {
 	return v != nil && v.VisibilityArchivalURI != nil
 }
 
+// GetReplicationFilter returns the value of ReplicationFilter if it is set or its
+// zero value if it is unset.
+func (v *RegisterDomainRequest) GetReplicationFilter() (o *DomainReplicationFilter) {
+	if v != nil && v.ReplicationFilter != nil {
+		return v.ReplicationFilter
+	}
+
+	return
+}
+
+// IsSetReplicationFilter returns true if ReplicationFilter is not nil.
+func (v *RegisterDomainRequest) IsSetReplicationFilter() bool {
+	return v != nil && v.ReplicationFilter != nil
+}
+
 type RemoveTaskRequest struct {
 	ShardID *int32 `json:"shardID,omitempty"`
 	Type    *int32 `json:"type,omitempty"`
@@ -39237,32 +42195,6 @@ type RetryPolicy struct {
 	ExpirationIntervalInSeconds *int32   `json:"expirationIntervalInSeconds,omitempty"`
 }
 
-type _List_String_ValueList []string
-
-func (v _List_String_ValueList) ForEach(f func(wire.Value) error) error {
-	for _, x := range v {
-		w, err := wire.NewValueString(x), error(nil)
-		if err != nil {
-			return err
-		}
-		err = f(w)
-		if err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-func (v _List_String_ValueList) Size() int {
-	return len(v)
-}
-
-func (_List_String_ValueList) ValueType() wire.Type {
-	return wire.TBinary
-}
-
-func (_List_String_ValueList) Close() {}
-
 // ToWire translates a RetryPolicy struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
@@ -39338,24 +42270,6 @@ func (v *RetryPolicy) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _List_String_Read(l wire.ValueList) ([]string, error) {
-	if l.ValueType() != wire.TBinary {
-		return nil, nil
-	}
-
-	o := make([]string, 0, l.Size())
-	err := l.ForEach(func(x wire.Value) error {
-		i, err := x.GetString(), error(nil)
-		if err != nil {
-			return err
-		}
-		o = append(o, i)
-		return nil
-	})
-	l.Close()
-	return o, err
-}
-
 // FromWire deserializes a RetryPolicy struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
@@ -39479,21 +42393,6 @@ func (v *RetryPolicy) String() string {
 	return fmt.Sprintf("RetryPolicy{%v}", strings.Join(fields[:i], ", "))
 }
 
-func _List_String_Equals(lhs, rhs []string) bool {
-	if len(lhs) != len(rhs) {
-		return false
-	}
-
-	for i, lv := range lhs {
-		rv := rhs[i]
-		if !(lv == rv) {
-			return false
-		}
-	}
-
-	return true
-}
-
 // Equals returns true if all the fields of this RetryPolicy match the
 // provided RetryPolicy.
 //
@@ -39526,17 +42425,6 @@ func (v *RetryPolicy) Equals(rhs *RetryPolicy) bool {
 	return true
 }
 
-type _List_String_Zapper []string
-
-// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
-// fast logging of _List_String_Zapper.
-func (l _List_String_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
-	for _, v := range l {
-		enc.AppendString(v)
-	}
-	return err
-}
-
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of RetryPolicy.
 func (v *RetryPolicy) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -40418,6 +43306,7 @@ type ScheduleActivityTaskDecisionAttributes struct {
 	HeartbeatTimeoutSeconds       *int32        `json:"heartbeatTimeoutSeconds,omitempty"`
 	RetryPolicy                   *RetryPolicy  `json:"retryPolicy,omitempty"`
 	Header                        *Header       `json:"header,omitempty"`
+	AffinityToken                 *string       `json:"affinityToken,omitempty"`
 }
 
 // ToWire translates a ScheduleActivityTaskDecisionAttributes struct into a Thrift-level intermediate
@@ -40437,7 +43326,7 @@ type ScheduleActivityTaskDecisionAttributes struct {
 //   }
 func (v *ScheduleActivityTaskDecisionAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [11]wire.Field
+		fields [12]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -40531,6 +43420,14 @@ func (v *ScheduleActivityTaskDecisionAttributes) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 80, Value: w}
 		i++
 	}
+	if v.AffinityToken != nil {
+		w, err = wire.NewValueString(*(v.AffinityToken)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 90, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -40656,6 +43553,16 @@ func (v *ScheduleActivityTaskDecisionAttributes) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 90:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.AffinityToken = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -40670,7 +43577,7 @@ func (v *ScheduleActivityTaskDecisionAttributes) String() string {
 		return "<nil>"
 	}
 
-	var fields [11]string
+	var fields [12]string
 	i := 0
 	if v.ActivityId != nil {
 		fields[i] = fmt.Sprintf("ActivityId: %v", *(v.ActivityId))
@@ -40716,6 +43623,10 @@ func (v *ScheduleActivityTaskDecisionAttributes) String() string {
 		fields[i] = fmt.Sprintf("Header: %v", v.Header)
 		i++
 	}
+	if v.AffinityToken != nil {
+		fields[i] = fmt.Sprintf("AffinityToken: %v", *(v.AffinityToken))
+		i++
+	}
 
 	return fmt.Sprintf("ScheduleActivityTaskDecisionAttributes{%v}", strings.Join(fields[:i], ", "))
 }
@@ -40763,6 +43674,9 @@ func (v *ScheduleActivityTaskDecisionAttributes) Equals(rhs *ScheduleActivityTas
 	if !((v.Header == nil && rhs.Header == nil) || (v.Header != nil && rhs.Header != nil && v.Header.Equals(rhs.Header))) {
 		return false
 	}
+	if !_String_EqualsPtr(v.AffinityToken, rhs.AffinityToken) {
+		return false
+	}
 
 	return true
 }
@@ -40806,6 +43720,9 @@ func (v *ScheduleActivityTaskDecisionAttributes) MarshalLogObject(enc zapcore.Ob
 	if v.Header != nil {
 		err = multierr.Append(err, enc.AddObject("header", v.Header))
 	}
+	if v.AffinityToken != nil {
+		enc.AddString("affinityToken", *v.AffinityToken)
+	}
 	return err
 }
 
@@ -40974,6 +43891,21 @@ func (v *ScheduleActivityTaskDecisionAttributes) IsSetHeader() bool {
 	return v != nil && v.Header != nil
 }
 
+// GetAffinityToken returns the value of AffinityToken if it is set or its
+// zero value if it is unset.
+func (v *ScheduleActivityTaskDecisionAttributes) GetAffinityToken() (o string) {
+	if v != nil && v.AffinityToken != nil {
+		return *v.AffinityToken
+	}
+
+	return
+}
+
+// IsSetAffinityToken returns true if AffinityToken is not nil.
+func (v *ScheduleActivityTaskDecisionAttributes) IsSetAffinityToken() bool {
+	return v != nil && v.AffinityToken != nil
+}
+
 type SearchAttributes struct {
 	IndexedFields map[string][]byte `json:"indexedFields,omitempty"`
 }
@@ -41600,12 +44532,14 @@ type SignalExternalWorkflowExecutionFailedCause int32
 
 const (
 	SignalExternalWorkflowExecutionFailedCauseUnknownExternalWorkflowExecution SignalExternalWorkflowExecutionFailedCause = 0
+	SignalExternalWorkflowExecutionFailedCauseTargetClusterUnreachable         SignalExternalWorkflowExecutionFailedCause = 1
 )
 
 // SignalExternalWorkflowExecutionFailedCause_Values returns all recognized values of SignalExternalWorkflowExecutionFailedCause.
 func SignalExternalWorkflowExecutionFailedCause_Values() []SignalExternalWorkflowExecutionFailedCause {
 	return []SignalExternalWorkflowExecutionFailedCause{
 		SignalExternalWorkflowExecutionFailedCauseUnknownExternalWorkflowExecution,
+		SignalExternalWorkflowExecutionFailedCauseTargetClusterUnreachable,
 	}
 }
 
@@ -41619,6 +44553,9 @@ func (v *SignalExternalWorkflowExecutionFailedCause) UnmarshalText(value []byte)
 	case "UNKNOWN_EXTERNAL_WORKFLOW_EXECUTION":
 		*v = SignalExternalWorkflowExecutionFailedCauseUnknownExternalWorkflowExecution
 		return nil
+	case "TARGET_CLUSTER_UNREACHABLE":
+		*v = SignalExternalWorkflowExecutionFailedCauseTargetClusterUnreachable
+		return nil
 	default:
 		val, err := strconv.ParseInt(s, 10, 32)
 		if err != nil {
@@ -41639,6 +44576,8 @@ func (v SignalExternalWorkflowExecutionFailedCause) MarshalText() ([]byte, error
 	switch int32(v) {
 	case 0:
 		return []byte("UNKNOWN_EXTERNAL_WORKFLOW_EXECUTION"), nil
+	case 1:
+		return []byte("TARGET_CLUSTER_UNREACHABLE"), nil
 	}
 	return []byte(strconv.FormatInt(int64(v), 10)), nil
 }
@@ -41652,6 +44591,8 @@ func (v SignalExternalWorkflowExecutionFailedCause) MarshalLogObject(enc zapcore
 	switch int32(v) {
 	case 0:
 		enc.AddString("name", "UNKNOWN_EXTERNAL_WORKFLOW_EXECUTION")
+	case 1:
+		enc.AddString("name", "TARGET_CLUSTER_UNREACHABLE")
 	}
 	return nil
 }
@@ -41694,6 +44635,8 @@ func (v SignalExternalWorkflowExecutionFailedCause) String() string {
 	switch w {
 	case 0:
 		return "UNKNOWN_EXTERNAL_WORKFLOW_EXECUTION"
+	case 1:
+		return "TARGET_CLUSTER_UNREACHABLE"
 	}
 	return fmt.Sprintf("SignalExternalWorkflowExecutionFailedCause(%d)", w)
 }
@@ -41714,6 +44657,8 @@ func (v SignalExternalWorkflowExecutionFailedCause) MarshalJSON() ([]byte, error
 	switch int32(v) {
 	case 0:
 		return ([]byte)("\"UNKNOWN_EXTERNAL_WORKFLOW_EXECUTION\""), nil
+	case 1:
+		return ([]byte)("\"TARGET_CLUSTER_UNREACHABLE\""), nil
 	}
 	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
 }
@@ -49928,6 +52873,9 @@ type UpdateDomainRequest struct {
 	ReplicationConfiguration *DomainReplicationConfiguration `json:"replicationConfiguration,omitempty"`
 	SecurityToken            *string                         `json:"securityToken,omitempty"`
 	DeleteBadBinary          *string                         `json:"deleteBadBinary,omitempty"`
+	FailoverTimeoutInSeconds *int32                          `json:"failoverTimeoutInSeconds,omitempty"`
+	FailoverReason           *string                         `json:"failoverReason,omitempty"`
+	PromoteToGlobalDomain    *bool                           `json:"promoteToGlobalDomain,omitempty"`
 }
 
 // ToWire translates a UpdateDomainRequest struct into a Thrift-level intermediate
@@ -49947,7 +52895,7 @@ type UpdateDomainRequest struct {
 //   }
 func (v *UpdateDomainRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [6]wire.Field
+		fields [9]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -50001,6 +52949,30 @@ func (v *UpdateDomainRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 60, Value: w}
 		i++
 	}
+	if v.FailoverTimeoutInSeconds != nil {
+		w, err = wire.NewValueI32(*(v.FailoverTimeoutInSeconds)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
+	if v.FailoverReason != nil {
+		w, err = wire.NewValueString(*(v.FailoverReason)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
+	if v.PromoteToGlobalDomain != nil {
+		w, err = wire.NewValueBool(*(v.PromoteToGlobalDomain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 90, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -50086,6 +53058,36 @@ func (v *UpdateDomainRequest) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 70:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.FailoverTimeoutInSeconds = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 80:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.FailoverReason = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 90:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.PromoteToGlobalDomain = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -50100,7 +53102,7 @@ func (v *UpdateDomainRequest) String() string {
 		return "<nil>"
 	}
 
-	var fields [6]string
+	var fields [9]string
 	i := 0
 	if v.Name != nil {
 		fields[i] = fmt.Sprintf("Name: %v", *(v.Name))
@@ -50126,6 +53128,18 @@ func (v *UpdateDomainRequest) String() string {
 		fields[i] = fmt.Sprintf("DeleteBadBinary: %v", *(v.DeleteBadBinary))
 		i++
 	}
+	if v.FailoverTimeoutInSeconds != nil {
+		fields[i] = fmt.Sprintf("FailoverTimeoutInSeconds: %v", *(v.FailoverTimeoutInSeconds))
+		i++
+	}
+	if v.FailoverReason != nil {
+		fields[i] = fmt.Sprintf("FailoverReason: %v", *(v.FailoverReason))
+		i++
+	}
+	if v.PromoteToGlobalDomain != nil {
+		fields[i] = fmt.Sprintf("PromoteToGlobalDomain: %v", *(v.PromoteToGlobalDomain))
+		i++
+	}
 
 	return fmt.Sprintf("UpdateDomainRequest{%v}", strings.Join(fields[:i], ", "))
 }
@@ -50158,6 +53172,15 @@ func (v *UpdateDomainRequest) Equals(rhs *UpdateDomainRequest) bool {
 	if !_String_EqualsPtr(v.DeleteBadBinary, rhs.DeleteBadBinary) {
 		return false
 	}
+	if !_I32_EqualsPtr(v.FailoverTimeoutInSeconds, rhs.FailoverTimeoutInSeconds) {
+		return false
+	}
+	if !_String_EqualsPtr(v.FailoverReason, rhs.FailoverReason) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.PromoteToGlobalDomain, rhs.PromoteToGlobalDomain) {
+		return false
+	}
 
 	return true
 }
@@ -50186,6 +53209,15 @@ func (v *UpdateDomainRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err e
 	if v.DeleteBadBinary != nil {
 		enc.AddString("deleteBadBinary", *v.DeleteBadBinary)
 	}
+	if v.FailoverTimeoutInSeconds != nil {
+		enc.AddInt32("failoverTimeoutInSeconds", *v.FailoverTimeoutInSeconds)
+	}
+	if v.FailoverReason != nil {
+		enc.AddString("failoverReason", *v.FailoverReason)
+	}
+	if v.PromoteToGlobalDomain != nil {
+		enc.AddBool("promoteToGlobalDomain", *v.PromoteToGlobalDomain)
+	}
 	return err
 }
 
@@ -50279,12 +53311,58 @@ func (v *UpdateDomainRequest) IsSetDeleteBadBinary() bool {
 	return v != nil && v.DeleteBadBinary != nil
 }
 
+// GetFailoverTimeoutInSeconds returns the value of FailoverTimeoutInSeconds if it is set or its
+// zero value if it is unset.
+func (v *UpdateDomainRequest) GetFailoverTimeoutInSeconds() (o int32) {
+	if v != nil && v.FailoverTimeoutInSeconds != nil {
+		return *v.FailoverTimeoutInSeconds
+	}
+
+	return
+}
+
+// IsSetFailoverTimeoutInSeconds returns true if FailoverTimeoutInSeconds is not nil.
+func (v *UpdateDomainRequest) IsSetFailoverTimeoutInSeconds() bool {
+	return v != nil && v.FailoverTimeoutInSeconds != nil
+}
+
+// GetFailoverReason returns the value of FailoverReason if it is set or its
+// zero value if it is unset.
+func (v *UpdateDomainRequest) GetFailoverReason() (o string) {
+	if v != nil && v.FailoverReason != nil {
+		return *v.FailoverReason
+	}
+
+	return
+}
+
+// IsSetFailoverReason returns true if FailoverReason is not nil.
+func (v *UpdateDomainRequest) IsSetFailoverReason() bool {
+	return v != nil && v.FailoverReason != nil
+}
+
+// GetPromoteToGlobalDomain returns the value of PromoteToGlobalDomain if it is set or its
+// zero value if it is unset.
+func (v *UpdateDomainRequest) GetPromoteToGlobalDomain() (o bool) {
+	if v != nil && v.PromoteToGlobalDomain != nil {
+		return *v.PromoteToGlobalDomain
+	}
+
+	return
+}
+
+// IsSetPromoteToGlobalDomain returns true if PromoteToGlobalDomain is not nil.
+func (v *UpdateDomainRequest) IsSetPromoteToGlobalDomain() bool {
+	return v != nil && v.PromoteToGlobalDomain != nil
+}
+
 type UpdateDomainResponse struct {
 	DomainInfo               *DomainInfo                     `json:"domainInfo,omitempty"`
 	Configuration            *DomainConfiguration            `json:"configuration,omitempty"`
 	ReplicationConfiguration *DomainReplicationConfiguration `json:"replicationConfiguration,omitempty"`
 	FailoverVersion          *int64                          `json:"failoverVersion,omitempty"`
 	IsGlobalDomain           *bool                           `json:"isGlobalDomain,omitempty"`
+	FailoverInfo             *DomainFailoverInfo             `json:"failoverInfo,omitempty"`
 }
 
 // ToWire translates a UpdateDomainResponse struct into a Thrift-level intermediate
@@ -50304,7 +53382,7 @@ type UpdateDomainResponse struct {
 //   }
 func (v *UpdateDomainResponse) ToWire() (wire.Value, error) {
 	var (
-		fields [5]wire.Field
+		fields [6]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -50350,6 +53428,14 @@ func (v *UpdateDomainResponse) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 50, Value: w}
 		i++
 	}
+	if v.FailoverInfo != nil {
+		w, err = v.FailoverInfo.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -50419,6 +53505,14 @@ func (v *UpdateDomainResponse) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 60:
+			if field.Value.Type() == wire.TStruct {
+				v.FailoverInfo, err = _DomainFailoverInfo_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -50433,7 +53527,7 @@ func (v *UpdateDomainResponse) String() string {
 		return "<nil>"
 	}
 
-	var fields [5]string
+	var fields [6]string
 	i := 0
 	if v.DomainInfo != nil {
 		fields[i] = fmt.Sprintf("DomainInfo: %v", v.DomainInfo)
@@ -50455,6 +53549,10 @@ func (v *UpdateDomainResponse) String() string {
 		fields[i] = fmt.Sprintf("IsGlobalDomain: %v", *(v.IsGlobalDomain))
 		i++
 	}
+	if v.FailoverInfo != nil {
+		fields[i] = fmt.Sprintf("FailoverInfo: %v", v.FailoverInfo)
+		i++
+	}
 
 	return fmt.Sprintf("UpdateDomainResponse{%v}", strings.Join(fields[:i], ", "))
 }
@@ -50484,6 +53582,9 @@ func (v *UpdateDomainResponse) Equals(rhs *UpdateDomainResponse) bool {
 	if !_Bool_EqualsPtr(v.IsGlobalDomain, rhs.IsGlobalDomain) {
 		return false
 	}
+	if !((v.FailoverInfo == nil && rhs.FailoverInfo == nil) || (v.FailoverInfo != nil && rhs.FailoverInfo != nil && v.FailoverInfo.Equals(rhs.FailoverInfo))) {
+		return false
+	}
 
 	return true
 }
@@ -50509,6 +53610,9 @@ func (v *UpdateDomainResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err
 	if v.IsGlobalDomain != nil {
 		enc.AddBool("isGlobalDomain", *v.IsGlobalDomain)
 	}
+	if v.FailoverInfo != nil {
+		err = multierr.Append(err, enc.AddObject("failoverInfo", v.FailoverInfo))
+	}
 	return err
 }
 
@@ -50587,8 +53691,24 @@ func (v *UpdateDomainResponse) IsSetIsGlobalDomain() bool {
 	return v != nil && v.IsGlobalDomain != nil
 }
 
+// GetFailoverInfo returns the value of FailoverInfo if it is set or its
+// zero value if it is unset.
+func (v *UpdateDomainResponse) GetFailoverInfo() (o *DomainFailoverInfo) {
+	if v != nil && v.FailoverInfo != nil {
+		return v.FailoverInfo
+	}
+
+	return
+}
+
+// IsSetFailoverInfo returns true if FailoverInfo is not nil.
+func (v *UpdateDomainResponse) IsSetFailoverInfo() bool {
+	return v != nil && v.FailoverInfo != nil
+}
+
 type UpsertWorkflowSearchAttributesDecisionAttributes struct {
-	SearchAttributes *SearchAttributes `json:"searchAttributes,omitempty"`
+	SearchAttributes       *SearchAttributes `json:"searchAttributes,omitempty"`
+	RemoveSearchAttributes []string          `json:"removeSearchAttributes,omitempty"`
 }
 
 // ToWire translates a UpsertWorkflowSearchAttributesDecisionAttributes struct into a Thrift-level intermediate
@@ -50608,7 +53728,7 @@ type UpsertWorkflowSearchAttributesDecisionAttributes struct {
 //   }
 func (v *UpsertWorkflowSearchAttributesDecisionAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [1]wire.Field
+		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -50622,6 +53742,14 @@ func (v *UpsertWorkflowSearchAttributesDecisionAttributes) ToWire() (wire.Value,
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
+	if v.RemoveSearchAttributes != nil {
+		w, err = wire.NewValueList(_List_String_ValueList(v.RemoveSearchAttributes)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -50655,6 +53783,14 @@ func (v *UpsertWorkflowSearchAttributesDecisionAttributes) FromWire(w wire.Value
 					return err
 				}
 
+			}
+		case 20:
+			if field.Value.Type() == wire.TList {
+				v.RemoveSearchAttributes, err = _List_String_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -50669,12 +53805,16 @@ func (v *UpsertWorkflowSearchAttributesDecisionAttributes) String() string {
 		return "<nil>"
 	}
 
-	var fields [1]string
+	var fields [2]string
 	i := 0
 	if v.SearchAttributes != nil {
 		fields[i] = fmt.Sprintf("SearchAttributes: %v", v.SearchAttributes)
 		i++
 	}
+	if v.RemoveSearchAttributes != nil {
+		fields[i] = fmt.Sprintf("RemoveSearchAttributes: %v", v.RemoveSearchAttributes)
+		i++
+	}
 
 	return fmt.Sprintf("UpsertWorkflowSearchAttributesDecisionAttributes{%v}", strings.Join(fields[:i], ", "))
 }
@@ -50692,6 +53832,9 @@ func (v *UpsertWorkflowSearchAttributesDecisionAttributes) Equals(rhs *UpsertWor
 	if !((v.SearchAttributes == nil && rhs.SearchAttributes == nil) || (v.SearchAttributes != nil && rhs.SearchAttributes != nil && v.SearchAttributes.Equals(rhs.SearchAttributes))) {
 		return false
 	}
+	if !((v.RemoveSearchAttributes == nil && rhs.RemoveSearchAttributes == nil) || (v.RemoveSearchAttributes != nil && rhs.RemoveSearchAttributes != nil && _List_String_Equals(v.RemoveSearchAttributes, rhs.RemoveSearchAttributes))) {
+		return false
+	}
 
 	return true
 }
@@ -50705,6 +53848,9 @@ func (v *UpsertWorkflowSearchAttributesDecisionAttributes) MarshalLogObject(enc
 	if v.SearchAttributes != nil {
 		err = multierr.Append(err, enc.AddObject("searchAttributes", v.SearchAttributes))
 	}
+	if v.RemoveSearchAttributes != nil {
+		err = multierr.Append(err, enc.AddArray("removeSearchAttributes", (_List_String_Zapper)(v.RemoveSearchAttributes)))
+	}
 	return err
 }
 
@@ -50723,9 +53869,25 @@ func (v *UpsertWorkflowSearchAttributesDecisionAttributes) IsSetSearchAttributes
 	return v != nil && v.SearchAttributes != nil
 }
 
+// GetRemoveSearchAttributes returns the value of RemoveSearchAttributes if it is set or its
+// zero value if it is unset.
+func (v *UpsertWorkflowSearchAttributesDecisionAttributes) GetRemoveSearchAttributes() (o []string) {
+	if v != nil && v.RemoveSearchAttributes != nil {
+		return v.RemoveSearchAttributes
+	}
+
+	return
+}
+
+// IsSetRemoveSearchAttributes returns true if RemoveSearchAttributes is not nil.
+func (v *UpsertWorkflowSearchAttributesDecisionAttributes) IsSetRemoveSearchAttributes() bool {
+	return v != nil && v.RemoveSearchAttributes != nil
+}
+
 type UpsertWorkflowSearchAttributesEventAttributes struct {
 	DecisionTaskCompletedEventId *int64            `json:"decisionTaskCompletedEventId,omitempty"`
 	SearchAttributes             *SearchAttributes `json:"searchAttributes,omitempty"`
+	RemoveSearchAttributes       []string          `json:"removeSearchAttributes,omitempty"`
 }
 
 // ToWire translates a UpsertWorkflowSearchAttributesEventAttributes struct into a Thrift-level intermediate
@@ -50745,7 +53907,7 @@ type UpsertWorkflowSearchAttributesEventAttributes struct {
 //   }
 func (v *UpsertWorkflowSearchAttributesEventAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [2]wire.Field
+		fields [3]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -50767,6 +53929,14 @@ func (v *UpsertWorkflowSearchAttributesEventAttributes) ToWire() (wire.Value, er
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
+	if v.RemoveSearchAttributes != nil {
+		w, err = wire.NewValueList(_List_String_ValueList(v.RemoveSearchAttributes)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -50810,6 +53980,14 @@ func (v *UpsertWorkflowSearchAttributesEventAttributes) FromWire(w wire.Value) e
 					return err
 				}
 
+			}
+		case 30:
+			if field.Value.Type() == wire.TList {
+				v.RemoveSearchAttributes, err = _List_String_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -50824,7 +54002,7 @@ func (v *UpsertWorkflowSearchAttributesEventAttributes) String() string {
 		return "<nil>"
 	}
 
-	var fields [2]string
+	var fields [3]string
 	i := 0
 	if v.DecisionTaskCompletedEventId != nil {
 		fields[i] = fmt.Sprintf("DecisionTaskCompletedEventId: %v", *(v.DecisionTaskCompletedEventId))
@@ -50834,6 +54012,10 @@ func (v *UpsertWorkflowSearchAttributesEventAttributes) String() string {
 		fields[i] = fmt.Sprintf("SearchAttributes: %v", v.SearchAttributes)
 		i++
 	}
+	if v.RemoveSearchAttributes != nil {
+		fields[i] = fmt.Sprintf("RemoveSearchAttributes: %v", v.RemoveSearchAttributes)
+		i++
+	}
 
 	return fmt.Sprintf("UpsertWorkflowSearchAttributesEventAttributes{%v}", strings.Join(fields[:i], ", "))
 }
@@ -50854,6 +54036,9 @@ func (v *UpsertWorkflowSearchAttributesEventAttributes) Equals(rhs *UpsertWorkfl
 	if !((v.SearchAttributes == nil && rhs.SearchAttributes == nil) || (v.SearchAttributes != nil && rhs.SearchAttributes != nil && v.SearchAttributes.Equals(rhs.SearchAttributes))) {
 		return false
 	}
+	if !((v.RemoveSearchAttributes == nil && rhs.RemoveSearchAttributes == nil) || (v.RemoveSearchAttributes != nil && rhs.RemoveSearchAttributes != nil && _List_String_Equals(v.RemoveSearchAttributes, rhs.RemoveSearchAttributes))) {
+		return false
+	}
 
 	return true
 }
@@ -50870,6 +54055,9 @@ func (v *UpsertWorkflowSearchAttributesEventAttributes) MarshalLogObject(enc zap
 	if v.SearchAttributes != nil {
 		err = multierr.Append(err, enc.AddObject("searchAttributes", v.SearchAttributes))
 	}
+	if v.RemoveSearchAttributes != nil {
+		err = multierr.Append(err, enc.AddArray("removeSearchAttributes", (_List_String_Zapper)(v.RemoveSearchAttributes)))
+	}
 	return err
 }
 
@@ -50903,6 +54091,21 @@ func (v *UpsertWorkflowSearchAttributesEventAttributes) IsSetSearchAttributes()
 	return v != nil && v.SearchAttributes != nil
 }
 
+// GetRemoveSearchAttributes returns the value of RemoveSearchAttributes if it is set or its
+// zero value if it is unset.
+func (v *UpsertWorkflowSearchAttributesEventAttributes) GetRemoveSearchAttributes() (o []string) {
+	if v != nil && v.RemoveSearchAttributes != nil {
+		return v.RemoveSearchAttributes
+	}
+
+	return
+}
+
+// IsSetRemoveSearchAttributes returns true if RemoveSearchAttributes is not nil.
+func (v *UpsertWorkflowSearchAttributesEventAttributes) IsSetRemoveSearchAttributes() bool {
+	return v != nil && v.RemoveSearchAttributes != nil
+}
+
 type VersionHistories struct {
 	CurrentVersionHistoryIndex *int32            `json:"currentVersionHistoryIndex,omitempty"`
 	Histories                  []*VersionHistory `json:"histories,omitempty"`
@@ -57673,8 +60876,8 @@ var ThriftModule = &thriftreflect.ThriftModule{
 	Name:     "shared",
 	Package:  "github.com/uber/cadence/.gen/go/shared",
 	FilePath: "shared.thrift",
-	SHA1:     "d60cb4cb426038a2fe9131a8d23c68a2730dbe88",
+	SHA1:     "a822f458a38ad68911a364364a4efbb065e07c4d",
 	Raw:      rawIDL,
 }
 
-const rawIDL = "// Copyright (c) 2017 Uber Technologies, Inc.\n//\n// Permission is hereby granted, free of charge, to any person obtaining a copy\n// of this software and associated documentation files (the \"Software\"), to deal\n// in the Software without restriction, including without limitation the rights\n// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell\n// copies of the Software, and to permit persons to whom the Software is\n// furnished to do so, subject to the following conditions:\n//\n// The above copyright notice and this permission notice shall be included in\n// all copies or substantial portions of the Software.\n//\n// THE SOFTWARE IS PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR\n// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,\n// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE\n// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER\n// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,\n// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN\n// THE SOFTWARE.\n\nnamespace java com.uber.cadence\n\nexception BadRequestError {\n  1: required string message\n}\n\nexception InternalServiceError {\n  1: required string message\n}\n\nexception DomainAlreadyExistsError {\n  1: required string message\n}\n\nexception WorkflowExecutionAlreadyStartedError {\n  10: optional string message\n  20: optional string startRequestId\n  30: optional string runId\n}\n\nexception EntityNotExistsError {\n  1: required string message\n}\n\nexception ServiceBusyError {\n  1: required string message\n}\n\nexception CancellationAlreadyRequestedError {\n  1: required string message\n}\n\nexception QueryFailedError {\n  1: required string message\n}\n\nexception DomainNotActiveError {\n  1: required string message\n  2: required string domainName\n  3: required string currentCluster\n  4: required string activeCluster\n}\n\nexception LimitExceededError {\n  1: required string message\n}\n\nexception AccessDeniedError {\n  1: required string message\n}\n\nexception RetryTaskError {\n  1: required string message\n  2: optional string domainId\n  3: optional string workflowId\n  4: optional string runId\n  5: optional i64 (js.type = \"Long\") nextEventId\n}\n\nexception RetryTaskV2Error {\n  1: required string message\n  2: optional string domainId\n  3: optional string workflowId\n  4: optional string runId\n  5: optional i64 (js.type = \"Long\") startEventId\n  6: optional i64 (js.type = \"Long\") startEventVersion\n  7: optional i64 (js.type = \"Long\") endEventId\n  8: optional i64 (js.type = \"Long\") endEventVersion\n}\n\nexception ClientVersionNotSupportedError {\n  1: required string featureVersion\n  2: required string clientImpl\n  3: required string supportedVersions\n}\n\nexception CurrentBranchChangedError {\n  10: required string message\n  20: required binary currentBranchToken\n}\n\nenum WorkflowIdReusePolicy {\n  /*\n   * allow start a workflow execution using the same workflow ID,\n   * when workflow not running, and the last execution close state is in\n   * [terminated, cancelled, timeouted, failed].\n   */\n  AllowDuplicateFailedOnly,\n  /*\n   * allow start a workflow execution using the same workflow ID,\n   * when workflow not running.\n   */\n  AllowDuplicate,\n  /*\n   * do not allow start a workflow execution using the same workflow ID at all\n   */\n  RejectDuplicate,\n}\n\nenum DomainStatus {\n  REGISTERED,\n  DEPRECATED,\n  DELETED,\n}\n\nenum TimeoutType {\n  START_TO_CLOSE,\n  SCHEDULE_TO_START,\n  SCHEDULE_TO_CLOSE,\n  HEARTBEAT,\n}\n\nenum ParentClosePolicy {\n\tABANDON,\n\tREQUEST_CANCEL,\n\tTERMINATE,\n}\n\n\n// whenever this list of decision is changed\n// do change the mutableStateBuilder.go\n// function shouldBufferEvent\n// to make sure wo do the correct event ordering\nenum DecisionType {\n  ScheduleActivityTask,\n  RequestCancelActivityTask,\n  StartTimer,\n  CompleteWorkflowExecution,\n  FailWorkflowExecution,\n  CancelTimer,\n  CancelWorkflowExecution,\n  RequestCancelExternalWorkflowExecution,\n  RecordMarker,\n  ContinueAsNewWorkflowExecution,\n  StartChildWorkflowExecution,\n  SignalExternalWorkflowExecution,\n  UpsertWorkflowSearchAttributes,\n}\n\nenum EventType {\n  WorkflowExecutionStarted,\n  WorkflowExecutionCompleted,\n  WorkflowExecutionFailed,\n  WorkflowExecutionTimedOut,\n  DecisionTaskScheduled,\n  DecisionTaskStarted,\n  DecisionTaskCompleted,\n  DecisionTaskTimedOut\n  DecisionTaskFailed,\n  ActivityTaskScheduled,\n  ActivityTaskStarted,\n  ActivityTaskCompleted,\n  ActivityTaskFailed,\n  ActivityTaskTimedOut,\n  ActivityTaskCancelRequested,\n  RequestCancelActivityTaskFailed,\n  ActivityTaskCanceled,\n  TimerStarted,\n  TimerFired,\n  CancelTimerFailed,\n  TimerCanceled,\n  WorkflowExecutionCancelRequested,\n  WorkflowExecutionCanceled,\n  RequestCancelExternalWorkflowExecutionInitiated,\n  RequestCancelExternalWorkflowExecutionFailed,\n  ExternalWorkflowExecutionCancelRequested,\n  MarkerRecorded,\n  WorkflowExecutionSignaled,\n  WorkflowExecutionTerminated,\n  WorkflowExecutionContinuedAsNew,\n  StartChildWorkflowExecutionInitiated,\n  StartChildWorkflowExecutionFailed,\n  ChildWorkflowExecutionStarted,\n  ChildWorkflowExecutionCompleted,\n  ChildWorkflowExecutionFailed,\n  ChildWorkflowExecutionCanceled,\n  ChildWorkflowExecutionTimedOut,\n  ChildWorkflowExecutionTerminated,\n  SignalExternalWorkflowExecutionInitiated,\n  SignalExternalWorkflowExecutionFailed,\n  ExternalWorkflowExecutionSignaled,\n  UpsertWorkflowSearchAttributes,\n}\n\nenum DecisionTaskFailedCause {\n  UNHANDLED_DECISION,\n  BAD_SCHEDULE_ACTIVITY_ATTRIBUTES,\n  BAD_REQUEST_CANCEL_ACTIVITY_ATTRIBUTES,\n  BAD_START_TIMER_ATTRIBUTES,\n  BAD_CANCEL_TIMER_ATTRIBUTES,\n  BAD_RECORD_MARKER_ATTRIBUTES,\n  BAD_COMPLETE_WORKFLOW_EXECUTION_ATTRIBUTES,\n  BAD_FAIL_WORKFLOW_EXECUTION_ATTRIBUTES,\n  BAD_CANCEL_WORKFLOW_EXECUTION_ATTRIBUTES,\n  BAD_REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_ATTRIBUTES,\n  BAD_CONTINUE_AS_NEW_ATTRIBUTES,\n  START_TIMER_DUPLICATE_ID,\n  RESET_STICKY_TASKLIST,\n  WORKFLOW_WORKER_UNHANDLED_FAILURE,\n  BAD_SIGNAL_WORKFLOW_EXECUTION_ATTRIBUTES,\n  BAD_START_CHILD_EXECUTION_ATTRIBUTES,\n  FORCE_CLOSE_DECISION,\n  FAILOVER_CLOSE_DECISION,\n  BAD_SIGNAL_INPUT_SIZE,\n  RESET_WORKFLOW,\n  BAD_BINARY,\n  SCHEDULE_ACTIVITY_DUPLICATE_ID,\n  BAD_SEARCH_ATTRIBUTES,\n}\n\nenum CancelExternalWorkflowExecutionFailedCause {\n  UNKNOWN_EXTERNAL_WORKFLOW_EXECUTION,\n}\n\nenum SignalExternalWorkflowExecutionFailedCause {\n  UNKNOWN_EXTERNAL_WORKFLOW_EXECUTION,\n}\n\nenum ChildWorkflowExecutionFailedCause {\n  WORKFLOW_ALREADY_RUNNING,\n}\n\n// TODO: when migrating to gRPC, add a running / none status,\n//  currently, customer is using null / nil as an indication\n//  that workflow is still running\nenum WorkflowExecutionCloseStatus {\n  COMPLETED,\n  FAILED,\n  CANCELED,\n  TERMINATED,\n  CONTINUED_AS_NEW,\n  TIMED_OUT,\n}\n\nenum QueryTaskCompletedType {\n  COMPLETED,\n  FAILED,\n}\n\nenum QueryResultType {\n  ANSWERED,\n  FAILED,\n}\n\nenum PendingActivityState {\n  SCHEDULED,\n  STARTED,\n  CANCEL_REQUESTED,\n}\n\nenum HistoryEventFilterType {\n  ALL_EVENT,\n  CLOSE_EVENT,\n}\n\nenum TaskListKind {\n  NORMAL,\n  STICKY,\n}\n\nenum ArchivalStatus {\n  DISABLED,\n  ENABLED,\n}\n\nenum IndexedValueType {\n  STRING,\n  KEYWORD,\n  INT,\n  DOUBLE,\n  BOOL,\n  DATETIME,\n}\n\nstruct Header {\n    10: optional map<string, binary> fields\n}\n\nstruct WorkflowType {\n  10: optional string name\n}\n\nstruct ActivityType {\n  10: optional string name\n}\n\nstruct TaskList {\n  10: optional string name\n  20: optional TaskListKind kind\n}\n\nenum EncodingType {\n  ThriftRW,\n  JSON,\n}\n\nenum QueryRejectCondition {\n  // NOT_OPEN indicates that query should be rejected if workflow is not open\n  NOT_OPEN\n  // NOT_COMPLETED_CLEANLY indicates that query should be rejected if workflow did not complete cleanly\n  NOT_COMPLETED_CLEANLY\n}\n\nenum QueryConsistencyLevel {\n  // EVENTUAL indicates that query should be eventually consistent\n  EVENTUAL\n  // STRONG indicates that any events that came before query should be reflected in workflow state before running query\n  STRONG\n}\n\nstruct DataBlob {\n  10: optional EncodingType EncodingType\n  20: optional binary Data\n}\n\nstruct ReplicationInfo {\n  10: optional i64 (js.type = \"Long\") version\n  20: optional i64 (js.type = \"Long\") lastEventId\n}\n\nstruct TaskListMetadata {\n  10: optional double maxTasksPerSecond\n}\n\nstruct WorkflowExecution {\n  10: optional string workflowId\n  20: optional string runId\n}\n\nstruct Memo {\n  10: optional map<string,binary> fields\n}\n\nstruct SearchAttributes {\n  10: optional map<string,binary> indexedFields\n}\n\nstruct WorkerVersionInfo {\n  10: optional string impl\n  20: optional string featureVersion\n}\n\nstruct WorkflowExecutionInfo {\n  10: optional WorkflowExecution execution\n  20: optional WorkflowType type\n  30: optional i64 (js.type = \"Long\") startTime\n  40: optional i64 (js.type = \"Long\") closeTime\n  50: optional WorkflowExecutionCloseStatus closeStatus\n  60: optional i64 (js.type = \"Long\") historyLength\n  70: optional string parentDomainId\n  80: optional WorkflowExecution parentExecution\n  90: optional i64 (js.type = \"Long\") executionTime\n  100: optional Memo memo\n  101: optional SearchAttributes searchAttributes\n  110: optional ResetPoints autoResetPoints\n}\n\nstruct WorkflowExecutionConfiguration {\n  10: optional TaskList taskList\n  20: optional i32 executionStartToCloseTimeoutSeconds\n  30: optional i32 taskStartToCloseTimeoutSeconds\n//  40: optional ChildPolicy childPolicy -- Removed but reserve the IDL order number\n}\n\nstruct TransientDecisionInfo {\n  10: optional HistoryEvent scheduledEvent\n  20: optional HistoryEvent startedEvent\n}\n\nstruct ScheduleActivityTaskDecisionAttributes {\n  10: optional string activityId\n  20: optional ActivityType activityType\n  25: optional string domain\n  30: optional TaskList taskList\n  40: optional binary input\n  45: optional i32 scheduleToCloseTimeoutSeconds\n  50: optional i32 scheduleToStartTimeoutSeconds\n  55: optional i32 startToCloseTimeoutSeconds\n  60: optional i32 heartbeatTimeoutSeconds\n  70: optional RetryPolicy retryPolicy\n  80: optional Header header\n}\n\nstruct RequestCancelActivityTaskDecisionAttributes {\n  10: optional string activityId\n}\n\nstruct StartTimerDecisionAttributes {\n  10: optional string timerId\n  20: optional i64 (js.type = \"Long\") startToFireTimeoutSeconds\n}\n\nstruct CompleteWorkflowExecutionDecisionAttributes {\n  10: optional binary result\n}\n\nstruct FailWorkflowExecutionDecisionAttributes {\n  10: optional string reason\n  20: optional binary details\n}\n\nstruct CancelTimerDecisionAttributes {\n  10: optional string timerId\n}\n\nstruct CancelWorkflowExecutionDecisionAttributes {\n  10: optional binary details\n}\n\nstruct RequestCancelExternalWorkflowExecutionDecisionAttributes {\n  10: optional string domain\n  20: optional string workflowId\n  30: optional string runId\n  40: optional binary control\n  50: optional bool childWorkflowOnly\n}\n\nstruct SignalExternalWorkflowExecutionDecisionAttributes {\n  10: optional string domain\n  20: optional WorkflowExecution execution\n  30: optional string signalName\n  40: optional binary input\n  50: optional binary control\n  60: optional bool childWorkflowOnly\n}\n\nstruct UpsertWorkflowSearchAttributesDecisionAttributes {\n  10: optional SearchAttributes searchAttributes\n}\n\nstruct RecordMarkerDecisionAttributes {\n  10: optional string markerName\n  20: optional binary details\n  30: optional Header header\n}\n\nstruct ContinueAsNewWorkflowExecutionDecisionAttributes {\n  10: optional WorkflowType workflowType\n  20: optional TaskList taskList\n  30: optional binary input\n  40: optional i32 executionStartToCloseTimeoutSeconds\n  50: optional i32 taskStartToCloseTimeoutSeconds\n  60: optional i32 backoffStartIntervalInSeconds\n  70: optional RetryPolicy retryPolicy\n  80: optional ContinueAsNewInitiator initiator\n  90: optional string failureReason\n  100: optional binary failureDetails\n  110: optional binary lastCompletionResult\n  120: optional string cronSchedule\n  130: optional Header header\n  140: optional Memo memo\n  150: optional SearchAttributes searchAttributes\n}\n\nstruct StartChildWorkflowExecutionDecisionAttributes {\n  10: optional string domain\n  20: optional string workflowId\n  30: optional WorkflowType workflowType\n  40: optional TaskList taskList\n  50: optional binary input\n  60: optional i32 executionStartToCloseTimeoutSeconds\n  70: optional i32 taskStartToCloseTimeoutSeconds\n//  80: optional ChildPolicy childPolicy -- Removed but reserve the IDL order number\n  81: optional ParentClosePolicy parentClosePolicy\n  90: optional binary control\n  100: optional WorkflowIdReusePolicy workflowIdReusePolicy\n  110: optional RetryPolicy retryPolicy\n  120: optional string cronSchedule\n  130: optional Header header\n  140: optional Memo memo\n  150: optional SearchAttributes searchAttributes\n}\n\nstruct Decision {\n  10:  optional DecisionType decisionType\n  20:  optional ScheduleActivityTaskDecisionAttributes scheduleActivityTaskDecisionAttributes\n  25:  optional StartTimerDecisionAttributes startTimerDecisionAttributes\n  30:  optional CompleteWorkflowExecutionDecisionAttributes completeWorkflowExecutionDecisionAttributes\n  35:  optional FailWorkflowExecutionDecisionAttributes failWorkflowExecutionDecisionAttributes\n  40:  optional RequestCancelActivityTaskDecisionAttributes requestCancelActivityTaskDecisionAttributes\n  50:  optional CancelTimerDecisionAttributes cancelTimerDecisionAttributes\n  60:  optional CancelWorkflowExecutionDecisionAttributes cancelWorkflowExecutionDecisionAttributes\n  70:  optional RequestCancelExternalWorkflowExecutionDecisionAttributes requestCancelExternalWorkflowExecutionDecisionAttributes\n  80:  optional RecordMarkerDecisionAttributes recordMarkerDecisionAttributes\n  90:  optional ContinueAsNewWorkflowExecutionDecisionAttributes continueAsNewWorkflowExecutionDecisionAttributes\n  100: optional StartChildWorkflowExecutionDecisionAttributes startChildWorkflowExecutionDecisionAttributes\n  110: optional SignalExternalWorkflowExecutionDecisionAttributes signalExternalWorkflowExecutionDecisionAttributes\n  120: optional UpsertWorkflowSearchAttributesDecisionAttributes upsertWorkflowSearchAttributesDecisionAttributes\n}\n\nstruct WorkflowExecutionStartedEventAttributes {\n  10: optional WorkflowType workflowType\n  12: optional string parentWorkflowDomain\n  14: optional WorkflowExecution parentWorkflowExecution\n  16: optional i64 (js.type = \"Long\") parentInitiatedEventId\n  20: optional TaskList taskList\n  30: optional binary input\n  40: optional i32 executionStartToCloseTimeoutSeconds\n  50: optional i32 taskStartToCloseTimeoutSeconds\n//  52: optional ChildPolicy childPolicy -- Removed but reserve the IDL order number\n  54: optional string continuedExecutionRunId\n  55: optional ContinueAsNewInitiator initiator\n  56: optional string continuedFailureReason\n  57: optional binary continuedFailureDetails\n  58: optional binary lastCompletionResult\n  59: optional string originalExecutionRunId // This is the runID when the WorkflowExecutionStarted event is written\n  60: optional string identity\n  61: optional string firstExecutionRunId // This is the very first runID along the chain of ContinueAsNew and Reset.\n  70: optional RetryPolicy retryPolicy\n  80: optional i32 attempt\n  90: optional i64 (js.type = \"Long\") expirationTimestamp\n  100: optional string cronSchedule\n  110: optional i32 firstDecisionTaskBackoffSeconds\n  120: optional Memo memo\n  121: optional SearchAttributes searchAttributes\n  130: optional ResetPoints prevAutoResetPoints\n  140: optional Header header\n}\n\nstruct ResetPoints{\n  10: optional list<ResetPointInfo> points\n}\n\n struct ResetPointInfo{\n  10: optional string binaryChecksum\n  20: optional string runId\n  30: optional i64 firstDecisionCompletedId\n  40: optional i64 (js.type = \"Long\") createdTimeNano\n  50: optional i64 (js.type = \"Long\") expiringTimeNano //the time that the run is deleted due to retention\n  60: optional bool resettable                         // false if the resset point has pending childWFs/reqCancels/signalExternals.\n}\n\nstruct WorkflowExecutionCompletedEventAttributes {\n  10: optional binary result\n  20: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n}\n\nstruct WorkflowExecutionFailedEventAttributes {\n  10: optional string reason\n  20: optional binary details\n  30: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n}\n\nstruct WorkflowExecutionTimedOutEventAttributes {\n  10: optional TimeoutType timeoutType\n}\n\nenum ContinueAsNewInitiator {\n  Decider,\n  RetryPolicy,\n  CronSchedule,\n}\n\nstruct WorkflowExecutionContinuedAsNewEventAttributes {\n  10: optional string newExecutionRunId\n  20: optional WorkflowType workflowType\n  30: optional TaskList taskList\n  40: optional binary input\n  50: optional i32 executionStartToCloseTimeoutSeconds\n  60: optional i32 taskStartToCloseTimeoutSeconds\n  70: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  80: optional i32 backoffStartIntervalInSeconds\n  90: optional ContinueAsNewInitiator initiator\n  100: optional string failureReason\n  110: optional binary failureDetails\n  120: optional binary lastCompletionResult\n  130: optional Header header\n  140: optional Memo memo\n  150: optional SearchAttributes searchAttributes\n}\n\nstruct DecisionTaskScheduledEventAttributes {\n  10: optional TaskList taskList\n  20: optional i32 startToCloseTimeoutSeconds\n  30: optional i64 (js.type = \"Long\") attempt\n}\n\nstruct DecisionTaskStartedEventAttributes {\n  10: optional i64 (js.type = \"Long\") scheduledEventId\n  20: optional string identity\n  30: optional string requestId\n}\n\nstruct DecisionTaskCompletedEventAttributes {\n  10: optional binary executionContext\n  20: optional i64 (js.type = \"Long\") scheduledEventId\n  30: optional i64 (js.type = \"Long\") startedEventId\n  40: optional string identity\n  50: optional string binaryChecksum\n}\n\nstruct DecisionTaskTimedOutEventAttributes {\n  10: optional i64 (js.type = \"Long\") scheduledEventId\n  20: optional i64 (js.type = \"Long\") startedEventId\n  30: optional TimeoutType timeoutType\n}\n\nstruct DecisionTaskFailedEventAttributes {\n  10: optional i64 (js.type = \"Long\") scheduledEventId\n  20: optional i64 (js.type = \"Long\") startedEventId\n  30: optional DecisionTaskFailedCause cause\n  35: optional binary details\n  40: optional string identity\n  50: optional string reason\n  // for reset workflow\n  60: optional string baseRunId\n  70: optional string newRunId\n  80: optional i64 (js.type = \"Long\") forkEventVersion\n}\n\nstruct ActivityTaskScheduledEventAttributes {\n  10: optional string activityId\n  20: optional ActivityType activityType\n  25: optional string domain\n  30: optional TaskList taskList\n  40: optional binary input\n  45: optional i32 scheduleToCloseTimeoutSeconds\n  50: optional i32 scheduleToStartTimeoutSeconds\n  55: optional i32 startToCloseTimeoutSeconds\n  60: optional i32 heartbeatTimeoutSeconds\n  90: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  110: optional RetryPolicy retryPolicy\n  120: optional Header header\n}\n\nstruct ActivityTaskStartedEventAttributes {\n  10: optional i64 (js.type = \"Long\") scheduledEventId\n  20: optional string identity\n  30: optional string requestId\n  40: optional i32 attempt\n}\n\nstruct ActivityTaskCompletedEventAttributes {\n  10: optional binary result\n  20: optional i64 (js.type = \"Long\") scheduledEventId\n  30: optional i64 (js.type = \"Long\") startedEventId\n  40: optional string identity\n}\n\nstruct ActivityTaskFailedEventAttributes {\n  10: optional string reason\n  20: optional binary details\n  30: optional i64 (js.type = \"Long\") scheduledEventId\n  40: optional i64 (js.type = \"Long\") startedEventId\n  50: optional string identity\n}\n\nstruct ActivityTaskTimedOutEventAttributes {\n  05: optional binary details\n  10: optional i64 (js.type = \"Long\") scheduledEventId\n  20: optional i64 (js.type = \"Long\") startedEventId\n  30: optional TimeoutType timeoutType\n  // For retry activity, it may have a failure before timeout. It's important to keep those information for debug.\n  // Client can also provide the info for making next decision\n  40: optional string lastFailureReason\n  50: optional binary lastFailureDetails\n}\n\nstruct ActivityTaskCancelRequestedEventAttributes {\n  10: optional string activityId\n  20: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n}\n\nstruct RequestCancelActivityTaskFailedEventAttributes{\n  10: optional string activityId\n  20: optional string cause\n  30: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n}\n\nstruct ActivityTaskCanceledEventAttributes {\n  10: optional binary details\n  20: optional i64 (js.type = \"Long\") latestCancelRequestedEventId\n  30: optional i64 (js.type = \"Long\") scheduledEventId\n  40: optional i64 (js.type = \"Long\") startedEventId\n  50: optional string identity\n}\n\nstruct TimerStartedEventAttributes {\n  10: optional string timerId\n  20: optional i64 (js.type = \"Long\") startToFireTimeoutSeconds\n  30: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n}\n\nstruct TimerFiredEventAttributes {\n  10: optional string timerId\n  20: optional i64 (js.type = \"Long\") startedEventId\n}\n\nstruct TimerCanceledEventAttributes {\n  10: optional string timerId\n  20: optional i64 (js.type = \"Long\") startedEventId\n  30: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  40: optional string identity\n}\n\nstruct CancelTimerFailedEventAttributes {\n  10: optional string timerId\n  20: optional string cause\n  30: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  40: optional string identity\n}\n\nstruct WorkflowExecutionCancelRequestedEventAttributes {\n  10: optional string cause\n  20: optional i64 (js.type = \"Long\") externalInitiatedEventId\n  30: optional WorkflowExecution externalWorkflowExecution\n  40: optional string identity\n}\n\nstruct WorkflowExecutionCanceledEventAttributes {\n  10: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  20: optional binary details\n}\n\nstruct MarkerRecordedEventAttributes {\n  10: optional string markerName\n  20: optional binary details\n  30: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  40: optional Header header\n}\n\nstruct WorkflowExecutionSignaledEventAttributes {\n  10: optional string signalName\n  20: optional binary input\n  30: optional string identity\n}\n\nstruct WorkflowExecutionTerminatedEventAttributes {\n  10: optional string reason\n  20: optional binary details\n  30: optional string identity\n}\n\nstruct RequestCancelExternalWorkflowExecutionInitiatedEventAttributes {\n  10: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n  40: optional binary control\n  50: optional bool childWorkflowOnly\n}\n\nstruct RequestCancelExternalWorkflowExecutionFailedEventAttributes {\n  10: optional CancelExternalWorkflowExecutionFailedCause cause\n  20: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  30: optional string domain\n  40: optional WorkflowExecution workflowExecution\n  50: optional i64 (js.type = \"Long\") initiatedEventId\n  60: optional binary control\n}\n\nstruct ExternalWorkflowExecutionCancelRequestedEventAttributes {\n  10: optional i64 (js.type = \"Long\") initiatedEventId\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n}\n\nstruct SignalExternalWorkflowExecutionInitiatedEventAttributes {\n  10: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n  40: optional string signalName\n  50: optional binary input\n  60: optional binary control\n  70: optional bool childWorkflowOnly\n}\n\nstruct SignalExternalWorkflowExecutionFailedEventAttributes {\n  10: optional SignalExternalWorkflowExecutionFailedCause cause\n  20: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  30: optional string domain\n  40: optional WorkflowExecution workflowExecution\n  50: optional i64 (js.type = \"Long\") initiatedEventId\n  60: optional binary control\n}\n\nstruct ExternalWorkflowExecutionSignaledEventAttributes {\n  10: optional i64 (js.type = \"Long\") initiatedEventId\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n  40: optional binary control\n}\n\nstruct UpsertWorkflowSearchAttributesEventAttributes {\n  10: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  20: optional SearchAttributes searchAttributes\n}\n\nstruct StartChildWorkflowExecutionInitiatedEventAttributes {\n  10:  optional string domain\n  20:  optional string workflowId\n  30:  optional WorkflowType workflowType\n  40:  optional TaskList taskList\n  50:  optional binary input\n  60:  optional i32 executionStartToCloseTimeoutSeconds\n  70:  optional i32 taskStartToCloseTimeoutSeconds\n//  80:  optional ChildPolicy childPolicy -- Removed but reserve the IDL order number\n  81:  optional ParentClosePolicy parentClosePolicy\n  90:  optional binary control\n  100: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  110: optional WorkflowIdReusePolicy workflowIdReusePolicy\n  120: optional RetryPolicy retryPolicy\n  130: optional string cronSchedule\n  140: optional Header header\n  150: optional Memo memo\n  160: optional SearchAttributes searchAttributes\n}\n\nstruct StartChildWorkflowExecutionFailedEventAttributes {\n  10: optional string domain\n  20: optional string workflowId\n  30: optional WorkflowType workflowType\n  40: optional ChildWorkflowExecutionFailedCause cause\n  50: optional binary control\n  60: optional i64 (js.type = \"Long\") initiatedEventId\n  70: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n}\n\nstruct ChildWorkflowExecutionStartedEventAttributes {\n  10: optional string domain\n  20: optional i64 (js.type = \"Long\") initiatedEventId\n  30: optional WorkflowExecution workflowExecution\n  40: optional WorkflowType workflowType\n  50: optional Header header\n}\n\nstruct ChildWorkflowExecutionCompletedEventAttributes {\n  10: optional binary result\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n  40: optional WorkflowType workflowType\n  50: optional i64 (js.type = \"Long\") initiatedEventId\n  60: optional i64 (js.type = \"Long\") startedEventId\n}\n\nstruct ChildWorkflowExecutionFailedEventAttributes {\n  10: optional string reason\n  20: optional binary details\n  30: optional string domain\n  40: optional WorkflowExecution workflowExecution\n  50: optional WorkflowType workflowType\n  60: optional i64 (js.type = \"Long\") initiatedEventId\n  70: optional i64 (js.type = \"Long\") startedEventId\n}\n\nstruct ChildWorkflowExecutionCanceledEventAttributes {\n  10: optional binary details\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n  40: optional WorkflowType workflowType\n  50: optional i64 (js.type = \"Long\") initiatedEventId\n  60: optional i64 (js.type = \"Long\") startedEventId\n}\n\nstruct ChildWorkflowExecutionTimedOutEventAttributes {\n  10: optional TimeoutType timeoutType\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n  40: optional WorkflowType workflowType\n  50: optional i64 (js.type = \"Long\") initiatedEventId\n  60: optional i64 (js.type = \"Long\") startedEventId\n}\n\nstruct ChildWorkflowExecutionTerminatedEventAttributes {\n  10: optional string domain\n  20: optional WorkflowExecution workflowExecution\n  30: optional WorkflowType workflowType\n  40: optional i64 (js.type = \"Long\") initiatedEventId\n  50: optional i64 (js.type = \"Long\") startedEventId\n}\n\nstruct HistoryEvent {\n  10:  optional i64 (js.type = \"Long\") eventId\n  20:  optional i64 (js.type = \"Long\") timestamp\n  30:  optional EventType eventType\n  35:  optional i64 (js.type = \"Long\") version\n  36:  optional i64 (js.type = \"Long\") taskId\n  40:  optional WorkflowExecutionStartedEventAttributes workflowExecutionStartedEventAttributes\n  50:  optional WorkflowExecutionCompletedEventAttributes workflowExecutionCompletedEventAttributes\n  60:  optional WorkflowExecutionFailedEventAttributes workflowExecutionFailedEventAttributes\n  70:  optional WorkflowExecutionTimedOutEventAttributes workflowExecutionTimedOutEventAttributes\n  80:  optional DecisionTaskScheduledEventAttributes decisionTaskScheduledEventAttributes\n  90:  optional DecisionTaskStartedEventAttributes decisionTaskStartedEventAttributes\n  100: optional DecisionTaskCompletedEventAttributes decisionTaskCompletedEventAttributes\n  110: optional DecisionTaskTimedOutEventAttributes decisionTaskTimedOutEventAttributes\n  120: optional DecisionTaskFailedEventAttributes decisionTaskFailedEventAttributes\n  130: optional ActivityTaskScheduledEventAttributes activityTaskScheduledEventAttributes\n  140: optional ActivityTaskStartedEventAttributes activityTaskStartedEventAttributes\n  150: optional ActivityTaskCompletedEventAttributes activityTaskCompletedEventAttributes\n  160: optional ActivityTaskFailedEventAttributes activityTaskFailedEventAttributes\n  170: optional ActivityTaskTimedOutEventAttributes activityTaskTimedOutEventAttributes\n  180: optional TimerStartedEventAttributes timerStartedEventAttributes\n  190: optional TimerFiredEventAttributes timerFiredEventAttributes\n  200: optional ActivityTaskCancelRequestedEventAttributes activityTaskCancelRequestedEventAttributes\n  210: optional RequestCancelActivityTaskFailedEventAttributes requestCancelActivityTaskFailedEventAttributes\n  220: optional ActivityTaskCanceledEventAttributes activityTaskCanceledEventAttributes\n  230: optional TimerCanceledEventAttributes timerCanceledEventAttributes\n  240: optional CancelTimerFailedEventAttributes cancelTimerFailedEventAttributes\n  250: optional MarkerRecordedEventAttributes markerRecordedEventAttributes\n  260: optional WorkflowExecutionSignaledEventAttributes workflowExecutionSignaledEventAttributes\n  270: optional WorkflowExecutionTerminatedEventAttributes workflowExecutionTerminatedEventAttributes\n  280: optional WorkflowExecutionCancelRequestedEventAttributes workflowExecutionCancelRequestedEventAttributes\n  290: optional WorkflowExecutionCanceledEventAttributes workflowExecutionCanceledEventAttributes\n  300: optional RequestCancelExternalWorkflowExecutionInitiatedEventAttributes requestCancelExternalWorkflowExecutionInitiatedEventAttributes\n  310: optional RequestCancelExternalWorkflowExecutionFailedEventAttributes requestCancelExternalWorkflowExecutionFailedEventAttributes\n  320: optional ExternalWorkflowExecutionCancelRequestedEventAttributes externalWorkflowExecutionCancelRequestedEventAttributes\n  330: optional WorkflowExecutionContinuedAsNewEventAttributes workflowExecutionContinuedAsNewEventAttributes\n  340: optional StartChildWorkflowExecutionInitiatedEventAttributes startChildWorkflowExecutionInitiatedEventAttributes\n  350: optional StartChildWorkflowExecutionFailedEventAttributes startChildWorkflowExecutionFailedEventAttributes\n  360: optional ChildWorkflowExecutionStartedEventAttributes childWorkflowExecutionStartedEventAttributes\n  370: optional ChildWorkflowExecutionCompletedEventAttributes childWorkflowExecutionCompletedEventAttributes\n  380: optional ChildWorkflowExecutionFailedEventAttributes childWorkflowExecutionFailedEventAttributes\n  390: optional ChildWorkflowExecutionCanceledEventAttributes childWorkflowExecutionCanceledEventAttributes\n  400: optional ChildWorkflowExecutionTimedOutEventAttributes childWorkflowExecutionTimedOutEventAttributes\n  410: optional ChildWorkflowExecutionTerminatedEventAttributes childWorkflowExecutionTerminatedEventAttributes\n  420: optional SignalExternalWorkflowExecutionInitiatedEventAttributes signalExternalWorkflowExecutionInitiatedEventAttributes\n  430: optional SignalExternalWorkflowExecutionFailedEventAttributes signalExternalWorkflowExecutionFailedEventAttributes\n  440: optional ExternalWorkflowExecutionSignaledEventAttributes externalWorkflowExecutionSignaledEventAttributes\n  450: optional UpsertWorkflowSearchAttributesEventAttributes upsertWorkflowSearchAttributesEventAttributes\n}\n\nstruct History {\n  10: optional list<HistoryEvent> events\n}\n\nstruct WorkflowExecutionFilter {\n  10: optional string workflowId\n  20: optional string runId\n}\n\nstruct WorkflowTypeFilter {\n  10: optional string name\n}\n\nstruct StartTimeFilter {\n  10: optional i64 (js.type = \"Long\") earliestTime\n  20: optional i64 (js.type = \"Long\") latestTime\n}\n\nstruct DomainInfo {\n  10: optional string name\n  20: optional DomainStatus status\n  30: optional string description\n  40: optional string ownerEmail\n  // A key-value map for any customized purpose\n  50: optional map<string,string> data\n  60: optional string uuid\n}\n\nstruct DomainConfiguration {\n  10: optional i32 workflowExecutionRetentionPeriodInDays\n  20: optional bool emitMetric\n  70: optional BadBinaries badBinaries\n  80: optional ArchivalStatus historyArchivalStatus\n  90: optional string historyArchivalURI\n  100: optional ArchivalStatus visibilityArchivalStatus\n  110: optional string visibilityArchivalURI\n}\n\nstruct BadBinaries{\n  10: optional map<string, BadBinaryInfo> binaries\n}\n\nstruct BadBinaryInfo{\n  10: optional string reason\n  20: optional string operator\n  30: optional i64 (js.type = \"Long\") createdTimeNano\n}\n\nstruct UpdateDomainInfo {\n  10: optional string description\n  20: optional string ownerEmail\n  // A key-value map for any customized purpose\n  30: optional map<string,string> data\n}\n\nstruct ClusterReplicationConfiguration {\n 10: optional string clusterName\n}\n\nstruct DomainReplicationConfiguration {\n 10: optional string activeClusterName\n 20: optional list<ClusterReplicationConfiguration> clusters\n}\n\nstruct RegisterDomainRequest {\n  10: optional string name\n  20: optional string description\n  30: optional string ownerEmail\n  40: optional i32 workflowExecutionRetentionPeriodInDays\n  50: optional bool emitMetric\n  60: optional list<ClusterReplicationConfiguration> clusters\n  70: optional string activeClusterName\n  // A key-value map for any customized purpose\n  80: optional map<string,string> data\n  90: optional string securityToken\n  120: optional bool isGlobalDomain\n  130: optional ArchivalStatus historyArchivalStatus\n  140: optional string historyArchivalURI\n  150: optional ArchivalStatus visibilityArchivalStatus\n  160: optional string visibilityArchivalURI\n}\n\nstruct ListDomainsRequest {\n  10: optional i32 pageSize\n  20: optional binary nextPageToken\n}\n\nstruct ListDomainsResponse {\n  10: optional list<DescribeDomainResponse> domains\n  20: optional binary nextPageToken\n}\n\nstruct DescribeDomainRequest {\n  10: optional string name\n  20: optional string uuid\n}\n\nstruct DescribeDomainResponse {\n  10: optional DomainInfo domainInfo\n  20: optional DomainConfiguration configuration\n  30: optional DomainReplicationConfiguration replicationConfiguration\n  40: optional i64 (js.type = \"Long\") failoverVersion\n  50: optional bool isGlobalDomain\n}\n\nstruct UpdateDomainRequest {\n 10: optional string name\n 20: optional UpdateDomainInfo updatedInfo\n 30: optional DomainConfiguration configuration\n 40: optional DomainReplicationConfiguration replicationConfiguration\n 50: optional string securityToken\n 60: optional string deleteBadBinary\n}\n\nstruct UpdateDomainResponse {\n  10: optional DomainInfo domainInfo\n  20: optional DomainConfiguration configuration\n  30: optional DomainReplicationConfiguration replicationConfiguration\n  40: optional i64 (js.type = \"Long\") failoverVersion\n  50: optional bool isGlobalDomain\n}\n\nstruct DeprecateDomainRequest {\n 10: optional string name\n 20: optional string securityToken\n}\n\nstruct StartWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional string workflowId\n  30: optional WorkflowType workflowType\n  40: optional TaskList taskList\n  50: optional binary input\n  60: optional i32 executionStartToCloseTimeoutSeconds\n  70: optional i32 taskStartToCloseTimeoutSeconds\n  80: optional string identity\n  90: optional string requestId\n  100: optional WorkflowIdReusePolicy workflowIdReusePolicy\n//  110: optional ChildPolicy childPolicy -- Removed but reserve the IDL order number\n  120: optional RetryPolicy retryPolicy\n  130: optional string cronSchedule\n  140: optional Memo memo\n  141: optional SearchAttributes searchAttributes\n  150: optional Header header\n}\n\nstruct StartWorkflowExecutionResponse {\n  10: optional string runId\n}\n\nstruct PollForDecisionTaskRequest {\n  10: optional string domain\n  20: optional TaskList taskList\n  30: optional string identity\n  40: optional string binaryChecksum\n}\n\nstruct PollForDecisionTaskResponse {\n  10: optional binary taskToken\n  20: optional WorkflowExecution workflowExecution\n  30: optional WorkflowType workflowType\n  40: optional i64 (js.type = \"Long\") previousStartedEventId\n  50: optional i64 (js.type = \"Long\") startedEventId\n  51: optional i64 (js.type = 'Long') attempt\n  54: optional i64 (js.type = \"Long\") backlogCountHint\n  60: optional History history\n  70: optional binary nextPageToken\n  80: optional WorkflowQuery query\n  90: optional TaskList WorkflowExecutionTaskList\n  100:  optional i64 (js.type = \"Long\") scheduledTimestamp\n  110:  optional i64 (js.type = \"Long\") startedTimestamp\n  120:  optional map<string, WorkflowQuery> queries\n}\n\nstruct StickyExecutionAttributes {\n  10: optional TaskList workerTaskList\n  20: optional i32 scheduleToStartTimeoutSeconds\n}\n\nstruct RespondDecisionTaskCompletedRequest {\n  10: optional binary taskToken\n  20: optional list<Decision> decisions\n  30: optional binary executionContext\n  40: optional string identity\n  50: optional StickyExecutionAttributes stickyAttributes\n  60: optional bool returnNewDecisionTask\n  70: optional bool forceCreateNewDecisionTask\n  80: optional string binaryChecksum\n  90: optional map<string, WorkflowQueryResult> queryResults\n}\n\nstruct RespondDecisionTaskCompletedResponse {\n  10: optional PollForDecisionTaskResponse decisionTask\n}\n\nstruct RespondDecisionTaskFailedRequest {\n  10: optional binary taskToken\n  20: optional DecisionTaskFailedCause cause\n  30: optional binary details\n  40: optional string identity\n}\n\nstruct PollForActivityTaskRequest {\n  10: optional string domain\n  20: optional TaskList taskList\n  30: optional string identity\n  40: optional TaskListMetadata taskListMetadata\n}\n\nstruct PollForActivityTaskResponse {\n  10:  optional binary taskToken\n  20:  optional WorkflowExecution workflowExecution\n  30:  optional string activityId\n  40:  optional ActivityType activityType\n  50:  optional binary input\n  70:  optional i64 (js.type = \"Long\") scheduledTimestamp\n  80:  optional i32 scheduleToCloseTimeoutSeconds\n  90:  optional i64 (js.type = \"Long\") startedTimestamp\n  100: optional i32 startToCloseTimeoutSeconds\n  110: optional i32 heartbeatTimeoutSeconds\n  120: optional i32 attempt\n  130: optional i64 (js.type = \"Long\") scheduledTimestampOfThisAttempt\n  140: optional binary heartbeatDetails\n  150: optional WorkflowType workflowType\n  160: optional string workflowDomain\n  170: optional Header header\n}\n\nstruct RecordActivityTaskHeartbeatRequest {\n  10: optional binary taskToken\n  20: optional binary details\n  30: optional string identity\n}\n\nstruct RecordActivityTaskHeartbeatByIDRequest {\n  10: optional string domain\n  20: optional string workflowID\n  30: optional string runID\n  40: optional string activityID\n  50: optional binary details\n  60: optional string identity\n}\n\nstruct RecordActivityTaskHeartbeatResponse {\n  10: optional bool cancelRequested\n}\n\nstruct RespondActivityTaskCompletedRequest {\n  10: optional binary taskToken\n  20: optional binary result\n  30: optional string identity\n}\n\nstruct RespondActivityTaskFailedRequest {\n  10: optional binary taskToken\n  20: optional string reason\n  30: optional binary details\n  40: optional string identity\n}\n\nstruct RespondActivityTaskCanceledRequest {\n  10: optional binary taskToken\n  20: optional binary details\n  30: optional string identity\n}\n\nstruct RespondActivityTaskCompletedByIDRequest {\n  10: optional string domain\n  20: optional string workflowID\n  30: optional string runID\n  40: optional string activityID\n  50: optional binary result\n  60: optional string identity\n}\n\nstruct RespondActivityTaskFailedByIDRequest {\n  10: optional string domain\n  20: optional string workflowID\n  30: optional string runID\n  40: optional string activityID\n  50: optional string reason\n  60: optional binary details\n  70: optional string identity\n}\n\nstruct RespondActivityTaskCanceledByIDRequest {\n  10: optional string domain\n  20: optional string workflowID\n  30: optional string runID\n  40: optional string activityID\n  50: optional binary details\n  60: optional string identity\n}\n\nstruct RequestCancelWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional WorkflowExecution workflowExecution\n  30: optional string identity\n  40: optional string requestId\n}\n\nstruct GetWorkflowExecutionHistoryRequest {\n  10: optional string domain\n  20: optional WorkflowExecution execution\n  30: optional i32 maximumPageSize\n  40: optional binary nextPageToken\n  50: optional bool waitForNewEvent\n  60: optional HistoryEventFilterType HistoryEventFilterType\n}\n\nstruct GetWorkflowExecutionHistoryResponse {\n  10: optional History history\n  20: optional binary nextPageToken\n  30: optional bool archived\n}\n\nstruct SignalWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional WorkflowExecution workflowExecution\n  30: optional string signalName\n  40: optional binary input\n  50: optional string identity\n  60: optional string requestId\n  70: optional binary control\n}\n\nstruct SignalWithStartWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional string workflowId\n  30: optional WorkflowType workflowType\n  40: optional TaskList taskList\n  50: optional binary input\n  60: optional i32 executionStartToCloseTimeoutSeconds\n  70: optional i32 taskStartToCloseTimeoutSeconds\n  80: optional string identity\n  90: optional string requestId\n  100: optional WorkflowIdReusePolicy workflowIdReusePolicy\n  110: optional string signalName\n  120: optional binary signalInput\n  130: optional binary control\n  140: optional RetryPolicy retryPolicy\n  150: optional string cronSchedule\n  160: optional Memo memo\n  161: optional SearchAttributes searchAttributes\n  170: optional Header header\n}\n\nstruct TerminateWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional WorkflowExecution workflowExecution\n  30: optional string reason\n  40: optional binary details\n  50: optional string identity\n}\n\nstruct ResetWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional WorkflowExecution workflowExecution\n  30: optional string reason\n  40: optional i64 (js.type = \"Long\") decisionFinishEventId\n  50: optional string requestId\n}\n\nstruct ResetWorkflowExecutionResponse {\n  10: optional string runId\n}\n\nstruct ListOpenWorkflowExecutionsRequest {\n  10: optional string domain\n  20: optional i32 maximumPageSize\n  30: optional binary nextPageToken\n  40: optional StartTimeFilter StartTimeFilter\n  50: optional WorkflowExecutionFilter executionFilter\n  60: optional WorkflowTypeFilter typeFilter\n}\n\nstruct ListOpenWorkflowExecutionsResponse {\n  10: optional list<WorkflowExecutionInfo> executions\n  20: optional binary nextPageToken\n}\n\nstruct ListClosedWorkflowExecutionsRequest {\n  10: optional string domain\n  20: optional i32 maximumPageSize\n  30: optional binary nextPageToken\n  40: optional StartTimeFilter StartTimeFilter\n  50: optional WorkflowExecutionFilter executionFilter\n  60: optional WorkflowTypeFilter typeFilter\n  70: optional WorkflowExecutionCloseStatus statusFilter\n}\n\nstruct ListClosedWorkflowExecutionsResponse {\n  10: optional list<WorkflowExecutionInfo> executions\n  20: optional binary nextPageToken\n}\n\nstruct ListWorkflowExecutionsRequest {\n  10: optional string domain\n  20: optional i32 pageSize\n  30: optional binary nextPageToken\n  40: optional string query\n}\n\nstruct ListWorkflowExecutionsResponse {\n  10: optional list<WorkflowExecutionInfo> executions\n  20: optional binary nextPageToken\n}\n\nstruct ListArchivedWorkflowExecutionsRequest {\n  10: optional string domain\n  20: optional i32 pageSize\n  30: optional binary nextPageToken\n  40: optional string query\n}\n\nstruct ListArchivedWorkflowExecutionsResponse {\n  10: optional list<WorkflowExecutionInfo> executions\n  20: optional binary nextPageToken\n}\n\nstruct CountWorkflowExecutionsRequest {\n  10: optional string domain\n  20: optional string query\n}\n\nstruct CountWorkflowExecutionsResponse {\n  10: optional i64 count\n}\n\nstruct GetSearchAttributesResponse {\n  10: optional map<string, IndexedValueType> keys\n}\n\nstruct QueryWorkflowRequest {\n  10: optional string domain\n  20: optional WorkflowExecution execution\n  30: optional WorkflowQuery query\n  // QueryRejectCondition can used to reject the query if workflow state does not satisify condition\n  40: optional QueryRejectCondition queryRejectCondition\n  50: optional QueryConsistencyLevel queryConsistencyLevel\n}\n\nstruct QueryRejected {\n  10: optional WorkflowExecutionCloseStatus closeStatus\n}\n\nstruct QueryWorkflowResponse {\n  10: optional binary queryResult\n  20: optional QueryRejected queryRejected\n}\n\nstruct WorkflowQuery {\n  10: optional string queryType\n  20: optional binary queryArgs\n}\n\nstruct ResetStickyTaskListRequest {\n  10: optional string domain\n  20: optional WorkflowExecution execution\n}\n\nstruct ResetStickyTaskListResponse {\n    // The reason to keep this response is to allow returning\n    // information in the future.\n}\n\nstruct RespondQueryTaskCompletedRequest {\n  10: optional binary taskToken\n  20: optional QueryTaskCompletedType completedType\n  30: optional binary queryResult\n  40: optional string errorMessage\n  50: optional WorkerVersionInfo workerVersionInfo\n}\n\nstruct WorkflowQueryResult {\n  10: optional QueryResultType resultType\n  20: optional binary answer\n  30: optional string errorMessage\n}\n\nstruct DescribeWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional WorkflowExecution execution\n}\n\nstruct PendingActivityInfo {\n  10: optional string activityID\n  20: optional ActivityType activityType\n  30: optional PendingActivityState state\n  40: optional binary heartbeatDetails\n  50: optional i64 (js.type = \"Long\") lastHeartbeatTimestamp\n  60: optional i64 (js.type = \"Long\") lastStartedTimestamp\n  70: optional i32 attempt\n  80: optional i32 maximumAttempts\n  90: optional i64 (js.type = \"Long\") scheduledTimestamp\n  100: optional i64 (js.type = \"Long\") expirationTimestamp\n  110: optional string lastFailureReason\n  120: optional string lastWorkerIdentity\n  130: optional binary lastFailureDetails\n}\n\nstruct PendingChildExecutionInfo {\n  10: optional string workflowID\n  20: optional string runID\n  30: optional string workflowTypName\n  40: optional i64 (js.type = \"Long\") initiatedID\n  50: optional ParentClosePolicy parentClosePolicy\n}\n\nstruct DescribeWorkflowExecutionResponse {\n  10: optional WorkflowExecutionConfiguration executionConfiguration\n  20: optional WorkflowExecutionInfo workflowExecutionInfo\n  30: optional list<PendingActivityInfo> pendingActivities\n  40: optional list<PendingChildExecutionInfo> pendingChildren\n}\n\nstruct DescribeTaskListRequest {\n  10: optional string domain\n  20: optional TaskList taskList\n  30: optional TaskListType taskListType\n  40: optional bool includeTaskListStatus\n}\n\nstruct DescribeTaskListResponse {\n  10: optional list<PollerInfo> pollers\n  20: optional TaskListStatus taskListStatus\n}\n\nstruct TaskListStatus {\n  10: optional i64 (js.type = \"Long\") backlogCountHint\n  20: optional i64 (js.type = \"Long\") readLevel\n  30: optional i64 (js.type = \"Long\") ackLevel\n  35: optional double ratePerSecond\n  40: optional TaskIDBlock taskIDBlock\n}\n\nstruct TaskIDBlock {\n  10: optional i64 (js.type = \"Long\")  startID\n  20: optional i64 (js.type = \"Long\")  endID\n}\n\n//At least one of the parameters needs to be provided\nstruct DescribeHistoryHostRequest {\n  10: optional string               hostAddress //ip:port\n  20: optional i32                  shardIdForHost\n  30: optional WorkflowExecution    executionForHost\n}\n\nstruct RemoveTaskRequest {\n  10: optional i32                      shardID\n  20: optional i32                      type\n  30: optional i64 (js.type = \"Long\")   taskID\n}\n\nstruct CloseShardRequest {\n  10: optional i32               shardID\n}\n\nstruct DescribeHistoryHostResponse{\n  10: optional i32                  numberOfShards\n  20: optional list<i32>            shardIDs\n  30: optional DomainCacheInfo      domainCache\n  40: optional string               shardControllerStatus\n  50: optional string               address\n}\n\nstruct DomainCacheInfo{\n  10: optional i64 numOfItemsInCacheByID\n  20: optional i64 numOfItemsInCacheByName\n}\n\nenum TaskListType {\n  /*\n   * Decision type of tasklist\n   */\n  Decision,\n  /*\n   * Activity type of tasklist\n   */\n  Activity,\n}\n\nstruct PollerInfo {\n  // Unix Nano\n  10: optional i64 (js.type = \"Long\")  lastAccessTime\n  20: optional string identity\n  30: optional double ratePerSecond\n}\n\nstruct RetryPolicy {\n  // Interval of the first retry. If coefficient is 1.0 then it is used for all retries.\n  10: optional i32 initialIntervalInSeconds\n\n  // Coefficient used to calculate the next retry interval.\n  // The next retry interval is previous interval multiplied by the coefficient.\n  // Must be 1 or larger.\n  20: optional double backoffCoefficient\n\n  // Maximum interval between retries. Exponential backoff leads to interval increase.\n  // This value is the cap of the increase. Default is 100x of initial interval.\n  30: optional i32 maximumIntervalInSeconds\n\n  // Maximum number of attempts. When exceeded the retries stop even if not expired yet.\n  // Must be 1 or bigger. Default is unlimited.\n  40: optional i32 maximumAttempts\n\n  // Non-Retriable errors. Will stop retrying if error matches this list.\n  50: optional list<string> nonRetriableErrorReasons\n\n  // Expiration time for the whole retry process.\n  60: optional i32 expirationIntervalInSeconds\n}\n\n// HistoryBranchRange represents a piece of range for a branch.\nstruct HistoryBranchRange{\n  // branchID of original branch forked from\n  10: optional string branchID\n  // beinning node for the range, inclusive\n  20: optional i64 beginNodeID\n  // ending node for the range, exclusive\n  30: optional i64 endNodeID\n}\n\n// For history persistence to serialize/deserialize branch details\nstruct HistoryBranch{\n  10: optional string treeID\n  20: optional string branchID\n  30: optional list<HistoryBranchRange> ancestors\n}\n\n// VersionHistoryItem contains signal eventID and the corresponding version\nstruct VersionHistoryItem{\n  10: optional i64 (js.type = \"Long\") eventID\n  20: optional i64 (js.type = \"Long\") version\n}\n\n// VersionHistory contains the version history of a branch\nstruct VersionHistory{\n  10: optional binary branchToken\n  20: optional list<VersionHistoryItem> items\n}\n\n// VersionHistories contains all version histories from all branches\nstruct VersionHistories{\n  10: optional i32 currentVersionHistoryIndex\n  20: optional list<VersionHistory> histories\n}\n\n// ReapplyEventsRequest is the request for reapply events API\nstruct ReapplyEventsRequest{\n  10: optional string domainName\n  20: optional WorkflowExecution workflowExecution\n  30: optional DataBlob events\n}"
+const rawIDL = "// Copyright (c) 2017 Uber Technologies, Inc.\n//\n// Permission is hereby granted, free of charge, to any person obtaining a copy\n// of this software and associated documentation files (the \"Software\"), to deal\n// in the Software without restriction, including without limitation the rights\n// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell\n// copies of the Software, and to permit persons to whom the Software is\n// furnished to do so, subject to the following conditions:\n//\n// The above copyright notice and this permission notice shall be included in\n// all copies or substantial portions of the Software.\n//\n// THE SOFTWARE IS PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR\n// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,\n// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE\n// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER\n// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,\n// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN\n// THE SOFTWARE.\n\nnamespace java com.uber.cadence\n\nexception BadRequestError {\n  1: required string message\n}\n\nexception InternalServiceError {\n  1: required string message\n}\n\nexception DomainAlreadyExistsError {\n  1: required string message\n}\n\nexception WorkflowExecutionAlreadyStartedError {\n  10: optional string message\n  20: optional string startRequestId\n  30: optional string runId\n}\n\nexception EntityNotExistsError {\n  1: required string message\n}\n\nexception ServiceBusyError {\n  1: required string message\n}\n\nexception CancellationAlreadyRequestedError {\n  1: required string message\n}\n\nexception QueryFailedError {\n  1: required string message\n}\n\nexception DomainNotActiveError {\n  1: required string message\n  2: required string domainName\n  3: required string currentCluster\n  4: required string activeCluster\n}\n\nexception LimitExceededError {\n  1: required string message\n}\n\nexception AccessDeniedError {\n  1: required string message\n}\n\nexception RetryTaskError {\n  1: required string message\n  2: optional string domainId\n  3: optional string workflowId\n  4: optional string runId\n  5: optional i64 (js.type = \"Long\") nextEventId\n}\n\nexception RetryTaskV2Error {\n  1: required string message\n  2: optional string domainId\n  3: optional string workflowId\n  4: optional string runId\n  5: optional i64 (js.type = \"Long\") startEventId\n  6: optional i64 (js.type = \"Long\") startEventVersion\n  7: optional i64 (js.type = \"Long\") endEventId\n  8: optional i64 (js.type = \"Long\") endEventVersion\n}\n\nexception ClientVersionNotSupportedError {\n  1: required string featureVersion\n  2: required string clientImpl\n  3: required string supportedVersions\n}\n\nexception CurrentBranchChangedError {\n  10: required string message\n  20: required binary currentBranchToken\n}\n\nenum WorkflowIdReusePolicy {\n  /*\n   * allow start a workflow execution using the same workflow ID,\n   * when workflow not running, and the last execution close state is in\n   * [terminated, cancelled, timeouted, failed].\n   */\n  AllowDuplicateFailedOnly,\n  /*\n   * allow start a workflow execution using the same workflow ID,\n   * when workflow not running.\n   */\n  AllowDuplicate,\n  /*\n   * do not allow start a workflow execution using the same workflow ID at all\n   */\n  RejectDuplicate,\n}\n\nenum DomainStatus {\n  REGISTERED,\n  DEPRECATED,\n  DELETED,\n}\n\nenum TimeoutType {\n  START_TO_CLOSE,\n  SCHEDULE_TO_START,\n  SCHEDULE_TO_CLOSE,\n  HEARTBEAT,\n}\n\nenum ParentClosePolicy {\n\tABANDON,\n\tREQUEST_CANCEL,\n\tTERMINATE,\n}\n\n\n// whenever this list of decision is changed\n// do change the mutableStateBuilder.go\n// function shouldBufferEvent\n// to make sure wo do the correct event ordering\nenum DecisionType {\n  ScheduleActivityTask,\n  RequestCancelActivityTask,\n  StartTimer,\n  CompleteWorkflowExecution,\n  FailWorkflowExecution,\n  CancelTimer,\n  CancelWorkflowExecution,\n  RequestCancelExternalWorkflowExecution,\n  RecordMarker,\n  ContinueAsNewWorkflowExecution,\n  StartChildWorkflowExecution,\n  SignalExternalWorkflowExecution,\n  UpsertWorkflowSearchAttributes,\n}\n\nenum EventType {\n  WorkflowExecutionStarted,\n  WorkflowExecutionCompleted,\n  WorkflowExecutionFailed,\n  WorkflowExecutionTimedOut,\n  DecisionTaskScheduled,\n  DecisionTaskStarted,\n  DecisionTaskCompleted,\n  DecisionTaskTimedOut\n  DecisionTaskFailed,\n  ActivityTaskScheduled,\n  ActivityTaskStarted,\n  ActivityTaskCompleted,\n  ActivityTaskFailed,\n  ActivityTaskTimedOut,\n  ActivityTaskCancelRequested,\n  RequestCancelActivityTaskFailed,\n  ActivityTaskCanceled,\n  TimerStarted,\n  TimerFired,\n  CancelTimerFailed,\n  TimerCanceled,\n  WorkflowExecutionCancelRequested,\n  WorkflowExecutionCanceled,\n  RequestCancelExternalWorkflowExecutionInitiated,\n  RequestCancelExternalWorkflowExecutionFailed,\n  ExternalWorkflowExecutionCancelRequested,\n  MarkerRecorded,\n  WorkflowExecutionSignaled,\n  WorkflowExecutionTerminated,\n  WorkflowExecutionContinuedAsNew,\n  StartChildWorkflowExecutionInitiated,\n  StartChildWorkflowExecutionFailed,\n  ChildWorkflowExecutionStarted,\n  ChildWorkflowExecutionCompleted,\n  ChildWorkflowExecutionFailed,\n  ChildWorkflowExecutionCanceled,\n  ChildWorkflowExecutionTimedOut,\n  ChildWorkflowExecutionTerminated,\n  SignalExternalWorkflowExecutionInitiated,\n  SignalExternalWorkflowExecutionFailed,\n  ExternalWorkflowExecutionSignaled,\n  UpsertWorkflowSearchAttributes,\n}\n\nenum DecisionTaskFailedCause {\n  UNHANDLED_DECISION,\n  BAD_SCHEDULE_ACTIVITY_ATTRIBUTES,\n  BAD_REQUEST_CANCEL_ACTIVITY_ATTRIBUTES,\n  BAD_START_TIMER_ATTRIBUTES,\n  BAD_CANCEL_TIMER_ATTRIBUTES,\n  BAD_RECORD_MARKER_ATTRIBUTES,\n  BAD_COMPLETE_WORKFLOW_EXECUTION_ATTRIBUTES,\n  BAD_FAIL_WORKFLOW_EXECUTION_ATTRIBUTES,\n  BAD_CANCEL_WORKFLOW_EXECUTION_ATTRIBUTES,\n  BAD_REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_ATTRIBUTES,\n  BAD_CONTINUE_AS_NEW_ATTRIBUTES,\n  START_TIMER_DUPLICATE_ID,\n  RESET_STICKY_TASKLIST,\n  WORKFLOW_WORKER_UNHANDLED_FAILURE,\n  BAD_SIGNAL_WORKFLOW_EXECUTION_ATTRIBUTES,\n  BAD_START_CHILD_EXECUTION_ATTRIBUTES,\n  FORCE_CLOSE_DECISION,\n  FAILOVER_CLOSE_DECISION,\n  BAD_SIGNAL_INPUT_SIZE,\n  RESET_WORKFLOW,\n  BAD_BINARY,\n  SCHEDULE_ACTIVITY_DUPLICATE_ID,\n  BAD_SEARCH_ATTRIBUTES,\n  PENDING_ACTIVITIES_LIMIT_EXCEEDED,\n  PENDING_CHILD_WORKFLOWS_LIMIT_EXCEEDED,\n  PENDING_TIMERS_LIMIT_EXCEEDED,\n}\n\nenum CancelExternalWorkflowExecutionFailedCause {\n  UNKNOWN_EXTERNAL_WORKFLOW_EXECUTION,\n}\n\nenum SignalExternalWorkflowExecutionFailedCause {\n  UNKNOWN_EXTERNAL_WORKFLOW_EXECUTION,\n  TARGET_CLUSTER_UNREACHABLE,\n}\n\nenum ChildWorkflowExecutionFailedCause {\n  WORKFLOW_ALREADY_RUNNING,\n  TARGET_CLUSTER_UNREACHABLE,\n  OPEN_WORKFLOWS_LIMIT_EXCEEDED,\n}\n\n// TODO: when migrating to gRPC, add a running / none status,\n//  currently, customer is using null / nil as an indication\n//  that workflow is still running\nenum WorkflowExecutionCloseStatus {\n  COMPLETED,\n  FAILED,\n  CANCELED,\n  TERMINATED,\n  CONTINUED_AS_NEW,\n  TIMED_OUT,\n}\n\nenum QueryTaskCompletedType {\n  COMPLETED,\n  FAILED,\n}\n\nenum QueryResultType {\n  ANSWERED,\n  FAILED,\n}\n\nenum PendingActivityState {\n  SCHEDULED,\n  STARTED,\n  CANCEL_REQUESTED,\n}\n\nenum HistoryEventFilterType {\n  ALL_EVENT,\n  CLOSE_EVENT,\n}\n\nenum TaskListKind {\n  NORMAL,\n  STICKY,\n}\n\nenum ArchivalStatus {\n  DISABLED,\n  ENABLED,\n}\n\nenum DomainFailoverState {\n  DRAINING,\n  COMPLETED,\n  TIMED_OUT,\n}\n\nenum IndexedValueType {\n  STRING,\n  KEYWORD,\n  INT,\n  DOUBLE,\n  BOOL,\n  DATETIME,\n  // KEYWORD_LIST and DATETIME_LIST attributes hold a list of values,\n  // a query condition on the attribute matches if any value in the list matches\n  KEYWORD_LIST,\n  DATETIME_LIST,\n}\n\nstruct Header {\n    10: optional map<string, binary> fields\n}\n\nstruct WorkflowType {\n  10: optional string name\n}\n\nstruct ActivityType {\n  10: optional string name\n}\n\nstruct TaskList {\n  10: optional string name\n  20: optional TaskListKind kind\n}\n\nenum EncodingType {\n  ThriftRW,\n  JSON,\n}\n\nenum QueryRejectCondition {\n  // NOT_OPEN indicates that query should be rejected if workflow is not open\n  NOT_OPEN\n  // NOT_COMPLETED_CLEANLY indicates that query should be rejected if workflow did not complete cleanly\n  NOT_COMPLETED_CLEANLY\n}\n\nenum QueryConsistencyLevel {\n  // EVENTUAL indicates that query should be eventually consistent\n  EVENTUAL\n  // STRONG indicates that any events that came before query should be reflected in workflow state before running query\n  STRONG\n}\n\nstruct DataBlob {\n  10: optional EncodingType EncodingType\n  20: optional binary Data\n}\n\nstruct ReplicationInfo {\n  10: optional i64 (js.type = \"Long\") version\n  20: optional i64 (js.type = \"Long\") lastEventId\n}\n\nstruct TaskListMetadata {\n  10: optional double maxTasksPerSecond\n}\n\nstruct WorkflowExecution {\n  10: optional string workflowId\n  20: optional string runId\n}\n\nstruct Memo {\n  10: optional map<string,binary> fields\n}\n\nstruct SearchAttributes {\n  10: optional map<string,binary> indexedFields\n}\n\nstruct WorkerVersionInfo {\n  10: optional string impl\n  20: optional string featureVersion\n}\n\nstruct WorkflowExecutionInfo {\n  10: optional WorkflowExecution execution\n  20: optional WorkflowType type\n  30: optional i64 (js.type = \"Long\") startTime\n  40: optional i64 (js.type = \"Long\") closeTime\n  50: optional WorkflowExecutionCloseStatus closeStatus\n  60: optional i64 (js.type = \"Long\") historyLength\n  70: optional string parentDomainId\n  80: optional WorkflowExecution parentExecution\n  90: optional i64 (js.type = \"Long\") executionTime\n  100: optional Memo memo\n  101: optional SearchAttributes searchAttributes\n  110: optional ResetPoints autoResetPoints\n}\n\nstruct WorkflowExecutionConfiguration {\n  10: optional TaskList taskList\n  20: optional i32 executionStartToCloseTimeoutSeconds\n  30: optional i32 taskStartToCloseTimeoutSeconds\n//  40: optional ChildPolicy childPolicy -- Removed but reserve the IDL order number\n}\n\nstruct TransientDecisionInfo {\n  10: optional HistoryEvent scheduledEvent\n  20: optional HistoryEvent startedEvent\n}\n\nstruct ScheduleActivityTaskDecisionAttributes {\n  10: optional string activityId\n  20: optional ActivityType activityType\n  25: optional string domain\n  30: optional TaskList taskList\n  40: optional binary input\n  45: optional i32 scheduleToCloseTimeoutSeconds\n  50: optional i32 scheduleToStartTimeoutSeconds\n  55: optional i32 startToCloseTimeoutSeconds\n  60: optional i32 heartbeatTimeoutSeconds\n  70: optional RetryPolicy retryPolicy\n  80: optional Header header\n  90: optional string affinityToken\n}\n\nstruct RequestCancelActivityTaskDecisionAttributes {\n  10: optional string activityId\n}\n\nstruct StartTimerDecisionAttributes {\n  10: optional string timerId\n  20: optional i64 (js.type = \"Long\") startToFireTimeoutSeconds\n}\n\nstruct CompleteWorkflowExecutionDecisionAttributes {\n  10: optional binary result\n}\n\nstruct FailWorkflowExecutionDecisionAttributes {\n  10: optional string reason\n  20: optional binary details\n}\n\nstruct CancelTimerDecisionAttributes {\n  10: optional string timerId\n}\n\nstruct CancelWorkflowExecutionDecisionAttributes {\n  10: optional binary details\n}\n\nstruct RequestCancelExternalWorkflowExecutionDecisionAttributes {\n  10: optional string domain\n  20: optional string workflowId\n  30: optional string runId\n  40: optional binary control\n  50: optional bool childWorkflowOnly\n}\n\nstruct SignalExternalWorkflowExecutionDecisionAttributes {\n  10: optional string domain\n  20: optional WorkflowExecution execution\n  30: optional string signalName\n  40: optional binary input\n  50: optional binary control\n  60: optional bool childWorkflowOnly\n}\n\nstruct UpsertWorkflowSearchAttributesDecisionAttributes {\n  10: optional SearchAttributes searchAttributes\n  // removeSearchAttributes are the keys of the search attributes removed from the workflow\n  20: optional list<string> removeSearchAttributes\n}\n\nstruct RecordMarkerDecisionAttributes {\n  10: optional string markerName\n  20: optional binary details\n  30: optional Header header\n}\n\nstruct ContinueAsNewWorkflowExecutionDecisionAttributes {\n  10: optional WorkflowType workflowType\n  20: optional TaskList taskList\n  30: optional binary input\n  40: optional i32 executionStartToCloseTimeoutSeconds\n  50: optional i32 taskStartToCloseTimeoutSeconds\n  60: optional i32 backoffStartIntervalInSeconds\n  70: optional RetryPolicy retryPolicy\n  80: optional ContinueAsNewInitiator initiator\n  90: optional string failureReason\n  100: optional binary failureDetails\n  110: optional binary lastCompletionResult\n  120: optional string cronSchedule\n  130: optional Header header\n  140: optional Memo memo\n  150: optional SearchAttributes searchAttributes\n}\n\nstruct StartChildWorkflowExecutionDecisionAttributes {\n  10: optional string domain\n  20: optional string workflowId\n  30: optional WorkflowType workflowType\n  40: optional TaskList taskList\n  50: optional binary input\n  60: optional i32 executionStartToCloseTimeoutSeconds\n  70: optional i32 taskStartToCloseTimeoutSeconds\n//  80: optional ChildPolicy childPolicy -- Removed but reserve the IDL order number\n  81: optional ParentClosePolicy parentClosePolicy\n  90: optional binary control\n  100: optional WorkflowIdReusePolicy workflowIdReusePolicy\n  110: optional RetryPolicy retryPolicy\n  120: optional string cronSchedule\n  130: optional Header header\n  140: optional Memo memo\n  150: optional SearchAttributes searchAttributes\n}\n\nstruct Decision {\n  10:  optional DecisionType decisionType\n  20:  optional ScheduleActivityTaskDecisionAttributes scheduleActivityTaskDecisionAttributes\n  25:  optional StartTimerDecisionAttributes startTimerDecisionAttributes\n  30:  optional CompleteWorkflowExecutionDecisionAttributes completeWorkflowExecutionDecisionAttributes\n  35:  optional FailWorkflowExecutionDecisionAttributes failWorkflowExecutionDecisionAttributes\n  40:  optional RequestCancelActivityTaskDecisionAttributes requestCancelActivityTaskDecisionAttributes\n  50:  optional CancelTimerDecisionAttributes cancelTimerDecisionAttributes\n  60:  optional CancelWorkflowExecutionDecisionAttributes cancelWorkflowExecutionDecisionAttributes\n  70:  optional RequestCancelExternalWorkflowExecutionDecisionAttributes requestCancelExternalWorkflowExecutionDecisionAttributes\n  80:  optional RecordMarkerDecisionAttributes recordMarkerDecisionAttributes\n  90:  optional ContinueAsNewWorkflowExecutionDecisionAttributes continueAsNewWorkflowExecutionDecisionAttributes\n  100: optional StartChildWorkflowExecutionDecisionAttributes startChildWorkflowExecutionDecisionAttributes\n  110: optional SignalExternalWorkflowExecutionDecisionAttributes signalExternalWorkflowExecutionDecisionAttributes\n  120: optional UpsertWorkflowSearchAttributesDecisionAttributes upsertWorkflowSearchAttributesDecisionAttributes\n}\n\nstruct WorkflowExecutionStartedEventAttributes {\n  10: optional WorkflowType workflowType\n  12: optional string parentWorkflowDomain\n  14: optional WorkflowExecution parentWorkflowExecution\n  16: optional i64 (js.type = \"Long\") parentInitiatedEventId\n  20: optional TaskList taskList\n  30: optional binary input\n  40: optional i32 executionStartToCloseTimeoutSeconds\n  50: optional i32 taskStartToCloseTimeoutSeconds\n//  52: optional ChildPolicy childPolicy -- Removed but reserve the IDL order number\n  54: optional string continuedExecutionRunId\n  55: optional ContinueAsNewInitiator initiator\n  56: optional string continuedFailureReason\n  57: optional binary continuedFailureDetails\n  58: optional binary lastCompletionResult\n  59: optional string originalExecutionRunId // This is the runID when the WorkflowExecutionStarted event is written\n  60: optional string identity\n  61: optional string firstExecutionRunId // This is the very first runID along the chain of ContinueAsNew and Reset.\n  70: optional RetryPolicy retryPolicy\n  80: optional i32 attempt\n  90: optional i64 (js.type = \"Long\") expirationTimestamp\n  100: optional string cronSchedule\n  110: optional i32 firstDecisionTaskBackoffSeconds\n  120: optional Memo memo\n  121: optional SearchAttributes searchAttributes\n  130: optional ResetPoints prevAutoResetPoints\n  140: optional Header header\n}\n\nstruct ResetPoints{\n  10: optional list<ResetPointInfo> points\n}\n\n struct ResetPointInfo{\n  10: optional string binaryChecksum\n  20: optional string runId\n  30: optional i64 firstDecisionCompletedId\n  40: optional i64 (js.type = \"Long\") createdTimeNano\n  50: optional i64 (js.type = \"Long\") expiringTimeNano //the time that the run is deleted due to retention\n  60: optional bool resettable                         // false if the resset point has pending childWFs/reqCancels/signalExternals.\n}\n\nstruct WorkflowExecutionCompletedEventAttributes {\n  10: optional binary result\n  20: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n}\n\nstruct WorkflowExecutionFailedEventAttributes {\n  10: optional string reason\n  20: optional binary details\n  30: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n}\n\nstruct WorkflowExecutionTimedOutEventAttributes {\n  10: optional TimeoutType timeoutType\n}\n\nenum ContinueAsNewInitiator {\n  Decider,\n  RetryPolicy,\n  CronSchedule,\n}\n\nstruct WorkflowExecutionContinuedAsNewEventAttributes {\n  10: optional string newExecutionRunId\n  20: optional WorkflowType workflowType\n  30: optional TaskList taskList\n  40: optional binary input\n  50: optional i32 executionStartToCloseTimeoutSeconds\n  60: optional i32 taskStartToCloseTimeoutSeconds\n  70: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  80: optional i32 backoffStartIntervalInSeconds\n  90: optional ContinueAsNewInitiator initiator\n  100: optional string failureReason\n  110: optional binary failureDetails\n  120: optional binary lastCompletionResult\n  130: optional Header header\n  140: optional Memo memo\n  150: optional SearchAttributes searchAttributes\n}\n\nstruct DecisionTaskScheduledEventAttributes {\n  10: optional TaskList taskList\n  20: optional i32 startToCloseTimeoutSeconds\n  30: optional i64 (js.type = \"Long\") attempt\n}\n\nstruct DecisionTaskStartedEventAttributes {\n  10: optional i64 (js.type = \"Long\") scheduledEventId\n  20: optional string identity\n  30: optional string requestId\n}\n\nstruct DecisionTaskCompletedEventAttributes {\n  10: optional binary executionContext\n  20: optional i64 (js.type = \"Long\") scheduledEventId\n  30: optional i64 (js.type = \"Long\") startedEventId\n  40: optional string identity\n  50: optional string binaryChecksum\n}\n\nstruct DecisionTaskTimedOutEventAttributes {\n  10: optional i64 (js.type = \"Long\") scheduledEventId\n  20: optional i64 (js.type = \"Long\") startedEventId\n  30: optional TimeoutType timeoutType\n}\n\nstruct DecisionTaskFailedEventAttributes {\n  10: optional i64 (js.type = \"Long\") scheduledEventId\n  20: optional i64 (js.type = \"Long\") startedEventId\n  30: optional DecisionTaskFailedCause cause\n  35: optional binary details\n  40: optional string identity\n  50: optional string reason\n  // for reset workflow\n  60: optional string baseRunId\n  70: optional string newRunId\n  80: optional i64 (js.type = \"Long\") forkEventVersion\n}\n\nstruct ActivityTaskScheduledEventAttributes {\n  10: optional string activityId\n  20: optional ActivityType activityType\n  25: optional string domain\n  30: optional TaskList taskList\n  40: optional binary input\n  45: optional i32 scheduleToCloseTimeoutSeconds\n  50: optional i32 scheduleToStartTimeoutSeconds\n  55: optional i32 startToCloseTimeoutSeconds\n  60: optional i32 heartbeatTimeoutSeconds\n  90: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  110: optional RetryPolicy retryPolicy\n  120: optional Header header\n  130: optional string affinityToken\n}\n\nstruct ActivityTaskStartedEventAttributes {\n  10: optional i64 (js.type = \"Long\") scheduledEventId\n  20: optional string identity\n  30: optional string requestId\n  40: optional i32 attempt\n}\n\nstruct ActivityTaskCompletedEventAttributes {\n  10: optional binary result\n  20: optional i64 (js.type = \"Long\") scheduledEventId\n  30: optional i64 (js.type = \"Long\") startedEventId\n  40: optional string identity\n}\n\nstruct ActivityTaskFailedEventAttributes {\n  10: optional string reason\n  20: optional binary details\n  30: optional i64 (js.type = \"Long\") scheduledEventId\n  40: optional i64 (js.type = \"Long\") startedEventId\n  50: optional string identity\n}\n\nstruct ActivityTaskTimedOutEventAttributes {\n  05: optional binary details\n  10: optional i64 (js.type = \"Long\") scheduledEventId\n  20: optional i64 (js.type = \"Long\") startedEventId\n  30: optional TimeoutType timeoutType\n  // For retry activity, it may have a failure before timeout. It's important to keep those information for debug.\n  // Client can also provide the info for making next decision\n  40: optional string lastFailureReason\n  50: optional binary lastFailureDetails\n}\n\nstruct ActivityTaskCancelRequestedEventAttributes {\n  10: optional string activityId\n  20: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n}\n\nstruct RequestCancelActivityTaskFailedEventAttributes{\n  10: optional string activityId\n  20: optional string cause\n  30: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n}\n\nstruct ActivityTaskCanceledEventAttributes {\n  10: optional binary details\n  20: optional i64 (js.type = \"Long\") latestCancelRequestedEventId\n  30: optional i64 (js.type = \"Long\") scheduledEventId\n  40: optional i64 (js.type = \"Long\") startedEventId\n  50: optional string identity\n}\n\nstruct TimerStartedEventAttributes {\n  10: optional string timerId\n  20: optional i64 (js.type = \"Long\") startToFireTimeoutSeconds\n  30: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n}\n\nstruct TimerFiredEventAttributes {\n  10: optional string timerId\n  20: optional i64 (js.type = \"Long\") startedEventId\n}\n\nstruct TimerCanceledEventAttributes {\n  10: optional string timerId\n  20: optional i64 (js.type = \"Long\") startedEventId\n  30: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  40: optional string identity\n}\n\nstruct CancelTimerFailedEventAttributes {\n  10: optional string timerId\n  20: optional string cause\n  30: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  40: optional string identity\n}\n\nstruct WorkflowExecutionCancelRequestedEventAttributes {\n  10: optional string cause\n  20: optional i64 (js.type = \"Long\") externalInitiatedEventId\n  30: optional WorkflowExecution externalWorkflowExecution\n  40: optional string identity\n}\n\nstruct WorkflowExecutionCanceledEventAttributes {\n  10: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  20: optional binary details\n}\n\nstruct MarkerRecordedEventAttributes {\n  10: optional string markerName\n  20: optional binary details\n  30: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  40: optional Header header\n}\n\nstruct WorkflowExecutionSignaledEventAttributes {\n  10: optional string signalName\n  20: optional binary input\n  30: optional string identity\n}\n\nstruct WorkflowExecutionTerminatedEventAttributes {\n  10: optional string reason\n  20: optional binary details\n  30: optional string identity\n}\n\nstruct RequestCancelExternalWorkflowExecutionInitiatedEventAttributes {\n  10: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n  40: optional binary control\n  50: optional bool childWorkflowOnly\n}\n\nstruct RequestCancelExternalWorkflowExecutionFailedEventAttributes {\n  10: optional CancelExternalWorkflowExecutionFailedCause cause\n  20: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  30: optional string domain\n  40: optional WorkflowExecution workflowExecution\n  50: optional i64 (js.type = \"Long\") initiatedEventId\n  60: optional binary control\n}\n\nstruct ExternalWorkflowExecutionCancelRequestedEventAttributes {\n  10: optional i64 (js.type = \"Long\") initiatedEventId\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n}\n\nstruct SignalExternalWorkflowExecutionInitiatedEventAttributes {\n  10: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n  40: optional string signalName\n  50: optional binary input\n  60: optional binary control\n  70: optional bool childWorkflowOnly\n}\n\nstruct SignalExternalWorkflowExecutionFailedEventAttributes {\n  10: optional SignalExternalWorkflowExecutionFailedCause cause\n  20: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  30: optional string domain\n  40: optional WorkflowExecution workflowExecution\n  50: optional i64 (js.type = \"Long\") initiatedEventId\n  60: optional binary control\n}\n\nstruct ExternalWorkflowExecutionSignaledEventAttributes {\n  10: optional i64 (js.type = \"Long\") initiatedEventId\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n  40: optional binary control\n}\n\nstruct UpsertWorkflowSearchAttributesEventAttributes {\n  10: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  20: optional SearchAttributes searchAttributes\n  30: optional list<string> removeSearchAttributes\n}\n\nstruct StartChildWorkflowExecutionInitiatedEventAttributes {\n  10:  optional string domain\n  20:  optional string workflowId\n  30:  optional WorkflowType workflowType\n  40:  optional TaskList taskList\n  50:  optional binary input\n  60:  optional i32 executionStartToCloseTimeoutSeconds\n  70:  optional i32 taskStartToCloseTimeoutSeconds\n//  80:  optional ChildPolicy childPolicy -- Removed but reserve the IDL order number\n  81:  optional ParentClosePolicy parentClosePolicy\n  90:  optional binary control\n  100: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  110: optional WorkflowIdReusePolicy workflowIdReusePolicy\n  120: optional RetryPolicy retryPolicy\n  130: optional string cronSchedule\n  140: optional Header header\n  150: optional Memo memo\n  160: optional SearchAttributes searchAttributes\n}\n\nstruct StartChildWorkflowExecutionFailedEventAttributes {\n  10: optional string domain\n  20: optional string workflowId\n  30: optional WorkflowType workflowType\n  40: optional ChildWorkflowExecutionFailedCause cause\n  50: optional binary control\n  60: optional i64 (js.type = \"Long\") initiatedEventId\n  70: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n}\n\nstruct ChildWorkflowExecutionStartedEventAttributes {\n  10: optional string domain\n  20: optional i64 (js.type = \"Long\") initiatedEventId\n  30: optional WorkflowExecution workflowExecution\n  40: optional WorkflowType workflowType\n  50: optional Header header\n}\n\nstruct ChildWorkflowExecutionCompletedEventAttributes {\n  10: optional binary result\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n  40: optional WorkflowType workflowType\n  50: optional i64 (js.type = \"Long\") initiatedEventId\n  60: optional i64 (js.type = \"Long\") startedEventId\n}\n\nstruct ChildWorkflowExecutionFailedEventAttributes {\n  10: optional string reason\n  20: optional binary details\n  30: optional string domain\n  40: optional WorkflowExecution workflowExecution\n  50: optional WorkflowType workflowType\n  60: optional i64 (js.type = \"Long\") initiatedEventId\n  70: optional i64 (js.type = \"Long\") startedEventId\n}\n\nstruct ChildWorkflowExecutionCanceledEventAttributes {\n  10: optional binary details\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n  40: optional WorkflowType workflowType\n  50: optional i64 (js.type = \"Long\") initiatedEventId\n  60: optional i64 (js.type = \"Long\") startedEventId\n}\n\nstruct ChildWorkflowExecutionTimedOutEventAttributes {\n  10: optional TimeoutType timeoutType\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n  40: optional WorkflowType workflowType\n  50: optional i64 (js.type = \"Long\") initiatedEventId\n  60: optional i64 (js.type = \"Long\") startedEventId\n}\n\nstruct ChildWorkflowExecutionTerminatedEventAttributes {\n  10: optional string domain\n  20: optional WorkflowExecution workflowExecution\n  30: optional WorkflowType workflowType\n  40: optional i64 (js.type = \"Long\") initiatedEventId\n  50: optional i64 (js.type = \"Long\") startedEventId\n}\n\nstruct HistoryEvent {\n  10:  optional i64 (js.type = \"Long\") eventId\n  20:  optional i64 (js.type = \"Long\") timestamp\n  30:  optional EventType eventType\n  35:  optional i64 (js.type = \"Long\") version\n  36:  optional i64 (js.type = \"Long\") taskId\n  40:  optional WorkflowExecutionStartedEventAttributes workflowExecutionStartedEventAttributes\n  50:  optional WorkflowExecutionCompletedEventAttributes workflowExecutionCompletedEventAttributes\n  60:  optional WorkflowExecutionFailedEventAttributes workflowExecutionFailedEventAttributes\n  70:  optional WorkflowExecutionTimedOutEventAttributes workflowExecutionTimedOutEventAttributes\n  80:  optional DecisionTaskScheduledEventAttributes decisionTaskScheduledEventAttributes\n  90:  optional DecisionTaskStartedEventAttributes decisionTaskStartedEventAttributes\n  100: optional DecisionTaskCompletedEventAttributes decisionTaskCompletedEventAttributes\n  110: optional DecisionTaskTimedOutEventAttributes decisionTaskTimedOutEventAttributes\n  120: optional DecisionTaskFailedEventAttributes decisionTaskFailedEventAttributes\n  130: optional ActivityTaskScheduledEventAttributes activityTaskScheduledEventAttributes\n  140: optional ActivityTaskStartedEventAttributes activityTaskStartedEventAttributes\n  150: optional ActivityTaskCompletedEventAttributes activityTaskCompletedEventAttributes\n  160: optional ActivityTaskFailedEventAttributes activityTaskFailedEventAttributes\n  170: optional ActivityTaskTimedOutEventAttributes activityTaskTimedOutEventAttributes\n  180: optional TimerStartedEventAttributes timerStartedEventAttributes\n  190: optional TimerFiredEventAttributes timerFiredEventAttributes\n  200: optional ActivityTaskCancelRequestedEventAttributes activityTaskCancelRequestedEventAttributes\n  210: optional RequestCancelActivityTaskFailedEventAttributes requestCancelActivityTaskFailedEventAttributes\n  220: optional ActivityTaskCanceledEventAttributes activityTaskCanceledEventAttributes\n  230: optional TimerCanceledEventAttributes timerCanceledEventAttributes\n  240: optional CancelTimerFailedEventAttributes cancelTimerFailedEventAttributes\n  250: optional MarkerRecordedEventAttributes markerRecordedEventAttributes\n  260: optional WorkflowExecutionSignaledEventAttributes workflowExecutionSignaledEventAttributes\n  270: optional WorkflowExecutionTerminatedEventAttributes workflowExecutionTerminatedEventAttributes\n  280: optional WorkflowExecutionCancelRequestedEventAttributes workflowExecutionCancelRequestedEventAttributes\n  290: optional WorkflowExecutionCanceledEventAttributes workflowExecutionCanceledEventAttributes\n  300: optional RequestCancelExternalWorkflowExecutionInitiatedEventAttributes requestCancelExternalWorkflowExecutionInitiatedEventAttributes\n  310: optional RequestCancelExternalWorkflowExecutionFailedEventAttributes requestCancelExternalWorkflowExecutionFailedEventAttributes\n  320: optional ExternalWorkflowExecutionCancelRequestedEventAttributes externalWorkflowExecutionCancelRequestedEventAttributes\n  330: optional WorkflowExecutionContinuedAsNewEventAttributes workflowExecutionContinuedAsNewEventAttributes\n  340: optional StartChildWorkflowExecutionInitiatedEventAttributes startChildWorkflowExecutionInitiatedEventAttributes\n  350: optional StartChildWorkflowExecutionFailedEventAttributes startChildWorkflowExecutionFailedEventAttributes\n  360: optional ChildWorkflowExecutionStartedEventAttributes childWorkflowExecutionStartedEventAttributes\n  370: optional ChildWorkflowExecutionCompletedEventAttributes childWorkflowExecutionCompletedEventAttributes\n  380: optional ChildWorkflowExecutionFailedEventAttributes childWorkflowExecutionFailedEventAttributes\n  390: optional ChildWorkflowExecutionCanceledEventAttributes childWorkflowExecutionCanceledEventAttributes\n  400: optional ChildWorkflowExecutionTimedOutEventAttributes childWorkflowExecutionTimedOutEventAttributes\n  410: optional ChildWorkflowExecutionTerminatedEventAttributes childWorkflowExecutionTerminatedEventAttributes\n  420: optional SignalExternalWorkflowExecutionInitiatedEventAttributes signalExternalWorkflowExecutionInitiatedEventAttributes\n  430: optional SignalExternalWorkflowExecutionFailedEventAttributes signalExternalWorkflowExecutionFailedEventAttributes\n  440: optional ExternalWorkflowExecutionSignaledEventAttributes externalWorkflowExecutionSignaledEventAttributes\n  450: optional UpsertWorkflowSearchAttributesEventAttributes upsertWorkflowSearchAttributesEventAttributes\n}\n\nstruct History {\n  10: optional list<HistoryEvent> events\n}\n\nstruct WorkflowExecutionFilter {\n  10: optional string workflowId\n  20: optional string runId\n}\n\nstruct WorkflowTypeFilter {\n  10: optional string name\n}\n\nstruct StartTimeFilter {\n  10: optional i64 (js.type = \"Long\") earliestTime\n  20: optional i64 (js.type = \"Long\") latestTime\n}\n\nstruct DomainInfo {\n  10: optional string name\n  20: optional DomainStatus status\n  30: optional string description\n  40: optional string ownerEmail\n  // A key-value map for any customized purpose\n  50: optional map<string,string> data\n  60: optional string uuid\n}\n\nstruct DomainConfiguration {\n  10: optional i32 workflowExecutionRetentionPeriodInDays\n  20: optional bool emitMetric\n  70: optional BadBinaries badBinaries\n  80: optional ArchivalStatus historyArchivalStatus\n  90: optional string historyArchivalURI\n  100: optional ArchivalStatus visibilityArchivalStatus\n  110: optional string visibilityArchivalURI\n}\n\nstruct BadBinaries{\n  10: optional map<string, BadBinaryInfo> binaries\n}\n\nstruct BadBinaryInfo{\n  10: optional string reason\n  20: optional string operator\n  30: optional i64 (js.type = \"Long\") createdTimeNano\n}\n\nstruct UpdateDomainInfo {\n  10: optional string description\n  20: optional string ownerEmail\n  // A key-value map for any customized purpose\n  30: optional map<string,string> data\n}\n\nstruct ClusterReplicationConfiguration {\n 10: optional string clusterName\n}\n\nstruct DomainReplicationConfiguration {\n 10: optional string activeClusterName\n 20: optional list<ClusterReplicationConfiguration> clusters\n 30: optional DomainReplicationFilter replicationFilter\n}\n\n// DomainReplicationFilter restricts what of a global domain is replicated to the other clusters\nstruct DomainReplicationFilter {\n  // when not empty, only workflows of these types are replicated\n  10: optional list<string> workflowTypes\n  // when true, search attributes and memo are removed from the replicated history\n  20: optional bool skipVisibilityPayloads\n}\n\nstruct RegisterDomainRequest {\n  10: optional string name\n  20: optional string description\n  30: optional string ownerEmail\n  40: optional i32 workflowExecutionRetentionPeriodInDays\n  50: optional bool emitMetric\n  60: optional list<ClusterReplicationConfiguration> clusters\n  70: optional string activeClusterName\n  // A key-value map for any customized purpose\n  80: optional map<string,string> data\n  90: optional string securityToken\n  120: optional bool isGlobalDomain\n  130: optional ArchivalStatus historyArchivalStatus\n  140: optional string historyArchivalURI\n  150: optional ArchivalStatus visibilityArchivalStatus\n  160: optional string visibilityArchivalURI\n  170: optional DomainReplicationFilter replicationFilter\n}\n\nstruct ListDomainsRequest {\n  10: optional i32 pageSize\n  20: optional binary nextPageToken\n}\n\nstruct ListDomainsResponse {\n  10: optional list<DescribeDomainResponse> domains\n  20: optional binary nextPageToken\n}\n\nstruct DescribeDomainRequest {\n  10: optional string name\n  20: optional string uuid\n}\n\nstruct DomainFailoverInfo {\n  10: optional DomainFailoverState state\n  20: optional string sourceCluster\n  30: optional string targetCluster\n  40: optional i64 (js.type = \"Long\") startTimestamp\n  50: optional i64 (js.type = \"Long\") expireTimestamp\n  60: optional i64 (js.type = \"Long\") endTimestamp\n  70: optional string reason\n}\n\nstruct DomainFailoverRecord {\n  10: optional i64 (js.type = \"Long\") failoverVersion\n  20: optional string fromCluster\n  30: optional string toCluster\n  40: optional i64 (js.type = \"Long\") timestamp\n  // not set for failovers received through domain replication\n  50: optional bool graceful\n  60: optional string reason\n}\n\nstruct DomainFailoverHistory {\n  10: optional list<DomainFailoverRecord> records\n}\n\nstruct DescribeDomainResponse {\n  10: optional DomainInfo domainInfo\n  20: optional DomainConfiguration configuration\n  30: optional DomainReplicationConfiguration replicationConfiguration\n  40: optional i64 (js.type = \"Long\") failoverVersion\n  50: optional bool isGlobalDomain\n  60: optional DomainFailoverInfo failoverInfo\n  // latest failovers of the domain, most recent first\n  70: optional list<DomainFailoverRecord> failoverHistory\n}\n\nstruct UpdateDomainRequest {\n 10: optional string name\n 20: optional UpdateDomainInfo updatedInfo\n 30: optional DomainConfiguration configuration\n 40: optional DomainReplicationConfiguration replicationConfiguration\n 50: optional string securityToken\n 60: optional string deleteBadBinary\n // when set together with replicationConfiguration.activeClusterName, the domain is drained before the failover\n 70: optional i32 failoverTimeoutInSeconds\n // recorded in the failover history of the domain when the active cluster is changed\n 80: optional string failoverReason\n // when true, the local domain is promoted to a global domain\n 90: optional bool promoteToGlobalDomain\n}\n\nstruct UpdateDomainResponse {\n  10: optional DomainInfo domainInfo\n  20: optional DomainConfiguration configuration\n  30: optional DomainReplicationConfiguration replicationConfiguration\n  40: optional i64 (js.type = \"Long\") failoverVersion\n  50: optional bool isGlobalDomain\n  60: optional DomainFailoverInfo failoverInfo\n}\n\nstruct DeprecateDomainRequest {\n 10: optional string name\n 20: optional string securityToken\n}\n\nstruct StartWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional string workflowId\n  30: optional WorkflowType workflowType\n  40: optional TaskList taskList\n  50: optional binary input\n  60: optional i32 executionStartToCloseTimeoutSeconds\n  70: optional i32 taskStartToCloseTimeoutSeconds\n  80: optional string identity\n  90: optional string requestId\n  100: optional WorkflowIdReusePolicy workflowIdReusePolicy\n//  110: optional ChildPolicy childPolicy -- Removed but reserve the IDL order number\n  120: optional RetryPolicy retryPolicy\n  130: optional string cronSchedule\n  140: optional Memo memo\n  141: optional SearchAttributes searchAttributes\n  150: optional Header header\n}\n\nstruct StartWorkflowExecutionResponse {\n  10: optional string runId\n}\n\nstruct PollForDecisionTaskRequest {\n  10: optional string domain\n  20: optional TaskList taskList\n  30: optional string identity\n  40: optional string binaryChecksum\n}\n\nstruct PollForDecisionTaskResponse {\n  10: optional binary taskToken\n  20: optional WorkflowExecution workflowExecution\n  30: optional WorkflowType workflowType\n  40: optional i64 (js.type = \"Long\") previousStartedEventId\n  50: optional i64 (js.type = \"Long\") startedEventId\n  51: optional i64 (js.type = 'Long') attempt\n  54: optional i64 (js.type = \"Long\") backlogCountHint\n  60: optional History history\n  70: optional binary nextPageToken\n  80: optional WorkflowQuery query\n  90: optional TaskList WorkflowExecutionTaskList\n  100:  optional i64 (js.type = \"Long\") scheduledTimestamp\n  110:  optional i64 (js.type = \"Long\") startedTimestamp\n  120:  optional map<string, WorkflowQuery> queries\n}\n\nstruct StickyExecutionAttributes {\n  10: optional TaskList workerTaskList\n  20: optional i32 scheduleToStartTimeoutSeconds\n}\n\nstruct RespondDecisionTaskCompletedRequest {\n  10: optional binary taskToken\n  20: optional list<Decision> decisions\n  30: optional binary executionContext\n  40: optional string identity\n  50: optional StickyExecutionAttributes stickyAttributes\n  60: optional bool returnNewDecisionTask\n  70: optional bool forceCreateNewDecisionTask\n  80: optional string binaryChecksum\n  90: optional map<string, WorkflowQueryResult> queryResults\n}\n\nstruct RespondDecisionTaskCompletedResponse {\n  10: optional PollForDecisionTaskResponse decisionTask\n}\n\nstruct RespondDecisionTaskFailedRequest {\n  10: optional binary taskToken\n  20: optional DecisionTaskFailedCause cause\n  30: optional binary details\n  40: optional string identity\n}\n\nstruct PollForActivityTaskRequest {\n  10: optional string domain\n  20: optional TaskList taskList\n  30: optional string identity\n  40: optional TaskListMetadata taskListMetadata\n  50: optional string affinityToken\n}\n\nstruct PollForActivityTaskResponse {\n  10:  optional binary taskToken\n  20:  optional WorkflowExecution workflowExecution\n  30:  optional string activityId\n  40:  optional ActivityType activityType\n  50:  optional binary input\n  70:  optional i64 (js.type = \"Long\") scheduledTimestamp\n  80:  optional i32 scheduleToCloseTimeoutSeconds\n  90:  optional i64 (js.type = \"Long\") startedTimestamp\n  100: optional i32 startToCloseTimeoutSeconds\n  110: optional i32 heartbeatTimeoutSeconds\n  120: optional i32 attempt\n  130: optional i64 (js.type = \"Long\") scheduledTimestampOfThisAttempt\n  140: optional binary heartbeatDetails\n  150: optional WorkflowType workflowType\n  160: optional string workflowDomain\n  170: optional Header header\n}\n\nstruct RecordActivityTaskHeartbeatRequest {\n  10: optional binary taskToken\n  20: optional binary details\n  30: optional string identity\n}\n\nstruct RecordActivityTaskHeartbeatByIDRequest {\n  10: optional string domain\n  20: optional string workflowID\n  30: optional string runID\n  40: optional string activityID\n  50: optional binary details\n  60: optional string identity\n}\n\nstruct RecordActivityTaskHeartbeatResponse {\n  10: optional bool cancelRequested\n}\n\nstruct RespondActivityTaskCompletedRequest {\n  10: optional binary taskToken\n  20: optional binary result\n  30: optional string identity\n}\n\nstruct RespondActivityTaskFailedRequest {\n  10: optional binary taskToken\n  20: optional string reason\n  30: optional binary details\n  40: optional string identity\n}\n\nstruct RespondActivityTaskCanceledRequest {\n  10: optional binary taskToken\n  20: optional binary details\n  30: optional string identity\n}\n\nstruct RespondActivityTaskCompletedByIDRequest {\n  10: optional string domain\n  20: optional string workflowID\n  30: optional string runID\n  40: optional string activityID\n  50: optional binary result\n  60: optional string identity\n}\n\nstruct RespondActivityTaskFailedByIDRequest {\n  10: optional string domain\n  20: optional string workflowID\n  30: optional string runID\n  40: optional string activityID\n  50: optional string reason\n  60: optional binary details\n  70: optional string identity\n}\n\nstruct RespondActivityTaskCanceledByIDRequest {\n  10: optional string domain\n  20: optional string workflowID\n  30: optional string runID\n  40: optional string activityID\n  50: optional binary details\n  60: optional string identity\n}\n\nstruct RequestCancelWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional WorkflowExecution workflowExecution\n  30: optional string identity\n  40: optional string requestId\n}\n\nstruct GetWorkflowExecutionHistoryRequest {\n  10: optional string domain\n  20: optional WorkflowExecution execution\n  30: optional i32 maximumPageSize\n  40: optional binary nextPageToken\n  50: optional bool waitForNewEvent\n  60: optional HistoryEventFilterType HistoryEventFilterType\n}\n\nstruct GetWorkflowExecutionHistoryResponse {\n  10: optional History history\n  20: optional binary nextPageToken\n  30: optional bool archived\n}\n\nstruct SignalWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional WorkflowExecution workflowExecution\n  30: optional string signalName\n  40: optional binary input\n  50: optional string identity\n  60: optional string requestId\n  70: optional binary control\n}\n\nstruct SignalWithStartWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional string workflowId\n  30: optional WorkflowType workflowType\n  40: optional TaskList taskList\n  50: optional binary input\n  60: optional i32 executionStartToCloseTimeoutSeconds\n  70: optional i32 taskStartToCloseTimeoutSeconds\n  80: optional string identity\n  90: optional string requestId\n  100: optional WorkflowIdReusePolicy workflowIdReusePolicy\n  110: optional string signalName\n  120: optional binary signalInput\n  130: optional binary control\n  140: optional RetryPolicy retryPolicy\n  150: optional string cronSchedule\n  160: optional Memo memo\n  161: optional SearchAttributes searchAttributes\n  170: optional Header header\n}\n\nstruct TerminateWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional WorkflowExecution workflowExecution\n  30: optional string reason\n  40: optional binary details\n  50: optional string identity\n}\n\nstruct ResetWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional WorkflowExecution workflowExecution\n  30: optional string reason\n  40: optional i64 (js.type = \"Long\") decisionFinishEventId\n  50: optional string requestId\n}\n\nstruct ResetWorkflowExecutionResponse {\n  10: optional string runId\n}\n\nstruct ListOpenWorkflowExecutionsRequest {\n  10: optional string domain\n  20: optional i32 maximumPageSize\n  30: optional binary nextPageToken\n  40: optional StartTimeFilter StartTimeFilter\n  50: optional WorkflowExecutionFilter executionFilter\n  60: optional WorkflowTypeFilter typeFilter\n}\n\nstruct ListOpenWorkflowExecutionsResponse {\n  10: optional list<WorkflowExecutionInfo> executions\n  20: optional binary nextPageToken\n}\n\nstruct ListClosedWorkflowExecutionsRequest {\n  10: optional string domain\n  20: optional i32 maximumPageSize\n  30: optional binary nextPageToken\n  40: optional StartTimeFilter StartTimeFilter\n  50: optional WorkflowExecutionFilter executionFilter\n  60: optional WorkflowTypeFilter typeFilter\n  70: optional WorkflowExecutionCloseStatus statusFilter\n}\n\nstruct ListClosedWorkflowExecutionsResponse {\n  10: optional list<WorkflowExecutionInfo> executions\n  20: optional binary nextPageToken\n}\n\nstruct ListWorkflowExecutionsRequest {\n  10: optional string domain\n  20: optional i32 pageSize\n  30: optional binary nextPageToken\n  40: optional string query\n}\n\nstruct ListWorkflowExecutionsResponse {\n  10: optional list<WorkflowExecutionInfo> executions\n  20: optional binary nextPageToken\n}\n\nstruct ListArchivedWorkflowExecutionsRequest {\n  10: optional string domain\n  20: optional i32 pageSize\n  30: optional binary nextPageToken\n  40: optional string query\n}\n\nstruct ListArchivedWorkflowExecutionsResponse {\n  10: optional list<WorkflowExecutionInfo> executions\n  20: optional binary nextPageToken\n}\n\nstruct CountWorkflowExecutionsRequest {\n  10: optional string domain\n  20: optional string query\n}\n\nstruct CountWorkflowExecutionsResponse {\n  10: optional i64 count\n  // counts per group of workflows, if the query has GROUP BY clause\n  20: optional list<CountWorkflowExecutionsGroup> groups\n  // number of workflows not counted in any group, because the number of groups exceeds the limit\n  30: optional i64 truncatedCount\n}\n\nstruct CountWorkflowExecutionsGroup {\n  // JSON encoded values of the GROUP BY attributes, keyed by attribute name\n  10: optional map<string, binary> groupValues\n  20: optional i64 count\n}\n\nstruct GetSearchAttributesResponse {\n  10: optional map<string, IndexedValueType> keys\n}\n\nstruct QueryWorkflowRequest {\n  10: optional string domain\n  20: optional WorkflowExecution execution\n  30: optional WorkflowQuery query\n  // QueryRejectCondition can used to reject the query if workflow state does not satisify condition\n  40: optional QueryRejectCondition queryRejectCondition\n  50: optional QueryConsistencyLevel queryConsistencyLevel\n}\n\nstruct QueryRejected {\n  10: optional WorkflowExecutionCloseStatus closeStatus\n}\n\nstruct QueryWorkflowResponse {\n  10: optional binary queryResult\n  20: optional QueryRejected queryRejected\n}\n\nstruct WorkflowQuery {\n  10: optional string queryType\n  20: optional binary queryArgs\n}\n\nstruct ResetStickyTaskListRequest {\n  10: optional string domain\n  20: optional WorkflowExecution execution\n}\n\nstruct ResetStickyTaskListResponse {\n    // The reason to keep this response is to allow returning\n    // information in the future.\n}\n\nstruct RespondQueryTaskCompletedRequest {\n  10: optional binary taskToken\n  20: optional QueryTaskCompletedType completedType\n  30: optional binary queryResult\n  40: optional string errorMessage\n  50: optional WorkerVersionInfo workerVersionInfo\n}\n\nstruct WorkflowQueryResult {\n  10: optional QueryResultType resultType\n  20: optional binary answer\n  30: optional string errorMessage\n}\n\nstruct DescribeWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional WorkflowExecution execution\n}\n\nstruct PendingActivityInfo {\n  10: optional string activityID\n  20: optional ActivityType activityType\n  30: optional PendingActivityState state\n  40: optional binary heartbeatDetails\n  50: optional i64 (js.type = \"Long\") lastHeartbeatTimestamp\n  60: optional i64 (js.type = \"Long\") lastStartedTimestamp\n  70: optional i32 attempt\n  80: optional i32 maximumAttempts\n  90: optional i64 (js.type = \"Long\") scheduledTimestamp\n  100: optional i64 (js.type = \"Long\") expirationTimestamp\n  110: optional string lastFailureReason\n  120: optional string lastWorkerIdentity\n  130: optional binary lastFailureDetails\n}\n\nstruct PendingChildExecutionInfo {\n  10: optional string workflowID\n  20: optional string runID\n  30: optional string workflowTypName\n  40: optional i64 (js.type = \"Long\") initiatedID\n  50: optional ParentClosePolicy parentClosePolicy\n}\n\nstruct DescribeWorkflowExecutionResponse {\n  10: optional WorkflowExecutionConfiguration executionConfiguration\n  20: optional WorkflowExecutionInfo workflowExecutionInfo\n  30: optional list<PendingActivityInfo> pendingActivities\n  40: optional list<PendingChildExecutionInfo> pendingChildren\n}\n\nstruct DescribeTaskListRequest {\n  10: optional string domain\n  20: optional TaskList taskList\n  30: optional TaskListType taskListType\n  40: optional bool includeTaskListStatus\n}\n\nstruct DescribeTaskListResponse {\n  10: optional list<PollerInfo> pollers\n  20: optional TaskListStatus taskListStatus\n  // pollers which polled this tasklist recently but have since stopped polling\n  30: optional list<PollerInfo> inactivePollers\n  // server side dispatch limits configured per activity type, only set for activity tasklists\n  40: optional list<ActivityTypeLimitInfo> activityTypeLimits\n}\n\nstruct ActivityTypeLimitInfo {\n  10: optional string activityType\n  20: optional double ratePerSecond\n  30: optional i32 maxOutstanding\n  40: optional i32 outstanding\n}\n\nstruct TaskListStatus {\n  10: optional i64 (js.type = \"Long\") backlogCountHint\n  20: optional i64 (js.type = \"Long\") readLevel\n  30: optional i64 (js.type = \"Long\") ackLevel\n  35: optional double ratePerSecond\n  40: optional TaskIDBlock taskIDBlock\n}\n\nstruct TaskIDBlock {\n  10: optional i64 (js.type = \"Long\")  startID\n  20: optional i64 (js.type = \"Long\")  endID\n}\n\n//At least one of the parameters needs to be provided\nstruct DescribeHistoryHostRequest {\n  10: optional string               hostAddress //ip:port\n  20: optional i32                  shardIdForHost\n  30: optional WorkflowExecution    executionForHost\n}\n\nstruct RemoveTaskRequest {\n  10: optional i32                      shardID\n  20: optional i32                      type\n  30: optional i64 (js.type = \"Long\")   taskID\n}\n\nstruct CloseShardRequest {\n  10: optional i32               shardID\n}\n\nstruct DescribeHistoryHostResponse{\n  10: optional i32                  numberOfShards\n  20: optional list<i32>            shardIDs\n  30: optional DomainCacheInfo      domainCache\n  40: optional string               shardControllerStatus\n  50: optional string               address\n}\n\nstruct DomainCacheInfo{\n  10: optional i64 numOfItemsInCacheByID\n  20: optional i64 numOfItemsInCacheByName\n}\n\nenum TaskListType {\n  /*\n   * Decision type of tasklist\n   */\n  Decision,\n  /*\n   * Activity type of tasklist\n   */\n  Activity,\n}\n\nstruct PollerInfo {\n  // Unix Nano\n  10: optional i64 (js.type = \"Long\")  lastAccessTime\n  20: optional string identity\n  30: optional double ratePerSecond\n  40: optional string clientImpl\n  50: optional string clientFeatureVersion\n  60: optional string clientLibraryVersion\n  // number of polls from this identity currently waiting on the tasklist\n  70: optional i32 outstandingPollCount\n  80: optional i64 (js.type = \"Long\") tasksDispatched\n  90: optional i64 (js.type = \"Long\") tasksCompleted\n  100: optional i64 (js.type = \"Long\") tasksFailed\n  // decision tasks dispatched from the sticky tasklist of this identity\n  110: optional i64 (js.type = \"Long\") stickyCacheHits\n  // decision tasks of workflows with previous decisions dispatched from the normal tasklist,\n  // i.e. the worker had to replay the full history\n  120: optional i64 (js.type = \"Long\") stickyCacheMisses\n}\n\nstruct RetryPolicy {\n  // Interval of the first retry. If coefficient is 1.0 then it is used for all retries.\n  10: optional i32 initialIntervalInSeconds\n\n  // Coefficient used to calculate the next retry interval.\n  // The next retry interval is previous interval multiplied by the coefficient.\n  // Must be 1 or larger.\n  20: optional double backoffCoefficient\n\n  // Maximum interval between retries. Exponential backoff leads to interval increase.\n  // This value is the cap of the increase. Default is 100x of initial interval.\n  30: optional i32 maximumIntervalInSeconds\n\n  // Maximum number of attempts. When exceeded the retries stop even if not expired yet.\n  // Must be 1 or bigger. Default is unlimited.\n  40: optional i32 maximumAttempts\n\n  // Non-Retriable errors. Will stop retrying if error matches this list.\n  50: optional list<string> nonRetriableErrorReasons\n\n  // Expiration time for the whole retry process.\n  60: optional i32 expirationIntervalInSeconds\n}\n\n// HistoryBranchRange represents a piece of range for a branch.\nstruct HistoryBranchRange{\n  // branchID of original branch forked from\n  10: optional string branchID\n  // beinning node for the range, inclusive\n  20: optional i64 beginNodeID\n  // ending node for the range, exclusive\n  30: optional i64 endNodeID\n}\n\n// For history persistence to serialize/deserialize branch details\nstruct HistoryBranch{\n  10: optional string treeID\n  20: optional string branchID\n  30: optional list<HistoryBranchRange> ancestors\n}\n\n// VersionHistoryItem contains signal eventID and the corresponding version\nstruct VersionHistoryItem{\n  10: optional i64 (js.type = \"Long\") eventID\n  20: optional i64 (js.type = \"Long\") version\n}\n\n// VersionHistory contains the version history of a branch\nstruct VersionHistory{\n  10: optional binary branchToken\n  20: optional list<VersionHistoryItem> items\n}\n\n// VersionHistories contains all version histories from all branches\nstruct VersionHistories{\n  10: optional i32 currentVersionHistoryIndex\n  20: optional list<VersionHistory> histories\n}\n\n// ReapplyEventsRequest is the request for reapply events API\nstruct ReapplyEventsRequest{\n  10: optional string domainName\n  20: optional WorkflowExecution workflowExecution\n  30: optional DataBlob events\n}"
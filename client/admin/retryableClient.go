@@ -26,6 +26,7 @@ import (
 	"go.uber.org/yarpc"
 
 	"github.com/uber/cadence/.gen/go/admin"
+	"github.com/uber/cadence/.gen/go/replicator"
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common/backoff"
 )
@@ -59,6 +60,178 @@ func (c *retryableClient) AddSearchAttribute(
 	return backoff.Retry(op, c.policy, c.isRetryable)
 }
 
+func (c *retryableClient) UpdateSearchAttributes(
+	ctx context.Context,
+	request *admin.UpdateSearchAttributesRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.UpdateSearchAttributes(ctx, request, opts...)
+	}
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) DescribeSearchAttributes(
+	ctx context.Context,
+	request *admin.DescribeSearchAttributesRequest,
+	opts ...yarpc.CallOption,
+) (*admin.DescribeSearchAttributesResponse, error) {
+
+	var resp *admin.DescribeSearchAttributesResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.DescribeSearchAttributes(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DrainTaskList(
+	ctx context.Context,
+	request *admin.DrainTaskListRequest,
+	opts ...yarpc.CallOption,
+) (*admin.DrainTaskListResponse, error) {
+
+	var resp *admin.DrainTaskListResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.DrainTaskList(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) GetDLQReplicationMessages(
+	ctx context.Context,
+	request *replicator.GetDLQReplicationMessagesRequest,
+	opts ...yarpc.CallOption,
+) (*replicator.GetDLQReplicationMessagesResponse, error) {
+
+	var resp *replicator.GetDLQReplicationMessagesResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.GetDLQReplicationMessages(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ReadDLQMessages(
+	ctx context.Context,
+	request *replicator.ReadDLQMessagesRequest,
+	opts ...yarpc.CallOption,
+) (*replicator.ReadDLQMessagesResponse, error) {
+
+	var resp *replicator.ReadDLQMessagesResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ReadDLQMessages(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) PurgeDLQMessages(
+	ctx context.Context,
+	request *replicator.PurgeDLQMessagesRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.PurgeDLQMessages(ctx, request, opts...)
+	}
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) MergeDLQMessages(
+	ctx context.Context,
+	request *replicator.MergeDLQMessagesRequest,
+	opts ...yarpc.CallOption,
+) (*replicator.MergeDLQMessagesResponse, error) {
+
+	var resp *replicator.MergeDLQMessagesResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.MergeDLQMessages(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DescribeReplication(
+	ctx context.Context,
+	request *replicator.DescribeReplicationRequest,
+	opts ...yarpc.CallOption,
+) (*replicator.DescribeReplicationResponse, error) {
+
+	var resp *replicator.DescribeReplicationResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.DescribeReplication(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) StartChildWorkflowExecution(
+	ctx context.Context,
+	request *admin.StartChildWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (*shared.StartWorkflowExecutionResponse, error) {
+
+	var resp *shared.StartWorkflowExecutionResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.StartChildWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) SignalExternalWorkflowExecution(
+	ctx context.Context,
+	request *admin.SignalExternalWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.SignalExternalWorkflowExecution(ctx, request, opts...)
+	}
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) RecordChildExecutionCompleted(
+	ctx context.Context,
+	request *admin.RecordChildExecutionCompletedRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.RecordChildExecutionCompleted(ctx, request, opts...)
+	}
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) RemoveSignalMutableState(
+	ctx context.Context,
+	request *admin.RemoveSignalMutableStateRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.RemoveSignalMutableState(ctx, request, opts...)
+	}
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
 func (c *retryableClient) DescribeHistoryHost(
 	ctx context.Context,
 	request *shared.DescribeHistoryHostRequest,
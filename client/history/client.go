@@ -773,7 +773,9 @@ func (c *clientImpl) GetReplicationMessages(
 		}
 
 		if _, ok := requestsByClient[client]; !ok {
-			requestsByClient[client] = &replicator.GetReplicationMessagesRequest{}
+			requestsByClient[client] = &replicator.GetReplicationMessagesRequest{
+				ClusterName: request.ClusterName,
+			}
 		}
 
 		req := requestsByClient[client]
@@ -811,6 +813,123 @@ func (c *clientImpl) GetReplicationMessages(
 	return response, nil
 }
 
+func (c *clientImpl) GetReplicationAckLevels(
+	ctx context.Context,
+	request *h.GetReplicationAckLevelsRequest,
+	opts ...yarpc.CallOption,
+) (*h.GetReplicationAckLevelsResponse, error) {
+	requestsByClient := make(map[historyserviceclient.Interface]*h.GetReplicationAckLevelsRequest)
+
+	for _, shardID := range request.ShardIDs {
+		client, err := c.getClientForShardID(int(shardID))
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := requestsByClient[client]; !ok {
+			requestsByClient[client] = &h.GetReplicationAckLevelsRequest{
+				ClusterName: request.ClusterName,
+			}
+		}
+
+		req := requestsByClient[client]
+		req.ShardIDs = append(req.ShardIDs, shardID)
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(len(requestsByClient))
+	respChan := make(chan *h.GetReplicationAckLevelsResponse, len(requestsByClient))
+	for client, req := range requestsByClient {
+		go func(client historyserviceclient.Interface, request *h.GetReplicationAckLevelsRequest) {
+			defer wg.Done()
+
+			ctx, cancel := c.createContext(ctx)
+			defer cancel()
+			resp, err := client.GetReplicationAckLevels(ctx, request, opts...)
+			if err != nil {
+				c.logger.Warn("Failed to get replication ack levels from client", tag.Error(err))
+				return
+			}
+			respChan <- resp
+		}(client, req)
+	}
+
+	wg.Wait()
+	close(respChan)
+
+	response := &h.GetReplicationAckLevelsResponse{AckLevelsByShard: make(map[int32]*h.ReplicationAckLevel)}
+	for resp := range respChan {
+		for shardID, ackLevel := range resp.AckLevelsByShard {
+			response.AckLevelsByShard[shardID] = ackLevel
+		}
+	}
+
+	return response, nil
+}
+
+func (c *clientImpl) DescribeReplication(
+	ctx context.Context,
+	request *replicator.DescribeReplicationRequest,
+	opts ...yarpc.CallOption,
+) (*replicator.DescribeReplicationResponse, error) {
+	requestsByClient := make(map[historyserviceclient.Interface]*replicator.DescribeReplicationRequest)
+
+	for _, shardID := range request.ShardIDs {
+		client, err := c.getClientForShardID(int(shardID))
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := requestsByClient[client]; !ok {
+			requestsByClient[client] = &replicator.DescribeReplicationRequest{
+				SourceCluster: request.SourceCluster,
+				Domain:        request.Domain,
+				DomainID:      request.DomainID,
+			}
+		}
+
+		req := requestsByClient[client]
+		req.ShardIDs = append(req.ShardIDs, shardID)
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(len(requestsByClient))
+	respChan := make(chan *replicator.DescribeReplicationResponse, len(requestsByClient))
+	errChan := make(chan error, len(requestsByClient))
+	for client, req := range requestsByClient {
+		go func(client historyserviceclient.Interface, request *replicator.DescribeReplicationRequest) {
+			defer wg.Done()
+
+			ctx, cancel := c.createContext(ctx)
+			defer cancel()
+			resp, err := client.DescribeReplication(ctx, request, opts...)
+			if err != nil {
+				c.logger.Warn("Failed to describe replication from client", tag.Error(err))
+				errChan <- err
+				return
+			}
+			respChan <- resp
+		}(client, req)
+	}
+
+	wg.Wait()
+	close(respChan)
+	close(errChan)
+
+	response := &replicator.DescribeReplicationResponse{SourceCluster: request.SourceCluster}
+	for resp := range respChan {
+		response.Shards = append(response.Shards, resp.Shards...)
+	}
+	if len(response.Shards) == 0 && len(request.ShardIDs) != 0 {
+		// none of the hosts could describe the replication, surface the cause
+		if err, ok := <-errChan; ok {
+			return nil, err
+		}
+	}
+
+	return response, nil
+}
+
 func (c *clientImpl) ReapplyEvents(
 	ctx context.Context,
 	request *h.ReapplyEventsRequest,
@@ -830,6 +949,127 @@ func (c *clientImpl) ReapplyEvents(
 	return err
 }
 
+func (c *clientImpl) BackfillReplication(
+	ctx context.Context,
+	request *h.BackfillReplicationRequest,
+	opts ...yarpc.CallOption,
+) error {
+	client, err := c.getClientForWorkflowID(request.GetExecution().GetWorkflowId())
+	if err != nil {
+		return err
+	}
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		return client.BackfillReplication(ctx, request, opts...)
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	return err
+}
+
+func (c *clientImpl) GetDLQReplicationMessages(
+	ctx context.Context,
+	request *replicator.GetDLQReplicationMessagesRequest,
+	opts ...yarpc.CallOption,
+) (*replicator.GetDLQReplicationMessagesResponse, error) {
+	requestsByClient := make(map[historyserviceclient.Interface]*replicator.GetDLQReplicationMessagesRequest)
+	for _, taskInfo := range request.GetTaskInfos() {
+		client, err := c.getClientForWorkflowID(taskInfo.GetWorkflowID())
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := requestsByClient[client]; !ok {
+			requestsByClient[client] = &replicator.GetDLQReplicationMessagesRequest{}
+		}
+
+		req := requestsByClient[client]
+		req.TaskInfos = append(req.TaskInfos, taskInfo)
+	}
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	response := &replicator.GetDLQReplicationMessagesResponse{}
+	for client, req := range requestsByClient {
+		ctx, cancel := c.createContext(ctx)
+		resp, err := client.GetDLQReplicationMessages(ctx, req, opts...)
+		cancel()
+		if err != nil {
+			return nil, err
+		}
+		response.ReplicationTasks = append(response.ReplicationTasks, resp.GetReplicationTasks()...)
+	}
+	return response, nil
+}
+
+func (c *clientImpl) ReadDLQMessages(
+	ctx context.Context,
+	request *replicator.ReadDLQMessagesRequest,
+	opts ...yarpc.CallOption,
+) (*replicator.ReadDLQMessagesResponse, error) {
+	client, err := c.getClientForShardID(int(request.GetShardID()))
+	if err != nil {
+		return nil, err
+	}
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	var response *replicator.ReadDLQMessagesResponse
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		var err error
+		response, err = client.ReadDLQMessages(ctx, request, opts...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (c *clientImpl) PurgeDLQMessages(
+	ctx context.Context,
+	request *replicator.PurgeDLQMessagesRequest,
+	opts ...yarpc.CallOption,
+) error {
+	client, err := c.getClientForShardID(int(request.GetShardID()))
+	if err != nil {
+		return err
+	}
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		return client.PurgeDLQMessages(ctx, request, opts...)
+	}
+	return c.executeWithRedirect(ctx, client, op)
+}
+
+func (c *clientImpl) MergeDLQMessages(
+	ctx context.Context,
+	request *replicator.MergeDLQMessagesRequest,
+	opts ...yarpc.CallOption,
+) (*replicator.MergeDLQMessagesResponse, error) {
+	client, err := c.getClientForShardID(int(request.GetShardID()))
+	if err != nil {
+		return nil, err
+	}
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	var response *replicator.MergeDLQMessagesResponse
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		var err error
+		response, err = client.MergeDLQMessages(ctx, request, opts...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (c *clientImpl) createContext(parent context.Context) (context.Context, context.CancelFunc) {
 	if parent == nil {
 		return context.WithTimeout(context.Background(), c.timeout)
@@ -22,6 +22,7 @@ package matching
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"go.uber.org/yarpc"
@@ -69,14 +70,18 @@ func (c *clientImpl) AddActivityTask(
 	request *m.AddActivityTaskRequest,
 	opts ...yarpc.CallOption) error {
 	opts = common.AggregateYarpcOptions(ctx, opts...)
-	partition := c.loadBalancer.PickWritePartition(
-		request.GetDomainUUID(),
-		*request.GetTaskList(),
-		persistence.TaskListTypeActivity,
-		request.GetForwardedFrom(),
-	)
-	request.TaskList.Name = &partition
-	client, err := c.getClientForTasklist(partition)
+	if request.GetAffinityToken() != "" {
+		routeToAffinityTaskList(request.TaskList, request.GetAffinityToken())
+	} else {
+		partition := c.loadBalancer.PickWritePartition(
+			request.GetDomainUUID(),
+			*request.GetTaskList(),
+			persistence.TaskListTypeActivity,
+			request.GetForwardedFrom(),
+		)
+		request.TaskList.Name = &partition
+	}
+	client, err := c.getClientForTasklist(request.TaskList.GetName())
 	if err != nil {
 		return err
 	}
@@ -111,13 +116,17 @@ func (c *clientImpl) PollForActivityTask(
 	request *m.PollForActivityTaskRequest,
 	opts ...yarpc.CallOption) (*workflow.PollForActivityTaskResponse, error) {
 	opts = common.AggregateYarpcOptions(ctx, opts...)
-	partition := c.loadBalancer.PickReadPartition(
-		request.GetDomainUUID(),
-		*request.PollRequest.GetTaskList(),
-		persistence.TaskListTypeActivity,
-		request.GetForwardedFrom(),
-	)
-	request.PollRequest.TaskList.Name = &partition
+	if request.PollRequest.GetAffinityToken() != "" {
+		routeToAffinityTaskList(request.PollRequest.TaskList, request.PollRequest.GetAffinityToken())
+	} else {
+		partition := c.loadBalancer.PickReadPartition(
+			request.GetDomainUUID(),
+			*request.PollRequest.GetTaskList(),
+			persistence.TaskListTypeActivity,
+			request.GetForwardedFrom(),
+		)
+		request.PollRequest.TaskList.Name = &partition
+	}
 	client, err := c.getClientForTasklist(request.PollRequest.TaskList.GetName())
 	if err != nil {
 		return nil, err
@@ -199,6 +208,28 @@ func (c *clientImpl) DescribeTaskList(ctx context.Context, request *m.DescribeTa
 	return client.DescribeTaskList(ctx, request, opts...)
 }
 
+func (c *clientImpl) RecordTaskCompletion(ctx context.Context, request *m.RecordTaskCompletionRequest, opts ...yarpc.CallOption) error {
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getClientForTasklist(request.TaskList.GetName())
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.RecordTaskCompletion(ctx, request, opts...)
+}
+
+func (c *clientImpl) DrainTaskList(ctx context.Context, request *m.DrainTaskListRequest, opts ...yarpc.CallOption) (*m.DrainTaskListResponse, error) {
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getClientForTasklist(request.TaskList.GetName())
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.DrainTaskList(ctx, request, opts...)
+}
+
 func (c *clientImpl) createContext(parent context.Context) (context.Context, context.CancelFunc) {
 	if parent == nil {
 		return context.WithTimeout(context.Background(), c.timeout)
@@ -220,3 +251,14 @@ func (c *clientImpl) getClientForTasklist(key string) (matchingserviceclient.Int
 	}
 	return client.(matchingserviceclient.Interface), nil
 }
+
+// routeToAffinityTaskList points the task list of an activity task or poll with an affinity
+// token to the affinity task list of that token. Affinity task lists are never partitioned,
+// they are sticky task lists owned by a single host like the sticky task lists of decisions
+func routeToAffinityTaskList(taskList *workflow.TaskList, affinityToken string) {
+	if !strings.HasPrefix(taskList.GetName(), affinityTaskListPrefix) {
+		// requests are retried with the same task list, which is only rewritten once
+		taskList.Name = common.StringPtr(AffinityTaskListName(taskList.GetName(), affinityToken))
+	}
+	taskList.Kind = common.TaskListKindPtr(workflow.TaskListKindSticky)
+}
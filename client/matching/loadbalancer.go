@@ -67,8 +67,37 @@ type (
 
 const (
 	taskListPartitionPrefix = "/__cadence_sys/"
+	// affinityTaskListPrefix is the naming prefix of the task lists which hold the activity
+	// tasks scheduled with an affinity token, until a poller with that token picks them up
+	affinityTaskListPrefix = "/__cadence_affinity/"
 )
 
+// AffinityTaskListName returns the name of the task list which holds the activity tasks of
+// the given task list scheduled with the given affinity token. Affinity tokens must not
+// contain any '/' as the token is the last element of the name
+func AffinityTaskListName(taskListName string, affinityToken string) string {
+	return fmt.Sprintf("%v%v/%v", affinityTaskListPrefix, taskListName, affinityToken)
+}
+
+// TaskListBaseName returns the name of the task list as specified by the user, given the
+// name of one of its partitions or of one of its affinity task lists
+func TaskListBaseName(name string) string {
+	prefix := ""
+	switch {
+	case strings.HasPrefix(name, taskListPartitionPrefix):
+		prefix = taskListPartitionPrefix
+	case strings.HasPrefix(name, affinityTaskListPrefix):
+		prefix = affinityTaskListPrefix
+	default:
+		return name
+	}
+	suffixOff := strings.LastIndex(name, "/")
+	if suffixOff <= len(prefix) {
+		return name
+	}
+	return name[len(prefix):suffixOff]
+}
+
 // NewLoadBalancer returns an instance of matching load balancer that
 // can help distribute api calls across task list partitions
 func NewLoadBalancer(
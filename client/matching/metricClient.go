@@ -216,6 +216,40 @@ func (c *metricClient) DescribeTaskList(
 	return resp, err
 }
 
+func (c *metricClient) RecordTaskCompletion(
+	ctx context.Context,
+	request *m.RecordTaskCompletionRequest,
+	opts ...yarpc.CallOption) error {
+	c.metricsClient.IncCounter(metrics.MatchingClientRecordTaskCompletionScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.MatchingClientRecordTaskCompletionScope, metrics.CadenceClientLatency)
+	err := c.client.RecordTaskCompletion(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.MatchingClientRecordTaskCompletionScope, metrics.CadenceClientFailures)
+	}
+
+	return err
+}
+
+func (c *metricClient) DrainTaskList(
+	ctx context.Context,
+	request *m.DrainTaskListRequest,
+	opts ...yarpc.CallOption) (*m.DrainTaskListResponse, error) {
+	c.metricsClient.IncCounter(metrics.MatchingClientDrainTaskListScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.MatchingClientDrainTaskListScope, metrics.CadenceClientLatency)
+	resp, err := c.client.DrainTaskList(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.MatchingClientDrainTaskListScope, metrics.CadenceClientFailures)
+	}
+
+	return resp, err
+}
+
 func (c *metricClient) emitForwardedFromStats(scope int, forwardedFrom string, taskList *workflow.TaskList) {
 	if taskList == nil {
 		return
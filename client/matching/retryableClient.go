@@ -157,3 +157,31 @@ func (c *retryableClient) DescribeTaskList(
 	err := backoff.Retry(op, c.policy, c.isRetryable)
 	return resp, err
 }
+
+func (c *retryableClient) RecordTaskCompletion(
+	ctx context.Context,
+	request *m.RecordTaskCompletionRequest,
+	opts ...yarpc.CallOption) error {
+
+	op := func() error {
+		return c.client.RecordTaskCompletion(ctx, request, opts...)
+	}
+
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) DrainTaskList(
+	ctx context.Context,
+	request *m.DrainTaskListRequest,
+	opts ...yarpc.CallOption) (*m.DrainTaskListResponse, error) {
+
+	var resp *m.DrainTaskListResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.DrainTaskList(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
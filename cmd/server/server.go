@@ -37,6 +37,8 @@ import (
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	persistenceClient "github.com/uber/cadence/common/persistence/client"
 	"github.com/uber/cadence/common/service"
 	"github.com/uber/cadence/common/service/config"
 	"github.com/uber/cadence/common/service/dynamicconfig"
@@ -130,6 +132,7 @@ func (s *server) startService() common.Daemon {
 	params.PProfInitializer = svcCfg.PProf.NewInitializer(params.Logger)
 
 	params.DCRedirectionPolicy = s.cfg.DCRedirectionPolicy
+	params.VisibilityExportConfig = s.cfg.VisibilityExport
 
 	params.MetricsClient = metrics.NewClient(params.MetricScope, service.GetMetricsServiceIdx(params.Name, params.Logger))
 
@@ -155,7 +158,11 @@ func (s *server) startService() common.Daemon {
 		common.GetDefaultAdvancedVisibilityWritingMode(params.PersistenceConfig.IsAdvancedVisibilityConfigExist()),
 	)()
 	isAdvancedVisEnabled := advancedVisMode != common.AdvancedVisibilityWritingModeOff
-	if params.ClusterMetadata.IsGlobalDomainEnabled() {
+	if s.cfg.Messaging.Type == config.MessagingTypeQueue {
+		if params.ClusterMetadata.IsGlobalDomainEnabled() || isAdvancedVisEnabled {
+			params.MessagingClient = s.newQueueMessagingClient(&params)
+		}
+	} else if params.ClusterMetadata.IsGlobalDomainEnabled() {
 		params.MessagingClient = messaging.NewKafkaClient(&s.cfg.Kafka, params.MetricsClient, zap.NewNop(), params.Logger, params.MetricScope, true, isAdvancedVisEnabled)
 	} else if isAdvancedVisEnabled {
 		params.MessagingClient = messaging.NewKafkaClient(&s.cfg.Kafka, params.MetricsClient, zap.NewNop(), params.Logger, params.MetricScope, false, isAdvancedVisEnabled)
@@ -227,6 +234,35 @@ func (s *server) startService() common.Daemon {
 	return daemon
 }
 
+// newQueueMessagingClient creates a messaging client backed by the persistence queue of the current cluster.
+// Replication tasks still go through kafka unless remote clusters fetch them with the rpc replication consumer
+func (s *server) newQueueMessagingClient(params *service.BootstrapParams) messaging.Client {
+	var replicationClient messaging.Client
+	if params.ClusterMetadata.IsGlobalDomainEnabled() {
+		consumerConfig := params.ClusterMetadata.GetReplicationConsumerConfig()
+		if consumerConfig == nil || consumerConfig.Type != config.ReplicationConsumerTypeRPC {
+			replicationClient = messaging.NewKafkaClient(&s.cfg.Kafka, params.MetricsClient, zap.NewNop(), params.Logger, params.MetricScope, true, false)
+		}
+	}
+
+	// the indexer consumer groups are registered up front, so messages are kept until the indexer consumes them
+	var consumerGroups []string
+	if advancedVisStore, ok := s.cfg.Persistence.DataStores[s.cfg.Persistence.AdvancedVisibilityStore]; ok && advancedVisStore.ElasticSearch != nil {
+		consumerGroups = advancedVisStore.ElasticSearch.GetConsumerNames()
+	}
+
+	pConfig := s.cfg.Persistence
+	pFactory := persistenceClient.NewFactory(&pConfig, params.ClusterMetadata.GetCurrentClusterName(), params.MetricsClient, params.Logger)
+	return persistence.NewQueueMessagingClient(
+		pFactory.NewQueue,
+		s.cfg.Messaging.Partitions,
+		consumerGroups,
+		replicationClient,
+		params.MetricsClient,
+		params.Logger,
+	)
+}
+
 // execute runs the daemon in a separate go routine
 func execute(d common.Daemon, doneC chan struct{}) {
 	d.Start()
@@ -130,6 +130,7 @@ type (
 		isGlobalDomain              bool
 		failoverNotificationVersion int64
 		notificationVersion         int64
+		failoverInfo                *workflow.DomainFailoverInfo
 		initialized                 bool
 	}
 )
@@ -537,6 +538,7 @@ func (c *domainCache) updateIDToDomainCache(
 	entry.isGlobalDomain = record.isGlobalDomain
 	entry.failoverNotificationVersion = record.failoverNotificationVersion
 	entry.notificationVersion = record.notificationVersion
+	entry.failoverInfo = record.failoverInfo
 	entry.initialized = record.initialized
 
 	nextDomain := entry.duplicate()
@@ -655,6 +657,7 @@ func (c *domainCache) buildEntryFromRecord(
 	newEntry.isGlobalDomain = record.IsGlobalDomain
 	newEntry.failoverNotificationVersion = record.FailoverNotificationVersion
 	newEntry.notificationVersion = record.NotificationVersion
+	newEntry.failoverInfo = record.FailoverInfo
 	newEntry.initialized = true
 	return newEntry
 }
@@ -694,6 +697,7 @@ func (entry *DomainCacheEntry) duplicate() *DomainCacheEntry {
 	}
 	result.replicationConfig = &persistence.DomainReplicationConfig{
 		ActiveClusterName: entry.replicationConfig.ActiveClusterName,
+		Filter:            entry.replicationConfig.Filter,
 	}
 	for _, clusterName := range entry.replicationConfig.Clusters {
 		result.replicationConfig.Clusters = append(result.replicationConfig.Clusters, &*clusterName)
@@ -703,6 +707,10 @@ func (entry *DomainCacheEntry) duplicate() *DomainCacheEntry {
 	result.isGlobalDomain = entry.isGlobalDomain
 	result.failoverNotificationVersion = entry.failoverNotificationVersion
 	result.notificationVersion = entry.notificationVersion
+	if entry.failoverInfo != nil {
+		failoverInfo := *entry.failoverInfo
+		result.failoverInfo = &failoverInfo
+	}
 	result.initialized = entry.initialized
 	return result
 }
@@ -747,6 +755,19 @@ func (entry *DomainCacheEntry) GetNotificationVersion() int64 {
 	return entry.notificationVersion
 }
 
+// GetFailoverInfo return the state of the latest graceful failover of the domain, nil if none
+func (entry *DomainCacheEntry) GetFailoverInfo() *workflow.DomainFailoverInfo {
+	return entry.failoverInfo
+}
+
+// IsDomainDraining return whether the domain is active and being drained by a graceful failover
+func (entry *DomainCacheEntry) IsDomainDraining() bool {
+	return entry.IsDomainActive() &&
+		entry.failoverInfo != nil &&
+		entry.failoverInfo.State != nil &&
+		entry.failoverInfo.GetState() == workflow.DomainFailoverStateDraining
+}
+
 // IsDomainActive return whether the domain is active, i.e. non global domain or global domain which active cluster is the current cluster
 func (entry *DomainCacheEntry) IsDomainActive() bool {
 	if !entry.isGlobalDomain {
@@ -43,6 +43,8 @@ const (
 	FirstBlobPageToken = 1
 	// LastBlobNextPageToken is the next page token on the last blob for each history archival
 	LastBlobNextPageToken = -1
+	// EndMessageID is the id of the end message, here we use the int64 max
+	EndMessageID int64 = 1<<63 - 1
 )
 
 const (
@@ -84,6 +86,8 @@ const (
 const (
 	// VisibilityAppName is used to find kafka topics and ES indexName for visibility
 	VisibilityAppName = "visibility"
+	// HistorySearchAppName is used to find ES indexName for history search
+	HistorySearchAppName = "history"
 )
 
 // This was flagged by salus as potentially hardcoded credentials. This is a false positive by the scanner and should be
@@ -132,6 +136,12 @@ const (
 // Queue types used in queue table
 const (
 	DomainReplicationQueueType QueueType = 1
+	// VisibilityQueueType is the queue carrying advanced visibility messages when messaging is queue based
+	VisibilityQueueType QueueType = 2
+	// VisibilityDLQQueueType is the queue holding visibility messages that failed processing
+	VisibilityDLQQueueType QueueType = 3
+	// VisibilityMigrationRepairQueueType is the queue holding visibility records whose write to the migration target failed
+	VisibilityMigrationRepairQueueType QueueType = 4
 )
 
 // enum for dynamic config AdvancedVisibilityWritingMode
@@ -143,3 +153,16 @@ const (
 	// AdvancedVisibilityWritingModeDual means write to both normal visibility and advanced visibility store
 	AdvancedVisibilityWritingModeDual = "dual"
 )
+
+// enum for dynamic config MatchingBacklogSegmentMode
+const (
+	// BacklogSegmentModeOff means the backlog of a task list is stored as one row per task,
+	// segments are neither read nor deleted
+	BacklogSegmentModeOff = "off"
+	// BacklogSegmentModeOn means the backlog of a task list is stored as segments of tasks,
+	// tasks which were stored as rows are still read
+	BacklogSegmentModeOn = "on"
+	// BacklogSegmentModeDrain means the backlog of a task list is stored as one row per task,
+	// tasks which were stored as segments are still read until none is left
+	BacklogSegmentModeDrain = "drain"
+)
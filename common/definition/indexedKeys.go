@@ -44,6 +44,12 @@ const (
 	CustomDoubleField    = "CustomDoubleField"
 	CustomDatetimeField  = "CustomDatetimeField"
 	CadenceChangeVersion = "CadenceChangeVersion"
+
+	// search attributes maintained by history for pending activities and child workflows
+	CadencePendingActivityTypes = "CadencePendingActivityTypes"
+	CadenceMaxActivityAttempt   = "CadenceMaxActivityAttempt"
+	CadencePendingChildCount    = "CadencePendingChildCount"
+	CadenceLastFailureReason    = "CadenceLastFailureReason"
 )
 
 // valid non-indexed fields on ES
@@ -54,6 +60,25 @@ const (
 // Attr is prefix of custom search attributes
 const Attr = "Attr"
 
+// History is qualifier of history search fields in queries, e.g. History.FailureReason = 'timeout'
+const History = "History"
+
+// valid indexed fields of history events on ES history search index
+const (
+	FailureReason = "FailureReason"
+	ActivityType  = "ActivityType"
+	SignalName    = "SignalName"
+	MarkerName    = "MarkerName"
+)
+
+// lifecycle status of search attributes, search attributes without status are active
+const (
+	// SearchAttributeStatusDeprecated is the status of search attributes which can be queried but not set
+	SearchAttributeStatusDeprecated = "deprecated"
+	// SearchAttributeStatusRemoved is the status of search attributes which are removed from the registry
+	SearchAttributeStatusRemoved = "removed"
+)
+
 // defaultIndexedKeys defines all searchable keys
 var defaultIndexedKeys = createDefaultIndexedKeys()
 
@@ -71,6 +96,9 @@ func createDefaultIndexedKeys() map[string]interface{} {
 	for k, v := range systemIndexedKeys {
 		defaultIndexedKeys[k] = v
 	}
+	for k, v := range stateIndexedKeys {
+		defaultIndexedKeys[k] = v
+	}
 	return defaultIndexedKeys
 }
 
@@ -97,3 +125,31 @@ func IsSystemIndexedKey(key string) bool {
 	_, ok := systemIndexedKeys[key]
 	return ok
 }
+
+// stateIndexedKeys is the visibility keys maintained by history from the state of workflows
+var stateIndexedKeys = map[string]interface{}{
+	CadencePendingActivityTypes: shared.IndexedValueTypeKeywordList,
+	CadenceMaxActivityAttempt:   shared.IndexedValueTypeInt,
+	CadencePendingChildCount:    shared.IndexedValueTypeInt,
+	CadenceLastFailureReason:    shared.IndexedValueTypeKeyword,
+}
+
+// IsStateIndexedKey return true if key is maintained by history from the state of workflows
+func IsStateIndexedKey(key string) bool {
+	_, ok := stateIndexedKeys[key]
+	return ok
+}
+
+// historySearchKeys is the fields of history events indexed for history search
+var historySearchKeys = map[string]interface{}{
+	FailureReason: shared.IndexedValueTypeString,
+	ActivityType:  shared.IndexedValueTypeKeyword,
+	SignalName:    shared.IndexedValueTypeKeyword,
+	MarkerName:    shared.IndexedValueTypeKeyword,
+}
+
+// IsHistorySearchKey return true if key is a field of history events indexed for history search
+func IsHistorySearchKey(key string) bool {
+	_, ok := historySearchKeys[key]
+	return ok
+}
@@ -0,0 +1,158 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package definition
+
+import (
+	"reflect"
+
+	"github.com/uber/cadence/.gen/go/shared"
+)
+
+type (
+	// SearchAttributes is a search attributes registry in dynamic config: the registered keys with their value types,
+	// the lifecycle status of the keys and the aliases of the keys.
+	// The registry of a domain only has its overrides of the cluster registry, so that keys added to the cluster
+	// registry later are also available to the domain.
+	SearchAttributes struct {
+		Valid   map[string]interface{}
+		Status  map[string]interface{}
+		Aliases map[string]interface{}
+	}
+)
+
+// removedAlias is the alias override of a domain, which removes an alias of the cluster registry from the domain
+const removedAlias = ""
+
+// NewSearchAttributes returns a copy of the search attributes registry
+func NewSearchAttributes(valid, status, aliases map[string]interface{}) *SearchAttributes {
+	return &SearchAttributes{
+		Valid:   copyMap(valid),
+		Status:  copyMap(status),
+		Aliases: copyMap(aliases),
+	}
+}
+
+// Override returns the search attributes registry of the domain with the overrides.
+// Keys added by the domain are active unless the domain sets their status, the domain status of other keys
+// overrides the cluster status, and an empty alias removes the alias of the cluster from the domain.
+func (r *SearchAttributes) Override(overrides *SearchAttributes) *SearchAttributes {
+	result := NewSearchAttributes(r.Valid, r.Status, r.Aliases)
+	for k, v := range overrides.Valid {
+		result.Valid[k] = v
+		delete(result.Status, k)
+	}
+	for k, v := range overrides.Status {
+		result.Status[k] = v
+		if v == SearchAttributeStatusRemoved {
+			delete(result.Valid, k)
+		}
+	}
+	for alias, k := range overrides.Aliases {
+		if k == removedAlias {
+			delete(result.Aliases, alias)
+		} else {
+			result.Aliases[alias] = k
+		}
+	}
+	for alias, k := range result.Aliases {
+		if key, ok := k.(string); !ok || result.Valid[key] == nil {
+			delete(result.Aliases, alias)
+		}
+	}
+	return result
+}
+
+// GetOverrides returns the entries of the domain registry which differ from the cluster registry.
+// Dynamic config returns the cluster registry for domains without a registry of their own, which has no overrides.
+func (r *SearchAttributes) GetOverrides(domain *SearchAttributes) *SearchAttributes {
+	overrides := NewSearchAttributes(nil, nil, nil)
+	for k, v := range domain.Valid {
+		if clusterValue, ok := r.Valid[k]; !ok || !isSameValueType(clusterValue, v) {
+			overrides.Valid[k] = v
+		}
+	}
+	for k, v := range domain.Status {
+		if r.Status[k] != v {
+			overrides.Status[k] = v
+		}
+	}
+	for alias, k := range domain.Aliases {
+		if r.Aliases[alias] != k {
+			overrides.Aliases[alias] = k
+		}
+	}
+	return overrides
+}
+
+// RemoveAlias removes the alias from the domain registry with the overrides of the cluster registry
+func (r *SearchAttributes) RemoveAlias(overrides *SearchAttributes, alias string) {
+	if _, ok := r.Aliases[alias]; ok {
+		overrides.Aliases[alias] = removedAlias
+		return
+	}
+	delete(overrides.Aliases, alias)
+}
+
+// GetValueType returns the value type of the registered key
+func (r *SearchAttributes) GetValueType(key string) (shared.IndexedValueType, bool) {
+	return toIndexedValueType(r.Valid[key])
+}
+
+// MergeValidSearchAttributes returns the keys registered for the domain with the valid search attributes
+// of the cluster and of the domain, regardless of the lifecycle status of the keys
+func MergeValidSearchAttributes(cluster, domain map[string]interface{}) map[string]interface{} {
+	result := copyMap(cluster)
+	for k, v := range domain {
+		result[k] = v
+	}
+	return result
+}
+
+// isSameValueType compares value types, which are numbers of different types when read from dynamic config
+func isSameValueType(a, b interface{}) bool {
+	typeA, okA := toIndexedValueType(a)
+	typeB, okB := toIndexedValueType(b)
+	if okA && okB {
+		return typeA == typeB
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+func toIndexedValueType(fieldType interface{}) (shared.IndexedValueType, bool) {
+	switch t := fieldType.(type) {
+	case float64:
+		return shared.IndexedValueType(t), true
+	case int:
+		return shared.IndexedValueType(t), true
+	case shared.IndexedValueType:
+		return t, true
+	default:
+		return 0, false
+	}
+}
+
+func copyMap(m map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		result[k] = v
+	}
+	return result
+}
@@ -0,0 +1,106 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package definition
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/.gen/go/shared"
+)
+
+type (
+	searchAttributesSuite struct {
+		suite.Suite
+	}
+)
+
+func TestSearchAttributesSuite(t *testing.T) {
+	s := new(searchAttributesSuite)
+	suite.Run(t, s)
+}
+
+func (s *searchAttributesSuite) newClusterRegistry() *SearchAttributes {
+	return NewSearchAttributes(
+		map[string]interface{}{"key1": float64(1), "key2": 2, "key3": 1},
+		map[string]interface{}{"key3": SearchAttributeStatusDeprecated, "key4": SearchAttributeStatusRemoved},
+		map[string]interface{}{"alias1": "key1", "alias2": "key2"},
+	)
+}
+
+func (s *searchAttributesSuite) TestGetOverrides() {
+	cluster := s.newClusterRegistry()
+
+	// dynamic config falls back to the cluster registry for domains without a registry of their own
+	s.Equal(NewSearchAttributes(nil, nil, nil), cluster.GetOverrides(cluster))
+	s.Equal(NewSearchAttributes(nil, nil, nil), cluster.GetOverrides(NewSearchAttributes(
+		map[string]interface{}{"key1": 1, "key2": shared.IndexedValueTypeInt}, nil, nil)))
+
+	overrides := cluster.GetOverrides(NewSearchAttributes(
+		map[string]interface{}{"key1": 1, "key5": 2},
+		map[string]interface{}{"key2": SearchAttributeStatusRemoved},
+		map[string]interface{}{"alias1": removedAlias},
+	))
+	s.Equal(NewSearchAttributes(
+		map[string]interface{}{"key5": 2},
+		map[string]interface{}{"key2": SearchAttributeStatusRemoved},
+		map[string]interface{}{"alias1": removedAlias},
+	), overrides)
+}
+
+func (s *searchAttributesSuite) TestOverride() {
+	cluster := s.newClusterRegistry()
+	domain := cluster.Override(NewSearchAttributes(
+		map[string]interface{}{"key4": 1, "key5": 2},
+		map[string]interface{}{"key2": SearchAttributeStatusRemoved},
+		map[string]interface{}{"alias1": removedAlias, "alias5": "key5"},
+	))
+	s.Equal(map[string]interface{}{"key1": float64(1), "key3": 1, "key4": 1, "key5": 2}, domain.Valid)
+	s.Equal(map[string]interface{}{
+		"key2": SearchAttributeStatusRemoved,
+		"key3": SearchAttributeStatusDeprecated,
+	}, domain.Status)
+	// aliases of the removed keys are dropped
+	s.Equal(map[string]interface{}{"alias5": "key5"}, domain.Aliases)
+
+	// keys added to the cluster registry later reach the domain
+	cluster.Valid["key6"] = 1
+	s.Contains(cluster.Override(NewSearchAttributes(map[string]interface{}{"key5": 2}, nil, nil)).Valid, "key6")
+}
+
+func (s *searchAttributesSuite) TestRemoveAlias() {
+	cluster := s.newClusterRegistry()
+	overrides := NewSearchAttributes(nil, nil, map[string]interface{}{"alias5": "key1"})
+	cluster.RemoveAlias(overrides, "alias1")
+	cluster.RemoveAlias(overrides, "alias5")
+	s.Equal(map[string]interface{}{"alias1": removedAlias}, overrides.Aliases)
+	s.NotContains(cluster.Override(overrides).Aliases, "alias1")
+}
+
+func (s *searchAttributesSuite) TestGetValueType() {
+	cluster := s.newClusterRegistry()
+	valueType, ok := cluster.GetValueType("key1")
+	s.True(ok)
+	s.Equal(shared.IndexedValueTypeKeyword, valueType)
+	_, ok = cluster.GetValueType("key4")
+	s.False(ok)
+}
@@ -85,6 +85,10 @@ func (d *AttrValidatorImpl) validateDomainReplicationConfigForLocalDomain(
 		return &shared.BadRequestError{Message: "Invalid local domain clusters"}
 	}
 
+	if replicationConfig.Filter != nil {
+		return errReplicationFilterLocalDomain
+	}
+
 	return nil
 }
 
@@ -115,6 +119,14 @@ func (d *AttrValidatorImpl) validateDomainReplicationConfigForGlobalDomain(
 		return errActiveClusterNotInClusters
 	}
 
+	if replicationConfig.Filter != nil {
+		for _, workflowType := range replicationConfig.Filter.WorkflowTypes {
+			if len(workflowType) == 0 {
+				return errInvalidReplicationFilter
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -144,6 +156,24 @@ func (d *AttrValidatorImpl) validateDomainReplicationConfigClustersDoesNotChange
 	return nil
 }
 
+func (d *AttrValidatorImpl) validateDomainReplicationConfigClustersNotRemoved(
+	clustersOld []*persistence.ClusterReplicationConfig,
+	clustersNew []*persistence.ClusterReplicationConfig,
+) error {
+
+	clusterNamesNew := make(map[string]bool)
+	for _, clusterConfig := range clustersNew {
+		clusterNamesNew[clusterConfig.ClusterName] = true
+	}
+
+	for _, clusterConfig := range clustersOld {
+		if _, ok := clusterNamesNew[clusterConfig.ClusterName]; !ok {
+			return errCannotRemoveClustersFromDomain
+		}
+	}
+	return nil
+}
+
 func (d *AttrValidatorImpl) validateClusterName(
 	clusterName string,
 ) error {
@@ -25,6 +25,7 @@ import (
 
 	"github.com/uber/cadence/.gen/go/shared"
 
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cluster"
 
 	"github.com/uber/cadence/common/persistence"
@@ -207,6 +208,41 @@ func (s *attrValidatorSuite) TestValidateDomainReplicationConfigForGlobalDomain(
 	s.NoError(err)
 }
 
+func (s *attrValidatorSuite) TestValidateDomainReplicationConfig_ReplicationFilter() {
+	s.mockClusterMetadata.On("GetCurrentClusterName").Return(
+		cluster.TestCurrentClusterName,
+	)
+	s.mockClusterMetadata.On("GetAllClusterInfo").Return(
+		cluster.TestAllClusterInfo,
+	)
+
+	err := s.validator.validateDomainReplicationConfigForLocalDomain(
+		&persistence.DomainReplicationConfig{
+			ActiveClusterName: cluster.TestCurrentClusterName,
+			Clusters: []*persistence.ClusterReplicationConfig{
+				{ClusterName: cluster.TestCurrentClusterName},
+			},
+			Filter: &shared.DomainReplicationFilter{SkipVisibilityPayloads: common.BoolPtr(true)},
+		},
+	)
+	s.Equal(errReplicationFilterLocalDomain, err)
+
+	globalReplicationConfig := &persistence.DomainReplicationConfig{
+		ActiveClusterName: cluster.TestCurrentClusterName,
+		Clusters: []*persistence.ClusterReplicationConfig{
+			{ClusterName: cluster.TestCurrentClusterName},
+			{ClusterName: cluster.TestAlternativeClusterName},
+		},
+		Filter: &shared.DomainReplicationFilter{WorkflowTypes: []string{"some random workflow type", ""}},
+	}
+	err = s.validator.validateDomainReplicationConfigForGlobalDomain(globalReplicationConfig)
+	s.Equal(errInvalidReplicationFilter, err)
+
+	globalReplicationConfig.Filter.WorkflowTypes = []string{"some random workflow type"}
+	err = s.validator.validateDomainReplicationConfigForGlobalDomain(globalReplicationConfig)
+	s.NoError(err)
+}
+
 func (s *attrValidatorSuite) TestValidateDomainReplicationConfigClustersDoesNotChange() {
 	err := s.validator.validateDomainReplicationConfigClustersDoesNotChange(
 		[]*persistence.ClusterReplicationConfig{
@@ -252,3 +288,26 @@ func (s *attrValidatorSuite) TestValidateDomainReplicationConfigClustersDoesNotC
 	)
 	s.IsType(&shared.BadRequestError{}, err)
 }
+
+func (s *attrValidatorSuite) TestValidateDomainReplicationConfigClustersNotRemoved() {
+	err := s.validator.validateDomainReplicationConfigClustersNotRemoved(
+		[]*persistence.ClusterReplicationConfig{
+			{ClusterName: cluster.TestCurrentClusterName},
+		},
+		[]*persistence.ClusterReplicationConfig{
+			{ClusterName: cluster.TestCurrentClusterName},
+			{ClusterName: cluster.TestAlternativeClusterName},
+		},
+	)
+	s.NoError(err)
+
+	err = s.validator.validateDomainReplicationConfigClustersNotRemoved(
+		[]*persistence.ClusterReplicationConfig{
+			{ClusterName: cluster.TestCurrentClusterName},
+		},
+		[]*persistence.ClusterReplicationConfig{
+			{ClusterName: cluster.TestAlternativeClusterName},
+		},
+	)
+	s.Equal(errCannotRemoveClustersFromDomain, err)
+}
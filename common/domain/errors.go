@@ -31,6 +31,17 @@ var (
 	errCannotModifyClustersFromDomain  = &workflow.BadRequestError{Message: "Cannot modify existing replicated clusters from a domain."}
 	errActiveClusterNotInClusters      = &workflow.BadRequestError{Message: "Active cluster is not contained in all clusters."}
 	errCannotDoDomainFailoverAndUpdate = &workflow.BadRequestError{Message: "Cannot set active cluster to current cluster when other parameters are set."}
+	errReplicationFilterLocalDomain    = &workflow.BadRequestError{Message: "Cannot set replication filter on local domain."}
+	errInvalidReplicationFilter        = &workflow.BadRequestError{Message: "Replication filter contains an empty workflow type."}
+	errGlobalDomainNotEnabled          = &workflow.BadRequestError{Message: "Global domain is not enabled, cannot promote local domain to global domain."}
+	errCannotRemoveClustersFromDomain  = &workflow.BadRequestError{Message: "Cannot remove existing replicated clusters from a domain."}
+
+	errGracefulFailoverActiveClusterNotSet = &workflow.BadRequestError{Message: "Active cluster must be set for graceful failover."}
+	errGracefulFailoverLocalDomain         = &workflow.BadRequestError{Message: "Cannot do graceful failover on local domain."}
+	errGracefulFailoverInvalidTimeout      = &workflow.BadRequestError{Message: "Graceful failover timeout must be greater than 0."}
+	errGracefulFailoverNotFromActive       = &workflow.BadRequestError{Message: "Graceful failover must be started from the current active cluster."}
+	errGracefulFailoverSameCluster         = &workflow.BadRequestError{Message: "Cannot gracefully failover to the current active cluster."}
+	errGracefulFailoverInProgress          = &workflow.BadRequestError{Message: "Graceful failover is already in progress."}
 
 	errInvalidRetentionPeriod = &workflow.BadRequestError{Message: "A valid retention period is not set on request."}
 	errInvalidArchivalConfig  = &workflow.BadRequestError{Message: "Invalid to enable archival without specifying a uri."}
@@ -0,0 +1,273 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package domain
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	h "github.com/uber/cadence/.gen/go/history"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/client/history"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/membership"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+const (
+	failoverCoordinatorListPageSize       = 100
+	failoverCoordinatorAckLevelsTimeout   = 10 * time.Second
+	failoverCoordinatorHandoverRetryLimit = 3
+	// failoverCoordinatorKey is looked up in the membership ring to pick the host coordinating all failovers
+	failoverCoordinatorKey = "cadence-domain-failover-coordinator"
+)
+
+type (
+	// FailoverCoordinator drives graceful domain failovers started in the current cluster, only the frontend host
+	// owning failoverCoordinatorKey in the membership ring coordinates. Once every history shard has observed the
+	// draining state and its replication tasks up to its current max read level are acked by the target cluster,
+	// the coordinator hands the domain over; if the failover expires first, the domain is handed over anyway.
+	FailoverCoordinator interface {
+		common.Daemon
+	}
+
+	failoverCoordinatorImpl struct {
+		status          int32
+		handler         *HandlerImpl
+		metadataMgr     persistence.MetadataManager
+		clusterMetadata cluster.Metadata
+		historyClient   history.Client
+		numberOfShards  int
+		resolver        membership.ServiceResolver
+		hostInfo        *membership.HostInfo
+		refreshInterval dynamicconfig.DurationPropertyFn
+		metricsClient   metrics.Client
+		logger          log.Logger
+		shutdownChan    chan struct{}
+	}
+)
+
+var _ FailoverCoordinator = (*failoverCoordinatorImpl)(nil)
+
+// NewFailoverCoordinator creates a new graceful domain failover coordinator
+func NewFailoverCoordinator(
+	handler *HandlerImpl,
+	metadataMgr persistence.MetadataManager,
+	clusterMetadata cluster.Metadata,
+	historyClient history.Client,
+	numberOfShards int,
+	resolver membership.ServiceResolver,
+	hostInfo *membership.HostInfo,
+	refreshInterval dynamicconfig.DurationPropertyFn,
+	metricsClient metrics.Client,
+	logger log.Logger,
+) FailoverCoordinator {
+
+	return &failoverCoordinatorImpl{
+		status:          common.DaemonStatusInitialized,
+		handler:         handler,
+		metadataMgr:     metadataMgr,
+		clusterMetadata: clusterMetadata,
+		historyClient:   historyClient,
+		numberOfShards:  numberOfShards,
+		resolver:        resolver,
+		hostInfo:        hostInfo,
+		refreshInterval: refreshInterval,
+		metricsClient:   metricsClient,
+		logger:          logger.WithTags(tag.ComponentFailoverCoordinator),
+		shutdownChan:    make(chan struct{}),
+	}
+}
+
+// Start starts the coordinator
+func (c *failoverCoordinatorImpl) Start() {
+	if !atomic.CompareAndSwapInt32(&c.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+
+	go c.refreshLoop()
+	c.logger.Info("Domain failover coordinator started.")
+}
+
+// Stop stops the coordinator
+func (c *failoverCoordinatorImpl) Stop() {
+	if !atomic.CompareAndSwapInt32(&c.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+
+	close(c.shutdownChan)
+	c.logger.Info("Domain failover coordinator stopped.")
+}
+
+func (c *failoverCoordinatorImpl) refreshLoop() {
+	timer := time.NewTimer(c.refreshInterval())
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-c.shutdownChan:
+			return
+		case <-timer.C:
+			c.refresh()
+			timer.Reset(c.refreshInterval())
+		}
+	}
+}
+
+func (c *failoverCoordinatorImpl) refresh() {
+	if !c.isCoordinator() {
+		return
+	}
+
+	domains, err := c.listDrainingDomains()
+	if err != nil {
+		c.logger.Warn("Failed to list draining domains.", tag.Error(err))
+		return
+	}
+	for _, domain := range domains {
+		c.checkDomain(domain)
+	}
+}
+
+// listDrainingDomains returns the draining global domains active in the current cluster
+func (c *failoverCoordinatorImpl) listDrainingDomains() ([]*persistence.GetDomainResponse, error) {
+	currentCluster := c.clusterMetadata.GetCurrentClusterName()
+
+	var domains []*persistence.GetDomainResponse
+	var token []byte
+	for {
+		resp, err := c.metadataMgr.ListDomains(&persistence.ListDomainsRequest{
+			PageSize:      failoverCoordinatorListPageSize,
+			NextPageToken: token,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, domain := range resp.Domains {
+			if !domain.IsGlobalDomain ||
+				!isDomainDraining(domain.FailoverInfo) ||
+				domain.ReplicationConfig.ActiveClusterName != currentCluster {
+				continue
+			}
+			domains = append(domains, domain)
+		}
+
+		token = resp.NextPageToken
+		if len(token) == 0 {
+			return domains, nil
+		}
+	}
+}
+
+func (c *failoverCoordinatorImpl) isCoordinator() bool {
+	host, err := c.resolver.Lookup(failoverCoordinatorKey)
+	if err != nil {
+		c.logger.Warn("Failed to resolve domain failover coordinator.", tag.Error(err))
+		return false
+	}
+	return host.Identity() == c.hostInfo.Identity()
+}
+
+func (c *failoverCoordinatorImpl) checkDomain(domain *persistence.GetDomainResponse) {
+	domainID := domain.Info.ID
+	logger := c.logger.WithTags(tag.WorkflowDomainName(domain.Info.Name), tag.WorkflowDomainID(domainID))
+
+	if time.Now().UnixNano() >= domain.FailoverInfo.GetExpireTimestamp() {
+		if c.handover(domain.Info.Name, shared.DomainFailoverStateTimedOut, logger) {
+			c.metricsClient.IncCounter(metrics.FrontendDomainFailoverCoordinatorScope, metrics.GracefulFailoverTimedOut)
+		}
+		return
+	}
+
+	shardIDs := make([]int32, 0, c.numberOfShards)
+	for shardID := 0; shardID < c.numberOfShards; shardID++ {
+		shardIDs = append(shardIDs, int32(shardID))
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), failoverCoordinatorAckLevelsTimeout)
+	defer cancel()
+	resp, err := c.historyClient.GetReplicationAckLevels(ctx, &h.GetReplicationAckLevelsRequest{
+		ClusterName: common.StringPtr(domain.FailoverInfo.GetTargetCluster()),
+		ShardIDs:    shardIDs,
+	})
+	if err != nil {
+		logger.Warn("Failed to get replication ack levels.", tag.Error(err))
+		return
+	}
+
+	if !isDrained(domain.NotificationVersion, shardIDs, resp.AckLevelsByShard) {
+		return
+	}
+
+	if c.handover(domain.Info.Name, shared.DomainFailoverStateCompleted, logger) {
+		c.metricsClient.IncCounter(metrics.FrontendDomainFailoverCoordinatorScope, metrics.GracefulFailoverCompleted)
+	}
+}
+
+func (c *failoverCoordinatorImpl) handover(
+	domainName string,
+	state shared.DomainFailoverState,
+	logger log.Logger,
+) bool {
+
+	var err error
+	for attempt := 0; attempt < failoverCoordinatorHandoverRetryLimit; attempt++ {
+		if err = c.handler.handoverGracefulFailover(domainName, state); err == nil {
+			return true
+		}
+		if _, ok := err.(*persistence.ConditionFailedError); !ok {
+			break
+		}
+	}
+	logger.Error("Failed to hand over graceful domain failover.", tag.Error(err))
+	return false
+}
+
+// isDrained returns true if all shards have observed the draining state and have been acked by the target cluster
+// up to their current max read levels. Decisions and activities of the domain keep completing while it drains,
+// so an ack level is never compared with a max read level read earlier, which would miss the tasks created since.
+func isDrained(
+	domainNotificationVersion int64,
+	shardIDs []int32,
+	ackLevels map[int32]*h.ReplicationAckLevel,
+) bool {
+
+	for _, shardID := range shardIDs {
+		ackLevel, ok := ackLevels[shardID]
+		if !ok {
+			return false
+		}
+		if ackLevel.GetDomainNotificationVersion() <= domainNotificationVersion {
+			// shard has not observed the draining state yet
+			return false
+		}
+		if ackLevel.GetAckLevel() < ackLevel.GetMaxReadLevel() {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,107 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	h "github.com/uber/cadence/.gen/go/history"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/membership"
+	"github.com/uber/cadence/common/mocks"
+)
+
+type (
+	failoverCoordinatorSuite struct {
+		suite.Suite
+	}
+)
+
+func TestFailoverCoordinatorSuite(t *testing.T) {
+	s := new(failoverCoordinatorSuite)
+	suite.Run(t, s)
+}
+
+func (s *failoverCoordinatorSuite) TestIsDrained_ShardMissing() {
+	ackLevels := map[int32]*h.ReplicationAckLevel{
+		0: newReplicationAckLevel(11, 100, 100),
+	}
+	s.False(isDrained(10, []int32{0, 1}, ackLevels))
+}
+
+func (s *failoverCoordinatorSuite) TestIsDrained_DrainingNotObserved() {
+	ackLevels := map[int32]*h.ReplicationAckLevel{
+		0: newReplicationAckLevel(10, 100, 100),
+	}
+	s.False(isDrained(10, []int32{0}, ackLevels))
+}
+
+func (s *failoverCoordinatorSuite) TestIsDrained_CurrentMaxReadLevel() {
+	shardIDs := []int32{0, 1}
+
+	s.False(isDrained(10, shardIDs, map[int32]*h.ReplicationAckLevel{
+		0: newReplicationAckLevel(11, 100, 100),
+		1: newReplicationAckLevel(11, 100, 50),
+	}))
+
+	// tasks created after the first check, e.g. by completed activities, have to be acked as well
+	s.False(isDrained(10, shardIDs, map[int32]*h.ReplicationAckLevel{
+		0: newReplicationAckLevel(12, 200, 100),
+		1: newReplicationAckLevel(12, 100, 100),
+	}))
+
+	s.True(isDrained(10, shardIDs, map[int32]*h.ReplicationAckLevel{
+		0: newReplicationAckLevel(12, 200, 200),
+		1: newReplicationAckLevel(12, 100, 100),
+	}))
+}
+
+func (s *failoverCoordinatorSuite) TestRefresh_NotCoordinator() {
+	resolver := &mocks.ServiceResolver{}
+	metadataMgr := &mocks.MetadataManager{}
+	defer resolver.AssertExpectations(s.T())
+	defer metadataMgr.AssertExpectations(s.T())
+	resolver.On("Lookup", failoverCoordinatorKey).Return(membership.NewHostInfo("other-host", nil), nil).Once()
+
+	coordinator := &failoverCoordinatorImpl{
+		metadataMgr: metadataMgr,
+		resolver:    resolver,
+		hostInfo:    membership.NewHostInfo("this-host", nil),
+		logger:      loggerimpl.NewNopLogger(),
+	}
+	coordinator.refresh()
+}
+
+func newReplicationAckLevel(
+	domainNotificationVersion int64,
+	maxReadLevel int64,
+	ackLevel int64,
+) *h.ReplicationAckLevel {
+
+	return &h.ReplicationAckLevel{
+		DomainNotificationVersion: common.Int64Ptr(domainNotificationVersion),
+		MaxReadLevel:              common.Int64Ptr(maxReadLevel),
+		AckLevel:                  common.Int64Ptr(ackLevel),
+	}
+}
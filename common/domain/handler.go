@@ -211,6 +211,7 @@ func (d *HandlerImpl) RegisterDomain(
 	replicationConfig := &persistence.DomainReplicationConfig{
 		ActiveClusterName: activeClusterName,
 		Clusters:          clusters,
+		Filter:            registerRequest.ReplicationFilter,
 	}
 	isGlobalDomain := registerRequest.GetIsGlobalDomain()
 
@@ -298,6 +299,8 @@ func (d *HandlerImpl) ListDomains(
 		desc := &shared.DescribeDomainResponse{
 			IsGlobalDomain:  common.BoolPtr(domain.IsGlobalDomain),
 			FailoverVersion: common.Int64Ptr(domain.FailoverVersion),
+			FailoverInfo:    domain.FailoverInfo,
+			FailoverHistory: domain.FailoverHistory,
 		}
 		desc.DomainInfo, desc.Configuration, desc.ReplicationConfiguration = d.createResponse(ctx, domain.Info, domain.Config, domain.ReplicationConfig)
 		domains = append(domains, desc)
@@ -330,6 +333,8 @@ func (d *HandlerImpl) DescribeDomain(
 	response := &shared.DescribeDomainResponse{
 		IsGlobalDomain:  common.BoolPtr(resp.IsGlobalDomain),
 		FailoverVersion: common.Int64Ptr(resp.FailoverVersion),
+		FailoverInfo:    resp.FailoverInfo,
+		FailoverHistory: resp.FailoverHistory,
 	}
 	response.DomainInfo, response.Configuration, response.ReplicationConfiguration = d.createResponse(ctx, resp.Info, resp.Config, resp.ReplicationConfig)
 	return response, nil
@@ -362,6 +367,9 @@ func (d *HandlerImpl) UpdateDomain(
 	failoverVersion := getResponse.FailoverVersion
 	failoverNotificationVersion := getResponse.FailoverNotificationVersion
 	isGlobalDomain := getResponse.IsGlobalDomain
+	failoverInfo := getResponse.FailoverInfo
+	failoverHistory := getResponse.FailoverHistory
+	previousActiveCluster := replicationConfig.ActiveClusterName
 
 	currentHistoryArchivalState := &ArchivalState{
 		Status: config.HistoryArchivalStatus,
@@ -405,6 +413,21 @@ func (d *HandlerImpl) UpdateDomain(
 	activeClusterChanged := false
 	// whether anything other than active cluster is changed
 	configurationChanged := false
+	// whether a graceful failover is started
+	failoverInfoChanged := false
+	// whether the local domain is promoted to a global domain
+	domainPromoted := false
+
+	if updateRequest.GetPromoteToGlobalDomain() && !isGlobalDomain {
+		if !d.clusterMetadata.IsGlobalDomainEnabled() {
+			return nil, errGlobalDomainNotEnabled
+		}
+		domainPromoted = true
+		configurationChanged = true
+		isGlobalDomain = true
+		failoverVersion = d.clusterMetadata.GetNextFailoverVersion(replicationConfig.ActiveClusterName, 0)
+		failoverNotificationVersion = notificationVersion
+	}
 
 	if updateRequest.UpdatedInfo != nil {
 		updatedInfo := updateRequest.UpdatedInfo
@@ -477,7 +500,15 @@ func (d *HandlerImpl) UpdateDomain(
 				})
 			}
 
-			if err := d.domainAttrValidator.validateDomainReplicationConfigClustersDoesNotChange(
+			if domainPromoted {
+				// clusters can only be added when the domain is promoted
+				if err := d.domainAttrValidator.validateDomainReplicationConfigClustersNotRemoved(
+					replicationConfig.Clusters,
+					clustersNew,
+				); err != nil {
+					return nil, err
+				}
+			} else if err := d.domainAttrValidator.validateDomainReplicationConfigClustersDoesNotChange(
 				replicationConfig.Clusters,
 				clustersNew,
 			); err != nil {
@@ -486,12 +517,48 @@ func (d *HandlerImpl) UpdateDomain(
 			replicationConfig.Clusters = clustersNew
 		}
 
+		if updateReplicationConfig.ReplicationFilter != nil {
+			configurationChanged = true
+			replicationConfig.Filter = updateReplicationConfig.ReplicationFilter
+			if len(replicationConfig.Filter.WorkflowTypes) == 0 && !replicationConfig.Filter.GetSkipVisibilityPayloads() {
+				// an empty filter clears the existing one
+				replicationConfig.Filter = nil
+			}
+		}
+
 		if updateReplicationConfig.ActiveClusterName != nil {
 			activeClusterChanged = true
 			replicationConfig.ActiveClusterName = updateReplicationConfig.GetActiveClusterName()
 		}
 	}
 
+	if updateRequest.FailoverTimeoutInSeconds != nil {
+		if err := d.validateGracefulFailover(
+			updateRequest,
+			isGlobalDomain,
+			previousActiveCluster,
+			failoverInfo,
+		); err != nil {
+			return nil, err
+		}
+		// the active cluster is only switched at handover, once the target cluster caught up
+		now := time.Now()
+		failoverInfo = &shared.DomainFailoverInfo{
+			State:           shared.DomainFailoverStateDraining.Ptr(),
+			SourceCluster:   common.StringPtr(previousActiveCluster),
+			TargetCluster:   common.StringPtr(replicationConfig.ActiveClusterName),
+			StartTimestamp:  common.Int64Ptr(now.UnixNano()),
+			ExpireTimestamp: common.Int64Ptr(now.Add(time.Duration(updateRequest.GetFailoverTimeoutInSeconds()) * time.Second).UnixNano()),
+			Reason:          updateRequest.FailoverReason,
+		}
+		replicationConfig.ActiveClusterName = previousActiveCluster
+		activeClusterChanged = false
+		failoverInfoChanged = true
+	} else if activeClusterChanged && isDomainDraining(failoverInfo) {
+		// a forced failover aborts the ongoing graceful failover
+		failoverInfo = nil
+	}
+
 	if err := d.domainAttrValidator.validateDomainConfig(config); err != nil {
 		return nil, err
 	}
@@ -509,9 +576,9 @@ func (d *HandlerImpl) UpdateDomain(
 		}
 	}
 
-	if configurationChanged && activeClusterChanged && isGlobalDomain {
+	if configurationChanged && (activeClusterChanged || failoverInfoChanged) && isGlobalDomain {
 		return nil, errCannotDoDomainFailoverAndUpdate
-	} else if configurationChanged || activeClusterChanged {
+	} else if configurationChanged || activeClusterChanged || failoverInfoChanged {
 		if configurationChanged && isGlobalDomain && !d.clusterMetadata.IsMasterCluster() {
 			return nil, errNotMasterCluster
 		}
@@ -526,16 +593,27 @@ func (d *HandlerImpl) UpdateDomain(
 				failoverVersion,
 			)
 			failoverNotificationVersion = notificationVersion
+			failoverHistory = persistence.AppendDomainFailoverRecord(failoverHistory, &shared.DomainFailoverRecord{
+				FailoverVersion: common.Int64Ptr(failoverVersion),
+				FromCluster:     common.StringPtr(previousActiveCluster),
+				ToCluster:       common.StringPtr(replicationConfig.ActiveClusterName),
+				Timestamp:       common.Int64Ptr(time.Now().UnixNano()),
+				Graceful:        common.BoolPtr(false),
+				Reason:          updateRequest.FailoverReason,
+			})
 		}
 
 		updateReq := &persistence.UpdateDomainRequest{
 			Info:                        info,
 			Config:                      config,
 			ReplicationConfig:           replicationConfig,
+			IsGlobalDomain:              isGlobalDomain,
 			ConfigVersion:               configVersion,
 			FailoverVersion:             failoverVersion,
 			FailoverNotificationVersion: failoverNotificationVersion,
 			NotificationVersion:         notificationVersion,
+			FailoverInfo:                failoverInfo,
+			FailoverHistory:             failoverHistory,
 		}
 		err = d.metadataMgr.UpdateDomain(updateReq)
 		if err != nil {
@@ -548,7 +626,12 @@ func (d *HandlerImpl) UpdateDomain(
 	}
 
 	if isGlobalDomain {
-		err = d.domainReplicator.HandleTransmissionTask(replicator.DomainOperationUpdate,
+		domainOperation := replicator.DomainOperationUpdate
+		if domainPromoted {
+			// the domain does not exist in the other clusters yet
+			domainOperation = replicator.DomainOperationCreate
+		}
+		err = d.domainReplicator.HandleTransmissionTask(domainOperation,
 			info, config, replicationConfig, configVersion, failoverVersion, isGlobalDomain)
 		if err != nil {
 			return nil, err
@@ -558,9 +641,16 @@ func (d *HandlerImpl) UpdateDomain(
 	response := &shared.UpdateDomainResponse{
 		IsGlobalDomain:  common.BoolPtr(isGlobalDomain),
 		FailoverVersion: common.Int64Ptr(failoverVersion),
+		FailoverInfo:    failoverInfo,
 	}
 	response.DomainInfo, response.Configuration, response.ReplicationConfiguration = d.createResponse(ctx, info, config, replicationConfig)
 
+	if domainPromoted {
+		d.logger.Info("Domain promoted to global domain",
+			tag.WorkflowDomainName(info.Name),
+			tag.WorkflowDomainID(info.ID),
+		)
+	}
 	d.logger.Info("Update domain succeeded",
 		tag.WorkflowDomainName(info.Name),
 		tag.WorkflowDomainID(info.ID),
@@ -600,10 +690,13 @@ func (d *HandlerImpl) DeprecateDomain(
 		Info:                        getResponse.Info,
 		Config:                      getResponse.Config,
 		ReplicationConfig:           getResponse.ReplicationConfig,
+		IsGlobalDomain:              getResponse.IsGlobalDomain,
 		ConfigVersion:               getResponse.ConfigVersion,
 		FailoverVersion:             getResponse.FailoverVersion,
 		FailoverNotificationVersion: getResponse.FailoverNotificationVersion,
 		NotificationVersion:         notificationVersion,
+		FailoverInfo:                getResponse.FailoverInfo,
+		FailoverHistory:             getResponse.FailoverHistory,
 	}
 	err = d.metadataMgr.UpdateDomain(updateReq)
 	if err != nil {
@@ -612,6 +705,107 @@ func (d *HandlerImpl) DeprecateDomain(
 	return nil
 }
 
+// handoverGracefulFailover switches the active cluster of a draining domain to the failover target
+func (d *HandlerImpl) handoverGracefulFailover(
+	domainName string,
+	state shared.DomainFailoverState,
+) error {
+
+	// must get the metadata (notificationVersion) first
+	// this version can be regarded as the lock on the v2 domain table
+	metadata, err := d.metadataMgr.GetMetadata()
+	if err != nil {
+		return err
+	}
+	notificationVersion := metadata.NotificationVersion
+	getResponse, err := d.metadataMgr.GetDomain(&persistence.GetDomainRequest{Name: domainName})
+	if err != nil {
+		return err
+	}
+	if !isDomainDraining(getResponse.FailoverInfo) {
+		// graceful failover is already finished or aborted
+		return nil
+	}
+
+	failoverInfo := getResponse.FailoverInfo
+	replicationConfig := getResponse.ReplicationConfig
+	replicationConfig.ActiveClusterName = failoverInfo.GetTargetCluster()
+	failoverVersion := d.clusterMetadata.GetNextFailoverVersion(
+		replicationConfig.ActiveClusterName,
+		getResponse.FailoverVersion,
+	)
+	now := time.Now()
+	failoverInfo.State = state.Ptr()
+	failoverInfo.EndTimestamp = common.Int64Ptr(now.UnixNano())
+	failoverHistory := persistence.AppendDomainFailoverRecord(getResponse.FailoverHistory, &shared.DomainFailoverRecord{
+		FailoverVersion: common.Int64Ptr(failoverVersion),
+		FromCluster:     common.StringPtr(failoverInfo.GetSourceCluster()),
+		ToCluster:       common.StringPtr(failoverInfo.GetTargetCluster()),
+		Timestamp:       common.Int64Ptr(now.UnixNano()),
+		Graceful:        common.BoolPtr(true),
+		Reason:          failoverInfo.Reason,
+	})
+
+	updateReq := &persistence.UpdateDomainRequest{
+		Info:                        getResponse.Info,
+		Config:                      getResponse.Config,
+		ReplicationConfig:           replicationConfig,
+		IsGlobalDomain:              getResponse.IsGlobalDomain,
+		ConfigVersion:               getResponse.ConfigVersion,
+		FailoverVersion:             failoverVersion,
+		FailoverNotificationVersion: notificationVersion,
+		NotificationVersion:         notificationVersion,
+		FailoverInfo:                failoverInfo,
+		FailoverHistory:             failoverHistory,
+	}
+	if err := d.metadataMgr.UpdateDomain(updateReq); err != nil {
+		return err
+	}
+
+	if getResponse.IsGlobalDomain {
+		if err := d.domainReplicator.HandleTransmissionTask(replicator.DomainOperationUpdate,
+			getResponse.Info, getResponse.Config, replicationConfig, getResponse.ConfigVersion, failoverVersion, getResponse.IsGlobalDomain); err != nil {
+			return err
+		}
+	}
+
+	d.logger.Info("Graceful domain failover handed over",
+		tag.WorkflowDomainName(getResponse.Info.Name),
+		tag.WorkflowDomainID(getResponse.Info.ID),
+		tag.ClusterName(replicationConfig.ActiveClusterName),
+		tag.Value(state.String()),
+	)
+	return nil
+}
+
+func (d *HandlerImpl) validateGracefulFailover(
+	updateRequest *shared.UpdateDomainRequest,
+	isGlobalDomain bool,
+	previousActiveCluster string,
+	failoverInfo *shared.DomainFailoverInfo,
+) error {
+
+	if updateRequest.ReplicationConfiguration == nil || updateRequest.ReplicationConfiguration.ActiveClusterName == nil {
+		return errGracefulFailoverActiveClusterNotSet
+	}
+	if !isGlobalDomain {
+		return errGracefulFailoverLocalDomain
+	}
+	if updateRequest.GetFailoverTimeoutInSeconds() <= 0 {
+		return errGracefulFailoverInvalidTimeout
+	}
+	if previousActiveCluster != d.clusterMetadata.GetCurrentClusterName() {
+		return errGracefulFailoverNotFromActive
+	}
+	if updateRequest.ReplicationConfiguration.GetActiveClusterName() == previousActiveCluster {
+		return errGracefulFailoverSameCluster
+	}
+	if isDomainDraining(failoverInfo) {
+		return errGracefulFailoverInProgress
+	}
+	return nil
+}
+
 func (d *HandlerImpl) createResponse(
 	ctx context.Context,
 	info *persistence.DomainInfo,
@@ -648,6 +842,7 @@ func (d *HandlerImpl) createResponse(
 	replicationConfigResult := &shared.DomainReplicationConfiguration{
 		ActiveClusterName: common.StringPtr(replicationConfig.ActiveClusterName),
 		Clusters:          clusters,
+		ReplicationFilter: replicationConfig.Filter,
 	}
 
 	return infoResult, configResult, replicationConfigResult
@@ -766,3 +961,9 @@ func getDomainStatus(info *persistence.DomainInfo) *shared.DomainStatus {
 
 	return nil
 }
+
+func isDomainDraining(failoverInfo *shared.DomainFailoverInfo) bool {
+	return failoverInfo != nil &&
+		failoverInfo.State != nil &&
+		failoverInfo.GetState() == shared.DomainFailoverStateDraining
+}
@@ -849,6 +849,72 @@ func (s *domainHandlerGlobalDomainEnabledMasterClusterSuite) TestUpdateGetDomain
 	)
 }
 
+func (s *domainHandlerGlobalDomainEnabledMasterClusterSuite) TestUpdateGetDomain_GlobalDomain_GracefulFailover() {
+	domainName := s.getRandomDomainName()
+	retention := int32(7)
+	prevActiveClusterName := s.ClusterMetadata.GetCurrentClusterName()
+	nextActiveClusterName := ""
+	clusters := []*shared.ClusterReplicationConfiguration{}
+	for clusterName := range s.ClusterMetadata.GetAllClusterInfo() {
+		if clusterName != prevActiveClusterName {
+			nextActiveClusterName = clusterName
+		}
+		clusters = append(clusters, &shared.ClusterReplicationConfiguration{
+			ClusterName: common.StringPtr(clusterName),
+		})
+	}
+	s.True(len(nextActiveClusterName) > 0)
+
+	s.mockProducer.On("Publish", mock.Anything).Return(nil).Times(3)
+
+	err := s.handler.RegisterDomain(context.Background(), &shared.RegisterDomainRequest{
+		Name:                                   common.StringPtr(domainName),
+		WorkflowExecutionRetentionPeriodInDays: common.Int32Ptr(retention),
+		Clusters:                               clusters,
+		ActiveClusterName:                      common.StringPtr(prevActiveClusterName),
+		IsGlobalDomain:                         common.BoolPtr(true),
+	})
+	s.Nil(err)
+
+	updateResp, err := s.handler.UpdateDomain(context.Background(), &shared.UpdateDomainRequest{
+		Name: common.StringPtr(domainName),
+		ReplicationConfiguration: &shared.DomainReplicationConfiguration{
+			ActiveClusterName: common.StringPtr(nextActiveClusterName),
+		},
+		FailoverTimeoutInSeconds: common.Int32Ptr(60),
+	})
+	s.Nil(err)
+	s.Equal(prevActiveClusterName, updateResp.ReplicationConfiguration.GetActiveClusterName())
+	s.Equal(s.ClusterMetadata.GetNextFailoverVersion(prevActiveClusterName, 0), updateResp.GetFailoverVersion())
+	s.Equal(shared.DomainFailoverStateDraining, updateResp.FailoverInfo.GetState())
+	s.Equal(prevActiveClusterName, updateResp.FailoverInfo.GetSourceCluster())
+	s.Equal(nextActiveClusterName, updateResp.FailoverInfo.GetTargetCluster())
+
+	_, err = s.handler.UpdateDomain(context.Background(), &shared.UpdateDomainRequest{
+		Name: common.StringPtr(domainName),
+		ReplicationConfiguration: &shared.DomainReplicationConfiguration{
+			ActiveClusterName: common.StringPtr(nextActiveClusterName),
+		},
+		FailoverTimeoutInSeconds: common.Int32Ptr(60),
+	})
+	s.Equal(errGracefulFailoverInProgress, err)
+
+	err = s.handler.handoverGracefulFailover(domainName, shared.DomainFailoverStateCompleted)
+	s.Nil(err)
+
+	getResp, err := s.handler.DescribeDomain(context.Background(), &shared.DescribeDomainRequest{
+		Name: common.StringPtr(domainName),
+	})
+	s.Nil(err)
+	s.Equal(nextActiveClusterName, getResp.ReplicationConfiguration.GetActiveClusterName())
+	s.Equal(s.ClusterMetadata.GetNextFailoverVersion(
+		nextActiveClusterName,
+		s.ClusterMetadata.GetNextFailoverVersion(prevActiveClusterName, 0),
+	), getResp.GetFailoverVersion())
+	s.Equal(shared.DomainFailoverStateCompleted, getResp.FailoverInfo.GetState())
+	s.NotNil(getResp.FailoverInfo.EndTimestamp)
+}
+
 func (s *domainHandlerGlobalDomainEnabledMasterClusterSuite) getRandomDomainName() string {
 	return "domain" + uuid.New()
 }
@@ -0,0 +1,69 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package domain
+
+import (
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+)
+
+// StampEmptyEventVersions sets the version of the events carrying the empty version, which are the events
+// written before the domain is promoted from a local domain to a global domain, to the version recorded
+// for them in the version history, so remote clusters attribute them to the cluster owning the local domain
+func StampEmptyEventVersions(
+	blob *shared.DataBlob,
+	versionHistory *persistence.VersionHistory,
+	serializer persistence.PayloadSerializer,
+) (*shared.DataBlob, error) {
+
+	if blob == nil {
+		return nil, nil
+	}
+
+	dataBlob := persistence.NewDataBlobFromThrift(blob)
+	events, err := serializer.DeserializeBatchEvents(dataBlob)
+	if err != nil {
+		return nil, err
+	}
+
+	stamped := false
+	for _, event := range events {
+		if event.GetVersion() != common.EmptyVersion {
+			continue
+		}
+		version, err := versionHistory.GetEventVersion(event.GetEventId())
+		if err != nil {
+			return nil, err
+		}
+		event.Version = common.Int64Ptr(version)
+		stamped = true
+	}
+	if !stamped {
+		return blob, nil
+	}
+
+	dataBlob, err = serializer.SerializeBatchEvents(events, dataBlob.GetEncoding())
+	if err != nil {
+		return nil, err
+	}
+	return dataBlob.ToThrift(), nil
+}
@@ -0,0 +1,68 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+)
+
+func TestStampEmptyEventVersions(t *testing.T) {
+	serializer := persistence.NewPayloadSerializer()
+	versionHistory := persistence.NewVersionHistory([]byte("some random branch token"), []*persistence.VersionHistoryItem{
+		persistence.NewVersionHistoryItem(2, 1),
+		persistence.NewVersionHistoryItem(4, 11),
+	})
+	newBlob := func(versions ...int64) *shared.DataBlob {
+		var events []*shared.HistoryEvent
+		for i, version := range versions {
+			events = append(events, &shared.HistoryEvent{
+				EventId: common.Int64Ptr(int64(i + 1)),
+				Version: common.Int64Ptr(version),
+			})
+		}
+		blob, err := serializer.SerializeBatchEvents(events, common.EncodingTypeThriftRW)
+		require.NoError(t, err)
+		return blob.ToThrift()
+	}
+
+	blob := newBlob(1, 1, 11)
+	stampedBlob, err := StampEmptyEventVersions(blob, versionHistory, serializer)
+	require.NoError(t, err)
+	require.Equal(t, blob, stampedBlob)
+
+	stampedBlob, err = StampEmptyEventVersions(newBlob(common.EmptyVersion, common.EmptyVersion, 11), versionHistory, serializer)
+	require.NoError(t, err)
+	events, err := serializer.DeserializeBatchEvents(persistence.NewDataBlobFromThrift(stampedBlob))
+	require.NoError(t, err)
+	require.Len(t, events, 3)
+	require.Equal(t, int64(1), events[0].GetVersion())
+	require.Equal(t, int64(1), events[1].GetVersion())
+	require.Equal(t, int64(11), events[2].GetVersion())
+
+	_, err = StampEmptyEventVersions(newBlob(1, 1, 11, 11, common.EmptyVersion), versionHistory, serializer)
+	require.Error(t, err)
+}
@@ -0,0 +1,149 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package domain
+
+import (
+	"github.com/uber/cadence/.gen/go/replicator"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/persistence"
+)
+
+type (
+	// ReplicationFilter applies the domain replication filter to the replicated workflows and history events
+	ReplicationFilter struct {
+		filter     *shared.DomainReplicationFilter
+		serializer persistence.PayloadSerializer
+	}
+)
+
+// NewReplicationFilter creates a new replication filter of a domain
+func NewReplicationFilter(
+	filter *shared.DomainReplicationFilter,
+	serializer persistence.PayloadSerializer,
+) *ReplicationFilter {
+
+	return &ReplicationFilter{
+		filter:     filter,
+		serializer: serializer,
+	}
+}
+
+// ShouldReplicate returns whether the workflows of the given type are replicated to other clusters
+func (f *ReplicationFilter) ShouldReplicate(
+	workflowType string,
+) bool {
+
+	if f.filter == nil || len(f.filter.WorkflowTypes) == 0 {
+		return true
+	}
+	for _, allowed := range f.filter.WorkflowTypes {
+		if allowed == workflowType {
+			return true
+		}
+	}
+	return false
+}
+
+// Apply removes from the replication task the payloads excluded by the filter
+func (f *ReplicationFilter) Apply(
+	task *replicator.ReplicationTask,
+) error {
+
+	if task == nil || !f.filter.GetSkipVisibilityPayloads() {
+		return nil
+	}
+
+	if attr := task.HistoryTaskAttributes; attr != nil {
+		removeVisibilityPayloads(attr.History.GetEvents())
+		removeVisibilityPayloads(attr.NewRunHistory.GetEvents())
+	}
+
+	if attr := task.HistoryTaskV2Attributes; attr != nil {
+		var err error
+		if attr.Events, err = f.ApplyToBlob(attr.Events); err != nil {
+			return err
+		}
+		if attr.NewRunEvents, err = f.ApplyToBlob(attr.NewRunEvents); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ApplyToBlob removes from the serialized history events the payloads excluded by the filter
+func (f *ReplicationFilter) ApplyToBlob(
+	blob *shared.DataBlob,
+) (*shared.DataBlob, error) {
+
+	if blob == nil || !f.filter.GetSkipVisibilityPayloads() {
+		return nil, nil
+	}
+
+	dataBlob := persistence.NewDataBlobFromThrift(blob)
+	events, err := f.serializer.DeserializeBatchEvents(dataBlob)
+	if err != nil {
+		return nil, err
+	}
+	if !removeVisibilityPayloads(events) {
+		return blob, nil
+	}
+
+	dataBlob, err = f.serializer.SerializeBatchEvents(events, dataBlob.GetEncoding())
+	if err != nil {
+		return nil, err
+	}
+	return dataBlob.ToThrift(), nil
+}
+
+// removeVisibilityPayloads clears the memo and search attributes of the events,
+// returns whether any event is changed
+func removeVisibilityPayloads(
+	events []*shared.HistoryEvent,
+) bool {
+
+	changed := false
+	for _, event := range events {
+		switch event.GetEventType() {
+		case shared.EventTypeWorkflowExecutionStarted:
+			attr := event.WorkflowExecutionStartedEventAttributes
+			changed = changed || attr.Memo != nil || attr.SearchAttributes != nil
+			attr.Memo = nil
+			attr.SearchAttributes = nil
+		case shared.EventTypeWorkflowExecutionContinuedAsNew:
+			attr := event.WorkflowExecutionContinuedAsNewEventAttributes
+			changed = changed || attr.Memo != nil || attr.SearchAttributes != nil
+			attr.Memo = nil
+			attr.SearchAttributes = nil
+		case shared.EventTypeStartChildWorkflowExecutionInitiated:
+			attr := event.StartChildWorkflowExecutionInitiatedEventAttributes
+			changed = changed || attr.Memo != nil || attr.SearchAttributes != nil
+			attr.Memo = nil
+			attr.SearchAttributes = nil
+		case shared.EventTypeUpsertWorkflowSearchAttributes:
+			attr := event.UpsertWorkflowSearchAttributesEventAttributes
+			changed = true
+			// the event is kept so event IDs stay the same across clusters
+			attr.SearchAttributes = &shared.SearchAttributes{}
+			attr.RemoveSearchAttributes = nil
+		}
+	}
+	return changed
+}
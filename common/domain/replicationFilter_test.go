@@ -0,0 +1,111 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	r "github.com/uber/cadence/.gen/go/replicator"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+)
+
+func TestReplicationFilter_ShouldReplicate(t *testing.T) {
+	workflowType := "some random workflow type"
+	serializer := persistence.NewPayloadSerializer()
+	require.True(t, NewReplicationFilter(nil, serializer).ShouldReplicate(workflowType))
+	require.True(t, NewReplicationFilter(&shared.DomainReplicationFilter{}, serializer).ShouldReplicate(workflowType))
+
+	filter := NewReplicationFilter(&shared.DomainReplicationFilter{
+		WorkflowTypes: []string{"some random workflow type"},
+	}, serializer)
+	require.True(t, filter.ShouldReplicate(workflowType))
+
+	filter = NewReplicationFilter(&shared.DomainReplicationFilter{
+		WorkflowTypes: []string{"other workflow type"},
+	}, serializer)
+	require.False(t, filter.ShouldReplicate(workflowType))
+}
+
+func TestReplicationFilter_SkipVisibilityPayloads(t *testing.T) {
+	serializer := persistence.NewPayloadSerializer()
+	newEvents := func() []*shared.HistoryEvent {
+		return []*shared.HistoryEvent{
+			{
+				EventId:   common.Int64Ptr(1),
+				EventType: shared.EventTypeWorkflowExecutionStarted.Ptr(),
+				WorkflowExecutionStartedEventAttributes: &shared.WorkflowExecutionStartedEventAttributes{
+					Memo: &shared.Memo{Fields: map[string][]byte{"key": []byte("value")}},
+					SearchAttributes: &shared.SearchAttributes{
+						IndexedFields: map[string][]byte{"CustomKeywordField": []byte(`"value"`)},
+					},
+				},
+			},
+			{
+				EventId:   common.Int64Ptr(2),
+				EventType: shared.EventTypeUpsertWorkflowSearchAttributes.Ptr(),
+				UpsertWorkflowSearchAttributesEventAttributes: &shared.UpsertWorkflowSearchAttributesEventAttributes{
+					SearchAttributes: &shared.SearchAttributes{
+						IndexedFields: map[string][]byte{"CustomKeywordField": []byte(`"value"`)},
+					},
+					RemoveSearchAttributes: []string{"CustomIntField"},
+				},
+			},
+		}
+	}
+	blob, err := serializer.SerializeBatchEvents(newEvents(), common.EncodingTypeThriftRW)
+	require.NoError(t, err)
+	task := &r.ReplicationTask{
+		TaskType: r.ReplicationTaskTypeHistoryV2.Ptr(),
+		HistoryTaskV2Attributes: &r.HistoryTaskV2Attributes{
+			Events: blob.ToThrift(),
+		},
+	}
+
+	// nothing is removed without skipVisibilityPayloads
+	require.NoError(t, NewReplicationFilter(&shared.DomainReplicationFilter{}, serializer).Apply(task))
+	require.Equal(t, blob.ToThrift(), task.HistoryTaskV2Attributes.Events)
+
+	filter := NewReplicationFilter(&shared.DomainReplicationFilter{
+		SkipVisibilityPayloads: common.BoolPtr(true),
+	}, serializer)
+	require.NoError(t, filter.Apply(task))
+	events, err := serializer.DeserializeBatchEvents(persistence.NewDataBlobFromThrift(task.HistoryTaskV2Attributes.Events))
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	require.Nil(t, events[0].WorkflowExecutionStartedEventAttributes.Memo)
+	require.Nil(t, events[0].WorkflowExecutionStartedEventAttributes.SearchAttributes)
+	require.Empty(t, events[1].UpsertWorkflowSearchAttributesEventAttributes.SearchAttributes.IndexedFields)
+	require.Empty(t, events[1].UpsertWorkflowSearchAttributesEventAttributes.RemoveSearchAttributes)
+
+	legacyTask := &r.ReplicationTask{
+		TaskType: r.ReplicationTaskTypeHistory.Ptr(),
+		HistoryTaskAttributes: &r.HistoryTaskAttributes{
+			History: &shared.History{Events: newEvents()},
+		},
+	}
+	require.NoError(t, filter.Apply(legacyTask))
+	require.Nil(t, legacyTask.HistoryTaskAttributes.History.Events[0].WorkflowExecutionStartedEventAttributes.Memo)
+	require.Empty(t, legacyTask.HistoryTaskAttributes.History.Events[1].UpsertWorkflowSearchAttributesEventAttributes.SearchAttributes.IndexedFields)
+}
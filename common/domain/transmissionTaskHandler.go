@@ -99,6 +99,7 @@ func (domainReplicator *domainReplicatorImpl) HandleTransmissionTask(domainOpera
 		ReplicationConfig: &shared.DomainReplicationConfiguration{
 			ActiveClusterName: common.StringPtr(replicationConfig.ActiveClusterName),
 			Clusters:          domainReplicator.convertClusterReplicationConfigToThrift(replicationConfig.Clusters),
+			ReplicationFilter: replicationConfig.Filter,
 		},
 		ConfigVersion:   common.Int64Ptr(configVersion),
 		FailoverVersion: common.Int64Ptr(failoverVersion),
@@ -22,6 +22,7 @@ package elasticsearch
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/olivere/elastic"
@@ -31,13 +32,15 @@ type (
 	// Client is a wrapper around ElasticSearch client library.
 	// It simplifies the interface and enables mocking. We intentionally let implementation details of the elastic library
 	// bleed through, as the main purpose is testability not abstraction.
+	// Types of the v6 elastic library are used for all versions, implementations for other versions convert
+	// requests and responses from and to those types.
 	Client interface {
 		Search(ctx context.Context, p *SearchParameters) (*elastic.SearchResult, error)
 		SearchWithDSL(ctx context.Context, index, query string) (*elastic.SearchResult, error)
 		Scroll(ctx context.Context, scrollID string) (*elastic.SearchResult, ScrollService, error)
 		ScrollFirstPage(ctx context.Context, index, query string) (*elastic.SearchResult, ScrollService, error)
 		Count(ctx context.Context, index, query string) (int64, error)
-		RunBulkProcessor(ctx context.Context, p *BulkProcessorParameters) (*elastic.BulkProcessor, error)
+		RunBulkProcessor(ctx context.Context, p *BulkProcessorParameters) (BulkProcessor, error)
 		PutMapping(ctx context.Context, index, root, key, valueType string) error
 		CreateIndex(ctx context.Context, index string) error
 	}
@@ -47,6 +50,16 @@ type (
 		Clear(ctx context.Context) error
 	}
 
+	// BulkProcessor is interface for elastic.BulkProcessor
+	// (elastic package doesn't provide such interface that tests can mock)
+	BulkProcessor interface {
+		Start(ctx context.Context) error
+		Stop() error
+		Close() error
+		Add(request elastic.BulkableRequest)
+		Flush() error
+	}
+
 	// SearchParameters holds all required and optional parameters for executing a search
 	SearchParameters struct {
 		Index       string
@@ -68,101 +81,18 @@ type (
 		BeforeFunc    elastic.BulkBeforeFunc
 		AfterFunc     elastic.BulkAfterFunc
 	}
-
-	// elasticWrapper implements Client
-	elasticWrapper struct {
-		client *elastic.Client
-	}
-
-	scrollServiceImpl struct {
-		scrollService *elastic.ScrollService
-	}
 )
 
-var _ Client = (*elasticWrapper)(nil)
-
-// NewClient create a ES client
+// NewClient create a ES client for the ElasticSearch version in config
 func NewClient(config *Config) (Client, error) {
-	client, err := elastic.NewClient(
-		elastic.SetURL(config.URL.String()),
-		elastic.SetRetrier(elastic.NewBackoffRetrier(elastic.NewExponentialBackoff(128*time.Millisecond, 513*time.Millisecond))),
-		elastic.SetDecoder(&elastic.NumberDecoder{}), // critical to ensure decode of int64 won't lose precise
-	)
-	if err != nil {
-		return nil, err
+	switch config.GetVersion() {
+	case ESVersion6:
+		return newClientV6(config)
+	case ESVersion7:
+		return newClientV7(config)
+	default:
+		return nil, fmt.Errorf("unsupported ElasticSearch version: %v", config.Version)
 	}
-	return NewWrapperClient(client), nil
-}
-
-// NewWrapperClient returns a new implementation of Client
-func NewWrapperClient(esClient *elastic.Client) Client {
-	return &elasticWrapper{client: esClient}
-}
-
-func (c *elasticWrapper) Search(ctx context.Context, p *SearchParameters) (*elastic.SearchResult, error) {
-	searchService := c.client.Search(p.Index).
-		Query(p.Query).
-		From(p.From).
-		SortBy(p.Sorter...)
-
-	if p.PageSize != 0 {
-		searchService.Size(p.PageSize)
-	}
-
-	if len(p.SearchAfter) != 0 {
-		searchService.SearchAfter(p.SearchAfter...)
-	}
-
-	return searchService.Do(ctx)
-}
-
-func (c *elasticWrapper) SearchWithDSL(ctx context.Context, index, query string) (*elastic.SearchResult, error) {
-	return c.client.Search(index).Source(query).Do(ctx)
-}
-
-func (c *elasticWrapper) Scroll(ctx context.Context, scrollID string) (
-	*elastic.SearchResult, ScrollService, error) {
-
-	scrollService := elastic.NewScrollService(c.client)
-	result, err := scrollService.ScrollId(scrollID).Do(ctx)
-	return result, &scrollServiceImpl{scrollService}, err
-}
-
-func (c *elasticWrapper) ScrollFirstPage(ctx context.Context, index, query string) (
-	*elastic.SearchResult, ScrollService, error) {
-
-	scrollService := elastic.NewScrollService(c.client)
-	result, err := scrollService.Index(index).Body(query).Do(ctx)
-	return result, &scrollServiceImpl{scrollService}, err
-}
-
-func (c *elasticWrapper) Count(ctx context.Context, index, query string) (int64, error) {
-	return c.client.Count(index).BodyString(query).Do(ctx)
-}
-
-func (c *elasticWrapper) RunBulkProcessor(ctx context.Context, p *BulkProcessorParameters) (*elastic.BulkProcessor, error) {
-	return c.client.BulkProcessor().
-		Name(p.Name).
-		Workers(p.NumOfWorkers).
-		BulkActions(p.BulkActions).
-		BulkSize(p.BulkSize).
-		FlushInterval(p.FlushInterval).
-		Backoff(p.Backoff).
-		Before(p.BeforeFunc).
-		After(p.AfterFunc).
-		Do(ctx)
-}
-
-// root is for nested object like Attr property for search attributes.
-func (c *elasticWrapper) PutMapping(ctx context.Context, index, root, key, valueType string) error {
-	body := buildPutMappingBody(root, key, valueType)
-	_, err := c.client.PutMapping().Index(index).Type("_doc").BodyJson(body).Do(ctx)
-	return err
-}
-
-func (c *elasticWrapper) CreateIndex(ctx context.Context, index string) error {
-	_, err := c.client.CreateIndex(index).Do(ctx)
-	return err
 }
 
 func buildPutMappingBody(root, key, valueType string) map[string]interface{} {
@@ -186,7 +116,3 @@ func buildPutMappingBody(root, key, valueType string) map[string]interface{} {
 	}
 	return body
 }
-
-func (s *scrollServiceImpl) Clear(ctx context.Context) error {
-	return s.scrollService.Clear(ctx)
-}
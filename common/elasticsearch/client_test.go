@@ -48,3 +48,8 @@ func Test_BuildPutMappingBody(t *testing.T) {
 		require.Equal(t, test.expected, fmt.Sprintf("%v", buildPutMappingBody(test.root, k, v)))
 	}
 }
+
+func Test_NewClient_UnsupportedVersion(t *testing.T) {
+	_, err := NewClient(&Config{Version: "v5"})
+	require.Error(t, err)
+}
@@ -0,0 +1,303 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package elasticsearch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/url"
+	"time"
+
+	"github.com/olivere/elastic"
+	"github.com/valyala/fastjson"
+)
+
+type (
+	// elasticWrapperV7 implements Client for ElasticSearch v7 with the v6 elastic library.
+	// ElasticSearch v7 removed mapping types and returns the total hits of a search as an object,
+	// so searches and scrolls are performed directly and mapping types are removed from requests.
+	elasticWrapperV7 struct {
+		client *elastic.Client
+	}
+
+	scrollServiceImplV7 struct {
+		client   *elastic.Client
+		scrollID string
+	}
+
+	// bulkableRequestV7 wraps a v6 bulkable request to remove the mapping type from its action line
+	bulkableRequestV7 struct {
+		request elastic.BulkableRequest
+	}
+
+	bulkProcessorV7 struct {
+		processor *elastic.BulkProcessor
+	}
+)
+
+const (
+	dslFieldTrackTotalHits = "track_total_hits"
+
+	// paramRestTotalHitsAsInt makes ElasticSearch v7 return the total hits as a number, as v6 does
+	paramRestTotalHitsAsInt = "rest_total_hits_as_int"
+	paramScroll             = "scroll"
+)
+
+var _ Client = (*elasticWrapperV7)(nil)
+
+func newClientV7(config *Config) (Client, error) {
+	client, err := elastic.NewClient(
+		elastic.SetURL(config.URL.String()),
+		elastic.SetRetrier(elastic.NewBackoffRetrier(elastic.NewExponentialBackoff(128*time.Millisecond, 513*time.Millisecond))),
+		elastic.SetDecoder(&elastic.NumberDecoder{}), // critical to ensure decode of int64 won't lose precise
+	)
+	if err != nil {
+		return nil, err
+	}
+	return NewWrapperClientV7(client), nil
+}
+
+// NewWrapperClientV7 returns a new implementation of Client for ElasticSearch v7
+func NewWrapperClientV7(esClient *elastic.Client) Client {
+	return &elasticWrapperV7{client: esClient}
+}
+
+func (c *elasticWrapperV7) Search(ctx context.Context, p *SearchParameters) (*elastic.SearchResult, error) {
+	searchSource := elastic.NewSearchSource().
+		Query(p.Query).
+		From(p.From).
+		SortBy(p.Sorter...)
+
+	if p.PageSize != 0 {
+		searchSource.Size(p.PageSize)
+	}
+
+	if len(p.SearchAfter) != 0 {
+		searchSource.SearchAfter(p.SearchAfter...)
+	}
+
+	source, err := searchSource.Source()
+	if err != nil {
+		return nil, err
+	}
+	query, err := json.Marshal(source)
+	if err != nil {
+		return nil, err
+	}
+	return c.SearchWithDSL(ctx, p.Index, string(query))
+}
+
+func (c *elasticWrapperV7) SearchWithDSL(ctx context.Context, index, query string) (*elastic.SearchResult, error) {
+	dsl, err := fastjson.Parse(query)
+	if err != nil {
+		return nil, err
+	}
+	// ES v7 only counts hits accurately up to 10000 by default
+	dsl.Set(dslFieldTrackTotalHits, fastjson.MustParse("true"))
+
+	return c.search(ctx, "/"+url.PathEscape(index)+"/_search", url.Values{}, dsl.String())
+}
+
+func (c *elasticWrapperV7) Scroll(ctx context.Context, scrollID string) (
+	*elastic.SearchResult, ScrollService, error) {
+
+	body := struct {
+		Scroll   string `json:"scroll"`
+		ScrollID string `json:"scroll_id"`
+	}{
+		Scroll:   elastic.DefaultScrollKeepAlive,
+		ScrollID: scrollID,
+	}
+	result, err := c.search(ctx, "/_search/scroll", url.Values{}, body)
+	return c.scrollResult(result, err, scrollID)
+}
+
+func (c *elasticWrapperV7) ScrollFirstPage(ctx context.Context, index, query string) (
+	*elastic.SearchResult, ScrollService, error) {
+
+	params := url.Values{}
+	params.Set(paramScroll, elastic.DefaultScrollKeepAlive)
+	result, err := c.search(ctx, "/"+url.PathEscape(index)+"/_search", params, query)
+	return c.scrollResult(result, err, "")
+}
+
+func (c *elasticWrapperV7) Count(ctx context.Context, index, query string) (int64, error) {
+	return c.client.Count(index).BodyString(query).Do(ctx)
+}
+
+func (c *elasticWrapperV7) RunBulkProcessor(ctx context.Context, p *BulkProcessorParameters) (BulkProcessor, error) {
+	service := c.client.BulkProcessor().
+		Name(p.Name).
+		Workers(p.NumOfWorkers).
+		BulkActions(p.BulkActions).
+		BulkSize(p.BulkSize).
+		FlushInterval(p.FlushInterval).
+		Backoff(p.Backoff)
+
+	if p.BeforeFunc != nil {
+		service.Before(func(executionID int64, requests []elastic.BulkableRequest) {
+			p.BeforeFunc(executionID, unwrapBulkableRequestsV7(requests))
+		})
+	}
+	if p.AfterFunc != nil {
+		service.After(func(executionID int64, requests []elastic.BulkableRequest, response *elastic.BulkResponse, err error) {
+			p.AfterFunc(executionID, unwrapBulkableRequestsV7(requests), response, err)
+		})
+	}
+
+	processor, err := service.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &bulkProcessorV7{processor: processor}, nil
+}
+
+// root is for nested object like Attr property for search attributes.
+func (c *elasticWrapperV7) PutMapping(ctx context.Context, index, root, key, valueType string) error {
+	body := buildPutMappingBody(root, key, valueType)
+	_, err := c.client.PerformRequest(ctx, elastic.PerformRequestOptions{
+		Method: "PUT",
+		Path:   "/" + url.PathEscape(index) + "/_mapping",
+		Body:   body,
+	})
+	return err
+}
+
+func (c *elasticWrapperV7) CreateIndex(ctx context.Context, index string) error {
+	_, err := c.client.CreateIndex(index).Do(ctx)
+	return err
+}
+
+// search performs a search or scroll request and decodes its response into the v6 search result
+func (c *elasticWrapperV7) search(ctx context.Context, path string, params url.Values, body interface{}) (*elastic.SearchResult, error) {
+	params.Set(paramRestTotalHitsAsInt, "true")
+	response, err := c.client.PerformRequest(ctx, elastic.PerformRequestOptions{
+		Method: "POST",
+		Path:   path,
+		Params: params,
+		Body:   body,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return decodeSearchResultV7(response.Body)
+}
+
+// scrollResult returns io.EOF when there are no more hits, the same as the scroll service of the elastic library
+func (c *elasticWrapperV7) scrollResult(result *elastic.SearchResult, err error, scrollID string) (
+	*elastic.SearchResult, ScrollService, error) {
+
+	if result != nil && len(result.ScrollId) != 0 {
+		scrollID = result.ScrollId
+	}
+	scrollService := &scrollServiceImplV7{client: c.client, scrollID: scrollID}
+	if err != nil {
+		return nil, scrollService, err
+	}
+	if result.Hits == nil || len(result.Hits.Hits) == 0 {
+		return result, scrollService, io.EOF
+	}
+	return result, scrollService, nil
+}
+
+func decodeSearchResultV7(body []byte) (*elastic.SearchResult, error) {
+	result := &elastic.SearchResult{}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber() // critical to ensure decode of int64 won't lose precise
+	if err := decoder.Decode(result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (s *scrollServiceImplV7) Clear(ctx context.Context) error {
+	if len(s.scrollID) == 0 {
+		return nil
+	}
+	_, err := s.client.ClearScroll(s.scrollID).Do(ctx)
+	return err
+}
+
+func (p *bulkProcessorV7) Start(ctx context.Context) error {
+	return p.processor.Start(ctx)
+}
+
+func (p *bulkProcessorV7) Stop() error {
+	return p.processor.Stop()
+}
+
+func (p *bulkProcessorV7) Close() error {
+	return p.processor.Close()
+}
+
+func (p *bulkProcessorV7) Add(request elastic.BulkableRequest) {
+	p.processor.Add(&bulkableRequestV7{request: request})
+}
+
+func (p *bulkProcessorV7) Flush() error {
+	return p.processor.Flush()
+}
+
+func (r *bulkableRequestV7) String() string {
+	return r.request.String()
+}
+
+// Source removes the _type from the action line, ES v7 indices have no mapping type
+func (r *bulkableRequestV7) Source() ([]string, error) {
+	lines, err := r.request.Source()
+	if err != nil || len(lines) == 0 {
+		return lines, err
+	}
+
+	var action map[string]map[string]interface{}
+	decoder := json.NewDecoder(bytes.NewReader([]byte(lines[0])))
+	decoder.UseNumber() // keep external versions as is
+	if err := decoder.Decode(&action); err != nil {
+		return nil, err
+	}
+	for _, metadata := range action {
+		delete(metadata, "_type")
+	}
+	actionLine, err := json.Marshal(action)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]string, len(lines))
+	copy(result, lines)
+	result[0] = string(actionLine)
+	return result, nil
+}
+
+// unwrapBulkableRequestsV7 returns the requests added to the bulk processor, for the before and after callbacks
+func unwrapBulkableRequestsV7(requests []elastic.BulkableRequest) []elastic.BulkableRequest {
+	result := make([]elastic.BulkableRequest, 0, len(requests))
+	for _, request := range requests {
+		if r, ok := request.(*bulkableRequestV7); ok {
+			result = append(result, r.request)
+		} else {
+			result = append(result, request)
+		}
+	}
+	return result
+}
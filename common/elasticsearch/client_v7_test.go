@@ -0,0 +1,162 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package elasticsearch
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/olivere/elastic"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_BulkableRequestV7_Source(t *testing.T) {
+	request := elastic.NewBulkIndexRequest().
+		Index("test-index").
+		Type("_doc").
+		Id("test-id").
+		VersionType("external").
+		Version(1557826929478263501).
+		Doc(map[string]interface{}{"KafkaKey": "test-key"})
+
+	lines, err := (&bulkableRequestV7{request: request}).Source()
+	require.NoError(t, err)
+	require.Len(t, lines, 2)
+	require.Equal(t, `{"index":{"_id":"test-id","_index":"test-index","version":1557826929478263501,"version_type":"external"}}`, lines[0])
+	require.Equal(t, `{"KafkaKey":"test-key"}`, lines[1])
+
+	deleteRequest := elastic.NewBulkDeleteRequest().Index("test-index").Type("_doc").Id("test-id")
+	lines, err = (&bulkableRequestV7{request: deleteRequest}).Source()
+	require.NoError(t, err)
+	require.Equal(t, []string{`{"delete":{"_id":"test-id","_index":"test-index"}}`}, lines)
+}
+
+func Test_UnwrapBulkableRequestsV7(t *testing.T) {
+	request := elastic.NewBulkDeleteRequest().Index("test-index").Id("test-id")
+	requests := unwrapBulkableRequestsV7([]elastic.BulkableRequest{&bulkableRequestV7{request: request}})
+	require.Equal(t, []elastic.BulkableRequest{request}, requests)
+}
+
+func Test_ElasticWrapperV7_SearchWithDSL(t *testing.T) {
+	client, server := newTestClientV7(t, func(w http.ResponseWriter, r *http.Request, body string) {
+		require.Equal(t, "/test-index/_search", r.URL.Path)
+		require.Equal(t, "true", r.URL.Query().Get(paramRestTotalHitsAsInt))
+		require.Equal(t, `{"query":{"match_all":{}},"track_total_hits":true}`, body)
+		w.Write([]byte(`{
+			"took": 5,
+			"hits": {
+				"total": 12345,
+				"hits": [{"_index": "test-index", "_id": "test-id", "_source": {"WorkflowID": "wid"}, "sort": [1557826929478263501, "rid"]}]
+			},
+			"aggregations": {"group_0": {"buckets": [{"key": "wt", "doc_count": 1}]}}
+		}`))
+	})
+	defer server.Close()
+
+	result, err := client.SearchWithDSL(context.Background(), "test-index", `{"query":{"match_all":{}}}`)
+	require.NoError(t, err)
+	require.Equal(t, int64(12345), result.TotalHits())
+	require.Len(t, result.Hits.Hits, 1)
+	require.Equal(t, "test-id", result.Hits.Hits[0].Id)
+	require.Equal(t, "1557826929478263501", result.Hits.Hits[0].Sort[0].(json.Number).String())
+
+	terms, ok := result.Aggregations.Terms("group_0")
+	require.True(t, ok)
+	require.Len(t, terms.Buckets, 1)
+}
+
+func Test_ElasticWrapperV7_Scroll(t *testing.T) {
+	client, server := newTestClientV7(t, func(w http.ResponseWriter, r *http.Request, body string) {
+		require.Equal(t, "true", r.URL.Query().Get(paramRestTotalHitsAsInt))
+		switch r.URL.Path {
+		case "/test-index/_search":
+			require.Equal(t, elastic.DefaultScrollKeepAlive, r.URL.Query().Get(paramScroll))
+			w.Write([]byte(`{"_scroll_id": "scroll-1", "hits": {"total": 1, "hits": [{"_id": "test-id"}]}}`))
+		case "/_search/scroll":
+			require.Equal(t, `{"scroll":"5m","scroll_id":"scroll-1"}`, body)
+			w.Write([]byte(`{"_scroll_id": "scroll-2", "hits": {"total": 1, "hits": []}}`))
+		default:
+			require.Fail(t, "unexpected request", r.URL.Path)
+		}
+	})
+	defer server.Close()
+
+	result, scrollService, err := client.ScrollFirstPage(context.Background(), "test-index", `{"query":{"match_all":{}}}`)
+	require.NoError(t, err)
+	require.Equal(t, "scroll-1", result.ScrollId)
+	require.Len(t, result.Hits.Hits, 1)
+	require.Equal(t, "scroll-1", scrollService.(*scrollServiceImplV7).scrollID)
+
+	result, scrollService, err = client.Scroll(context.Background(), result.ScrollId)
+	require.Equal(t, io.EOF, err)
+	require.Empty(t, result.Hits.Hits)
+	require.Equal(t, "scroll-2", scrollService.(*scrollServiceImplV7).scrollID)
+}
+
+func Test_ElasticWrapperV7_PutMapping(t *testing.T) {
+	client, server := newTestClientV7(t, func(w http.ResponseWriter, r *http.Request, body string) {
+		require.Equal(t, "PUT", r.Method)
+		require.Equal(t, "/test-index/_mapping", r.URL.Path)
+		require.Equal(t, `{"properties":{"Attr":{"properties":{"CustomKeywordField":{"type":"keyword"}}}}}`, body)
+		w.Write([]byte(`{"acknowledged": true}`))
+	})
+	defer server.Close()
+
+	require.NoError(t, client.PutMapping(context.Background(), "test-index", "Attr", "CustomKeywordField", "keyword"))
+}
+
+func Test_ElasticWrapperV7_Error(t *testing.T) {
+	client, server := newTestClientV7(t, func(w http.ResponseWriter, r *http.Request, body string) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"error": {"type": "index_not_found_exception"}, "status": 404}`))
+	})
+	defer server.Close()
+
+	_, err := client.SearchWithDSL(context.Background(), "test-index", `{}`)
+	require.True(t, elastic.IsNotFound(err))
+}
+
+func newTestClientV7(
+	t *testing.T,
+	handler func(w http.ResponseWriter, r *http.Request, body string),
+) (Client, *httptest.Server) {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		w.Header().Set("Content-Type", "application/json")
+		handler(w, r, string(body))
+	}))
+
+	esClient, err := elastic.NewClient(
+		elastic.SetURL(server.URL),
+		elastic.SetSniff(false),
+		elastic.SetHealthcheck(false),
+		elastic.SetMaxRetries(0),
+	)
+	require.NoError(t, err)
+	return NewWrapperClientV7(esClient), server
+}
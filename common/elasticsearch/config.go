@@ -21,16 +21,26 @@
 package elasticsearch
 
 import (
+	"fmt"
 	"net/url"
 
 	"github.com/uber/cadence/common"
 )
 
+const (
+	// ESVersion6 is ElasticSearch v6, used when version is not set in config
+	ESVersion6 = "v6"
+	// ESVersion7 is ElasticSearch v7
+	ESVersion7 = "v7"
+)
+
 // Config for connecting to ElasticSearch
 type (
 	Config struct {
 		URL     url.URL           `yaml:url`
 		Indices map[string]string `yaml:indices`
+		// Version of ElasticSearch, v6 or v7, default to v6
+		Version string `yaml:"version"`
 	}
 )
 
@@ -38,3 +48,30 @@ type (
 func (cfg *Config) GetVisibilityIndex() string {
 	return cfg.Indices[common.VisibilityAppName]
 }
+
+// GetHistoryIndex return history search index name, history search is disabled if it is empty
+func (cfg *Config) GetHistoryIndex() string {
+	return cfg.Indices[common.HistorySearchAppName]
+}
+
+// GetConsumerNames return the names of the consumer groups indexing visibility messages into the indices
+func (cfg *Config) GetConsumerNames() []string {
+	consumerNames := []string{GetConsumerName(cfg.GetVisibilityIndex())}
+	if historyIndex := cfg.GetHistoryIndex(); historyIndex != "" {
+		consumerNames = append(consumerNames, GetConsumerName(historyIndex))
+	}
+	return consumerNames
+}
+
+// GetConsumerName return the name of the consumer group indexing visibility messages into the index
+func GetConsumerName(index string) string {
+	return fmt.Sprintf("%s-consumer", index)
+}
+
+// GetVersion return ElasticSearch version, default to v6 if not set
+func (cfg *Config) GetVersion() string {
+	if cfg.Version == "" {
+		return ESVersion6
+	}
+	return cfg.Version
+}
@@ -25,18 +25,18 @@ import elastic "github.com/olivere/elastic"
 
 import mock "github.com/stretchr/testify/mock"
 
-// ElasticBulkProcessor is an autogenerated mock type for the ElasticBulkProcessor type
-type ElasticBulkProcessor struct {
+// BulkProcessor is an autogenerated mock type for the BulkProcessor type
+type BulkProcessor struct {
 	mock.Mock
 }
 
 // Add provides a mock function with given fields: request
-func (_m *ElasticBulkProcessor) Add(request elastic.BulkableRequest) {
+func (_m *BulkProcessor) Add(request elastic.BulkableRequest) {
 	_m.Called(request)
 }
 
 // Close provides a mock function with given fields:
-func (_m *ElasticBulkProcessor) Close() error {
+func (_m *BulkProcessor) Close() error {
 	ret := _m.Called()
 
 	var r0 error
@@ -50,7 +50,7 @@ func (_m *ElasticBulkProcessor) Close() error {
 }
 
 // Flush provides a mock function with given fields:
-func (_m *ElasticBulkProcessor) Flush() error {
+func (_m *BulkProcessor) Flush() error {
 	ret := _m.Called()
 
 	var r0 error
@@ -64,7 +64,7 @@ func (_m *ElasticBulkProcessor) Flush() error {
 }
 
 // Start provides a mock function with given fields: ctx
-func (_m *ElasticBulkProcessor) Start(ctx context.Context) error {
+func (_m *BulkProcessor) Start(ctx context.Context) error {
 	ret := _m.Called(ctx)
 
 	var r0 error
@@ -77,22 +77,8 @@ func (_m *ElasticBulkProcessor) Start(ctx context.Context) error {
 	return r0
 }
 
-// Stats provides a mock function with given fields:
-func (_m *ElasticBulkProcessor) Stats() elastic.BulkProcessorStats {
-	ret := _m.Called()
-
-	var r0 elastic.BulkProcessorStats
-	if rf, ok := ret.Get(0).(func() elastic.BulkProcessorStats); ok {
-		r0 = rf()
-	} else {
-		r0 = ret.Get(0).(elastic.BulkProcessorStats)
-	}
-
-	return r0
-}
-
 // Stop provides a mock function with given fields:
-func (_m *ElasticBulkProcessor) Stop() error {
+func (_m *BulkProcessor) Stop() error {
 	ret := _m.Called()
 
 	var r0 error
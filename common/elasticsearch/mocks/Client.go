@@ -81,15 +81,15 @@ func (_m *Client) PutMapping(ctx context.Context, index string, root string, key
 }
 
 // RunBulkProcessor provides a mock function with given fields: ctx, p
-func (_m *Client) RunBulkProcessor(ctx context.Context, p *elasticsearch.BulkProcessorParameters) (*elastic.BulkProcessor, error) {
+func (_m *Client) RunBulkProcessor(ctx context.Context, p *elasticsearch.BulkProcessorParameters) (elasticsearch.BulkProcessor, error) {
 	ret := _m.Called(ctx, p)
 
-	var r0 *elastic.BulkProcessor
-	if rf, ok := ret.Get(0).(func(context.Context, *elasticsearch.BulkProcessorParameters) *elastic.BulkProcessor); ok {
+	var r0 elasticsearch.BulkProcessor
+	if rf, ok := ret.Get(0).(func(context.Context, *elasticsearch.BulkProcessorParameters) elasticsearch.BulkProcessor); ok {
 		r0 = rf(ctx, p)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*elastic.BulkProcessor)
+			r0 = ret.Get(0).(elasticsearch.BulkProcessor)
 		}
 	}
 
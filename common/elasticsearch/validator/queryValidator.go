@@ -30,26 +30,26 @@ import (
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/definition"
-	"github.com/uber/cadence/common/service/dynamicconfig"
 )
 
 // VisibilityQueryValidator for sql query validation
 type VisibilityQueryValidator struct {
-	validSearchAttributes dynamicconfig.MapPropertyFn
+	searchAttributes *SearchAttributesRegistry
 }
 
 // NewQueryValidator create VisibilityQueryValidator
-func NewQueryValidator(validSearchAttributes dynamicconfig.MapPropertyFn) *VisibilityQueryValidator {
+func NewQueryValidator(searchAttributes *SearchAttributesRegistry) *VisibilityQueryValidator {
 	return &VisibilityQueryValidator{
-		validSearchAttributes: validSearchAttributes,
+		searchAttributes: searchAttributes,
 	}
 }
 
 // ValidateListRequestForQuery validate that search attributes in listRequest query is legal,
-// and add prefix for custom keys
+// and add prefix for custom keys and replace aliases with the keys
 func (qv *VisibilityQueryValidator) ValidateListRequestForQuery(listRequest *workflow.ListWorkflowExecutionsRequest) error {
 	whereClause := listRequest.GetQuery()
-	newQuery, err := qv.validateListOrCountRequestForQuery(whereClause)
+	attrs := qv.searchAttributes.forDomain(listRequest.GetDomain())
+	newQuery, err := qv.validateListOrCountRequestForQuery(whereClause, attrs, false)
 	if err != nil {
 		return err
 	}
@@ -58,10 +58,11 @@ func (qv *VisibilityQueryValidator) ValidateListRequestForQuery(listRequest *wor
 }
 
 // ValidateCountRequestForQuery validate that search attributes in countRequest query is legal,
-// and add prefix for custom keys
+// and add prefix for custom keys and replace aliases with the keys. Count query can also group by search attributes
 func (qv *VisibilityQueryValidator) ValidateCountRequestForQuery(countRequest *workflow.CountWorkflowExecutionsRequest) error {
 	whereClause := countRequest.GetQuery()
-	newQuery, err := qv.validateListOrCountRequestForQuery(whereClause)
+	attrs := qv.searchAttributes.forDomain(countRequest.GetDomain())
+	newQuery, err := qv.validateListOrCountRequestForQuery(whereClause, attrs, true)
 	if err != nil {
 		return err
 	}
@@ -71,14 +72,18 @@ func (qv *VisibilityQueryValidator) ValidateCountRequestForQuery(countRequest *w
 
 // validateListOrCountRequestForQuery valid sql for visibility API
 // it also adds attr prefix for customized fields
-func (qv *VisibilityQueryValidator) validateListOrCountRequestForQuery(whereClause string) (string, error) {
+func (qv *VisibilityQueryValidator) validateListOrCountRequestForQuery(
+	whereClause string,
+	attrs *domainSearchAttributes,
+	allowGroupBy bool,
+) (string, error) {
 	if len(whereClause) != 0 {
 		// Build a placeholder query that allows us to easily parse the contents of the where clause.
 		// IMPORTANT: This query is never executed, it is just used to parse and validate whereClause
 		var placeholderQuery string
 		whereClause := strings.TrimSpace(whereClause)
 		// #nosec
-		if common.IsJustOrderByClause(whereClause) { // just order by
+		if common.IsJustOrderByClause(whereClause) || common.IsJustGroupByClause(whereClause) { // just order by or group by
 			placeholderQuery = fmt.Sprintf("SELECT * FROM dummy %s", whereClause)
 		} else {
 			placeholderQuery = fmt.Sprintf("SELECT * FROM dummy WHERE %s", whereClause)
@@ -96,14 +101,30 @@ func (qv *VisibilityQueryValidator) validateListOrCountRequestForQuery(whereClau
 		buf := sqlparser.NewTrackedBuffer(nil)
 		// validate where expr
 		if sel.Where != nil {
-			err = qv.validateWhereExpr(sel.Where.Expr)
+			err = qv.validateWhereExpr(sel.Where.Expr, attrs)
+			if err != nil {
+				return "", &workflow.BadRequestError{Message: err.Error()}
+			}
+			err = validateHistoryConditions(sel.Where.Expr)
 			if err != nil {
 				return "", &workflow.BadRequestError{Message: err.Error()}
 			}
 			sel.Where.Expr.Format(buf)
 		}
+		// validate group by
+		if len(sel.GroupBy) > 0 && !allowGroupBy {
+			return "", &workflow.BadRequestError{Message: "group by is only supported for count."}
+		}
+		if sel.Having != nil {
+			return "", &workflow.BadRequestError{Message: "having is not supported."}
+		}
+		err = qv.validateGroupByExpr(sel.GroupBy, attrs)
+		if err != nil {
+			return "", &workflow.BadRequestError{Message: err.Error()}
+		}
+		sel.GroupBy.Format(buf)
 		// validate order by
-		err = qv.validateOrderByExpr(sel.OrderBy)
+		err = qv.validateOrderByExpr(sel.OrderBy, attrs)
 		if err != nil {
 			return "", &workflow.BadRequestError{Message: err.Error()}
 		}
@@ -114,21 +135,21 @@ func (qv *VisibilityQueryValidator) validateListOrCountRequestForQuery(whereClau
 	return whereClause, nil
 }
 
-func (qv *VisibilityQueryValidator) validateWhereExpr(expr sqlparser.Expr) error {
+func (qv *VisibilityQueryValidator) validateWhereExpr(expr sqlparser.Expr, attrs *domainSearchAttributes) error {
 	if expr == nil {
 		return nil
 	}
 
 	switch expr.(type) {
 	case *sqlparser.AndExpr, *sqlparser.OrExpr:
-		return qv.validateAndOrExpr(expr)
+		return qv.validateAndOrExpr(expr, attrs)
 	case *sqlparser.ComparisonExpr:
-		return qv.validateComparisonExpr(expr)
+		return qv.validateComparisonExpr(expr, attrs)
 	case *sqlparser.RangeCond:
-		return qv.validateRangeExpr(expr)
+		return qv.validateRangeExpr(expr, attrs)
 	case *sqlparser.ParenExpr:
 		parentExpr := expr.(*sqlparser.ParenExpr)
-		return qv.validateWhereExpr(parentExpr.Expr)
+		return qv.validateWhereExpr(parentExpr.Expr, attrs)
 	default:
 		return errors.New("invalid where clause")
 	}
@@ -136,7 +157,7 @@ func (qv *VisibilityQueryValidator) validateWhereExpr(expr sqlparser.Expr) error
 	return nil
 }
 
-func (qv *VisibilityQueryValidator) validateAndOrExpr(expr sqlparser.Expr) error {
+func (qv *VisibilityQueryValidator) validateAndOrExpr(expr sqlparser.Expr, attrs *domainSearchAttributes) error {
 	var leftExpr sqlparser.Expr
 	var rightExpr sqlparser.Expr
 
@@ -151,20 +172,29 @@ func (qv *VisibilityQueryValidator) validateAndOrExpr(expr sqlparser.Expr) error
 		rightExpr = orExpr.Right
 	}
 
-	if err := qv.validateWhereExpr(leftExpr); err != nil {
+	if err := qv.validateWhereExpr(leftExpr, attrs); err != nil {
 		return err
 	}
-	return qv.validateWhereExpr(rightExpr)
+	return qv.validateWhereExpr(rightExpr, attrs)
 }
 
-func (qv *VisibilityQueryValidator) validateComparisonExpr(expr sqlparser.Expr) error {
+func (qv *VisibilityQueryValidator) validateComparisonExpr(expr sqlparser.Expr, attrs *domainSearchAttributes) error {
 	comparisonExpr := expr.(*sqlparser.ComparisonExpr)
 	colName, ok := comparisonExpr.Left.(*sqlparser.ColName)
 	if !ok {
 		return errors.New("invalid comparison expression")
 	}
-	colNameStr := colName.Name.String()
-	if qv.isValidSearchAttributes(colNameStr) {
+	if isHistoryColName(colName) {
+		if !definition.IsHistorySearchKey(colName.Name.String()) {
+			return errors.New("invalid history search field")
+		}
+		return nil
+	}
+	colNameStr, err := resolveColName(colName, attrs)
+	if err != nil {
+		return err
+	}
+	if attrs.isValid(colNameStr) {
 		if !definition.IsSystemIndexedKey(colNameStr) { // add search attribute prefix
 			comparisonExpr.Left = &sqlparser.ColName{
 				Metadata:  colName.Metadata,
@@ -177,14 +207,20 @@ func (qv *VisibilityQueryValidator) validateComparisonExpr(expr sqlparser.Expr)
 	return errors.New("invalid search attribute")
 }
 
-func (qv *VisibilityQueryValidator) validateRangeExpr(expr sqlparser.Expr) error {
+func (qv *VisibilityQueryValidator) validateRangeExpr(expr sqlparser.Expr, attrs *domainSearchAttributes) error {
 	rangeCond := expr.(*sqlparser.RangeCond)
 	colName, ok := rangeCond.Left.(*sqlparser.ColName)
 	if !ok {
 		return errors.New("invalid range expression")
 	}
-	colNameStr := colName.Name.String()
-	if qv.isValidSearchAttributes(colNameStr) {
+	if isHistoryColName(colName) {
+		return errors.New("history search field can not be used in range expression")
+	}
+	colNameStr, err := resolveColName(colName, attrs)
+	if err != nil {
+		return err
+	}
+	if attrs.isValid(colNameStr) {
 		if !definition.IsSystemIndexedKey(colNameStr) { // add search attribute prefix
 			rangeCond.Left = &sqlparser.ColName{
 				Metadata:  colName.Metadata,
@@ -197,14 +233,20 @@ func (qv *VisibilityQueryValidator) validateRangeExpr(expr sqlparser.Expr) error
 	return errors.New("invalid search attribute")
 }
 
-func (qv *VisibilityQueryValidator) validateOrderByExpr(orderBy sqlparser.OrderBy) error {
+func (qv *VisibilityQueryValidator) validateOrderByExpr(orderBy sqlparser.OrderBy, attrs *domainSearchAttributes) error {
 	for _, orderByExpr := range orderBy {
 		colName, ok := orderByExpr.Expr.(*sqlparser.ColName)
 		if !ok {
 			return errors.New("invalid order by expression")
 		}
-		colNameStr := colName.Name.String()
-		if qv.isValidSearchAttributes(colNameStr) {
+		colNameStr, err := resolveColName(colName, attrs)
+		if err != nil {
+			return err
+		}
+		if attrs.isValid(colNameStr) {
+			if isListSearchAttribute(colNameStr, attrs) {
+				return errors.New("list search attribute can not be used to sort")
+			}
 			if !definition.IsSystemIndexedKey(colNameStr) { // add search attribute prefix
 				orderByExpr.Expr = &sqlparser.ColName{
 					Metadata:  colName.Metadata,
@@ -219,9 +261,90 @@ func (qv *VisibilityQueryValidator) validateOrderByExpr(orderBy sqlparser.OrderB
 	return nil
 }
 
-// isValidSearchAttributes return true if key is registered
-func (qv *VisibilityQueryValidator) isValidSearchAttributes(key string) bool {
-	validAttr := qv.validSearchAttributes()
-	_, isValidKey := validAttr[key]
-	return isValidKey
+func (qv *VisibilityQueryValidator) validateGroupByExpr(groupBy sqlparser.GroupBy, attrs *domainSearchAttributes) error {
+	for i, groupByExpr := range groupBy {
+		colName, ok := groupByExpr.(*sqlparser.ColName)
+		if !ok {
+			return errors.New("invalid group by expression")
+		}
+		colNameStr, err := resolveColName(colName, attrs)
+		if err != nil {
+			return err
+		}
+		if !isValidGroupByAttribute(colNameStr, attrs) {
+			return errors.New("invalid group by attribute")
+		}
+		if !definition.IsSystemIndexedKey(colNameStr) { // add search attribute prefix
+			groupBy[i] = &sqlparser.ColName{
+				Metadata:  colName.Metadata,
+				Name:      sqlparser.NewColIdent(definition.Attr + "." + colNameStr),
+				Qualifier: colName.Qualifier,
+			}
+		}
+	}
+	return nil
+}
+
+// validateHistoryConditions verifies history search conditions are only combined with other conditions by and,
+// because they are resolved into the workflows they match before querying visibility records
+func validateHistoryConditions(expr sqlparser.Expr) error {
+	switch e := expr.(type) {
+	case *sqlparser.AndExpr:
+		if err := validateHistoryConditions(e.Left); err != nil {
+			return err
+		}
+		return validateHistoryConditions(e.Right)
+	case *sqlparser.ParenExpr:
+		return validateHistoryConditions(e.Expr)
+	case *sqlparser.ComparisonExpr:
+		return nil
+	}
+
+	hasHistoryCondition := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if colName, ok := node.(*sqlparser.ColName); ok && isHistoryColName(colName) {
+			hasHistoryCondition = true
+		}
+		return !hasHistoryCondition, nil
+	}, expr)
+	if hasHistoryCondition {
+		return errors.New("history search condition can only be combined with other conditions by and")
+	}
+	return nil
+}
+
+// isHistoryColName return true if the column is a history search field, e.g. History.FailureReason
+func isHistoryColName(colName *sqlparser.ColName) bool {
+	return colName.Qualifier.Name.String() == definition.History
+}
+
+// resolveColName returns the search attribute key of the column, which may be an alias of the key,
+// and verifies the search attribute is not removed
+func resolveColName(colName *sqlparser.ColName, attrs *domainSearchAttributes) (string, error) {
+	key := attrs.resolve(colName.Name.String())
+	if attrs.isRemoved(key) {
+		return "", fmt.Errorf("search attribute %s has been removed", key)
+	}
+	return key, nil
+}
+
+// isValidGroupByAttribute return true if key is registered with a type that can be grouped by,
+// full text string, double and datetime attributes are excluded
+func isValidGroupByAttribute(key string, attrs *domainSearchAttributes) bool {
+	valueType, ok := attrs.getValueType(key)
+	if !ok {
+		return false
+	}
+	switch valueType {
+	case workflow.IndexedValueTypeKeyword, workflow.IndexedValueTypeInt, workflow.IndexedValueTypeBool:
+		return true
+	default:
+		return false
+	}
+}
+
+// isListSearchAttribute return true if key is registered with a list type
+func isListSearchAttribute(key string, attrs *domainSearchAttributes) bool {
+	valueType, ok := attrs.getValueType(key)
+	return ok && (valueType == workflow.IndexedValueTypeKeywordList || valueType == workflow.IndexedValueTypeDatetimeList)
 }
@@ -42,7 +42,7 @@ func TestQueryValidatorSuite(t *testing.T) {
 
 func (s *queryValidatorSuite) TestValidateListRequestForQuery() {
 	validSearchAttr := dynamicconfig.GetMapPropertyFn(definition.GetDefaultIndexedKeys())
-	qv := NewQueryValidator(validSearchAttr)
+	qv := NewQueryValidator(newTestSearchAttributesRegistry(validSearchAttr))
 
 	listRequest := &shared.ListWorkflowExecutionsRequest{}
 	s.Nil(qv.ValidateListRequestForQuery(listRequest))
@@ -114,6 +114,17 @@ func (s *queryValidatorSuite) TestValidateListRequestForQuery() {
 	listRequest.Query = common.StringPtr(query)
 	s.Equal("BadRequestError{Message: invalid order by attribute}", qv.ValidateListRequestForQuery(listRequest).Error())
 
+	// list search attribute can not be used to sort
+	validSearchAttrWithList := map[string]interface{}{}
+	for k, v := range definition.GetDefaultIndexedKeys() {
+		validSearchAttrWithList[k] = v
+	}
+	validSearchAttrWithList["CustomKeywordListField"] = shared.IndexedValueTypeKeywordList
+	listQV := NewQueryValidator(newTestSearchAttributesRegistry(dynamicconfig.GetMapPropertyFn(validSearchAttrWithList)))
+	query = "order by CustomKeywordListField desc"
+	listRequest.Query = common.StringPtr(query)
+	s.Equal("BadRequestError{Message: list search attribute can not be used to sort}", listQV.ValidateListRequestForQuery(listRequest).Error())
+
 	// invalid order by attribute expr
 	query = "order by 123"
 	listRequest.Query = common.StringPtr(query)
@@ -131,4 +142,65 @@ func (s *queryValidatorSuite) TestValidateListRequestForQuery() {
 	query = "WorkflowID = 'wid' union select * from dummy"
 	listRequest.Query = common.StringPtr(query)
 	s.NotNil(qv.ValidateListRequestForQuery(listRequest))
+
+	// history search conditions
+	query = "CustomStringField = 'custom' and (History.FailureReason = 'timeout' and History.ActivityType in ('a', 'b'))"
+	listRequest.Query = common.StringPtr(query)
+	s.Nil(qv.ValidateListRequestForQuery(listRequest))
+	s.Equal("`Attr.CustomStringField` = 'custom' and (History.FailureReason = 'timeout' and History.ActivityType in ('a', 'b'))", listRequest.GetQuery())
+
+	query = "History.CloseTime = 123"
+	listRequest.Query = common.StringPtr(query)
+	s.Equal("BadRequestError{Message: invalid history search field}", qv.ValidateListRequestForQuery(listRequest).Error())
+
+	query = "History.SignalName between 'a' and 'b'"
+	listRequest.Query = common.StringPtr(query)
+	s.Equal("BadRequestError{Message: history search field can not be used in range expression}", qv.ValidateListRequestForQuery(listRequest).Error())
+
+	query = "WorkflowID = 'wid' or History.MarkerName = 'marker'"
+	listRequest.Query = common.StringPtr(query)
+	s.Equal("BadRequestError{Message: history search condition can only be combined with other conditions by and}", qv.ValidateListRequestForQuery(listRequest).Error())
+
+	query = "WorkflowID = 'wid' and (CloseStatus = 1 or History.FailureReason = 'timeout')"
+	listRequest.Query = common.StringPtr(query)
+	s.Equal("BadRequestError{Message: history search condition can only be combined with other conditions by and}", qv.ValidateListRequestForQuery(listRequest).Error())
+}
+
+func (s *queryValidatorSuite) TestValidateCountRequestForQuery() {
+	validSearchAttr := dynamicconfig.GetMapPropertyFn(definition.GetDefaultIndexedKeys())
+	qv := NewQueryValidator(newTestSearchAttributesRegistry(validSearchAttr))
+
+	countRequest := &shared.CountWorkflowExecutionsRequest{}
+	s.Nil(qv.ValidateCountRequestForQuery(countRequest))
+	s.Equal("", countRequest.GetQuery())
+
+	// condition + group by
+	query := "CloseTime = missing group by WorkflowType, CustomKeywordField"
+	countRequest.Query = common.StringPtr(query)
+	s.Nil(qv.ValidateCountRequestForQuery(countRequest))
+	s.Equal("CloseTime = missing group by WorkflowType, `Attr.CustomKeywordField`", countRequest.GetQuery())
+
+	// only group by
+	query = "group by CloseStatus"
+	countRequest.Query = common.StringPtr(query)
+	s.Nil(qv.ValidateCountRequestForQuery(countRequest))
+	s.Equal(" "+query, countRequest.GetQuery())
+
+	// invalid group by attribute
+	query = "group by InvalidField"
+	countRequest.Query = common.StringPtr(query)
+	s.Equal("BadRequestError{Message: invalid group by attribute}", qv.ValidateCountRequestForQuery(countRequest).Error())
+
+	// full text attribute cannot be grouped by
+	query = "group by CustomStringField"
+	countRequest.Query = common.StringPtr(query)
+	s.Equal("BadRequestError{Message: invalid group by attribute}", qv.ValidateCountRequestForQuery(countRequest).Error())
+
+	query = "group by WorkflowType having count(*) > 1"
+	countRequest.Query = common.StringPtr(query)
+	s.Equal("BadRequestError{Message: having is not supported.}", qv.ValidateCountRequestForQuery(countRequest).Error())
+
+	// group by is not allowed in list
+	listRequest := &shared.ListWorkflowExecutionsRequest{Query: common.StringPtr("group by WorkflowType")}
+	s.Equal("BadRequestError{Message: group by is only supported for count.}", qv.ValidateListRequestForQuery(listRequest).Error())
 }
@@ -0,0 +1,109 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package validator
+
+import (
+	gen "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	// SearchAttributesRegistry resolves the search attributes registered for a domain,
+	// together with the lifecycle status and the aliases of the keys.
+	// The registry of a domain in dynamic config overrides the cluster registry.
+	SearchAttributesRegistry struct {
+		validSearchAttributes  dynamicconfig.MapPropertyFn
+		searchAttributeStatus  dynamicconfig.MapPropertyFn
+		searchAttributeAliases dynamicconfig.MapPropertyFn
+	}
+
+	// domainSearchAttributes is a snapshot of the search attributes registry of a domain
+	domainSearchAttributes struct {
+		*definition.SearchAttributes
+	}
+)
+
+// NewSearchAttributesRegistry create SearchAttributesRegistry
+func NewSearchAttributesRegistry(
+	validSearchAttributes dynamicconfig.MapPropertyFn,
+	searchAttributeStatus dynamicconfig.MapPropertyFn,
+	searchAttributeAliases dynamicconfig.MapPropertyFn,
+) *SearchAttributesRegistry {
+	return &SearchAttributesRegistry{
+		validSearchAttributes:  validSearchAttributes,
+		searchAttributeStatus:  searchAttributeStatus,
+		searchAttributeAliases: searchAttributeAliases,
+	}
+}
+
+// GetValidSearchAttributes returns the search attributes registered for the domain
+func (r *SearchAttributesRegistry) GetValidSearchAttributes(domain string) map[string]interface{} {
+	return r.forDomain(domain).Valid
+}
+
+func (r *SearchAttributesRegistry) forDomain(domain string) *domainSearchAttributes {
+	cluster := definition.NewSearchAttributes(
+		r.validSearchAttributes(),
+		r.searchAttributeStatus(),
+		r.searchAttributeAliases(),
+	)
+	if domain == "" {
+		return &domainSearchAttributes{cluster}
+	}
+
+	filter := dynamicconfig.DomainFilter(domain)
+	domainRegistry := definition.NewSearchAttributes(
+		r.validSearchAttributes(filter),
+		r.searchAttributeStatus(filter),
+		r.searchAttributeAliases(filter),
+	)
+	return &domainSearchAttributes{cluster.Override(cluster.GetOverrides(domainRegistry))}
+}
+
+// resolve returns the key the alias refers to, or the key itself if it is not an alias
+func (a *domainSearchAttributes) resolve(key string) string {
+	if target, ok := a.Aliases[key].(string); ok {
+		return target
+	}
+	return key
+}
+
+// isValid return true if key is registered
+func (a *domainSearchAttributes) isValid(key string) bool {
+	_, ok := a.Valid[key]
+	return ok
+}
+
+// isDeprecated return true if key is registered but deprecated
+func (a *domainSearchAttributes) isDeprecated(key string) bool {
+	return a.isValid(key) && a.Status[key] == definition.SearchAttributeStatusDeprecated
+}
+
+// isRemoved return true if key was registered and then removed
+func (a *domainSearchAttributes) isRemoved(key string) bool {
+	return !a.isValid(key) && a.Status[key] == definition.SearchAttributeStatusRemoved
+}
+
+// getValueType returns the value type of the registered key
+func (a *domainSearchAttributes) getValueType(key string) (gen.IndexedValueType, bool) {
+	return a.GetValueType(key)
+}
@@ -0,0 +1,184 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package validator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	gen "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type searchAttributesRegistrySuite struct {
+	suite.Suite
+}
+
+func TestSearchAttributesRegistrySuite(t *testing.T) {
+	s := new(searchAttributesRegistrySuite)
+	suite.Run(t, s)
+}
+
+func newTestSearchAttributesRegistry(validSearchAttributes dynamicconfig.MapPropertyFn) *SearchAttributesRegistry {
+	return NewSearchAttributesRegistry(
+		validSearchAttributes,
+		dynamicconfig.GetMapPropertyFn(map[string]interface{}{}),
+		dynamicconfig.GetMapPropertyFn(map[string]interface{}{}),
+	)
+}
+
+// getMapPropertyFnFilteredByDomain returns the value of the domain, or the default value for other domains
+func getMapPropertyFnFilteredByDomain(
+	domainValues map[string]map[string]interface{},
+	defaultValue map[string]interface{},
+) dynamicconfig.MapPropertyFn {
+	return func(opts ...dynamicconfig.FilterOption) map[string]interface{} {
+		filters := make(map[dynamicconfig.Filter]interface{})
+		for _, opt := range opts {
+			opt(filters)
+		}
+		if domain, ok := filters[dynamicconfig.DomainName].(string); ok {
+			if value, ok := domainValues[domain]; ok {
+				return value
+			}
+		}
+		return defaultValue
+	}
+}
+
+func (s *searchAttributesRegistrySuite) newLifecycleRegistry() *SearchAttributesRegistry {
+	valid := map[string]interface{}{
+		definition.WorkflowID: gen.IndexedValueTypeKeyword,
+		"CustomKeywordField":  gen.IndexedValueTypeKeyword,
+		"OldKeywordField":     gen.IndexedValueTypeKeyword,
+	}
+	status := map[string]interface{}{
+		"OldKeywordField": definition.SearchAttributeStatusDeprecated,
+		"CustomIntField":  definition.SearchAttributeStatusRemoved,
+	}
+	aliases := map[string]interface{}{
+		"Keyword": "CustomKeywordField",
+	}
+	return NewSearchAttributesRegistry(
+		getMapPropertyFnFilteredByDomain(map[string]map[string]interface{}{
+			"domain-with-registry": {"DomainKeywordField": gen.IndexedValueTypeKeyword},
+		}, valid),
+		getMapPropertyFnFilteredByDomain(map[string]map[string]interface{}{
+			"domain-without-custom": {"CustomKeywordField": definition.SearchAttributeStatusRemoved},
+		}, status),
+		dynamicconfig.GetMapPropertyFn(aliases),
+	)
+}
+
+func (s *searchAttributesRegistrySuite) TestGetValidSearchAttributes() {
+	registry := s.newLifecycleRegistry()
+	s.Contains(registry.GetValidSearchAttributes("domain"), "CustomKeywordField")
+	s.NotContains(registry.GetValidSearchAttributes("domain"), "DomainKeywordField")
+	s.Contains(registry.GetValidSearchAttributes("domain-with-registry"), "DomainKeywordField")
+	// keys of the cluster registry are inherited by the domains with their own registry
+	s.Contains(registry.GetValidSearchAttributes("domain-with-registry"), "CustomKeywordField")
+	s.NotContains(registry.GetValidSearchAttributes("domain-without-custom"), "CustomKeywordField")
+	s.Contains(registry.GetValidSearchAttributes("domain-without-custom"), "OldKeywordField")
+}
+
+func (s *searchAttributesRegistrySuite) TestQueryValidator() {
+	qv := NewQueryValidator(s.newLifecycleRegistry())
+
+	listRequest := &gen.ListWorkflowExecutionsRequest{Domain: common.StringPtr("domain")}
+	listRequest.Query = common.StringPtr("Keyword = 'keyword' order by OldKeywordField")
+	s.NoError(qv.ValidateListRequestForQuery(listRequest))
+	s.Equal("`Attr.CustomKeywordField` = 'keyword' order by `Attr.OldKeywordField` asc", listRequest.GetQuery())
+
+	listRequest.Query = common.StringPtr("CustomIntField = 1")
+	s.EqualError(qv.ValidateListRequestForQuery(listRequest), "BadRequestError{Message: search attribute CustomIntField has been removed}")
+
+	countRequest := &gen.CountWorkflowExecutionsRequest{Domain: common.StringPtr("domain")}
+	countRequest.Query = common.StringPtr("group by Keyword")
+	s.NoError(qv.ValidateCountRequestForQuery(countRequest))
+	s.Equal(" group by `Attr.CustomKeywordField`", countRequest.GetQuery())
+
+	countRequest.Query = common.StringPtr("CustomIntField between 1 and 2")
+	s.EqualError(qv.ValidateCountRequestForQuery(countRequest), "BadRequestError{Message: search attribute CustomIntField has been removed}")
+
+	listRequest = &gen.ListWorkflowExecutionsRequest{Domain: common.StringPtr("domain-with-registry")}
+	listRequest.Query = common.StringPtr("DomainKeywordField = 'keyword'")
+	s.NoError(qv.ValidateListRequestForQuery(listRequest))
+	listRequest.Query = common.StringPtr("Keyword = 'keyword'")
+	s.NoError(qv.ValidateListRequestForQuery(listRequest))
+
+	listRequest = &gen.ListWorkflowExecutionsRequest{Domain: common.StringPtr("domain-without-custom")}
+	listRequest.Query = common.StringPtr("CustomKeywordField = 'keyword'")
+	s.EqualError(qv.ValidateListRequestForQuery(listRequest), "BadRequestError{Message: search attribute CustomKeywordField has been removed}")
+	listRequest.Query = common.StringPtr("Keyword = 'keyword'")
+	s.EqualError(qv.ValidateListRequestForQuery(listRequest), "BadRequestError{Message: invalid search attribute}")
+}
+
+func (s *searchAttributesRegistrySuite) TestSearchAttributesValidator() {
+	sv := NewSearchAttributesValidator(log.NewNoop(),
+		s.newLifecycleRegistry(),
+		dynamicconfig.GetIntPropertyFilteredByDomain(10),
+		dynamicconfig.GetIntPropertyFilteredByDomain(100),
+		dynamicconfig.GetIntPropertyFilteredByDomain(1000))
+	domain := "domain"
+
+	attr := &gen.SearchAttributes{
+		IndexedFields: map[string][]byte{"Keyword": []byte(`"keyword"`)},
+	}
+	s.NoError(sv.ValidateSearchAttributes(attr, domain))
+	s.Equal(map[string][]byte{"CustomKeywordField": []byte(`"keyword"`)}, attr.IndexedFields)
+
+	attr.IndexedFields["Keyword"] = []byte(`"keyword"`)
+	s.EqualError(sv.ValidateSearchAttributes(attr, domain), "BadRequestError{Message: CustomKeywordField and its alias Keyword are both set}")
+
+	attr.IndexedFields = map[string][]byte{"OldKeywordField": []byte(`"keyword"`)}
+	s.EqualError(sv.ValidateSearchAttributes(attr, domain), "BadRequestError{Message: OldKeywordField is deprecated search attribute}")
+
+	attr.IndexedFields = map[string][]byte{"CustomIntField": []byte(`1`)}
+	s.EqualError(sv.ValidateSearchAttributes(attr, domain), "BadRequestError{Message: search attribute CustomIntField has been removed}")
+
+	keys := []string{"Keyword", "OldKeywordField", "CustomIntField"}
+	s.NoError(sv.ValidateSearchAttributeKeys(keys, domain))
+	s.Equal([]string{"CustomKeywordField", "OldKeywordField", "CustomIntField"}, keys)
+
+	s.EqualError(sv.ValidateSearchAttributeKeys([]string{definition.WorkflowID}, domain),
+		"BadRequestError{Message: WorkflowID is read-only Cadence reservered attribute}")
+}
+
+func (s *searchAttributesRegistrySuite) TestSearchAttributesValidator_StateKeys() {
+	sv := NewSearchAttributesValidator(log.NewNoop(),
+		newTestSearchAttributesRegistry(dynamicconfig.GetMapPropertyFn(definition.GetDefaultIndexedKeys())),
+		dynamicconfig.GetIntPropertyFilteredByDomain(10),
+		dynamicconfig.GetIntPropertyFilteredByDomain(100),
+		dynamicconfig.GetIntPropertyFilteredByDomain(1000))
+	domain := "domain"
+
+	attr := &gen.SearchAttributes{
+		IndexedFields: map[string][]byte{definition.CadencePendingChildCount: []byte("1")},
+	}
+	s.EqualError(sv.ValidateSearchAttributes(attr, domain),
+		"BadRequestError{Message: CadencePendingChildCount is read-only Cadence reservered attribute}")
+	s.EqualError(sv.ValidateSearchAttributeKeys([]string{definition.CadenceLastFailureReason}, domain),
+		"BadRequestError{Message: CadenceLastFailureReason is read-only Cadence reservered attribute}")
+}
@@ -21,7 +21,9 @@
 package validator
 
 import (
+	"encoding/json"
 	"fmt"
+	"time"
 
 	gen "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common/definition"
@@ -34,7 +36,7 @@ import (
 type SearchAttributesValidator struct {
 	logger log.Logger
 
-	validSearchAttributes             dynamicconfig.MapPropertyFn
+	searchAttributes                  *SearchAttributesRegistry
 	searchAttributesNumberOfKeysLimit dynamicconfig.IntPropertyFnWithDomainFilter
 	searchAttributesSizeOfValueLimit  dynamicconfig.IntPropertyFnWithDomainFilter
 	searchAttributesTotalSizeLimit    dynamicconfig.IntPropertyFnWithDomainFilter
@@ -43,26 +45,32 @@ type SearchAttributesValidator struct {
 // NewSearchAttributesValidator create SearchAttributesValidator
 func NewSearchAttributesValidator(
 	logger log.Logger,
-	validSearchAttributes dynamicconfig.MapPropertyFn,
+	searchAttributes *SearchAttributesRegistry,
 	searchAttributesNumberOfKeysLimit dynamicconfig.IntPropertyFnWithDomainFilter,
 	searchAttributesSizeOfValueLimit dynamicconfig.IntPropertyFnWithDomainFilter,
 	searchAttributesTotalSizeLimit dynamicconfig.IntPropertyFnWithDomainFilter,
 ) *SearchAttributesValidator {
 	return &SearchAttributesValidator{
 		logger:                            logger,
-		validSearchAttributes:             validSearchAttributes,
+		searchAttributes:                  searchAttributes,
 		searchAttributesNumberOfKeysLimit: searchAttributesNumberOfKeysLimit,
 		searchAttributesSizeOfValueLimit:  searchAttributesSizeOfValueLimit,
 		searchAttributesTotalSizeLimit:    searchAttributesTotalSizeLimit,
 	}
 }
 
-// ValidateSearchAttributes validate search attributes are valid for writing and not exceed limits
+// ValidateSearchAttributes validate search attributes are valid for writing and not exceed limits,
+// aliases of search attributes in input are replaced with the keys
 func (sv *SearchAttributesValidator) ValidateSearchAttributes(input *gen.SearchAttributes, domain string) error {
 	if input == nil {
 		return nil
 	}
 
+	attrs := sv.searchAttributes.forDomain(domain)
+	if err := resolveAliases(input, attrs); err != nil {
+		return err
+	}
+
 	// verify: number of keys <= limit
 	fields := input.GetIndexedFields()
 	lengthOfFields := len(fields)
@@ -74,17 +82,20 @@ func (sv *SearchAttributesValidator) ValidateSearchAttributes(input *gen.SearchA
 
 	totalSize := 0
 	for key, val := range fields {
-		// verify: key is whitelisted
-		if !sv.isValidSearchAttributes(key) {
-			sv.logger.WithTags(tag.ESKey(key), tag.WorkflowDomainName(domain)).
-				Error("invalid search attribute")
-			return &gen.BadRequestError{Message: fmt.Sprintf("%s is not valid search attribute", key)}
+		if err := sv.validateKey(key, domain, attrs); err != nil {
+			return err
 		}
-		// verify: key is not system reserved
-		if definition.IsSystemIndexedKey(key) {
+		// verify: key is not deprecated
+		if attrs.isDeprecated(key) {
 			sv.logger.WithTags(tag.ESKey(key), tag.WorkflowDomainName(domain)).
-				Error("illegal update of system reserved attribute")
-			return &gen.BadRequestError{Message: fmt.Sprintf("%s is read-only Cadence reservered attribute", key)}
+				Error("illegal update of deprecated search attribute")
+			return &gen.BadRequestError{Message: fmt.Sprintf("%s is deprecated search attribute", key)}
+		}
+		// verify: value of list search attributes is a list of values of the element type
+		if err := validateListValue(key, val, attrs); err != nil {
+			sv.logger.WithTags(tag.ESKey(key), tag.WorkflowDomainName(domain), tag.Error(err)).
+				Error("invalid value of list search attribute")
+			return &gen.BadRequestError{Message: err.Error()}
 		}
 		// verify: size of single value <= limit
 		if len(val) > sv.searchAttributesSizeOfValueLimit(domain) {
@@ -105,9 +116,79 @@ func (sv *SearchAttributesValidator) ValidateSearchAttributes(input *gen.SearchA
 	return nil
 }
 
-// isValidSearchAttributes return true if key is registered
-func (sv *SearchAttributesValidator) isValidSearchAttributes(key string) bool {
-	validAttr := sv.validSearchAttributes()
-	_, isValidKey := validAttr[key]
-	return isValidKey
+// ValidateSearchAttributeKeys validate the keys of search attributes removed from a workflow,
+// aliases in keys are replaced with the keys. Deprecated and removed search attributes can be removed.
+func (sv *SearchAttributesValidator) ValidateSearchAttributeKeys(keys []string, domain string) error {
+	attrs := sv.searchAttributes.forDomain(domain)
+	for i, key := range keys {
+		key = attrs.resolve(key)
+		keys[i] = key
+		if attrs.isRemoved(key) {
+			continue
+		}
+		if err := sv.validateKey(key, domain, attrs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resolveAliases replaces the aliases of search attributes in input with the keys
+func resolveAliases(input *gen.SearchAttributes, attrs *domainSearchAttributes) error {
+	for key, val := range input.GetIndexedFields() {
+		target := attrs.resolve(key)
+		if target == key {
+			continue
+		}
+		if _, ok := input.IndexedFields[target]; ok {
+			return &gen.BadRequestError{Message: fmt.Sprintf("%s and its alias %s are both set", target, key)}
+		}
+		delete(input.IndexedFields, key)
+		input.IndexedFields[target] = val
+	}
+	return nil
+}
+
+// validateKey verifies the key is whitelisted and not system reserved or maintained by Cadence
+func (sv *SearchAttributesValidator) validateKey(key string, domain string, attrs *domainSearchAttributes) error {
+	if attrs.isRemoved(key) {
+		sv.logger.WithTags(tag.ESKey(key), tag.WorkflowDomainName(domain)).
+			Error("illegal update of removed search attribute")
+		return &gen.BadRequestError{Message: fmt.Sprintf("search attribute %s has been removed", key)}
+	}
+	if !attrs.isValid(key) {
+		sv.logger.WithTags(tag.ESKey(key), tag.WorkflowDomainName(domain)).
+			Error("invalid search attribute")
+		return &gen.BadRequestError{Message: fmt.Sprintf("%s is not valid search attribute", key)}
+	}
+	if definition.IsSystemIndexedKey(key) {
+		sv.logger.WithTags(tag.ESKey(key), tag.WorkflowDomainName(domain)).
+			Error("illegal update of system reserved attribute")
+		return &gen.BadRequestError{Message: fmt.Sprintf("%s is read-only Cadence reservered attribute", key)}
+	}
+	if definition.IsStateIndexedKey(key) {
+		sv.logger.WithTags(tag.ESKey(key), tag.WorkflowDomainName(domain)).
+			Error("illegal update of search attribute maintained by Cadence")
+		return &gen.BadRequestError{Message: fmt.Sprintf("%s is read-only Cadence reservered attribute", key)}
+	}
+	return nil
+}
+
+// validateListValue verifies the value of KEYWORD_LIST attribute is a JSON array of strings
+// and the value of DATETIME_LIST attribute is a JSON array of RFC3339 time strings
+func validateListValue(key string, val []byte, attrs *domainSearchAttributes) error {
+	valueType, _ := attrs.getValueType(key)
+	switch valueType {
+	case gen.IndexedValueTypeKeywordList:
+		var values []string
+		if err := json.Unmarshal(val, &values); err != nil {
+			return fmt.Errorf("value of %s must be a list of strings", key)
+		}
+	case gen.IndexedValueTypeDatetimeList:
+		var values []time.Time
+		if err := json.Unmarshal(val, &values); err != nil {
+			return fmt.Errorf("value of %s must be a list of RFC3339 time", key)
+		}
+	}
+	return nil
 }
@@ -46,7 +46,7 @@ func (s *searchAttributesValidatorSuite) TestValidateSearchAttributes() {
 	sizeOfTotalLimit := 20
 
 	validator := NewSearchAttributesValidator(log.NewNoop(),
-		dynamicconfig.GetMapPropertyFn(definition.GetDefaultIndexedKeys()),
+		newTestSearchAttributesRegistry(dynamicconfig.GetMapPropertyFn(definition.GetDefaultIndexedKeys())),
 		dynamicconfig.GetIntPropertyFilteredByDomain(numOfKeysLimit),
 		dynamicconfig.GetIntPropertyFilteredByDomain(sizeOfValueLimit),
 		dynamicconfig.GetIntPropertyFilteredByDomain(sizeOfTotalLimit))
@@ -104,3 +104,60 @@ func (s *searchAttributesValidatorSuite) TestValidateSearchAttributes() {
 	err = validator.ValidateSearchAttributes(attr, domain)
 	s.Equal(`BadRequestError{Message: total size 40 exceed limit}`, err.Error())
 }
+
+func (s *searchAttributesValidatorSuite) TestValidateSearchAttributes_ListValue() {
+	validSearchAttributes := map[string]interface{}{
+		"CustomKeywordField": gen.IndexedValueTypeKeyword,
+		"Tags":               gen.IndexedValueTypeKeywordList,
+		"Deadlines":          gen.IndexedValueTypeDatetimeList,
+	}
+	validator := NewSearchAttributesValidator(log.NewNoop(),
+		newTestSearchAttributesRegistry(dynamicconfig.GetMapPropertyFn(validSearchAttributes)),
+		dynamicconfig.GetIntPropertyFilteredByDomain(10),
+		dynamicconfig.GetIntPropertyFilteredByDomain(100),
+		dynamicconfig.GetIntPropertyFilteredByDomain(1000))
+
+	domain := "domain"
+	attr := &gen.SearchAttributes{
+		IndexedFields: map[string][]byte{
+			"CustomKeywordField": []byte(`"keyword"`),
+			"Tags":               []byte(`["a","b"]`),
+			"Deadlines":          []byte(`["2019-06-07T16:16:36-08:00"]`),
+		},
+	}
+	s.NoError(validator.ValidateSearchAttributes(attr, domain))
+
+	attr.IndexedFields = map[string][]byte{
+		"Tags": []byte(`"a"`),
+	}
+	err := validator.ValidateSearchAttributes(attr, domain)
+	s.Equal(`BadRequestError{Message: value of Tags must be a list of strings}`, err.Error())
+
+	attr.IndexedFields = map[string][]byte{
+		"Tags": []byte(`["a",1]`),
+	}
+	s.Error(validator.ValidateSearchAttributes(attr, domain))
+
+	attr.IndexedFields = map[string][]byte{
+		"Deadlines": []byte(`[1560000000]`),
+	}
+	err = validator.ValidateSearchAttributes(attr, domain)
+	s.Equal(`BadRequestError{Message: value of Deadlines must be a list of RFC3339 time}`, err.Error())
+}
+
+func (s *searchAttributesValidatorSuite) TestValidateSearchAttributeKeys() {
+	validator := NewSearchAttributesValidator(log.NewNoop(),
+		newTestSearchAttributesRegistry(dynamicconfig.GetMapPropertyFn(definition.GetDefaultIndexedKeys())),
+		dynamicconfig.GetIntPropertyFilteredByDomain(10),
+		dynamicconfig.GetIntPropertyFilteredByDomain(100),
+		dynamicconfig.GetIntPropertyFilteredByDomain(1000))
+
+	domain := "domain"
+	s.NoError(validator.ValidateSearchAttributeKeys(nil, domain))
+	s.NoError(validator.ValidateSearchAttributeKeys([]string{"CustomKeywordField", "CustomIntField"}, domain))
+
+	err := validator.ValidateSearchAttributeKeys([]string{"InvalidKey"}, domain)
+	s.Equal(`BadRequestError{Message: InvalidKey is not valid search attribute}`, err.Error())
+	err = validator.ValidateSearchAttributeKeys([]string{"CloseTime"}, domain)
+	s.Equal(`BadRequestError{Message: CloseTime is read-only Cadence reservered attribute}`, err.Error())
+}
@@ -583,6 +583,16 @@ func KafkaOffset(offset int64) Tag {
 	return newInt64("kafka-offset", offset)
 }
 
+// QueueType returns tag for the type of a persistence queue
+func QueueType(queueType int) Tag {
+	return newInt("queue-type", queueType)
+}
+
+// QueueConsumerName returns tag for the consumer name of a persistence queue
+func QueueConsumerName(consumerName string) Tag {
+	return newStringTag("queue-consumer-name", consumerName)
+}
+
 // TokenLastEventID returns tag for TokenLastEventID
 func TokenLastEventID(id int64) Tag {
 	return newInt64("token-last-event-id", id)
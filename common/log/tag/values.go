@@ -120,8 +120,12 @@ var (
 	ComponentESVisibilityManager      = component("es-visibility-manager")
 	ComponentArchiver                 = component("archiver")
 	ComponentBatcher                  = component("batcher")
+	ComponentFailoverManager          = component("failover-manager")
+	ComponentVisibilityMigration      = component("visibility-migration")
+	ComponentVisibilityExport         = component("visibility-export")
 	ComponentWorker                   = component("worker")
 	ComponentServiceResolver          = component("service-resolver")
+	ComponentFailoverCoordinator      = component("failover-coordinator")
 )
 
 // Pre-defined values for TagSysLifecycle
@@ -172,6 +176,7 @@ var (
 	StoreOperationGetTasks                = storeOperation("get-tasks")
 	StoreOperationCompleteTask            = storeOperation("complete-task")
 	StoreOperationCompleteTasksLessThan   = storeOperation("complete-tasks-less-than")
+	StoreOperationCompleteTaskSegments    = storeOperation("complete-task-segments")
 	StoreOperationCreateWorkflowExecution = storeOperation("create-wf-execution")
 	StoreOperationGetWorkflowExecution    = storeOperation("get-wf-execution")
 	StoreOperationUpdateWorkflowExecution = storeOperation("update-wf-execution")
@@ -165,6 +165,12 @@ const (
 	PersistencePutReplicationTaskToDLQScope
 	// PersistenceGetReplicationTasksFromDLQScope tracks PersistenceGetReplicationTasksFromDLQScope calls made by service to persistence layer
 	PersistenceGetReplicationTasksFromDLQScope
+	// PersistenceDeleteReplicationTaskFromDLQScope tracks PersistenceDeleteReplicationTaskFromDLQScope calls made by service to persistence layer
+	PersistenceDeleteReplicationTaskFromDLQScope
+	// PersistenceRangeDeleteReplicationTaskFromDLQScope tracks PersistenceRangeDeleteReplicationTaskFromDLQScope calls made by service to persistence layer
+	PersistenceRangeDeleteReplicationTaskFromDLQScope
+	// PersistenceGetReplicationDLQSizeScope tracks PersistenceGetReplicationDLQSizeScope calls made by service to persistence layer
+	PersistenceGetReplicationDLQSizeScope
 	// PersistenceGetTimerIndexTasksScope tracks GetTimerIndexTasks calls made by service to persistence layer
 	PersistenceGetTimerIndexTasksScope
 	// PersistenceCompleteTimerTaskScope tracks CompleteTimerTasks calls made by service to persistence layer
@@ -179,6 +185,12 @@ const (
 	PersistenceCompleteTaskScope
 	// PersistenceCompleteTasksLessThanScope is the metric scope for persistence.TaskManager.PersistenceCompleteTasksLessThan API
 	PersistenceCompleteTasksLessThanScope
+	// PersistenceCreateTaskSegmentScope tracks CreateTaskSegment calls made by service to persistence layer
+	PersistenceCreateTaskSegmentScope
+	// PersistenceGetTaskSegmentsScope tracks GetTaskSegments calls made by service to persistence layer
+	PersistenceGetTaskSegmentsScope
+	// PersistenceCompleteTaskSegmentsLessThanScope tracks CompleteTaskSegmentsLessThan calls made by service to persistence layer
+	PersistenceCompleteTaskSegmentsLessThanScope
 	// PersistenceLeaseTaskListScope tracks LeaseTaskList calls made by service to persistence layer
 	PersistenceLeaseTaskListScope
 	// PersistenceUpdateTaskListScope tracks PersistenceUpdateTaskListScope calls made by service to persistence layer
@@ -305,6 +317,20 @@ const (
 	HistoryClientQueryWorkflowScope
 	// HistoryClientReapplyEventsScope tracks RPC calls to history service
 	HistoryClientReapplyEventsScope
+	// HistoryClientGetDLQReplicationMessagesScope tracks RPC calls to history service
+	HistoryClientGetDLQReplicationMessagesScope
+	// HistoryClientReadDLQMessagesScope tracks RPC calls to history service
+	HistoryClientReadDLQMessagesScope
+	// HistoryClientPurgeDLQMessagesScope tracks RPC calls to history service
+	HistoryClientPurgeDLQMessagesScope
+	// HistoryClientMergeDLQMessagesScope tracks RPC calls to history service
+	HistoryClientMergeDLQMessagesScope
+	// HistoryClientGetReplicationAckLevelsScope tracks RPC calls to history service
+	HistoryClientGetReplicationAckLevelsScope
+	// HistoryClientDescribeReplicationScope tracks RPC calls to history service
+	HistoryClientDescribeReplicationScope
+	// HistoryClientBackfillReplicationScope tracks RPC calls to history service
+	HistoryClientBackfillReplicationScope
 	// MatchingClientPollForDecisionTaskScope tracks RPC calls to matching service
 	MatchingClientPollForDecisionTaskScope
 	// MatchingClientPollForActivityTaskScope tracks RPC calls to matching service
@@ -321,6 +347,10 @@ const (
 	MatchingClientCancelOutstandingPollScope
 	// MatchingClientDescribeTaskListScope tracks RPC calls to matching service
 	MatchingClientDescribeTaskListScope
+	// MatchingClientRecordTaskCompletionScope tracks RPC calls to matching service
+	MatchingClientRecordTaskCompletionScope
+	// MatchingClientDrainTaskListScope tracks RPC calls to matching service
+	MatchingClientDrainTaskListScope
 	// FrontendClientDeprecateDomainScope tracks RPC calls to frontend service
 	FrontendClientDeprecateDomainScope
 	// FrontendClientDescribeDomainScope tracks RPC calls to frontend service
@@ -401,6 +431,10 @@ const (
 	FrontendClientReapplyEventsScope
 	// AdminClientAddSearchAttributeScope tracks RPC calls to admin service
 	AdminClientAddSearchAttributeScope
+	// AdminClientUpdateSearchAttributesScope tracks RPC calls to admin service
+	AdminClientUpdateSearchAttributesScope
+	// AdminClientDescribeSearchAttributesScope tracks RPC calls to admin service
+	AdminClientDescribeSearchAttributesScope
 	// AdminClientCloseShardScope tracks RPC calls to admin service
 	AdminClientCloseShardScope
 	// AdminClientDescribeHistoryHostScope tracks RPC calls to admin service
@@ -411,6 +445,26 @@ const (
 	AdminClientGetWorkflowExecutionRawHistoryScope
 	// AdminClientGetWorkflowExecutionRawHistoryV2Scope tracks RPC calls to admin service
 	AdminClientGetWorkflowExecutionRawHistoryV2Scope
+	// AdminClientDrainTaskListScope tracks RPC calls to admin service
+	AdminClientDrainTaskListScope
+	// AdminClientGetDLQReplicationMessagesScope tracks RPC calls to admin service
+	AdminClientGetDLQReplicationMessagesScope
+	// AdminClientReadDLQMessagesScope tracks RPC calls to admin service
+	AdminClientReadDLQMessagesScope
+	// AdminClientPurgeDLQMessagesScope tracks RPC calls to admin service
+	AdminClientPurgeDLQMessagesScope
+	// AdminClientMergeDLQMessagesScope tracks RPC calls to admin service
+	AdminClientMergeDLQMessagesScope
+	// AdminClientDescribeReplicationScope tracks RPC calls to admin service
+	AdminClientDescribeReplicationScope
+	// AdminClientStartChildWorkflowExecutionScope tracks RPC calls to admin service
+	AdminClientStartChildWorkflowExecutionScope
+	// AdminClientSignalExternalWorkflowExecutionScope tracks RPC calls to admin service
+	AdminClientSignalExternalWorkflowExecutionScope
+	// AdminClientRecordChildExecutionCompletedScope tracks RPC calls to admin service
+	AdminClientRecordChildExecutionCompletedScope
+	// AdminClientRemoveSignalMutableStateScope tracks RPC calls to admin service
+	AdminClientRemoveSignalMutableStateScope
 	// DCRedirectionDeprecateDomainScope tracks RPC calls for dc redirection
 	DCRedirectionDeprecateDomainScope
 	// DCRedirectionDescribeDomainScope tracks RPC calls for dc redirection
@@ -592,6 +646,30 @@ const (
 	AdminRemoveTaskScope
 	//AdminCloseShardTaskScope is the metric scope for admin.AdminRemoveTaskScope
 	AdminCloseShardTaskScope
+	// AdminDrainTaskListScope is the metric scope for admin.DrainTaskList
+	AdminDrainTaskListScope
+	// AdminUpdateSearchAttributesScope is the metric scope for admin.UpdateSearchAttributes
+	AdminUpdateSearchAttributesScope
+	// AdminDescribeSearchAttributesScope is the metric scope for admin.DescribeSearchAttributes
+	AdminDescribeSearchAttributesScope
+	// AdminGetDLQReplicationMessagesScope is the metric scope for admin.GetDLQReplicationMessages
+	AdminGetDLQReplicationMessagesScope
+	// AdminReadDLQMessagesScope is the metric scope for admin.ReadDLQMessages
+	AdminReadDLQMessagesScope
+	// AdminPurgeDLQMessagesScope is the metric scope for admin.PurgeDLQMessages
+	AdminPurgeDLQMessagesScope
+	// AdminMergeDLQMessagesScope is the metric scope for admin.MergeDLQMessages
+	AdminMergeDLQMessagesScope
+	// AdminDescribeReplicationScope is the metric scope for admin.DescribeReplication
+	AdminDescribeReplicationScope
+	// AdminStartChildWorkflowExecutionScope is the metric scope for admin.StartChildWorkflowExecution
+	AdminStartChildWorkflowExecutionScope
+	// AdminSignalExternalWorkflowExecutionScope is the metric scope for admin.SignalExternalWorkflowExecution
+	AdminSignalExternalWorkflowExecutionScope
+	// AdminRecordChildExecutionCompletedScope is the metric scope for admin.RecordChildExecutionCompleted
+	AdminRecordChildExecutionCompletedScope
+	// AdminRemoveSignalMutableStateScope is the metric scope for admin.RemoveSignalMutableState
+	AdminRemoveSignalMutableStateScope
 
 	NumAdminScopes
 )
@@ -678,6 +756,8 @@ const (
 	FrontendReapplyEventsScope
 	// FrontendDomainReplicationQueueScope is the metrics scope for domain replication queue
 	FrontendDomainReplicationQueueScope
+	// FrontendDomainFailoverCoordinatorScope is the metrics scope for graceful domain failover coordinator
+	FrontendDomainFailoverCoordinatorScope
 
 	NumFrontendScopes
 )
@@ -736,6 +816,20 @@ const (
 	HistoryDescribeMutableStateScope
 	// GetReplicationMessages tracks GetReplicationMessages API calls received by service
 	HistoryGetReplicationMessagesScope
+	// HistoryGetDLQReplicationMessagesScope tracks GetDLQReplicationMessages API calls received by service
+	HistoryGetDLQReplicationMessagesScope
+	// HistoryReadDLQMessagesScope tracks ReadDLQMessages API calls received by service
+	HistoryReadDLQMessagesScope
+	// HistoryPurgeDLQMessagesScope tracks PurgeDLQMessages API calls received by service
+	HistoryPurgeDLQMessagesScope
+	// HistoryMergeDLQMessagesScope tracks MergeDLQMessages API calls received by service
+	HistoryMergeDLQMessagesScope
+	// HistoryGetReplicationAckLevelsScope tracks GetReplicationAckLevels API calls received by service
+	HistoryGetReplicationAckLevelsScope
+	// HistoryDescribeReplicationScope tracks DescribeReplication API calls received by service
+	HistoryDescribeReplicationScope
+	// HistoryBackfillReplicationScope tracks BackfillReplication API calls received by service
+	HistoryBackfillReplicationScope
 	// HistoryShardControllerScope is the scope used by shard controller
 	HistoryShardControllerScope
 	// HistoryReapplyEventsScope is the scope used by event reapplication
@@ -866,6 +960,8 @@ const (
 	ArchiverClientScope
 	// ReplicationTaskFetcherScope is scope used by all metrics emitted by ReplicationTaskFetcher
 	ReplicationTaskFetcherScope
+	// ReplicationDLQStatsScope is scope used by all metrics emitted related to replication DLQ
+	ReplicationDLQStatsScope
 
 	NumHistoryScopes
 )
@@ -890,6 +986,10 @@ const (
 	MatchingCancelOutstandingPollScope
 	// MatchingDescribeTaskListScope tracks DescribeTaskList API calls received by service
 	MatchingDescribeTaskListScope
+	// MatchingRecordTaskCompletionScope tracks RecordTaskCompletion API calls received by service
+	MatchingRecordTaskCompletionScope
+	// MatchingDrainTaskListScope tracks DrainTaskList API calls received by service
+	MatchingDrainTaskListScope
 
 	NumMatchingScopes
 )
@@ -934,6 +1034,10 @@ const (
 	HistoryScavengerScope
 	// ParentClosePolicyProcessorScope is scope used by all metrics emitted by worker.ParentClosePolicyProcessor
 	ParentClosePolicyProcessorScope
+	// FailoverManagerScope is scope used by all metrics emitted by worker.FailoverManager
+	FailoverManagerScope
+	// ReplicationVerifierScope is scope used by all metrics emitted by worker.replication.Verifier module
+	ReplicationVerifierScope
 
 	NumWorkerScopes
 )
@@ -961,6 +1065,9 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		PersistenceCompleteReplicationTaskScope:                  {operation: "CompleteReplicationTask"},
 		PersistencePutReplicationTaskToDLQScope:                  {operation: "PersistencePutReplicationTaskToDLQ"},
 		PersistenceGetReplicationTasksFromDLQScope:               {operation: "PersistenceGetReplicationTasksFromDLQ"},
+		PersistenceDeleteReplicationTaskFromDLQScope:             {operation: "PersistenceDeleteReplicationTaskFromDLQ"},
+		PersistenceRangeDeleteReplicationTaskFromDLQScope:        {operation: "PersistenceRangeDeleteReplicationTaskFromDLQ"},
+		PersistenceGetReplicationDLQSizeScope:                    {operation: "PersistenceGetReplicationDLQSize"},
 		PersistenceGetTimerIndexTasksScope:                       {operation: "GetTimerIndexTasks"},
 		PersistenceCompleteTimerTaskScope:                        {operation: "CompleteTimerTask"},
 		PersistenceRangeCompleteTimerTaskScope:                   {operation: "RangeCompleteTimerTask"},
@@ -968,6 +1075,9 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		PersistenceGetTasksScope:                                 {operation: "GetTasks"},
 		PersistenceCompleteTaskScope:                             {operation: "CompleteTask"},
 		PersistenceCompleteTasksLessThanScope:                    {operation: "CompleteTasksLessThan"},
+		PersistenceCreateTaskSegmentScope:                        {operation: "CreateTaskSegment"},
+		PersistenceGetTaskSegmentsScope:                          {operation: "GetTaskSegments"},
+		PersistenceCompleteTaskSegmentsLessThanScope:             {operation: "CompleteTaskSegmentsLessThan"},
 		PersistenceLeaseTaskListScope:                            {operation: "LeaseTaskList"},
 		PersistenceUpdateTaskListScope:                           {operation: "UpdateTaskList"},
 		PersistenceListTaskListScope:                             {operation: "ListTaskList"},
@@ -1041,6 +1151,13 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryClientGetReplicationTasksScope:               {operation: "HistoryClientGetReplicationTasksScope", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientQueryWorkflowScope:                     {operation: "HistoryClientQueryWorkflowScope", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientReapplyEventsScope:                     {operation: "HistoryClientReapplyEventsScope", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientGetDLQReplicationMessagesScope:         {operation: "HistoryClientGetDLQReplicationMessagesScope", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientReadDLQMessagesScope:                   {operation: "HistoryClientReadDLQMessagesScope", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientPurgeDLQMessagesScope:                  {operation: "HistoryClientPurgeDLQMessagesScope", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientMergeDLQMessagesScope:                  {operation: "HistoryClientMergeDLQMessagesScope", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientGetReplicationAckLevelsScope:           {operation: "HistoryClientGetReplicationAckLevelsScope", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientDescribeReplicationScope:               {operation: "HistoryClientDescribeReplicationScope", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientBackfillReplicationScope:               {operation: "HistoryClientBackfillReplicationScope", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
 		MatchingClientPollForDecisionTaskScope:              {operation: "MatchingClientPollForDecisionTask", tags: map[string]string{CadenceRoleTagName: MatchingRoleTagValue}},
 		MatchingClientPollForActivityTaskScope:              {operation: "MatchingClientPollForActivityTask", tags: map[string]string{CadenceRoleTagName: MatchingRoleTagValue}},
 		MatchingClientAddActivityTaskScope:                  {operation: "MatchingClientAddActivityTask", tags: map[string]string{CadenceRoleTagName: MatchingRoleTagValue}},
@@ -1049,6 +1166,8 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		MatchingClientRespondQueryTaskCompletedScope:        {operation: "MatchingClientRespondQueryTaskCompleted", tags: map[string]string{CadenceRoleTagName: MatchingRoleTagValue}},
 		MatchingClientCancelOutstandingPollScope:            {operation: "MatchingClientCancelOutstandingPoll", tags: map[string]string{CadenceRoleTagName: MatchingRoleTagValue}},
 		MatchingClientDescribeTaskListScope:                 {operation: "MatchingClientDescribeTaskList", tags: map[string]string{CadenceRoleTagName: MatchingRoleTagValue}},
+		MatchingClientRecordTaskCompletionScope:             {operation: "MatchingClientRecordTaskCompletion", tags: map[string]string{CadenceRoleTagName: MatchingRoleTagValue}},
+		MatchingClientDrainTaskListScope:                    {operation: "MatchingClientDrainTaskList", tags: map[string]string{CadenceRoleTagName: MatchingRoleTagValue}},
 		FrontendClientDeprecateDomainScope:                  {operation: "FrontendClientDeprecateDomain", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
 		FrontendClientDescribeDomainScope:                   {operation: "FrontendClientDescribeDomain", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
 		FrontendClientDescribeTaskListScope:                 {operation: "FrontendClientDescribeTaskList", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
@@ -1089,11 +1208,23 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		FrontendClientGetDomainReplicationTasksScope:        {operation: "FrontendClientGetDomainReplicationTasksScope", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
 		FrontendClientReapplyEventsScope:                    {operation: "FrontendClientReapplyEventsScope", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
 		AdminClientAddSearchAttributeScope:                  {operation: "AdminClientAddSearchAttribute", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientUpdateSearchAttributesScope:              {operation: "AdminClientUpdateSearchAttributes", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientDescribeSearchAttributesScope:            {operation: "AdminClientDescribeSearchAttributes", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
 		AdminClientDescribeHistoryHostScope:                 {operation: "AdminClientDescribeHistoryHost", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
 		AdminClientDescribeWorkflowExecutionScope:           {operation: "AdminClientDescribeWorkflowExecution", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
 		AdminClientGetWorkflowExecutionRawHistoryScope:      {operation: "AdminClientGetWorkflowExecutionRawHistory", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
 		AdminClientGetWorkflowExecutionRawHistoryV2Scope:    {operation: "AdminClientGetWorkflowExecutionRawHistoryV2", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
 		AdminClientCloseShardScope:                          {operation: "AdminClientCloseShard", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientDrainTaskListScope:                       {operation: "AdminClientDrainTaskList", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientGetDLQReplicationMessagesScope:           {operation: "AdminClientGetDLQReplicationMessages", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientReadDLQMessagesScope:                     {operation: "AdminClientReadDLQMessages", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientPurgeDLQMessagesScope:                    {operation: "AdminClientPurgeDLQMessages", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientMergeDLQMessagesScope:                    {operation: "AdminClientMergeDLQMessages", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientDescribeReplicationScope:                 {operation: "AdminClientDescribeReplication", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientStartChildWorkflowExecutionScope:         {operation: "AdminClientStartChildWorkflowExecution", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientSignalExternalWorkflowExecutionScope:     {operation: "AdminClientSignalExternalWorkflowExecution", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientRecordChildExecutionCompletedScope:       {operation: "AdminClientRecordChildExecutionCompleted", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientRemoveSignalMutableStateScope:            {operation: "AdminClientRemoveSignalMutableState", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
 		DCRedirectionDeprecateDomainScope:                   {operation: "DCRedirectionDeprecateDomain", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionDescribeDomainScope:                    {operation: "DCRedirectionDescribeDomain", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionDescribeTaskListScope:                  {operation: "DCRedirectionDescribeTaskList", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
@@ -1177,6 +1308,18 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		AdminDescribeWorkflowExecutionScope:        {operation: "DescribeWorkflowExecution"},
 		AdminGetWorkflowExecutionRawHistoryScope:   {operation: "GetWorkflowExecutionRawHistory"},
 		AdminGetWorkflowExecutionRawHistoryV2Scope: {operation: "GetWorkflowExecutionRawHistoryV2"},
+		AdminDrainTaskListScope:                    {operation: "DrainTaskList"},
+		AdminUpdateSearchAttributesScope:           {operation: "AdminUpdateSearchAttributes"},
+		AdminDescribeSearchAttributesScope:         {operation: "AdminDescribeSearchAttributes"},
+		AdminGetDLQReplicationMessagesScope:        {operation: "AdminGetDLQReplicationMessages"},
+		AdminReadDLQMessagesScope:                  {operation: "AdminReadDLQMessages"},
+		AdminPurgeDLQMessagesScope:                 {operation: "AdminPurgeDLQMessages"},
+		AdminMergeDLQMessagesScope:                 {operation: "AdminMergeDLQMessages"},
+		AdminDescribeReplicationScope:              {operation: "AdminDescribeReplication"},
+		AdminStartChildWorkflowExecutionScope:      {operation: "AdminStartChildWorkflowExecution"},
+		AdminSignalExternalWorkflowExecutionScope:  {operation: "AdminSignalExternalWorkflowExecution"},
+		AdminRecordChildExecutionCompletedScope:    {operation: "AdminRecordChildExecutionCompleted"},
+		AdminRemoveSignalMutableStateScope:         {operation: "AdminRemoveSignalMutableState"},
 
 		FrontendStartWorkflowExecutionScope:           {operation: "StartWorkflowExecution"},
 		FrontendPollForDecisionTaskScope:              {operation: "PollForDecisionTask"},
@@ -1218,6 +1361,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		FrontendGetDomainReplicationMessagesScope:     {operation: "GetDomainReplicationMessages"},
 		FrontendReapplyEventsScope:                    {operation: "ReapplyEvents"},
 		FrontendDomainReplicationQueueScope:           {operation: "DomainReplicationQueue"},
+		FrontendDomainFailoverCoordinatorScope:        {operation: "DomainFailoverCoordinator"},
 	},
 	// History Scope Names
 	History: {
@@ -1250,6 +1394,13 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistorySyncActivityScope:                               {operation: "SyncActivity"},
 		HistoryDescribeMutableStateScope:                       {operation: "DescribeMutableState"},
 		HistoryGetReplicationMessagesScope:                     {operation: "GetReplicationMessages"},
+		HistoryGetDLQReplicationMessagesScope:                  {operation: "GetDLQReplicationMessages"},
+		HistoryReadDLQMessagesScope:                            {operation: "ReadDLQMessages"},
+		HistoryPurgeDLQMessagesScope:                           {operation: "PurgeDLQMessages"},
+		HistoryMergeDLQMessagesScope:                           {operation: "MergeDLQMessages"},
+		HistoryGetReplicationAckLevelsScope:                    {operation: "GetReplicationAckLevels"},
+		HistoryDescribeReplicationScope:                        {operation: "DescribeReplication"},
+		HistoryBackfillReplicationScope:                        {operation: "BackfillReplication"},
 		HistoryShardControllerScope:                            {operation: "ShardController"},
 		HistoryReapplyEventsScope:                              {operation: "EventReapplication"},
 		TransferQueueProcessorScope:                            {operation: "TransferQueueProcessor"},
@@ -1312,6 +1463,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		WorkflowCompletionStatsScope:                           {operation: "CompletionStats", tags: map[string]string{StatsTypeTagName: CountStatsTypeTagValue}},
 		ArchiverClientScope:                                    {operation: "ArchiverClient"},
 		ReplicationTaskFetcherScope:                            {operation: "ReplicationTaskFetcher"},
+		ReplicationDLQStatsScope:                               {operation: "ReplicationDLQStats"},
 	},
 	// Matching Scope Names
 	Matching: {
@@ -1324,6 +1476,8 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		MatchingRespondQueryTaskCompletedScope: {operation: "RespondQueryTaskCompleted"},
 		MatchingCancelOutstandingPollScope:     {operation: "CancelOutstandingPoll"},
 		MatchingDescribeTaskListScope:          {operation: "DescribeTaskList"},
+		MatchingRecordTaskCompletionScope:      {operation: "RecordTaskCompletion"},
+		MatchingDrainTaskListScope:             {operation: "DrainTaskList"},
 	},
 	// Worker Scope Names
 	Worker: {
@@ -1346,6 +1500,8 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryScavengerScope:                  {operation: "historyscavenger"},
 		BatcherScope:                           {operation: "batcher"},
 		ParentClosePolicyProcessorScope:        {operation: "ParentClosePolicyProcessor"},
+		FailoverManagerScope:                   {operation: "FailoverManager"},
+		ReplicationVerifierScope:               {operation: "replicationverifier"},
 	},
 }
 
@@ -1382,6 +1538,7 @@ const (
 	PersistenceErrDomainAlreadyExistsCounter
 	PersistenceErrBadRequestCounter
 	PersistenceSampledCounter
+	PersistenceVisibilityMigrationTargetFailures
 
 	CadenceClientRequests
 	CadenceClientFailures
@@ -1444,6 +1601,8 @@ const (
 // Frontend Metrics enum
 const (
 	DomainReplicationTaskAckLevel = iota + NumCommonMetrics
+	GracefulFailoverCompleted
+	GracefulFailoverTimedOut
 
 	NumFrontendMetrics
 )
@@ -1483,6 +1642,14 @@ const (
 	StaleMutableStateCounter
 	AutoResetPointsLimitExceededCounter
 	AutoResetPointCorruptionCounter
+	OpenWorkflowsLimitExceededCounter
+	OpenWorkflowsCountNotAvailableCounter
+	DomainHistorySizeLimitExceededCounter
+	PendingActivitiesLimitExceededCounter
+	PendingChildWorkflowsLimitExceededCounter
+	PendingTimersLimitExceededCounter
+	SignalsLimitExceededCounter
+	HistorySizeLimitExceededCounter
 	ConcurrencyUpdateFailureCounter
 	CadenceErrEventAlreadyStartedCounter
 	CadenceErrShardOwnershipLostCounter
@@ -1581,7 +1748,12 @@ const (
 	ReplicationTasksLag
 	ReplicationTasksFetched
 	ReplicationTasksReturned
+	ReplicationDLQSize
+	ReplicationTasksEnqueuedToDLQ
+	ReplicationDLQMessagesMerged
+	ReplicationDLQMessagesPurged
 	GetReplicationMessagesForShardLatency
+	GetDLQReplicationMessagesLatency
 	EventReapplySkippedCount
 	DirectQueryDispatchLatency
 	DecisionTaskQueryLatency
@@ -1605,6 +1777,7 @@ const (
 	RespondQueryTaskFailedCounter
 	SyncThrottleCounter
 	BufferThrottleCounter
+	ActivityTypeThrottleCounter
 	SyncMatchLatency
 	AsyncMatchLatency
 	ExpiredTasksCounter
@@ -1622,6 +1795,15 @@ const (
 	LocalToRemoteMatchCounter
 	RemoteToLocalMatchCounter
 	RemoteToRemoteMatchCounter
+	BacklogTasksWritten
+	BacklogTasksRead
+	BacklogWriteLatency
+	BacklogReadLatency
+	BacklogDeleteLatency
+	AffinityNoPollerFallbackCounter
+	AffinityTimeoutFallbackCounter
+	DrainedTaskRedirectCounter
+	DrainedTaskRejectCounter
 
 	NumMatchingMetrics
 )
@@ -1681,6 +1863,12 @@ const (
 	HistoryScavengerSkipCount
 	ParentClosePolicyProcessorSuccess
 	ParentClosePolicyProcessorFailures
+	FailoverManagerDomainFailoverSuccess
+	FailoverManagerDomainFailoverFailures
+	ReplicationVerifierVerifiedCount
+	ReplicationVerifierMismatchCount
+	ReplicationVerifierRepairedCount
+	ReplicationVerifierErrorCount
 
 	NumWorkerMetrics
 )
@@ -1719,6 +1907,7 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		PersistenceErrDomainAlreadyExistsCounter:            {metricName: "persistence_errors_domain_already_exists", metricType: Counter},
 		PersistenceErrBadRequestCounter:                     {metricName: "persistence_errors_bad_request", metricType: Counter},
 		PersistenceSampledCounter:                           {metricName: "persistence_sampled", metricType: Counter},
+		PersistenceVisibilityMigrationTargetFailures:        {metricName: "persistence_visibility_migration_target_errors", metricType: Counter},
 		CadenceClientRequests:                               {metricName: "cadence_client_requests", metricType: Counter},
 		CadenceClientFailures:                               {metricName: "cadence_client_errors", metricType: Counter},
 		CadenceClientLatency:                                {metricName: "cadence_client_latency", metricType: Timer},
@@ -1765,6 +1954,8 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 	},
 	Frontend: {
 		DomainReplicationTaskAckLevel: {metricName: "domain_replication_task_ack_level", metricType: Gauge},
+		GracefulFailoverCompleted:     {metricName: "graceful_failover_completed", metricType: Counter},
+		GracefulFailoverTimedOut:      {metricName: "graceful_failover_timed_out", metricType: Counter},
 	},
 	History: {
 		TaskRequests:                                      {metricName: "task_requests", metricType: Counter},
@@ -1799,6 +1990,14 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		StaleMutableStateCounter:                          {metricName: "stale_mutable_state", metricType: Counter},
 		AutoResetPointsLimitExceededCounter:               {metricName: "auto_reset_points_exceed_limit", metricType: Counter},
 		AutoResetPointCorruptionCounter:                   {metricName: "auto_reset_point_corruption", metricType: Counter},
+		OpenWorkflowsLimitExceededCounter:                 {metricName: "open_workflows_limit_exceeded", metricType: Counter},
+		OpenWorkflowsCountNotAvailableCounter:             {metricName: "open_workflows_count_not_available", metricType: Counter},
+		DomainHistorySizeLimitExceededCounter:             {metricName: "domain_history_size_limit_exceeded", metricType: Counter},
+		PendingActivitiesLimitExceededCounter:             {metricName: "pending_activities_limit_exceeded", metricType: Counter},
+		PendingChildWorkflowsLimitExceededCounter:         {metricName: "pending_child_workflows_limit_exceeded", metricType: Counter},
+		PendingTimersLimitExceededCounter:                 {metricName: "pending_timers_limit_exceeded", metricType: Counter},
+		SignalsLimitExceededCounter:                       {metricName: "signals_limit_exceeded", metricType: Counter},
+		HistorySizeLimitExceededCounter:                   {metricName: "history_size_limit_exceeded", metricType: Counter},
 		ConcurrencyUpdateFailureCounter:                   {metricName: "concurrency_update_failure", metricType: Counter},
 		CadenceErrShardOwnershipLostCounter:               {metricName: "cadence_errors_shard_ownership_lost", metricType: Counter},
 		CadenceErrEventAlreadyStartedCounter:              {metricName: "cadence_errors_event_already_started", metricType: Counter},
@@ -1897,7 +2096,12 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		ReplicationTasksLag:                               {metricName: "replication_tasks_lag", metricType: Timer},
 		ReplicationTasksFetched:                           {metricName: "replication_tasks_fetched", metricType: Timer},
 		ReplicationTasksReturned:                          {metricName: "replication_tasks_returned", metricType: Timer},
+		ReplicationDLQSize:                                {metricName: "replication_dlq_size", metricType: Gauge},
+		ReplicationTasksEnqueuedToDLQ:                     {metricName: "replication_tasks_enqueued_to_dlq", metricType: Counter},
+		ReplicationDLQMessagesMerged:                      {metricName: "replication_dlq_messages_merged", metricType: Counter},
+		ReplicationDLQMessagesPurged:                      {metricName: "replication_dlq_messages_purged", metricType: Counter},
 		GetReplicationMessagesForShardLatency:             {metricName: "get_replication_messages_for_shard", metricType: Timer},
+		GetDLQReplicationMessagesLatency:                  {metricName: "get_dlq_replication_messages", metricType: Timer},
 		EventReapplySkippedCount:                          {metricName: "event_reapply_skipped_count", metricType: Counter},
 		DirectQueryDispatchLatency:                        {metricName: "direct_query_dispatch_latency", metricType: Timer},
 		DecisionTaskQueryLatency:                          {metricName: "decision_task_query_latency", metricType: Timer},
@@ -1908,32 +2112,42 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		DecisionStartToCloseTimeoutOverrideCount:          {metricName: "decision_start_to_close_timeout_overrides", metricType: Counter},
 	},
 	Matching: {
-		PollSuccessCounter:            {metricName: "poll_success"},
-		PollTimeoutCounter:            {metricName: "poll_timeouts"},
-		PollSuccessWithSyncCounter:    {metricName: "poll_success_sync"},
-		LeaseRequestCounter:           {metricName: "lease_requests"},
-		LeaseFailureCounter:           {metricName: "lease_failures"},
-		ConditionFailedErrorCounter:   {metricName: "condition_failed_errors"},
-		RespondQueryTaskFailedCounter: {metricName: "respond_query_failed"},
-		SyncThrottleCounter:           {metricName: "sync_throttle_count"},
-		BufferThrottleCounter:         {metricName: "buffer_throttle_count"},
-		ExpiredTasksCounter:           {metricName: "tasks_expired"},
-		ForwardedCounter:              {metricName: "forwarded"},
-		ForwardTaskCalls:              {metricName: "forward_task_calls"},
-		ForwardTaskErrors:             {metricName: "forward_task_errors"},
-		ForwardQueryCalls:             {metricName: "forward_query_calls"},
-		ForwardQueryErrors:            {metricName: "forward_query_errors"},
-		ForwardPollCalls:              {metricName: "forward_poll_calls"},
-		ForwardPollErrors:             {metricName: "forward_poll_errors"},
-		SyncMatchLatency:              {metricName: "syncmatch_latency", metricType: Timer},
-		AsyncMatchLatency:             {metricName: "asyncmatch_latency", metricType: Timer},
-		ForwardTaskLatency:            {metricName: "forward_task_latency"},
-		ForwardQueryLatency:           {metricName: "forward_query_latency"},
-		ForwardPollLatency:            {metricName: "forward_poll_latency"},
-		LocalToLocalMatchCounter:      {metricName: "local_to_local_matches"},
-		LocalToRemoteMatchCounter:     {metricName: "local_to_remote_matches"},
-		RemoteToLocalMatchCounter:     {metricName: "remote_to_local_matches"},
-		RemoteToRemoteMatchCounter:    {metricName: "remote_to_remote_matches"},
+		PollSuccessCounter:              {metricName: "poll_success"},
+		PollTimeoutCounter:              {metricName: "poll_timeouts"},
+		PollSuccessWithSyncCounter:      {metricName: "poll_success_sync"},
+		LeaseRequestCounter:             {metricName: "lease_requests"},
+		LeaseFailureCounter:             {metricName: "lease_failures"},
+		ConditionFailedErrorCounter:     {metricName: "condition_failed_errors"},
+		RespondQueryTaskFailedCounter:   {metricName: "respond_query_failed"},
+		SyncThrottleCounter:             {metricName: "sync_throttle_count"},
+		BufferThrottleCounter:           {metricName: "buffer_throttle_count"},
+		ActivityTypeThrottleCounter:     {metricName: "activity_type_throttle_count"},
+		ExpiredTasksCounter:             {metricName: "tasks_expired"},
+		ForwardedCounter:                {metricName: "forwarded"},
+		ForwardTaskCalls:                {metricName: "forward_task_calls"},
+		ForwardTaskErrors:               {metricName: "forward_task_errors"},
+		ForwardQueryCalls:               {metricName: "forward_query_calls"},
+		ForwardQueryErrors:              {metricName: "forward_query_errors"},
+		ForwardPollCalls:                {metricName: "forward_poll_calls"},
+		ForwardPollErrors:               {metricName: "forward_poll_errors"},
+		SyncMatchLatency:                {metricName: "syncmatch_latency", metricType: Timer},
+		AsyncMatchLatency:               {metricName: "asyncmatch_latency", metricType: Timer},
+		ForwardTaskLatency:              {metricName: "forward_task_latency"},
+		ForwardQueryLatency:             {metricName: "forward_query_latency"},
+		ForwardPollLatency:              {metricName: "forward_poll_latency"},
+		LocalToLocalMatchCounter:        {metricName: "local_to_local_matches"},
+		LocalToRemoteMatchCounter:       {metricName: "local_to_remote_matches"},
+		RemoteToLocalMatchCounter:       {metricName: "remote_to_local_matches"},
+		RemoteToRemoteMatchCounter:      {metricName: "remote_to_remote_matches"},
+		BacklogTasksWritten:             {metricName: "backlog_tasks_written"},
+		BacklogTasksRead:                {metricName: "backlog_tasks_read"},
+		BacklogWriteLatency:             {metricName: "backlog_write_latency", metricType: Timer},
+		BacklogReadLatency:              {metricName: "backlog_read_latency", metricType: Timer},
+		BacklogDeleteLatency:            {metricName: "backlog_delete_latency", metricType: Timer},
+		AffinityNoPollerFallbackCounter: {metricName: "affinity_no_poller_fallback"},
+		AffinityTimeoutFallbackCounter:  {metricName: "affinity_timeout_fallback"},
+		DrainedTaskRedirectCounter:      {metricName: "drained_task_redirect"},
+		DrainedTaskRejectCounter:        {metricName: "drained_task_reject"},
 	},
 	Worker: {
 		ReplicatorMessages:                            {metricName: "replicator_messages"},
@@ -1989,6 +2203,12 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		HistoryScavengerSkipCount:                     {metricName: "scavenger_skips", metricType: Counter},
 		ParentClosePolicyProcessorSuccess:             {metricName: "parent_close_policy_processor_requests", metricType: Counter},
 		ParentClosePolicyProcessorFailures:            {metricName: "parent_close_policy_processor_errors", metricType: Counter},
+		FailoverManagerDomainFailoverSuccess:          {metricName: "failover_manager_domain_failover_success", metricType: Counter},
+		FailoverManagerDomainFailoverFailures:         {metricName: "failover_manager_domain_failover_errors", metricType: Counter},
+		ReplicationVerifierVerifiedCount:              {metricName: "replication_verifier_verified", metricType: Counter},
+		ReplicationVerifierMismatchCount:              {metricName: "replication_verifier_mismatches", metricType: Counter},
+		ReplicationVerifierRepairedCount:              {metricName: "replication_verifier_repaired", metricType: Counter},
+		ReplicationVerifierErrorCount:                 {metricName: "replication_verifier_errors", metricType: Counter},
 	},
 }
 
@@ -31,6 +31,7 @@ const (
 	domain        = "domain"
 	targetCluster = "target_cluster"
 	taskList      = "tasklist"
+	backlogLayout = "backlog_layout"
 
 	domainAllValue = "all"
 	unknownValue   = "_unknown_"
@@ -60,6 +61,10 @@ type (
 	taskListTag struct {
 		value string
 	}
+
+	backlogLayoutTag struct {
+		value string
+	}
 )
 
 // DomainTag returns a new domain tag. For timers, this also ensures that we
@@ -147,3 +152,18 @@ func (d taskListTag) Key() string {
 func (d taskListTag) Value() string {
 	return d.value
 }
+
+// BacklogLayoutTag returns a new backlog layout tag.
+func BacklogLayoutTag(value string) Tag {
+	return backlogLayoutTag{value}
+}
+
+// Key returns the key of the backlog layout tag
+func (d backlogLayoutTag) Key() string {
+	return backlogLayout
+}
+
+// Value returns the value of the backlog layout tag
+func (d backlogLayoutTag) Value() string {
+	return d.value
+}
@@ -206,3 +206,70 @@ func (_m *TaskManager) GetTasks(request *persistence.GetTasksRequest) (*persiste
 
 	return r0, r1
 }
+
+// CreateTaskSegment provides a mock function with given fields: request
+func (_m *TaskManager) CreateTaskSegment(request *persistence.CreateTasksRequest) (*persistence.CreateTasksResponse, error) {
+	ret := _m.Called(request)
+
+	var r0 *persistence.CreateTasksResponse
+	if rf, ok := ret.Get(0).(func(*persistence.CreateTasksRequest) *persistence.CreateTasksResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*persistence.CreateTasksResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*persistence.CreateTasksRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTaskSegments provides a mock function with given fields: request
+func (_m *TaskManager) GetTaskSegments(request *persistence.GetTasksRequest) (*persistence.GetTasksResponse, error) {
+	ret := _m.Called(request)
+
+	var r0 *persistence.GetTasksResponse
+	if rf, ok := ret.Get(0).(func(*persistence.GetTasksRequest) *persistence.GetTasksResponse); ok {
+		r0 = rf(request)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*persistence.GetTasksResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*persistence.GetTasksRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CompleteTaskSegmentsLessThan provides a mock function with given fields: request
+func (_m *TaskManager) CompleteTaskSegmentsLessThan(request *persistence.CompleteTasksLessThanRequest) (int, error) {
+	ret := _m.Called(request)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(*persistence.CompleteTasksLessThanRequest) int); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(int)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*persistence.CompleteTasksLessThanRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
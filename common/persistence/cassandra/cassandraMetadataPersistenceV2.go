@@ -79,8 +79,9 @@ const (
 		`WHERE id = ?`
 
 	templateCreateDomainByNameQueryWithinBatchV2 = `INSERT INTO domains_by_name_v2 (` +
-		`domains_partition, name, domain, config, replication_config, is_global_domain, config_version, failover_version, failover_notification_version, notification_version) ` +
-		`VALUES(?, ?, ` + templateDomainInfoType + `, ` + templateDomainConfigType + `, ` + templateDomainReplicationConfigType + `, ?, ?, ?, ?, ?) IF NOT EXISTS`
+		`domains_partition, name, domain, config, replication_config, is_global_domain, config_version, failover_version, failover_notification_version, notification_version, ` +
+		`replication_filter, replication_filter_encoding) ` +
+		`VALUES(?, ?, ` + templateDomainInfoType + `, ` + templateDomainConfigType + `, ` + templateDomainReplicationConfigType + `, ?, ?, ?, ?, ?, ?, ?) IF NOT EXISTS`
 
 	templateGetDomainByNameQueryV2 = `SELECT domain.id, domain.name, domain.status, domain.description, ` +
 		`domain.owner_email, domain.data, config.retention, config.emit_metric, ` +
@@ -93,7 +94,10 @@ const (
 		`config_version, ` +
 		`failover_version, ` +
 		`failover_notification_version, ` +
-		`notification_version ` +
+		`notification_version, ` +
+		`failover_info, failover_info_encoding, ` +
+		`failover_history, failover_history_encoding, ` +
+		`replication_filter, replication_filter_encoding ` +
 		`FROM domains_by_name_v2 ` +
 		`WHERE domains_partition = ? ` +
 		`and name = ?`
@@ -102,10 +106,17 @@ const (
 		`SET domain = ` + templateDomainInfoType + `, ` +
 		`config = ` + templateDomainConfigType + `, ` +
 		`replication_config = ` + templateDomainReplicationConfigType + `, ` +
+		`is_global_domain = ? , ` +
 		`config_version = ? ,` +
 		`failover_version = ? ,` +
 		`failover_notification_version = ? , ` +
-		`notification_version = ? ` +
+		`notification_version = ? , ` +
+		`failover_info = ? , ` +
+		`failover_info_encoding = ? , ` +
+		`failover_history = ? , ` +
+		`failover_history_encoding = ? , ` +
+		`replication_filter = ? , ` +
+		`replication_filter_encoding = ? ` +
 		`WHERE domains_partition = ? ` +
 		`and name = ?`
 
@@ -135,7 +146,10 @@ const (
 		`config_version, ` +
 		`failover_version, ` +
 		`failover_notification_version, ` +
-		`notification_version ` +
+		`notification_version, ` +
+		`failover_info, failover_info_encoding, ` +
+		`failover_history, failover_history_encoding, ` +
+		`replication_filter, replication_filter_encoding ` +
 		`FROM domains_by_name_v2 ` +
 		`WHERE domains_partition = ? `
 )
@@ -202,6 +216,8 @@ func (m *cassandraMetadataPersistenceV2) CreateDomainInV2Table(request *p.Intern
 		return nil, err
 	}
 
+	replicationFilterData, replicationFilterEncoding := p.FromDataBlob(request.ReplicationFilter)
+
 	batch := m.session.NewBatch(gocql.LoggedBatch)
 	batch.Query(templateCreateDomainByNameQueryWithinBatchV2,
 		constDomainPartition,
@@ -229,6 +245,8 @@ func (m *cassandraMetadataPersistenceV2) CreateDomainInV2Table(request *p.Intern
 		request.FailoverVersion,
 		p.InitialFailoverNotificationVersion,
 		metadata.NotificationVersion,
+		replicationFilterData,
+		replicationFilterEncoding,
 	)
 	m.updateMetadataBatch(batch, metadata.NotificationVersion)
 
@@ -268,6 +286,10 @@ func (m *cassandraMetadataPersistenceV2) CreateDomainInV2Table(request *p.Intern
 }
 
 func (m *cassandraMetadataPersistenceV2) UpdateDomain(request *p.InternalUpdateDomainRequest) error {
+	failoverInfoData, failoverInfoEncoding := p.FromDataBlob(request.FailoverInfo)
+	failoverHistoryData, failoverHistoryEncoding := p.FromDataBlob(request.FailoverHistory)
+	replicationFilterData, replicationFilterEncoding := p.FromDataBlob(request.ReplicationFilter)
+
 	batch := m.session.NewBatch(gocql.LoggedBatch)
 	batch.Query(templateUpdateDomainByNameQueryWithinBatchV2,
 		request.Info.ID,
@@ -288,10 +310,17 @@ func (m *cassandraMetadataPersistenceV2) UpdateDomain(request *p.InternalUpdateD
 		string(request.Config.BadBinaries.GetEncoding()),
 		request.ReplicationConfig.ActiveClusterName,
 		p.SerializeClusterConfigs(request.ReplicationConfig.Clusters),
+		request.IsGlobalDomain,
 		request.ConfigVersion,
 		request.FailoverVersion,
 		request.FailoverNotificationVersion,
 		request.NotificationVersion,
+		failoverInfoData,
+		failoverInfoEncoding,
+		failoverHistoryData,
+		failoverHistoryEncoding,
+		replicationFilterData,
+		replicationFilterEncoding,
 		constDomainPartition,
 		request.Info.Name,
 	)
@@ -368,6 +397,12 @@ func (m *cassandraMetadataPersistenceV2) GetDomain(request *p.GetDomainRequest)
 
 	var badBinariesData []byte
 	var badBinariesDataEncoding string
+	var failoverInfoData []byte
+	var failoverInfoDataEncoding string
+	var failoverHistoryData []byte
+	var failoverHistoryDataEncoding string
+	var replicationFilterData []byte
+	var replicationFilterDataEncoding string
 
 	query = m.session.Query(templateGetDomainByNameQueryV2, constDomainPartition, domainName)
 	err = query.Scan(
@@ -394,6 +429,12 @@ func (m *cassandraMetadataPersistenceV2) GetDomain(request *p.GetDomainRequest)
 		&failoverVersion,
 		&failoverNotificationVersion,
 		&notificationVersion,
+		&failoverInfoData,
+		&failoverInfoDataEncoding,
+		&failoverHistoryData,
+		&failoverHistoryDataEncoding,
+		&replicationFilterData,
+		&replicationFilterDataEncoding,
 	)
 
 	if err != nil {
@@ -417,6 +458,9 @@ func (m *cassandraMetadataPersistenceV2) GetDomain(request *p.GetDomainRequest)
 		FailoverVersion:             failoverVersion,
 		FailoverNotificationVersion: failoverNotificationVersion,
 		NotificationVersion:         notificationVersion,
+		FailoverInfo:                p.NewDataBlob(failoverInfoData, common.EncodingType(failoverInfoDataEncoding)),
+		FailoverHistory:             p.NewDataBlob(failoverHistoryData, common.EncodingType(failoverHistoryDataEncoding)),
+		ReplicationFilter:           p.NewDataBlob(replicationFilterData, common.EncodingType(replicationFilterDataEncoding)),
 	}, nil
 }
 
@@ -440,6 +484,12 @@ func (m *cassandraMetadataPersistenceV2) ListDomains(request *p.ListDomainsReque
 	var replicationClusters []map[string]interface{}
 	var badBinariesData []byte
 	var badBinariesDataEncoding string
+	var failoverInfoData []byte
+	var failoverInfoDataEncoding string
+	var failoverHistoryData []byte
+	var failoverHistoryDataEncoding string
+	var replicationFilterData []byte
+	var replicationFilterDataEncoding string
 	response := &p.InternalListDomainsResponse{}
 	for iter.Scan(
 		&name,
@@ -466,6 +516,12 @@ func (m *cassandraMetadataPersistenceV2) ListDomains(request *p.ListDomainsReque
 		&domain.FailoverVersion,
 		&domain.FailoverNotificationVersion,
 		&domain.NotificationVersion,
+		&failoverInfoData,
+		&failoverInfoDataEncoding,
+		&failoverHistoryData,
+		&failoverHistoryDataEncoding,
+		&replicationFilterData,
+		&replicationFilterDataEncoding,
 	) {
 		if name != domainMetadataRecordName {
 			// do not include the metadata record
@@ -475,6 +531,15 @@ func (m *cassandraMetadataPersistenceV2) ListDomains(request *p.ListDomainsReque
 			domain.Config.BadBinaries = p.NewDataBlob(badBinariesData, common.EncodingType(badBinariesDataEncoding))
 			badBinariesData = []byte("")
 			badBinariesDataEncoding = ""
+			domain.FailoverInfo = p.NewDataBlob(failoverInfoData, common.EncodingType(failoverInfoDataEncoding))
+			failoverInfoData = []byte("")
+			failoverInfoDataEncoding = ""
+			domain.FailoverHistory = p.NewDataBlob(failoverHistoryData, common.EncodingType(failoverHistoryDataEncoding))
+			failoverHistoryData = []byte("")
+			failoverHistoryDataEncoding = ""
+			domain.ReplicationFilter = p.NewDataBlob(replicationFilterData, common.EncodingType(replicationFilterDataEncoding))
+			replicationFilterData = []byte("")
+			replicationFilterDataEncoding = ""
 			domain.ReplicationConfig.ActiveClusterName = p.GetOrUseDefaultActiveCluster(m.currentClusterName, domain.ReplicationConfig.ActiveClusterName)
 			domain.ReplicationConfig.Clusters = p.DeserializeClusterConfigs(replicationClusters)
 			domain.ReplicationConfig.Clusters = p.GetOrUseDefaultClusters(m.currentClusterName, domain.ReplicationConfig.Clusters)
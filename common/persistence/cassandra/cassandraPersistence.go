@@ -95,6 +95,7 @@ const (
 	// Row types for table tasks
 	rowTypeTask = iota
 	rowTypeTaskList
+	rowTypeTaskSegment
 )
 
 const (
@@ -268,6 +269,7 @@ const (
 		`last_failure_reason: ?, ` +
 		`last_worker_identity: ?, ` +
 		`last_failure_details: ?, ` +
+		`started_task_list: ?, ` +
 		`event_data_encoding: ?` +
 		`}`
 
@@ -318,7 +320,9 @@ const (
 		`type: ?, ` +
 		`ack_level: ?, ` +
 		`kind: ?, ` +
-		`last_updated: ? ` +
+		`last_updated: ?, ` +
+		`draining: ?, ` +
+		`drain_target: ? ` +
 		`}`
 
 	templateTaskType = `{` +
@@ -326,7 +330,8 @@ const (
 		`workflow_id: ?, ` +
 		`run_id: ?, ` +
 		`schedule_id: ?,` +
-		`created_time: ? ` +
+		`created_time: ?, ` +
+		`activity_type: ? ` +
 		`}`
 
 	templateCreateShardQuery = `INSERT INTO executions (` +
@@ -715,6 +720,14 @@ workflow_state = ? ` +
 		`and task_id > ? ` +
 		`and task_id <= ?`
 
+	templateGetDLQSizeQuery = `SELECT count(1) as count ` +
+		`FROM executions ` +
+		`WHERE shard_id = ? ` +
+		`and type = ? ` +
+		`and domain_id = ? ` +
+		`and workflow_id = ? ` +
+		`and run_id = ?`
+
 	templateCompleteTransferTaskQuery = `DELETE FROM executions ` +
 		`WHERE shard_id = ? ` +
 		`and type = ? ` +
@@ -770,6 +783,22 @@ workflow_state = ? ` +
 		`domain_id, task_list_name, task_list_type, type, task_id, task) ` +
 		`VALUES(?, ?, ?, ?, ?, ` + templateTaskType + `) USING TTL ?`
 
+	templateCreateTaskSegmentQuery = `INSERT INTO tasks (` +
+		`domain_id, task_list_name, task_list_type, type, task_id, task_segment) ` +
+		`VALUES(?, ?, ?, ?, ?, ?)`
+
+	templateCreateTaskSegmentWithTTLQuery = `INSERT INTO tasks (` +
+		`domain_id, task_list_name, task_list_type, type, task_id, task_segment) ` +
+		`VALUES(?, ?, ?, ?, ?, ?) USING TTL ?`
+
+	templateGetTaskSegmentsQuery = `SELECT task_id, task_segment ` +
+		`FROM tasks ` +
+		`WHERE domain_id = ? ` +
+		`and task_list_name = ? ` +
+		`and task_list_type = ? ` +
+		`and type = ? ` +
+		`and task_id > ?`
+
 	templateGetTasksQuery = `SELECT task_id, task ` +
 		`FROM tasks ` +
 		`WHERE domain_id = ? ` +
@@ -2295,6 +2324,8 @@ func (d *cassandraPersistence) LeaseTaskList(request *p.LeaseTaskListRequest) (*
 		taskListTaskID,
 	)
 	var rangeID, ackLevel int64
+	var draining bool
+	var drainTarget string
 	var tlDB map[string]interface{}
 	err := query.Scan(&rangeID, &tlDB)
 	if err != nil {
@@ -2312,6 +2343,8 @@ func (d *cassandraPersistence) LeaseTaskList(request *p.LeaseTaskListRequest) (*
 				0,
 				request.TaskListKind,
 				now,
+				false,
+				"",
 			)
 		} else if isThrottlingError(err) {
 			return nil, &workflow.ServiceBusyError{
@@ -2336,6 +2369,8 @@ func (d *cassandraPersistence) LeaseTaskList(request *p.LeaseTaskListRequest) (*
 		}
 		ackLevel = tlDB["ack_level"].(int64)
 		taskListKind := tlDB["kind"].(int)
+		draining, _ = tlDB["draining"].(bool)
+		drainTarget, _ = tlDB["drain_target"].(string)
 		query = d.session.Query(templateUpdateTaskListQuery,
 			rangeID+1,
 			request.DomainID,
@@ -2344,6 +2379,8 @@ func (d *cassandraPersistence) LeaseTaskList(request *p.LeaseTaskListRequest) (*
 			ackLevel,
 			taskListKind,
 			now,
+			draining,
+			drainTarget,
 			request.DomainID,
 			&request.TaskList,
 			request.TaskType,
@@ -2379,6 +2416,8 @@ func (d *cassandraPersistence) LeaseTaskList(request *p.LeaseTaskListRequest) (*
 		AckLevel:    ackLevel,
 		Kind:        request.TaskListKind,
 		LastUpdated: now,
+		Draining:    draining,
+		DrainTarget: drainTarget,
 	}
 	return &p.LeaseTaskListResponse{TaskListInfo: tli}, nil
 }
@@ -2401,6 +2440,8 @@ func (d *cassandraPersistence) UpdateTaskList(request *p.UpdateTaskListRequest)
 			tli.AckLevel,
 			tli.Kind,
 			time.Now(),
+			tli.Draining,
+			tli.DrainTarget,
 			stickyTaskListTTL,
 		)
 		err := query.Exec()
@@ -2425,6 +2466,8 @@ func (d *cassandraPersistence) UpdateTaskList(request *p.UpdateTaskListRequest)
 		tli.AckLevel,
 		tli.Kind,
 		time.Now(),
+		tli.Draining,
+		tli.DrainTarget,
 		tli.DomainID,
 		&tli.Name,
 		tli.TaskType,
@@ -2496,8 +2539,6 @@ func (d *cassandraPersistence) CreateTasks(request *p.CreateTasksRequest) (*p.Cr
 	domainID := request.TaskListInfo.DomainID
 	taskList := request.TaskListInfo.Name
 	taskListType := request.TaskListInfo.TaskType
-	taskListKind := request.TaskListInfo.Kind
-	ackLevel := request.TaskListInfo.AckLevel
 	cqlNowTimestamp := p.UnixNanoToDBTimestamp(time.Now().UnixNano())
 
 	for _, task := range request.Tasks {
@@ -2514,7 +2555,8 @@ func (d *cassandraPersistence) CreateTasks(request *p.CreateTasksRequest) (*p.Cr
 				task.Execution.GetWorkflowId(),
 				task.Execution.GetRunId(),
 				scheduleID,
-				cqlNowTimestamp)
+				cqlNowTimestamp,
+				task.Data.ActivityType)
 		} else {
 			if ttl > maxCassandraTTL {
 				ttl = maxCassandraTTL
@@ -2530,25 +2572,105 @@ func (d *cassandraPersistence) CreateTasks(request *p.CreateTasksRequest) (*p.Cr
 				task.Execution.GetRunId(),
 				scheduleID,
 				cqlNowTimestamp,
+				task.Data.ActivityType,
 				ttl)
 		}
 	}
 
+	return d.executeCreateTasksBatch(batch, request.TaskListInfo, "CreateTasks")
+}
+
+// From TaskManager interface
+func (d *cassandraPersistence) CreateTaskSegment(request *p.CreateTasksRequest) (*p.CreateTasksResponse, error) {
+	if len(request.Tasks) == 0 {
+		return &p.CreateTasksResponse{}, nil
+	}
+
+	batch := d.session.NewBatch(gocql.LoggedBatch)
+	domainID := request.TaskListInfo.DomainID
+	now := time.Now()
+	cqlNowTimestamp := p.UnixNanoToDBTimestamp(now.UnixNano())
+
+	// the segment expires with its last task, unless one of its tasks never expires
+	ttl := int64(0)
+	neverExpires := false
+	segment := make([]map[string]interface{}, len(request.Tasks))
+	for i, task := range request.Tasks {
+		var expiryTime time.Time
+		taskTTL := int64(task.Data.ScheduleToStartTimeout)
+		if taskTTL > 0 {
+			expiryTime = now.Add(time.Duration(taskTTL) * time.Second)
+		}
+		if taskTTL <= 0 {
+			neverExpires = true
+		} else if taskTTL > ttl {
+			ttl = taskTTL
+		}
+		segment[i] = map[string]interface{}{
+			"domain_id":     domainID,
+			"workflow_id":   task.Execution.GetWorkflowId(),
+			"run_id":        task.Execution.GetRunId(),
+			"schedule_id":   task.Data.ScheduleID,
+			"created_time":  cqlNowTimestamp,
+			"activity_type": task.Data.ActivityType,
+			"task_id":       task.TaskID,
+			"expiry_time":   expiryTime,
+		}
+	}
+
+	// segments are keyed by the id of their last task
+	lastTaskID := request.Tasks[len(request.Tasks)-1].TaskID
+	if neverExpires {
+		batch.Query(templateCreateTaskSegmentQuery,
+			domainID,
+			request.TaskListInfo.Name,
+			request.TaskListInfo.TaskType,
+			rowTypeTaskSegment,
+			lastTaskID,
+			segment)
+	} else {
+		if ttl > maxCassandraTTL {
+			ttl = maxCassandraTTL
+		}
+		batch.Query(templateCreateTaskSegmentWithTTLQuery,
+			domainID,
+			request.TaskListInfo.Name,
+			request.TaskListInfo.TaskType,
+			rowTypeTaskSegment,
+			lastTaskID,
+			segment,
+			ttl)
+	}
+
+	return d.executeCreateTasksBatch(batch, request.TaskListInfo, "CreateTaskSegment")
+}
+
+func (d *cassandraPersistence) executeCreateTasksBatch(
+	batch *gocql.Batch,
+	taskListInfo *p.TaskListInfo,
+	operation string,
+) (*p.CreateTasksResponse, error) {
+	domainID := taskListInfo.DomainID
+	taskList := taskListInfo.Name
+	taskListType := taskListInfo.TaskType
+
 	// The following query is used to ensure that range_id didn't change
 	batch.Query(templateUpdateTaskListQuery,
-		request.TaskListInfo.RangeID,
+		taskListInfo.RangeID,
 		domainID,
 		taskList,
 		taskListType,
-		ackLevel,
-		taskListKind,
+		taskListInfo.AckLevel,
+		taskListInfo.Kind,
 		time.Now(),
+		taskListInfo.Draining,
+		taskListInfo.DrainTarget,
 		domainID,
 		taskList,
 		taskListType,
 		rowTypeTaskList,
 		taskListTaskID,
-		request.TaskListInfo.RangeID,
+		taskListInfo.RangeID,
 	)
 
 	previous := make(map[string]interface{})
@@ -2556,18 +2678,18 @@ func (d *cassandraPersistence) CreateTasks(request *p.CreateTasksRequest) (*p.Cr
 	if err != nil {
 		if isThrottlingError(err) {
 			return nil, &workflow.ServiceBusyError{
-				Message: fmt.Sprintf("CreateTasks operation failed. Error: %v", err),
+				Message: fmt.Sprintf("%v operation failed. Error: %v", operation, err),
 			}
 		}
 		return nil, &workflow.InternalServiceError{
-			Message: fmt.Sprintf("CreateTasks operation failed. Error : %v", err),
+			Message: fmt.Sprintf("%v operation failed. Error : %v", operation, err),
 		}
 	}
 	if !applied {
 		rangeID := previous["range_id"]
 		return nil, &p.ConditionFailedError{
 			Msg: fmt.Sprintf("Failed to create task. TaskList: %v, taskListType: %v, rangeID: %v, db rangeID: %v",
-				taskList, taskListType, request.TaskListInfo.RangeID, rangeID),
+				taskList, taskListType, taskListInfo.RangeID, rangeID),
 		}
 	}
 
@@ -2673,6 +2795,83 @@ func (d *cassandraPersistence) CompleteTasksLessThan(request *p.CompleteTasksLes
 	return p.UnknownNumRowsAffected, nil
 }
 
+// From TaskManager interface
+func (d *cassandraPersistence) GetTaskSegments(request *p.GetTasksRequest) (*p.GetTasksResponse, error) {
+	if request.MaxReadLevel == nil {
+		return nil, &workflow.InternalServiceError{
+			Message: "getTaskSegments: both readLevel and maxReadLevel MUST be specified for cassandra persistence",
+		}
+	}
+	if request.ReadLevel > *request.MaxReadLevel {
+		return &p.GetTasksResponse{}, nil
+	}
+
+	// segments are keyed by the id of their last task, the first segment
+	// returned may contain tasks which are below the read level
+	query := d.session.Query(templateGetTaskSegmentsQuery,
+		request.DomainID,
+		request.TaskList,
+		request.TaskType,
+		rowTypeTaskSegment,
+		request.ReadLevel,
+	).PageSize(request.BatchSize)
+
+	iter := query.Iter()
+	if iter == nil {
+		return nil, &workflow.InternalServiceError{
+			Message: "GetTaskSegments operation failed.  Not able to create query iterator.",
+		}
+	}
+
+	response := &p.GetTasksResponse{}
+	row := make(map[string]interface{})
+PopulateTasks:
+	for iter.MapScan(row) {
+		segment, ok := row["task_segment"].([]map[string]interface{})
+		if !ok { // no segments, but static column record returned
+			continue
+		}
+		for _, task := range segment {
+			t := createTaskInfo(task)
+			if t.TaskID <= request.ReadLevel {
+				continue
+			}
+			if t.TaskID > *request.MaxReadLevel || len(response.Tasks) == request.BatchSize {
+				break PopulateTasks
+			}
+			response.Tasks = append(response.Tasks, t)
+		}
+		row = make(map[string]interface{}) // Reinitialize map as initialized fails on unmarshalling
+	}
+
+	if err := iter.Close(); err != nil {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("GetTaskSegments operation failed. Error: %v", err),
+		}
+	}
+
+	return response, nil
+}
+
+// CompleteTaskSegmentsLessThan deletes all segments whose last task is less than or equal to the given
+// task id. Like CompleteTasksLessThan, this API ignores the Limit request parameter
+func (d *cassandraPersistence) CompleteTaskSegmentsLessThan(request *p.CompleteTasksLessThanRequest) (int, error) {
+	query := d.session.Query(templateCompleteTasksLessThanQuery,
+		request.DomainID, request.TaskListName, request.TaskType, rowTypeTaskSegment, request.TaskID)
+	err := query.Exec()
+	if err != nil {
+		if isThrottlingError(err) {
+			return 0, &workflow.ServiceBusyError{
+				Message: fmt.Sprintf("CompleteTaskSegmentsLessThan operation failed. Error: %v", err),
+			}
+		}
+		return 0, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("CompleteTaskSegmentsLessThan operation failed. Error: %v", err),
+		}
+	}
+	return p.UnknownNumRowsAffected, nil
+}
+
 func (d *cassandraPersistence) GetTimerIndexTasks(request *p.GetTimerIndexTasksRequest) (*p.GetTimerIndexTasksResponse,
 	error) {
 	// Reading timer tasks need to be quorum level consistent, otherwise we could loose task
@@ -2775,8 +2974,94 @@ func (d *cassandraPersistence) GetReplicationTasksFromDLQ(
 		rowTypeDLQRunID,
 		defaultVisibilityTimestamp,
 		request.ReadLevel,
-		request.ReadLevel+int64(request.BatchSize),
+		request.MaxReadLevel,
 	).PageSize(request.BatchSize).PageState(request.NextPageToken)
 
 	return d.populateGetReplicationTasksResponse(query)
 }
+
+func (d *cassandraPersistence) DeleteReplicationTaskFromDLQ(
+	request *p.DeleteReplicationTaskFromDLQRequest,
+) error {
+
+	query := d.session.Query(templateCompleteTransferTaskQuery,
+		d.shardID,
+		rowTypeDLQ,
+		rowTypeDLQDomainID,
+		request.SourceClusterName,
+		rowTypeDLQRunID,
+		defaultVisibilityTimestamp,
+		request.TaskID,
+	)
+
+	err := query.Exec()
+	if err != nil {
+		if isThrottlingError(err) {
+			return &workflow.ServiceBusyError{
+				Message: fmt.Sprintf("DeleteReplicationTaskFromDLQ operation failed. Error: %v", err),
+			}
+		}
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("DeleteReplicationTaskFromDLQ operation failed. Error: %v", err),
+		}
+	}
+
+	return nil
+}
+
+func (d *cassandraPersistence) RangeDeleteReplicationTaskFromDLQ(
+	request *p.RangeDeleteReplicationTaskFromDLQRequest,
+) error {
+
+	query := d.session.Query(templateRangeCompleteTransferTaskQuery,
+		d.shardID,
+		rowTypeDLQ,
+		rowTypeDLQDomainID,
+		request.SourceClusterName,
+		rowTypeDLQRunID,
+		defaultVisibilityTimestamp,
+		request.ExclusiveBeginTaskID,
+		request.InclusiveEndTaskID,
+	)
+
+	err := query.Exec()
+	if err != nil {
+		if isThrottlingError(err) {
+			return &workflow.ServiceBusyError{
+				Message: fmt.Sprintf("RangeDeleteReplicationTaskFromDLQ operation failed. Error: %v", err),
+			}
+		}
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("RangeDeleteReplicationTaskFromDLQ operation failed. Error: %v", err),
+		}
+	}
+
+	return nil
+}
+
+func (d *cassandraPersistence) GetReplicationDLQSize(
+	request *p.GetReplicationDLQSizeRequest,
+) (*p.GetReplicationDLQSizeResponse, error) {
+
+	query := d.session.Query(templateGetDLQSizeQuery,
+		d.shardID,
+		rowTypeDLQ,
+		rowTypeDLQDomainID,
+		request.SourceClusterName,
+		rowTypeDLQRunID,
+	)
+
+	var size int64
+	if err := query.Scan(&size); err != nil {
+		if isThrottlingError(err) {
+			return nil, &workflow.ServiceBusyError{
+				Message: fmt.Sprintf("GetReplicationDLQSize operation failed. Error: %v", err),
+			}
+		}
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("GetReplicationDLQSize operation failed. Error: %v", err),
+		}
+	}
+
+	return &p.GetReplicationDLQSizeResponse{Size: size}, nil
+}
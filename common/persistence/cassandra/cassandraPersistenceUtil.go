@@ -1309,6 +1309,7 @@ func updateActivityInfos(
 			a.LastFailureReason,
 			a.LastWorkerIdentity,
 			a.LastFailureDetails,
+			a.StartedTaskList,
 			scheduleEncoding,
 			shardID,
 			rowTypeExecution,
@@ -2123,6 +2124,8 @@ func createActivityInfo(
 			info.LastWorkerIdentity = v.(string)
 		case "last_failure_details":
 			info.LastFailureDetails = v.([]byte)
+		case "started_task_list":
+			info.StartedTaskList = v.(string)
 		case "event_data_encoding":
 			sharedEncoding = common.EncodingType(v.(string))
 		}
@@ -2295,6 +2298,7 @@ func resetActivityInfoMap(
 		aInfo["last_failure_reason"] = a.LastFailureReason
 		aInfo["last_worker_identity"] = a.LastWorkerIdentity
 		aInfo["last_failure_details"] = a.LastFailureDetails
+		aInfo["started_task_list"] = a.StartedTaskList
 
 		aMap[a.ScheduleID] = aInfo
 	}
@@ -2433,6 +2437,12 @@ func createTaskInfo(
 			info.ScheduleID = v.(int64)
 		case "created_time":
 			info.CreatedTime = v.(time.Time)
+		case "activity_type":
+			info.ActivityType = v.(string)
+		case "task_id":
+			info.TaskID = v.(int64)
+		case "expiry_time":
+			info.Expiry = v.(time.Time)
 		}
 	}
 
@@ -246,7 +246,7 @@ func (q *cassandraQueue) UpdateAckLevel(messageID int, clusterName string) error
 	}
 
 	// Ignore possibly delayed message
-	if queueMetadata.clusterAckLevels[clusterName] > messageID {
+	if ackLevel, ok := queueMetadata.clusterAckLevels[clusterName]; ok && ackLevel > messageID {
 		return nil
 	}
 
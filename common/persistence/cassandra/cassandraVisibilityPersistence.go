@@ -21,6 +21,7 @@
 package cassandra
 
 import (
+	"encoding/json"
 	"fmt"
 	"time"
 
@@ -142,6 +143,13 @@ type (
 		cassandraStore
 		lowConslevel gocql.Consistency
 	}
+
+	// visibilityPageToken is the page token of query based list, as open and closed executions
+	// are stored in different tables
+	visibilityPageToken struct {
+		Closed    bool
+		PageState []byte
+	}
 )
 
 // newVisibilityPersistence is used to create an instance of VisibilityManager implementation
@@ -663,15 +671,137 @@ func (v *cassandraVisibilityPersistence) DeleteWorkflowExecution(request *p.Visi
 }
 
 func (v *cassandraVisibilityPersistence) ListWorkflowExecutions(request *p.ListWorkflowExecutionsRequestV2) (*p.InternalListWorkflowExecutionsResponse, error) {
-	return nil, p.NewOperationNotSupportErrorForVis()
+	return v.listWorkflowExecutionsByQuery("ListWorkflowExecutions", request)
 }
 
+// ScanWorkflowExecutions is the same as ListWorkflowExecutions, since both page through the visibility tables
 func (v *cassandraVisibilityPersistence) ScanWorkflowExecutions(request *p.ListWorkflowExecutionsRequestV2) (*p.InternalListWorkflowExecutionsResponse, error) {
-	return nil, p.NewOperationNotSupportErrorForVis()
+	return v.listWorkflowExecutionsByQuery("ScanWorkflowExecutions", request)
 }
 
+// CountWorkflowExecutions counts executions matching a query in the same grammar as ListWorkflowExecutions.
+// The count pages through the matching rows of the domain partition and fails once more than
+// countWorkflowExecutionsMaxRows rows match, so the query must be narrowed by time range or filter.
 func (v *cassandraVisibilityPersistence) CountWorkflowExecutions(request *p.CountWorkflowExecutionsRequest) (*p.CountWorkflowExecutionsResponse, error) {
-	return nil, p.NewOperationNotSupportErrorForVis()
+	visQuery, err := parseVisibilityQuery(request.Query)
+	if err != nil {
+		return nil, &workflow.BadRequestError{Message: fmt.Sprintf("Error when parse query: %v", err)}
+	}
+
+	var count int64
+	for _, closed := range []bool{false, true} {
+		if (closed && !visQuery.listClosed) || (!closed && !visQuery.listOpen) {
+			continue
+		}
+		tableCount, err := v.countWorkflowExecutions(visQuery, request.DomainUUID, closed, countWorkflowExecutionsMaxRows-count)
+		if err != nil {
+			return nil, err
+		}
+		count += tableCount
+	}
+	return &p.CountWorkflowExecutionsResponse{Count: count}, nil
+}
+
+// countWorkflowExecutions counts the matching rows of either open or closed executions table, up to maxRows
+func (v *cassandraVisibilityPersistence) countWorkflowExecutions(
+	visQuery *visibilityQuery, domainID string, closed bool, maxRows int64) (int64, error) {
+	cql, values := visQuery.getCQL(countExecutionsFieldNames, domainID, closed)
+	iter := v.session.Query(cql, values...).Consistency(v.lowConslevel).PageSize(countWorkflowExecutionsPageSize).Iter()
+	if iter == nil {
+		return 0, &workflow.InternalServiceError{
+			Message: "CountWorkflowExecutions operation failed.  Not able to create query iterator.",
+		}
+	}
+
+	var count int64
+	var runID gocql.UUID
+	for iter.Scan(&runID) {
+		count++
+		if count > maxRows {
+			iter.Close()
+			return 0, &workflow.BadRequestError{
+				Message: fmt.Sprintf("CountWorkflowExecutions operation failed. Query matches more than %v executions, narrow the time range or add a filter.", countWorkflowExecutionsMaxRows),
+			}
+		}
+	}
+	if err := iter.Close(); err != nil {
+		if isThrottlingError(err) {
+			return 0, &workflow.ServiceBusyError{
+				Message: fmt.Sprintf("CountWorkflowExecutions operation failed. Error: %v", err),
+			}
+		}
+		return 0, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("CountWorkflowExecutions operation failed. Error: %v", err),
+		}
+	}
+	return count, nil
+}
+
+// listWorkflowExecutionsByQuery pages through open executions first, then closed executions if the query matches both
+func (v *cassandraVisibilityPersistence) listWorkflowExecutionsByQuery(
+	opName string, request *p.ListWorkflowExecutionsRequestV2) (*p.InternalListWorkflowExecutionsResponse, error) {
+	visQuery, err := parseVisibilityQuery(request.Query)
+	if err != nil {
+		return nil, &workflow.BadRequestError{Message: fmt.Sprintf("Error when parse query: %v", err)}
+	}
+
+	token := &visibilityPageToken{Closed: !visQuery.listOpen}
+	if len(request.NextPageToken) > 0 {
+		if err := json.Unmarshal(request.NextPageToken, token); err != nil {
+			return nil, &workflow.BadRequestError{Message: fmt.Sprintf("Invalid next page token: %v", err)}
+		}
+	}
+
+	fields := openExecutionsFieldNames
+	readRecord := readOpenWorkflowExecutionRecord
+	if token.Closed {
+		fields = closedExecutionsFieldNames
+		readRecord = readClosedWorkflowExecutionRecord
+	}
+	cql, values := visQuery.getCQL(fields, request.DomainUUID, token.Closed)
+	query := v.session.Query(cql, values...).Consistency(v.lowConslevel)
+	iter := query.PageSize(request.PageSize).PageState(token.PageState).Iter()
+	if iter == nil {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("%v operation failed.  Not able to create query iterator.", opName),
+		}
+	}
+
+	response := &p.InternalListWorkflowExecutionsResponse{}
+	response.Executions = make([]*p.VisibilityWorkflowExecutionInfo, 0)
+	wfexecution, has := readRecord(iter)
+	for has {
+		response.Executions = append(response.Executions, wfexecution)
+		wfexecution, has = readRecord(iter)
+	}
+
+	var nextToken *visibilityPageToken
+	if pageState := iter.PageState(); len(pageState) > 0 {
+		nextToken = &visibilityPageToken{Closed: token.Closed, PageState: make([]byte, len(pageState))}
+		copy(nextToken.PageState, pageState)
+	} else if !token.Closed && visQuery.listClosed {
+		nextToken = &visibilityPageToken{Closed: true}
+	}
+	if err := iter.Close(); err != nil {
+		if isThrottlingError(err) {
+			return nil, &workflow.ServiceBusyError{
+				Message: fmt.Sprintf("%v operation failed. Error: %v", opName, err),
+			}
+		}
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("%v operation failed. Error: %v", opName, err),
+		}
+	}
+
+	if nextToken != nil {
+		response.NextPageToken, err = json.Marshal(nextToken)
+		if err != nil {
+			return nil, &workflow.InternalServiceError{
+				Message: fmt.Sprintf("%v operation failed. Error: %v", opName, err),
+			}
+		}
+	}
+	return response, nil
 }
 
 func readOpenWorkflowExecutionRecord(iter *gocql.Iter) (*p.VisibilityWorkflowExecutionInfo, bool) {
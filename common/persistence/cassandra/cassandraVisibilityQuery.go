@@ -0,0 +1,339 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cassandra
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/xwb1989/sqlparser"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/definition"
+	p "github.com/uber/cadence/common/persistence"
+)
+
+const (
+	// missingValue is the special value used by visibility queries to match executions without the attribute,
+	// e.g. CloseTime = missing matches open workflows
+	missingValue = "missing"
+
+	openExecutionsFieldNames   = `workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding`
+	closedExecutionsFieldNames = `workflow_id, run_id, start_time, execution_time, close_time, workflow_type_name, status, history_length, memo, encoding`
+	countExecutionsFieldNames  = `run_id`
+
+	// countWorkflowExecutionsPageSize is the page size used when paging through executions to count them
+	countWorkflowExecutionsPageSize = 1000
+	// countWorkflowExecutionsMaxRows bounds the number of rows a single count is allowed to scan
+	countWorkflowExecutionsMaxRows = int64(100000)
+)
+
+type (
+	// visibilityQuery is a visibility query in the limited grammar supported by the cassandra visibility tables:
+	// a conjunction of StartTime or CloseTime range, CloseTime = missing, and at most one of
+	// WorkflowType, WorkflowID or CloseStatus equality
+	visibilityQuery struct {
+		listOpen     bool
+		listClosed   bool
+		byStartTime  bool
+		byCloseTime  bool
+		earliestTime int64
+		latestTime   int64
+		workflowType *string
+		workflowID   *string
+		closeStatus  *int32
+	}
+)
+
+// parseVisibilityQuery parses the where clause of a visibility query
+func parseVisibilityQuery(query string) (*visibilityQuery, error) {
+	result := &visibilityQuery{
+		listOpen:     true,
+		listClosed:   true,
+		earliestTime: 0,
+		latestTime:   math.MaxInt64,
+	}
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return result, nil
+	}
+	if common.IsJustOrderByClause(query) {
+		return nil, errors.New("order by is not supported")
+	}
+	if common.IsJustGroupByClause(query) {
+		return nil, errors.New("group by is not supported")
+	}
+
+	// IMPORTANT: the placeholder query is never executed, it is only used to parse the query
+	stmt, err := sqlparser.Parse(fmt.Sprintf("SELECT * FROM dummy WHERE %s", query))
+	if err != nil {
+		return nil, err
+	}
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok {
+		return nil, errors.New("invalid select query")
+	}
+	if len(sel.OrderBy) > 0 {
+		return nil, errors.New("order by is not supported")
+	}
+	if len(sel.GroupBy) > 0 {
+		return nil, errors.New("group by is not supported")
+	}
+	if err := result.parseWhereExpr(sel.Where.Expr); err != nil {
+		return nil, err
+	}
+
+	if !result.listOpen && !result.listClosed {
+		return nil, errors.New("query matches neither open nor closed workflows")
+	}
+	if result.byCloseTime && !result.listClosed {
+		return nil, errors.New("CloseTime range cannot be used for open workflows")
+	}
+	if result.earliestTime > result.latestTime {
+		return nil, errors.New("invalid time range")
+	}
+	return result, nil
+}
+
+func (q *visibilityQuery) parseWhereExpr(expr sqlparser.Expr) error {
+	switch expr := expr.(type) {
+	case *sqlparser.AndExpr:
+		if err := q.parseWhereExpr(expr.Left); err != nil {
+			return err
+		}
+		return q.parseWhereExpr(expr.Right)
+	case *sqlparser.ParenExpr:
+		return q.parseWhereExpr(expr.Expr)
+	case *sqlparser.ComparisonExpr:
+		return q.parseComparisonExpr(expr)
+	case *sqlparser.RangeCond:
+		return q.parseRangeCond(expr)
+	case *sqlparser.OrExpr:
+		return errors.New("OR is not supported")
+	default:
+		return errors.New("invalid where clause")
+	}
+}
+
+func (q *visibilityQuery) parseComparisonExpr(expr *sqlparser.ComparisonExpr) error {
+	key, err := getColName(expr.Left)
+	if err != nil {
+		return err
+	}
+
+	switch key {
+	case definition.WorkflowType, definition.WorkflowID, definition.CloseStatus:
+		if expr.Operator != sqlparser.EqualStr {
+			return fmt.Errorf("operator %v is not supported for %v", expr.Operator, key)
+		}
+		if q.workflowType != nil || q.workflowID != nil || q.closeStatus != nil {
+			return errors.New("only one of WorkflowType, WorkflowID and CloseStatus can be used")
+		}
+		value, err := getValue(expr.Right)
+		if err != nil {
+			return err
+		}
+		switch key {
+		case definition.WorkflowType:
+			q.workflowType = &value
+		case definition.WorkflowID:
+			q.workflowID = &value
+		case definition.CloseStatus:
+			status, err := strconv.ParseInt(value, 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid CloseStatus %v", value)
+			}
+			closeStatus := int32(status)
+			q.closeStatus = &closeStatus
+			q.listOpen = false
+		}
+		return nil
+
+	case definition.CloseTime:
+		if isMissingValue(expr.Right) {
+			switch expr.Operator {
+			case sqlparser.EqualStr:
+				q.listClosed = false
+			case sqlparser.NotEqualStr:
+				q.listOpen = false
+			default:
+				return fmt.Errorf("operator %v is not supported for missing value", expr.Operator)
+			}
+			return nil
+		}
+		return q.parseTimeComparison(key, expr.Operator, expr.Right)
+
+	case definition.StartTime:
+		return q.parseTimeComparison(key, expr.Operator, expr.Right)
+
+	default:
+		return fmt.Errorf("%v is not supported", key)
+	}
+}
+
+func (q *visibilityQuery) parseRangeCond(expr *sqlparser.RangeCond) error {
+	key, err := getColName(expr.Left)
+	if err != nil {
+		return err
+	}
+	if expr.Operator != sqlparser.BetweenStr {
+		return fmt.Errorf("operator %v is not supported", expr.Operator)
+	}
+	if err := q.parseTimeComparison(key, sqlparser.GreaterEqualStr, expr.From); err != nil {
+		return err
+	}
+	return q.parseTimeComparison(key, sqlparser.LessEqualStr, expr.To)
+}
+
+func (q *visibilityQuery) parseTimeComparison(key string, operator string, valueExpr sqlparser.Expr) error {
+	switch key {
+	case definition.StartTime:
+		if q.byCloseTime {
+			return errors.New("only one of StartTime and CloseTime range can be used")
+		}
+		q.byStartTime = true
+	case definition.CloseTime:
+		if q.byStartTime {
+			return errors.New("only one of StartTime and CloseTime range can be used")
+		}
+		q.byCloseTime = true
+		q.listOpen = false
+	default:
+		return fmt.Errorf("range on %v is not supported", key)
+	}
+
+	value, err := getValue(valueExpr)
+	if err != nil {
+		return err
+	}
+	timestamp, err := parseTime(value)
+	if err != nil {
+		return err
+	}
+
+	switch operator {
+	case sqlparser.EqualStr:
+		q.setEarliestTime(timestamp)
+		q.setLatestTime(timestamp)
+	case sqlparser.GreaterThanStr:
+		q.setEarliestTime(timestamp + 1)
+	case sqlparser.GreaterEqualStr:
+		q.setEarliestTime(timestamp)
+	case sqlparser.LessThanStr:
+		q.setLatestTime(timestamp - 1)
+	case sqlparser.LessEqualStr:
+		q.setLatestTime(timestamp)
+	default:
+		return fmt.Errorf("operator %v is not supported for %v", operator, key)
+	}
+	return nil
+}
+
+func (q *visibilityQuery) setEarliestTime(timestamp int64) {
+	if timestamp > q.earliestTime {
+		q.earliestTime = timestamp
+	}
+}
+
+func (q *visibilityQuery) setLatestTime(timestamp int64) {
+	if timestamp < q.latestTime {
+		q.latestTime = timestamp
+	}
+}
+
+// getCQL returns the CQL statement with its values to select the given fields
+// from either open or closed executions table
+func (q *visibilityQuery) getCQL(fields string, domainID string, closed bool) (string, []interface{}) {
+	table := "open_executions"
+	timeColumn := "start_time"
+	if closed {
+		table = "closed_executions"
+		if q.byCloseTime {
+			table = "closed_executions_v2"
+			timeColumn = "close_time"
+		}
+	}
+
+	cql := fmt.Sprintf(`SELECT %s FROM %s `+
+		`WHERE domain_id = ? `+
+		`AND domain_partition = ? `+
+		`AND %s >= ? `+
+		`AND %s <= ? `, fields, table, timeColumn, timeColumn)
+	values := []interface{}{
+		domainID,
+		domainPartition,
+		p.UnixNanoToDBTimestamp(q.earliestTime),
+		p.UnixNanoToDBTimestamp(q.latestTime),
+	}
+	switch {
+	case q.workflowType != nil:
+		cql += `AND workflow_type_name = ? `
+		values = append(values, *q.workflowType)
+	case q.workflowID != nil:
+		cql += `AND workflow_id = ? `
+		values = append(values, *q.workflowID)
+	case q.closeStatus != nil:
+		cql += `AND status = ? `
+		values = append(values, *q.closeStatus)
+	}
+	return cql, values
+}
+
+func getColName(expr sqlparser.Expr) (string, error) {
+	colName, ok := expr.(*sqlparser.ColName)
+	if !ok {
+		return "", errors.New("invalid column name")
+	}
+	key := colName.Name.String()
+	if !colName.Qualifier.IsEmpty() || strings.HasPrefix(key, definition.Attr+".") {
+		return "", errors.New("custom search attributes are not supported")
+	}
+	return key, nil
+}
+
+func getValue(expr sqlparser.Expr) (string, error) {
+	value, ok := expr.(*sqlparser.SQLVal)
+	if !ok || (value.Type != sqlparser.StrVal && value.Type != sqlparser.IntVal) {
+		return "", errors.New("invalid value")
+	}
+	return string(value.Val), nil
+}
+
+// parseTime accepts time as unix nano or in RFC3339 format, same as the ElasticSearch visibility store
+func parseTime(value string) (int64, error) {
+	if nano, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return nano, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return 0, err
+	}
+	return t.UnixNano(), nil
+}
+
+func isMissingValue(expr sqlparser.Expr) bool {
+	colName, ok := expr.(*sqlparser.ColName)
+	return ok && colName.Qualifier.IsEmpty() && colName.Name.String() == missingValue
+}
@@ -0,0 +1,121 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cassandra
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type visibilityQuerySuite struct {
+	suite.Suite
+}
+
+func TestVisibilityQuerySuite(t *testing.T) {
+	suite.Run(t, new(visibilityQuerySuite))
+}
+
+func (s *visibilityQuerySuite) TestEmptyQuery() {
+	query, err := parseVisibilityQuery("")
+	s.NoError(err)
+	s.True(query.listOpen)
+	s.True(query.listClosed)
+	s.Equal(int64(0), query.earliestTime)
+	s.Equal(int64(math.MaxInt64), query.latestTime)
+}
+
+func (s *visibilityQuerySuite) TestOpenByType() {
+	query, err := parseVisibilityQuery(`CloseTime = missing and WorkflowType = 'wt' and StartTime >= 1000`)
+	s.NoError(err)
+	s.True(query.listOpen)
+	s.False(query.listClosed)
+	s.False(query.byCloseTime)
+	s.Equal("wt", *query.workflowType)
+	s.Equal(int64(1000), query.earliestTime)
+
+	cql, values := query.getCQL(openExecutionsFieldNames, "domain-id", false)
+	s.Equal(`SELECT `+openExecutionsFieldNames+` FROM open_executions `+
+		`WHERE domain_id = ? AND domain_partition = ? AND start_time >= ? AND start_time <= ? `+
+		`AND workflow_type_name = ? `, cql)
+	s.Len(values, 5)
+}
+
+func (s *visibilityQuerySuite) TestClosedByCloseTime() {
+	closeTime := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	query, err := parseVisibilityQuery(`CloseStatus = 1 and CloseTime between '2019-10-01T00:00:00Z' and 1569974400000000000`)
+	s.NoError(err)
+	s.False(query.listOpen)
+	s.True(query.listClosed)
+	s.True(query.byCloseTime)
+	s.Equal(int32(1), *query.closeStatus)
+	s.Equal(closeTime.UnixNano(), query.earliestTime)
+	s.Equal(closeTime.Add(24*time.Hour).UnixNano(), query.latestTime)
+
+	cql, _ := query.getCQL(closedExecutionsFieldNames, "domain-id", true)
+	s.Equal(`SELECT `+closedExecutionsFieldNames+` FROM closed_executions_v2 `+
+		`WHERE domain_id = ? AND domain_partition = ? AND close_time >= ? AND close_time <= ? `+
+		`AND status = ? `, cql)
+}
+
+func (s *visibilityQuerySuite) TestCountByID() {
+	query, err := parseVisibilityQuery(`WorkflowID = 'wid'`)
+	s.NoError(err)
+	s.True(query.listOpen)
+	s.True(query.listClosed)
+
+	cql, values := query.getCQL(countExecutionsFieldNames, "domain-id", true)
+	s.Equal(`SELECT run_id FROM closed_executions `+
+		`WHERE domain_id = ? AND domain_partition = ? AND start_time >= ? AND start_time <= ? `+
+		`AND workflow_id = ? `, cql)
+	s.Len(values, 5)
+}
+
+func (s *visibilityQuerySuite) TestTimeRange() {
+	query, err := parseVisibilityQuery(`StartTime > 1000 and StartTime < 2000 and StartTime <= 3000`)
+	s.NoError(err)
+	s.Equal(int64(1001), query.earliestTime)
+	s.Equal(int64(1999), query.latestTime)
+}
+
+func (s *visibilityQuerySuite) TestInvalidQuery() {
+	invalidQueries := []string{
+		`WorkflowType = 'wt' or WorkflowID = 'wid'`,
+		`WorkflowType = 'wt' and WorkflowID = 'wid'`,
+		`WorkflowType != 'wt'`,
+		`StartTime > 1000 and CloseTime < 2000`,
+		`CloseTime = missing and CloseStatus = 1`,
+		`CloseTime = missing and CloseTime > 1000`,
+		`StartTime > 2000 and StartTime < 1000`,
+		`HistoryLength > 10`,
+		"`Attr.CustomIntField` = 1",
+		`order by StartTime`,
+		`WorkflowID = 'wid' order by StartTime`,
+		`group by WorkflowType`,
+		`WorkflowID = 'wid' group by WorkflowType`,
+	}
+	for _, query := range invalidQueries {
+		_, err := parseVisibilityQuery(query)
+		s.Error(err, query)
+	}
+}
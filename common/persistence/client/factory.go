@@ -21,6 +21,7 @@
 package client
 
 import (
+	"errors"
 	"sync"
 
 	"github.com/uber/cadence/common"
@@ -31,6 +32,7 @@ import (
 	"github.com/uber/cadence/common/persistence/sql"
 	"github.com/uber/cadence/common/quotas"
 	"github.com/uber/cadence/common/service/config"
+	"github.com/uber/cadence/common/service/dynamicconfig"
 )
 
 type (
@@ -52,8 +54,13 @@ type (
 		NewExecutionManager(shardID int) (p.ExecutionManager, error)
 		// NewVisibilityManager returns a new visibility manager
 		NewVisibilityManager() (p.VisibilityManager, error)
+		// NewVisibilityMigrationManagers returns the visibility managers of the source and target stores of
+		// visibility migration, an error is returned when no migration target store is configured
+		NewVisibilityMigrationManagers() (source p.VisibilityManager, target p.VisibilityManager, err error)
 		// NewDomainReplicationQueue returns a new queue for domain replication
 		NewDomainReplicationQueue() (p.DomainReplicationQueue, error)
+		// NewQueue returns a new queue of the given type
+		NewQueue(queueType common.QueueType) (p.Queue, error)
 	}
 	// DataStoreFactory is a low level interface to be implemented by a datastore
 	// Examples of datastores are cassandra, mysql etc
@@ -99,6 +106,7 @@ const (
 	storeTypeExecution
 	storeTypeVisibility
 	storeTypeQueue
+	storeTypeVisibilityMigrationTarget
 )
 
 var storeTypes = []storeType{
@@ -223,14 +231,52 @@ func (f *factoryImpl) NewExecutionManager(shardID int) (p.ExecutionManager, erro
 
 // NewVisibilityManager returns a new visibility manager
 func (f *factoryImpl) NewVisibilityManager() (p.VisibilityManager, error) {
-	ds := f.datastores[storeTypeVisibility]
+	if !f.config.IsVisibilityMigrationConfigExist() {
+		return f.newVisibilityManager(storeTypeVisibility, f.config.VisibilityStore)
+	}
+
+	source, target, err := f.NewVisibilityMigrationManagers()
+	if err != nil {
+		return nil, err
+	}
+	readFromTarget := dynamicconfig.GetBoolPropertyFnFilteredByDomain(false)
+	if visConfig := f.config.VisibilityConfig; visConfig != nil && visConfig.EnableReadFromMigrationTarget != nil {
+		readFromTarget = visConfig.EnableReadFromMigrationTarget
+	}
+	repairQueue, err := f.NewQueue(common.VisibilityMigrationRepairQueueType)
+	if err != nil {
+		return nil, err
+	}
+	return p.NewVisibilityDualManager(source, target, readFromTarget, repairQueue, f.metricsClient, f.logger), nil
+}
+
+// NewVisibilityMigrationManagers returns the visibility managers of the source and target stores of visibility migration
+func (f *factoryImpl) NewVisibilityMigrationManagers() (p.VisibilityManager, p.VisibilityManager, error) {
+	if !f.config.IsVisibilityMigrationConfigExist() {
+		return nil, nil, errors.New("visibility migration target store is not configured")
+	}
+
+	source, err := f.newVisibilityManager(storeTypeVisibility, f.config.VisibilityStore)
+	if err != nil {
+		return nil, nil, err
+	}
+	target, err := f.newVisibilityManager(storeTypeVisibilityMigrationTarget, f.config.VisibilityMigrationTargetStore)
+	if err != nil {
+		source.Close()
+		return nil, nil, err
+	}
+	return source, target, nil
+}
+
+func (f *factoryImpl) newVisibilityManager(st storeType, storeName string) (p.VisibilityManager, error) {
+	ds := f.datastores[st]
 	store, err := ds.factory.NewVisibilityStore()
 	if err != nil {
 		return nil, err
 	}
 	visConfig := f.config.VisibilityConfig
-	if visConfig != nil && visConfig.EnableReadFromClosedExecutionV2() && f.isCassandra() {
-		store, err = cassandra.NewVisibilityPersistenceV2(store, f.getCassandraConfig(), f.logger)
+	if visConfig != nil && visConfig.EnableReadFromClosedExecutionV2() && f.isCassandra(storeName) {
+		store, err = cassandra.NewVisibilityPersistenceV2(store, f.getCassandraConfig(storeName), f.logger)
 	}
 
 	result := p.NewVisibilityManagerImpl(store, f.logger)
@@ -248,8 +294,18 @@ func (f *factoryImpl) NewVisibilityManager() (p.VisibilityManager, error) {
 }
 
 func (f *factoryImpl) NewDomainReplicationQueue() (p.DomainReplicationQueue, error) {
+	result, err := f.NewQueue(common.DomainReplicationQueueType)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.NewDomainReplicationQueue(result, f.clusterName, f.metricsClient, f.logger), nil
+}
+
+// NewQueue returns a new queue of the given type
+func (f *factoryImpl) NewQueue(queueType common.QueueType) (p.Queue, error) {
 	ds := f.datastores[storeTypeQueue]
-	result, err := ds.factory.NewQueue(common.DomainReplicationQueueType)
+	result, err := ds.factory.NewQueue(queueType)
 	if err != nil {
 		return nil, err
 	}
@@ -260,7 +316,7 @@ func (f *factoryImpl) NewDomainReplicationQueue() (p.DomainReplicationQueue, err
 		result = p.NewQueuePersistenceMetricsClient(result, f.metricsClient, f.logger)
 	}
 
-	return p.NewDomainReplicationQueue(result, f.clusterName, f.metricsClient, f.logger), nil
+	return result, nil
 }
 
 // Close closes this factory
@@ -269,14 +325,21 @@ func (f *factoryImpl) Close() {
 	ds.factory.Close()
 }
 
-func (f *factoryImpl) isCassandra() bool {
-	cfg := f.config
-	return cfg.DataStores[cfg.VisibilityStore].SQL == nil
+func (f *factoryImpl) isCassandra(storeName string) bool {
+	return f.config.DataStores[storeName].SQL == nil
 }
 
-func (f *factoryImpl) getCassandraConfig() *config.Cassandra {
-	cfg := f.config
-	return cfg.DataStores[cfg.VisibilityStore].Cassandra
+// getValidSearchAttributes returns the registered search attributes used to validate visibility queries,
+// nil if not configured
+func (f *factoryImpl) getValidSearchAttributes() dynamicconfig.MapPropertyFn {
+	if f.config.VisibilityConfig == nil {
+		return nil
+	}
+	return f.config.VisibilityConfig.ValidSearchAttributes
+}
+
+func (f *factoryImpl) getCassandraConfig(storeName string) *config.Cassandra {
+	return f.config.DataStores[storeName].Cassandra
 }
 
 func (f *factoryImpl) init(clusterName string, limiters map[string]quotas.Limiter) {
@@ -287,7 +350,7 @@ func (f *factoryImpl) init(clusterName string, limiters map[string]quotas.Limite
 	case defaultCfg.Cassandra != nil:
 		defaultDataStore.factory = cassandra.NewFactory(*defaultCfg.Cassandra, clusterName, f.logger)
 	case defaultCfg.SQL != nil:
-		defaultDataStore.factory = sql.NewFactory(*defaultCfg.SQL, clusterName, f.logger)
+		defaultDataStore.factory = sql.NewFactory(*defaultCfg.SQL, clusterName, f.getValidSearchAttributes(), f.logger)
 	default:
 		f.logger.Fatal("invalid config: one of cassandra or sql params must be specified")
 	}
@@ -304,12 +367,28 @@ func (f *factoryImpl) init(clusterName string, limiters map[string]quotas.Limite
 	case defaultCfg.Cassandra != nil:
 		visibilityDataStore.factory = cassandra.NewFactory(*visibilityCfg.Cassandra, clusterName, f.logger)
 	case visibilityCfg.SQL != nil:
-		visibilityDataStore.factory = sql.NewFactory(*visibilityCfg.SQL, clusterName, f.logger)
+		visibilityDataStore.factory = sql.NewFactory(*visibilityCfg.SQL, clusterName, f.getValidSearchAttributes(), f.logger)
 	default:
 		f.logger.Fatal("invalid config: one of cassandra or sql params must be specified")
 	}
 
 	f.datastores[storeTypeVisibility] = visibilityDataStore
+
+	if !f.config.IsVisibilityMigrationConfigExist() {
+		return
+	}
+	targetCfg := f.config.DataStores[f.config.VisibilityMigrationTargetStore]
+	targetDataStore := Datastore{ratelimit: limiters[f.config.VisibilityMigrationTargetStore]}
+	switch {
+	case targetCfg.Cassandra != nil:
+		targetDataStore.factory = cassandra.NewFactory(*targetCfg.Cassandra, clusterName, f.logger)
+	case targetCfg.SQL != nil:
+		targetDataStore.factory = sql.NewFactory(*targetCfg.SQL, clusterName, f.getValidSearchAttributes(), f.logger)
+	default:
+		f.logger.Fatal("invalid config: one of cassandra or sql params must be specified for visibility migration target store")
+	}
+
+	f.datastores[storeTypeVisibilityMigrationTarget] = targetDataStore
 }
 
 func buildRatelimiters(cfg *config.Persistence) map[string]quotas.Limiter {
@@ -40,6 +40,9 @@ const (
 	DomainStatusDeleted
 )
 
+// MaxDomainFailoverHistorySize is the max number of failover records kept per domain
+const MaxDomainFailoverHistorySize = 10
+
 const (
 	// EventStoreVersion is already deprecated, this is used for forward
 	// compatibility (so that rollback is possible).
@@ -400,6 +403,9 @@ type (
 		Kind        int
 		Expiry      time.Time
 		LastUpdated time.Time
+		// Draining task lists reject new tasks, or forward them to DrainTarget when it is set
+		Draining    bool
+		DrainTarget string
 	}
 
 	// TaskInfo describes either activity or decision task
@@ -412,6 +418,7 @@ type (
 		ScheduleToStartTimeout int32
 		Expiry                 time.Time
 		CreatedTime            time.Time
+		ActivityType           string
 	}
 
 	// Task is the generic interface for workflow tasks
@@ -668,6 +675,8 @@ type (
 		LastFailureReason  string
 		LastWorkerIdentity string
 		LastFailureDetails []byte
+		// task list partition which dispatched the started attempt
+		StartedTaskList string
 		// Not written to database - This is used only for deduping heartbeat timer creation
 		LastHeartbeatTimeoutVisibility int64
 	}
@@ -984,6 +993,29 @@ type (
 	// GetReplicationTasksFromDLQResponse is the response for GetReplicationTasksFromDLQ
 	GetReplicationTasksFromDLQResponse = GetReplicationTasksResponse
 
+	// DeleteReplicationTaskFromDLQRequest is used to delete a replication task from dlq
+	DeleteReplicationTaskFromDLQRequest struct {
+		SourceClusterName string
+		TaskID            int64
+	}
+
+	// RangeDeleteReplicationTaskFromDLQRequest is used to delete a range of replication tasks from dlq
+	RangeDeleteReplicationTaskFromDLQRequest struct {
+		SourceClusterName    string
+		ExclusiveBeginTaskID int64
+		InclusiveEndTaskID   int64
+	}
+
+	// GetReplicationDLQSizeRequest is used to get the number of replication tasks in dlq
+	GetReplicationDLQSizeRequest struct {
+		SourceClusterName string
+	}
+
+	// GetReplicationDLQSizeResponse is the response for GetReplicationDLQSize
+	GetReplicationDLQSizeResponse struct {
+		Size int64
+	}
+
 	// RangeCompleteTimerTaskRequest is used to complete a range of tasks in the timer task queue
 	RangeCompleteTimerTaskRequest struct {
 		InclusiveBeginTimestamp time.Time
@@ -1127,6 +1159,7 @@ type (
 	DomainReplicationConfig struct {
 		ActiveClusterName string
 		Clusters          []*ClusterReplicationConfig
+		Filter            *workflow.DomainReplicationFilter
 	}
 
 	// ClusterReplicationConfig describes the cross DC cluster replication configuration
@@ -1165,6 +1198,8 @@ type (
 		FailoverVersion             int64
 		FailoverNotificationVersion int64
 		NotificationVersion         int64
+		FailoverInfo                *workflow.DomainFailoverInfo
+		FailoverHistory             []*workflow.DomainFailoverRecord
 	}
 
 	// UpdateDomainRequest is used to update domain
@@ -1172,10 +1207,13 @@ type (
 		Info                        *DomainInfo
 		Config                      *DomainConfig
 		ReplicationConfig           *DomainReplicationConfig
+		IsGlobalDomain              bool
 		ConfigVersion               int64
 		FailoverVersion             int64
 		FailoverNotificationVersion int64
 		NotificationVersion         int64
+		FailoverInfo                *workflow.DomainFailoverInfo
+		FailoverHistory             []*workflow.DomainFailoverRecord
 	}
 
 	// DeleteDomainRequest is used to delete domain entry from domains table
@@ -1457,6 +1495,9 @@ type (
 		CompleteReplicationTask(request *CompleteReplicationTaskRequest) error
 		PutReplicationTaskToDLQ(request *PutReplicationTaskToDLQRequest) error
 		GetReplicationTasksFromDLQ(request *GetReplicationTasksFromDLQRequest) (*GetReplicationTasksFromDLQResponse, error)
+		DeleteReplicationTaskFromDLQ(request *DeleteReplicationTaskFromDLQRequest) error
+		RangeDeleteReplicationTaskFromDLQ(request *RangeDeleteReplicationTaskFromDLQRequest) error
+		GetReplicationDLQSize(request *GetReplicationDLQSizeRequest) (*GetReplicationDLQSizeResponse, error)
 
 		// Timer related methods.
 		GetTimerIndexTasks(request *GetTimerIndexTasksRequest) (*GetTimerIndexTasksResponse, error)
@@ -1494,6 +1535,16 @@ type (
 		//  - number of rows actually deleted, if limit is honored
 		//  - UnknownNumRowsDeleted, when all rows below value are deleted
 		CompleteTasksLessThan(request *CompleteTasksLessThanRequest) (int, error)
+		// CreateTaskSegment persists a batch of tasks, sorted by task id, as a single segment
+		// of the task list backlog. Like CreateTasks, it fails if the range id has changed
+		CreateTaskSegment(request *CreateTasksRequest) (*CreateTasksResponse, error)
+		// GetTaskSegments returns the tasks stored in segments within the given range sorted
+		// by task id, BatchSize is the max number of tasks returned
+		GetTaskSegments(request *GetTasksRequest) (*GetTasksResponse, error)
+		// CompleteTaskSegmentsLessThan deletes segments whose tasks are all less than or equal
+		// to the given task id. Limit is the max number of segments deleted and the return
+		// value follows the same rules as CompleteTasksLessThan
+		CompleteTaskSegmentsLessThan(request *CompleteTasksLessThanRequest) (int, error)
 	}
 
 	// HistoryManager is used to manager workflow history events
@@ -2369,6 +2420,22 @@ func (config *ClusterReplicationConfig) deserialize(input map[string]interface{}
 	config.ClusterName = input["cluster_name"].(string)
 }
 
+// AppendDomainFailoverRecord adds the record to the front of the domain failover history,
+// only the latest MaxDomainFailoverHistorySize records are kept
+func AppendDomainFailoverRecord(
+	history []*workflow.DomainFailoverRecord,
+	record *workflow.DomainFailoverRecord,
+) []*workflow.DomainFailoverRecord {
+
+	result := make([]*workflow.DomainFailoverRecord, 0, len(history)+1)
+	result = append(result, record)
+	result = append(result, history...)
+	if len(result) > MaxDomainFailoverHistorySize {
+		result = result[:MaxDomainFailoverHistorySize]
+	}
+	return result
+}
+
 // DBTimestampToUnixNano converts CQL timestamp to UnixNano
 func DBTimestampToUnixNano(milliseconds int64) int64 {
 	return milliseconds * 1000 * 1000 // Milliseconds are 10⁻³, nanoseconds are 10⁻⁹, (-3) - (-9) = 6, so multiply by 10⁶
@@ -0,0 +1,211 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package elasticsearch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/cch123/elasticsql"
+	"github.com/valyala/fastjson"
+	"github.com/xwb1989/sqlparser"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/definition"
+	p "github.com/uber/cadence/common/persistence"
+)
+
+const (
+	defaultHistorySearchMaxResults = 1000
+
+	dslFieldSource = "_source"
+)
+
+var (
+	errHistorySearchNotEnabled = &workflow.BadRequestError{Message: "history search is not enabled"}
+)
+
+// resolveHistoryConditions replaces the history search conditions of the query, e.g. History.FailureReason = 'timeout',
+// with a condition on the run IDs of the workflows matched by them in the history search index.
+// Frontend verifies history search conditions are only combined with other conditions by and.
+func (v *esVisibilityStore) resolveHistoryConditions(domainID, query string) (string, error) {
+	if !strings.Contains(query, definition.History+".") {
+		return query, nil
+	}
+	query = strings.TrimSpace(query)
+	if common.IsJustOrderByClause(query) || common.IsJustGroupByClause(query) {
+		return query, nil
+	}
+
+	stmt, err := sqlparser.Parse(fmt.Sprintf("select * from dummy where %s", query))
+	if err != nil {
+		return "", &workflow.BadRequestError{Message: fmt.Sprintf("Error when parse query: %v", err)}
+	}
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok || sel.Where == nil {
+		return query, nil
+	}
+	historyExpr, otherExpr := splitHistoryConditions(sel.Where.Expr)
+	if historyExpr == nil {
+		return query, nil
+	}
+	if v.config == nil || len(v.config.ESHistoryIndexName) == 0 {
+		return "", errHistorySearchNotEnabled
+	}
+
+	runIDs, err := v.searchHistory(domainID, historyExpr)
+	if err != nil {
+		return "", err
+	}
+	values := make(sqlparser.ValTuple, 0, len(runIDs))
+	for _, runID := range runIDs {
+		values = append(values, sqlparser.NewStrVal([]byte(runID)))
+	}
+	if len(values) == 0 { // matches no workflow
+		values = append(values, sqlparser.NewStrVal([]byte{}))
+	}
+	var runIDExpr sqlparser.Expr = &sqlparser.ComparisonExpr{
+		Operator: sqlparser.InStr,
+		Left:     &sqlparser.ColName{Name: sqlparser.NewColIdent(definition.RunID)},
+		Right:    values,
+	}
+	if otherExpr != nil {
+		runIDExpr = &sqlparser.AndExpr{Left: otherExpr, Right: runIDExpr}
+	}
+
+	buf := sqlparser.NewTrackedBuffer(nil)
+	runIDExpr.Format(buf)
+	sel.GroupBy.Format(buf)
+	sel.OrderBy.Format(buf)
+	return buf.String(), nil
+}
+
+// resolveHistoryConditionsForList returns a copy of the list request with history search conditions resolved
+func (v *esVisibilityStore) resolveHistoryConditionsForList(
+	request *p.ListWorkflowExecutionsRequestV2) (*p.ListWorkflowExecutionsRequestV2, error) {
+
+	query, err := v.resolveHistoryConditions(request.DomainUUID, request.Query)
+	if err != nil {
+		return nil, err
+	}
+	listRequest := *request
+	listRequest.Query = query
+	return &listRequest, nil
+}
+
+// searchHistory returns the run IDs of the workflows matched by the conditions in the history search index,
+// the query is rejected if the conditions match more workflows than the max results, instead of truncating them
+func (v *esVisibilityStore) searchHistory(domainID string, expr sqlparser.Expr) ([]string, error) {
+	maxResults := defaultHistorySearchMaxResults
+	if v.config.ESHistorySearchMaxResults != nil {
+		maxResults = v.config.ESHistorySearchMaxResults()
+	}
+	sql := fmt.Sprintf("select * from dummy where %s limit %d", sqlparser.String(expr), maxResults)
+	dslStr, _, err := elasticsql.Convert(sql)
+	if err != nil {
+		return nil, &workflow.BadRequestError{Message: fmt.Sprintf("Error when parse query: %v", err)}
+	}
+	dsl, err := fastjson.Parse(dslStr)
+	if err != nil {
+		return nil, &workflow.BadRequestError{Message: fmt.Sprintf("Error when parse query: %v", err)}
+	}
+	addDomainToQuery(dsl, domainID)
+	dsl.Set(dslFieldSource, fastjson.MustParse(fmt.Sprintf(`["%s"]`, definition.RunID)))
+
+	searchResult, err := v.esClient.SearchWithDSL(context.Background(), v.config.ESHistoryIndexName, dsl.String())
+	if err != nil {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("Search history failed. Error: %v", err),
+		}
+	}
+
+	var runIDs []string
+	if searchResult.Hits == nil {
+		return runIDs, nil
+	}
+	if searchResult.Hits.TotalHits > int64(maxResults) {
+		return nil, &workflow.BadRequestError{
+			Message: fmt.Sprintf("History conditions match %d workflows, more than the limit %d. Narrow them down by adding conditions.",
+				searchResult.Hits.TotalHits, maxResults),
+		}
+	}
+	for _, hit := range searchResult.Hits.Hits {
+		var source struct {
+			RunID string
+		}
+		if hit.Source == nil {
+			continue
+		}
+		if err := json.Unmarshal(*hit.Source, &source); err != nil {
+			return nil, &workflow.InternalServiceError{
+				Message: fmt.Sprintf("Search history failed to decode result. Error: %v", err),
+			}
+		}
+		runIDs = append(runIDs, source.RunID)
+	}
+	return runIDs, nil
+}
+
+// splitHistoryConditions splits the top level and conditions of the expression into history search conditions
+// without the History qualifier, and other conditions. Either of them is nil if there is no such condition.
+func splitHistoryConditions(expr sqlparser.Expr) (historyExpr sqlparser.Expr, otherExpr sqlparser.Expr) {
+	switch e := expr.(type) {
+	case *sqlparser.AndExpr:
+		leftHistory, leftOther := splitHistoryConditions(e.Left)
+		rightHistory, rightOther := splitHistoryConditions(e.Right)
+		return andExpr(leftHistory, rightHistory), andExpr(leftOther, rightOther)
+	case *sqlparser.ParenExpr:
+		historyExpr, otherExpr := splitHistoryConditions(e.Expr)
+		if historyExpr != nil && otherExpr != nil {
+			return historyExpr, otherExpr
+		}
+		if historyExpr != nil {
+			return &sqlparser.ParenExpr{Expr: historyExpr}, nil
+		}
+		return nil, expr
+	case *sqlparser.ComparisonExpr:
+		colName, ok := e.Left.(*sqlparser.ColName)
+		if !ok || colName.Qualifier.Name.String() != definition.History {
+			return nil, expr
+		}
+		return &sqlparser.ComparisonExpr{
+			Operator: e.Operator,
+			Left:     &sqlparser.ColName{Name: colName.Name},
+			Right:    e.Right,
+			Escape:   e.Escape,
+		}, nil
+	default:
+		return nil, expr
+	}
+}
+
+func andExpr(left, right sqlparser.Expr) sqlparser.Expr {
+	if left == nil {
+		return right
+	}
+	if right == nil {
+		return left
+	}
+	return &sqlparser.AndExpr{Left: left, Right: right}
+}
@@ -36,6 +36,7 @@ import (
 	"github.com/cch123/elasticsql"
 	"github.com/olivere/elastic"
 	"github.com/valyala/fastjson"
+	"github.com/xwb1989/sqlparser"
 
 	"github.com/uber/cadence/.gen/go/indexer"
 	workflow "github.com/uber/cadence/.gen/go/shared"
@@ -47,6 +48,7 @@ import (
 	"github.com/uber/cadence/common/messaging"
 	p "github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service/config"
+	"github.com/uber/cadence/common/service/dynamicconfig"
 )
 
 const (
@@ -392,6 +394,10 @@ func (v *esVisibilityStore) ListWorkflowExecutions(
 		return nil, err
 	}
 
+	request, err = v.resolveHistoryConditionsForList(request)
+	if err != nil {
+		return nil, err
+	}
 	queryDSL, err := v.getESQueryDSL(request, token)
 	if err != nil {
 		return nil, &workflow.BadRequestError{Message: fmt.Sprintf("Error when parse query: %v", err)}
@@ -422,7 +428,11 @@ func (v *esVisibilityStore) ScanWorkflowExecutions(
 	var searchResult *elastic.SearchResult
 	var scrollService es.ScrollService
 	if len(token.ScrollID) == 0 { // first call
-		queryDSL, err := getESQueryDSLForScan(request)
+		scanRequest, err := v.resolveHistoryConditionsForList(request)
+		if err != nil {
+			return nil, err
+		}
+		queryDSL, err := getESQueryDSLForScan(scanRequest)
 		if err != nil {
 			return nil, &workflow.BadRequestError{Message: fmt.Sprintf("Error when parse query: %v", err)}
 		}
@@ -447,12 +457,39 @@ func (v *esVisibilityStore) ScanWorkflowExecutions(
 func (v *esVisibilityStore) CountWorkflowExecutions(request *p.CountWorkflowExecutionsRequest) (
 	*p.CountWorkflowExecutionsResponse, error) {
 
-	queryDSL, err := getESQueryDSLForCount(request)
+	query, err := v.resolveHistoryConditions(request.DomainUUID, request.Query)
+	if err != nil {
+		return nil, err
+	}
+	countRequest := *request
+	countRequest.Query = query
+	queryDSL, groupByFields, err := getESQueryDSLForCount(&countRequest)
 	if err != nil {
 		return nil, &workflow.BadRequestError{Message: fmt.Sprintf("Error when parse query: %v", err)}
 	}
 
 	ctx := context.Background()
+	if len(groupByFields) > 0 {
+		searchResult, err := v.esClient.SearchWithDSL(ctx, v.index, queryDSL)
+		if err != nil {
+			return nil, &workflow.InternalServiceError{
+				Message: fmt.Sprintf("CountWorkflowExecutions failed. Error: %v", err),
+			}
+		}
+		groups, truncatedCount, err := getCountGroupsFromAggregations(searchResult.Aggregations, groupByFields, 0, map[string][]byte{})
+		if err != nil {
+			return nil, &workflow.InternalServiceError{
+				Message: fmt.Sprintf("CountWorkflowExecutions failed to parse aggregations. Error: %v", err),
+			}
+		}
+		response := &p.CountWorkflowExecutionsResponse{
+			Count:          searchResult.TotalHits(),
+			Groups:         groups,
+			TruncatedCount: truncatedCount,
+		}
+		return response, nil
+	}
+
 	count, err := v.esClient.Count(ctx, v.index, queryDSL)
 	if err != nil {
 		return nil, &workflow.InternalServiceError{
@@ -470,13 +507,17 @@ const (
 	jsonSortForOpen          = `[{"StartTime":"desc"},{"RunID":"desc"}]`
 	jsonSortWithTieBreaker   = `{"RunID":"desc"}`
 
-	dslFieldSort        = "sort"
-	dslFieldSearchAfter = "search_after"
-	dslFieldFrom        = "from"
-	dslFieldSize        = "size"
-	dslFieldMust        = "must"
+	dslFieldSort         = "sort"
+	dslFieldSearchAfter  = "search_after"
+	dslFieldFrom         = "from"
+	dslFieldSize         = "size"
+	dslFieldAggregations = "aggregations"
+	dslFieldMust         = "must"
 
 	defaultDateTimeFormat = time.RFC3339 // used for converting UnixNano to string like 2018-02-15T16:16:36-08:00
+
+	groupByAggregationPrefix = "group_"
+	countGroupByMaxBuckets   = 1000 // max number of buckets of all levels of the group by aggregations
 )
 
 var (
@@ -506,19 +547,156 @@ func getESQueryDSLForScan(request *p.ListWorkflowExecutionsRequestV2) (string, e
 	return dsl.String(), nil
 }
 
-func getESQueryDSLForCount(request *p.CountWorkflowExecutionsRequest) (string, error) {
+// getESQueryDSLForCount returns the DSL for the count request, and the group by fields of the query if any.
+// Query with group by is a search with nested terms aggregations instead of a count.
+func getESQueryDSLForCount(request *p.CountWorkflowExecutionsRequest) (string, []string, error) {
 	sql := getSQLFromCountRequest(request)
 	dsl, err := getCustomizedDSLFromSQL(sql, request.DomainUUID)
 	if err != nil {
-		return "", err
+		return "", nil, err
+	}
+	groupByFields, err := getGroupByFieldsFromSQL(sql)
+	if err != nil {
+		return "", nil, err
 	}
 
 	// remove not needed fields
 	dsl.Del(dslFieldFrom)
 	dsl.Del(dslFieldSize)
 	dsl.Del(dslFieldSort)
+	// aggregations generated by elasticsql are not valid for nested group by, so they are replaced
+	dsl.Del(dslFieldAggregations)
 
-	return dsl.String(), nil
+	if len(groupByFields) > 0 {
+		bucketSize, err := getGroupByBucketSize(len(groupByFields))
+		if err != nil {
+			return "", nil, err
+		}
+		dsl.Set(dslFieldSize, fastjson.MustParse("0"))
+		dsl.Set(dslFieldAggregations, fastjson.MustParse(getAggregationsForGroupBy(groupByFields, bucketSize, 0)))
+	}
+
+	return dsl.String(), groupByFields, nil
+}
+
+func getGroupByFieldsFromSQL(sql string) ([]string, error) {
+	stmt, err := sqlparser.Parse(sql)
+	if err != nil {
+		return nil, err
+	}
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok {
+		return nil, errors.New("invalid select query")
+	}
+	var fields []string
+	for _, expr := range sel.GroupBy {
+		colName, ok := expr.(*sqlparser.ColName)
+		if !ok {
+			return nil, errors.New("invalid group by expression")
+		}
+		fields = append(fields, colName.Name.String())
+	}
+	return fields, nil
+}
+
+// getGroupByBucketSize returns the largest terms size of each level of the group by aggregations,
+// for which the buckets of all levels do not exceed countGroupByMaxBuckets
+func getGroupByBucketSize(numGroupByFields int) (int, error) {
+	bucketSize := 0
+	for {
+		size := bucketSize + 1
+		totalBuckets, levelBuckets := 0, 1
+		for level := 0; level < numGroupByFields && totalBuckets <= countGroupByMaxBuckets; level++ {
+			levelBuckets *= size
+			totalBuckets += levelBuckets
+		}
+		if totalBuckets > countGroupByMaxBuckets {
+			break
+		}
+		bucketSize = size
+	}
+	if bucketSize == 0 {
+		return 0, fmt.Errorf("too many group by fields, the number of groups exceeds %d", countGroupByMaxBuckets)
+	}
+	return bucketSize, nil
+}
+
+// getAggregationsForGroupBy returns nested terms aggregations, one level per group by field
+func getAggregationsForGroupBy(groupByFields []string, bucketSize int, level int) string {
+	aggs := fmt.Sprintf(`{"terms":{"field":"%s","size":%d}`, groupByFields[level], bucketSize)
+	if level+1 < len(groupByFields) {
+		aggs += fmt.Sprintf(`,"%s":%s`, dslFieldAggregations, getAggregationsForGroupBy(groupByFields, bucketSize, level+1))
+	}
+	aggs += "}"
+	return fmt.Sprintf(`{"%s%d":%s}`, groupByAggregationPrefix, level, aggs)
+}
+
+// getCountGroupsFromAggregations flattens the buckets of nested terms aggregations into groups,
+// each group has the JSON encoded values of all group by fields and the number of workflows in the group.
+// It also returns the number of workflows left out of the groups, as the buckets of each level are capped.
+func getCountGroupsFromAggregations(
+	aggs elastic.Aggregations,
+	groupByFields []string,
+	level int,
+	groupValues map[string][]byte,
+) ([]*workflow.CountWorkflowExecutionsGroup, int64, error) {
+
+	terms, ok := aggs.Terms(fmt.Sprintf("%s%d", groupByAggregationPrefix, level))
+	if !ok {
+		return nil, 0, nil
+	}
+
+	key := strings.TrimPrefix(groupByFields[level], definition.Attr+".")
+	var groups []*workflow.CountWorkflowExecutionsGroup
+	truncatedCount := terms.SumOfOtherDocCount
+	for _, bucket := range terms.Buckets {
+		value, err := json.Marshal(getBucketKey(bucket))
+		if err != nil {
+			return nil, 0, err
+		}
+		values := make(map[string][]byte, len(groupValues)+1)
+		for k, v := range groupValues {
+			values[k] = v
+		}
+		values[key] = value
+
+		if level+1 < len(groupByFields) {
+			subGroups, subTruncatedCount, err := getCountGroupsFromAggregations(bucket.Aggregations, groupByFields, level+1, values)
+			if err != nil {
+				return nil, 0, err
+			}
+			groups = append(groups, subGroups...)
+			truncatedCount += subTruncatedCount
+			continue
+		}
+		groups = append(groups, &workflow.CountWorkflowExecutionsGroup{
+			GroupValues: values,
+			Count:       common.Int64Ptr(bucket.DocCount),
+		})
+	}
+	return groups, truncatedCount, nil
+}
+
+// getBucketKey returns the key of the terms bucket, bool fields are keyed by 0 and 1 in ES,
+// so their string representation is used instead
+func getBucketKey(bucket *elastic.AggregationBucketKeyItem) interface{} {
+	if bucket.KeyAsString != nil {
+		switch *bucket.KeyAsString {
+		case "true":
+			return true
+		case "false":
+			return false
+		}
+	}
+	if bucket.KeyNumber != "" {
+		if intValue, err := bucket.KeyNumber.Int64(); err == nil {
+			return intValue
+		}
+		if floatValue, err := bucket.KeyNumber.Float64(); err == nil {
+			return floatValue
+		}
+	}
+	return bucket.Key
 }
 
 func (v *esVisibilityStore) getESQueryDSL(request *p.ListWorkflowExecutionsRequestV2, token *esVisibilityPageToken) (string, error) {
@@ -528,13 +706,13 @@ func (v *esVisibilityStore) getESQueryDSL(request *p.ListWorkflowExecutionsReque
 		return "", err
 	}
 
-	sortField, err := v.processSortField(dsl)
+	sortField, err := v.processSortField(dsl, request.Domain)
 	if err != nil {
 		return "", err
 	}
 
 	if shouldSearchAfter(token) {
-		valueOfSearchAfter, err := v.getValueOfSearchAfterInJSON(token, sortField)
+		valueOfSearchAfter, err := v.getValueOfSearchAfterInJSON(token, sortField, request.Domain)
 		if err != nil {
 			return "", err
 		}
@@ -565,6 +743,8 @@ func getSQLFromCountRequest(request *p.CountWorkflowExecutionsRequest) string {
 	var sql string
 	if strings.TrimSpace(request.Query) == "" {
 		sql = "select * from dummy"
+	} else if common.IsJustGroupByClause(request.Query) {
+		sql = fmt.Sprintf("select * from dummy %s", request.Query)
 	} else {
 		sql = fmt.Sprintf("select * from dummy where %s", request.Query)
 	}
@@ -627,7 +807,7 @@ func addMustQuery(dsl *fastjson.Value, queryString string) {
 	valOfTopQuery.Set("bool", fastjson.MustParse(newValOfBool))
 }
 
-func (v *esVisibilityStore) processSortField(dsl *fastjson.Value) (string, error) {
+func (v *esVisibilityStore) processSortField(dsl *fastjson.Value, domain string) (string, error) {
 	isSorted := dsl.Exists(dslFieldSort)
 	var sortField string
 
@@ -644,7 +824,7 @@ func (v *esVisibilityStore) processSortField(dsl *fastjson.Value) (string, error
 		obj.Visit(func(k []byte, v *fastjson.Value) { // visit is only way to get object key in fastjson
 			sortField = string(k)
 		})
-		if v.getFieldType(sortField) == workflow.IndexedValueTypeString {
+		if v.getFieldType(sortField, domain) == workflow.IndexedValueTypeString {
 			return "", errors.New("not able to sort by IndexedValueTypeString field, use IndexedValueTypeKeyword field")
 		}
 		// add RunID as tie-breaker
@@ -654,11 +834,14 @@ func (v *esVisibilityStore) processSortField(dsl *fastjson.Value) (string, error
 	return sortField, nil
 }
 
-func (v *esVisibilityStore) getFieldType(fieldName string) workflow.IndexedValueType {
+func (v *esVisibilityStore) getFieldType(fieldName string, domain string) workflow.IndexedValueType {
 	if strings.HasPrefix(fieldName, definition.Attr) {
 		fieldName = fieldName[len(definition.Attr)+1:] // remove prefix
 	}
-	validMap := v.config.ValidSearchAttributes()
+	validMap := definition.MergeValidSearchAttributes(
+		v.config.ValidSearchAttributes(),
+		v.config.ValidSearchAttributes(dynamicconfig.DomainFilter(domain)),
+	)
 	fieldType, ok := validMap[fieldName]
 	if !ok {
 		v.logger.Error("Unknown fieldName, validation should be done in frontend already", tag.Value(fieldName))
@@ -670,10 +853,10 @@ func shouldSearchAfter(token *esVisibilityPageToken) bool {
 	return token.TieBreaker != ""
 }
 
-func (v *esVisibilityStore) getValueOfSearchAfterInJSON(token *esVisibilityPageToken, sortField string) (string, error) {
+func (v *esVisibilityStore) getValueOfSearchAfterInJSON(token *esVisibilityPageToken, sortField string, domain string) (string, error) {
 	var sortVal interface{}
 	var err error
-	switch v.getFieldType(sortField) {
+	switch v.getFieldType(sortField, domain) {
 	case workflow.IndexedValueTypeInt, workflow.IndexedValueTypeDatetime, workflow.IndexedValueTypeBool:
 		sortVal, err = token.SortValue.(json.Number).Int64()
 		if err != nil {
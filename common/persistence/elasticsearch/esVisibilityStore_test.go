@@ -61,6 +61,7 @@ type ESVisibilitySuite struct {
 
 var (
 	testIndex        = "test-index"
+	testHistoryIndex = "test-history-index"
 	testDomain       = "test-domain"
 	testDomainID     = "bfd5c907-f899-4baf-a7b2-2ab85e623ebd"
 	testPageSize     = 5
@@ -800,24 +801,55 @@ func (s *ESVisibilitySuite) TestGetESQueryDSLForCount() {
 	}
 
 	// empty query
-	dsl, err := getESQueryDSLForCount(request)
+	dsl, _, err := getESQueryDSLForCount(request)
 	s.Nil(err)
 	s.Equal(`{"query":{"bool":{"must":[{"match_phrase":{"DomainID":{"query":"bfd5c907-f899-4baf-a7b2-2ab85e623ebd"}}},{"bool":{"must":[{"match_all":{}}]}}]}}}`, dsl)
 
 	request.Query = `WorkflowID = 'wid' order by StartTime desc`
-	dsl, err = getESQueryDSLForCount(request)
+	dsl, _, err = getESQueryDSLForCount(request)
 	s.Nil(err)
 	s.Equal(`{"query":{"bool":{"must":[{"match_phrase":{"DomainID":{"query":"bfd5c907-f899-4baf-a7b2-2ab85e623ebd"}}},{"bool":{"must":[{"match_phrase":{"WorkflowID":{"query":"wid"}}}]}}]}}}`, dsl)
 
 	request.Query = `CloseTime < "2018-06-07T15:04:05+07:00" and StartTime > "2018-05-04T16:00:00+07:00" and ExecutionTime >= "2018-05-05T16:00:00+07:00"`
-	dsl, err = getESQueryDSLForCount(request)
+	dsl, _, err = getESQueryDSLForCount(request)
 	s.Nil(err)
 	s.Equal(`{"query":{"bool":{"must":[{"match_phrase":{"DomainID":{"query":"bfd5c907-f899-4baf-a7b2-2ab85e623ebd"}}},{"bool":{"must":[{"range":{"ExecutionTime":{"gt":"0"}}},{"bool":{"must":[{"range":{"CloseTime":{"lt":"1528358645000000000"}}},{"range":{"StartTime":{"gt":"1525424400000000000"}}},{"range":{"ExecutionTime":{"from":"1525510800000000000"}}}]}}]}}]}}}`, dsl)
 
 	request.Query = `ExecutionTime < 1000`
-	dsl, err = getESQueryDSLForCount(request)
+	dsl, _, err = getESQueryDSLForCount(request)
 	s.Nil(err)
 	s.Equal(`{"query":{"bool":{"must":[{"match_phrase":{"DomainID":{"query":"bfd5c907-f899-4baf-a7b2-2ab85e623ebd"}}},{"bool":{"must":[{"range":{"ExecutionTime":{"gt":"0"}}},{"bool":{"must":[{"range":{"ExecutionTime":{"lt":"1000"}}}]}}]}}]}}}`, dsl)
+
+	request.Query = `group by CloseStatus`
+	dsl, groupByFields, err := getESQueryDSLForCount(request)
+	s.Nil(err)
+	s.Equal([]string{"CloseStatus"}, groupByFields)
+	s.Equal(`{"query":{"bool":{"must":[{"match_phrase":{"DomainID":{"query":"bfd5c907-f899-4baf-a7b2-2ab85e623ebd"}}},{"bool":{"must":[{"match_all":{}}]}}]}},"size":0,"aggregations":{"group_0":{"terms":{"field":"CloseStatus","size":1000}}}}`, dsl)
+
+	request.Query = "WorkflowID = 'wid' group by WorkflowType, `Attr.CustomKeywordField`"
+	dsl, groupByFields, err = getESQueryDSLForCount(request)
+	s.Nil(err)
+	s.Equal([]string{"WorkflowType", "Attr.CustomKeywordField"}, groupByFields)
+	s.Equal(`{"query":{"bool":{"must":[{"match_phrase":{"DomainID":{"query":"bfd5c907-f899-4baf-a7b2-2ab85e623ebd"}}},{"bool":{"must":[{"match_phrase":{"WorkflowID":{"query":"wid"}}}]}}]}},"size":0,"aggregations":{"group_0":{"terms":{"field":"WorkflowType","size":31},"aggregations":{"group_1":{"terms":{"field":"Attr.CustomKeywordField","size":31}}}}}}`, dsl)
+}
+
+func (s *ESVisibilitySuite) TestGetGroupByBucketSize() {
+	bucketSize, err := getGroupByBucketSize(1)
+	s.NoError(err)
+	s.Equal(countGroupByMaxBuckets, bucketSize)
+
+	// buckets of all levels are counted: 31 + 31*31 <= 1000 < 32 + 32*32
+	bucketSize, err = getGroupByBucketSize(2)
+	s.NoError(err)
+	s.Equal(31, bucketSize)
+
+	// 9 + 81 + 729 <= 1000 < 10 + 100 + 1000
+	bucketSize, err = getGroupByBucketSize(3)
+	s.NoError(err)
+	s.Equal(9, bucketSize)
+
+	_, err = getGroupByBucketSize(countGroupByMaxBuckets + 1)
+	s.Error(err)
 }
 
 func (s *ESVisibilitySuite) TestAddDomainToQuery() {
@@ -946,6 +978,119 @@ func (s *ESVisibilitySuite) TestCountWorkflowExecutions() {
 	s.True(strings.Contains(err.Error(), "Error when parse query"))
 }
 
+func (s *ESVisibilitySuite) TestCountWorkflowExecutions_GroupBy() {
+	aggregations := `{"group_0":{"sum_other_doc_count":5,"buckets":[` +
+		`{"key":"wt1","doc_count":3,"group_1":{"buckets":[{"key":1,"key_as_string":"true","doc_count":2},{"key":0,"key_as_string":"false","doc_count":1}]}},` +
+		`{"key":"wt2","doc_count":3,"group_1":{"sum_other_doc_count":2,"buckets":[{"key":0,"key_as_string":"false","doc_count":1}]}}]}}`
+	var aggs elastic.Aggregations
+	s.NoError(json.Unmarshal([]byte(aggregations), &aggs))
+	searchResult := &elastic.SearchResult{
+		Hits:         &elastic.SearchHits{TotalHits: 11},
+		Aggregations: aggs,
+	}
+	s.mockESClient.On("SearchWithDSL", mock.Anything, testIndex, mock.MatchedBy(func(input string) bool {
+		s.True(strings.Contains(input, `"aggregations":{"group_0":{"terms":{"field":"WorkflowType","size":31}`))
+		return true
+	})).Return(searchResult, nil).Once()
+
+	request := &p.CountWorkflowExecutionsRequest{
+		DomainUUID: testDomainID,
+		Domain:     testDomain,
+		Query:      "group by WorkflowType, `Attr.CustomBoolField`",
+	}
+	resp, err := s.visibilityStore.CountWorkflowExecutions(request)
+	s.NoError(err)
+	s.Equal(int64(11), resp.Count)
+	s.Equal(int64(7), resp.TruncatedCount)
+	s.Equal([]*workflow.CountWorkflowExecutionsGroup{
+		{GroupValues: map[string][]byte{"WorkflowType": []byte(`"wt1"`), "CustomBoolField": []byte(`true`)}, Count: common.Int64Ptr(2)},
+		{GroupValues: map[string][]byte{"WorkflowType": []byte(`"wt1"`), "CustomBoolField": []byte(`false`)}, Count: common.Int64Ptr(1)},
+		{GroupValues: map[string][]byte{"WorkflowType": []byte(`"wt2"`), "CustomBoolField": []byte(`false`)}, Count: common.Int64Ptr(1)},
+	}, resp.Groups)
+
+	// test internal error
+	s.mockESClient.On("SearchWithDSL", mock.Anything, testIndex, mock.Anything).Return(nil, errTestESSearch).Once()
+	_, err = s.visibilityStore.CountWorkflowExecutions(request)
+	s.Error(err)
+	_, ok := err.(*workflow.InternalServiceError)
+	s.True(ok)
+}
+
+func (s *ESVisibilitySuite) TestResolveHistoryConditions() {
+	// query without history search conditions is not changed
+	query := "`Attr.CustomStringField` = 'custom' order by StartTime desc"
+	resolved, err := s.visibilityStore.resolveHistoryConditions(testDomainID, query)
+	s.NoError(err)
+	s.Equal(query, resolved)
+
+	query = "`Attr.CustomStringField` = 'custom' and History.FailureReason = 'timeout' order by StartTime desc"
+	_, err = s.visibilityStore.resolveHistoryConditions(testDomainID, query)
+	s.Equal(errHistorySearchNotEnabled, err)
+
+	s.visibilityStore.config.ESHistoryIndexName = testHistoryIndex
+	source1 := json.RawMessage(`{"RunID":"rid1"}`)
+	source2 := json.RawMessage(`{"RunID":"rid2"}`)
+	s.mockESClient.On("SearchWithDSL", mock.Anything, testHistoryIndex, mock.MatchedBy(func(input string) bool {
+		return strings.Contains(input, fmt.Sprintf(`{"match_phrase":{"DomainID":{"query":"%s"}}}`, testDomainID)) &&
+			strings.Contains(input, `{"match_phrase":{"FailureReason":{"query":"timeout"}}}`) &&
+			strings.Contains(input, `"size":1000`) &&
+			strings.Contains(input, `"_source":["RunID"]`)
+	})).Return(&elastic.SearchResult{
+		Hits: &elastic.SearchHits{TotalHits: 2, Hits: []*elastic.SearchHit{{Source: &source1}, {Source: &source2}}},
+	}, nil).Once()
+	resolved, err = s.visibilityStore.resolveHistoryConditions(testDomainID, query)
+	s.NoError(err)
+	s.Equal("`Attr.CustomStringField` = 'custom' and RunID in ('rid1', 'rid2') order by StartTime desc", resolved)
+
+	// matched workflows are not truncated to the max results
+	s.visibilityStore.config.ESHistorySearchMaxResults = dynamicconfig.GetIntPropertyFn(1)
+	s.mockESClient.On("SearchWithDSL", mock.Anything, testHistoryIndex, mock.MatchedBy(func(input string) bool {
+		return strings.Contains(input, `"size":1`)
+	})).Return(&elastic.SearchResult{
+		Hits: &elastic.SearchHits{TotalHits: 2, Hits: []*elastic.SearchHit{{Source: &source1}}},
+	}, nil).Once()
+	_, err = s.visibilityStore.resolveHistoryConditions(testDomainID, query)
+	s.Equal(&workflow.BadRequestError{
+		Message: "History conditions match 2 workflows, more than the limit 1. Narrow them down by adding conditions.",
+	}, err)
+	s.visibilityStore.config.ESHistorySearchMaxResults = nil
+
+	// no workflow matched
+	query = "(History.ActivityType = 'activity' and History.SignalName != 'signal')"
+	s.mockESClient.On("SearchWithDSL", mock.Anything, testHistoryIndex, mock.Anything).Return(testSearchResult, nil).Once()
+	resolved, err = s.visibilityStore.resolveHistoryConditions(testDomainID, query)
+	s.NoError(err)
+	s.Equal("RunID in ('')", resolved)
+
+	s.mockESClient.On("SearchWithDSL", mock.Anything, testHistoryIndex, mock.Anything).Return(nil, errTestESSearch).Once()
+	_, err = s.visibilityStore.resolveHistoryConditions(testDomainID, query)
+	s.Error(err)
+	_, ok := err.(*workflow.InternalServiceError)
+	s.True(ok)
+}
+
+func (s *ESVisibilitySuite) TestListWorkflowExecutions_HistoryConditions() {
+	s.visibilityStore.config.ESHistoryIndexName = testHistoryIndex
+	source := json.RawMessage(fmt.Sprintf(`{"RunID":"%s"}`, testRunID))
+	s.mockESClient.On("SearchWithDSL", mock.Anything, testHistoryIndex, mock.Anything).Return(&elastic.SearchResult{
+		Hits: &elastic.SearchHits{Hits: []*elastic.SearchHit{{Source: &source}}},
+	}, nil).Once()
+	s.mockESClient.On("SearchWithDSL", mock.Anything, testIndex, mock.MatchedBy(func(input string) bool {
+		return strings.Contains(input, `{"match_phrase":{"CloseStatus":{"query":"5"}}}`) &&
+			strings.Contains(input, fmt.Sprintf(`{"terms":{"RunID":["%s"]}}`, testRunID))
+	})).Return(testSearchResult, nil).Once()
+
+	request := &p.ListWorkflowExecutionsRequestV2{
+		DomainUUID: testDomainID,
+		Domain:     testDomain,
+		PageSize:   10,
+		Query:      `CloseStatus = 5 and History.MarkerName = 'marker'`,
+	}
+	_, err := s.visibilityStore.ListWorkflowExecutions(request)
+	s.NoError(err)
+	s.Equal(`CloseStatus = 5 and History.MarkerName = 'marker'`, request.Query)
+}
+
 func (s *ESVisibilitySuite) TestTimeProcessFunc() {
 	cases := []struct {
 		key   string
@@ -1016,8 +1161,8 @@ func (s *ESVisibilitySuite) TestProcessAllValuesForKey() {
 }
 
 func (s *ESVisibilitySuite) TestGetFieldType() {
-	s.Equal(workflow.IndexedValueTypeInt, s.visibilityStore.getFieldType("StartTime"))
-	s.Equal(workflow.IndexedValueTypeDatetime, s.visibilityStore.getFieldType("Attr.CustomDatetimeField"))
+	s.Equal(workflow.IndexedValueTypeInt, s.visibilityStore.getFieldType("StartTime", testDomain))
+	s.Equal(workflow.IndexedValueTypeDatetime, s.visibilityStore.getFieldType("Attr.CustomDatetimeField", testDomain))
 }
 
 func (s *ESVisibilitySuite) TestGetValueOfSearchAfterInJSON() {
@@ -1026,7 +1171,7 @@ func (s *ESVisibilitySuite) TestGetValueOfSearchAfterInJSON() {
 	// Int field
 	token := s.getTokenHelper(123)
 	sortField := definition.CustomIntField
-	res, err := v.getValueOfSearchAfterInJSON(token, sortField)
+	res, err := v.getValueOfSearchAfterInJSON(token, sortField, testDomain)
 	s.Nil(err)
 	s.Equal(`[123, "t"]`, res)
 
@@ -1035,7 +1180,7 @@ func (s *ESVisibilitySuite) TestGetValueOfSearchAfterInJSON() {
 	dec.UseNumber()
 	err = dec.Decode(&token)
 	s.Nil(err)
-	res, err = v.getValueOfSearchAfterInJSON(token, sortField)
+	res, err = v.getValueOfSearchAfterInJSON(token, sortField, testDomain)
 	s.Nil(err)
 	s.Equal(`[-9223372036854775808, "t"]`, res)
 
@@ -1044,14 +1189,14 @@ func (s *ESVisibilitySuite) TestGetValueOfSearchAfterInJSON() {
 	dec.UseNumber()
 	err = dec.Decode(&token)
 	s.Nil(err)
-	res, err = v.getValueOfSearchAfterInJSON(token, sortField)
+	res, err = v.getValueOfSearchAfterInJSON(token, sortField, testDomain)
 	s.Nil(err)
 	s.Equal(`[9223372036854775807, "t"]`, res)
 
 	// Double field
 	token = s.getTokenHelper(1.11)
 	sortField = definition.CustomDoubleField
-	res, err = v.getValueOfSearchAfterInJSON(token, sortField)
+	res, err = v.getValueOfSearchAfterInJSON(token, sortField, testDomain)
 	s.Nil(err)
 	s.Equal(`[1.11, "t"]`, res)
 
@@ -1060,19 +1205,19 @@ func (s *ESVisibilitySuite) TestGetValueOfSearchAfterInJSON() {
 	dec.UseNumber()
 	err = dec.Decode(&token)
 	s.Nil(err)
-	res, err = v.getValueOfSearchAfterInJSON(token, sortField)
+	res, err = v.getValueOfSearchAfterInJSON(token, sortField, testDomain)
 	s.Nil(err)
 	s.Equal(`["-Infinity", "t"]`, res)
 
 	// Keyword field
 	token = s.getTokenHelper("keyword")
 	sortField = definition.CustomKeywordField
-	res, err = v.getValueOfSearchAfterInJSON(token, sortField)
+	res, err = v.getValueOfSearchAfterInJSON(token, sortField, testDomain)
 	s.Nil(err)
 	s.Equal(`["keyword", "t"]`, res)
 
 	token = s.getTokenHelper(nil)
-	res, err = v.getValueOfSearchAfterInJSON(token, sortField)
+	res, err = v.getValueOfSearchAfterInJSON(token, sortField, testDomain)
 	s.Nil(err)
 	s.Equal(`[null, "t"]`, res)
 }
@@ -292,6 +292,7 @@ func (m *executionManagerImpl) DeserializeActivityInfos(
 			LastFailureReason:              v.LastFailureReason,
 			LastWorkerIdentity:             v.LastWorkerIdentity,
 			LastFailureDetails:             v.LastFailureDetails,
+			StartedTaskList:                v.StartedTaskList,
 			LastHeartbeatTimeoutVisibility: v.LastHeartbeatTimeoutVisibility,
 		}
 		newInfos[k] = a
@@ -412,6 +413,7 @@ func (m *executionManagerImpl) SerializeUpsertActivityInfos(
 			LastFailureReason:              v.LastFailureReason,
 			LastWorkerIdentity:             v.LastWorkerIdentity,
 			LastFailureDetails:             v.LastFailureDetails,
+			StartedTaskList:                v.StartedTaskList,
 			LastHeartbeatTimeoutVisibility: v.LastHeartbeatTimeoutVisibility,
 		}
 		newInfos = append(newInfos, i)
@@ -825,6 +827,24 @@ func (m *executionManagerImpl) GetReplicationTasksFromDLQ(
 	return m.persistence.GetReplicationTasksFromDLQ(request)
 }
 
+func (m *executionManagerImpl) DeleteReplicationTaskFromDLQ(
+	request *DeleteReplicationTaskFromDLQRequest,
+) error {
+	return m.persistence.DeleteReplicationTaskFromDLQ(request)
+}
+
+func (m *executionManagerImpl) RangeDeleteReplicationTaskFromDLQ(
+	request *RangeDeleteReplicationTaskFromDLQRequest,
+) error {
+	return m.persistence.RangeDeleteReplicationTaskFromDLQ(request)
+}
+
+func (m *executionManagerImpl) GetReplicationDLQSize(
+	request *GetReplicationDLQSizeRequest,
+) (*GetReplicationDLQSizeResponse, error) {
+	return m.persistence.GetReplicationDLQSize(request)
+}
+
 // Timer related methods.
 func (m *executionManagerImpl) GetTimerIndexTasks(
 	request *GetTimerIndexTasksRequest,
@@ -56,10 +56,15 @@ func (m *metadataManagerImpl) CreateDomain(request *CreateDomainRequest) (*Creat
 	if err != nil {
 		return nil, err
 	}
+	replicationFilter, err := m.serializeReplicationFilter(request.ReplicationConfig)
+	if err != nil {
+		return nil, err
+	}
 	return m.persistence.CreateDomain(&InternalCreateDomainRequest{
 		Info:              request.Info,
 		Config:            &dc,
 		ReplicationConfig: request.ReplicationConfig,
+		ReplicationFilter: replicationFilter,
 		IsGlobalDomain:    request.IsGlobalDomain,
 		ConfigVersion:     request.ConfigVersion,
 		FailoverVersion:   request.FailoverVersion,
@@ -76,6 +81,17 @@ func (m *metadataManagerImpl) GetDomain(request *GetDomainRequest) (*GetDomainRe
 	if err != nil {
 		return nil, err
 	}
+	failoverInfo, err := m.serializer.DeserializeDomainFailoverInfo(resp.FailoverInfo)
+	if err != nil {
+		return nil, err
+	}
+	failoverHistory, err := m.serializer.DeserializeDomainFailoverHistory(resp.FailoverHistory)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.deserializeReplicationFilter(resp.ReplicationConfig, resp.ReplicationFilter); err != nil {
+		return nil, err
+	}
 
 	return &GetDomainResponse{
 		Info:                        resp.Info,
@@ -86,6 +102,8 @@ func (m *metadataManagerImpl) GetDomain(request *GetDomainRequest) (*GetDomainRe
 		FailoverVersion:             resp.FailoverVersion,
 		FailoverNotificationVersion: resp.FailoverNotificationVersion,
 		NotificationVersion:         resp.NotificationVersion,
+		FailoverInfo:                failoverInfo,
+		FailoverHistory:             failoverHistory,
 	}, nil
 }
 
@@ -94,14 +112,30 @@ func (m *metadataManagerImpl) UpdateDomain(request *UpdateDomainRequest) error {
 	if err != nil {
 		return err
 	}
+	failoverInfo, err := m.serializer.SerializeDomainFailoverInfo(request.FailoverInfo, common.EncodingTypeThriftRW)
+	if err != nil {
+		return err
+	}
+	failoverHistory, err := m.serializer.SerializeDomainFailoverHistory(request.FailoverHistory, common.EncodingTypeThriftRW)
+	if err != nil {
+		return err
+	}
+	replicationFilter, err := m.serializeReplicationFilter(request.ReplicationConfig)
+	if err != nil {
+		return err
+	}
 	return m.persistence.UpdateDomain(&InternalUpdateDomainRequest{
 		Info:                        request.Info,
 		Config:                      &dc,
 		ReplicationConfig:           request.ReplicationConfig,
+		IsGlobalDomain:              request.IsGlobalDomain,
 		ConfigVersion:               request.ConfigVersion,
 		FailoverVersion:             request.FailoverVersion,
 		FailoverNotificationVersion: request.FailoverNotificationVersion,
 		NotificationVersion:         request.NotificationVersion,
+		FailoverInfo:                failoverInfo,
+		FailoverHistory:             failoverHistory,
+		ReplicationFilter:           replicationFilter,
 	})
 }
 
@@ -124,6 +158,17 @@ func (m *metadataManagerImpl) ListDomains(request *ListDomainsRequest) (*ListDom
 		if err != nil {
 			return nil, err
 		}
+		failoverInfo, err := m.serializer.DeserializeDomainFailoverInfo(d.FailoverInfo)
+		if err != nil {
+			return nil, err
+		}
+		failoverHistory, err := m.serializer.DeserializeDomainFailoverHistory(d.FailoverHistory)
+		if err != nil {
+			return nil, err
+		}
+		if err := m.deserializeReplicationFilter(d.ReplicationConfig, d.ReplicationFilter); err != nil {
+			return nil, err
+		}
 		domains = append(domains, &GetDomainResponse{
 			Info:                        d.Info,
 			Config:                      &dc,
@@ -133,6 +178,8 @@ func (m *metadataManagerImpl) ListDomains(request *ListDomainsRequest) (*ListDom
 			FailoverVersion:             d.FailoverVersion,
 			FailoverNotificationVersion: d.FailoverNotificationVersion,
 			NotificationVersion:         d.NotificationVersion,
+			FailoverInfo:                failoverInfo,
+			FailoverHistory:             failoverHistory,
 		})
 	}
 	return &ListDomainsResponse{
@@ -185,6 +232,25 @@ func (m *metadataManagerImpl) deserializeDomainConfig(ic *InternalDomainConfig)
 	}, nil
 }
 
+func (m *metadataManagerImpl) serializeReplicationFilter(rc *DomainReplicationConfig) (*DataBlob, error) {
+	if rc == nil {
+		return nil, nil
+	}
+	return m.serializer.SerializeDomainReplicationFilter(rc.Filter, common.EncodingTypeThriftRW)
+}
+
+func (m *metadataManagerImpl) deserializeReplicationFilter(rc *DomainReplicationConfig, data *DataBlob) error {
+	if rc == nil {
+		return nil
+	}
+	filter, err := m.serializer.DeserializeDomainReplicationFilter(data)
+	if err != nil {
+		return err
+	}
+	rc.Filter = filter
+	return nil
+}
+
 func (m *metadataManagerImpl) GetMetadata() (*GetMetadataResponse, error) {
 	return m.persistence.GetMetadata()
 }
@@ -2496,6 +2496,7 @@ func (s *ExecutionManagerSuite) TestWorkflowMutableStateActivities() {
 		LastFailureReason:        "some random error",
 		LastWorkerIdentity:       uuid.New(),
 		LastFailureDetails:       []byte(uuid.New()),
+		StartedTaskList:          "some random task list partition",
 	}}
 	err2 := s.UpdateWorkflowExecution(updatedInfo, updatedStats, nil, []int64{int64(4)}, nil, int64(3), nil, activityInfos, nil, nil, nil)
 	s.NoError(err2)
@@ -2541,6 +2542,7 @@ func (s *ExecutionManagerSuite) TestWorkflowMutableStateActivities() {
 	s.Equal(activityInfos[0].LastFailureReason, ai.LastFailureReason)
 	s.Equal(activityInfos[0].LastWorkerIdentity, ai.LastWorkerIdentity)
 	s.Equal(activityInfos[0].LastFailureDetails, ai.LastFailureDetails)
+	s.Equal(activityInfos[0].StartedTaskList, ai.StartedTaskList)
 
 	err2 = s.UpdateWorkflowExecution(updatedInfo, updatedStats, nil, nil, nil, int64(5), nil, nil, []int64{1}, nil, nil)
 	s.NoError(err2)
@@ -4845,6 +4847,65 @@ func (s *ExecutionManagerSuite) TestConflictResolveWorkflowExecutionWithTransact
 }
 
 // TestCreateGetShardBackfill test
+// TestReplicationDLQ test
+func (s *ExecutionManagerSuite) TestReplicationDLQ() {
+	sourceCluster := "test-dlq-source-cluster"
+	getTaskIDs := func(readLevel int64, maxReadLevel int64, batchSize int) []int64 {
+		resp, err := s.ExecutionManager.GetReplicationTasksFromDLQ(
+			p.NewGetReplicationTasksFromDLQRequest(sourceCluster, readLevel, maxReadLevel, batchSize, nil),
+		)
+		s.NoError(err)
+		var taskIDs []int64
+		for _, task := range resp.Tasks {
+			taskIDs = append(taskIDs, task.TaskID)
+		}
+		return taskIDs
+	}
+	getSize := func() int64 {
+		resp, err := s.ExecutionManager.GetReplicationDLQSize(&p.GetReplicationDLQSizeRequest{
+			SourceClusterName: sourceCluster,
+		})
+		s.NoError(err)
+		return resp.Size
+	}
+
+	for taskID := int64(100); taskID < 105; taskID++ {
+		err := s.ExecutionManager.PutReplicationTaskToDLQ(&p.PutReplicationTaskToDLQRequest{
+			SourceClusterName: sourceCluster,
+			TaskInfo: &p.ReplicationTaskInfo{
+				DomainID:     uuid.New(),
+				WorkflowID:   "test-dlq-workflow-id",
+				RunID:        uuid.New(),
+				TaskID:       taskID,
+				TaskType:     p.ReplicationTaskTypeHistory,
+				FirstEventID: 1,
+				NextEventID:  3,
+				Version:      1,
+			},
+		})
+		s.NoError(err)
+	}
+	s.Equal(int64(5), getSize())
+
+	// tasks are read up to the max read level, their ids are not related to the batch size
+	s.Equal([]int64{100, 101, 102, 103}, getTaskIDs(-1, 103, 10))
+	s.Equal([]int64{102, 103, 104}, getTaskIDs(101, math.MaxInt64, 10))
+
+	err := s.ExecutionManager.DeleteReplicationTaskFromDLQ(&p.DeleteReplicationTaskFromDLQRequest{
+		SourceClusterName: sourceCluster,
+		TaskID:            100,
+	})
+	s.NoError(err)
+	err = s.ExecutionManager.RangeDeleteReplicationTaskFromDLQ(&p.RangeDeleteReplicationTaskFromDLQRequest{
+		SourceClusterName:    sourceCluster,
+		ExclusiveBeginTaskID: 100,
+		InclusiveEndTaskID:   102,
+	})
+	s.NoError(err)
+	s.Equal(int64(2), getSize())
+	s.Equal([]int64{103, 104}, getTaskIDs(-1, math.MaxInt64, 10))
+}
+
 func (s *ExecutionManagerSuite) TestCreateGetShardBackfill() {
 	shardID := 4
 	rangeID := int64(59)
@@ -287,6 +287,95 @@ func (s *MatchingPersistenceSuite) TestCompleteTasksLessThan() {
 	}
 }
 
+// TestTaskSegments test
+func (s *MatchingPersistenceSuite) TestTaskSegments() {
+	domainID := uuid.New()
+	taskList := "task-segments-tl0"
+	wfExec := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("task-segments-test"),
+		RunId:      common.StringPtr(uuid.New()),
+	}
+	resp, err := s.TaskMgr.LeaseTaskList(
+		&p.LeaseTaskListRequest{DomainID: domainID, TaskList: taskList, TaskType: p.TaskListTypeActivity})
+	s.NoError(err)
+	taskListInfo := resp.TaskListInfo
+
+	var taskIDs []int64
+	for segment := 0; segment < 3; segment++ {
+		var tasks []*p.CreateTaskInfo
+		for i := 0; i < 3; i++ {
+			taskID := s.GetNextSequenceNumber()
+			tasks = append(tasks, &p.CreateTaskInfo{
+				TaskID:    taskID,
+				Execution: wfExec,
+				Data: &p.TaskInfo{
+					DomainID:               domainID,
+					WorkflowID:             wfExec.GetWorkflowId(),
+					RunID:                  wfExec.GetRunId(),
+					TaskID:                 taskID,
+					ScheduleID:             taskID,
+					ScheduleToStartTimeout: defaultScheduleToStartTimeout,
+					ActivityType:           "activity-type",
+				},
+			})
+			taskIDs = append(taskIDs, taskID)
+		}
+		_, err := s.TaskMgr.CreateTaskSegment(&p.CreateTasksRequest{TaskListInfo: taskListInfo, Tasks: tasks})
+		s.NoError(err)
+	}
+
+	getTaskIDs := func(readLevel int64, maxReadLevel int64, batchSize int) []int64 {
+		resp, err := s.TaskMgr.GetTaskSegments(&p.GetTasksRequest{
+			DomainID:     domainID,
+			TaskList:     taskList,
+			TaskType:     p.TaskListTypeActivity,
+			ReadLevel:    readLevel,
+			MaxReadLevel: &maxReadLevel,
+			BatchSize:    batchSize,
+		})
+		s.NoError(err)
+		result := []int64{}
+		for _, task := range resp.Tasks {
+			s.Equal(wfExec.GetWorkflowId(), task.WorkflowID)
+			s.Equal(wfExec.GetRunId(), task.RunID)
+			s.Equal(task.TaskID, task.ScheduleID)
+			s.Equal("activity-type", task.ActivityType)
+			result = append(result, task.TaskID)
+		}
+		return result
+	}
+
+	lastTaskID := taskIDs[len(taskIDs)-1]
+	s.Equal(taskIDs, getTaskIDs(0, lastTaskID, 100))
+	s.Equal(taskIDs[1:5], getTaskIDs(taskIDs[0], lastTaskID, 4))
+	s.Equal(taskIDs[4:6], getTaskIDs(taskIDs[3], taskIDs[5], 100))
+
+	// a segment is only deleted once all of its tasks are completed
+	_, err = s.TaskMgr.CompleteTaskSegmentsLessThan(&p.CompleteTasksLessThanRequest{
+		DomainID:     domainID,
+		TaskListName: taskList,
+		TaskType:     p.TaskListTypeActivity,
+		TaskID:       taskIDs[4],
+		Limit:        100,
+	})
+	s.NoError(err)
+	s.Equal(taskIDs[3:], getTaskIDs(0, lastTaskID, 100))
+
+	// segments can't be created once the task list is owned by someone else
+	_, err = s.TaskMgr.LeaseTaskList(
+		&p.LeaseTaskListRequest{DomainID: domainID, TaskList: taskList, TaskType: p.TaskListTypeActivity})
+	s.NoError(err)
+	_, err = s.TaskMgr.CreateTaskSegment(&p.CreateTasksRequest{
+		TaskListInfo: taskListInfo,
+		Tasks: []*p.CreateTaskInfo{{
+			TaskID:    s.GetNextSequenceNumber(),
+			Execution: wfExec,
+			Data:      &p.TaskInfo{DomainID: domainID, WorkflowID: wfExec.GetWorkflowId(), RunID: wfExec.GetRunId()},
+		}},
+	})
+	s.IsType(&p.ConditionFailedError{}, err)
+}
+
 // TestLeaseAndUpdateTaskList test
 func (s *MatchingPersistenceSuite) TestLeaseAndUpdateTaskList() {
 	domainID := "00136543-72ad-4615-b7e9-44bca9775b45"
@@ -345,6 +434,45 @@ func (s *MatchingPersistenceSuite) TestLeaseAndUpdateTaskList() {
 	s.Error(err)
 }
 
+// TestTaskListDrainState test
+func (s *MatchingPersistenceSuite) TestTaskListDrainState() {
+	domainID := uuid.New()
+	taskList := "drained-tasklist"
+	response, err := s.TaskMgr.LeaseTaskList(&p.LeaseTaskListRequest{
+		DomainID: domainID,
+		TaskList: taskList,
+		TaskType: p.TaskListTypeActivity,
+	})
+	s.NoError(err)
+	tli := response.TaskListInfo
+	s.False(tli.Draining)
+	s.Equal("", tli.DrainTarget)
+
+	_, err = s.TaskMgr.UpdateTaskList(&p.UpdateTaskListRequest{
+		TaskListInfo: &p.TaskListInfo{
+			DomainID:    domainID,
+			Name:        taskList,
+			TaskType:    p.TaskListTypeActivity,
+			RangeID:     tli.RangeID,
+			AckLevel:    0,
+			Kind:        p.TaskListKindNormal,
+			Draining:    true,
+			DrainTarget: "target-tasklist",
+		},
+	})
+	s.NoError(err)
+
+	response, err = s.TaskMgr.LeaseTaskList(&p.LeaseTaskListRequest{
+		DomainID: domainID,
+		TaskList: taskList,
+		TaskType: p.TaskListTypeActivity,
+	})
+	s.NoError(err)
+	tli = response.TaskListInfo
+	s.True(tli.Draining)
+	s.Equal("target-tasklist", tli.DrainTarget)
+}
+
 // TestLeaseAndUpdateTaskListSticky test
 func (s *MatchingPersistenceSuite) TestLeaseAndUpdateTaskListSticky() {
 	domainID := uuid.New()
@@ -535,6 +535,7 @@ func (m *MetadataPersistenceSuiteV2) TestConcurrentUpdateDomain() {
 					ActiveClusterName: resp2.ReplicationConfig.ActiveClusterName,
 					Clusters:          resp2.ReplicationConfig.Clusters,
 				},
+				resp2.IsGlobalDomain,
 				resp2.ConfigVersion,
 				resp2.FailoverVersion,
 				resp2.FailoverNotificationVersion,
@@ -703,6 +704,7 @@ func (m *MetadataPersistenceSuiteV2) TestUpdateDomain() {
 			ActiveClusterName: updateClusterActive,
 			Clusters:          updateClusters,
 		},
+		isGlobalDomain,
 		updateConfigVersion,
 		updateFailoverVersion,
 		updateFailoverNotificationVersion,
@@ -1038,11 +1040,12 @@ func (m *MetadataPersistenceSuiteV2) GetDomain(id, name string) (*p.GetDomainRes
 
 // UpdateDomain helper method
 func (m *MetadataPersistenceSuiteV2) UpdateDomain(info *p.DomainInfo, config *p.DomainConfig, replicationConfig *p.DomainReplicationConfig,
-	configVersion int64, failoverVersion int64, failoverNotificationVersion int64, notificationVersion int64) error {
+	isGlobalDomain bool, configVersion int64, failoverVersion int64, failoverNotificationVersion int64, notificationVersion int64) error {
 	return m.MetadataManager.UpdateDomain(&p.UpdateDomainRequest{
 		Info:                        info,
 		Config:                      config,
 		ReplicationConfig:           replicationConfig,
+		IsGlobalDomain:              isGlobalDomain,
 		ConfigVersion:               configVersion,
 		FailoverVersion:             failoverVersion,
 		FailoverNotificationVersion: failoverNotificationVersion,
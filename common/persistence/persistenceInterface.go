@@ -79,6 +79,9 @@ type (
 		CompleteReplicationTask(request *CompleteReplicationTaskRequest) error
 		PutReplicationTaskToDLQ(request *PutReplicationTaskToDLQRequest) error
 		GetReplicationTasksFromDLQ(request *GetReplicationTasksFromDLQRequest) (*GetReplicationTasksFromDLQResponse, error)
+		DeleteReplicationTaskFromDLQ(request *DeleteReplicationTaskFromDLQRequest) error
+		RangeDeleteReplicationTaskFromDLQ(request *RangeDeleteReplicationTaskFromDLQRequest) error
+		GetReplicationDLQSize(request *GetReplicationDLQSizeRequest) (*GetReplicationDLQSizeResponse, error)
 
 		// Timer related methods.
 		GetTimerIndexTasks(request *GetTimerIndexTasksRequest) (*GetTimerIndexTasksResponse, error)
@@ -281,6 +284,8 @@ type (
 		LastFailureReason  string
 		LastWorkerIdentity string
 		LastFailureDetails []byte
+		// task list partition which dispatched the started attempt
+		StartedTaskList string
 		// Not written to database - This is used only for deduping heartbeat timer creation
 		LastHeartbeatTimeoutVisibility int64
 	}
@@ -601,6 +606,7 @@ type (
 		Info              *DomainInfo
 		Config            *InternalDomainConfig
 		ReplicationConfig *DomainReplicationConfig
+		ReplicationFilter *DataBlob
 		IsGlobalDomain    bool
 		ConfigVersion     int64
 		FailoverVersion   int64
@@ -616,6 +622,9 @@ type (
 		FailoverVersion             int64
 		FailoverNotificationVersion int64
 		NotificationVersion         int64
+		FailoverInfo                *DataBlob
+		FailoverHistory             *DataBlob
+		ReplicationFilter           *DataBlob
 	}
 
 	// InternalUpdateDomainRequest is used to update domain
@@ -623,10 +632,14 @@ type (
 		Info                        *DomainInfo
 		Config                      *InternalDomainConfig
 		ReplicationConfig           *DomainReplicationConfig
+		IsGlobalDomain              bool
 		ConfigVersion               int64
 		FailoverVersion             int64
 		FailoverNotificationVersion int64
 		NotificationVersion         int64
+		FailoverInfo                *DataBlob
+		FailoverHistory             *DataBlob
+		ReplicationFilter           *DataBlob
 	}
 
 	// InternalListDomainsResponse is the response for GetDomain
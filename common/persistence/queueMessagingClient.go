@@ -0,0 +1,607 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/uber/cadence/.gen/go/indexer"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/codec"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/messaging"
+	"github.com/uber/cadence/common/metrics"
+)
+
+const (
+	queueConsumerPollInterval  = time.Second
+	queueConsumerAckInterval   = 5 * time.Second
+	queueConsumerReadBatchSize = 100
+
+	defaultQueueMessagingPartitions = 8
+
+	// queuePartitionTypeOffset separates the queue types of the partitions of a queue. The first
+	// partition uses the queue type itself, the others add a multiple of this offset to it
+	queuePartitionTypeOffset = 1000
+
+	emptyQueueMessageID = -1
+)
+
+var (
+	// ErrUnknownMessagingApplication is returned when the queue based messaging client is asked for an application it does not serve
+	ErrUnknownMessagingApplication = errors.New("unknown messaging application")
+	// ErrQueueReplicationConsumerNotSupported is returned when a replication consumer is created without a replication
+	// messaging client, remote clusters then fetch replication tasks through the rpc replication consumer instead
+	ErrQueueReplicationConsumerNotSupported = errors.New("queue based messaging only supports the rpc replication consumer")
+
+	errQueueConsumerStopped = errors.New("queue consumer is stopped")
+
+	queueEnqueueRetryPolicy = createQueueEnqueueRetryPolicy()
+)
+
+type (
+	// QueueFactory returns the persistence queue of the given type owned by the current cluster
+	QueueFactory func(queueType common.QueueType) (Queue, error)
+
+	// QueuePartitionOwner returns true when the current host consumes the partition with the given key
+	QueuePartitionOwner func(partitionKey string) bool
+
+	queueMessagingClient struct {
+		queueFactory      QueueFactory
+		numPartitions     int
+		consumerGroups    []string
+		partitionOwner    QueuePartitionOwner
+		replicationClient messaging.Client
+		metricsClient     metrics.Client
+		logger            log.Logger
+	}
+
+	queueProducer struct {
+		partitions []Queue
+		// partitionLocks serialize the enqueues of the host to a partition, so that concurrent
+		// publishes of the host do not conflict with each other on the next message ID
+		partitionLocks []sync.Mutex
+		encoder        codec.BinaryEncoder
+		logger         log.Logger
+	}
+
+	// discardProducer drops the replication tasks published by history when no replication messaging
+	// client is configured, remote clusters then fetch replication tasks from history through rpc
+	discardProducer struct{}
+
+	queueConsumer struct {
+		partitions     []*queuePartitionConsumer
+		dlq            Queue
+		consumerName   string
+		consumerGroups []string
+		partitionOwner QueuePartitionOwner
+		pollInterval   time.Duration
+		ackInterval    time.Duration
+		logger         log.Logger
+		msgC           chan messaging.Message
+		doneC          chan struct{}
+		shutdownWG     sync.WaitGroup
+	}
+
+	// queuePartitionConsumer reads one partition of a queue while it is owned by the current host.
+	// The epoch changes each time the ownership changes, acks of messages read during a previous
+	// ownership are ignored as the partition is read again from its persisted ack level
+	queuePartitionConsumer struct {
+		sync.Mutex
+		partition int
+		queue     Queue
+		logger    log.Logger
+
+		owned             bool
+		epoch             int
+		readLevel         int
+		ackLevel          int
+		persistedAckLevel int
+		outstanding       []int
+		acked             map[int]bool
+	}
+
+	queueMessage struct {
+		partition *queuePartitionConsumer
+		dlq       Queue
+		epoch     int
+		id        int
+		payload   []byte
+	}
+)
+
+var _ messaging.Client = (*queueMessagingClient)(nil)
+var _ messaging.CloseableProducer = (*queueProducer)(nil)
+var _ messaging.CloseableProducer = (*discardProducer)(nil)
+var _ messaging.Consumer = (*queueConsumer)(nil)
+var _ messaging.Message = (*queueMessage)(nil)
+
+// NewQueueMessagingClient creates a messaging client backed by the persistence queue instead of Kafka.
+// Visibility messages go to a queue of the current cluster which is split into the given number of
+// partitions, 8 by default. A partition holds the messages of a workflow so they are consumed in order. The
+// number of partitions can only be increased. The consumers of a consumer group share the partitions
+// based on the owner given to WithQueuePartitionOwner, without one a consumer reads all partitions.
+// Messages are only purged once acked by all the given consumer groups and all groups which ever consumed
+// the queue, the given groups are registered by producers so that messages are kept until they consume them.
+// Replication tasks are delegated to the given replication client, when it is nil they are dropped
+// as remote clusters fetch them from history through the rpc replication consumer.
+func NewQueueMessagingClient(
+	queueFactory QueueFactory,
+	numPartitions int,
+	consumerGroups []string,
+	replicationClient messaging.Client,
+	metricsClient metrics.Client,
+	logger log.Logger,
+) messaging.Client {
+	if numPartitions <= 0 {
+		numPartitions = defaultQueueMessagingPartitions
+	}
+	return &queueMessagingClient{
+		queueFactory:      queueFactory,
+		numPartitions:     numPartitions,
+		consumerGroups:    consumerGroups,
+		replicationClient: replicationClient,
+		metricsClient:     metricsClient,
+		logger:            logger,
+	}
+}
+
+// WithQueuePartitionOwner returns a copy of a queue based messaging client whose consumers only read the
+// partitions owned by the current host. Other messaging clients are returned as they are, as they balance
+// partitions between consumers by themselves
+func WithQueuePartitionOwner(client messaging.Client, owner QueuePartitionOwner) messaging.Client {
+	queueClient, ok := client.(*queueMessagingClient)
+	if !ok {
+		return client
+	}
+	result := *queueClient
+	result.partitionOwner = owner
+	return &result
+}
+
+// NewConsumer is used to create a consumer of the queue backing the given application
+func (c *queueMessagingClient) NewConsumer(appName, consumerName string, concurrency int) (messaging.Consumer, error) {
+	if appName != common.VisibilityAppName {
+		return nil, ErrUnknownMessagingApplication
+	}
+	return c.newConsumerHelper(common.VisibilityQueueType, common.VisibilityDLQQueueType, consumerName, concurrency)
+}
+
+// NewConsumerWithClusterName is used to create a consumer of the replication tasks published by the source cluster
+func (c *queueMessagingClient) NewConsumerWithClusterName(currentCluster, sourceCluster, consumerName string, concurrency int) (messaging.Consumer, error) {
+	if c.replicationClient == nil {
+		return nil, ErrQueueReplicationConsumerNotSupported
+	}
+	return c.replicationClient.NewConsumerWithClusterName(currentCluster, sourceCluster, consumerName, concurrency)
+}
+
+// NewProducer is used to create a producer to the queue backing the given application
+func (c *queueMessagingClient) NewProducer(appName string) (messaging.Producer, error) {
+	if appName != common.VisibilityAppName {
+		return nil, ErrUnknownMessagingApplication
+	}
+	return c.newProducerHelper(common.VisibilityQueueType)
+}
+
+// NewProducerWithClusterName is used to create a producer of the replication tasks of the source cluster
+func (c *queueMessagingClient) NewProducerWithClusterName(sourceCluster string) (messaging.Producer, error) {
+	if c.replicationClient == nil {
+		return &discardProducer{}, nil
+	}
+	return c.replicationClient.NewProducerWithClusterName(sourceCluster)
+}
+
+func (c *queueMessagingClient) newProducerHelper(queueType common.QueueType) (messaging.Producer, error) {
+	partitions, err := c.newPartitions(queueType)
+	if err != nil {
+		return nil, err
+	}
+	if err := registerConsumerGroups(partitions, c.consumerGroups); err != nil {
+		return nil, err
+	}
+
+	producer := &queueProducer{
+		partitions:     partitions,
+		partitionLocks: make([]sync.Mutex, len(partitions)),
+		encoder:        codec.NewThriftRWEncoder(),
+		logger:         c.logger.WithTags(tag.QueueType(int(queueType))),
+	}
+	if c.metricsClient != nil {
+		return messaging.NewMetricProducer(producer, c.metricsClient), nil
+	}
+	return producer, nil
+}
+
+func (c *queueMessagingClient) newConsumerHelper(
+	queueType common.QueueType,
+	dlqType common.QueueType,
+	consumerName string,
+	concurrency int,
+) (messaging.Consumer, error) {
+	partitions, err := c.newPartitions(queueType)
+	if err != nil {
+		return nil, err
+	}
+	consumerGroups := append([]string{consumerName}, c.consumerGroups...)
+	if err := registerConsumerGroups(partitions, consumerGroups); err != nil {
+		return nil, err
+	}
+	dlq, err := c.queueFactory(dlqType)
+	if err != nil {
+		return nil, err
+	}
+
+	logger := c.logger.WithTags(tag.QueueType(int(queueType)), tag.QueueConsumerName(consumerName))
+	consumer := &queueConsumer{
+		dlq:            dlq,
+		consumerName:   consumerName,
+		consumerGroups: consumerGroups,
+		partitionOwner: c.partitionOwner,
+		pollInterval:   queueConsumerPollInterval,
+		ackInterval:    queueConsumerAckInterval,
+		logger:         logger,
+		msgC:           make(chan messaging.Message, common.MaxInt(concurrency, 1)),
+		doneC:          make(chan struct{}),
+	}
+	for partition, queue := range partitions {
+		consumer.partitions = append(consumer.partitions, &queuePartitionConsumer{
+			partition: partition,
+			queue:     queue,
+			logger:    logger.WithTags(tag.KafkaPartition(int32(partition))),
+		})
+	}
+	return consumer, nil
+}
+
+func (c *queueMessagingClient) newPartitions(queueType common.QueueType) ([]Queue, error) {
+	partitions := make([]Queue, c.numPartitions)
+	for partition := range partitions {
+		queue, err := c.queueFactory(common.QueueType(int(queueType) + partition*queuePartitionTypeOffset))
+		if err != nil {
+			return nil, err
+		}
+		partitions[partition] = queue
+	}
+	return partitions, nil
+}
+
+// Publish encodes the message and appends it to the partition of its workflow
+func (p *queueProducer) Publish(message interface{}) error {
+	msg, ok := message.(*indexer.Message)
+	if !ok {
+		return errors.New("unknown producer message type")
+	}
+	payload, err := p.encoder.Encode(msg)
+	if err != nil {
+		p.logger.Error("Failed to serialize thrift object", tag.Error(err))
+		return err
+	}
+
+	partition := common.WorkflowIDToHistoryShard(msg.GetWorkflowID(), len(p.partitions))
+	p.partitionLocks[partition].Lock()
+	err = enqueueWithRetry(p.partitions[partition], payload)
+	p.partitionLocks[partition].Unlock()
+	if err != nil {
+		p.logger.Warn("Failed to publish message to queue", tag.KafkaPartition(int32(partition)), tag.Error(err))
+		return err
+	}
+	return nil
+}
+
+// Close is a no-op, the underlying queues are owned by the persistence factory
+func (p *queueProducer) Close() error {
+	return nil
+}
+
+// Publish drops the message
+func (p *discardProducer) Publish(message interface{}) error {
+	return nil
+}
+
+// Close is a no-op
+func (p *discardProducer) Close() error {
+	return nil
+}
+
+// registerConsumerGroups adds an empty ack level for the consumer groups which have none yet,
+// so that the messages are not purged before the groups consume them
+func registerConsumerGroups(partitions []Queue, consumerGroups []string) error {
+	for _, queue := range partitions {
+		ackLevels, err := queue.GetAckLevels()
+		if err != nil {
+			return err
+		}
+		for _, consumerGroup := range consumerGroups {
+			if _, ok := ackLevels[consumerGroup]; ok {
+				continue
+			}
+			if err := queue.UpdateAckLevel(emptyQueueMessageID, consumerGroup); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// createQueueEnqueueRetryPolicy retries conflicting enqueues quickly, the jitter of the
+// exponential backoff spreads the retries of hosts enqueueing to the same partition
+func createQueueEnqueueRetryPolicy() backoff.RetryPolicy {
+	policy := backoff.NewExponentialRetryPolicy(10 * time.Millisecond)
+	policy.SetMaximumInterval(time.Second)
+	policy.SetExpirationInterval(30 * time.Second)
+	return policy
+}
+
+// enqueueWithRetry retries the enqueues which conflict with a concurrent enqueue to the same queue
+func enqueueWithRetry(queue Queue, payload []byte) error {
+	return backoff.Retry(
+		func() error {
+			return queue.EnqueueMessage(payload)
+		},
+		queueEnqueueRetryPolicy,
+		func(err error) bool {
+			_, ok := err.(*ConditionFailedError)
+			return ok
+		},
+	)
+}
+
+// Start starts polling the partitions owned by the current host
+func (c *queueConsumer) Start() error {
+	c.shutdownWG.Add(1)
+	go c.pollLoop()
+	return nil
+}
+
+// Stop stops polling and persists the current ack levels
+func (c *queueConsumer) Stop() {
+	c.logger.Info("Stopping consumer")
+	close(c.doneC)
+	c.shutdownWG.Wait()
+}
+
+// Messages return the message channel for this consumer
+func (c *queueConsumer) Messages() <-chan messaging.Message {
+	return c.msgC
+}
+
+func (c *queueConsumer) pollLoop() {
+	defer c.shutdownWG.Done()
+	defer close(c.msgC)
+
+	pollTimer := time.NewTimer(0)
+	defer pollTimer.Stop()
+	ackTicker := time.NewTicker(c.ackInterval)
+	defer ackTicker.Stop()
+
+	for {
+		select {
+		case <-c.doneC:
+			c.persistAckLevels()
+			c.logger.Info("Stop consuming messages from queue")
+			return
+		case <-ackTicker.C:
+			c.persistAckLevels()
+		case <-pollTimer.C:
+			hasMore := false
+			for _, partition := range c.partitions {
+				if !c.updateOwnership(partition) {
+					continue
+				}
+				count, err := c.poll(partition)
+				if err == errQueueConsumerStopped {
+					break
+				}
+				if err != nil {
+					partition.logger.Warn("Failed to read messages from queue", tag.Error(err))
+				}
+				hasMore = hasMore || count == queueConsumerReadBatchSize
+			}
+			if hasMore {
+				pollTimer.Reset(0)
+			} else {
+				pollTimer.Reset(c.pollInterval)
+			}
+		}
+	}
+}
+
+// updateOwnership starts reading a partition from its persisted ack level once the current host owns it,
+// and stops reading it once it is owned by another host. It returns true if the partition is owned
+func (c *queueConsumer) updateOwnership(p *queuePartitionConsumer) bool {
+	owned := c.partitionOwner == nil || c.partitionOwner(fmt.Sprintf("%v/%v", c.consumerName, p.partition))
+	if owned == p.isOwned() {
+		return owned
+	}
+
+	if !owned {
+		p.persistAckLevel(c.consumerName, c.consumerGroups)
+		p.Lock()
+		p.owned = false
+		p.epoch++
+		p.Unlock()
+		p.logger.Info("Stop consuming queue partition owned by another host")
+		return false
+	}
+
+	ackLevels, err := p.queue.GetAckLevels()
+	if err != nil {
+		p.logger.Warn("Failed to load ack level", tag.Error(err))
+		return false
+	}
+	ackLevel, ok := ackLevels[c.consumerName]
+	if !ok {
+		ackLevel = emptyQueueMessageID
+	}
+
+	p.Lock()
+	defer p.Unlock()
+	p.owned = true
+	p.epoch++
+	p.readLevel = ackLevel
+	p.ackLevel = ackLevel
+	p.persistedAckLevel = ackLevel
+	p.outstanding = nil
+	p.acked = make(map[int]bool)
+	p.logger.Info("Start consuming queue partition", tag.ReadLevel(int64(ackLevel)))
+	return true
+}
+
+func (c *queueConsumer) poll(p *queuePartitionConsumer) (int, error) {
+	p.Lock()
+	readLevel := p.readLevel
+	epoch := p.epoch
+	p.Unlock()
+
+	messages, err := p.queue.ReadMessages(readLevel, queueConsumerReadBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, message := range messages {
+		p.Lock()
+		p.outstanding = append(p.outstanding, message.ID)
+		p.readLevel = message.ID
+		p.Unlock()
+
+		select {
+		case c.msgC <- &queueMessage{partition: p, dlq: c.dlq, epoch: epoch, id: message.ID, payload: message.Payload}:
+		case <-c.doneC:
+			return 0, errQueueConsumerStopped
+		}
+	}
+	return len(messages), nil
+}
+
+func (c *queueConsumer) persistAckLevels() {
+	for _, partition := range c.partitions {
+		if partition.isOwned() {
+			partition.persistAckLevel(c.consumerName, c.consumerGroups)
+		}
+	}
+}
+
+func (p *queuePartitionConsumer) isOwned() bool {
+	p.Lock()
+	defer p.Unlock()
+	return p.owned
+}
+
+func (p *queuePartitionConsumer) ack(epoch int, id int) {
+	p.Lock()
+	defer p.Unlock()
+
+	if epoch != p.epoch {
+		return
+	}
+	p.acked[id] = true
+	for len(p.outstanding) > 0 && p.acked[p.outstanding[0]] {
+		p.ackLevel = p.outstanding[0]
+		delete(p.acked, p.outstanding[0])
+		p.outstanding = p.outstanding[1:]
+	}
+}
+
+func (p *queuePartitionConsumer) persistAckLevel(consumerName string, consumerGroups []string) {
+	p.Lock()
+	ackLevel := p.ackLevel
+	persistedAckLevel := p.persistedAckLevel
+	p.Unlock()
+
+	if ackLevel <= persistedAckLevel {
+		return
+	}
+	if err := p.queue.UpdateAckLevel(ackLevel, consumerName); err != nil {
+		p.logger.Warn("Failed to update queue ack level", tag.Error(err))
+		return
+	}
+	p.Lock()
+	p.persistedAckLevel = ackLevel
+	p.Unlock()
+
+	if err := p.purgeAckedMessages(consumerGroups); err != nil {
+		p.logger.Warn("Failed to purge acked queue messages", tag.Error(err))
+	}
+}
+
+// purgeAckedMessages deletes the messages acked by all consumer groups, the known consumer groups
+// without an ack level have not consumed any message yet
+func (p *queuePartitionConsumer) purgeAckedMessages(consumerGroups []string) error {
+	ackLevels, err := p.queue.GetAckLevels()
+	if err != nil {
+		return err
+	}
+
+	minAckLevel := math.MaxInt64
+	for _, ackLevel := range ackLevels {
+		if ackLevel < minAckLevel {
+			minAckLevel = ackLevel
+		}
+	}
+	for _, consumerGroup := range consumerGroups {
+		if _, ok := ackLevels[consumerGroup]; !ok {
+			minAckLevel = emptyQueueMessageID
+		}
+	}
+	if minAckLevel == emptyQueueMessageID {
+		return nil
+	}
+	if minAckLevel == math.MaxInt64 {
+		return nil
+	}
+	return p.queue.DeleteMessagesBefore(minAckLevel)
+}
+
+// Value is the encoded message
+func (m *queueMessage) Value() []byte {
+	return m.payload
+}
+
+// Partition is the partition of the queue holding the message
+func (m *queueMessage) Partition() int32 {
+	return int32(m.partition.partition)
+}
+
+// Offset is the ID of the message in its partition
+func (m *queueMessage) Offset() int64 {
+	return int64(m.id)
+}
+
+// Ack marks the message as successfully processed
+func (m *queueMessage) Ack() error {
+	m.partition.ack(m.epoch, m.id)
+	return nil
+}
+
+// Nack moves the message to the DLQ of the consumer and acks it
+func (m *queueMessage) Nack() error {
+	if err := enqueueWithRetry(m.dlq, m.payload); err != nil {
+		return err
+	}
+	m.partition.ack(m.epoch, m.id)
+	return nil
+}
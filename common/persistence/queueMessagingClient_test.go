@@ -0,0 +1,416 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/.gen/go/indexer"
+	"github.com/uber/cadence/.gen/go/replicator"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/codec"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/messaging"
+)
+
+type (
+	queueMessagingClientSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		queues  map[common.QueueType]*inMemoryQueue
+		encoder codec.BinaryEncoder
+	}
+
+	inMemoryQueue struct {
+		sync.Mutex
+		nextID          int
+		messages        []*QueueMessage
+		ackLevels       map[string]int
+		enqueueConflict int
+	}
+
+	replicationMessagingClient struct {
+		messaging.Client
+		producer messaging.Producer
+	}
+)
+
+func TestQueueMessagingClientSuite(t *testing.T) {
+	s := new(queueMessagingClientSuite)
+	suite.Run(t, s)
+}
+
+func (s *queueMessagingClientSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.queues = make(map[common.QueueType]*inMemoryQueue)
+	s.encoder = codec.NewThriftRWEncoder()
+}
+
+func (s *queueMessagingClientSuite) newClient(numPartitions int, replicationClient messaging.Client, consumerGroups ...string) messaging.Client {
+	queueFactory := func(queueType common.QueueType) (Queue, error) {
+		return s.getQueue(queueType), nil
+	}
+	return NewQueueMessagingClient(queueFactory, numPartitions, consumerGroups, replicationClient, nil, loggerimpl.NewNopLogger())
+}
+
+func (s *queueMessagingClientSuite) getQueue(queueType common.QueueType) *inMemoryQueue {
+	queue, ok := s.queues[queueType]
+	if !ok {
+		queue = &inMemoryQueue{ackLevels: make(map[string]int)}
+		s.queues[queueType] = queue
+	}
+	return queue
+}
+
+func (s *queueMessagingClientSuite) newConsumer(consumer messaging.Consumer, err error) *queueConsumer {
+	s.NoError(err)
+	c := consumer.(*queueConsumer)
+	c.pollInterval = 10 * time.Millisecond
+	c.ackInterval = 10 * time.Millisecond
+	return c
+}
+
+func (s *queueMessagingClientSuite) publish(producer messaging.Producer, workflowIDs ...string) {
+	for _, workflowID := range workflowIDs {
+		s.NoError(producer.Publish(&indexer.Message{WorkflowID: common.StringPtr(workflowID)}))
+	}
+}
+
+func (s *queueMessagingClientSuite) receive(consumer messaging.Consumer) messaging.Message {
+	select {
+	case msg := <-consumer.Messages():
+		return msg
+	case <-time.After(5 * time.Second):
+		s.FailNow("timed out waiting for message")
+		return nil
+	}
+}
+
+func (s *queueMessagingClientSuite) receiveWorkflowID(consumer messaging.Consumer) (messaging.Message, string) {
+	msg := s.receive(consumer)
+	var indexMsg indexer.Message
+	s.NoError(s.encoder.Decode(msg.Value(), &indexMsg))
+	return msg, indexMsg.GetWorkflowID()
+}
+
+func (s *queueMessagingClientSuite) TestUnknownApplication() {
+	client := s.newClient(1, nil)
+	_, err := client.NewProducer("unknown")
+	s.Equal(ErrUnknownMessagingApplication, err)
+	_, err = client.NewConsumer("unknown", "consumer", 1)
+	s.Equal(ErrUnknownMessagingApplication, err)
+}
+
+func (s *queueMessagingClientSuite) TestPublishAndConsume_Visibility() {
+	client := s.newClient(1, nil)
+	producer, err := client.NewProducer(common.VisibilityAppName)
+	s.NoError(err)
+	s.publish(producer, "wid-0", "wid-1", "wid-2")
+	s.Error(producer.Publish("not a thrift message"))
+
+	consumer := s.newConsumer(client.NewConsumer(common.VisibilityAppName, "indexer", 10))
+	s.NoError(consumer.Start())
+	for i := 0; i < 3; i++ {
+		msg, workflowID := s.receiveWorkflowID(consumer)
+		s.Equal(fmt.Sprintf("wid-%v", i), workflowID)
+		s.Equal(int64(i), msg.Offset())
+		s.NoError(msg.Ack())
+	}
+	consumer.Stop()
+
+	ackLevels, err := s.getQueue(common.VisibilityQueueType).GetAckLevels()
+	s.NoError(err)
+	s.Equal(map[string]int{"indexer": 2}, ackLevels)
+}
+
+func (s *queueMessagingClientSuite) TestPublish_RetriesConflictingEnqueue() {
+	client := s.newClient(1, nil)
+	producer, err := client.NewProducer(common.VisibilityAppName)
+	s.NoError(err)
+
+	queue := s.getQueue(common.VisibilityQueueType)
+	queue.enqueueConflict = 2
+	s.publish(producer, "wid")
+	messages, err := queue.ReadMessages(emptyQueueMessageID, 10)
+	s.NoError(err)
+	s.Len(messages, 1)
+}
+
+func (s *queueMessagingClientSuite) TestPartitions_SharedBetweenConsumers() {
+	client := s.newClient(4, nil)
+	producer, err := client.NewProducer(common.VisibilityAppName)
+	s.NoError(err)
+	var workflowIDs []string
+	for i := 0; i < 20; i++ {
+		workflowIDs = append(workflowIDs, fmt.Sprintf("wid-%v", i))
+	}
+	s.publish(producer, workflowIDs...)
+	for partition := 0; partition < 4; partition++ {
+		queueType := common.QueueType(int(common.VisibilityQueueType) + partition*queuePartitionTypeOffset)
+		s.NotEmpty(s.getQueue(queueType).messages)
+	}
+
+	ownerOf := func(partitionKey string) int {
+		var partition int
+		_, err := fmt.Sscanf(partitionKey, "indexer/%d", &partition)
+		s.NoError(err)
+		return partition % 2
+	}
+	var consumers []*queueConsumer
+	for host := 0; host < 2; host++ {
+		host := host
+		hostClient := WithQueuePartitionOwner(client, func(partitionKey string) bool {
+			return ownerOf(partitionKey) == host
+		})
+		consumer := s.newConsumer(hostClient.NewConsumer(common.VisibilityAppName, "indexer", 20))
+		s.NoError(consumer.Start())
+		consumers = append(consumers, consumer)
+	}
+
+	received := make(map[string]bool)
+	for len(received) < len(workflowIDs) {
+		for host, consumer := range consumers {
+			select {
+			case msg := <-consumer.Messages():
+				var indexMsg indexer.Message
+				s.NoError(s.encoder.Decode(msg.Value(), &indexMsg))
+				s.Equal(host, int(msg.Partition())%2)
+				s.Equal(int(msg.Partition()), common.WorkflowIDToHistoryShard(indexMsg.GetWorkflowID(), 4))
+				s.False(received[indexMsg.GetWorkflowID()])
+				received[indexMsg.GetWorkflowID()] = true
+			case <-time.After(10 * time.Millisecond):
+			}
+		}
+	}
+	for _, consumer := range consumers {
+		consumer.Stop()
+	}
+}
+
+func (s *queueMessagingClientSuite) TestPartitionOwnership_Moves() {
+	client := s.newClient(1, nil)
+	producer, err := client.NewProducer(common.VisibilityAppName)
+	s.NoError(err)
+	s.publish(producer, "wid-0", "wid-1")
+
+	var lock sync.Mutex
+	owned := true
+	consumer := s.newConsumer(WithQueuePartitionOwner(client, func(string) bool {
+		lock.Lock()
+		defer lock.Unlock()
+		return owned
+	}).NewConsumer(common.VisibilityAppName, "indexer", 1))
+	s.NoError(consumer.Start())
+	msg := s.receive(consumer)
+	s.NoError(msg.Ack())
+	second := s.receive(consumer)
+
+	// the ack level is persisted when the partition moves, the other host resumes from it
+	lock.Lock()
+	owned = false
+	lock.Unlock()
+	s.Eventually(func() bool {
+		return !consumer.partitions[0].isOwned()
+	}, 5*time.Second, 10*time.Millisecond)
+	s.NoError(second.Ack())
+	consumer.Stop()
+
+	ackLevels, err := s.getQueue(common.VisibilityQueueType).GetAckLevels()
+	s.NoError(err)
+	s.Equal(map[string]int{"indexer": 0}, ackLevels)
+
+	consumer = s.newConsumer(client.NewConsumer(common.VisibilityAppName, "indexer", 1))
+	s.NoError(consumer.Start())
+	_, workflowID := s.receiveWorkflowID(consumer)
+	s.Equal("wid-1", workflowID)
+	consumer.Stop()
+}
+
+func (s *queueMessagingClientSuite) TestAckLevel_OutOfOrderAndResume() {
+	client := s.newClient(1, nil)
+	producer, err := client.NewProducer(common.VisibilityAppName)
+	s.NoError(err)
+	s.publish(producer, "wid-0", "wid-1", "wid-2")
+
+	consumer := s.newConsumer(client.NewConsumer(common.VisibilityAppName, "indexer", 10))
+	s.NoError(consumer.Start())
+	first := s.receive(consumer)
+	second := s.receive(consumer)
+	s.receive(consumer)
+	// the ack level can not move past the first message until it is acked
+	s.NoError(second.Ack())
+	s.NoError(first.Ack())
+	consumer.Stop()
+
+	consumer = s.newConsumer(client.NewConsumer(common.VisibilityAppName, "indexer", 10))
+	s.NoError(consumer.Start())
+	msg := s.receive(consumer)
+	s.Equal(int64(2), msg.Offset())
+	consumer.Stop()
+}
+
+func (s *queueMessagingClientSuite) TestPurge_WaitsForAllConsumerGroups() {
+	client := s.newClient(1, nil, "indexer", "history-indexer")
+	producer, err := client.NewProducer(common.VisibilityAppName)
+	s.NoError(err)
+	queue := s.getQueue(common.VisibilityQueueType)
+	ackLevels, err := queue.GetAckLevels()
+	s.NoError(err)
+	s.Equal(map[string]int{"indexer": emptyQueueMessageID, "history-indexer": emptyQueueMessageID}, ackLevels)
+	s.publish(producer, "wid-0", "wid-1", "wid-2")
+
+	consume := func(consumerName string) {
+		consumer := s.newConsumer(client.NewConsumer(common.VisibilityAppName, consumerName, 10))
+		s.NoError(consumer.Start())
+		for i := 0; i < 3; i++ {
+			s.NoError(s.receive(consumer).Ack())
+		}
+		consumer.Stop()
+	}
+
+	// the messages are kept until the registered consumer group which has not started yet consumes them
+	consume("indexer")
+	messages, err := queue.ReadMessages(emptyQueueMessageID, 10)
+	s.NoError(err)
+	s.Len(messages, 3)
+
+	consume("history-indexer")
+	messages, err = queue.ReadMessages(emptyQueueMessageID, 10)
+	s.NoError(err)
+	s.Len(messages, 1)
+	s.Equal(2, messages[0].ID)
+}
+
+func (s *queueMessagingClientSuite) TestNack_MovesMessageToDLQ() {
+	client := s.newClient(1, nil)
+	producer, err := client.NewProducer(common.VisibilityAppName)
+	s.NoError(err)
+	s.publish(producer, "wid")
+
+	consumer := s.newConsumer(client.NewConsumer(common.VisibilityAppName, "indexer", 1))
+	s.NoError(consumer.Start())
+	msg := s.receive(consumer)
+	s.NoError(msg.Nack())
+	consumer.Stop()
+
+	dlqMessages, err := s.getQueue(common.VisibilityDLQQueueType).ReadMessages(emptyQueueMessageID, 10)
+	s.NoError(err)
+	s.Len(dlqMessages, 1)
+	s.Equal(msg.Value(), dlqMessages[0].Payload)
+	ackLevels, err := s.getQueue(common.VisibilityQueueType).GetAckLevels()
+	s.NoError(err)
+	s.Equal(0, ackLevels["indexer"])
+}
+
+func (s *queueMessagingClientSuite) TestReplication() {
+	task := &replicator.ReplicationTask{
+		TaskType: replicator.ReplicationTaskTypeHistory.Ptr(),
+	}
+
+	// without a replication client, tasks are fetched by remote clusters through rpc
+	client := s.newClient(1, nil)
+	producer, err := client.NewProducerWithClusterName("active")
+	s.NoError(err)
+	s.NoError(producer.Publish(task))
+	s.Empty(s.queues)
+	_, err = client.NewConsumerWithClusterName("standby", "active", "consumer", 1)
+	s.Equal(ErrQueueReplicationConsumerNotSupported, err)
+
+	replicationProducer := &discardProducer{}
+	client = s.newClient(1, &replicationMessagingClient{producer: replicationProducer})
+	producer, err = client.NewProducerWithClusterName("active")
+	s.NoError(err)
+	s.Equal(replicationProducer, producer)
+}
+
+func (c *replicationMessagingClient) NewProducerWithClusterName(sourceCluster string) (messaging.Producer, error) {
+	return c.producer, nil
+}
+
+func (q *inMemoryQueue) EnqueueMessage(messagePayload []byte) error {
+	q.Lock()
+	defer q.Unlock()
+
+	if q.enqueueConflict > 0 {
+		q.enqueueConflict--
+		return &ConditionFailedError{Msg: "message ID exists in queue"}
+	}
+	q.messages = append(q.messages, &QueueMessage{ID: q.nextID, Payload: messagePayload})
+	q.nextID++
+	return nil
+}
+
+func (q *inMemoryQueue) ReadMessages(lastMessageID int, maxCount int) ([]*QueueMessage, error) {
+	q.Lock()
+	defer q.Unlock()
+
+	var result []*QueueMessage
+	for _, message := range q.messages {
+		if message.ID > lastMessageID && len(result) < maxCount {
+			result = append(result, message)
+		}
+	}
+	return result, nil
+}
+
+func (q *inMemoryQueue) DeleteMessagesBefore(messageID int) error {
+	q.Lock()
+	defer q.Unlock()
+
+	var remaining []*QueueMessage
+	for _, message := range q.messages {
+		if message.ID >= messageID {
+			remaining = append(remaining, message)
+		}
+	}
+	q.messages = remaining
+	return nil
+}
+
+func (q *inMemoryQueue) UpdateAckLevel(messageID int, clusterName string) error {
+	q.Lock()
+	defer q.Unlock()
+
+	if ackLevel, ok := q.ackLevels[clusterName]; !ok || ackLevel < messageID {
+		q.ackLevels[clusterName] = messageID
+	}
+	return nil
+}
+
+func (q *inMemoryQueue) GetAckLevels() (map[string]int, error) {
+	q.Lock()
+	defer q.Unlock()
+
+	result := make(map[string]int, len(q.ackLevels))
+	for k, v := range q.ackLevels {
+		result[k] = v
+	}
+	return result, nil
+}
+
+func (q *inMemoryQueue) Close() {}
@@ -53,6 +53,18 @@ type (
 		SerializeBadBinaries(event *workflow.BadBinaries, encodingType common.EncodingType) (*DataBlob, error)
 		DeserializeBadBinaries(data *DataBlob) (*workflow.BadBinaries, error)
 
+		// serialize/deserialize domain failover info
+		SerializeDomainFailoverInfo(info *workflow.DomainFailoverInfo, encodingType common.EncodingType) (*DataBlob, error)
+		DeserializeDomainFailoverInfo(data *DataBlob) (*workflow.DomainFailoverInfo, error)
+
+		// serialize/deserialize domain failover history
+		SerializeDomainFailoverHistory(records []*workflow.DomainFailoverRecord, encodingType common.EncodingType) (*DataBlob, error)
+		DeserializeDomainFailoverHistory(data *DataBlob) ([]*workflow.DomainFailoverRecord, error)
+
+		// serialize/deserialize domain replication filter
+		SerializeDomainReplicationFilter(filter *workflow.DomainReplicationFilter, encodingType common.EncodingType) (*DataBlob, error)
+		DeserializeDomainReplicationFilter(data *DataBlob) (*workflow.DomainReplicationFilter, error)
+
 		// serialize/deserialize version histories
 		SerializeVersionHistories(histories *workflow.VersionHistories, encodingType common.EncodingType) (*DataBlob, error)
 		DeserializeVersionHistories(data *DataBlob) (*workflow.VersionHistories, error)
@@ -143,6 +155,54 @@ func (t *serializerImpl) DeserializeBadBinaries(data *DataBlob) (*workflow.BadBi
 	return &bb, err
 }
 
+func (t *serializerImpl) SerializeDomainFailoverInfo(info *workflow.DomainFailoverInfo, encodingType common.EncodingType) (*DataBlob, error) {
+	if info == nil {
+		return nil, nil
+	}
+	return t.serialize(info, encodingType)
+}
+
+func (t *serializerImpl) DeserializeDomainFailoverInfo(data *DataBlob) (*workflow.DomainFailoverInfo, error) {
+	if data == nil {
+		return nil, nil
+	}
+	var info workflow.DomainFailoverInfo
+	err := t.deserialize(data, &info)
+	return &info, err
+}
+
+func (t *serializerImpl) SerializeDomainFailoverHistory(records []*workflow.DomainFailoverRecord, encodingType common.EncodingType) (*DataBlob, error) {
+	if len(records) == 0 {
+		return nil, nil
+	}
+	return t.serialize(&workflow.DomainFailoverHistory{Records: records}, encodingType)
+}
+
+func (t *serializerImpl) DeserializeDomainFailoverHistory(data *DataBlob) ([]*workflow.DomainFailoverRecord, error) {
+	if data == nil {
+		return nil, nil
+	}
+	var history workflow.DomainFailoverHistory
+	err := t.deserialize(data, &history)
+	return history.Records, err
+}
+
+func (t *serializerImpl) SerializeDomainReplicationFilter(filter *workflow.DomainReplicationFilter, encodingType common.EncodingType) (*DataBlob, error) {
+	if filter == nil {
+		return nil, nil
+	}
+	return t.serialize(filter, encodingType)
+}
+
+func (t *serializerImpl) DeserializeDomainReplicationFilter(data *DataBlob) (*workflow.DomainReplicationFilter, error) {
+	if data == nil {
+		return nil, nil
+	}
+	var filter workflow.DomainReplicationFilter
+	err := t.deserialize(data, &filter)
+	return &filter, err
+}
+
 func (t *serializerImpl) SerializeVisibilityMemo(memo *workflow.Memo, encodingType common.EncodingType) (*DataBlob, error) {
 	if memo == nil {
 		// Return nil here to be consistent with Event
@@ -207,6 +267,12 @@ func (t *serializerImpl) thriftrwEncode(input interface{}) ([]byte, error) {
 		return t.thriftrwEncoder.Encode(input.(*workflow.ResetPoints))
 	case *workflow.BadBinaries:
 		return t.thriftrwEncoder.Encode(input.(*workflow.BadBinaries))
+	case *workflow.DomainFailoverInfo:
+		return t.thriftrwEncoder.Encode(input.(*workflow.DomainFailoverInfo))
+	case *workflow.DomainFailoverHistory:
+		return t.thriftrwEncoder.Encode(input.(*workflow.DomainFailoverHistory))
+	case *workflow.DomainReplicationFilter:
+		return t.thriftrwEncoder.Encode(input.(*workflow.DomainReplicationFilter))
 	case *workflow.VersionHistories:
 		return t.thriftrwEncoder.Encode(input.(*workflow.VersionHistories))
 	default:
@@ -262,6 +328,15 @@ func (t *serializerImpl) thriftrwDecode(data []byte, target interface{}) error {
 		rp := target.(*workflow.BadBinaries)
 		t.thriftrwEncoder.Decode(data, rp)
 		return nil
+	case *workflow.DomainFailoverInfo:
+		info := target.(*workflow.DomainFailoverInfo)
+		return t.thriftrwEncoder.Decode(data, info)
+	case *workflow.DomainFailoverHistory:
+		history := target.(*workflow.DomainFailoverHistory)
+		return t.thriftrwEncoder.Decode(data, history)
+	case *workflow.DomainReplicationFilter:
+		filter := target.(*workflow.DomainReplicationFilter)
+		return t.thriftrwEncoder.Decode(data, filter)
 	case *workflow.VersionHistories:
 		rp := target.(*workflow.VersionHistories)
 		t.thriftrwEncoder.Decode(data, rp)
@@ -409,3 +409,30 @@ func (s *cadenceSerializerSuite) TestSerializer() {
 	succ := common.AwaitWaitGroup(&doneWG, 10*time.Second)
 	s.True(succ, "test timed out")
 }
+
+func (s *cadenceSerializerSuite) TestDomainFailoverHistory() {
+	serializer := NewPayloadSerializer()
+
+	blob, err := serializer.SerializeDomainFailoverHistory(nil, common.EncodingTypeThriftRW)
+	s.NoError(err)
+	s.Nil(blob)
+
+	var history []*workflow.DomainFailoverRecord
+	for version := int64(0); version < MaxDomainFailoverHistorySize+2; version++ {
+		history = AppendDomainFailoverRecord(history, &workflow.DomainFailoverRecord{
+			FailoverVersion: common.Int64Ptr(version),
+			FromCluster:     common.StringPtr("some random source cluster"),
+			ToCluster:       common.StringPtr("some random target cluster"),
+			Timestamp:       common.Int64Ptr(time.Now().UnixNano()),
+			Graceful:        common.BoolPtr(true),
+		})
+	}
+	s.Len(history, MaxDomainFailoverHistorySize)
+	s.Equal(int64(MaxDomainFailoverHistorySize+1), history[0].GetFailoverVersion())
+
+	blob, err = serializer.SerializeDomainFailoverHistory(history, common.EncodingTypeThriftRW)
+	s.NoError(err)
+	deserialized, err := serializer.DeserializeDomainFailoverHistory(blob)
+	s.NoError(err)
+	s.Equal(history, deserialized)
+}
@@ -174,6 +174,15 @@ func taskInfoFromBlob(b []byte, proto string) (*sqlblobs.TaskInfo, error) {
 	return result, thriftRWDecode(b, proto, result)
 }
 
+func taskSegmentInfoToBlob(info *sqlblobs.TaskSegmentInfo) (p.DataBlob, error) {
+	return thriftRWEncode(info)
+}
+
+func taskSegmentInfoFromBlob(b []byte, proto string) (*sqlblobs.TaskSegmentInfo, error) {
+	result := &sqlblobs.TaskSegmentInfo{}
+	return result, thriftRWDecode(b, proto, result)
+}
+
 func taskListInfoToBlob(info *sqlblobs.TaskListInfo) (p.DataBlob, error) {
 	return thriftRWEncode(info)
 }
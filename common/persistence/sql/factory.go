@@ -29,15 +29,17 @@ import (
 	"github.com/uber/cadence/common/persistence/sql/storage"
 	"github.com/uber/cadence/common/persistence/sql/storage/sqldb"
 	"github.com/uber/cadence/common/service/config"
+	"github.com/uber/cadence/common/service/dynamicconfig"
 )
 
 type (
 	// Factory vends store objects backed by MySQL
 	Factory struct {
-		cfg         config.SQL
-		dbConn      dbConn
-		clusterName string
-		logger      log.Logger
+		cfg                   config.SQL
+		dbConn                dbConn
+		clusterName           string
+		validSearchAttributes dynamicconfig.MapPropertyFn
+		logger                log.Logger
 	}
 
 	// dbConn represents a logical mysql connection - its a
@@ -53,12 +55,13 @@ type (
 
 // NewFactory returns an instance of a factory object which can be used to create
 // datastores backed by any kind of SQL store
-func NewFactory(cfg config.SQL, clusterName string, logger log.Logger) *Factory {
+func NewFactory(cfg config.SQL, clusterName string, validSearchAttributes dynamicconfig.MapPropertyFn, logger log.Logger) *Factory {
 	return &Factory{
-		cfg:         cfg,
-		clusterName: clusterName,
-		logger:      logger,
-		dbConn:      newRefCountedDBConn(&cfg),
+		cfg:                   cfg,
+		clusterName:           clusterName,
+		validSearchAttributes: validSearchAttributes,
+		logger:                logger,
+		dbConn:                newRefCountedDBConn(&cfg),
 	}
 }
 
@@ -109,7 +112,7 @@ func (f *Factory) NewExecutionStore(shardID int) (p.ExecutionStore, error) {
 
 // NewVisibilityStore returns a visibility store
 func (f *Factory) NewVisibilityStore() (p.VisibilityStore, error) {
-	return NewSQLVisibilityStore(f.cfg, f.logger)
+	return NewSQLVisibilityStore(f.cfg, f.validSearchAttributes, f.logger)
 }
 
 // NewQueue returns a new queue backed by sql
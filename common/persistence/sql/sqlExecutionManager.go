@@ -1040,6 +1040,58 @@ func (m *sqlExecutionManager) GetReplicationTasksFromDLQ(
 	}
 }
 
+func (m *sqlExecutionManager) DeleteReplicationTaskFromDLQ(
+	request *p.DeleteReplicationTaskFromDLQRequest,
+) error {
+
+	if _, err := m.db.DeleteMessageFromReplicationTasksDLQ(
+		request.SourceClusterName,
+		m.shardID,
+		request.TaskID,
+	); err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("DeleteReplicationTaskFromDLQ operation failed. Error: %v", err),
+		}
+	}
+	return nil
+}
+
+func (m *sqlExecutionManager) RangeDeleteReplicationTaskFromDLQ(
+	request *p.RangeDeleteReplicationTaskFromDLQRequest,
+) error {
+
+	if _, err := m.db.RangeDeleteMessageFromReplicationTasksDLQ(&sqldb.ReplicationTasksDLQFilter{
+		ReplicationTasksFilter: sqldb.ReplicationTasksFilter{
+			ShardID:   m.shardID,
+			MinTaskID: request.ExclusiveBeginTaskID,
+			MaxTaskID: request.InclusiveEndTaskID,
+		},
+		SourceClusterName: request.SourceClusterName,
+	}); err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("RangeDeleteReplicationTaskFromDLQ operation failed. Error: %v", err),
+		}
+	}
+	return nil
+}
+
+func (m *sqlExecutionManager) GetReplicationDLQSize(
+	request *p.GetReplicationDLQSizeRequest,
+) (*p.GetReplicationDLQSizeResponse, error) {
+
+	size, err := m.db.SelectCountFromReplicationTasksDLQ(request.SourceClusterName, m.shardID)
+	switch err {
+	case nil:
+		return &p.GetReplicationDLQSizeResponse{Size: size}, nil
+	case sql.ErrNoRows:
+		return &p.GetReplicationDLQSizeResponse{Size: 0}, nil
+	default:
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("GetReplicationDLQSize operation failed. Error: %v", err),
+		}
+	}
+}
+
 type timerTaskPageToken struct {
 	TaskID    int64
 	Timestamp time.Time
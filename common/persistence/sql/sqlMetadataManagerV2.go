@@ -100,6 +100,12 @@ func (m *sqlMetadataManagerV2) CreateDomain(request *persistence.InternalCreateD
 		badBinaries = request.Config.BadBinaries.Data
 		badBinariesEncoding = common.StringPtr(string(request.Config.BadBinaries.GetEncoding()))
 	}
+	var replicationFilter []byte
+	var replicationFilterEncoding *string
+	if request.ReplicationFilter != nil {
+		replicationFilter = request.ReplicationFilter.Data
+		replicationFilterEncoding = common.StringPtr(string(request.ReplicationFilter.GetEncoding()))
+	}
 	domainInfo := &sqlblobs.DomainInfo{
 		Status:                      common.Int32Ptr(int32(request.Info.Status)),
 		Description:                 &request.Info.Description,
@@ -121,6 +127,8 @@ func (m *sqlMetadataManagerV2) CreateDomain(request *persistence.InternalCreateD
 		FailoverNotificationVersion: common.Int64Ptr(persistence.InitialFailoverNotificationVersion),
 		BadBinaries:                 badBinaries,
 		BadBinariesEncoding:         badBinariesEncoding,
+		ReplicationFilter:           replicationFilter,
+		ReplicationFilterEncoding:   replicationFilterEncoding,
 	}
 
 	blob, err := domainInfoToBlob(domainInfo)
@@ -216,6 +224,18 @@ func (m *sqlMetadataManagerV2) domainRowToGetDomainResponse(row *sqldb.DomainRow
 	if domainInfo.BadBinaries != nil {
 		badBinaries = persistence.NewDataBlob(domainInfo.BadBinaries, common.EncodingType(*domainInfo.BadBinariesEncoding))
 	}
+	var failoverInfo *persistence.DataBlob
+	if domainInfo.FailoverInfo != nil {
+		failoverInfo = persistence.NewDataBlob(domainInfo.FailoverInfo, common.EncodingType(domainInfo.GetFailoverInfoEncoding()))
+	}
+	var failoverHistory *persistence.DataBlob
+	if domainInfo.FailoverHistory != nil {
+		failoverHistory = persistence.NewDataBlob(domainInfo.FailoverHistory, common.EncodingType(domainInfo.GetFailoverHistoryEncoding()))
+	}
+	var replicationFilter *persistence.DataBlob
+	if domainInfo.ReplicationFilter != nil {
+		replicationFilter = persistence.NewDataBlob(domainInfo.ReplicationFilter, common.EncodingType(domainInfo.GetReplicationFilterEncoding()))
+	}
 
 	return &persistence.InternalGetDomainResponse{
 		Info: &persistence.DomainInfo{
@@ -246,6 +266,9 @@ func (m *sqlMetadataManagerV2) domainRowToGetDomainResponse(row *sqldb.DomainRow
 		ConfigVersion:               domainInfo.GetConfigVersion(),
 		NotificationVersion:         domainInfo.GetNotificationVersion(),
 		FailoverNotificationVersion: domainInfo.GetFailoverNotificationVersion(),
+		FailoverInfo:                failoverInfo,
+		FailoverHistory:             failoverHistory,
+		ReplicationFilter:           replicationFilter,
 	}, nil
 }
 
@@ -261,6 +284,24 @@ func (m *sqlMetadataManagerV2) UpdateDomain(request *persistence.InternalUpdateD
 		badBinaries = request.Config.BadBinaries.Data
 		badBinariesEncoding = common.StringPtr(string(request.Config.BadBinaries.GetEncoding()))
 	}
+	var failoverInfo []byte
+	var failoverInfoEncoding *string
+	if request.FailoverInfo != nil {
+		failoverInfo = request.FailoverInfo.Data
+		failoverInfoEncoding = common.StringPtr(string(request.FailoverInfo.GetEncoding()))
+	}
+	var failoverHistory []byte
+	var failoverHistoryEncoding *string
+	if request.FailoverHistory != nil {
+		failoverHistory = request.FailoverHistory.Data
+		failoverHistoryEncoding = common.StringPtr(string(request.FailoverHistory.GetEncoding()))
+	}
+	var replicationFilter []byte
+	var replicationFilterEncoding *string
+	if request.ReplicationFilter != nil {
+		replicationFilter = request.ReplicationFilter.Data
+		replicationFilterEncoding = common.StringPtr(string(request.ReplicationFilter.GetEncoding()))
+	}
 	domainInfo := &sqlblobs.DomainInfo{
 		Status:                      common.Int32Ptr(int32(request.Info.Status)),
 		Description:                 &request.Info.Description,
@@ -282,6 +323,12 @@ func (m *sqlMetadataManagerV2) UpdateDomain(request *persistence.InternalUpdateD
 		FailoverNotificationVersion: common.Int64Ptr(request.FailoverNotificationVersion),
 		BadBinaries:                 badBinaries,
 		BadBinariesEncoding:         badBinariesEncoding,
+		FailoverInfo:                failoverInfo,
+		FailoverInfoEncoding:        failoverInfoEncoding,
+		FailoverHistory:             failoverHistory,
+		FailoverHistoryEncoding:     failoverHistoryEncoding,
+		ReplicationFilter:           replicationFilter,
+		ReplicationFilterEncoding:   replicationFilterEncoding,
 	}
 
 	blob, err := domainInfoToBlob(domainInfo)
@@ -293,6 +340,7 @@ func (m *sqlMetadataManagerV2) UpdateDomain(request *persistence.InternalUpdateD
 		result, err := tx.UpdateDomain(&sqldb.DomainRow{
 			Name:         request.Info.Name,
 			ID:           sqldb.MustParseUUID(request.Info.ID),
+			IsGlobal:     request.IsGlobalDomain,
 			Data:         blob.Data,
 			DataEncoding: string(blob.Encoding),
 		})
@@ -122,7 +122,7 @@ func (q *sqlQueue) UpdateAckLevel(messageID int, clusterName string) error {
 		}
 
 		// Ignore possibly delayed message
-		if clusterAckLevels[clusterName] > messageID {
+		if ackLevel, ok := clusterAckLevels[clusterName]; ok && ackLevel > messageID {
 			return nil
 		}
 
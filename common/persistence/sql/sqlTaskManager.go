@@ -156,6 +156,8 @@ func (m *sqlTaskManager) LeaseTaskList(request *persistence.LeaseTaskListRequest
 			AckLevel:    ackLevel,
 			Kind:        request.TaskListKind,
 			LastUpdated: now,
+			Draining:    tlInfo.GetDraining(),
+			DrainTarget: tlInfo.GetDrainTarget(),
 		}}
 		return nil
 	})
@@ -170,6 +172,8 @@ func (m *sqlTaskManager) UpdateTaskList(request *persistence.UpdateTaskListReque
 		Kind:             common.Int16Ptr(int16(request.TaskListInfo.Kind)),
 		ExpiryTimeNanos:  common.Int64Ptr(0),
 		LastUpdatedNanos: common.TimeNowNanosPtr(),
+		Draining:         common.BoolPtr(request.TaskListInfo.Draining),
+		DrainTarget:      common.StringPtr(request.TaskListInfo.DrainTarget),
 	}
 	if request.TaskListInfo.Kind == persistence.TaskListKindSticky {
 		tlInfo.ExpiryTimeNanos = common.Int64Ptr(stickyTaskListTTL().UnixNano())
@@ -297,6 +301,8 @@ func (m *sqlTaskManager) ListTaskList(request *persistence.ListTaskListRequest)
 		resp.Items[i].AckLevel = info.GetAckLevel()
 		resp.Items[i].Expiry = time.Unix(0, info.GetExpiryTimeNanos())
 		resp.Items[i].LastUpdated = time.Unix(0, info.GetLastUpdatedNanos())
+		resp.Items[i].Draining = info.GetDraining()
+		resp.Items[i].DrainTarget = info.GetDrainTarget()
 	}
 
 	return resp, nil
@@ -337,6 +343,7 @@ func (m *sqlTaskManager) CreateTasks(request *persistence.CreateTasksRequest) (*
 			ScheduleID:       &v.Data.ScheduleID,
 			ExpiryTimeNanos:  common.Int64Ptr(expiryTime.UnixNano()),
 			CreatedTimeNanos: common.Int64Ptr(time.Now().UnixNano()),
+			ActivityType:     common.StringPtr(v.Data.ActivityType),
 		})
 		if err != nil {
 			return nil, err
@@ -392,13 +399,14 @@ func (m *sqlTaskManager) GetTasks(request *persistence.GetTasksRequest) (*persis
 			return nil, err
 		}
 		tasks[i] = &persistence.TaskInfo{
-			DomainID:    request.DomainID,
-			WorkflowID:  info.GetWorkflowID(),
-			RunID:       sqldb.UUID(info.RunID).String(),
-			TaskID:      v.TaskID,
-			ScheduleID:  info.GetScheduleID(),
-			Expiry:      time.Unix(0, info.GetExpiryTimeNanos()),
-			CreatedTime: time.Unix(0, info.GetCreatedTimeNanos()),
+			DomainID:     request.DomainID,
+			WorkflowID:   info.GetWorkflowID(),
+			RunID:        sqldb.UUID(info.RunID).String(),
+			TaskID:       v.TaskID,
+			ScheduleID:   info.GetScheduleID(),
+			Expiry:       time.Unix(0, info.GetExpiryTimeNanos()),
+			CreatedTime:  time.Unix(0, info.GetCreatedTimeNanos()),
+			ActivityType: info.GetActivityType(),
 		}
 	}
 
@@ -439,6 +447,125 @@ func (m *sqlTaskManager) CompleteTasksLessThan(request *persistence.CompleteTask
 	return int(nRows), nil
 }
 
+func (m *sqlTaskManager) CreateTaskSegment(request *persistence.CreateTasksRequest) (*persistence.CreateTasksResponse, error) {
+	if len(request.Tasks) == 0 {
+		return &persistence.CreateTasksResponse{}, nil
+	}
+	segment := &sqlblobs.TaskSegmentInfo{Tasks: make([]*sqlblobs.TaskInfo, len(request.Tasks))}
+	for i, v := range request.Tasks {
+		var expiryTime time.Time
+		if v.Data.ScheduleToStartTimeout > 0 {
+			expiryTime = time.Now().Add(time.Second * time.Duration(v.Data.ScheduleToStartTimeout))
+		}
+		segment.Tasks[i] = &sqlblobs.TaskInfo{
+			WorkflowID:       common.StringPtr(v.Data.WorkflowID),
+			RunID:            sqldb.MustParseUUID(v.Data.RunID),
+			ScheduleID:       common.Int64Ptr(v.Data.ScheduleID),
+			ExpiryTimeNanos:  common.Int64Ptr(expiryTime.UnixNano()),
+			CreatedTimeNanos: common.Int64Ptr(time.Now().UnixNano()),
+			ActivityType:     common.StringPtr(v.Data.ActivityType),
+			TaskID:           common.Int64Ptr(v.TaskID),
+		}
+	}
+	blob, err := taskSegmentInfoToBlob(segment)
+	if err != nil {
+		return nil, err
+	}
+	row := &sqldb.TasksRow{
+		DomainID:     sqldb.MustParseUUID(request.TaskListInfo.DomainID),
+		TaskListName: request.TaskListInfo.Name,
+		TaskType:     int64(request.TaskListInfo.TaskType),
+		TaskID:       request.Tasks[len(request.Tasks)-1].TaskID,
+		Data:         blob.Data,
+		DataEncoding: string(blob.Encoding),
+	}
+	var resp *persistence.CreateTasksResponse
+	err = m.txExecute("CreateTaskSegment", func(tx sqldb.Tx) error {
+		if _, err1 := tx.InsertIntoTaskSegments(row); err1 != nil {
+			return err1
+		}
+		// Lock task list before committing.
+		err1 := lockTaskList(tx,
+			m.shardID(request.TaskListInfo.DomainID, request.TaskListInfo.Name),
+			sqldb.MustParseUUID(request.TaskListInfo.DomainID),
+			request.TaskListInfo.Name,
+			request.TaskListInfo.TaskType, request.TaskListInfo.RangeID)
+		if err1 != nil {
+			return err1
+		}
+		resp = &persistence.CreateTasksResponse{}
+		return nil
+	})
+	return resp, err
+}
+
+func (m *sqlTaskManager) GetTaskSegments(request *persistence.GetTasksRequest) (*persistence.GetTasksResponse, error) {
+	// segments are keyed by the id of their last task, the first segment
+	// returned may contain tasks which are below the read level
+	rows, err := m.db.SelectFromTaskSegments(&sqldb.TasksFilter{
+		DomainID:     sqldb.MustParseUUID(request.DomainID),
+		TaskListName: request.TaskList,
+		TaskType:     int64(request.TaskType),
+		MinTaskID:    &request.ReadLevel,
+		PageSize:     &request.BatchSize,
+	})
+	if err != nil {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("GetTaskSegments operation failed. Failed to get rows. Error: %v", err),
+		}
+	}
+
+	response := &persistence.GetTasksResponse{}
+PopulateTasks:
+	for _, v := range rows {
+		segment, err := taskSegmentInfoFromBlob(v.Data, v.DataEncoding)
+		if err != nil {
+			return nil, err
+		}
+		for _, info := range segment.GetTasks() {
+			if info.GetTaskID() <= request.ReadLevel {
+				continue
+			}
+			if (request.MaxReadLevel != nil && info.GetTaskID() > *request.MaxReadLevel) ||
+				len(response.Tasks) == request.BatchSize {
+				break PopulateTasks
+			}
+			response.Tasks = append(response.Tasks, &persistence.TaskInfo{
+				DomainID:     request.DomainID,
+				WorkflowID:   info.GetWorkflowID(),
+				RunID:        sqldb.UUID(info.RunID).String(),
+				TaskID:       info.GetTaskID(),
+				ScheduleID:   info.GetScheduleID(),
+				Expiry:       time.Unix(0, info.GetExpiryTimeNanos()),
+				CreatedTime:  time.Unix(0, info.GetCreatedTimeNanos()),
+				ActivityType: info.GetActivityType(),
+			})
+		}
+	}
+
+	return response, nil
+}
+
+func (m *sqlTaskManager) CompleteTaskSegmentsLessThan(request *persistence.CompleteTasksLessThanRequest) (int, error) {
+	result, err := m.db.DeleteFromTaskSegments(&sqldb.TasksFilter{
+		DomainID:             sqldb.MustParseUUID(request.DomainID),
+		TaskListName:         request.TaskListName,
+		TaskType:             int64(request.TaskType),
+		TaskIDLessThanEquals: &request.TaskID,
+		Limit:                &request.Limit,
+	})
+	if err != nil {
+		return 0, &workflow.InternalServiceError{Message: err.Error()}
+	}
+	nRows, err := result.RowsAffected()
+	if err != nil {
+		return 0, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("rowsAffected returned error: %v", err),
+		}
+	}
+	return int(nRows), nil
+}
+
 func (m *sqlTaskManager) shardID(domainID string, name string) int {
 	id := farm.Hash32([]byte(domainID+"_"+name)) % uint32(m.nShards)
 	return int(id)
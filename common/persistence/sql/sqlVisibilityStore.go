@@ -28,40 +28,55 @@ import (
 
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/definition"
 	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
 	p "github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/sql/storage"
 	"github.com/uber/cadence/common/persistence/sql/storage/sqldb"
 	"github.com/uber/cadence/common/service/config"
+	"github.com/uber/cadence/common/service/dynamicconfig"
 )
 
 type (
 	sqlVisibilityStore struct {
 		sqlStore
+		validSearchAttributes dynamicconfig.MapPropertyFn
 	}
 
 	visibilityPageToken struct {
 		Time  time.Time
 		RunID string
+		// for queries with order by, the sort value and workflowID of the last row
+		SortValue  json.RawMessage
+		WorkflowID string
 	}
 )
 
 // NewSQLVisibilityStore creates an instance of ExecutionStore
-func NewSQLVisibilityStore(cfg config.SQL, logger log.Logger) (p.VisibilityStore, error) {
+func NewSQLVisibilityStore(cfg config.SQL, validSearchAttributes dynamicconfig.MapPropertyFn, logger log.Logger) (p.VisibilityStore, error) {
 	db, err := storage.NewSQLDB(&cfg)
 	if err != nil {
 		return nil, err
 	}
+	if validSearchAttributes == nil {
+		validSearchAttributes = dynamicconfig.GetMapPropertyFn(definition.GetDefaultIndexedKeys())
+	}
 	return &sqlVisibilityStore{
 		sqlStore: sqlStore{
 			db:     db,
 			logger: logger,
 		},
+		validSearchAttributes: validSearchAttributes,
 	}, nil
 }
 
 func (s *sqlVisibilityStore) RecordWorkflowExecutionStarted(request *p.InternalRecordWorkflowExecutionStartedRequest) error {
-	_, err := s.db.InsertIntoVisibility(&sqldb.VisibilityRow{
+	searchAttributes, err := serializeSearchAttributes(request.SearchAttributes)
+	if err != nil {
+		return err
+	}
+	_, err = s.db.InsertIntoVisibility(&sqldb.VisibilityRow{
 		DomainID:         request.DomainUUID,
 		WorkflowID:       request.WorkflowID,
 		RunID:            request.RunID,
@@ -70,12 +85,17 @@ func (s *sqlVisibilityStore) RecordWorkflowExecutionStarted(request *p.InternalR
 		WorkflowTypeName: request.WorkflowTypeName,
 		Memo:             request.Memo.Data,
 		Encoding:         string(request.Memo.GetEncoding()),
+		SearchAttributes: searchAttributes,
 	})
 
 	return err
 }
 
 func (s *sqlVisibilityStore) RecordWorkflowExecutionClosed(request *p.InternalRecordWorkflowExecutionClosedRequest) error {
+	searchAttributes, err := serializeSearchAttributes(request.SearchAttributes)
+	if err != nil {
+		return err
+	}
 	closeTime := time.Unix(0, request.CloseTimestamp)
 	result, err := s.db.ReplaceIntoVisibility(&sqldb.VisibilityRow{
 		DomainID:         request.DomainUUID,
@@ -89,6 +109,7 @@ func (s *sqlVisibilityStore) RecordWorkflowExecutionClosed(request *p.InternalRe
 		HistoryLength:    &request.HistoryLength,
 		Memo:             request.Memo.Data,
 		Encoding:         string(request.Memo.GetEncoding()),
+		SearchAttributes: searchAttributes,
 	})
 	if err != nil {
 		return err
@@ -104,7 +125,27 @@ func (s *sqlVisibilityStore) RecordWorkflowExecutionClosed(request *p.InternalRe
 }
 
 func (s *sqlVisibilityStore) UpsertWorkflowExecution(request *p.InternalUpsertWorkflowExecutionRequest) error {
-	return p.NewOperationNotSupportErrorForVis()
+	searchAttributes, err := serializeSearchAttributes(request.SearchAttributes)
+	if err != nil {
+		return err
+	}
+	_, err = s.db.UpsertIntoVisibility(&sqldb.VisibilityRow{
+		DomainID:         request.DomainUUID,
+		WorkflowID:       request.WorkflowID,
+		RunID:            request.RunID,
+		StartTime:        time.Unix(0, request.StartTimestamp),
+		ExecutionTime:    time.Unix(0, request.ExecutionTimestamp),
+		WorkflowTypeName: request.WorkflowTypeName,
+		Memo:             request.Memo.Data,
+		Encoding:         string(request.Memo.GetEncoding()),
+		SearchAttributes: searchAttributes,
+	})
+	if err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("UpsertWorkflowExecution operation failed. Error: %v", err),
+		}
+	}
+	return nil
 }
 
 func (s *sqlVisibilityStore) ListOpenWorkflowExecutions(request *p.ListWorkflowExecutionsRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
@@ -250,15 +291,40 @@ func (s *sqlVisibilityStore) DeleteWorkflowExecution(request *p.VisibilityDelete
 }
 
 func (s *sqlVisibilityStore) ListWorkflowExecutions(request *p.ListWorkflowExecutionsRequestV2) (*p.InternalListWorkflowExecutionsResponse, error) {
-	return nil, p.NewOperationNotSupportErrorForVis()
+	query, err := convertVisibilityQuery(request.Query, s.getValidSearchAttributes(request.Domain))
+	if err != nil {
+		return nil, &workflow.BadRequestError{Message: fmt.Sprintf("Error when parse query: %v", err)}
+	}
+	return s.listWorkflowExecutionsByQuery("ListWorkflowExecutions", request, query)
 }
 
 func (s *sqlVisibilityStore) ScanWorkflowExecutions(request *p.ListWorkflowExecutionsRequestV2) (*p.InternalListWorkflowExecutionsResponse, error) {
-	return nil, p.NewOperationNotSupportErrorForVis()
+	query, err := convertVisibilityQuery(request.Query, s.getValidSearchAttributes(request.Domain))
+	if err != nil {
+		return nil, &workflow.BadRequestError{Message: fmt.Sprintf("Error when parse query: %v", err)}
+	}
+	// scan does not guarantee any order, so the default order is used for pagination
+	query.orderBy = ""
+	query.sortColumn = ""
+	return s.listWorkflowExecutionsByQuery("ScanWorkflowExecutions", request, query)
 }
 
 func (s *sqlVisibilityStore) CountWorkflowExecutions(request *p.CountWorkflowExecutionsRequest) (*p.CountWorkflowExecutionsResponse, error) {
-	return nil, p.NewOperationNotSupportErrorForVis()
+	query, err := convertVisibilityQuery(request.Query, s.getValidSearchAttributes(request.Domain))
+	if err != nil {
+		return nil, &workflow.BadRequestError{Message: fmt.Sprintf("Error when parse query: %v", err)}
+	}
+	count, err := s.db.CountFromVisibilityByQuery(&sqldb.VisibilityQueryFilter{
+		DomainID:  request.DomainUUID,
+		Condition: query.condition,
+		Args:      query.args,
+	})
+	if err != nil {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("CountWorkflowExecutions operation failed. Select failed: %v", err),
+		}
+	}
+	return &p.CountWorkflowExecutionsResponse{Count: count}, nil
 }
 
 func (s *sqlVisibilityStore) rowToInfo(row *sqldb.VisibilityRow) *p.VisibilityWorkflowExecutionInfo {
@@ -273,6 +339,15 @@ func (s *sqlVisibilityStore) rowToInfo(row *sqldb.VisibilityRow) *p.VisibilityWo
 		ExecutionTime: row.ExecutionTime,
 		Memo:          p.NewDataBlob(row.Memo, common.EncodingType(row.Encoding)),
 	}
+	if len(row.SearchAttributes) > 0 {
+		if err := json.Unmarshal(row.SearchAttributes, &info.SearchAttributes); err != nil {
+			// log and skip search attributes, so the execution is still listed
+			s.logger.Error("Unable to decode search attributes of visibility record",
+				tag.WorkflowID(row.WorkflowID),
+				tag.WorkflowRunID(row.RunID),
+				tag.Error(err))
+		}
+	}
 	if row.CloseStatus != nil {
 		status := workflow.WorkflowExecutionCloseStatus(*row.CloseStatus)
 		info.Status = &status
@@ -325,6 +400,121 @@ func (s *sqlVisibilityStore) listWorkflowExecutions(opName string, pageToken []b
 	}, nil
 }
 
+func (s *sqlVisibilityStore) listWorkflowExecutionsByQuery(opName string, request *p.ListWorkflowExecutionsRequestV2, query *visibilityQuery) (*p.InternalListWorkflowExecutionsResponse, error) {
+	filter := &sqldb.VisibilityQueryFilter{
+		DomainID:  request.DomainUUID,
+		Condition: query.condition,
+		Args:      query.args,
+		OrderBy:   query.orderBy,
+		PageSize:  &request.PageSize,
+	}
+	if len(request.NextPageToken) > 0 {
+		token, err := s.deserializePageToken(request.NextPageToken)
+		if err != nil {
+			return nil, &workflow.BadRequestError{Message: fmt.Sprintf("Invalid next page token: %v", err)}
+		}
+		if query.orderBy != "" {
+			condition, args, err := query.pageCondition(token.SortValue, token.RunID, token.WorkflowID)
+			if err != nil {
+				return nil, &workflow.BadRequestError{Message: fmt.Sprintf("Invalid next page token: %v", err)}
+			}
+			if filter.Condition != "" {
+				condition = "(" + filter.Condition + ") AND " + condition
+			}
+			filter.Condition = condition
+			filter.Args = append(append([]interface{}{}, filter.Args...), args...)
+		} else {
+			filter.LastStartTime = &token.Time
+			filter.LastRunID = &token.RunID
+		}
+	}
+
+	rows, err := s.db.SelectFromVisibilityByQuery(filter)
+	if err != nil {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("%v operation failed. Select failed: %v", opName, err),
+		}
+	}
+
+	infos := make([]*p.VisibilityWorkflowExecutionInfo, len(rows))
+	for i, row := range rows {
+		infos[i] = s.rowToInfo(&row)
+	}
+	var nextPageToken []byte
+	if len(rows) == request.PageSize {
+		lastRow := rows[len(rows)-1]
+		token := &visibilityPageToken{
+			Time:  lastRow.StartTime,
+			RunID: lastRow.RunID,
+		}
+		if query.orderBy != "" {
+			token.WorkflowID = lastRow.WorkflowID
+			if token.SortValue, err = getSortValue(&lastRow, query); err != nil {
+				return nil, &workflow.InternalServiceError{
+					Message: fmt.Sprintf("%v operation failed. Unable to read sort value: %v", opName, err),
+				}
+			}
+		}
+		if nextPageToken, err = s.serializePageToken(token); err != nil {
+			return nil, err
+		}
+	}
+	return &p.InternalListWorkflowExecutionsResponse{
+		Executions:    infos,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (s *sqlVisibilityStore) getValidSearchAttributes(domain string) map[string]interface{} {
+	return definition.MergeValidSearchAttributes(
+		s.validSearchAttributes(),
+		s.validSearchAttributes(dynamicconfig.DomainFilter(domain)),
+	)
+}
+
+// getSortValue returns the JSON encoded value of the sort field of the row, which is null if the
+// custom search attribute is missing
+func getSortValue(row *sqldb.VisibilityRow, query *visibilityQuery) (json.RawMessage, error) {
+	if query.sortCustom {
+		if len(row.SearchAttributes) == 0 {
+			return json.RawMessage("null"), nil
+		}
+		var attributes map[string]json.RawMessage
+		if err := json.Unmarshal(row.SearchAttributes, &attributes); err != nil {
+			return nil, err
+		}
+		if value, ok := attributes[query.sortKey]; ok {
+			return value, nil
+		}
+		return json.RawMessage("null"), nil
+	}
+
+	var value interface{}
+	switch query.sortKey {
+	case definition.DomainID:
+		value = row.DomainID
+	case definition.WorkflowID:
+		value = row.WorkflowID
+	case definition.RunID:
+		value = row.RunID
+	case definition.WorkflowType:
+		value = row.WorkflowTypeName
+	case definition.StartTime:
+		value = row.StartTime
+	case definition.ExecutionTime:
+		value = row.ExecutionTime
+	case definition.CloseTime:
+		value = row.CloseTime
+	case definition.CloseStatus:
+		value = row.CloseStatus
+	case definition.HistoryLength:
+		value = row.HistoryLength
+	default:
+		return nil, fmt.Errorf("unknown sort field %v", query.sortKey)
+	}
+	return json.Marshal(value)
+}
+
 func (s *sqlVisibilityStore) deserializePageToken(data []byte) (*visibilityPageToken, error) {
 	var token visibilityPageToken
 	err := json.Unmarshal(data, &token)
@@ -335,3 +525,20 @@ func (s *sqlVisibilityStore) serializePageToken(token *visibilityPageToken) ([]b
 	data, err := json.Marshal(token)
 	return data, err
 }
+
+// serializeSearchAttributes encodes the search attributes, whose values are already JSON encoded,
+// as a JSON object for the search_attributes column
+func serializeSearchAttributes(searchAttributes map[string][]byte) ([]byte, error) {
+	if len(searchAttributes) == 0 {
+		return nil, nil
+	}
+	attributes := make(map[string]json.RawMessage, len(searchAttributes))
+	for key, value := range searchAttributes {
+		attributes[key] = json.RawMessage(value)
+	}
+	data, err := json.Marshal(attributes)
+	if err != nil {
+		return nil, &workflow.BadRequestError{Message: fmt.Sprintf("Unable to encode search attributes: %v", err)}
+	}
+	return data, nil
+}
@@ -118,6 +118,21 @@ task_id > ? AND
 task_id <= ?
 ORDER BY task_id LIMIT ?`
 
+	getReplicationTaskDLQCountQry = `SELECT count(1) as count FROM replication_tasks_dlq WHERE 
+source_cluster_name = ? AND
+shard_id = ?`
+
+	deleteReplicationTaskFromDLQQry = `DELETE FROM replication_tasks_dlq WHERE 
+source_cluster_name = ? AND
+shard_id = ? AND
+task_id = ?`
+
+	rangeDeleteReplicationTaskFromDLQQry = `DELETE FROM replication_tasks_dlq WHERE 
+source_cluster_name = ? AND
+shard_id = ? AND
+task_id > ? AND
+task_id <= ?`
+
 	bufferedEventsColumns    = `shard_id, domain_id, workflow_id, run_id, data, data_encoding`
 	createBufferedEventsQury = `INSERT INTO buffered_events(` + bufferedEventsColumns + `)
 VALUES (:shard_id, :domain_id, :workflow_id, :run_id, :data, :data_encoding)`
@@ -332,3 +347,27 @@ func (mdb *DB) SelectFromReplicationTasksDLQ(filter *sqldb.ReplicationTasksDLQFi
 		filter.PageSize)
 	return rows, err
 }
+
+// SelectCountFromReplicationTasksDLQ reads the number of rows in replication_tasks_dlq table
+func (mdb *DB) SelectCountFromReplicationTasksDLQ(sourceClusterName string, shardID int) (int64, error) {
+	var size []int64
+	if err := mdb.conn.Select(&size, getReplicationTaskDLQCountQry, sourceClusterName, shardID); err != nil {
+		return 0, err
+	}
+	return size[0], nil
+}
+
+// DeleteMessageFromReplicationTasksDLQ deletes one row from replication_tasks_dlq table
+func (mdb *DB) DeleteMessageFromReplicationTasksDLQ(sourceClusterName string, shardID int, taskID int64) (sql.Result, error) {
+	return mdb.conn.Exec(deleteReplicationTaskFromDLQQry, sourceClusterName, shardID, taskID)
+}
+
+// RangeDeleteMessageFromReplicationTasksDLQ deletes one or more rows from replication_tasks_dlq table
+func (mdb *DB) RangeDeleteMessageFromReplicationTasksDLQ(filter *sqldb.ReplicationTasksDLQFilter) (sql.Result, error) {
+	return mdb.conn.Exec(
+		rangeDeleteReplicationTaskFromDLQQry,
+		filter.SourceClusterName,
+		filter.ShardID,
+		filter.MinTaskID,
+		filter.MaxTaskID)
+}
@@ -80,6 +80,18 @@ task_type = :task_type
 	rangeDeleteTaskQry = `DELETE FROM tasks ` +
 		`WHERE domain_id = ? AND task_list_name = ? AND task_type = ? AND task_id <= ? ` +
 		`ORDER BY domain_id,task_list_name,task_type,task_id LIMIT ?`
+
+	createTaskSegmentQry = `INSERT INTO ` +
+		`task_segments(domain_id, task_list_name, task_type, task_id, data, data_encoding) ` +
+		`VALUES(:domain_id, :task_list_name, :task_type, :task_id, :data, :data_encoding)`
+
+	getTaskSegmentsQry = `SELECT task_id, data, data_encoding ` +
+		`FROM task_segments ` +
+		`WHERE domain_id = ? AND task_list_name = ? AND task_type = ? AND task_id > ? ORDER BY task_id LIMIT ?`
+
+	rangeDeleteTaskSegmentsQry = `DELETE FROM task_segments ` +
+		`WHERE domain_id = ? AND task_list_name = ? AND task_type = ? AND task_id <= ? ` +
+		`ORDER BY domain_id,task_list_name,task_type,task_id LIMIT ?`
 )
 
 // InsertIntoTasks inserts one or more rows into tasks table
@@ -117,6 +129,31 @@ func (mdb *DB) DeleteFromTasks(filter *sqldb.TasksFilter) (sql.Result, error) {
 	return mdb.conn.Exec(deleteTaskQry, filter.DomainID, filter.TaskListName, filter.TaskType, *filter.TaskID)
 }
 
+// InsertIntoTaskSegments inserts a row into task_segments table
+func (mdb *DB) InsertIntoTaskSegments(row *sqldb.TasksRow) (sql.Result, error) {
+	return mdb.conn.NamedExec(createTaskSegmentQry, row)
+}
+
+// SelectFromTaskSegments reads one or more rows from task_segments table
+func (mdb *DB) SelectFromTaskSegments(filter *sqldb.TasksFilter) ([]sqldb.TasksRow, error) {
+	var rows []sqldb.TasksRow
+	err := mdb.conn.Select(&rows, getTaskSegmentsQry, filter.DomainID,
+		filter.TaskListName, filter.TaskType, *filter.MinTaskID, *filter.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// DeleteFromTaskSegments deletes one or more rows from task_segments table
+func (mdb *DB) DeleteFromTaskSegments(filter *sqldb.TasksFilter) (sql.Result, error) {
+	if filter.TaskIDLessThanEquals == nil || filter.Limit == nil || *filter.Limit == 0 {
+		return nil, fmt.Errorf("missing taskIDLessThanEquals or limit parameter")
+	}
+	return mdb.conn.Exec(rangeDeleteTaskSegmentsQry,
+		filter.DomainID, filter.TaskListName, filter.TaskType, *filter.TaskIDLessThanEquals, *filter.Limit)
+}
+
 // InsertIntoTaskLists inserts one or more rows into task_lists table
 func (mdb *DB) InsertIntoTaskLists(row *sqldb.TaskListsRow) (sql.Result, error) {
 	return mdb.conn.NamedExec(createTaskListQry, row)
@@ -24,18 +24,29 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/uber/cadence/common/persistence/sql/storage/sqldb"
 )
 
 const (
 	templateCreateWorkflowExecutionStarted = `INSERT IGNORE INTO executions_visibility (` +
-		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding) ` +
-		`VALUES (?, ?, ?, ?, ?, ?, ?, ?)`
+		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, search_attributes) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`
 
 	templateCreateWorkflowExecutionClosed = `REPLACE INTO executions_visibility (` +
-		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, close_time, close_status, history_length, memo, encoding) ` +
-		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, close_time, close_status, history_length, memo, encoding, search_attributes) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	// closed workflows are not updated, the close record already has the final memo and search attributes
+	templateUpsertWorkflowExecution = `INSERT INTO executions_visibility (` +
+		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, search_attributes) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?) ` +
+		`ON DUPLICATE KEY UPDATE ` +
+		`memo = IF(close_status IS NULL, VALUES(memo), memo), ` +
+		`encoding = IF(close_status IS NULL, VALUES(encoding), encoding), ` +
+		`search_attributes = IF(close_status IS NULL, VALUES(search_attributes), search_attributes)`
 
 	// RunID condition is needed for correct pagination
 	templateConditions = ` AND domain_id = ?
@@ -45,7 +56,7 @@ const (
          ORDER BY start_time DESC, run_id
          LIMIT ?`
 
-	templateOpenFieldNames = `workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding`
+	templateOpenFieldNames = `workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, search_attributes`
 	templateOpenSelect     = `SELECT ` + templateOpenFieldNames + ` FROM executions_visibility WHERE close_status IS NULL `
 
 	templateClosedSelect = `SELECT ` + templateOpenFieldNames + `, close_time, close_status, history_length
@@ -65,12 +76,22 @@ const (
 
 	templateGetClosedWorkflowExecutionsByStatus = templateClosedSelect + `AND close_status = ?` + templateConditions
 
-	templateGetClosedWorkflowExecution = `SELECT workflow_id, run_id, start_time, execution_time, memo, encoding, close_time, workflow_type_name, close_status, history_length 
+	templateGetClosedWorkflowExecution = `SELECT workflow_id, run_id, start_time, execution_time, memo, encoding, close_time, workflow_type_name, close_status, history_length, search_attributes
 		 FROM executions_visibility
 		 WHERE domain_id = ? AND close_status IS NOT NULL
 		 AND run_id = ?`
 
 	templateDeleteWorkflowExecution = "DELETE FROM executions_visibility WHERE domain_id=? AND run_id=?"
+
+	templateSelectByQuery = `SELECT ` + templateOpenFieldNames + `, close_time, close_status, history_length
+		 FROM executions_visibility WHERE domain_id = ?`
+
+	templateCountByQuery = `SELECT COUNT(*) FROM executions_visibility WHERE domain_id = ?`
+
+	// RunID condition is needed for correct pagination, same as templateConditions
+	templateQueryPageConditions = ` AND start_time <= ? AND (run_id > ? OR start_time < ?)`
+
+	templateQueryDefaultOrderBy = `start_time DESC, run_id`
 )
 
 var errCloseParams = errors.New("missing one of {closeStatus, closeTime, historyLength} params")
@@ -87,7 +108,8 @@ func (mdb *DB) InsertIntoVisibility(row *sqldb.VisibilityRow) (sql.Result, error
 		row.ExecutionTime,
 		row.WorkflowTypeName,
 		row.Memo,
-		row.Encoding)
+		row.Encoding,
+		row.SearchAttributes)
 }
 
 // ReplaceIntoVisibility replaces an existing row if it exist or creates a new row in visibility table
@@ -107,7 +129,8 @@ func (mdb *DB) ReplaceIntoVisibility(row *sqldb.VisibilityRow) (sql.Result, erro
 			*row.CloseStatus,
 			*row.HistoryLength,
 			row.Memo,
-			row.Encoding)
+			row.Encoding,
+			row.SearchAttributes)
 	default:
 		return nil, errCloseParams
 	}
@@ -202,3 +225,81 @@ func (mdb *DB) SelectFromVisibility(filter *sqldb.VisibilityFilter) ([]sqldb.Vis
 	}
 	return rows, err
 }
+
+// UpsertIntoVisibility inserts a row into visibility table, or updates the memo and search attributes
+// of the existing row if the workflow is not closed yet
+func (mdb *DB) UpsertIntoVisibility(row *sqldb.VisibilityRow) (sql.Result, error) {
+	row.StartTime = mdb.converter.ToMySQLDateTime(row.StartTime)
+	return mdb.conn.Exec(templateUpsertWorkflowExecution,
+		row.DomainID,
+		row.WorkflowID,
+		row.RunID,
+		row.StartTime,
+		row.ExecutionTime,
+		row.WorkflowTypeName,
+		row.Memo,
+		row.Encoding,
+		row.SearchAttributes)
+}
+
+// SelectFromVisibilityByQuery reads one page of rows matching the query from visibility table
+func (mdb *DB) SelectFromVisibilityByQuery(filter *sqldb.VisibilityQueryFilter) ([]sqldb.VisibilityRow, error) {
+	if filter.PageSize == nil {
+		return nil, fmt.Errorf("invalid query filter")
+	}
+
+	var query strings.Builder
+	query.WriteString(templateSelectByQuery)
+	args := mdb.appendQueryCondition(&query, filter)
+	if filter.LastStartTime != nil && filter.LastRunID != nil {
+		lastStartTime := mdb.converter.ToMySQLDateTime(*filter.LastStartTime)
+		query.WriteString(templateQueryPageConditions)
+		args = append(args, lastStartTime, *filter.LastRunID, lastStartTime)
+	}
+	orderBy := templateQueryDefaultOrderBy
+	if filter.OrderBy != "" {
+		orderBy = filter.OrderBy
+	}
+	query.WriteString(" ORDER BY " + orderBy + " LIMIT ?")
+	args = append(args, *filter.PageSize)
+
+	var rows []sqldb.VisibilityRow
+	if err := mdb.conn.Select(&rows, query.String(), args...); err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		rows[i].StartTime = mdb.converter.FromMySQLDateTime(rows[i].StartTime)
+		rows[i].ExecutionTime = mdb.converter.FromMySQLDateTime(rows[i].ExecutionTime)
+		if rows[i].CloseTime != nil {
+			closeTime := mdb.converter.FromMySQLDateTime(*rows[i].CloseTime)
+			rows[i].CloseTime = &closeTime
+		}
+	}
+	return rows, nil
+}
+
+// CountFromVisibilityByQuery returns the number of rows matching the query from visibility table
+func (mdb *DB) CountFromVisibilityByQuery(filter *sqldb.VisibilityQueryFilter) (int64, error) {
+	var query strings.Builder
+	query.WriteString(templateCountByQuery)
+	args := mdb.appendQueryCondition(&query, filter)
+
+	var count int64
+	err := mdb.conn.Get(&count, query.String(), args...)
+	return count, err
+}
+
+func (mdb *DB) appendQueryCondition(query *strings.Builder, filter *sqldb.VisibilityQueryFilter) []interface{} {
+	args := []interface{}{filter.DomainID}
+	if filter.Condition == "" {
+		return args
+	}
+	query.WriteString(" AND (" + filter.Condition + ")")
+	for _, arg := range filter.Args {
+		if t, ok := arg.(time.Time); ok {
+			arg = mdb.converter.ToMySQLDateTime(t)
+		}
+		args = append(args, arg)
+	}
+	return args
+}
@@ -456,6 +456,7 @@ type (
 		HistoryLength    *int64
 		Memo             []byte
 		Encoding         string
+		SearchAttributes []byte
 	}
 
 	// VisibilityFilter contains the column names within executions_visibility table that
@@ -472,6 +473,18 @@ type (
 		PageSize         *int
 	}
 
+	// VisibilityQueryFilter contains the condition of a visibility query on executions_visibility table.
+	// Condition and OrderBy are expressed on the table columns, with Args as values of the placeholders in Condition
+	VisibilityQueryFilter struct {
+		DomainID      string
+		Condition     string
+		Args          []interface{}
+		OrderBy       string
+		LastStartTime *time.Time
+		LastRunID     *string
+		PageSize      *int
+	}
+
 	// QueueRow represents a row in queue table
 	QueueRow struct {
 		QueueType      common.QueueType
@@ -519,6 +532,16 @@ type (
 		//    - this will delete upto limit number of tasks less than or equal to the given task id
 		DeleteFromTasks(filter *TasksFilter) (sql.Result, error)
 
+		// task_segments table has the same columns as tasks table, the task id of a row is
+		// the id of the last task in its segment
+		InsertIntoTaskSegments(row *TasksRow) (sql.Result, error)
+		// SelectFromTaskSegments retrieves one or more rows from the task_segments table
+		// Required filter params - {domainID, tasklistName, taskType, minTaskID, pageSize}
+		SelectFromTaskSegments(filter *TasksFilter) ([]TasksRow, error)
+		// DeleteFromTaskSegments deletes up to limit rows from task_segments table
+		// Required filter params - {domainID, tasklistName, taskType, taskIDLessThanEquals, limit}
+		DeleteFromTaskSegments(filter *TasksFilter) (sql.Result, error)
+
 		InsertIntoTaskLists(row *TaskListsRow) (sql.Result, error)
 		ReplaceIntoTaskLists(row *TaskListsRow) (sql.Result, error)
 		UpdateTaskLists(row *TaskListsRow) (sql.Result, error)
@@ -595,6 +618,15 @@ type (
 		// SelectFromReplicationTasksDLQ returns one or more rows from replication_tasks_dlq table
 		// Required filter params - {sourceClusterName, shardID, minTaskID, pageSize}
 		SelectFromReplicationTasksDLQ(filter *ReplicationTasksDLQFilter) ([]ReplicationTasksRow, error)
+		// SelectCountFromReplicationTasksDLQ returns the number of rows in replication_tasks_dlq table
+		// Required filter params - {sourceClusterName, shardID}
+		SelectCountFromReplicationTasksDLQ(sourceClusterName string, shardID int) (int64, error)
+		// DeleteMessageFromReplicationTasksDLQ deletes one row from replication_tasks_dlq table
+		// Required filter params - {sourceClusterName, shardID, taskID}
+		DeleteMessageFromReplicationTasksDLQ(sourceClusterName string, shardID int, taskID int64) (sql.Result, error)
+		// RangeDeleteMessageFromReplicationTasksDLQ deletes one or more rows from replication_tasks_dlq table
+		// Required filter params - {sourceClusterName, shardID, minTaskID, maxTaskID}
+		RangeDeleteMessageFromReplicationTasksDLQ(filter *ReplicationTasksDLQFilter) (sql.Result, error)
 
 		ReplaceIntoActivityInfoMaps(rows []ActivityInfoMapsRow) (sql.Result, error)
 		// SelectFromActivityInfoMaps returns one or more rows from activity_info_maps
@@ -671,6 +703,18 @@ type (
 		//     - workflowID, workflowTypeName, closeStatus (along with closed=true)
 		SelectFromVisibility(filter *VisibilityFilter) ([]VisibilityRow, error)
 		DeleteFromVisibility(filter *VisibilityFilter) (sql.Result, error)
+		// UpsertIntoVisibility inserts a row into visibility table, or updates the memo and search attributes
+		// of the existing row if the workflow is not closed yet
+		UpsertIntoVisibility(row *VisibilityRow) (sql.Result, error)
+		// SelectFromVisibilityByQuery returns one page of rows from visibility table matching the query
+		// Required filter params - {domainID, pageSize}
+		// - rows are ordered by {startTime desc, runID} unless orderBy is specified
+		// - lastStartTime and lastRunID of the previous page are used for pagination with default order,
+		//   with orderBy, the condition on the sort value of the last row is part of the query condition
+		SelectFromVisibilityByQuery(filter *VisibilityQueryFilter) ([]VisibilityRow, error)
+		// CountFromVisibilityByQuery returns the number of rows from visibility table matching the query
+		// Required filter params - {domainID}
+		CountFromVisibilityByQuery(filter *VisibilityQueryFilter) (int64, error)
 
 		InsertIntoQueue(row *QueueRow) (sql.Result, error)
 		GetLastEnqueuedMessageIDForUpdate(queueType common.QueueType) (int, error)
@@ -0,0 +1,463 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sql
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/xwb1989/sqlparser"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/definition"
+)
+
+const (
+	// missingValue is the special value used by visibility queries to match executions without the attribute,
+	// e.g. CloseTime = missing matches open workflows
+	missingValue = "missing"
+
+	searchAttributesColumn = "search_attributes"
+)
+
+type (
+	// visibilityQuery is the SQL form of a visibility query, the condition and order by
+	// are expressed on the columns of executions_visibility table
+	visibilityQuery struct {
+		condition string
+		args      []interface{}
+		orderBy   string
+		// sort field of the order by, used to continue the next page after the last row
+		sortKey    string
+		sortColumn string
+		sortCustom bool
+		sortDesc   bool
+	}
+
+	visibilityQueryConverter struct {
+		buf  strings.Builder
+		args []interface{}
+		// validSearchAttributes are the search attributes registered for the domain
+		validSearchAttributes map[string]interface{}
+	}
+)
+
+var (
+	// visibilityColumns maps the system search attributes to executions_visibility columns,
+	// all other search attributes are stored in the search_attributes JSON column
+	visibilityColumns = map[string]string{
+		definition.DomainID:      "domain_id",
+		definition.WorkflowID:    "workflow_id",
+		definition.RunID:         "run_id",
+		definition.WorkflowType:  "workflow_type_name",
+		definition.StartTime:     "start_time",
+		definition.ExecutionTime: "execution_time",
+		definition.CloseTime:     "close_time",
+		definition.CloseStatus:   "close_status",
+		definition.HistoryLength: "history_length",
+	}
+
+	visibilityTimeColumns = map[string]bool{
+		definition.StartTime:     true,
+		definition.ExecutionTime: true,
+		definition.CloseTime:     true,
+	}
+
+	visibilityComparisonOperators = map[string]bool{
+		sqlparser.EqualStr:        true,
+		sqlparser.NotEqualStr:     true,
+		sqlparser.LessThanStr:     true,
+		sqlparser.LessEqualStr:    true,
+		sqlparser.GreaterThanStr:  true,
+		sqlparser.GreaterEqualStr: true,
+		sqlparser.InStr:           true,
+		sqlparser.NotInStr:        true,
+		sqlparser.LikeStr:         true,
+		sqlparser.NotLikeStr:      true,
+	}
+
+	searchAttributeKeyRegex = regexp.MustCompile(`^\w+$`)
+)
+
+// convertVisibilityQuery converts the where and order by clause of a visibility query,
+// in the same grammar as used for ElasticSearch, into the SQL form. Custom search attributes
+// must be registered in validSearchAttributes
+func convertVisibilityQuery(query string, validSearchAttributes map[string]interface{}) (*visibilityQuery, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return &visibilityQuery{}, nil
+	}
+
+	// IMPORTANT: the placeholder query is never executed, it is only used to parse the query
+	var placeholderQuery string
+	if common.IsJustOrderByClause(query) || common.IsJustGroupByClause(query) {
+		placeholderQuery = fmt.Sprintf("SELECT * FROM dummy %s", query)
+	} else {
+		placeholderQuery = fmt.Sprintf("SELECT * FROM dummy WHERE %s", query)
+	}
+	stmt, err := sqlparser.Parse(placeholderQuery)
+	if err != nil {
+		return nil, err
+	}
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok {
+		return nil, errors.New("invalid select query")
+	}
+	if len(sel.GroupBy) > 0 {
+		return nil, errors.New("group by is not supported")
+	}
+
+	result := &visibilityQuery{}
+	c := &visibilityQueryConverter{validSearchAttributes: validSearchAttributes}
+	if sel.Where != nil {
+		if err := c.convertWhereExpr(sel.Where.Expr); err != nil {
+			return nil, err
+		}
+		result.condition = c.buf.String()
+		result.args = c.args
+	}
+	if len(sel.OrderBy) > 0 {
+		if err := c.convertOrderBy(sel.OrderBy, result); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (c *visibilityQueryConverter) convertOrderBy(orderBy sqlparser.OrderBy, result *visibilityQuery) error {
+	if len(orderBy) > 1 {
+		return errors.New("only one field can be used to sort")
+	}
+	column, key, isCustom, err := c.convertColName(orderBy[0].Expr)
+	if err != nil {
+		return err
+	}
+	direction := "ASC"
+	if orderBy[0].Direction == sqlparser.DescScr {
+		direction = "DESC"
+	}
+	// run_id and workflow_id are added as tie-breakers for a stable order across pages
+	result.orderBy = fmt.Sprintf("%s %s, run_id, workflow_id", column, direction)
+	result.sortKey = key
+	result.sortColumn = column
+	result.sortCustom = isCustom
+	result.sortDesc = direction == "DESC"
+	return nil
+}
+
+// pageCondition returns the condition to read the rows after the last row of the previous page
+// in the order of the query, the sort value of the last row is null if the attribute is missing.
+// NULLs are sorted first in ascending order and last in descending order, same as MySQL does
+func (q *visibilityQuery) pageCondition(sortValue json.RawMessage, runID string, workflowID string) (string, []interface{}, error) {
+	tieBreaker := "(run_id > ? OR (run_id = ? AND workflow_id > ?))"
+	tieBreakerArgs := []interface{}{runID, runID, workflowID}
+
+	if len(sortValue) == 0 || string(sortValue) == "null" {
+		if q.sortDesc {
+			return fmt.Sprintf("(%s IS NULL AND %s)", q.sortColumn, tieBreaker), tieBreakerArgs, nil
+		}
+		condition := fmt.Sprintf("((%s IS NULL AND %s) OR %s IS NOT NULL)", q.sortColumn, tieBreaker, q.sortColumn)
+		return condition, tieBreakerArgs, nil
+	}
+
+	placeholder := "?"
+	var value interface{}
+	if q.sortCustom {
+		placeholder = "CAST(? AS JSON)"
+		value = string(sortValue)
+	} else {
+		var err error
+		if value, err = decodeSortValue(q.sortKey, sortValue); err != nil {
+			return "", nil, err
+		}
+	}
+	operator := ">"
+	if q.sortDesc {
+		operator = "<"
+	}
+	condition := fmt.Sprintf("(%s %s %s OR (%s = %s AND %s)",
+		q.sortColumn, operator, placeholder, q.sortColumn, placeholder, tieBreaker)
+	if q.sortDesc {
+		condition += fmt.Sprintf(" OR %s IS NULL", q.sortColumn)
+	}
+	condition += ")"
+	return condition, append([]interface{}{value, value}, tieBreakerArgs...), nil
+}
+
+// decodeSortValue decodes the sort value of a system search attribute into the column type
+func decodeSortValue(key string, sortValue json.RawMessage) (interface{}, error) {
+	var err error
+	switch {
+	case visibilityTimeColumns[key]:
+		var value time.Time
+		err = json.Unmarshal(sortValue, &value)
+		return value, err
+	case key == definition.CloseStatus || key == definition.HistoryLength:
+		var value int64
+		err = json.Unmarshal(sortValue, &value)
+		return value, err
+	default:
+		var value string
+		err = json.Unmarshal(sortValue, &value)
+		return value, err
+	}
+}
+
+func (c *visibilityQueryConverter) convertWhereExpr(expr sqlparser.Expr) error {
+	switch expr := expr.(type) {
+	case *sqlparser.AndExpr:
+		return c.convertBinaryExpr(expr.Left, "AND", expr.Right)
+	case *sqlparser.OrExpr:
+		return c.convertBinaryExpr(expr.Left, "OR", expr.Right)
+	case *sqlparser.ParenExpr:
+		c.buf.WriteString("(")
+		if err := c.convertWhereExpr(expr.Expr); err != nil {
+			return err
+		}
+		c.buf.WriteString(")")
+		return nil
+	case *sqlparser.ComparisonExpr:
+		return c.convertComparisonExpr(expr)
+	case *sqlparser.RangeCond:
+		return c.convertRangeCond(expr)
+	default:
+		return errors.New("invalid where clause")
+	}
+}
+
+func (c *visibilityQueryConverter) convertBinaryExpr(left sqlparser.Expr, operator string, right sqlparser.Expr) error {
+	c.buf.WriteString("(")
+	if err := c.convertWhereExpr(left); err != nil {
+		return err
+	}
+	c.buf.WriteString(" " + operator + " ")
+	if err := c.convertWhereExpr(right); err != nil {
+		return err
+	}
+	c.buf.WriteString(")")
+	return nil
+}
+
+func (c *visibilityQueryConverter) convertComparisonExpr(expr *sqlparser.ComparisonExpr) error {
+	if !visibilityComparisonOperators[expr.Operator] {
+		return fmt.Errorf("operator %v is not supported", expr.Operator)
+	}
+	column, key, isCustom, err := c.convertColName(expr.Left)
+	if err != nil {
+		return err
+	}
+
+	if isMissingValue(expr.Right) {
+		switch expr.Operator {
+		case sqlparser.EqualStr:
+			c.buf.WriteString(column + " IS NULL")
+		case sqlparser.NotEqualStr:
+			c.buf.WriteString(column + " IS NOT NULL")
+		default:
+			return fmt.Errorf("operator %v is not supported for missing value", expr.Operator)
+		}
+		return nil
+	}
+
+	switch expr.Operator {
+	case sqlparser.EqualStr, sqlparser.NotEqualStr, sqlparser.InStr, sqlparser.NotInStr:
+		if isCustom {
+			return c.convertContainsExpr(expr, column, key)
+		}
+	}
+
+	switch expr.Operator {
+	case sqlparser.InStr, sqlparser.NotInStr:
+		tuple, ok := expr.Right.(sqlparser.ValTuple)
+		if !ok {
+			return fmt.Errorf("invalid value for operator %v", expr.Operator)
+		}
+		c.buf.WriteString(column + " " + strings.ToUpper(expr.Operator) + " (")
+		for i, valExpr := range tuple {
+			if i > 0 {
+				c.buf.WriteString(", ")
+			}
+			if err := c.convertValue(valExpr, key, isCustom); err != nil {
+				return err
+			}
+		}
+		c.buf.WriteString(")")
+	case sqlparser.LikeStr, sqlparser.NotLikeStr:
+		if isCustom {
+			// LIKE is applied on the unquoted string value of the attribute
+			column = "JSON_UNQUOTE(" + column + ")"
+		}
+		c.buf.WriteString(column + " " + strings.ToUpper(expr.Operator) + " ")
+		if err := c.convertValue(expr.Right, key, false); err != nil {
+			return err
+		}
+	default:
+		c.buf.WriteString(column + " " + expr.Operator + " ")
+		if err := c.convertValue(expr.Right, key, isCustom); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// convertContainsExpr converts the equality and IN conditions on custom search attributes with JSON_CONTAINS,
+// which matches a scalar attribute equal to the value and a list attribute with any element equal to the value
+func (c *visibilityQueryConverter) convertContainsExpr(expr *sqlparser.ComparisonExpr, column string, key string) error {
+	values := sqlparser.ValTuple{expr.Right}
+	if expr.Operator == sqlparser.InStr || expr.Operator == sqlparser.NotInStr {
+		tuple, ok := expr.Right.(sqlparser.ValTuple)
+		if !ok {
+			return fmt.Errorf("invalid value for operator %v", expr.Operator)
+		}
+		values = tuple
+	}
+
+	if expr.Operator == sqlparser.NotEqualStr || expr.Operator == sqlparser.NotInStr {
+		c.buf.WriteString("NOT ")
+	}
+	c.buf.WriteString("(")
+	for i, valExpr := range values {
+		if i > 0 {
+			c.buf.WriteString(" OR ")
+		}
+		c.buf.WriteString("JSON_CONTAINS(" + column + ", ")
+		if err := c.convertValue(valExpr, key, true); err != nil {
+			return err
+		}
+		c.buf.WriteString(")")
+	}
+	c.buf.WriteString(")")
+	return nil
+}
+
+func (c *visibilityQueryConverter) convertRangeCond(expr *sqlparser.RangeCond) error {
+	column, key, isCustom, err := c.convertColName(expr.Left)
+	if err != nil {
+		return err
+	}
+
+	c.buf.WriteString(column + " " + strings.ToUpper(expr.Operator) + " ")
+	if err := c.convertValue(expr.From, key, isCustom); err != nil {
+		return err
+	}
+	c.buf.WriteString(" AND ")
+	return c.convertValue(expr.To, key, isCustom)
+}
+
+// convertValue writes the placeholder of the value and collects the value as argument,
+// values of custom search attributes are compared as JSON to the stored attribute values
+func (c *visibilityQueryConverter) convertValue(expr sqlparser.Expr, key string, isCustom bool) error {
+	var value interface{}
+	switch expr := expr.(type) {
+	case *sqlparser.SQLVal:
+		var err error
+		switch expr.Type {
+		case sqlparser.StrVal:
+			value = string(expr.Val)
+		case sqlparser.IntVal:
+			value, err = strconv.ParseInt(string(expr.Val), 10, 64)
+		case sqlparser.FloatVal:
+			value, err = strconv.ParseFloat(string(expr.Val), 64)
+		default:
+			err = fmt.Errorf("invalid value %v", string(expr.Val))
+		}
+		if err != nil {
+			return err
+		}
+	case sqlparser.BoolVal:
+		value = bool(expr)
+	default:
+		return errors.New("invalid value")
+	}
+
+	if !isCustom && visibilityTimeColumns[key] {
+		timeValue, err := convertTimeValue(value)
+		if err != nil {
+			return err
+		}
+		value = timeValue
+	}
+
+	if isCustom {
+		data, err := json.Marshal(value)
+		if err != nil {
+			return err
+		}
+		c.buf.WriteString("CAST(? AS JSON)")
+		c.args = append(c.args, string(data))
+		return nil
+	}
+	c.buf.WriteString("?")
+	c.args = append(c.args, value)
+	return nil
+}
+
+// convertColName returns the column expression and the search attribute key of the column name,
+// and whether the key is a custom search attribute stored in the search_attributes column
+func (c *visibilityQueryConverter) convertColName(expr sqlparser.Expr) (string, string, bool, error) {
+	colName, ok := expr.(*sqlparser.ColName)
+	if !ok {
+		return "", "", false, errors.New("invalid column name")
+	}
+	key := colName.Name.String()
+	isCustom := colName.Qualifier.Name.String() == definition.Attr
+	if strings.HasPrefix(key, definition.Attr+".") {
+		key = key[len(definition.Attr)+1:]
+		isCustom = true
+	}
+
+	if column, ok := visibilityColumns[key]; ok && !isCustom {
+		return column, key, false, nil
+	}
+	if _, ok := c.validSearchAttributes[key]; !ok || definition.IsSystemIndexedKey(key) {
+		return "", "", false, fmt.Errorf("invalid search attribute %v", key)
+	}
+	// the key is written into the JSON path of the column, so it is checked even though registered
+	if !searchAttributeKeyRegex.MatchString(key) {
+		return "", "", false, fmt.Errorf("invalid search attribute %v", key)
+	}
+	return fmt.Sprintf("JSON_EXTRACT(%s, '$.%s')", searchAttributesColumn, key), key, true, nil
+}
+
+// convertTimeValue accepts time as unix nano or in RFC3339 format, same as the ElasticSearch visibility store
+func convertTimeValue(value interface{}) (time.Time, error) {
+	switch value := value.(type) {
+	case int64:
+		return time.Unix(0, value).UTC(), nil
+	case string:
+		if nano, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return time.Unix(0, nano).UTC(), nil
+		}
+		return time.Parse(time.RFC3339, value)
+	default:
+		return time.Time{}, fmt.Errorf("invalid time value %v", value)
+	}
+}
+
+func isMissingValue(expr sqlparser.Expr) bool {
+	colName, ok := expr.(*sqlparser.ColName)
+	return ok && colName.Qualifier.IsEmpty() && colName.Name.String() == missingValue
+}
@@ -0,0 +1,182 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sql
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common/definition"
+)
+
+type visibilityQueryConverterSuite struct {
+	suite.Suite
+}
+
+var testValidSearchAttributes = definition.MergeValidSearchAttributes(
+	definition.GetDefaultIndexedKeys(),
+	map[string]interface{}{"CustomKeywordListField": 7},
+)
+
+func TestVisibilityQueryConverterSuite(t *testing.T) {
+	suite.Run(t, new(visibilityQueryConverterSuite))
+}
+
+func (s *visibilityQueryConverterSuite) TestEmptyQuery() {
+	query, err := convertVisibilityQuery("  ", testValidSearchAttributes)
+	s.NoError(err)
+	s.Equal(&visibilityQuery{}, query)
+}
+
+func (s *visibilityQueryConverterSuite) TestSystemAttributes() {
+	query, err := convertVisibilityQuery(`WorkflowType = 'wt' and CloseStatus != 1`, testValidSearchAttributes)
+	s.NoError(err)
+	s.Equal("(workflow_type_name = ? AND close_status != ?)", query.condition)
+	s.Equal([]interface{}{"wt", int64(1)}, query.args)
+	s.Equal("", query.orderBy)
+}
+
+func (s *visibilityQueryConverterSuite) TestMissingValue() {
+	query, err := convertVisibilityQuery(`CloseTime = missing`, testValidSearchAttributes)
+	s.NoError(err)
+	s.Equal("close_time IS NULL", query.condition)
+	s.Empty(query.args)
+
+	query, err = convertVisibilityQuery("`Attr.CustomKeywordField` != missing", testValidSearchAttributes)
+	s.NoError(err)
+	s.Equal("JSON_EXTRACT(search_attributes, '$.CustomKeywordField') IS NOT NULL", query.condition)
+
+	_, err = convertVisibilityQuery(`CloseTime > missing`, testValidSearchAttributes)
+	s.Error(err)
+}
+
+func (s *visibilityQueryConverterSuite) TestTimeValues() {
+	startTime := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	query, err := convertVisibilityQuery(`StartTime between 1569888000000000000 and '2019-10-01T00:00:00Z'`, testValidSearchAttributes)
+	s.NoError(err)
+	s.Equal("start_time BETWEEN ? AND ?", query.condition)
+	s.Len(query.args, 2)
+	s.True(startTime.Equal(query.args[0].(time.Time)))
+	s.True(startTime.Equal(query.args[1].(time.Time)))
+
+	_, err = convertVisibilityQuery(`CloseTime > 'yesterday'`, testValidSearchAttributes)
+	s.Error(err)
+}
+
+func (s *visibilityQueryConverterSuite) TestCustomAttributes() {
+	query, err := convertVisibilityQuery("(`Attr.CustomIntField` >= 10 or `Attr.CustomBoolField` = true) and "+
+		"`Attr.CustomKeywordField` in ('a', 'b') and `Attr.CustomStringField` like '%foo%'", testValidSearchAttributes)
+	s.NoError(err)
+	s.Equal("((((JSON_EXTRACT(search_attributes, '$.CustomIntField') >= CAST(? AS JSON) OR "+
+		"(JSON_CONTAINS(JSON_EXTRACT(search_attributes, '$.CustomBoolField'), CAST(? AS JSON))))) AND "+
+		"(JSON_CONTAINS(JSON_EXTRACT(search_attributes, '$.CustomKeywordField'), CAST(? AS JSON)) OR "+
+		"JSON_CONTAINS(JSON_EXTRACT(search_attributes, '$.CustomKeywordField'), CAST(? AS JSON)))) AND "+
+		"JSON_UNQUOTE(JSON_EXTRACT(search_attributes, '$.CustomStringField')) LIKE ?)", query.condition)
+	s.Equal([]interface{}{"10", "true", `"a"`, `"b"`, "%foo%"}, query.args)
+}
+
+func (s *visibilityQueryConverterSuite) TestCustomAttributes_NotContains() {
+	query, err := convertVisibilityQuery("`Attr.CustomKeywordListField` != 'a' and `Attr.CustomKeywordListField` not in ('b')", testValidSearchAttributes)
+	s.NoError(err)
+	s.Equal("(NOT (JSON_CONTAINS(JSON_EXTRACT(search_attributes, '$.CustomKeywordListField'), CAST(? AS JSON))) AND "+
+		"NOT (JSON_CONTAINS(JSON_EXTRACT(search_attributes, '$.CustomKeywordListField'), CAST(? AS JSON))))", query.condition)
+	s.Equal([]interface{}{`"a"`, `"b"`}, query.args)
+}
+
+func (s *visibilityQueryConverterSuite) TestOrderBy() {
+	query, err := convertVisibilityQuery(`order by CloseTime desc`, testValidSearchAttributes)
+	s.NoError(err)
+	s.Equal("", query.condition)
+	s.Equal("close_time DESC, run_id, workflow_id", query.orderBy)
+
+	query, err = convertVisibilityQuery("WorkflowID = 'wid' order by `Attr.CustomIntField`", testValidSearchAttributes)
+	s.NoError(err)
+	s.Equal("workflow_id = ?", query.condition)
+	s.Equal("JSON_EXTRACT(search_attributes, '$.CustomIntField') ASC, run_id, workflow_id", query.orderBy)
+
+	_, err = convertVisibilityQuery(`order by StartTime, CloseTime`, testValidSearchAttributes)
+	s.Error(err)
+}
+
+func (s *visibilityQueryConverterSuite) TestInvalidQuery() {
+	invalidQueries := []string{
+		`WorkflowID = `,
+		`WorkflowID <=> 'wid'`,
+		`not WorkflowID = 'wid'`,
+		"`Attr.Custom'Field` = 1",
+		`WorkflowID = 'wid'; drop table executions_visibility`,
+		`group by WorkflowType`,
+		`WorkflowID = 'wid' group by WorkflowType`,
+		"`Attr.UnregisteredField` = 1",
+		`UnregisteredField = 1`,
+		"`Attr.WorkflowID` = 'wid'",
+		`order by UnregisteredField`,
+	}
+	for _, query := range invalidQueries {
+		_, err := convertVisibilityQuery(query, testValidSearchAttributes)
+		s.Error(err, query)
+	}
+}
+
+func (s *visibilityQueryConverterSuite) TestRegisteredForDomain() {
+	validSearchAttributes := definition.MergeValidSearchAttributes(
+		definition.GetDefaultIndexedKeys(),
+		map[string]interface{}{"DomainField": 1},
+	)
+	query, err := convertVisibilityQuery("`Attr.DomainField` >= 10", validSearchAttributes)
+	s.NoError(err)
+	s.Equal("JSON_EXTRACT(search_attributes, '$.DomainField') >= CAST(? AS JSON)", query.condition)
+
+	_, err = convertVisibilityQuery("`Attr.DomainField` >= 10", definition.GetDefaultIndexedKeys())
+	s.Error(err)
+}
+
+func (s *visibilityQueryConverterSuite) TestPageCondition() {
+	query, err := convertVisibilityQuery(`order by CloseTime desc`, testValidSearchAttributes)
+	s.NoError(err)
+	closeTime := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	condition, args, err := query.pageCondition([]byte(`"2019-10-01T00:00:00Z"`), "rid", "wid")
+	s.NoError(err)
+	s.Equal("(close_time < ? OR (close_time = ? AND (run_id > ? OR (run_id = ? AND workflow_id > ?))) OR close_time IS NULL)", condition)
+	s.Equal([]interface{}{closeTime, closeTime, "rid", "rid", "wid"}, args)
+
+	condition, args, err = query.pageCondition([]byte("null"), "rid", "wid")
+	s.NoError(err)
+	s.Equal("(close_time IS NULL AND (run_id > ? OR (run_id = ? AND workflow_id > ?)))", condition)
+	s.Equal([]interface{}{"rid", "rid", "wid"}, args)
+
+	query, err = convertVisibilityQuery("order by `Attr.CustomIntField`", testValidSearchAttributes)
+	s.NoError(err)
+	condition, args, err = query.pageCondition([]byte("10"), "rid", "wid")
+	s.NoError(err)
+	s.Equal("(JSON_EXTRACT(search_attributes, '$.CustomIntField') > CAST(? AS JSON) OR "+
+		"(JSON_EXTRACT(search_attributes, '$.CustomIntField') = CAST(? AS JSON) AND "+
+		"(run_id > ? OR (run_id = ? AND workflow_id > ?))))", condition)
+	s.Equal([]interface{}{"10", "10", "rid", "rid", "wid"}, args)
+
+	condition, _, err = query.pageCondition([]byte("null"), "rid", "wid")
+	s.NoError(err)
+	s.Equal("((JSON_EXTRACT(search_attributes, '$.CustomIntField') IS NULL AND "+
+		"(run_id > ? OR (run_id = ? AND workflow_id > ?))) OR "+
+		"JSON_EXTRACT(search_attributes, '$.CustomIntField') IS NOT NULL)", condition)
+}
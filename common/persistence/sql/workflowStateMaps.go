@@ -80,6 +80,7 @@ func updateActivityInfos(
 				RetryLastFailureReason:        &v.LastFailureReason,
 				RetryLastWorkerIdentity:       &v.LastWorkerIdentity,
 				RetryLastFailureDetails:       v.LastFailureDetails,
+				StartedTaskList:               &v.StartedTaskList,
 			}
 			blob, err := activityInfoToBlob(info)
 			if err != nil {
@@ -195,6 +196,7 @@ func getActivityInfoMap(
 			LastFailureReason:        decoded.GetRetryLastFailureReason(),
 			LastWorkerIdentity:       decoded.GetRetryLastWorkerIdentity(),
 			LastFailureDetails:       decoded.GetRetryLastFailureDetails(),
+			StartedTaskList:          decoded.GetStartedTaskList(),
 		}
 		if decoded.StartedEvent != nil {
 			info.StartedEvent = persistence.NewDataBlob(decoded.StartedEvent, common.EncodingType(decoded.GetStartedEventEncoding()))
@@ -0,0 +1,228 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"encoding/json"
+
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	visibilityDualManager struct {
+		sourceManager  VisibilityManager
+		targetManager  VisibilityManager
+		readFromTarget dynamicconfig.BoolPropertyFnWithDomainFilter
+		repairQueue    Queue
+		metricClient   metrics.Client
+		logger         log.Logger
+	}
+
+	// VisibilityMigrationRepairMessage identifies a visibility record whose write to the migration target failed,
+	// the record is copied again from the source store by the repair pass of the migration workflow
+	VisibilityMigrationRepairMessage struct {
+		DomainID   string
+		WorkflowID string
+		RunID      string
+	}
+)
+
+var _ VisibilityManager = (*visibilityDualManager)(nil)
+
+// NewVisibilityDualManager creates a visibility manager used while migrating visibility records from the source store
+// to the target store. Writes go to both stores, reads go to the target store for the domains enabled by dynamic config.
+// A failed write to the target store does not fail the request, the record is queued in the repair queue instead and
+// copied again by the repair pass of the migration workflow, which must complete before reads are switched to the target.
+// The write fails only if the record can not be queued, or reads of the domain are already switched to the target store.
+func NewVisibilityDualManager(sourceManager, targetManager VisibilityManager,
+	readFromTarget dynamicconfig.BoolPropertyFnWithDomainFilter, repairQueue Queue, metricClient metrics.Client, logger log.Logger) VisibilityManager {
+	return &visibilityDualManager{
+		sourceManager:  sourceManager,
+		targetManager:  targetManager,
+		readFromTarget: readFromTarget,
+		repairQueue:    repairQueue,
+		metricClient:   metricClient,
+		logger:         logger,
+	}
+}
+
+func (v *visibilityDualManager) Close() {
+	v.sourceManager.Close()
+	v.targetManager.Close()
+}
+
+func (v *visibilityDualManager) GetName() string {
+	return "visibilityDualManager"
+}
+
+func (v *visibilityDualManager) RecordWorkflowExecutionStarted(request *RecordWorkflowExecutionStartedRequest) error {
+	if err := v.sourceManager.RecordWorkflowExecutionStarted(request); err != nil {
+		return err
+	}
+	if err := v.targetManager.RecordWorkflowExecutionStarted(request); err != nil {
+		if v.readFromTarget(request.Domain) {
+			return err
+		}
+		return v.handleTargetError(metrics.PersistenceRecordWorkflowExecutionStartedScope, err, &VisibilityMigrationRepairMessage{
+			DomainID:   request.DomainUUID,
+			WorkflowID: request.Execution.GetWorkflowId(),
+			RunID:      request.Execution.GetRunId(),
+		})
+	}
+	return nil
+}
+
+func (v *visibilityDualManager) RecordWorkflowExecutionClosed(request *RecordWorkflowExecutionClosedRequest) error {
+	if err := v.sourceManager.RecordWorkflowExecutionClosed(request); err != nil {
+		return err
+	}
+	if err := v.targetManager.RecordWorkflowExecutionClosed(request); err != nil {
+		if v.readFromTarget(request.Domain) {
+			return err
+		}
+		return v.handleTargetError(metrics.PersistenceRecordWorkflowExecutionClosedScope, err, &VisibilityMigrationRepairMessage{
+			DomainID:   request.DomainUUID,
+			WorkflowID: request.Execution.GetWorkflowId(),
+			RunID:      request.Execution.GetRunId(),
+		})
+	}
+	return nil
+}
+
+func (v *visibilityDualManager) UpsertWorkflowExecution(request *UpsertWorkflowExecutionRequest) error {
+	if err := v.sourceManager.UpsertWorkflowExecution(request); err != nil {
+		return err
+	}
+	if err := v.targetManager.UpsertWorkflowExecution(request); err != nil {
+		if v.readFromTarget(request.Domain) {
+			return err
+		}
+		return v.handleTargetError(metrics.PersistenceUpsertWorkflowExecutionScope, err, &VisibilityMigrationRepairMessage{
+			DomainID:   request.DomainUUID,
+			WorkflowID: request.Execution.GetWorkflowId(),
+			RunID:      request.Execution.GetRunId(),
+		})
+	}
+	return nil
+}
+
+func (v *visibilityDualManager) ListOpenWorkflowExecutions(request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
+	manager := v.chooseVisibilityManagerForDomain(request.Domain)
+	return manager.ListOpenWorkflowExecutions(request)
+}
+
+func (v *visibilityDualManager) ListClosedWorkflowExecutions(request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
+	manager := v.chooseVisibilityManagerForDomain(request.Domain)
+	return manager.ListClosedWorkflowExecutions(request)
+}
+
+func (v *visibilityDualManager) ListOpenWorkflowExecutionsByType(request *ListWorkflowExecutionsByTypeRequest) (*ListWorkflowExecutionsResponse, error) {
+	manager := v.chooseVisibilityManagerForDomain(request.Domain)
+	return manager.ListOpenWorkflowExecutionsByType(request)
+}
+
+func (v *visibilityDualManager) ListClosedWorkflowExecutionsByType(request *ListWorkflowExecutionsByTypeRequest) (*ListWorkflowExecutionsResponse, error) {
+	manager := v.chooseVisibilityManagerForDomain(request.Domain)
+	return manager.ListClosedWorkflowExecutionsByType(request)
+}
+
+func (v *visibilityDualManager) ListOpenWorkflowExecutionsByWorkflowID(request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error) {
+	manager := v.chooseVisibilityManagerForDomain(request.Domain)
+	return manager.ListOpenWorkflowExecutionsByWorkflowID(request)
+}
+
+func (v *visibilityDualManager) ListClosedWorkflowExecutionsByWorkflowID(request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error) {
+	manager := v.chooseVisibilityManagerForDomain(request.Domain)
+	return manager.ListClosedWorkflowExecutionsByWorkflowID(request)
+}
+
+func (v *visibilityDualManager) ListClosedWorkflowExecutionsByStatus(request *ListClosedWorkflowExecutionsByStatusRequest) (*ListWorkflowExecutionsResponse, error) {
+	manager := v.chooseVisibilityManagerForDomain(request.Domain)
+	return manager.ListClosedWorkflowExecutionsByStatus(request)
+}
+
+func (v *visibilityDualManager) GetClosedWorkflowExecution(request *GetClosedWorkflowExecutionRequest) (*GetClosedWorkflowExecutionResponse, error) {
+	manager := v.chooseVisibilityManagerForDomain(request.Domain)
+	return manager.GetClosedWorkflowExecution(request)
+}
+
+func (v *visibilityDualManager) DeleteWorkflowExecution(request *VisibilityDeleteWorkflowExecutionRequest) error {
+	if err := v.sourceManager.DeleteWorkflowExecution(request); err != nil {
+		return err
+	}
+	if err := v.targetManager.DeleteWorkflowExecution(request); err != nil {
+		return v.handleTargetError(metrics.PersistenceVisibilityDeleteWorkflowExecutionScope, err, &VisibilityMigrationRepairMessage{
+			DomainID:   request.DomainID,
+			WorkflowID: request.WorkflowID,
+			RunID:      request.RunID,
+		})
+	}
+	return nil
+}
+
+func (v *visibilityDualManager) ListWorkflowExecutions(request *ListWorkflowExecutionsRequestV2) (*ListWorkflowExecutionsResponse, error) {
+	manager := v.chooseVisibilityManagerForDomain(request.Domain)
+	return manager.ListWorkflowExecutions(request)
+}
+
+func (v *visibilityDualManager) ScanWorkflowExecutions(request *ListWorkflowExecutionsRequestV2) (*ListWorkflowExecutionsResponse, error) {
+	manager := v.chooseVisibilityManagerForDomain(request.Domain)
+	return manager.ScanWorkflowExecutions(request)
+}
+
+func (v *visibilityDualManager) CountWorkflowExecutions(request *CountWorkflowExecutionsRequest) (*CountWorkflowExecutionsResponse, error) {
+	manager := v.chooseVisibilityManagerForDomain(request.Domain)
+	return manager.CountWorkflowExecutions(request)
+}
+
+func (v *visibilityDualManager) chooseVisibilityManagerForDomain(domain string) VisibilityManager {
+	if v.readFromTarget(domain) {
+		return v.targetManager
+	}
+	return v.sourceManager
+}
+
+// handleTargetError queues the record of a failed write to the target store for repair,
+// the target error is returned if the record can not be queued, so that the write is retried
+func (v *visibilityDualManager) handleTargetError(scope int, err error, message *VisibilityMigrationRepairMessage) error {
+	if v.metricClient != nil {
+		v.metricClient.IncCounter(scope, metrics.PersistenceVisibilityMigrationTargetFailures)
+	}
+	logger := v.logger.WithTags(
+		tag.WorkflowDomainID(message.DomainID),
+		tag.WorkflowID(message.WorkflowID),
+		tag.WorkflowRunID(message.RunID),
+	)
+	logger.Warn("Failed to write visibility record to migration target store.", tag.Error(err))
+
+	payload, encodeErr := json.Marshal(message)
+	if encodeErr == nil {
+		encodeErr = v.repairQueue.EnqueueMessage(payload)
+	}
+	if encodeErr != nil {
+		logger.Error("Failed to queue visibility record for migration repair.", tag.Error(encodeErr))
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,179 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	visibilityDualManagerSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		source      *recordingVisibilityManager
+		target      *recordingVisibilityManager
+		repairQueue *inMemoryQueue
+		dualManager VisibilityManager
+	}
+
+	// recordingVisibilityManager records the calls made to the visibility manager
+	recordingVisibilityManager struct {
+		VisibilityManager
+
+		name  string
+		err   error
+		calls []string
+	}
+)
+
+func TestVisibilityDualManagerSuite(t *testing.T) {
+	s := new(visibilityDualManagerSuite)
+	suite.Run(t, s)
+}
+
+func (s *visibilityDualManagerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.source = &recordingVisibilityManager{name: "source"}
+	s.target = &recordingVisibilityManager{name: "target"}
+	s.repairQueue = &inMemoryQueue{ackLevels: make(map[string]int)}
+	s.dualManager = NewVisibilityDualManager(s.source, s.target, func(domain string) bool {
+		return domain == "migrated-domain"
+	}, s.repairQueue, metrics.NewClient(tally.NoopScope, metrics.Common), loggerimpl.NewNopLogger())
+}
+
+func (s *visibilityDualManagerSuite) TestRecordWorkflowExecutionStarted() {
+	s.NoError(s.dualManager.RecordWorkflowExecutionStarted(&RecordWorkflowExecutionStartedRequest{}))
+	s.Equal([]string{"RecordWorkflowExecutionStarted"}, s.source.calls)
+	s.Equal([]string{"RecordWorkflowExecutionStarted"}, s.target.calls)
+}
+
+func (s *visibilityDualManagerSuite) TestRecordWorkflowExecutionClosed_SourceError() {
+	s.source.err = errors.New("some random error")
+	s.Error(s.dualManager.RecordWorkflowExecutionClosed(&RecordWorkflowExecutionClosedRequest{}))
+	s.Equal([]string{"RecordWorkflowExecutionClosed"}, s.source.calls)
+	s.Empty(s.target.calls)
+}
+
+func (s *visibilityDualManagerSuite) TestRecordWorkflowExecutionStarted_TargetError() {
+	s.target.err = errors.New("some random error")
+	s.NoError(s.dualManager.RecordWorkflowExecutionStarted(&RecordWorkflowExecutionStartedRequest{
+		DomainUUID: "some-domain-id",
+		Domain:     "some-domain",
+		Execution:  workflow.WorkflowExecution{WorkflowId: common.StringPtr("some-wid"), RunId: common.StringPtr("some-rid")},
+	}))
+	s.Equal([]string{"RecordWorkflowExecutionStarted"}, s.source.calls)
+	s.Equal([]string{"RecordWorkflowExecutionStarted"}, s.target.calls)
+
+	messages, err := s.repairQueue.ReadMessages(emptyQueueMessageID, 10)
+	s.NoError(err)
+	s.Len(messages, 1)
+	var message VisibilityMigrationRepairMessage
+	s.NoError(json.Unmarshal(messages[0].Payload, &message))
+	s.Equal(VisibilityMigrationRepairMessage{DomainID: "some-domain-id", WorkflowID: "some-wid", RunID: "some-rid"}, message)
+}
+
+func (s *visibilityDualManagerSuite) TestUpsertWorkflowExecution_TargetAndRepairQueueError() {
+	s.target.err = errors.New("some random error")
+	s.repairQueue.enqueueConflict = 1
+	s.Equal(s.target.err, s.dualManager.UpsertWorkflowExecution(&UpsertWorkflowExecutionRequest{Domain: "some-domain"}))
+	s.Equal([]string{"UpsertWorkflowExecution"}, s.source.calls)
+	s.Equal([]string{"UpsertWorkflowExecution"}, s.target.calls)
+}
+
+func (s *visibilityDualManagerSuite) TestRecordWorkflowExecutionClosed_TargetErrorAfterReadsSwitched() {
+	s.target.err = errors.New("some random error")
+	s.Equal(s.target.err, s.dualManager.RecordWorkflowExecutionClosed(&RecordWorkflowExecutionClosedRequest{Domain: "migrated-domain"}))
+	messages, err := s.repairQueue.ReadMessages(emptyQueueMessageID, 10)
+	s.NoError(err)
+	s.Empty(messages)
+}
+
+func (s *visibilityDualManagerSuite) TestDeleteWorkflowExecution_TargetError() {
+	s.target.err = errors.New("some random error")
+	s.NoError(s.dualManager.DeleteWorkflowExecution(&VisibilityDeleteWorkflowExecutionRequest{}))
+	s.Equal([]string{"DeleteWorkflowExecution"}, s.source.calls)
+	s.Equal([]string{"DeleteWorkflowExecution"}, s.target.calls)
+}
+
+func (s *visibilityDualManagerSuite) TestRead() {
+	resp, err := s.dualManager.ListOpenWorkflowExecutions(&ListWorkflowExecutionsRequest{Domain: "some-domain"})
+	s.NoError(err)
+	s.Equal([]byte("source"), resp.NextPageToken)
+
+	resp, err = s.dualManager.ListOpenWorkflowExecutions(&ListWorkflowExecutionsRequest{Domain: "migrated-domain"})
+	s.NoError(err)
+	s.Equal([]byte("target"), resp.NextPageToken)
+
+	s.Equal([]string{"ListOpenWorkflowExecutions"}, s.source.calls)
+	s.Equal([]string{"ListOpenWorkflowExecutions"}, s.target.calls)
+}
+
+func (s *visibilityDualManagerSuite) TestRead_DefaultToSource() {
+	s.dualManager = NewVisibilityDualManager(s.source, s.target, dynamicconfig.GetBoolPropertyFnFilteredByDomain(false),
+		s.repairQueue, metrics.NewClient(tally.NoopScope, metrics.Common), loggerimpl.NewNopLogger())
+	_, err := s.dualManager.CountWorkflowExecutions(&CountWorkflowExecutionsRequest{Domain: "migrated-domain"})
+	s.NoError(err)
+	s.Equal([]string{"CountWorkflowExecutions"}, s.source.calls)
+	s.Empty(s.target.calls)
+}
+
+func (m *recordingVisibilityManager) RecordWorkflowExecutionStarted(_ *RecordWorkflowExecutionStartedRequest) error {
+	m.calls = append(m.calls, "RecordWorkflowExecutionStarted")
+	return m.err
+}
+
+func (m *recordingVisibilityManager) RecordWorkflowExecutionClosed(_ *RecordWorkflowExecutionClosedRequest) error {
+	m.calls = append(m.calls, "RecordWorkflowExecutionClosed")
+	return m.err
+}
+
+func (m *recordingVisibilityManager) UpsertWorkflowExecution(_ *UpsertWorkflowExecutionRequest) error {
+	m.calls = append(m.calls, "UpsertWorkflowExecution")
+	return m.err
+}
+
+func (m *recordingVisibilityManager) DeleteWorkflowExecution(_ *VisibilityDeleteWorkflowExecutionRequest) error {
+	m.calls = append(m.calls, "DeleteWorkflowExecution")
+	return m.err
+}
+
+func (m *recordingVisibilityManager) ListOpenWorkflowExecutions(_ *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
+	m.calls = append(m.calls, "ListOpenWorkflowExecutions")
+	return &ListWorkflowExecutionsResponse{NextPageToken: []byte(m.name)}, m.err
+}
+
+func (m *recordingVisibilityManager) CountWorkflowExecutions(_ *CountWorkflowExecutionsRequest) (*CountWorkflowExecutionsResponse, error) {
+	m.calls = append(m.calls, "CountWorkflowExecutions")
+	return &CountWorkflowExecutionsResponse{}, m.err
+}
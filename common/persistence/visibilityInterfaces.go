@@ -120,6 +120,10 @@ type (
 	// CountWorkflowExecutionsResponse is response to CountWorkflowExecutions
 	CountWorkflowExecutionsResponse struct {
 		Count int64
+		// Groups is set if the query has GROUP BY clause
+		Groups []*s.CountWorkflowExecutionsGroup
+		// TruncatedCount is the number of workflows not in any group, as the number of groups is capped
+		TruncatedCount int64
 	}
 
 	// ListWorkflowExecutionsByTypeRequest is used to list executions of
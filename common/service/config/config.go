@@ -42,6 +42,13 @@ const (
 	ReplicationConsumerTypeRPC = "rpc"
 )
 
+const (
+	// MessagingTypeKafka means messages are exchanged through kafka, this is the default.
+	MessagingTypeKafka = "kafka"
+	// MessagingTypeQueue means messages are exchanged through the persistence queue.
+	MessagingTypeQueue = "queue"
+)
+
 type (
 	// Config contains the configuration for a set of cadence services
 	Config struct {
@@ -59,6 +66,8 @@ type (
 		Services map[string]Service `yaml:"services"`
 		// Kafka is the config for connecting to kafka
 		Kafka messaging.KafkaConfig `yaml:"kafka"`
+		// Messaging is the config for choosing the messaging backend
+		Messaging Messaging `yaml:"messaging"`
 		// Archival is the config for archival
 		Archival Archival `yaml:"archival"`
 		// PublicClient is config for connecting to cadence frontend
@@ -68,6 +77,8 @@ type (
 		DynamicConfigClient dynamicconfig.FileBasedClientConfig `yaml:"dynamicConfigClient"`
 		// DomainDefaults is the default config for every domain
 		DomainDefaults DomainDefaults `yaml:"domainDefaults"`
+		// VisibilityExport is the config for exporting visibility records by the worker service
+		VisibilityExport VisibilityExport `yaml:"visibilityExport"`
 	}
 
 	// Service contains the service specific config items
@@ -80,6 +91,15 @@ type (
 		PProf PProf `yaml:"pprof"`
 	}
 
+	// Messaging contains the config for the messaging backend used by replication and advanced visibility
+	Messaging struct {
+		// Type is the messaging backend, either kafka or queue. Defaults to kafka
+		Type string `yaml:"type"`
+		// Partitions is the number of partitions of the queue carrying visibility messages when the
+		// type is queue, it spreads the writes and the consumers. Defaults to 8, it can only be increased
+		Partitions int `yaml:"partitions"`
+	}
+
 	// PProf contains the rpc config items
 	PProf struct {
 		// Port is the port on which the PProf will bind to
@@ -126,6 +146,9 @@ type (
 		VisibilityStore string `yaml:"visibilityStore" validate:"nonzero"`
 		// AdvancedVisibilityStore is the name of the datastore to be used for visibility records
 		AdvancedVisibilityStore string `yaml:"advancedVisibilityStore"`
+		// VisibilityMigrationTargetStore is the name of the datastore visibility records are migrated to,
+		// visibility records are written to both visibilityStore and this datastore when specified
+		VisibilityMigrationTargetStore string `yaml:"visibilityMigrationTargetStore"`
 		// HistoryMaxConns is the desired number of conns to history store. Value specified
 		// here overrides the MaxConns config specified as part of datastore
 		HistoryMaxConns int `yaml:"historyMaxConns"`
@@ -168,6 +191,12 @@ type (
 		MaxQPS dynamicconfig.IntPropertyFn
 		// ValidSearchAttributes is legal indexed keys that can be used in list APIs
 		ValidSearchAttributes dynamicconfig.MapPropertyFn
+		// EnableReadFromMigrationTarget read visibility records from the migration target store
+		EnableReadFromMigrationTarget dynamicconfig.BoolPropertyFnWithDomainFilter
+		// ESHistoryIndexName is the ElasticSearch index of history search, history conditions in queries are rejected if empty
+		ESHistoryIndexName string
+		// ESHistorySearchMaxResults is max number of workflows matched by history conditions of a query
+		ESHistorySearchMaxResults dynamicconfig.IntPropertyFn
 	}
 
 	// Cassandra contains configuration to connect to Cassandra cluster
@@ -359,6 +388,13 @@ type (
 		RefreshInterval time.Duration `yaml:"RefreshInterval"`
 	}
 
+	// VisibilityExport is the config for exporting visibility records by the worker service
+	VisibilityExport struct {
+		// BaseDir is the directory on the worker hosts which the exported files are written to,
+		// visibility export is rejected if it is not set
+		BaseDir string `yaml:"baseDir"`
+	}
+
 	// DomainDefaults is the default config for each domain
 	DomainDefaults struct {
 		// Archival is the default archival config for each domain
@@ -53,6 +53,9 @@ func (c *Persistence) DefaultStoreType() string {
 // Validate validates the persistence config
 func (c *Persistence) Validate() error {
 	stores := []string{c.DefaultStore, c.VisibilityStore}
+	if c.IsVisibilityMigrationConfigExist() {
+		stores = append(stores, c.VisibilityMigrationTargetStore)
+	}
 	for _, st := range stores {
 		ds, ok := c.DataStores[st]
 		if !ok {
@@ -75,3 +78,8 @@ func (c *Persistence) Validate() error {
 func (c *Persistence) IsAdvancedVisibilityConfigExist() bool {
 	return len(c.AdvancedVisibilityStore) != 0
 }
+
+// IsVisibilityMigrationConfigExist returns whether user specified visibilityMigrationTargetStore in config
+func (c *Persistence) IsVisibilityMigrationConfigExist() bool {
+	return len(c.VisibilityMigrationTargetStore) != 0
+}
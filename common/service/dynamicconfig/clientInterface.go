@@ -44,4 +44,7 @@ type Client interface {
 	) (time.Duration, error)
 	// UpdateValue takes value as map and updates by overriding. It doesn't support update with filters.
 	UpdateValue(name Key, value interface{}) error
+	// UpdateValueWithFilters updates by overriding the value constrained by exactly the filters,
+	// values with other constraints are kept.
+	UpdateValueWithFilters(name Key, filters map[Filter]interface{}, value interface{}) error
 }
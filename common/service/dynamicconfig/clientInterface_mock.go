@@ -190,3 +190,17 @@ func (mr *MockClientMockRecorder) UpdateValue(name, value interface{}) *gomock.C
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateValue", reflect.TypeOf((*MockClient)(nil).UpdateValue), name, value)
 }
+
+// UpdateValueWithFilters mocks base method
+func (m *MockClient) UpdateValueWithFilters(name Key, filters map[Filter]interface{}, value interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateValueWithFilters", name, filters, value)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateValueWithFilters indicates an expected call of UpdateValueWithFilters
+func (mr *MockClientMockRecorder) UpdateValueWithFilters(name, filters, value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateValueWithFilters", reflect.TypeOf((*MockClient)(nil).UpdateValueWithFilters), name, filters, value)
+}
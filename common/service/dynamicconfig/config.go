@@ -93,9 +93,15 @@ type StringPropertyFn func(opts ...FilterOption) string
 // MapPropertyFn is a wrapper to get map property from dynamic config
 type MapPropertyFn func(opts ...FilterOption) map[string]interface{}
 
+// MapPropertyFnWithTaskListInfoFilters is a wrapper to get map property from dynamic config with three filters: domain, taskList, taskType
+type MapPropertyFnWithTaskListInfoFilters func(domain string, taskList string, taskType int) map[string]interface{}
+
 // StringPropertyFnWithDomainFilter is a wrapper to get string property from dynamic config
 type StringPropertyFnWithDomainFilter func(domain string) string
 
+// StringPropertyFnWithTaskListInfoFilters is a wrapper to get string property from dynamic config with three filters: domain, taskList, taskType
+type StringPropertyFnWithTaskListInfoFilters func(domain string, taskList string, taskType int) string
+
 // BoolPropertyFnWithDomainFilter is a wrapper to get string property from dynamic config
 type BoolPropertyFnWithDomainFilter func(domain string) bool
 
@@ -251,6 +257,22 @@ func (c *Collection) GetMapProperty(key Key, defaultValue map[string]interface{}
 	}
 }
 
+// GetMapPropertyFilteredByTaskListInfo gets property with taskListInfo as filters and asserts that it's a map
+func (c *Collection) GetMapPropertyFilteredByTaskListInfo(key Key, defaultValue map[string]interface{}) MapPropertyFnWithTaskListInfoFilters {
+	return func(domain string, taskList string, taskType int) map[string]interface{} {
+		val, err := c.client.GetMapValue(
+			key,
+			getFilterMap(DomainFilter(domain), TaskListFilter(taskList), TaskTypeFilter(taskType)),
+			defaultValue,
+		)
+		if err != nil {
+			c.logNoValue(key, err)
+		}
+		c.logValue(key, mapToString(val), defaultValue)
+		return val
+	}
+}
+
 // mapToString ensure fmt.Print(map) will always be same instead of random order of keys.
 // Go 1.12+ will fix this then we don't mapToString anymore
 func mapToString(inputMap map[string]interface{}) string {
@@ -285,6 +307,22 @@ func (c *Collection) GetStringPropertyFnWithDomainFilter(key Key, defaultValue s
 	}
 }
 
+// GetStringPropertyFilteredByTaskListInfo gets property with taskListInfo as filters and asserts that it's a string
+func (c *Collection) GetStringPropertyFilteredByTaskListInfo(key Key, defaultValue string) StringPropertyFnWithTaskListInfoFilters {
+	return func(domain string, taskList string, taskType int) string {
+		val, err := c.client.GetStringValue(
+			key,
+			getFilterMap(DomainFilter(domain), TaskListFilter(taskList), TaskTypeFilter(taskType)),
+			defaultValue,
+		)
+		if err != nil {
+			c.logNoValue(key, err)
+		}
+		c.logValue(key, val, defaultValue)
+		return val
+	}
+}
+
 // GetBoolPropertyFnWithDomainFilter gets property with domain filter and asserts that its domain
 func (c *Collection) GetBoolPropertyFnWithDomainFilter(key Key, defaultValue bool) BoolPropertyFnWithDomainFilter {
 	return func(domain string) bool {
@@ -74,6 +74,11 @@ func GetStringPropertyFn(value string) func(opts ...FilterOption) string {
 	return func(...FilterOption) string { return value }
 }
 
+// GetStringPropertyFnFilteredByTaskListInfo returns value as StringPropertyFnWithTaskListInfoFilters
+func GetStringPropertyFnFilteredByTaskListInfo(value string) func(domain string, taskList string, taskType int) string {
+	return func(domain string, taskList string, taskType int) string { return value }
+}
+
 // GetMapPropertyFn returns value as MapPropertyFn
 func GetMapPropertyFn(value map[string]interface{}) func(opts ...FilterOption) map[string]interface{} {
 	return func(...FilterOption) map[string]interface{} { return value }
@@ -119,6 +119,10 @@ func (mc *inMemoryClient) UpdateValue(key Key, value interface{}) error {
 	return nil
 }
 
+func (mc *inMemoryClient) UpdateValueWithFilters(key Key, filters map[Filter]interface{}, value interface{}) error {
+	return errors.New("unable to update key with filters")
+}
+
 type configSuite struct {
 	suite.Suite
 	client *inMemoryClient
@@ -249,6 +253,34 @@ func (s *configSuite) TestGetMapProperty() {
 	s.Equal("321", value()["testKey"])
 }
 
+func (s *configSuite) TestGetMapPropertyFilteredByTaskListInfo() {
+	key := testGetMapPropertyFilteredByTaskListInfoKey
+	domain := "testDomain"
+	taskList := "testTaskList"
+	taskType := 0
+	val := map[string]interface{}{
+		"testKey": 123,
+	}
+	value := s.cln.GetMapPropertyFilteredByTaskListInfo(key, val)
+	s.Equal(val, value(domain, taskList, taskType))
+	newVal := map[string]interface{}{
+		"testKey": 321,
+	}
+	s.client.SetValue(key, newVal)
+	s.Equal(newVal, value(domain, taskList, taskType))
+}
+
+func (s *configSuite) TestGetStringPropertyFilteredByTaskListInfo() {
+	key := testGetStringPropertyFilteredByTaskListInfoKey
+	domain := "testDomain"
+	taskList := "testTaskList"
+	taskType := 0
+	value := s.cln.GetStringPropertyFilteredByTaskListInfo(key, "abc")
+	s.Equal("abc", value(domain, taskList, taskType))
+	s.client.SetValue(key, "efg")
+	s.Equal("efg", value(domain, taskList, taskType))
+}
+
 func (s *configSuite) TestUpdateConfig() {
 	key := testGetBoolPropertyKey
 	value := s.cln.GetBoolProperty(key, true)
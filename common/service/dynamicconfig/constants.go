@@ -48,6 +48,8 @@ var keys = map[Key]string{
 	testGetIntPropertyFilteredByTaskListInfoKey:      "testGetIntPropertyFilteredByTaskListInfoKey",
 	testGetDurationPropertyFilteredByTaskListInfoKey: "testGetDurationPropertyFilteredByTaskListInfoKey",
 	testGetBoolPropertyFilteredByTaskListInfoKey:     "testGetBoolPropertyFilteredByTaskListInfoKey",
+	testGetMapPropertyFilteredByTaskListInfoKey:      "testGetMapPropertyFilteredByTaskListInfoKey",
+	testGetStringPropertyFilteredByTaskListInfoKey:   "testGetStringPropertyFilteredByTaskListInfoKey",
 
 	// system settings
 	EnableGlobalDomain:                  "system.enableGlobalDomain",
@@ -57,6 +59,7 @@ var keys = map[Key]string{
 	EnableReadFromClosedExecutionV2:     "system.enableReadFromClosedExecutionV2",
 	AdvancedVisibilityWritingMode:       "system.advancedVisibilityWritingMode",
 	EnableReadVisibilityFromES:          "system.enableReadVisibilityFromES",
+	EnableReadVisibilityFromMigration:   "system.enableReadVisibilityFromMigration",
 	HistoryArchivalStatus:               "system.historyArchivalStatus",
 	EnableReadFromHistoryArchival:       "system.enableReadFromHistoryArchival",
 	VisibilityArchivalStatus:            "system.visibilityArchivalStatus",
@@ -68,6 +71,9 @@ var keys = map[Key]string{
 	DisallowQuery:                       "system.disallowQuery",
 	EnableBatcher:                       "worker.enableBatcher",
 	EnableParentClosePolicyWorker:       "system.enableParentClosePolicyWorker",
+	EnableFailoverManager:               "worker.enableFailoverManager",
+	EnableVisibilityMigration:           "worker.enableVisibilityMigration",
+	EnableVisibilityExport:              "worker.enableVisibilityExport",
 
 	// size limit
 	BlobSizeLimitError:     "limit.blobSize.error",
@@ -78,27 +84,39 @@ var keys = map[Key]string{
 	HistoryCountLimitWarn:  "limit.historyCount.warn",
 	MaxIDLengthLimit:       "limit.maxIDLength",
 
+	// multi-tenancy limit
+	OpenWorkflowsLimit:             "limit.openWorkflowsPerDomain",
+	DomainHistorySizeLimitPerShard: "limit.domainHistorySizePerShard",
+	PendingActivitiesLimit:         "limit.pendingActivitiesPerWorkflow",
+	PendingChildWorkflowsLimit:     "limit.pendingChildWorkflowsPerWorkflow",
+	PendingTimersLimit:             "limit.pendingTimersPerWorkflow",
+
 	// frontend settings
-	FrontendPersistenceMaxQPS:             "frontend.persistenceMaxQPS",
-	FrontendVisibilityMaxPageSize:         "frontend.visibilityMaxPageSize",
-	FrontendVisibilityListMaxQPS:          "frontend.visibilityListMaxQPS",
-	FrontendESVisibilityListMaxQPS:        "frontend.esVisibilityListMaxQPS",
-	FrontendMaxBadBinaries:                "frontend.maxBadBinaries",
-	FrontendESIndexMaxResultWindow:        "frontend.esIndexMaxResultWindow",
-	FrontendHistoryMaxPageSize:            "frontend.historyMaxPageSize",
-	FrontendRPS:                           "frontend.rps",
-	FrontendDomainRPS:                     "frontend.domainrps",
-	FrontendHistoryMgrNumConns:            "frontend.historyMgrNumConns",
-	DisableListVisibilityByFilter:         "frontend.disableListVisibilityByFilter",
-	FrontendThrottledLogRPS:               "frontend.throttledLogRPS",
-	EnableClientVersionCheck:              "frontend.enableClientVersionCheck",
-	ValidSearchAttributes:                 "frontend.validSearchAttributes",
-	SearchAttributesNumberOfKeysLimit:     "frontend.searchAttributesNumberOfKeysLimit",
-	SearchAttributesSizeOfValueLimit:      "frontend.searchAttributesSizeOfValueLimit",
-	SearchAttributesTotalSizeLimit:        "frontend.searchAttributesTotalSizeLimit",
-	VisibilityArchivalQueryMaxPageSize:    "frontend.visibilityArchivalQueryMaxPageSize",
-	VisibilityArchivalQueryMaxRangeInDays: "frontend.visibilityArchivalQueryMaxRangeInDays",
-	VisibilityArchivalQueryMaxQPS:         "frontend.visibilityArchivalQueryMaxQPS",
+	FrontendPersistenceMaxQPS:               "frontend.persistenceMaxQPS",
+	FrontendVisibilityMaxPageSize:           "frontend.visibilityMaxPageSize",
+	FrontendVisibilityListMaxQPS:            "frontend.visibilityListMaxQPS",
+	FrontendESVisibilityListMaxQPS:          "frontend.esVisibilityListMaxQPS",
+	FrontendMaxBadBinaries:                  "frontend.maxBadBinaries",
+	FrontendESIndexMaxResultWindow:          "frontend.esIndexMaxResultWindow",
+	FrontendESHistorySearchMaxResults:       "frontend.esHistorySearchMaxResults",
+	FrontendHistoryMaxPageSize:              "frontend.historyMaxPageSize",
+	FrontendRPS:                             "frontend.rps",
+	FrontendDomainRPS:                       "frontend.domainrps",
+	FrontendHistoryMgrNumConns:              "frontend.historyMgrNumConns",
+	DisableListVisibilityByFilter:           "frontend.disableListVisibilityByFilter",
+	FrontendThrottledLogRPS:                 "frontend.throttledLogRPS",
+	EnableClientVersionCheck:                "frontend.enableClientVersionCheck",
+	ValidSearchAttributes:                   "frontend.validSearchAttributes",
+	SearchAttributeStatus:                   "frontend.searchAttributeStatus",
+	SearchAttributeAliases:                  "frontend.searchAttributeAliases",
+	SearchAttributesNumberOfKeysLimit:       "frontend.searchAttributesNumberOfKeysLimit",
+	SearchAttributesSizeOfValueLimit:        "frontend.searchAttributesSizeOfValueLimit",
+	SearchAttributesTotalSizeLimit:          "frontend.searchAttributesTotalSizeLimit",
+	VisibilityArchivalQueryMaxPageSize:      "frontend.visibilityArchivalQueryMaxPageSize",
+	VisibilityArchivalQueryMaxRangeInDays:   "frontend.visibilityArchivalQueryMaxRangeInDays",
+	VisibilityArchivalQueryMaxQPS:           "frontend.visibilityArchivalQueryMaxQPS",
+	FrontendEnablePollerTaskStats:           "frontend.enablePollerTaskStats",
+	FrontendGracefulFailoverRefreshInterval: "frontend.gracefulFailoverRefreshInterval",
 
 	// matching settings
 	MatchingRPS:                             "matching.rps",
@@ -120,6 +138,10 @@ var keys = map[Key]string{
 	MatchingForwarderMaxOutstandingTasks:    "matching.forwarderMaxOutstandingTasks",
 	MatchingForwarderMaxRatePerSecond:       "matching.forwarderMaxRatePerSecond",
 	MatchingForwarderMaxChildrenPerNode:     "matching.forwarderMaxChildrenPerNode",
+	MatchingActivityTypeDispatchRPS:         "matching.activityTypeDispatchRPS",
+	MatchingActivityTypeMaxOutstanding:      "matching.activityTypeMaxOutstanding",
+	MatchingBacklogSegmentMode:              "matching.backlogSegmentMode",
+	MatchingActivityAffinityTimeout:         "matching.activityAffinityTimeout",
 
 	// history settings
 	HistoryRPS:                                            "history.rps",
@@ -129,6 +151,7 @@ var keys = map[Key]string{
 	HistoryLongPollExpirationInterval:                     "history.longPollExpirationInterval",
 	HistoryCacheInitialSize:                               "history.cacheInitialSize",
 	HistoryMaxAutoResetPoints:                             "history.historyMaxAutoResetPoints",
+	EnableStateSearchAttributes:                           "history.enableStateSearchAttributes",
 	HistoryCacheMaxSize:                                   "history.cacheMaxSize",
 	HistoryCacheTTL:                                       "history.cacheTTL",
 	EventsCacheInitialSize:                                "history.eventsCacheInitialSize",
@@ -159,6 +182,7 @@ var keys = map[Key]string{
 	TransferProcessorMaxPollRPS:                           "history.transferProcessorMaxPollRPS",
 	TransferTaskWorkerCount:                               "history.transferTaskWorkerCount",
 	TransferTaskMaxRetryCount:                             "history.transferTaskMaxRetryCount",
+	TransferCrossClusterTaskMaxRetryDuration:              "history.transferCrossClusterTaskMaxRetryDuration",
 	TransferProcessorCompleteTransferFailureRetryCount:    "history.transferProcessorCompleteTransferFailureRetryCount",
 	TransferProcessorUpdateShardTaskCount:                 "history.transferProcessorUpdateShardTaskCount",
 	TransferProcessorMaxPollInterval:                      "history.transferProcessorMaxPollInterval",
@@ -180,6 +204,7 @@ var keys = map[Key]string{
 	HistoryMgrNumConns:                                    "history.historyMgrNumConns",
 	MaximumBufferedEventsBatch:                            "history.maximumBufferedEventsBatch",
 	MaximumSignalsPerExecution:                            "history.maximumSignalsPerExecution",
+	OpenWorkflowsCountCacheTTL:                            "history.openWorkflowsCountCacheTTL",
 	ShardUpdateMinInterval:                                "history.shardUpdateMinInterval",
 	ShardSyncMinInterval:                                  "history.shardSyncMinInterval",
 	DefaultEventEncoding:                                  "history.defaultEventEncoding",
@@ -218,6 +243,7 @@ var keys = map[Key]string{
 	WorkerESProcessorBulkActions:                    "worker.ESProcessorBulkActions",
 	WorkerESProcessorBulkSize:                       "worker.ESProcessorBulkSize",
 	WorkerESProcessorFlushInterval:                  "worker.ESProcessorFlushInterval",
+	WorkerHistoryIndexerMaxQPS:                      "worker.historyIndexerMaxQPS",
 	EnableArchivalCompression:                       "worker.EnableArchivalCompression",
 	WorkerHistoryPageSize:                           "worker.WorkerHistoryPageSize",
 	WorkerTargetArchivalBlobSize:                    "worker.WorkerTargetArchivalBlobSize",
@@ -228,6 +254,9 @@ var keys = map[Key]string{
 	WorkerTimeLimitPerArchivalIteration:             "worker.TimeLimitPerArchivalIteration",
 	WorkerThrottledLogRPS:                           "worker.throttledLogRPS",
 	ScannerPersistenceMaxQPS:                        "worker.scannerPersistenceMaxQPS",
+	WorkerVisibilityMigrationMaxQPS:                 "worker.visibilityMigrationMaxQPS",
+	WorkerVisibilityExportMaxQPS:                    "worker.visibilityExportMaxQPS",
+	WorkerVisibilityExportEnabledForDomain:          "worker.visibilityExportEnabledForDomain",
 }
 
 const (
@@ -246,6 +275,8 @@ const (
 	testGetIntPropertyFilteredByTaskListInfoKey
 	testGetDurationPropertyFilteredByTaskListInfoKey
 	testGetBoolPropertyFilteredByTaskListInfoKey
+	testGetMapPropertyFilteredByTaskListInfoKey
+	testGetStringPropertyFilteredByTaskListInfoKey
 
 	// EnableGlobalDomain is key for enable global domain
 	EnableGlobalDomain
@@ -263,6 +294,8 @@ const (
 	EmitShardDiffLog
 	// EnableReadVisibilityFromES is key for enable read from elastic search
 	EnableReadVisibilityFromES
+	// EnableReadVisibilityFromMigration is key for enable read from the visibility migration target store
+	EnableReadVisibilityFromMigration
 	// DisableListVisibilityByFilter is config to disable list open/close workflow using filter
 	DisableListVisibilityByFilter
 	// HistoryArchivalStatus is key for the status of history archival
@@ -298,6 +331,19 @@ const (
 	// HistoryCountLimitWarn is the per workflow execution history event count limit for warning
 	HistoryCountLimitWarn
 
+	// OpenWorkflowsLimit is the per domain open workflow executions limit, 0 means no limit.
+	// Workflows of the domain cannot be started until the open workflows of the domain are counted
+	OpenWorkflowsLimit
+	// DomainHistorySizeLimitPerShard is the per domain limit of the history size retained on a history shard,
+	// new workflows of the domain are rejected by the shard once exceeded, 0 means no limit
+	DomainHistorySizeLimitPerShard
+	// PendingActivitiesLimit is the per workflow execution pending activities limit, 0 means no limit
+	PendingActivitiesLimit
+	// PendingChildWorkflowsLimit is the per workflow execution pending child workflows limit, 0 means no limit
+	PendingChildWorkflowsLimit
+	// PendingTimersLimit is the per workflow execution pending timers limit, 0 means no limit
+	PendingTimersLimit
+
 	// MaxIDLengthLimit is the length limit for various IDs, including: Domain, TaskList, WorkflowID, ActivityID, TimerID,
 	// WorkflowType, ActivityType, SignalName, MarkerName, ErrorReason/FailureReason/CancelCause, Identity, RequestID
 	MaxIDLengthLimit
@@ -314,6 +360,8 @@ const (
 	FrontendESVisibilityListMaxQPS
 	// FrontendESIndexMaxResultWindow is ElasticSearch index setting max_result_window
 	FrontendESIndexMaxResultWindow
+	// FrontendESHistorySearchMaxResults is max number of workflows matched by history conditions of a query
+	FrontendESHistorySearchMaxResults
 	// FrontendHistoryMaxPageSize is default max size for GetWorkflowExecutionHistory in one page
 	FrontendHistoryMaxPageSize
 	// FrontendRPS is workflow rate limit per second
@@ -330,6 +378,10 @@ const (
 	FrontendMaxBadBinaries
 	// ValidSearchAttributes is legal indexed keys that can be used in list APIs
 	ValidSearchAttributes
+	// SearchAttributeStatus is the lifecycle status of search attributes, deprecated or removed
+	SearchAttributeStatus
+	// SearchAttributeAliases maps the aliases of search attributes to the keys
+	SearchAttributeAliases
 	// SearchAttributesNumberOfKeysLimit is the limit of number of keys
 	SearchAttributesNumberOfKeysLimit
 	// SearchAttributesSizeOfValueLimit is the size limit of each value
@@ -342,6 +394,11 @@ const (
 	VisibilityArchivalQueryMaxRangeInDays
 	// VisibilityArchivalQueryMaxQPS is the timeout for a visibility archival query
 	VisibilityArchivalQueryMaxQPS
+	// FrontendEnablePollerTaskStats is whether frontend reports task completions to matching for per poller statistics
+	// and to release the outstanding slots of limited activity types
+	FrontendEnablePollerTaskStats
+	// FrontendGracefulFailoverRefreshInterval is the interval at which frontend checks draining domains for handover
+	FrontendGracefulFailoverRefreshInterval
 
 	// key for matching
 
@@ -383,6 +440,19 @@ const (
 	MatchingForwarderMaxRatePerSecond
 	// MatchingForwarderMaxChildrenPerNode is the max number of children per node in the task list partition tree
 	MatchingForwarderMaxChildrenPerNode
+	// MatchingActivityTypeDispatchRPS is a map from activity type to the max rate at which activity tasks
+	// of that type are dispatched from a task list to pollers
+	MatchingActivityTypeDispatchRPS
+	// MatchingActivityTypeMaxOutstanding is a map from activity type to the max number of activity tasks
+	// of that type which can be started but not yet completed across all pollers of a task list
+	MatchingActivityTypeMaxOutstanding
+	// MatchingBacklogSegmentMode is the layout used to persist the backlog of a task list, the backlog is
+	// stored as one row per task when off, or as segments of tasks when on. Drain writes rows but still reads
+	// segments until none is left, it must be used before turning segments off so the backlog is not lost
+	MatchingBacklogSegmentMode
+	// MatchingActivityAffinityTimeout is the max time an activity task scheduled with an affinity token waits
+	// for a poller which registered that token, before it falls back to the task list it was scheduled on
+	MatchingActivityAffinityTimeout
 
 	// key for history
 
@@ -460,6 +530,9 @@ const (
 	TransferTaskWorkerCount
 	// TransferTaskMaxRetryCount is max times of retry for transferQueueProcessor
 	TransferTaskMaxRetryCount
+	// TransferCrossClusterTaskMaxRetryDuration is the max duration a child workflow or signal transfer task is retried
+	// against the cluster where the target domain is active, before the failure is recorded in the source workflow
+	TransferCrossClusterTaskMaxRetryDuration
 	// TransferProcessorCompleteTransferFailureRetryCount is times of retry for failure
 	TransferProcessorCompleteTransferFailureRetryCount
 	// TransferProcessorUpdateShardTaskCount is update shard count for transferQueueProcessor
@@ -502,6 +575,8 @@ const (
 	MaximumBufferedEventsBatch
 	// MaximumSignalsPerExecution is max number of signals supported by single execution
 	MaximumSignalsPerExecution
+	// OpenWorkflowsCountCacheTTL is how long the open workflow count of a domain is cached for enforcing OpenWorkflowsLimit
+	OpenWorkflowsCountCacheTTL
 	// ShardUpdateMinInterval is the minimal time interval which the shard info can be updated
 	ShardUpdateMinInterval
 	// ShardSyncMinInterval is the minimal time interval which the shard info should be sync to remote
@@ -519,6 +594,9 @@ const (
 	AdminOperationToken
 	// HistoryMaxAutoResetPoints is the key for max number of auto reset points stored in mutableState
 	HistoryMaxAutoResetPoints
+	// EnableStateSearchAttributes is the key for enabling search attributes of pending activities and child workflows,
+	// which are maintained by history in mutableState
+	EnableStateSearchAttributes
 
 	// EnableParentClosePolicy whether to  ParentClosePolicy
 	EnableParentClosePolicy
@@ -563,6 +641,8 @@ const (
 	WorkerESProcessorBulkSize
 	// WorkerESProcessorFlushInterval is flush interval for esProcessor
 	WorkerESProcessorFlushInterval
+	// WorkerHistoryIndexerMaxQPS is the max rate of history pages read by indexer for history search
+	WorkerHistoryIndexerMaxQPS
 	// EnableArchivalCompression indicates whether blobs are compressed before they are archived
 	EnableArchivalCompression
 	// WorkerHistoryPageSize indicates the page size of history fetched from persistence for archival
@@ -583,10 +663,23 @@ const (
 	WorkerThrottledLogRPS
 	// ScannerPersistenceMaxQPS is the maximum rate of persistence calls from worker.Scanner
 	ScannerPersistenceMaxQPS
+	// WorkerVisibilityMigrationMaxQPS is the max rate of visibility records written by the visibility migration
+	WorkerVisibilityMigrationMaxQPS
+	// WorkerVisibilityExportMaxQPS is the max rate of visibility scan requests made by the visibility export
+	WorkerVisibilityExportMaxQPS
+	// WorkerVisibilityExportEnabledForDomain decides whether or not the visibility records of a domain can be exported,
+	// the export runs with the privileges of the worker service, so domains are allowed one by one
+	WorkerVisibilityExportEnabledForDomain
 	// EnableBatcher decides whether start batcher in our worker
 	EnableBatcher
 	// EnableParentClosePolicyWorker decides whether or not enable system workers for processing parent close policy task
 	EnableParentClosePolicyWorker
+	// EnableFailoverManager decides whether or not enable system workers for bulk domain failover
+	EnableFailoverManager
+	// EnableVisibilityMigration decides whether or not enable system workers for visibility store migration
+	EnableVisibilityMigration
+	// EnableVisibilityExport decides whether or not enable system workers for exporting visibility records to files
+	EnableVisibilityExport
 
 	//ReplicationTaskFetcherParallelism determines how many go routines we spin up for fetching tasks
 	ReplicationTaskFetcherParallelism
@@ -186,7 +186,39 @@ func (fc *fileBasedClient) GetDurationValue(
 }
 
 func (fc *fileBasedClient) UpdateValue(name Key, value interface{}) error {
-	keyName := keys[name]
+	return fc.updateValues(func(currentValues map[string][]*constrainedValue) {
+		cVal := &constrainedValue{
+			Value: value,
+		}
+		currentValues[keys[name]] = []*constrainedValue{cVal}
+	})
+}
+
+func (fc *fileBasedClient) UpdateValueWithFilters(name Key, filters map[Filter]interface{}, value interface{}) error {
+	return fc.updateValues(func(currentValues map[string][]*constrainedValue) {
+		keyName := keys[name]
+		cVal := &constrainedValue{
+			Value: value,
+		}
+		if len(filters) > 0 {
+			cVal.Constraints = make(map[string]interface{}, len(filters))
+			for filter, filterValue := range filters {
+				cVal.Constraints[filter.String()] = filterValue
+			}
+		}
+
+		var newValues []*constrainedValue
+		for _, v := range currentValues[keyName] {
+			if !match(v, filters) {
+				newValues = append(newValues, v)
+			}
+		}
+		currentValues[keyName] = append(newValues, cVal)
+	})
+}
+
+// updateValues applies the update to the values in config file, then writes and stores the values
+func (fc *fileBasedClient) updateValues(update func(currentValues map[string][]*constrainedValue)) error {
 	currentValues := make(map[string][]*constrainedValue)
 
 	confContent, err := ioutil.ReadFile(fc.config.Filepath)
@@ -198,10 +230,7 @@ func (fc *fileBasedClient) UpdateValue(name Key, value interface{}) error {
 		return fmt.Errorf("failed to decode dynamic config %v", err)
 	}
 
-	cVal := &constrainedValue{
-		Value: value,
-	}
-	currentValues[keyName] = []*constrainedValue{cVal}
+	update(currentValues)
 	newBytes, _ := yaml.Marshal(currentValues)
 
 	err = ioutil.WriteFile(fc.config.Filepath, newBytes, fileMode)
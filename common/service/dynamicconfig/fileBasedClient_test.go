@@ -351,3 +351,33 @@ func (s *fileBasedClientSuite) TestUpdateConfig() {
 	err = client.UpdateValue(key, v)
 	s.NoError(err)
 }
+
+func (s *fileBasedClientSuite) TestUpdateConfigWithFilters() {
+	client := s.client.(*fileBasedClient)
+	key := ValidSearchAttributes
+	domainFilters := map[Filter]interface{}{DomainName: "samples-domain"}
+
+	// update config of domain
+	err := client.UpdateValueWithFilters(key, domainFilters, map[string]interface{}{"WorkflowID": 1})
+	s.NoError(err)
+	current, err := client.GetMapValue(key, domainFilters, nil)
+	s.NoError(err)
+	s.Equal(map[string]interface{}{"WorkflowID": 1}, current)
+	current, err = client.GetMapValue(key, nil, nil)
+	s.NoError(err)
+	s.Equal(map[string]interface{}{"DomainID": 1}, current)
+
+	// update config without filters keeps config of domain
+	err = client.UpdateValueWithFilters(key, nil, map[string]interface{}{"DomainID": 2})
+	s.NoError(err)
+	current, err = client.GetMapValue(key, domainFilters, nil)
+	s.NoError(err)
+	s.Equal(map[string]interface{}{"WorkflowID": 1}, current)
+	current, err = client.GetMapValue(key, map[Filter]interface{}{DomainName: "other-domain"}, nil)
+	s.NoError(err)
+	s.Equal(map[string]interface{}{"DomainID": 2}, current)
+
+	// revert test file back
+	err = client.UpdateValue(key, map[string]interface{}{"DomainID": 1})
+	s.NoError(err)
+}
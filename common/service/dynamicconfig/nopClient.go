@@ -72,6 +72,10 @@ func (mc *nopClient) UpdateValue(name Key, value interface{}) error {
 	return errors.New("unable to update key")
 }
 
+func (mc *nopClient) UpdateValueWithFilters(name Key, filters map[Filter]interface{}, value interface{}) error {
+	return errors.New("unable to update key")
+}
+
 // NewNopClient creates a nop client
 func NewNopClient() Client {
 	return &nopClient{}
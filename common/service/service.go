@@ -80,6 +80,8 @@ type (
 		PublicClient        workflowserviceclient.Interface
 		ArchivalMetadata    archiver.ArchivalMetadata
 		ArchiverProvider    provider.ArchiverProvider
+		// VisibilityExportConfig is the static config of visibility export on worker service
+		VisibilityExportConfig config.VisibilityExport
 	}
 
 	// MembershipMonitorFactory provides a bootstrapped membership monitor
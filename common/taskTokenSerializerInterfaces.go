@@ -37,6 +37,7 @@ type (
 		ScheduleID      int64  `json:"scheduleId"`
 		ScheduleAttempt int64  `json:"scheduleAttempt"`
 		ActivityID      string `json:"activityId"`
+		TaskList        string `json:"taskList,omitempty"`
 	}
 
 	// QueryTaskToken identifies a query task
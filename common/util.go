@@ -486,6 +486,13 @@ func IsJustOrderByClause(clause string) bool {
 	return strings.HasPrefix(whereClause, "order by")
 }
 
+// IsJustGroupByClause return true is query start with group by
+func IsJustGroupByClause(clause string) bool {
+	whereClause := strings.TrimSpace(clause)
+	whereClause = strings.ToLower(whereClause)
+	return strings.HasPrefix(whereClause, "group by")
+}
+
 // ConvertIndexedValueTypeToThriftType takes fieldType as interface{} and convert to IndexedValueType.
 // Because different implementation of dynamic config client may lead to different types
 func ConvertIndexedValueTypeToThriftType(fieldType interface{}, logger log.Logger) workflow.IndexedValueType {
@@ -39,7 +39,11 @@ setup_mysql_schema() {
 }
 
 setup_es_template() {
-    SCHEMA_FILE=$CADENCE_HOME/schema/elasticsearch/visibility/index_template.json
+    if [ "$ES_VERSION" == "v7" ]; then
+        SCHEMA_FILE=$CADENCE_HOME/schema/elasticsearch/visibility/index_template_v7.json
+    else
+        SCHEMA_FILE=$CADENCE_HOME/schema/elasticsearch/visibility/index_template.json
+    fi
     server=`echo $ES_SEEDS | awk -F ',' '{print $1}'`
     URL="http://$server:$ES_PORT/_template/cadence-visibility-template"
     curl -X PUT $URL -H 'Content-Type: application/json' --data-binary "@$SCHEMA_FILE"
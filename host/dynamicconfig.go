@@ -96,6 +96,12 @@ func (d *dynamicClient) UpdateValue(name dynamicconfig.Key, value interface{}) e
 	return d.client.UpdateValue(name, value)
 }
 
+func (d *dynamicClient) UpdateValueWithFilters(
+	name dynamicconfig.Key, filters map[dynamicconfig.Filter]interface{}, value interface{},
+) error {
+	return d.client.UpdateValueWithFilters(name, filters, value)
+}
+
 // newIntegrationConfigClient - returns a dynamic config client for integration testing
 func newIntegrationConfigClient(client dynamicconfig.Client) dynamicconfig.Client {
 	return &dynamicClient{client}
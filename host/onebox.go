@@ -674,8 +674,12 @@ func (c *cadenceImpl) startWorker(hosts map[string][]string, startWG *sync.WaitG
 		c.startWorkerClientWorker(params, service, clientWorkerDomainCache)
 	}
 
+	var indexerDomainCache cache.DomainCache
 	if c.workerConfig.EnableIndexer {
-		c.startWorkerIndexer(params, service)
+		metadataManager := persistence.NewMetadataPersistenceMetricsClient(c.metadataMgr, service.GetMetricsClient(), c.logger)
+		indexerDomainCache = cache.NewDomainCache(metadataManager, params.ClusterMetadata, service.GetMetricsClient(), service.GetLogger())
+		indexerDomainCache.Start()
+		c.startWorkerIndexer(params, service, indexerDomainCache)
 	}
 
 	startWG.Done()
@@ -686,6 +690,9 @@ func (c *cadenceImpl) startWorker(hosts map[string][]string, startWG *sync.WaitG
 	if c.workerConfig.EnableArchiver {
 		clientWorkerDomainCache.Stop()
 	}
+	if c.workerConfig.EnableIndexer {
+		indexerDomainCache.Stop()
+	}
 	c.shutdownWG.Done()
 }
 
@@ -746,7 +753,7 @@ func (c *cadenceImpl) startWorkerClientWorker(params *service.BootstrapParams, s
 	}
 }
 
-func (c *cadenceImpl) startWorkerIndexer(params *service.BootstrapParams, service service.Service) {
+func (c *cadenceImpl) startWorkerIndexer(params *service.BootstrapParams, service service.Service, domainCache cache.DomainCache) {
 	params.DynamicConfig.UpdateValue(dynamicconfig.AdvancedVisibilityWritingMode, common.AdvancedVisibilityWritingModeDual)
 	workerConfig := worker.NewConfig(params)
 	c.indexer = indexer.NewIndexer(
@@ -754,6 +761,8 @@ func (c *cadenceImpl) startWorkerIndexer(params *service.BootstrapParams, servic
 		c.messagingClient,
 		c.esClient,
 		c.esConfig,
+		service.GetClientBean().GetFrontendClient(),
+		domainCache,
 		c.logger,
 		service.GetMetricsClient())
 	if err := c.indexer.Start(); err != nil {
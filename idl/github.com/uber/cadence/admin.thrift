@@ -21,6 +21,8 @@
 namespace java com.uber.cadence.admin
 
 include "shared.thrift"
+include "replicator.thrift"
+include "history.thrift"
 
 /**
 * AdminService provides advanced APIs for debugging and analysis with admin privillege
@@ -97,6 +99,156 @@ service AdminService {
       2: shared.InternalServiceError internalServiceError,
       3: shared.ServiceBusyError serviceBusyError,
     )
+
+  /**
+  * UpdateSearchAttributes deprecates, removes and aliases search attributes of the cluster, or of a domain
+  * if domain is set in request. Deprecated search attributes can be queried but not set on workflows,
+  * removed search attributes can neither be queried nor set, aliases can be used in place of the keys.
+  **/
+  void UpdateSearchAttributes(1: UpdateSearchAttributesRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * DescribeSearchAttributes returns the search attributes registered for the cluster, or for a domain
+  * if domain is set in request, with the deprecated and removed search attributes and the aliases.
+  **/
+  DescribeSearchAttributesResponse DescribeSearchAttributes(1: DescribeSearchAttributesRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * DrainTaskList starts or stops draining a tasklist, new tasks are redirected to the target tasklist or rejected
+  * while the existing backlog is dispatched to the pollers of the drained tasklist. Leave drain unset to only
+  * get the drain state and the remaining backlog of the tasklist.
+  **/
+  DrainTaskListResponse DrainTaskList(1: DrainTaskListRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * GetDLQReplicationMessages regenerates the replication tasks of the given task infos, it is called by the
+  * remote clusters to re-apply the replication tasks they put into their DLQ
+  **/
+  replicator.GetDLQReplicationMessagesResponse GetDLQReplicationMessages(1: replicator.GetDLQReplicationMessagesRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * ReadDLQMessages returns the replication tasks of a shard in the DLQ of the given source cluster
+  **/
+  replicator.ReadDLQMessagesResponse ReadDLQMessages(1: replicator.ReadDLQMessagesRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.ServiceBusyError serviceBusyError,
+      4: shared.EntityNotExistsError entityNotExistError,
+    )
+
+  /**
+  * PurgeDLQMessages deletes the replication tasks of a shard in the DLQ of the given source cluster
+  * up to the inclusive end message id
+  **/
+  void PurgeDLQMessages(1: replicator.PurgeDLQMessagesRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.ServiceBusyError serviceBusyError,
+      4: shared.EntityNotExistsError entityNotExistError,
+    )
+
+  /**
+  * MergeDLQMessages re-applies the replication tasks of a shard in the DLQ of the given source cluster
+  * up to the inclusive end message id and deletes them from the DLQ
+  **/
+  replicator.MergeDLQMessagesResponse MergeDLQMessages(1: replicator.MergeDLQMessagesRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.ServiceBusyError serviceBusyError,
+      4: shared.EntityNotExistsError entityNotExistError,
+    )
+
+  /**
+  * DescribeReplication returns the replication lag and health of the shards of this cluster
+  * for the tasks replicated from the given source cluster
+  **/
+  replicator.DescribeReplicationResponse DescribeReplication(1: replicator.DescribeReplicationRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.ServiceBusyError serviceBusyError,
+      4: shared.EntityNotExistsError entityNotExistError,
+    )
+
+  /**
+  * StartChildWorkflowExecution starts a child workflow on behalf of a parent workflow running in another cluster,
+  * then schedules the first decision task of the child workflow.
+  **/
+  shared.StartWorkflowExecutionResponse StartChildWorkflowExecution(1: StartChildWorkflowExecutionRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.WorkflowExecutionAlreadyStartedError sessionAlreadyExistError,
+      4: shared.EntityNotExistsError entityNotExistError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.ServiceBusyError serviceBusyError,
+      7: shared.LimitExceededError limitExceededError,
+    )
+
+  /**
+  * SignalExternalWorkflowExecution signals a workflow on behalf of a workflow running in another cluster.
+  **/
+  void SignalExternalWorkflowExecution(1: SignalExternalWorkflowExecutionRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.DomainNotActiveError domainNotActiveError,
+      5: shared.ServiceBusyError serviceBusyError,
+      6: shared.LimitExceededError limitExceededError,
+    )
+
+  /**
+  * RecordChildExecutionCompleted records the completion of a child workflow running in another cluster
+  * to its parent workflow.
+  **/
+  void RecordChildExecutionCompleted(1: RecordChildExecutionCompletedRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.DomainNotActiveError domainNotActiveError,
+      5: shared.ServiceBusyError serviceBusyError,
+      6: shared.LimitExceededError limitExceededError,
+    )
+
+  /**
+  * RemoveSignalMutableState removes the request ID of a signal sent by a workflow running in another cluster,
+  * once the signal is recorded as completed by the workflow sending it.
+  **/
+  void RemoveSignalMutableState(1: RemoveSignalMutableStateRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.DomainNotActiveError domainNotActiveError,
+      5: shared.ServiceBusyError serviceBusyError,
+      6: shared.LimitExceededError limitExceededError,
+    )
 }
 
 struct DescribeWorkflowExecutionRequest {
@@ -151,4 +303,61 @@ struct GetWorkflowExecutionRawHistoryV2Response {
 struct AddSearchAttributeRequest {
   10: optional map<string, shared.IndexedValueType> searchAttribute
   20: optional string securityToken
+  // search attributes are added to the registry of the domain if set, otherwise to the registry of the cluster
+  30: optional string domain
+}
+
+struct UpdateSearchAttributesRequest {
+  10: optional string domain
+  20: optional list<string> deprecateKeys
+  30: optional list<string> removeKeys
+  // aliases to add, from alias to the key
+  40: optional map<string, string> addAliases
+  50: optional list<string> removeAliases
+  60: optional string securityToken
+}
+
+struct DescribeSearchAttributesRequest {
+  10: optional string domain
+}
+
+struct DescribeSearchAttributesResponse {
+  10: optional map<string, shared.IndexedValueType> keys
+  20: optional list<string> deprecatedKeys
+  30: optional list<string> removedKeys
+  40: optional map<string, string> aliases
+}
+
+struct DrainTaskListRequest {
+  10: optional string domain
+  20: optional shared.TaskList taskList
+  30: optional shared.TaskListType taskListType
+  40: optional bool drain
+  50: optional string targetTaskList
+}
+
+struct DrainTaskListResponse {
+  10: optional bool draining
+  20: optional string targetTaskList
+  30: optional i64 (js.type = "Long") backlogCountHint
+}
+
+struct StartChildWorkflowExecutionRequest {
+  10: optional history.StartWorkflowExecutionRequest startRequest
+  20: optional string securityToken
+}
+
+struct SignalExternalWorkflowExecutionRequest {
+  10: optional history.SignalWorkflowExecutionRequest signalRequest
+  20: optional string securityToken
+}
+
+struct RecordChildExecutionCompletedRequest {
+  10: optional history.RecordChildExecutionCompletedRequest completionRequest
+  20: optional string securityToken
+}
+
+struct RemoveSignalMutableStateRequest {
+  10: optional history.RemoveSignalMutableStateRequest removeRequest
+  20: optional string securityToken
 }
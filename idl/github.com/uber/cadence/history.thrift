@@ -348,6 +348,29 @@ struct ReapplyEventsRequest {
   20: optional shared.ReapplyEventsRequest request
 }
 
+struct BackfillReplicationRequest {
+  10: optional string domainUUID
+  20: optional shared.WorkflowExecution execution
+}
+
+struct ReplicationAckLevel {
+  // domainNotificationVersion is the domain notification version already applied by the shard
+  10: optional i64 (js.type = "Long") domainNotificationVersion
+  // maxReadLevel is the highest task ID which is persisted in the shard
+  20: optional i64 (js.type = "Long") maxReadLevel
+  // ackLevel is the highest task ID up to which all replication tasks are processed by the remote cluster
+  30: optional i64 (js.type = "Long") ackLevel
+}
+
+struct GetReplicationAckLevelsRequest {
+  10: optional string clusterName
+  20: optional list<i32> shardIDs
+}
+
+struct GetReplicationAckLevelsResponse {
+  10: optional map<i32, ReplicationAckLevel> ackLevelsByShard
+}
+
 /**
 * HistoryService provides API to start a new long running workflow instance, as well as query and update the history
 * of workflow instances already created.
@@ -841,4 +864,86 @@ service HistoryService {
       6: ShardOwnershipLostError shardOwnershipLostError,
       7: shared.EntityNotExistsError entityNotExistError,
     )
+
+  /**
+  * GetDLQReplicationMessages regenerates the replication tasks of the given task infos
+  **/
+  replicator.GetDLQReplicationMessagesResponse GetDLQReplicationMessages(1: replicator.GetDLQReplicationMessagesRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.ServiceBusyError serviceBusyError,
+      4: shared.EntityNotExistsError entityNotExistError,
+    )
+
+  /**
+  * ReadDLQMessages returns the replication tasks of a shard in the DLQ of the given source cluster
+  **/
+  replicator.ReadDLQMessagesResponse ReadDLQMessages(1: replicator.ReadDLQMessagesRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.ServiceBusyError serviceBusyError,
+      4: shared.EntityNotExistsError entityNotExistError,
+      5: ShardOwnershipLostError shardOwnershipLostError,
+    )
+
+  /**
+  * PurgeDLQMessages deletes the replication tasks of a shard in the DLQ of the given source cluster
+  **/
+  void PurgeDLQMessages(1: replicator.PurgeDLQMessagesRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.ServiceBusyError serviceBusyError,
+      4: shared.EntityNotExistsError entityNotExistError,
+      5: ShardOwnershipLostError shardOwnershipLostError,
+    )
+
+  /**
+  * GetReplicationAckLevels returns how far the given remote cluster has processed the replication tasks of the given shards
+  **/
+  GetReplicationAckLevelsResponse GetReplicationAckLevels(1: GetReplicationAckLevelsRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * MergeDLQMessages re-applies the replication tasks of a shard in the DLQ of the given source cluster
+  **/
+  replicator.MergeDLQMessagesResponse MergeDLQMessages(1: replicator.MergeDLQMessagesRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.ServiceBusyError serviceBusyError,
+      4: shared.EntityNotExistsError entityNotExistError,
+      5: ShardOwnershipLostError shardOwnershipLostError,
+    )
+
+  /**
+  * DescribeReplication returns the replication status of the given shards for the given source cluster
+  **/
+  replicator.DescribeReplicationResponse DescribeReplication(1: replicator.DescribeReplicationRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * BackfillReplication replicates the history of an open workflow of a domain promoted from local to global
+  * to the other clusters of the domain
+  **/
+  void BackfillReplication(1: BackfillReplicationRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: ShardOwnershipLostError shardOwnershipLostError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.LimitExceededError limitExceededError,
+      7: shared.ServiceBusyError serviceBusyError,
+    )
 }
@@ -73,6 +73,8 @@ struct AddActivityTaskRequest {
   50: optional i64 (js.type = "Long") scheduleId
   60: optional i32 scheduleToStartTimeoutSeconds
   70: optional string forwardedFrom
+  80: optional string activityType
+  90: optional string affinityToken
 }
 
 struct QueryWorkflowRequest {
@@ -101,6 +103,34 @@ struct DescribeTaskListRequest {
   20: optional shared.DescribeTaskListRequest descRequest
 }
 
+struct RecordTaskCompletionRequest {
+  10: optional string domainUUID
+  20: optional shared.TaskList taskList
+  30: optional shared.TaskListType taskListType
+  40: optional string identity
+  50: optional bool failed
+  60: optional shared.WorkflowExecution execution
+  70: optional i64 (js.type = "Long") scheduleId
+}
+
+struct DrainTaskListRequest {
+  10: optional string domainUUID
+  20: optional shared.TaskList taskList
+  30: optional shared.TaskListType taskListType
+  // starts draining the task list when true and stops draining it when false, the
+  // drain state is left unchanged when not set so that the drain progress can be polled
+  40: optional bool drain
+  // new tasks are added to this task list while draining, they are rejected when empty
+  50: optional string targetTaskList
+  60: optional string forwardedFrom
+}
+
+struct DrainTaskListResponse {
+  10: optional bool draining
+  20: optional string targetTaskList
+  30: optional i64 (js.type = "Long") backlogCountHint
+}
+
 /**
 * MatchingService API is exposed to provide support for polling from long running applications.
 * Such applications are expected to have a worker which regularly polls for DecisionTask and ActivityTask.  For each
@@ -211,4 +241,28 @@ service MatchingService {
         3: shared.EntityNotExistsError entityNotExistError,
         4: shared.ServiceBusyError serviceBusyError,
       )
+
+  /**
+  * RecordTaskCompletion is called by frontend after a worker responds to a decision or activity task, so that
+  * the tasklist can keep per poller statistics. Only tasklists which are already loaded are updated.
+  **/
+  void RecordTaskCompletion(1: RecordTaskCompletionRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * DrainTaskList starts or stops draining a tasklist and all of its partitions. New tasks added to a draining
+  * tasklist are added to the target tasklist instead, or rejected when there is none, while the backlog of the
+  * drained tasklist is still dispatched to its pollers. The response reports the remaining backlog.
+  **/
+  DrainTaskListResponse DrainTaskList(1: DrainTaskListRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+    )
 }
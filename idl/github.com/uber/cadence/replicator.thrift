@@ -132,10 +132,13 @@ struct ReplicationMessages {
   // This can be different than the last taskId in the above list, because sender can decide to skip tasks (e.g. for completed workflows).
   20: optional i64 (js.type = "Long") lastRetrivedMessageId
   30: optional bool hasMore // Hint for flow control
+  // maxReadLevel is the max task ID the sender could read when serving the request, used to estimate the lag
+  40: optional i64 (js.type = "Long") maxReadLevel
 }
 
 struct GetReplicationMessagesRequest {
   10: optional list<ReplicationToken> tokens
+  20: optional string clusterName
 }
 
 struct GetReplicationMessagesResponse {
@@ -154,4 +157,112 @@ struct GetDomainReplicationMessagesRequest {
 
 struct GetDomainReplicationMessagesResponse {
   10: optional ReplicationMessages messages
-}
\ No newline at end of file
+}
+enum DLQType {
+  Replication,
+}
+
+struct ReplicationTaskInfo {
+  10: optional string domainID
+  20: optional string workflowID
+  30: optional string runID
+  40: optional i16 taskType
+  50: optional i64 (js.type = "Long") taskID
+  60: optional i64 (js.type = "Long") version
+  70: optional i64 (js.type = "Long") firstEventID
+  80: optional i64 (js.type = "Long") nextEventID
+  90: optional i64 (js.type = "Long") scheduledID
+}
+
+struct GetDLQReplicationMessagesRequest {
+  10: optional list<ReplicationTaskInfo> taskInfos
+}
+
+struct GetDLQReplicationMessagesResponse {
+  10: optional list<ReplicationTask> replicationTasks
+}
+
+struct ReadDLQMessagesRequest {
+  10: optional DLQType type
+  20: optional i32 shardID
+  30: optional string sourceCluster
+  40: optional i64 (js.type = "Long") inclusiveEndMessageID
+  50: optional i32 maximumPageSize
+  60: optional binary nextPageToken
+}
+
+struct ReadDLQMessagesResponse {
+  10: optional DLQType type
+  20: optional list<ReplicationTaskInfo> replicationTasks
+  30: optional binary nextPageToken
+}
+
+struct PurgeDLQMessagesRequest {
+  10: optional DLQType type
+  20: optional i32 shardID
+  30: optional string sourceCluster
+  40: optional i64 (js.type = "Long") inclusiveEndMessageID
+}
+
+struct MergeDLQMessagesRequest {
+  10: optional DLQType type
+  20: optional i32 shardID
+  30: optional string sourceCluster
+  40: optional i64 (js.type = "Long") inclusiveEndMessageID
+  50: optional i32 maximumPageSize
+  60: optional binary nextPageToken
+}
+
+struct MergeDLQMessagesResponse {
+  10: optional binary nextPageToken
+}
+
+struct ReplicationError {
+  10: optional i64 (js.type = "Long") taskID
+  20: optional string domainID
+  30: optional string workflowID
+  40: optional string runID
+  50: optional string message
+  60: optional i64 (js.type = "Long") timestamp
+}
+
+struct DomainReplicationStatus {
+  10: optional string domainID
+  20: optional i64 (js.type = "Long") lastAppliedTaskID
+  30: optional i64 (js.type = "Long") lastAppliedTimestamp
+  40: optional i64 (js.type = "Long") appliedTaskCount
+  50: optional i64 (js.type = "Long") failedTaskCount
+  60: optional ReplicationError lastError
+}
+
+struct ShardReplicationStatus {
+  10: optional i32 shardID
+  20: optional i64 (js.type = "Long") lastRetrievedMessageID
+  30: optional i64 (js.type = "Long") lastProcessedMessageID
+  40: optional i64 (js.type = "Long") sourceMaxReadLevel
+  // taskIDLag is the distance in task IDs between the source max read level and the last processed message
+  50: optional i64 (js.type = "Long") taskIDLag
+  // lastCaughtUpTimestamp is the last time the shard processed all replication tasks of the source
+  60: optional i64 (js.type = "Long") lastCaughtUpTimestamp
+  70: optional i64 (js.type = "Long") wallClockLagInMillis
+  80: optional i64 (js.type = "Long") dlqSize
+  90: optional ReplicationError lastError
+  100: optional list<DomainReplicationStatus> domains
+}
+
+struct DescribeReplicationRequest {
+  10: optional string sourceCluster
+  // empty shardIDs means all shards
+  20: optional list<i32> shardIDs
+  // domain is the optional domain name to report the per domain status for
+  30: optional string domain
+  // domainID is resolved from the domain by frontend
+  40: optional string domainID
+}
+
+struct DescribeReplicationResponse {
+  10: optional string sourceCluster
+  20: optional list<ShardReplicationStatus> shards
+  // missingShardIDs are the shards whose status cannot be retrieved
+  30: optional list<i32> missingShardIDs
+}
@@ -226,6 +226,9 @@ enum DecisionTaskFailedCause {
   BAD_BINARY,
   SCHEDULE_ACTIVITY_DUPLICATE_ID,
   BAD_SEARCH_ATTRIBUTES,
+  PENDING_ACTIVITIES_LIMIT_EXCEEDED,
+  PENDING_CHILD_WORKFLOWS_LIMIT_EXCEEDED,
+  PENDING_TIMERS_LIMIT_EXCEEDED,
 }
 
 enum CancelExternalWorkflowExecutionFailedCause {
@@ -234,10 +237,13 @@ enum CancelExternalWorkflowExecutionFailedCause {
 
 enum SignalExternalWorkflowExecutionFailedCause {
   UNKNOWN_EXTERNAL_WORKFLOW_EXECUTION,
+  TARGET_CLUSTER_UNREACHABLE,
 }
 
 enum ChildWorkflowExecutionFailedCause {
   WORKFLOW_ALREADY_RUNNING,
+  TARGET_CLUSTER_UNREACHABLE,
+  OPEN_WORKFLOWS_LIMIT_EXCEEDED,
 }
 
 // TODO: when migrating to gRPC, add a running / none status,
@@ -283,6 +289,12 @@ enum ArchivalStatus {
   ENABLED,
 }
 
+enum DomainFailoverState {
+  DRAINING,
+  COMPLETED,
+  TIMED_OUT,
+}
+
 enum IndexedValueType {
   STRING,
   KEYWORD,
@@ -290,6 +302,10 @@ enum IndexedValueType {
   DOUBLE,
   BOOL,
   DATETIME,
+  // KEYWORD_LIST and DATETIME_LIST attributes hold a list of values,
+  // a query condition on the attribute matches if any value in the list matches
+  KEYWORD_LIST,
+  DATETIME_LIST,
 }
 
 struct Header {
@@ -399,6 +415,7 @@ struct ScheduleActivityTaskDecisionAttributes {
   60: optional i32 heartbeatTimeoutSeconds
   70: optional RetryPolicy retryPolicy
   80: optional Header header
+  90: optional string affinityToken
 }
 
 struct RequestCancelActivityTaskDecisionAttributes {
@@ -446,6 +463,8 @@ struct SignalExternalWorkflowExecutionDecisionAttributes {
 
 struct UpsertWorkflowSearchAttributesDecisionAttributes {
   10: optional SearchAttributes searchAttributes
+  // removeSearchAttributes are the keys of the search attributes removed from the workflow
+  20: optional list<string> removeSearchAttributes
 }
 
 struct RecordMarkerDecisionAttributes {
@@ -641,6 +660,7 @@ struct ActivityTaskScheduledEventAttributes {
   90: optional i64 (js.type = "Long") decisionTaskCompletedEventId
   110: optional RetryPolicy retryPolicy
   120: optional Header header
+  130: optional string affinityToken
 }
 
 struct ActivityTaskStartedEventAttributes {
@@ -803,6 +823,7 @@ struct ExternalWorkflowExecutionSignaledEventAttributes {
 struct UpsertWorkflowSearchAttributesEventAttributes {
   10: optional i64 (js.type = "Long") decisionTaskCompletedEventId
   20: optional SearchAttributes searchAttributes
+  30: optional list<string> removeSearchAttributes
 }
 
 struct StartChildWorkflowExecutionInitiatedEventAttributes {
@@ -1000,6 +1021,15 @@ struct ClusterReplicationConfiguration {
 struct DomainReplicationConfiguration {
  10: optional string activeClusterName
  20: optional list<ClusterReplicationConfiguration> clusters
+ 30: optional DomainReplicationFilter replicationFilter
+}
+
+// DomainReplicationFilter restricts what of a global domain is replicated to the other clusters
+struct DomainReplicationFilter {
+  // when not empty, only workflows of these types are replicated
+  10: optional list<string> workflowTypes
+  // when true, search attributes and memo are removed from the replicated history
+  20: optional bool skipVisibilityPayloads
 }
 
 struct RegisterDomainRequest {
@@ -1018,6 +1048,7 @@ struct RegisterDomainRequest {
   140: optional string historyArchivalURI
   150: optional ArchivalStatus visibilityArchivalStatus
   160: optional string visibilityArchivalURI
+  170: optional DomainReplicationFilter replicationFilter
 }
 
 struct ListDomainsRequest {
@@ -1035,12 +1066,39 @@ struct DescribeDomainRequest {
   20: optional string uuid
 }
 
+struct DomainFailoverInfo {
+  10: optional DomainFailoverState state
+  20: optional string sourceCluster
+  30: optional string targetCluster
+  40: optional i64 (js.type = "Long") startTimestamp
+  50: optional i64 (js.type = "Long") expireTimestamp
+  60: optional i64 (js.type = "Long") endTimestamp
+  70: optional string reason
+}
+
+struct DomainFailoverRecord {
+  10: optional i64 (js.type = "Long") failoverVersion
+  20: optional string fromCluster
+  30: optional string toCluster
+  40: optional i64 (js.type = "Long") timestamp
+  // not set for failovers received through domain replication
+  50: optional bool graceful
+  60: optional string reason
+}
+
+struct DomainFailoverHistory {
+  10: optional list<DomainFailoverRecord> records
+}
+
 struct DescribeDomainResponse {
   10: optional DomainInfo domainInfo
   20: optional DomainConfiguration configuration
   30: optional DomainReplicationConfiguration replicationConfiguration
   40: optional i64 (js.type = "Long") failoverVersion
   50: optional bool isGlobalDomain
+  60: optional DomainFailoverInfo failoverInfo
+  // latest failovers of the domain, most recent first
+  70: optional list<DomainFailoverRecord> failoverHistory
 }
 
 struct UpdateDomainRequest {
@@ -1050,6 +1108,12 @@ struct UpdateDomainRequest {
  40: optional DomainReplicationConfiguration replicationConfiguration
  50: optional string securityToken
  60: optional string deleteBadBinary
+ // when set together with replicationConfiguration.activeClusterName, the domain is drained before the failover
+ 70: optional i32 failoverTimeoutInSeconds
+ // recorded in the failover history of the domain when the active cluster is changed
+ 80: optional string failoverReason
+ // when true, the local domain is promoted to a global domain
+ 90: optional bool promoteToGlobalDomain
 }
 
 struct UpdateDomainResponse {
@@ -1058,6 +1122,7 @@ struct UpdateDomainResponse {
   30: optional DomainReplicationConfiguration replicationConfiguration
   40: optional i64 (js.type = "Long") failoverVersion
   50: optional bool isGlobalDomain
+  60: optional DomainFailoverInfo failoverInfo
 }
 
 struct DeprecateDomainRequest {
@@ -1145,6 +1210,7 @@ struct PollForActivityTaskRequest {
   20: optional TaskList taskList
   30: optional string identity
   40: optional TaskListMetadata taskListMetadata
+  50: optional string affinityToken
 }
 
 struct PollForActivityTaskResponse {
@@ -1365,6 +1431,16 @@ struct CountWorkflowExecutionsRequest {
 
 struct CountWorkflowExecutionsResponse {
   10: optional i64 count
+  // counts per group of workflows, if the query has GROUP BY clause
+  20: optional list<CountWorkflowExecutionsGroup> groups
+  // number of workflows not counted in any group, because the number of groups exceeds the limit
+  30: optional i64 truncatedCount
+}
+
+struct CountWorkflowExecutionsGroup {
+  // JSON encoded values of the GROUP BY attributes, keyed by attribute name
+  10: optional map<string, binary> groupValues
+  20: optional i64 count
 }
 
 struct GetSearchAttributesResponse {
@@ -1464,6 +1540,17 @@ struct DescribeTaskListRequest {
 struct DescribeTaskListResponse {
   10: optional list<PollerInfo> pollers
   20: optional TaskListStatus taskListStatus
+  // pollers which polled this tasklist recently but have since stopped polling
+  30: optional list<PollerInfo> inactivePollers
+  // server side dispatch limits configured per activity type, only set for activity tasklists
+  40: optional list<ActivityTypeLimitInfo> activityTypeLimits
+}
+
+struct ActivityTypeLimitInfo {
+  10: optional string activityType
+  20: optional double ratePerSecond
+  30: optional i32 maxOutstanding
+  40: optional i32 outstanding
 }
 
 struct TaskListStatus {
@@ -1525,6 +1612,19 @@ struct PollerInfo {
   10: optional i64 (js.type = "Long")  lastAccessTime
   20: optional string identity
   30: optional double ratePerSecond
+  40: optional string clientImpl
+  50: optional string clientFeatureVersion
+  60: optional string clientLibraryVersion
+  // number of polls from this identity currently waiting on the tasklist
+  70: optional i32 outstandingPollCount
+  80: optional i64 (js.type = "Long") tasksDispatched
+  90: optional i64 (js.type = "Long") tasksCompleted
+  100: optional i64 (js.type = "Long") tasksFailed
+  // decision tasks dispatched from the sticky tasklist of this identity
+  110: optional i64 (js.type = "Long") stickyCacheHits
+  // decision tasks of workflows with previous decisions dispatched from the normal tasklist,
+  // i.e. the worker had to replay the full history
+  120: optional i64 (js.type = "Long") stickyCacheMisses
 }
 
 struct RetryPolicy {
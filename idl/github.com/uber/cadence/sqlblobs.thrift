@@ -57,6 +57,12 @@ struct DomainInfo {
   44: optional string historyArchivalURI
   46: optional i16 visibilityArchivalStatus
   48: optional string visibilityArchivalURI
+  50: optional binary failoverInfo
+  52: optional string failoverInfoEncoding
+  54: optional binary failoverHistory
+  56: optional string failoverHistoryEncoding
+  58: optional binary replicationFilter
+  60: optional string replicationFilterEncoding
 }
 
 struct HistoryTreeInfo {
@@ -165,6 +171,7 @@ struct ActivityInfo {
   66: optional string retryLastFailureReason
   68: optional string retryLastWorkerIdentity
   70: optional binary retryLastFailureDetails
+  72: optional string startedTaskList
 }
 
 struct ChildExecutionInfo {
@@ -214,6 +221,12 @@ struct TaskInfo {
   13: optional i64 (js.type = "Long") scheduleID
   14: optional i64 (js.type = "Long") expiryTimeNanos
   15: optional i64 (js.type = "Long") createdTimeNanos
+  16: optional string activityType
+  18: optional i64 (js.type = "Long") taskID
+}
+
+struct TaskSegmentInfo {
+  10: optional list<TaskInfo> tasks
 }
 
 struct TaskListInfo {
@@ -221,6 +234,8 @@ struct TaskListInfo {
   12: optional i64 (js.type = "Long") ackLevel
   14: optional i64 (js.type = "Long") expiryTimeNanos
   16: optional i64 (js.type = "Long") lastUpdatedNanos
+  18: optional bool draining
+  20: optional string drainTarget
 }
 
 struct TransferTaskInfo {
@@ -85,6 +85,17 @@ CREATE TABLE tasks (
   PRIMARY KEY (domain_id, task_list_name, task_type, task_id)
 );
 
+CREATE TABLE task_segments (
+  domain_id BINARY(16) NOT NULL,
+  task_list_name VARCHAR(255) NOT NULL,
+  task_type TINYINT NOT NULL, -- {Activity, Decision}
+  task_id BIGINT NOT NULL, -- id of the last task in the segment
+  --
+  data MEDIUMBLOB NOT NULL,
+  data_encoding VARCHAR(16) NOT NULL,
+  PRIMARY KEY (domain_id, task_list_name, task_type, task_id)
+);
+
 CREATE TABLE task_lists (
   shard_id INT NOT NULL,
   domain_id BINARY(16) NOT NULL,
@@ -25,6 +25,8 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
+	"sort"
 	"strconv"
 	"sync"
 	"sync/atomic"
@@ -39,15 +41,21 @@ import (
 	"github.com/uber/cadence/.gen/go/admin/adminserviceserver"
 	h "github.com/uber/cadence/.gen/go/history"
 	hist "github.com/uber/cadence/.gen/go/history"
+	m "github.com/uber/cadence/.gen/go/matching"
+	r "github.com/uber/cadence/.gen/go/replicator"
 	gen "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/client/history"
+	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/domain"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/quotas"
 	"github.com/uber/cadence/common/service"
 	"github.com/uber/cadence/common/service/dynamicconfig"
 	historyService "github.com/uber/cadence/service/history"
@@ -62,12 +70,15 @@ type (
 		numberOfHistoryShards int
 		service.Service
 		history       history.Client
+		matching      matching.Client
 		domainCache   cache.DomainCache
 		metricsClient metrics.Client
 		historyV2Mgr  persistence.HistoryManager
 		startWG       sync.WaitGroup
 		params        *service.BootstrapParams
 		config        *Config
+		// crossClusterRateLimiter limits the requests of the remote clusters to the domains
+		crossClusterRateLimiter quotas.Policy
 	}
 
 	getWorkflowRawHistoryV2Token struct {
@@ -80,6 +91,16 @@ type (
 		EndEventVersion   int64
 		PersistenceToken  []byte
 		VersionHistories  *gen.VersionHistories
+		WorkflowType      string
+	}
+
+	// searchAttributesRegistry is a copy of the search attributes registry in dynamic config.
+	// The registry of a domain is stored as overrides of the cluster registry.
+	searchAttributesRegistry struct {
+		// own is the registry written to dynamic config, the cluster registry or the overrides of the domain
+		own *definition.SearchAttributes
+		// cluster is the cluster registry the overrides apply to, nil for the cluster registry
+		cluster *definition.SearchAttributes
 	}
 )
 
@@ -100,6 +121,14 @@ func NewAdminHandler(
 		historyV2Mgr:          historyV2Mgr,
 		params:                params,
 		config:                config,
+		crossClusterRateLimiter: quotas.NewMultiStageRateLimiter(
+			func() float64 {
+				return float64(config.RPS())
+			},
+			func(domain string) float64 {
+				return float64(config.DomainRPS(domain))
+			},
+		),
 	}
 	// prevent us from trying to serve requests before handler's Start() is complete
 	handler.startWG.Add(1)
@@ -120,6 +149,11 @@ func (adh *AdminHandler) Start() error {
 	adh.domainCache.Start()
 
 	adh.history = adh.GetClientBean().GetHistoryClient()
+	matchingClient, err := adh.GetClientBean().GetMatchingClient(adh.domainCache.GetDomainName)
+	if err != nil {
+		return err
+	}
+	adh.matching = matchingClient
 	adh.metricsClient = adh.Service.GetMetricsClient()
 	adh.startWG.Done()
 	return nil
@@ -134,7 +168,7 @@ func (adh *AdminHandler) Stop() {
 	adh.domainCache.Stop()
 }
 
-// AddSearchAttribute add search attribute to whitelist
+// AddSearchAttribute add search attribute to whitelist, of the domain if domain is set
 func (adh *AdminHandler) AddSearchAttribute(ctx context.Context, request *admin.AddSearchAttributeRequest) error {
 	// validate request
 	if request == nil {
@@ -151,32 +185,37 @@ func (adh *AdminHandler) AddSearchAttribute(ctx context.Context, request *admin.
 	}
 
 	searchAttr := request.GetSearchAttribute()
-	currentValidAttr, _ := adh.params.DynamicConfig.GetMapValue(
-		dynamicconfig.ValidSearchAttributes, nil, definition.GetDefaultIndexedKeys())
+	filters := getSearchAttributesFilters(request.GetDomain())
+	registry := adh.getSearchAttributesRegistry(request.GetDomain())
+	effective := registry.effective()
+	statusUpdated := false
 	for k, v := range searchAttr {
 		if definition.IsSystemIndexedKey(k) {
 			return &gen.BadRequestError{Message: fmt.Sprintf("Key [%s] is reserved by system", k)}
 		}
-		if _, exist := currentValidAttr[k]; exist {
+		if _, exist := effective.Valid[k]; exist {
 			return &gen.BadRequestError{Message: fmt.Sprintf("Key [%s] is already whitelist", k)}
 		}
+		if _, exist := effective.Aliases[k]; exist {
+			return &gen.BadRequestError{Message: fmt.Sprintf("Key [%s] is already an alias", k)}
+		}
+		if len(convertIndexedValueTypeToESDataType(v)) == 0 {
+			return &gen.BadRequestError{Message: fmt.Sprintf("Unknown value type, %v", v)}
+		}
 
-		currentValidAttr[k] = int(v)
-	}
-
-	// update dynamic config
-	err := adh.params.DynamicConfig.UpdateValue(dynamicconfig.ValidSearchAttributes, currentValidAttr)
-	if err != nil {
-		return &gen.InternalServiceError{Message: fmt.Sprintf("Failed to update dynamic config, err: %v", err)}
+		registry.own.Valid[k] = int(v)
+		// a removed key can be added back
+		if _, exist := registry.own.Status[k]; exist {
+			delete(registry.own.Status, k)
+			statusUpdated = true
+		}
 	}
 
-	// update elasticsearch mapping, new added field will not be able to remove or update
+	// update elasticsearch mapping before dynamic config, so that keys conflicting with the type of
+	// their existing mapping are not whitelisted. New added field will not be able to remove or update
 	index := adh.params.ESConfig.GetVisibilityIndex()
 	for k, v := range searchAttr {
 		valueType := convertIndexedValueTypeToESDataType(v)
-		if len(valueType) == 0 {
-			return &gen.BadRequestError{Message: fmt.Sprintf("Unknown value type, %v", v)}
-		}
 		err := adh.params.ESClient.PutMapping(ctx, index, definition.Attr, k, valueType)
 		if elastic.IsNotFound(err) {
 			err = adh.params.ESClient.CreateIndex(ctx, index)
@@ -185,14 +224,153 @@ func (adh *AdminHandler) AddSearchAttribute(ctx context.Context, request *admin.
 			}
 			err = adh.params.ESClient.PutMapping(ctx, index, definition.Attr, k, valueType)
 		}
+		if isESBadRequest(err) {
+			return &gen.BadRequestError{Message: fmt.Sprintf("Key [%s] conflicts with the type of its existing mapping, err: %v", k, err)}
+		}
 		if err != nil {
 			return &gen.InternalServiceError{Message: fmt.Sprintf("Failed to update ES mapping, err: %v", err)}
 		}
 	}
 
+	// update dynamic config
+	err := adh.params.DynamicConfig.UpdateValueWithFilters(dynamicconfig.ValidSearchAttributes, filters, registry.own.Valid)
+	if err == nil && statusUpdated {
+		err = adh.params.DynamicConfig.UpdateValueWithFilters(dynamicconfig.SearchAttributeStatus, filters, registry.own.Status)
+	}
+	if err != nil {
+		return &gen.InternalServiceError{Message: fmt.Sprintf("Failed to update dynamic config, err: %v", err)}
+	}
+	return nil
+}
+
+// UpdateSearchAttributes deprecates or removes search attributes, and adds or removes their aliases,
+// of the domain if domain is set. Removed keys are only removed from the whitelist,
+// ES mapping of the keys is kept as fields can not be removed from the mapping
+func (adh *AdminHandler) UpdateSearchAttributes(ctx context.Context, request *admin.UpdateSearchAttributesRequest) (retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	scope := metrics.AdminUpdateSearchAttributesScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+	if err := checkPermission(adh.config, request.SecurityToken); err != nil {
+		return adh.error(errNoPermission, scope)
+	}
+	if len(request.DeprecateKeys) == 0 && len(request.RemoveKeys) == 0 &&
+		len(request.AddAliases) == 0 && len(request.RemoveAliases) == 0 {
+		return adh.error(&gen.BadRequestError{Message: "Updates are not provided"}, scope)
+	}
+
+	filters := getSearchAttributesFilters(request.GetDomain())
+	registry := adh.getSearchAttributesRegistry(request.GetDomain())
+	validUpdated, aliasesUpdated := false, false
+
+	for _, k := range request.DeprecateKeys {
+		if err := registry.validateCustomKey(k); err != nil {
+			return adh.error(err, scope)
+		}
+		registry.own.Status[k] = definition.SearchAttributeStatusDeprecated
+	}
+	for _, k := range request.RemoveKeys {
+		if err := registry.validateCustomKey(k); err != nil {
+			return adh.error(err, scope)
+		}
+		for alias, target := range registry.effective().Aliases {
+			if target == k {
+				registry.removeAlias(alias)
+				aliasesUpdated = true
+			}
+		}
+		delete(registry.own.Valid, k)
+		registry.own.Status[k] = definition.SearchAttributeStatusRemoved
+		validUpdated = true
+	}
+	for _, alias := range request.RemoveAliases {
+		if _, exist := registry.effective().Aliases[alias]; !exist {
+			return adh.error(&gen.BadRequestError{Message: fmt.Sprintf("Alias [%s] does not exist", alias)}, scope)
+		}
+		registry.removeAlias(alias)
+		aliasesUpdated = true
+	}
+	for alias, k := range request.AddAliases {
+		if definition.IsSystemIndexedKey(alias) {
+			return adh.error(&gen.BadRequestError{Message: fmt.Sprintf("Key [%s] is reserved by system", alias)}, scope)
+		}
+		effective := registry.effective()
+		if _, exist := effective.Valid[alias]; exist {
+			return adh.error(&gen.BadRequestError{Message: fmt.Sprintf("Alias [%s] is already whitelist as key", alias)}, scope)
+		}
+		if _, exist := effective.Aliases[alias]; exist {
+			return adh.error(&gen.BadRequestError{Message: fmt.Sprintf("Alias [%s] already exists", alias)}, scope)
+		}
+		if err := registry.validateCustomKey(k); err != nil {
+			return adh.error(err, scope)
+		}
+		registry.own.Aliases[alias] = k
+		aliasesUpdated = true
+	}
+
+	// update dynamic config
+	var err error
+	if validUpdated {
+		err = adh.params.DynamicConfig.UpdateValueWithFilters(dynamicconfig.ValidSearchAttributes, filters, registry.own.Valid)
+	}
+	if err == nil && (len(request.DeprecateKeys) != 0 || len(request.RemoveKeys) != 0) {
+		err = adh.params.DynamicConfig.UpdateValueWithFilters(dynamicconfig.SearchAttributeStatus, filters, registry.own.Status)
+	}
+	if err == nil && aliasesUpdated {
+		err = adh.params.DynamicConfig.UpdateValueWithFilters(dynamicconfig.SearchAttributeAliases, filters, registry.own.Aliases)
+	}
+	if err != nil {
+		return adh.error(&gen.InternalServiceError{Message: fmt.Sprintf("Failed to update dynamic config, err: %v", err)}, scope)
+	}
 	return nil
 }
 
+// DescribeSearchAttributes returns the search attributes registry, of the domain if domain is set
+func (adh *AdminHandler) DescribeSearchAttributes(ctx context.Context, request *admin.DescribeSearchAttributesRequest) (resp *admin.DescribeSearchAttributesResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	scope := metrics.AdminDescribeSearchAttributesScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+
+	registry := adh.getSearchAttributesRegistry(request.GetDomain()).effective()
+	resp = &admin.DescribeSearchAttributesResponse{
+		Keys:    make(map[string]gen.IndexedValueType, len(registry.Valid)),
+		Aliases: make(map[string]string, len(registry.Aliases)),
+	}
+	for k, v := range registry.Valid {
+		valueType, ok := registry.GetValueType(k)
+		if !ok {
+			adh.GetLogger().Warn("Unknown search attribute value type", tag.Key(k), tag.Value(v))
+			continue
+		}
+		resp.Keys[k] = valueType
+	}
+	for k, status := range registry.Status {
+		switch status {
+		case definition.SearchAttributeStatusDeprecated:
+			resp.DeprecatedKeys = append(resp.DeprecatedKeys, k)
+		case definition.SearchAttributeStatusRemoved:
+			resp.RemovedKeys = append(resp.RemovedKeys, k)
+		}
+	}
+	sort.Strings(resp.DeprecatedKeys)
+	sort.Strings(resp.RemovedKeys)
+	for alias, k := range registry.Aliases {
+		if key, ok := k.(string); ok {
+			resp.Aliases[alias] = key
+		}
+	}
+	return resp, nil
+}
+
 func (adh *AdminHandler) validateConfigForAdvanceVisibility() error {
 	if adh.params.ESConfig == nil || adh.params.ESClient == nil {
 		return errors.New("ES related config not found")
@@ -200,6 +378,71 @@ func (adh *AdminHandler) validateConfigForAdvanceVisibility() error {
 	return nil
 }
 
+// getSearchAttributesRegistry returns a copy of the search attributes registry in dynamic config,
+// of the domain if domain is set
+func (adh *AdminHandler) getSearchAttributesRegistry(domain string) *searchAttributesRegistry {
+	cluster := adh.readSearchAttributesRegistry(nil)
+	if domain == "" {
+		return &searchAttributesRegistry{own: cluster}
+	}
+	// dynamic config returns the cluster registry for the domains without overrides
+	overrides := cluster.GetOverrides(adh.readSearchAttributesRegistry(getSearchAttributesFilters(domain)))
+	return &searchAttributesRegistry{own: overrides, cluster: cluster}
+}
+
+func (adh *AdminHandler) readSearchAttributesRegistry(filters map[dynamicconfig.Filter]interface{}) *definition.SearchAttributes {
+	valid, _ := adh.params.DynamicConfig.GetMapValue(
+		dynamicconfig.ValidSearchAttributes, filters, definition.GetDefaultIndexedKeys())
+	status, _ := adh.params.DynamicConfig.GetMapValue(
+		dynamicconfig.SearchAttributeStatus, filters, map[string]interface{}{})
+	aliases, _ := adh.params.DynamicConfig.GetMapValue(
+		dynamicconfig.SearchAttributeAliases, filters, map[string]interface{}{})
+	return definition.NewSearchAttributes(valid, status, aliases)
+}
+
+// effective returns the registry with the overrides applied to the cluster registry
+func (r *searchAttributesRegistry) effective() *definition.SearchAttributes {
+	if r.cluster == nil {
+		return r.own
+	}
+	return r.cluster.Override(r.own)
+}
+
+// removeAlias removes the alias from the registry, for a domain by overriding the alias of the cluster registry
+func (r *searchAttributesRegistry) removeAlias(alias string) {
+	if r.cluster == nil {
+		delete(r.own.Aliases, alias)
+		return
+	}
+	r.cluster.RemoveAlias(r.own, alias)
+}
+
+// validateCustomKey checks the key is a whitelisted key which is not reserved by system
+func (r *searchAttributesRegistry) validateCustomKey(key string) error {
+	if definition.IsSystemIndexedKey(key) {
+		return &gen.BadRequestError{Message: fmt.Sprintf("Key [%s] is reserved by system", key)}
+	}
+	if _, exist := r.effective().Valid[key]; !exist {
+		return &gen.BadRequestError{Message: fmt.Sprintf("Key [%s] is not whitelist", key)}
+	}
+	return nil
+}
+
+// isESBadRequest returns true if ES rejected the request, e.g. the mapping conflicts with the existing mapping
+func isESBadRequest(err error) bool {
+	esErr, ok := err.(*elastic.Error)
+	return ok && esErr.Status == http.StatusBadRequest
+}
+
+// getSearchAttributesFilters returns the dynamic config filters of the search attributes registry of the domain,
+// or nil for the cluster registry
+func getSearchAttributesFilters(domain string) map[dynamicconfig.Filter]interface{} {
+	if domain == "" {
+		return nil
+	}
+	return map[dynamicconfig.Filter]interface{}{dynamicconfig.DomainName: domain}
+}
+
 // DescribeWorkflowExecution returns information about the specified workflow execution.
 func (adh *AdminHandler) DescribeWorkflowExecution(ctx context.Context, request *admin.DescribeWorkflowExecutionRequest) (resp *admin.DescribeWorkflowExecutionResponse, retError error) {
 	defer log.CapturePanic(adh.GetLogger(), &retError)
@@ -279,6 +522,496 @@ func (adh *AdminHandler) DescribeHistoryHost(ctx context.Context, request *gen.D
 	return resp, err
 }
 
+// DrainTaskList starts or stops draining a task list, or returns its drain state when drain is not set.
+// New tasks of a draining task list are forwarded to the target task list, or rejected when there is none
+func (adh *AdminHandler) DrainTaskList(ctx context.Context, request *admin.DrainTaskListRequest) (resp *admin.DrainTaskListResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	scope := metrics.AdminDrainTaskListScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetDomain() == "" {
+		return nil, adh.error(errDomainNotSet, scope)
+	}
+	if request.TaskList == nil || request.TaskList.GetName() == "" {
+		return nil, adh.error(errTaskListNotSet, scope)
+	}
+	if request.TaskListType == nil {
+		return nil, adh.error(errTaskListTypeNotSet, scope)
+	}
+	if request.GetTargetTaskList() == request.TaskList.GetName() {
+		return nil, adh.error(errInvalidDrainTarget, scope)
+	}
+
+	domainID, err := adh.domainCache.GetDomainID(request.GetDomain())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	matchingResp, err := adh.matching.DrainTaskList(ctx, &m.DrainTaskListRequest{
+		DomainUUID:     common.StringPtr(domainID),
+		TaskList:       &gen.TaskList{Name: common.StringPtr(request.TaskList.GetName())},
+		TaskListType:   request.TaskListType,
+		Drain:          request.Drain,
+		TargetTaskList: request.TargetTaskList,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &admin.DrainTaskListResponse{
+		Draining:         matchingResp.Draining,
+		TargetTaskList:   matchingResp.TargetTaskList,
+		BacklogCountHint: matchingResp.BacklogCountHint,
+	}, nil
+}
+
+// GetDLQReplicationMessages returns the full replication tasks for the given replication DLQ task infos
+func (adh *AdminHandler) GetDLQReplicationMessages(
+	ctx context.Context,
+	request *r.GetDLQReplicationMessagesRequest,
+) (resp *r.GetDLQReplicationMessagesResponse, retError error) {
+
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	scope := metrics.AdminGetDLQReplicationMessagesScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if len(request.GetTaskInfos()) == 0 {
+		return nil, adh.error(errTaskInfosNotSet, scope)
+	}
+
+	resp, err := adh.history.GetDLQReplicationMessages(ctx, request)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
+// ReadDLQMessages reads messages from the replication DLQ of a shard
+func (adh *AdminHandler) ReadDLQMessages(
+	ctx context.Context,
+	request *r.ReadDLQMessagesRequest,
+) (resp *r.ReadDLQMessagesResponse, retError error) {
+
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	scope := metrics.AdminReadDLQMessagesScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if err := adh.validateDLQRequest(request.Type, request.ShardID, request.GetSourceCluster()); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	if request.GetMaximumPageSize() <= 0 {
+		return nil, adh.error(errInvalidDLQPageSize, scope)
+	}
+	if request.InclusiveEndMessageID == nil {
+		request.InclusiveEndMessageID = common.Int64Ptr(common.EndMessageID)
+	}
+
+	resp, err := adh.history.ReadDLQMessages(ctx, request)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
+// PurgeDLQMessages deletes messages from the replication DLQ of a shard
+func (adh *AdminHandler) PurgeDLQMessages(
+	ctx context.Context,
+	request *r.PurgeDLQMessagesRequest,
+) (retError error) {
+
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	scope := metrics.AdminPurgeDLQMessagesScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+	if err := adh.validateDLQRequest(request.Type, request.ShardID, request.GetSourceCluster()); err != nil {
+		return adh.error(err, scope)
+	}
+	if request.InclusiveEndMessageID == nil {
+		request.InclusiveEndMessageID = common.Int64Ptr(common.EndMessageID)
+	}
+
+	if err := adh.history.PurgeDLQMessages(ctx, request); err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+// MergeDLQMessages re-applies messages from the replication DLQ of a shard and removes them from the DLQ
+func (adh *AdminHandler) MergeDLQMessages(
+	ctx context.Context,
+	request *r.MergeDLQMessagesRequest,
+) (resp *r.MergeDLQMessagesResponse, retError error) {
+
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	scope := metrics.AdminMergeDLQMessagesScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if err := adh.validateDLQRequest(request.Type, request.ShardID, request.GetSourceCluster()); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	if request.GetMaximumPageSize() <= 0 {
+		return nil, adh.error(errInvalidDLQPageSize, scope)
+	}
+	if request.InclusiveEndMessageID == nil {
+		request.InclusiveEndMessageID = common.Int64Ptr(common.EndMessageID)
+	}
+
+	resp, err := adh.history.MergeDLQMessages(ctx, request)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
+// DescribeReplication returns the replication lag and health of the shards for the given source cluster
+func (adh *AdminHandler) DescribeReplication(
+	ctx context.Context,
+	request *r.DescribeReplicationRequest,
+) (resp *r.DescribeReplicationResponse, retError error) {
+
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	scope := metrics.AdminDescribeReplicationScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetSourceCluster() == "" {
+		return nil, adh.error(errSourceClusterNotSet, scope)
+	}
+	if request.GetDomain() != "" {
+		domainID, err := adh.domainCache.GetDomainID(request.GetDomain())
+		if err != nil {
+			return nil, adh.error(err, scope)
+		}
+		request.DomainID = common.StringPtr(domainID)
+	}
+	if len(request.ShardIDs) == 0 {
+		for shardID := 0; shardID < adh.numberOfHistoryShards; shardID++ {
+			request.ShardIDs = append(request.ShardIDs, int32(shardID))
+		}
+	}
+
+	resp, err := adh.history.DescribeReplication(ctx, request)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	described := make(map[int32]struct{}, len(resp.Shards))
+	for _, shard := range resp.Shards {
+		described[shard.GetShardID()] = struct{}{}
+	}
+	for _, shardID := range request.ShardIDs {
+		if _, ok := described[shardID]; !ok {
+			resp.MissingShardIDs = append(resp.MissingShardIDs, shardID)
+		}
+	}
+	sort.Slice(resp.Shards, func(i, j int) bool {
+		return resp.Shards[i].GetShardID() < resp.Shards[j].GetShardID()
+	})
+	return resp, nil
+}
+
+// StartChildWorkflowExecution starts a child workflow on behalf of a parent workflow running in another cluster,
+// then schedules the first decision task of the child workflow
+func (adh *AdminHandler) StartChildWorkflowExecution(
+	ctx context.Context,
+	request *admin.StartChildWorkflowExecutionRequest,
+) (resp *gen.StartWorkflowExecutionResponse, retError error) {
+
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	scope := metrics.AdminStartChildWorkflowExecutionScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil || request.StartRequest == nil || request.StartRequest.StartRequest == nil ||
+		request.StartRequest.ParentExecutionInfo == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if err := checkPermission(adh.config, request.SecurityToken); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	startRequest := request.StartRequest
+	domainEntry, err := adh.getCrossClusterDomainEntry(startRequest.DomainUUID)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	if err := adh.validateStartChildWorkflowExecutionRequest(domainEntry, startRequest, scope); err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	resp, err = adh.history.StartWorkflowExecution(ctx, startRequest)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	// child workflow started by the history service does not have its first decision scheduled,
+	// which is done by the parent after the start is recorded in the same cluster
+	err = adh.history.ScheduleDecisionTask(ctx, &h.ScheduleDecisionTaskRequest{
+		DomainUUID: startRequest.DomainUUID,
+		WorkflowExecution: &gen.WorkflowExecution{
+			WorkflowId: startRequest.StartRequest.WorkflowId,
+			RunId:      resp.RunId,
+		},
+		IsFirstDecision: common.BoolPtr(true),
+	})
+	if err != nil {
+		if _, ok := err.(*gen.EntityNotExistsError); !ok {
+			return nil, adh.error(err, scope)
+		}
+		// child workflow is already timed out or terminated
+	}
+	return resp, nil
+}
+
+// SignalExternalWorkflowExecution signals a workflow on behalf of a workflow running in another cluster
+func (adh *AdminHandler) SignalExternalWorkflowExecution(
+	ctx context.Context,
+	request *admin.SignalExternalWorkflowExecutionRequest,
+) (retError error) {
+
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	scope := metrics.AdminSignalExternalWorkflowExecutionScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil || request.SignalRequest == nil || request.SignalRequest.SignalRequest == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+	if err := checkPermission(adh.config, request.SecurityToken); err != nil {
+		return adh.error(err, scope)
+	}
+	signalRequest := request.SignalRequest
+	domainEntry, err := adh.getCrossClusterDomainEntry(signalRequest.DomainUUID)
+	if err != nil {
+		return adh.error(err, scope)
+	}
+	if err := adh.validateSignalExternalWorkflowExecutionRequest(domainEntry, signalRequest, scope); err != nil {
+		return adh.error(err, scope)
+	}
+
+	if err := adh.history.SignalWorkflowExecution(ctx, signalRequest); err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+// RecordChildExecutionCompleted records the completion of a child workflow running in another cluster
+// to its parent workflow
+func (adh *AdminHandler) RecordChildExecutionCompleted(
+	ctx context.Context,
+	request *admin.RecordChildExecutionCompletedRequest,
+) (retError error) {
+
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	scope := metrics.AdminRecordChildExecutionCompletedScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil || request.CompletionRequest == nil || request.CompletionRequest.CompletionEvent == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+	if err := checkPermission(adh.config, request.SecurityToken); err != nil {
+		return adh.error(err, scope)
+	}
+	completionRequest := request.CompletionRequest
+	if _, err := adh.getCrossClusterDomainEntry(completionRequest.DomainUUID); err != nil {
+		return adh.error(err, scope)
+	}
+	if err := validateExecution(completionRequest.WorkflowExecution); err != nil {
+		return adh.error(err, scope)
+	}
+	if err := validateExecution(completionRequest.CompletedExecution); err != nil {
+		return adh.error(err, scope)
+	}
+
+	if err := adh.history.RecordChildExecutionCompleted(ctx, completionRequest); err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+// RemoveSignalMutableState removes the request ID of a signal sent by a workflow running in another cluster
+func (adh *AdminHandler) RemoveSignalMutableState(
+	ctx context.Context,
+	request *admin.RemoveSignalMutableStateRequest,
+) (retError error) {
+
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	scope := metrics.AdminRemoveSignalMutableStateScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil || request.RemoveRequest == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+	if err := checkPermission(adh.config, request.SecurityToken); err != nil {
+		return adh.error(err, scope)
+	}
+	removeRequest := request.RemoveRequest
+	if _, err := adh.getCrossClusterDomainEntry(removeRequest.DomainUUID); err != nil {
+		return adh.error(err, scope)
+	}
+	if err := validateExecution(removeRequest.WorkflowExecution); err != nil {
+		return adh.error(err, scope)
+	}
+	if removeRequest.GetRequestId() == "" {
+		return adh.error(errRequestIDNotSet, scope)
+	}
+
+	if err := adh.history.RemoveSignalMutableState(ctx, removeRequest); err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+// getCrossClusterDomainEntry returns the domain targeted by a cross cluster request,
+// the requests are rate limited by the same limits as the frontend APIs of the domain
+func (adh *AdminHandler) getCrossClusterDomainEntry(
+	domainUUID *string,
+) (*cache.DomainCacheEntry, error) {
+
+	if domainUUID == nil || *domainUUID == "" {
+		return nil, errDomainNotSet
+	}
+	domainEntry, err := adh.domainCache.GetDomainByID(*domainUUID)
+	if err != nil {
+		return nil, err
+	}
+	if !adh.crossClusterRateLimiter.Allow(quotas.Info{Domain: domainEntry.GetInfo().Name}) {
+		return nil, createServiceBusyError()
+	}
+	return domainEntry, nil
+}
+
+func (adh *AdminHandler) validateStartChildWorkflowExecutionRequest(
+	domainEntry *cache.DomainCacheEntry,
+	request *h.StartWorkflowExecutionRequest,
+	scope int,
+) error {
+
+	startRequest := request.StartRequest
+	maxIDLength := adh.config.MaxIDLengthLimit()
+	switch {
+	case startRequest.GetWorkflowId() == "":
+		return errWorkflowIDNotSet
+	case len(startRequest.GetWorkflowId()) > maxIDLength:
+		return errWorkflowIDTooLong
+	case startRequest.WorkflowType == nil || startRequest.WorkflowType.GetName() == "":
+		return errWorkflowTypeNotSet
+	case len(startRequest.WorkflowType.GetName()) > maxIDLength:
+		return errWorkflowTypeTooLong
+	case startRequest.TaskList == nil || startRequest.TaskList.GetName() == "":
+		return errTaskListNotSet
+	case len(startRequest.TaskList.GetName()) > maxIDLength:
+		return errTaskListTooLong
+	case startRequest.GetExecutionStartToCloseTimeoutSeconds() <= 0:
+		return errInvalidExecutionStartToCloseTimeoutSeconds
+	case startRequest.GetTaskStartToCloseTimeoutSeconds() <= 0:
+		return errInvalidTaskStartToCloseTimeoutSeconds
+	case startRequest.GetRequestId() == "":
+		return errRequestIDNotSet
+	case len(startRequest.GetRequestId()) > maxIDLength:
+		return errRequestIDTooLong
+	}
+	if err := validateExecution(request.ParentExecutionInfo.Execution); err != nil {
+		return err
+	}
+	if err := common.ValidateRetryPolicy(startRequest.RetryPolicy); err != nil {
+		return err
+	}
+	if err := backoff.ValidateSchedule(startRequest.GetCronSchedule()); err != nil {
+		return err
+	}
+
+	domainName := domainEntry.GetInfo().Name
+	actualSize := len(startRequest.Input)
+	if startRequest.Memo != nil {
+		actualSize += common.GetSizeOfMapStringToByteArray(startRequest.Memo.GetFields())
+	}
+	return common.CheckEventBlobSizeLimit(
+		actualSize,
+		adh.config.BlobSizeLimitWarn(domainName),
+		adh.config.BlobSizeLimitError(domainName),
+		domainEntry.GetInfo().ID,
+		startRequest.GetWorkflowId(),
+		"",
+		adh.metricsClient.Scope(scope).Tagged(metrics.DomainTag(domainName)),
+		adh.GetThrottledLogger(),
+	)
+}
+
+func (adh *AdminHandler) validateSignalExternalWorkflowExecutionRequest(
+	domainEntry *cache.DomainCacheEntry,
+	request *h.SignalWorkflowExecutionRequest,
+	scope int,
+) error {
+
+	signalRequest := request.SignalRequest
+	if err := validateExecution(signalRequest.WorkflowExecution); err != nil {
+		return err
+	}
+	maxIDLength := adh.config.MaxIDLengthLimit()
+	switch {
+	case signalRequest.GetSignalName() == "":
+		return &gen.BadRequestError{Message: "SignalName is not set on request."}
+	case len(signalRequest.GetSignalName()) > maxIDLength:
+		return errSignalNameTooLong
+	case len(signalRequest.GetRequestId()) > maxIDLength:
+		return errRequestIDTooLong
+	}
+
+	domainName := domainEntry.GetInfo().Name
+	return common.CheckEventBlobSizeLimit(
+		len(signalRequest.Input),
+		adh.config.BlobSizeLimitWarn(domainName),
+		adh.config.BlobSizeLimitError(domainName),
+		domainEntry.GetInfo().ID,
+		signalRequest.GetWorkflowExecution().GetWorkflowId(),
+		signalRequest.GetWorkflowExecution().GetRunId(),
+		adh.metricsClient.Scope(scope).Tagged(metrics.DomainTag(domainName)),
+		adh.GetThrottledLogger(),
+	)
+}
+
+func (adh *AdminHandler) validateDLQRequest(
+	dlqType *r.DLQType,
+	shardID *int32,
+	sourceCluster string,
+) error {
+
+	if dlqType == nil {
+		return errDLQTypeNotSet
+	}
+	if shardID == nil {
+		return errShardIDNotSet
+	}
+	if sourceCluster == "" {
+		return errSourceClusterNotSet
+	}
+	return nil
+}
+
 // GetWorkflowExecutionRawHistory - retrieves the history of workflow execution
 func (adh *AdminHandler) GetWorkflowExecutionRawHistory(
 	ctx context.Context,
@@ -483,6 +1216,7 @@ func (adh *AdminHandler) GetWorkflowExecutionRawHistoryV2(
 		}
 
 		pageToken = adh.generatePaginationToken(request, versionHistories)
+		pageToken.WorkflowType = response.WorkflowType.GetName()
 	} else {
 		pageToken, err = deserializeRawHistoryToken(request.NextPageToken)
 		if err != nil {
@@ -508,6 +1242,18 @@ func (adh *AdminHandler) GetWorkflowExecutionRawHistoryV2(
 		return nil, adh.error(err, scope)
 	}
 
+	// the history is re-sent to remote clusters, so the domain replication filter applies to it as well
+	domainEntry, err := adh.domainCache.GetDomainByID(domainID)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	filter := domain.NewReplicationFilter(domainEntry.GetReplicationConfig().Filter, adh.GetPayloadSerializer())
+	if !filter.ShouldReplicate(pageToken.WorkflowType) {
+		return nil, adh.error(&gen.EntityNotExistsError{
+			Message: "Workflow execution is not replicated by the domain replication filter.",
+		}, scope)
+	}
+
 	if pageToken.StartEventID+1 == pageToken.EndEventID {
 		// API is exclusive-exclusive. Return empty response here.
 		return &admin.GetWorkflowExecutionRawHistoryV2Response{
@@ -555,7 +1301,16 @@ func (adh *AdminHandler) GetWorkflowExecutionRawHistoryV2(
 	rawBlobs := rawHistoryResponse.HistoryEventBlobs
 	blobs := []*gen.DataBlob{}
 	for _, blob := range rawBlobs {
-		blobs = append(blobs, blob.ToThrift())
+		// events written before the domain is promoted to a global domain carry the empty version
+		stampedBlob, err := domain.StampEmptyEventVersions(blob.ToThrift(), targetVersionHistory, adh.GetPayloadSerializer())
+		if err != nil {
+			return nil, adh.error(err, scope)
+		}
+		filteredBlob, err := filter.ApplyToBlob(stampedBlob)
+		if err != nil {
+			return nil, adh.error(err, scope)
+		}
+		blobs = append(blobs, filteredBlob)
 	}
 
 	result := &admin.GetWorkflowExecutionRawHistoryV2Response{
@@ -759,7 +1514,7 @@ func convertIndexedValueTypeToESDataType(valueType gen.IndexedValueType) string
 	switch valueType {
 	case gen.IndexedValueTypeString:
 		return "text"
-	case gen.IndexedValueTypeKeyword:
+	case gen.IndexedValueTypeKeyword, gen.IndexedValueTypeKeywordList:
 		return "keyword"
 	case gen.IndexedValueTypeInt:
 		return "long"
@@ -767,7 +1522,7 @@ func convertIndexedValueTypeToESDataType(valueType gen.IndexedValueType) string
 		return "double"
 	case gen.IndexedValueTypeBool:
 		return "boolean"
-	case gen.IndexedValueTypeDatetime:
+	case gen.IndexedValueTypeDatetime, gen.IndexedValueTypeDatetimeList:
 		return "date"
 	default:
 		return ""
@@ -24,6 +24,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
 	"testing"
 
 	"github.com/uber/cadence/common/definition"
@@ -31,6 +32,7 @@ import (
 	"github.com/uber/cadence/common/service/dynamicconfig"
 
 	"github.com/golang/mock/gomock"
+	"github.com/olivere/elastic"
 	"github.com/pborman/uuid"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -40,6 +42,9 @@ import (
 	"github.com/uber/cadence/.gen/go/admin"
 	"github.com/uber/cadence/.gen/go/history"
 	"github.com/uber/cadence/.gen/go/history/historyservicetest"
+	"github.com/uber/cadence/.gen/go/matching"
+	"github.com/uber/cadence/.gen/go/matching/matchingservicetest"
+	"github.com/uber/cadence/.gen/go/replicator"
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/client"
 	"github.com/uber/cadence/common"
@@ -59,10 +64,11 @@ type (
 		suite.Suite
 		*require.Assertions
 
-		controller        *gomock.Controller
-		mockHistoryClient *historyservicetest.MockClient
-		mockDomainCache   *cache.MockDomainCache
-		mockClientBean    *client.MockBean
+		controller         *gomock.Controller
+		mockHistoryClient  *historyservicetest.MockClient
+		mockMatchingClient *matchingservicetest.MockClient
+		mockDomainCache    *cache.MockDomainCache
+		mockClientBean     *client.MockBean
 
 		logger                 log.Logger
 		domainName             string
@@ -87,11 +93,13 @@ func (s *adminHandlerSuite) SetupTest() {
 
 	s.controller = gomock.NewController(s.T())
 	s.mockHistoryClient = historyservicetest.NewMockClient(s.controller)
+	s.mockMatchingClient = matchingservicetest.NewMockClient(s.controller)
 	s.mockDomainCache = cache.NewMockDomainCache(s.controller)
 	s.mockClientBean = client.NewMockBean(s.controller)
 	s.mockDomainCache.EXPECT().Start().AnyTimes()
 	s.mockDomainCache.EXPECT().Stop().AnyTimes()
 	s.mockClientBean.EXPECT().GetHistoryClient().Return(s.mockHistoryClient).AnyTimes()
+	s.mockClientBean.EXPECT().GetMatchingClient(gomock.Any()).Return(s.mockMatchingClient, nil).AnyTimes()
 
 	s.logger = loggerimpl.NewDevelopmentForTest(s.Suite)
 	s.domainName = "some random domain name"
@@ -104,11 +112,13 @@ func (s *adminHandlerSuite) SetupTest() {
 	s.mockClusterMetadata.On("IsGlobalDomainEnabled").Return(true)
 	metricsClient := metrics.NewClient(tally.NoopScope, metrics.Frontend)
 
-	s.service = service.NewTestService(s.mockClusterMetadata, nil, metricsClient, s.mockClientBean, nil, nil, nil)
+	s.service = service.NewTestService(s.mockClusterMetadata, nil, metricsClient, s.mockClientBean, nil, nil, persistence.NewPayloadSerializer())
 	s.mockHistoryV2Mgr = &mocks.HistoryV2Manager{}
 	params := &service.BootstrapParams{}
 	config := &Config{
 		EnableAdminProtection: dynamicconfig.GetBoolPropertyFn(false),
+		RPS:                   dynamicconfig.GetIntPropertyFn(1200),
+		DomainRPS:             dynamicconfig.GetIntPropertyFilteredByDomain(1200),
 	}
 	s.handler = NewAdminHandler(s.service, 1, s.mockDomainCache, s.mockHistoryV2Mgr, params, config)
 	s.handler.Start()
@@ -148,6 +158,14 @@ func (s *adminHandlerSuite) Test_ConvertIndexedValueTypeToESDataType() {
 			input:    shared.IndexedValueTypeDatetime,
 			expected: "date",
 		},
+		{
+			input:    shared.IndexedValueTypeKeywordList,
+			expected: "keyword",
+		},
+		{
+			input:    shared.IndexedValueTypeDatetimeList,
+			expected: "date",
+		},
 		{
 			input:    shared.IndexedValueType(-1),
 			expected: "",
@@ -240,6 +258,7 @@ func (s *adminHandlerSuite) Test_GetWorkflowExecutionRawHistoryV2_FailedOnDomain
 func (s *adminHandlerSuite) Test_GetWorkflowExecutionRawHistoryV2() {
 	ctx := context.Background()
 	s.mockDomainCache.EXPECT().GetDomainID(s.domainName).Return(s.domainID, nil).AnyTimes()
+	s.mockDomainCache.EXPECT().GetDomainByID(s.domainID).Return(s.newDomainEntry(nil), nil).AnyTimes()
 	branchToken := []byte{1}
 	versionHistory := persistence.NewVersionHistory(branchToken, []*persistence.VersionHistoryItem{
 		persistence.NewVersionHistoryItem(int64(10), int64(100)),
@@ -279,6 +298,7 @@ func (s *adminHandlerSuite) Test_GetWorkflowExecutionRawHistoryV2() {
 func (s *adminHandlerSuite) Test_GetWorkflowExecutionRawHistoryV2_SameStartIDAndEndID() {
 	ctx := context.Background()
 	s.mockDomainCache.EXPECT().GetDomainID(s.domainName).Return(s.domainID, nil).AnyTimes()
+	s.mockDomainCache.EXPECT().GetDomainByID(s.domainID).Return(s.newDomainEntry(nil), nil).AnyTimes()
 	branchToken := []byte{1}
 	versionHistory := persistence.NewVersionHistory(branchToken, []*persistence.VersionHistoryItem{
 		persistence.NewVersionHistoryItem(int64(10), int64(100)),
@@ -309,6 +329,100 @@ func (s *adminHandlerSuite) Test_GetWorkflowExecutionRawHistoryV2_SameStartIDAnd
 	s.NoError(err)
 }
 
+func (s *adminHandlerSuite) Test_GetWorkflowExecutionRawHistoryV2_ExcludedByReplicationFilter() {
+	ctx := context.Background()
+	s.mockDomainCache.EXPECT().GetDomainID(s.domainName).Return(s.domainID, nil).AnyTimes()
+	s.mockDomainCache.EXPECT().GetDomainByID(s.domainID).Return(s.newDomainEntry(&shared.DomainReplicationFilter{
+		WorkflowTypes: []string{"replicated workflow type"},
+	}), nil).AnyTimes()
+	s.mockHistoryClient.EXPECT().GetMutableState(gomock.Any(), gomock.Any()).Return(s.newMutableStateResponse(), nil).AnyTimes()
+
+	_, err := s.handler.GetWorkflowExecutionRawHistoryV2(ctx, s.newRawHistoryV2Request())
+	s.IsType(&shared.EntityNotExistsError{}, err)
+}
+
+func (s *adminHandlerSuite) Test_GetWorkflowExecutionRawHistoryV2_SkipVisibilityPayloads() {
+	ctx := context.Background()
+	s.mockDomainCache.EXPECT().GetDomainID(s.domainName).Return(s.domainID, nil).AnyTimes()
+	s.mockDomainCache.EXPECT().GetDomainByID(s.domainID).Return(s.newDomainEntry(&shared.DomainReplicationFilter{
+		WorkflowTypes:          []string{"some random workflow type"},
+		SkipVisibilityPayloads: common.BoolPtr(true),
+	}), nil).AnyTimes()
+	s.mockHistoryClient.EXPECT().GetMutableState(gomock.Any(), gomock.Any()).Return(s.newMutableStateResponse(), nil).AnyTimes()
+
+	serializer := persistence.NewPayloadSerializer()
+	blob, err := serializer.SerializeBatchEvents([]*shared.HistoryEvent{
+		{
+			EventId:   common.Int64Ptr(1),
+			EventType: shared.EventTypeWorkflowExecutionStarted.Ptr(),
+			WorkflowExecutionStartedEventAttributes: &shared.WorkflowExecutionStartedEventAttributes{
+				Memo: &shared.Memo{Fields: map[string][]byte{"key": []byte("value")}},
+			},
+		},
+	}, common.EncodingTypeThriftRW)
+	s.NoError(err)
+	s.mockHistoryV2Mgr.On("ReadRawHistoryBranch", mock.Anything).Return(&persistence.ReadRawHistoryBranchResponse{
+		HistoryEventBlobs: []*persistence.DataBlob{blob},
+		NextPageToken:     []byte{},
+		Size:              len(blob.Data),
+	}, nil)
+
+	resp, err := s.handler.GetWorkflowExecutionRawHistoryV2(ctx, s.newRawHistoryV2Request())
+	s.NoError(err)
+	s.Len(resp.HistoryBatches, 1)
+	events, err := serializer.DeserializeBatchEvents(persistence.NewDataBlobFromThrift(resp.HistoryBatches[0]))
+	s.NoError(err)
+	s.Len(events, 1)
+	s.Nil(events[0].WorkflowExecutionStartedEventAttributes.Memo)
+}
+
+func (s *adminHandlerSuite) newDomainEntry(filter *shared.DomainReplicationFilter) *cache.DomainCacheEntry {
+	return cache.NewGlobalDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: s.domainID, Name: s.domainName},
+		&persistence.DomainConfig{},
+		&persistence.DomainReplicationConfig{
+			ActiveClusterName: s.currentClusterName,
+			Clusters: []*persistence.ClusterReplicationConfig{
+				{ClusterName: s.currentClusterName},
+				{ClusterName: s.alternativeClusterName},
+			},
+			Filter: filter,
+		},
+		common.EmptyVersion,
+		nil,
+	)
+}
+
+func (s *adminHandlerSuite) newMutableStateResponse() *history.GetMutableStateResponse {
+	branchToken := []byte{1}
+	versionHistory := persistence.NewVersionHistory(branchToken, []*persistence.VersionHistoryItem{
+		persistence.NewVersionHistoryItem(int64(10), int64(100)),
+	})
+	return &history.GetMutableStateResponse{
+		NextEventId:        common.Int64Ptr(11),
+		CurrentBranchToken: branchToken,
+		VersionHistories:   persistence.NewVersionHistories(versionHistory).ToThrift(),
+		ReplicationInfo:    make(map[string]*shared.ReplicationInfo),
+		WorkflowType:       &shared.WorkflowType{Name: common.StringPtr("some random workflow type")},
+	}
+}
+
+func (s *adminHandlerSuite) newRawHistoryV2Request() *admin.GetWorkflowExecutionRawHistoryV2Request {
+	return &admin.GetWorkflowExecutionRawHistoryV2Request{
+		Domain: common.StringPtr(s.domainName),
+		Execution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr("workflowID"),
+			RunId:      common.StringPtr(uuid.New()),
+		},
+		StartEventId:      common.Int64Ptr(1),
+		StartEventVersion: common.Int64Ptr(100),
+		EndEventId:        common.Int64Ptr(10),
+		EndEventVersion:   common.Int64Ptr(100),
+		MaximumPageSize:   common.Int32Ptr(10),
+		NextPageToken:     nil,
+	}
+}
+
 func (s *adminHandlerSuite) Test_SetRequestDefaultValueAndGetTargetVersionHistory_DefinedStartAndEnd() {
 	inputStartEventID := int64(1)
 	inputStartVersion := int64(10)
@@ -495,6 +609,10 @@ func (s *adminHandlerSuite) Test_AddSearchAttribute_Validate() {
 	}
 	dynamicConfig.EXPECT().GetMapValue(dynamicconfig.ValidSearchAttributes, nil, definition.GetDefaultIndexedKeys()).
 		Return(mockValidAttr, nil).AnyTimes()
+	dynamicConfig.EXPECT().GetMapValue(dynamicconfig.SearchAttributeStatus, nil, gomock.Any()).
+		Return(map[string]interface{}{}, nil).AnyTimes()
+	dynamicConfig.EXPECT().GetMapValue(dynamicconfig.SearchAttributeAliases, nil, gomock.Any()).
+		Return(map[string]interface{}{"testalias": "testkey"}, nil).AnyTimes()
 
 	testCases2 := []test{
 		{
@@ -515,29 +633,20 @@ func (s *adminHandlerSuite) Test_AddSearchAttribute_Validate() {
 			},
 			Expected: &shared.BadRequestError{Message: "Key [testkey] is already whitelist"},
 		},
+		{
+			Name: "key already an alias",
+			Request: &admin.AddSearchAttributeRequest{
+				SearchAttribute: map[string]shared.IndexedValueType{
+					"testalias": 1,
+				},
+			},
+			Expected: &shared.BadRequestError{Message: "Key [testalias] is already an alias"},
+		},
 	}
 	for _, testCase := range testCases2 {
 		s.Equal(testCase.Expected, handler.AddSearchAttribute(ctx, testCase.Request))
 	}
 
-	dcUpdateTest := test{
-		Name: "dynamic config update failed",
-		Request: &admin.AddSearchAttributeRequest{
-			SearchAttribute: map[string]shared.IndexedValueType{
-				"testkey2": 1,
-			},
-		},
-		Expected: &shared.InternalServiceError{Message: "Failed to update dynamic config, err: error"},
-	}
-	dynamicConfig.EXPECT().UpdateValue(dynamicconfig.ValidSearchAttributes, map[string]interface{}{
-		"testkey":  shared.IndexedValueTypeKeyword,
-		"testkey2": 1,
-	}).Return(errors.New("error"))
-	s.Equal(dcUpdateTest.Expected, handler.AddSearchAttribute(ctx, dcUpdateTest.Request))
-
-	// ES operations tests
-	dynamicConfig.EXPECT().UpdateValue(gomock.Any(), gomock.Any()).Return(nil).Times(2)
-
 	convertFailedTest := test{
 		Name: "unknown value type",
 		Request: &admin.AddSearchAttributeRequest{
@@ -549,7 +658,8 @@ func (s *adminHandlerSuite) Test_AddSearchAttribute_Validate() {
 	}
 	s.Equal(convertFailedTest.Expected, handler.AddSearchAttribute(ctx, convertFailedTest.Request))
 
-	esClient.On("PutMapping", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+	// ES operations tests, dynamic config is not updated if ES mapping is not updated
+	esClient.On("PutMapping", mock.Anything, mock.Anything, mock.Anything, "testkey4", mock.Anything).
 		Return(errors.New("error"))
 	esErrorTest := test{
 		Name: "es error",
@@ -561,6 +671,39 @@ func (s *adminHandlerSuite) Test_AddSearchAttribute_Validate() {
 		Expected: &shared.InternalServiceError{Message: "Failed to update ES mapping, err: error"},
 	}
 	s.Equal(esErrorTest.Expected, handler.AddSearchAttribute(ctx, esErrorTest.Request))
+
+	conflictErr := &elastic.Error{Status: http.StatusBadRequest}
+	esClient.On("PutMapping", mock.Anything, mock.Anything, mock.Anything, "testkey5", mock.Anything).
+		Return(conflictErr)
+	typeConflictTest := test{
+		Name: "type conflict",
+		Request: &admin.AddSearchAttributeRequest{
+			SearchAttribute: map[string]shared.IndexedValueType{
+				"testkey5": 2,
+			},
+		},
+		Expected: &shared.BadRequestError{
+			Message: fmt.Sprintf("Key [testkey5] conflicts with the type of its existing mapping, err: %v", conflictErr),
+		},
+	}
+	s.Equal(typeConflictTest.Expected, handler.AddSearchAttribute(ctx, typeConflictTest.Request))
+
+	esClient.On("PutMapping", mock.Anything, mock.Anything, mock.Anything, "testkey2", mock.Anything).
+		Return(nil)
+	dcUpdateTest := test{
+		Name: "dynamic config update failed",
+		Request: &admin.AddSearchAttributeRequest{
+			SearchAttribute: map[string]shared.IndexedValueType{
+				"testkey2": 1,
+			},
+		},
+		Expected: &shared.InternalServiceError{Message: "Failed to update dynamic config, err: error"},
+	}
+	dynamicConfig.EXPECT().UpdateValueWithFilters(dynamicconfig.ValidSearchAttributes, nil, map[string]interface{}{
+		"testkey":  shared.IndexedValueTypeKeyword,
+		"testkey2": 1,
+	}).Return(errors.New("error"))
+	s.Equal(dcUpdateTest.Expected, handler.AddSearchAttribute(ctx, dcUpdateTest.Request))
 }
 
 func (s *adminHandlerSuite) Test_AddSearchAttribute_Permission() {
@@ -596,3 +739,425 @@ func (s *adminHandlerSuite) Test_AddSearchAttribute_Permission() {
 		s.Equal(testCase.Expected, handler.AddSearchAttribute(ctx, testCase.Request))
 	}
 }
+
+func (s *adminHandlerSuite) Test_AddSearchAttribute_Domain() {
+	ctx := context.Background()
+	handler := s.handler
+	dynamicConfig := dynamicconfig.NewMockClient(s.controller)
+	esClient := &esmock.Client{}
+	defer func() { esClient.AssertExpectations(s.T()) }()
+	handler.params = &service.BootstrapParams{
+		DynamicConfig: dynamicConfig,
+		ESConfig:      &elasticsearch.Config{},
+		ESClient:      esClient,
+	}
+
+	clusterValid := map[string]interface{}{"testkey": 1}
+	dynamicConfig.EXPECT().GetMapValue(dynamicconfig.ValidSearchAttributes, nil, gomock.Any()).
+		Return(clusterValid, nil)
+	dynamicConfig.EXPECT().GetMapValue(dynamicconfig.SearchAttributeStatus, nil, gomock.Any()).
+		Return(map[string]interface{}{}, nil)
+	dynamicConfig.EXPECT().GetMapValue(dynamicconfig.SearchAttributeAliases, nil, gomock.Any()).
+		Return(map[string]interface{}{}, nil)
+	// the domain has no valid search attributes of its own, so dynamic config falls back to the cluster registry
+	filters := map[dynamicconfig.Filter]interface{}{dynamicconfig.DomainName: s.domainName}
+	dynamicConfig.EXPECT().GetMapValue(dynamicconfig.ValidSearchAttributes, filters, gomock.Any()).
+		Return(clusterValid, nil)
+	dynamicConfig.EXPECT().GetMapValue(dynamicconfig.SearchAttributeStatus, filters, gomock.Any()).
+		Return(map[string]interface{}{"testkey2": definition.SearchAttributeStatusRemoved}, nil)
+	dynamicConfig.EXPECT().GetMapValue(dynamicconfig.SearchAttributeAliases, filters, gomock.Any()).
+		Return(map[string]interface{}{}, nil)
+	// only the overrides of the domain are written, so keys added to the cluster later reach the domain
+	dynamicConfig.EXPECT().UpdateValueWithFilters(dynamicconfig.ValidSearchAttributes, filters, map[string]interface{}{
+		"testkey2": 1,
+	}).Return(nil)
+	dynamicConfig.EXPECT().UpdateValueWithFilters(dynamicconfig.SearchAttributeStatus, filters, map[string]interface{}{}).Return(nil)
+	esClient.On("PutMapping", mock.Anything, mock.Anything, definition.Attr, "testkey2", "keyword").Return(nil)
+
+	s.NoError(handler.AddSearchAttribute(ctx, &admin.AddSearchAttributeRequest{
+		SearchAttribute: map[string]shared.IndexedValueType{"testkey2": 1},
+		Domain:          common.StringPtr(s.domainName),
+	}))
+}
+
+func (s *adminHandlerSuite) Test_UpdateSearchAttributes_Validate() {
+	ctx := context.Background()
+	handler := s.handler
+	dynamicConfig := dynamicconfig.NewMockClient(s.controller)
+	handler.params = &service.BootstrapParams{DynamicConfig: dynamicConfig}
+
+	dynamicConfig.EXPECT().GetMapValue(dynamicconfig.ValidSearchAttributes, nil, gomock.Any()).
+		Return(map[string]interface{}{"WorkflowID": 1, "testkey": 1}, nil).AnyTimes()
+	dynamicConfig.EXPECT().GetMapValue(dynamicconfig.SearchAttributeStatus, nil, gomock.Any()).
+		Return(map[string]interface{}{}, nil).AnyTimes()
+	dynamicConfig.EXPECT().GetMapValue(dynamicconfig.SearchAttributeAliases, nil, gomock.Any()).
+		Return(map[string]interface{}{"testalias": "testkey"}, nil).AnyTimes()
+
+	testCases := []struct {
+		Name     string
+		Request  *admin.UpdateSearchAttributesRequest
+		Expected error
+	}{
+		{
+			Name:     "nil request",
+			Request:  nil,
+			Expected: errRequestNotSet,
+		},
+		{
+			Name:     "empty request",
+			Request:  &admin.UpdateSearchAttributesRequest{},
+			Expected: &shared.BadRequestError{Message: "Updates are not provided"},
+		},
+		{
+			Name:     "deprecate system key",
+			Request:  &admin.UpdateSearchAttributesRequest{DeprecateKeys: []string{"WorkflowID"}},
+			Expected: &shared.BadRequestError{Message: "Key [WorkflowID] is reserved by system"},
+		},
+		{
+			Name:     "remove unknown key",
+			Request:  &admin.UpdateSearchAttributesRequest{RemoveKeys: []string{"unknown"}},
+			Expected: &shared.BadRequestError{Message: "Key [unknown] is not whitelist"},
+		},
+		{
+			Name:     "remove unknown alias",
+			Request:  &admin.UpdateSearchAttributesRequest{RemoveAliases: []string{"unknown"}},
+			Expected: &shared.BadRequestError{Message: "Alias [unknown] does not exist"},
+		},
+		{
+			Name:     "alias is a key",
+			Request:  &admin.UpdateSearchAttributesRequest{AddAliases: map[string]string{"testkey": "testkey"}},
+			Expected: &shared.BadRequestError{Message: "Alias [testkey] is already whitelist as key"},
+		},
+		{
+			Name:     "alias already exists",
+			Request:  &admin.UpdateSearchAttributesRequest{AddAliases: map[string]string{"testalias": "testkey"}},
+			Expected: &shared.BadRequestError{Message: "Alias [testalias] already exists"},
+		},
+		{
+			Name:     "alias of an alias",
+			Request:  &admin.UpdateSearchAttributesRequest{AddAliases: map[string]string{"testalias2": "testalias"}},
+			Expected: &shared.BadRequestError{Message: "Key [testalias] is not whitelist"},
+		},
+	}
+	for _, testCase := range testCases {
+		s.Equal(testCase.Expected, handler.UpdateSearchAttributes(ctx, testCase.Request), testCase.Name)
+	}
+}
+
+func (s *adminHandlerSuite) Test_UpdateSearchAttributes() {
+	ctx := context.Background()
+	handler := s.handler
+	dynamicConfig := dynamicconfig.NewMockClient(s.controller)
+	handler.params = &service.BootstrapParams{DynamicConfig: dynamicConfig}
+
+	filters := map[dynamicconfig.Filter]interface{}{dynamicconfig.DomainName: s.domainName}
+	clusterValid := map[string]interface{}{"testkey": 1, "testkey2": 1, "testkey3": 1}
+	clusterAliases := map[string]interface{}{"alias2": "testkey2", "alias3": "testkey3"}
+	for _, f := range []map[dynamicconfig.Filter]interface{}{nil, filters} {
+		dynamicConfig.EXPECT().GetMapValue(dynamicconfig.ValidSearchAttributes, f, gomock.Any()).
+			Return(clusterValid, nil)
+		dynamicConfig.EXPECT().GetMapValue(dynamicconfig.SearchAttributeStatus, f, gomock.Any()).
+			Return(map[string]interface{}{}, nil)
+		dynamicConfig.EXPECT().GetMapValue(dynamicconfig.SearchAttributeAliases, f, gomock.Any()).
+			Return(clusterAliases, nil)
+	}
+	// keys and aliases of the cluster are removed from the domain by overriding them
+	dynamicConfig.EXPECT().UpdateValueWithFilters(dynamicconfig.ValidSearchAttributes, filters, map[string]interface{}{}).Return(nil)
+	dynamicConfig.EXPECT().UpdateValueWithFilters(dynamicconfig.SearchAttributeStatus, filters, map[string]interface{}{
+		"testkey":  definition.SearchAttributeStatusDeprecated,
+		"testkey2": definition.SearchAttributeStatusRemoved,
+	}).Return(nil)
+	dynamicConfig.EXPECT().UpdateValueWithFilters(dynamicconfig.SearchAttributeAliases, filters, map[string]interface{}{
+		"alias2":   "",
+		"alias3":   "",
+		"newalias": "testkey3",
+	}).Return(nil)
+
+	s.NoError(handler.UpdateSearchAttributes(ctx, &admin.UpdateSearchAttributesRequest{
+		Domain:        common.StringPtr(s.domainName),
+		DeprecateKeys: []string{"testkey"},
+		RemoveKeys:    []string{"testkey2"},
+		AddAliases:    map[string]string{"newalias": "testkey3"},
+		RemoveAliases: []string{"alias3"},
+	}))
+}
+
+func (s *adminHandlerSuite) Test_DescribeSearchAttributes() {
+	ctx := context.Background()
+	handler := s.handler
+	dynamicConfig := dynamicconfig.NewMockClient(s.controller)
+	handler.params = &service.BootstrapParams{DynamicConfig: dynamicConfig}
+
+	dynamicConfig.EXPECT().GetMapValue(dynamicconfig.ValidSearchAttributes, nil, gomock.Any()).
+		Return(map[string]interface{}{"testkey": float64(1), "testkey2": 2}, nil)
+	dynamicConfig.EXPECT().GetMapValue(dynamicconfig.SearchAttributeStatus, nil, gomock.Any()).
+		Return(map[string]interface{}{
+			"testkey":  definition.SearchAttributeStatusDeprecated,
+			"testkey3": definition.SearchAttributeStatusRemoved,
+		}, nil)
+	dynamicConfig.EXPECT().GetMapValue(dynamicconfig.SearchAttributeAliases, nil, gomock.Any()).
+		Return(map[string]interface{}{"alias": "testkey2"}, nil)
+
+	resp, err := handler.DescribeSearchAttributes(ctx, &admin.DescribeSearchAttributesRequest{})
+	s.NoError(err)
+	s.Equal(&admin.DescribeSearchAttributesResponse{
+		Keys: map[string]shared.IndexedValueType{
+			"testkey":  shared.IndexedValueTypeKeyword,
+			"testkey2": shared.IndexedValueTypeInt,
+		},
+		DeprecatedKeys: []string{"testkey"},
+		RemovedKeys:    []string{"testkey3"},
+		Aliases:        map[string]string{"alias": "testkey2"},
+	}, resp)
+}
+
+func (s *adminHandlerSuite) Test_DrainTaskList_Validate() {
+	ctx := context.Background()
+	taskListType := shared.TaskListTypeActivity
+	testCases := []struct {
+		Name     string
+		Request  *admin.DrainTaskListRequest
+		Expected error
+	}{
+		{
+			Name:     "nil request",
+			Request:  nil,
+			Expected: errRequestNotSet,
+		},
+		{
+			Name: "no domain",
+			Request: &admin.DrainTaskListRequest{
+				TaskList:     &shared.TaskList{Name: common.StringPtr("tl")},
+				TaskListType: &taskListType,
+			},
+			Expected: errDomainNotSet,
+		},
+		{
+			Name: "no task list",
+			Request: &admin.DrainTaskListRequest{
+				Domain:       common.StringPtr(s.domainName),
+				TaskListType: &taskListType,
+			},
+			Expected: errTaskListNotSet,
+		},
+		{
+			Name: "no task list type",
+			Request: &admin.DrainTaskListRequest{
+				Domain:   common.StringPtr(s.domainName),
+				TaskList: &shared.TaskList{Name: common.StringPtr("tl")},
+			},
+			Expected: errTaskListTypeNotSet,
+		},
+		{
+			Name: "drain into itself",
+			Request: &admin.DrainTaskListRequest{
+				Domain:         common.StringPtr(s.domainName),
+				TaskList:       &shared.TaskList{Name: common.StringPtr("tl")},
+				TaskListType:   &taskListType,
+				Drain:          common.BoolPtr(true),
+				TargetTaskList: common.StringPtr("tl"),
+			},
+			Expected: errInvalidDrainTarget,
+		},
+	}
+	for _, testCase := range testCases {
+		_, err := s.handler.DrainTaskList(ctx, testCase.Request)
+		s.Equal(testCase.Expected, err, testCase.Name)
+	}
+}
+
+func (s *adminHandlerSuite) Test_DrainTaskList_Success() {
+	ctx := context.Background()
+	taskListType := shared.TaskListTypeActivity
+	s.mockDomainCache.EXPECT().GetDomainID(s.domainName).Return(s.domainID, nil).Times(1)
+	s.mockMatchingClient.EXPECT().DrainTaskList(gomock.Any(), &matching.DrainTaskListRequest{
+		DomainUUID:     common.StringPtr(s.domainID),
+		TaskList:       &shared.TaskList{Name: common.StringPtr("tl")},
+		TaskListType:   &taskListType,
+		Drain:          common.BoolPtr(true),
+		TargetTaskList: common.StringPtr("tl-v2"),
+	}).Return(&matching.DrainTaskListResponse{
+		Draining:         common.BoolPtr(true),
+		TargetTaskList:   common.StringPtr("tl-v2"),
+		BacklogCountHint: common.Int64Ptr(10),
+	}, nil).Times(1)
+
+	resp, err := s.handler.DrainTaskList(ctx, &admin.DrainTaskListRequest{
+		Domain:         common.StringPtr(s.domainName),
+		TaskList:       &shared.TaskList{Name: common.StringPtr("tl")},
+		TaskListType:   &taskListType,
+		Drain:          common.BoolPtr(true),
+		TargetTaskList: common.StringPtr("tl-v2"),
+	})
+	s.NoError(err)
+	s.True(resp.GetDraining())
+	s.Equal("tl-v2", resp.GetTargetTaskList())
+	s.Equal(int64(10), resp.GetBacklogCountHint())
+}
+
+func (s *adminHandlerSuite) Test_ReadDLQMessages_Validate() {
+	ctx := context.Background()
+	testCases := []struct {
+		Name     string
+		Request  *replicator.ReadDLQMessagesRequest
+		Expected error
+	}{
+		{
+			Name:     "nil request",
+			Request:  nil,
+			Expected: errRequestNotSet,
+		},
+		{
+			Name: "no dlq type",
+			Request: &replicator.ReadDLQMessagesRequest{
+				ShardID:         common.Int32Ptr(1),
+				SourceCluster:   common.StringPtr("standby"),
+				MaximumPageSize: common.Int32Ptr(10),
+			},
+			Expected: errDLQTypeNotSet,
+		},
+		{
+			Name: "no shard id",
+			Request: &replicator.ReadDLQMessagesRequest{
+				Type:            replicator.DLQTypeReplication.Ptr(),
+				SourceCluster:   common.StringPtr("standby"),
+				MaximumPageSize: common.Int32Ptr(10),
+			},
+			Expected: errShardIDNotSet,
+		},
+		{
+			Name: "no source cluster",
+			Request: &replicator.ReadDLQMessagesRequest{
+				Type:            replicator.DLQTypeReplication.Ptr(),
+				ShardID:         common.Int32Ptr(1),
+				MaximumPageSize: common.Int32Ptr(10),
+			},
+			Expected: errSourceClusterNotSet,
+		},
+		{
+			Name: "no page size",
+			Request: &replicator.ReadDLQMessagesRequest{
+				Type:          replicator.DLQTypeReplication.Ptr(),
+				ShardID:       common.Int32Ptr(1),
+				SourceCluster: common.StringPtr("standby"),
+			},
+			Expected: errInvalidDLQPageSize,
+		},
+	}
+	for _, testCase := range testCases {
+		_, err := s.handler.ReadDLQMessages(ctx, testCase.Request)
+		s.Equal(testCase.Expected, err, testCase.Name)
+	}
+}
+
+func (s *adminHandlerSuite) Test_ReadDLQMessages_Success() {
+	ctx := context.Background()
+	s.mockHistoryClient.EXPECT().ReadDLQMessages(gomock.Any(), &replicator.ReadDLQMessagesRequest{
+		Type:                  replicator.DLQTypeReplication.Ptr(),
+		ShardID:               common.Int32Ptr(1),
+		SourceCluster:         common.StringPtr("standby"),
+		InclusiveEndMessageID: common.Int64Ptr(common.EndMessageID),
+		MaximumPageSize:       common.Int32Ptr(10),
+	}).Return(&replicator.ReadDLQMessagesResponse{
+		Type: replicator.DLQTypeReplication.Ptr(),
+		ReplicationTasks: []*replicator.ReplicationTaskInfo{
+			{TaskID: common.Int64Ptr(5)},
+		},
+	}, nil).Times(1)
+
+	resp, err := s.handler.ReadDLQMessages(ctx, &replicator.ReadDLQMessagesRequest{
+		Type:            replicator.DLQTypeReplication.Ptr(),
+		ShardID:         common.Int32Ptr(1),
+		SourceCluster:   common.StringPtr("standby"),
+		MaximumPageSize: common.Int32Ptr(10),
+	})
+	s.NoError(err)
+	s.Len(resp.GetReplicationTasks(), 1)
+	s.Equal(int64(5), resp.GetReplicationTasks()[0].GetTaskID())
+}
+
+func (s *adminHandlerSuite) Test_PurgeDLQMessages_Success() {
+	ctx := context.Background()
+	s.mockHistoryClient.EXPECT().PurgeDLQMessages(gomock.Any(), &replicator.PurgeDLQMessagesRequest{
+		Type:                  replicator.DLQTypeReplication.Ptr(),
+		ShardID:               common.Int32Ptr(1),
+		SourceCluster:         common.StringPtr("standby"),
+		InclusiveEndMessageID: common.Int64Ptr(100),
+	}).Return(nil).Times(1)
+
+	err := s.handler.PurgeDLQMessages(ctx, &replicator.PurgeDLQMessagesRequest{
+		Type:                  replicator.DLQTypeReplication.Ptr(),
+		ShardID:               common.Int32Ptr(1),
+		SourceCluster:         common.StringPtr("standby"),
+		InclusiveEndMessageID: common.Int64Ptr(100),
+	})
+	s.NoError(err)
+}
+
+func (s *adminHandlerSuite) Test_MergeDLQMessages_Success() {
+	ctx := context.Background()
+	s.mockHistoryClient.EXPECT().MergeDLQMessages(gomock.Any(), &replicator.MergeDLQMessagesRequest{
+		Type:                  replicator.DLQTypeReplication.Ptr(),
+		ShardID:               common.Int32Ptr(1),
+		SourceCluster:         common.StringPtr("standby"),
+		InclusiveEndMessageID: common.Int64Ptr(common.EndMessageID),
+		MaximumPageSize:       common.Int32Ptr(10),
+	}).Return(&replicator.MergeDLQMessagesResponse{
+		NextPageToken: []byte{1},
+	}, nil).Times(1)
+
+	resp, err := s.handler.MergeDLQMessages(ctx, &replicator.MergeDLQMessagesRequest{
+		Type:            replicator.DLQTypeReplication.Ptr(),
+		ShardID:         common.Int32Ptr(1),
+		SourceCluster:   common.StringPtr("standby"),
+		MaximumPageSize: common.Int32Ptr(10),
+	})
+	s.NoError(err)
+	s.Equal([]byte{1}, resp.GetNextPageToken())
+}
+
+func (s *adminHandlerSuite) Test_SignalExternalWorkflowExecution_Validate() {
+	ctx := context.Background()
+	config := &Config{
+		EnableAdminProtection: dynamicconfig.GetBoolPropertyFn(true),
+		AdminOperationToken:   dynamicconfig.GetStringPropertyFn(common.DefaultAdminOperationToken),
+		RPS:                   dynamicconfig.GetIntPropertyFn(100),
+		DomainRPS:             dynamicconfig.GetIntPropertyFilteredByDomain(100),
+		MaxIDLengthLimit:      dynamicconfig.GetIntPropertyFn(1000),
+		BlobSizeLimitWarn:     dynamicconfig.GetIntPropertyFilteredByDomain(256 * 1024),
+		BlobSizeLimitError:    dynamicconfig.GetIntPropertyFilteredByDomain(2 * 1024 * 1024),
+	}
+	handler := NewAdminHandler(s.service, 1, s.mockDomainCache, s.mockHistoryV2Mgr, &service.BootstrapParams{}, config)
+	handler.Start()
+	defer handler.Stop()
+	domainEntry := cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: s.domainID, Name: s.domainName},
+		nil,
+		"",
+		nil,
+	)
+	s.mockDomainCache.EXPECT().GetDomainByID(s.domainID).Return(domainEntry, nil).AnyTimes()
+
+	newRequest := func(token string, signalName string) *admin.SignalExternalWorkflowExecutionRequest {
+		return &admin.SignalExternalWorkflowExecutionRequest{
+			SignalRequest: &history.SignalWorkflowExecutionRequest{
+				DomainUUID: common.StringPtr(s.domainID),
+				SignalRequest: &shared.SignalWorkflowExecutionRequest{
+					WorkflowExecution: &shared.WorkflowExecution{
+						WorkflowId: common.StringPtr("some random workflow ID"),
+						RunId:      common.StringPtr(uuid.New()),
+					},
+					SignalName: common.StringPtr(signalName),
+				},
+			},
+			SecurityToken: common.StringPtr(token),
+		}
+	}
+
+	s.Equal(errRequestNotSet, handler.SignalExternalWorkflowExecution(ctx, &admin.SignalExternalWorkflowExecutionRequest{}))
+	s.Equal(errNoPermission, handler.SignalExternalWorkflowExecution(ctx, newRequest("unknown", "some random signal name")))
+	s.IsType(&shared.BadRequestError{}, handler.SignalExternalWorkflowExecution(ctx, newRequest(common.DefaultAdminOperationToken, "")))
+
+	s.mockHistoryClient.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	s.NoError(handler.SignalExternalWorkflowExecution(ctx, newRequest(common.DefaultAdminOperationToken, "some random signal name")))
+}
@@ -21,6 +21,8 @@
 package frontend
 
 import (
+	"time"
+
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/archiver"
 	"github.com/uber/cadence/common/cache"
@@ -47,8 +49,10 @@ type Config struct {
 	EnableReadFromClosedExecutionV2 dynamicconfig.BoolPropertyFn
 	VisibilityListMaxQPS            dynamicconfig.IntPropertyFnWithDomainFilter
 	EnableReadVisibilityFromES      dynamicconfig.BoolPropertyFnWithDomainFilter
+	EnableReadFromMigrationTarget   dynamicconfig.BoolPropertyFnWithDomainFilter
 	ESVisibilityListMaxQPS          dynamicconfig.IntPropertyFnWithDomainFilter
 	ESIndexMaxResultWindow          dynamicconfig.IntPropertyFn
+	ESHistorySearchMaxResults       dynamicconfig.IntPropertyFn
 	HistoryMaxPageSize              dynamicconfig.IntPropertyFnWithDomainFilter
 	RPS                             dynamicconfig.IntPropertyFn
 	DomainRPS                       dynamicconfig.IntPropertyFnWithDomainFilter
@@ -56,6 +60,7 @@ type Config struct {
 	EnableClientVersionCheck        dynamicconfig.BoolPropertyFn
 	MinRetentionDays                dynamicconfig.IntPropertyFn
 	DisallowQuery                   dynamicconfig.BoolPropertyFnWithDomainFilter
+	EnablePollerTaskStats           dynamicconfig.BoolPropertyFnWithDomainFilter
 
 	// Persistence settings
 	HistoryMgrNumConns dynamicconfig.IntPropertyFn
@@ -78,12 +83,17 @@ type Config struct {
 
 	// ValidSearchAttributes is legal indexed keys that can be used in list APIs
 	ValidSearchAttributes             dynamicconfig.MapPropertyFn
+	SearchAttributeStatus             dynamicconfig.MapPropertyFn
+	SearchAttributeAliases            dynamicconfig.MapPropertyFn
 	SearchAttributesNumberOfKeysLimit dynamicconfig.IntPropertyFnWithDomainFilter
 	SearchAttributesSizeOfValueLimit  dynamicconfig.IntPropertyFnWithDomainFilter
 	SearchAttributesTotalSizeLimit    dynamicconfig.IntPropertyFnWithDomainFilter
 
 	// VisibilityArchival system protection
 	VisibilityArchivalQueryMaxPageSize dynamicconfig.IntPropertyFn
+
+	// GracefulFailoverRefreshInterval is the interval of checking draining domains for handover
+	GracefulFailoverRefreshInterval dynamicconfig.DurationPropertyFn
 }
 
 // NewConfig returns new service config with default values
@@ -96,8 +106,10 @@ func NewConfig(dc *dynamicconfig.Collection, numHistoryShards int, enableReadFro
 		EnableReadFromClosedExecutionV2:     dc.GetBoolProperty(dynamicconfig.EnableReadFromClosedExecutionV2, false),
 		VisibilityListMaxQPS:                dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendVisibilityListMaxQPS, 1),
 		EnableReadVisibilityFromES:          dc.GetBoolPropertyFnWithDomainFilter(dynamicconfig.EnableReadVisibilityFromES, enableReadFromES),
+		EnableReadFromMigrationTarget:       dc.GetBoolPropertyFnWithDomainFilter(dynamicconfig.EnableReadVisibilityFromMigration, false),
 		ESVisibilityListMaxQPS:              dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendESVisibilityListMaxQPS, 3),
 		ESIndexMaxResultWindow:              dc.GetIntProperty(dynamicconfig.FrontendESIndexMaxResultWindow, 10000),
+		ESHistorySearchMaxResults:           dc.GetIntProperty(dynamicconfig.FrontendESHistorySearchMaxResults, 1000),
 		HistoryMaxPageSize:                  dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendHistoryMaxPageSize, common.GetHistoryMaxPageSize),
 		RPS:                                 dc.GetIntProperty(dynamicconfig.FrontendRPS, 1200),
 		DomainRPS:                           dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendDomainRPS, 1200),
@@ -113,12 +125,16 @@ func NewConfig(dc *dynamicconfig.Collection, numHistoryShards int, enableReadFro
 		EnableDomainNotActiveAutoForwarding: dc.GetBoolPropertyFnWithDomainFilter(dynamicconfig.EnableDomainNotActiveAutoForwarding, true),
 		EnableClientVersionCheck:            dc.GetBoolProperty(dynamicconfig.EnableClientVersionCheck, false),
 		ValidSearchAttributes:               dc.GetMapProperty(dynamicconfig.ValidSearchAttributes, definition.GetDefaultIndexedKeys()),
+		SearchAttributeStatus:               dc.GetMapProperty(dynamicconfig.SearchAttributeStatus, map[string]interface{}{}),
+		SearchAttributeAliases:              dc.GetMapProperty(dynamicconfig.SearchAttributeAliases, map[string]interface{}{}),
 		SearchAttributesNumberOfKeysLimit:   dc.GetIntPropertyFilteredByDomain(dynamicconfig.SearchAttributesNumberOfKeysLimit, 100),
 		SearchAttributesSizeOfValueLimit:    dc.GetIntPropertyFilteredByDomain(dynamicconfig.SearchAttributesSizeOfValueLimit, 2*1024),
 		SearchAttributesTotalSizeLimit:      dc.GetIntPropertyFilteredByDomain(dynamicconfig.SearchAttributesTotalSizeLimit, 40*1024),
 		MinRetentionDays:                    dc.GetIntProperty(dynamicconfig.MinRetentionDays, domain.MinRetentionDays),
 		VisibilityArchivalQueryMaxPageSize:  dc.GetIntProperty(dynamicconfig.VisibilityArchivalQueryMaxPageSize, 10000),
 		DisallowQuery:                       dc.GetBoolPropertyFnWithDomainFilter(dynamicconfig.DisallowQuery, false),
+		EnablePollerTaskStats:               dc.GetBoolPropertyFnWithDomainFilter(dynamicconfig.FrontendEnablePollerTaskStats, false),
+		GracefulFailoverRefreshInterval:     dc.GetDurationProperty(dynamicconfig.FrontendGracefulFailoverRefreshInterval, 10*time.Second),
 	}
 }
 
@@ -159,6 +175,8 @@ func (s *Service) Start() {
 		VisibilityListMaxQPS:            s.config.VisibilityListMaxQPS,
 		EnableSampling:                  s.config.EnableVisibilitySampling,
 		EnableReadFromClosedExecutionV2: s.config.EnableReadFromClosedExecutionV2,
+		EnableReadFromMigrationTarget:   s.config.EnableReadFromMigrationTarget,
+		ValidSearchAttributes:           s.config.ValidSearchAttributes,
 	}
 	pFactory := client.NewFactory(&pConfig, params.ClusterMetadata.GetCurrentClusterName(), base.GetMetricsClient(), log)
 
@@ -176,10 +194,12 @@ func (s *Service) Start() {
 	if params.ESConfig != nil {
 		visibilityIndexName := params.ESConfig.Indices[common.VisibilityAppName]
 		visibilityConfigForES := &config.VisibilityConfig{
-			MaxQPS:                 s.config.PersistenceMaxQPS,
-			VisibilityListMaxQPS:   s.config.ESVisibilityListMaxQPS,
-			ESIndexMaxResultWindow: s.config.ESIndexMaxResultWindow,
-			ValidSearchAttributes:  s.config.ValidSearchAttributes,
+			MaxQPS:                    s.config.PersistenceMaxQPS,
+			VisibilityListMaxQPS:      s.config.ESVisibilityListMaxQPS,
+			ESIndexMaxResultWindow:    s.config.ESIndexMaxResultWindow,
+			ValidSearchAttributes:     s.config.ValidSearchAttributes,
+			ESHistoryIndexName:        params.ESConfig.GetHistoryIndex(),
+			ESHistorySearchMaxResults: s.config.ESHistorySearchMaxResults,
 		}
 		visibilityFromES = espersistence.NewESVisibilityManager(visibilityIndexName, params.ESClient, visibilityConfigForES,
 			nil, base.GetMetricsClient(), log)
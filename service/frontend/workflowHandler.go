@@ -27,6 +27,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
@@ -62,6 +63,9 @@ import (
 const (
 	getDomainReplicationMessageBatchSize = 100
 	defaultLastMessageID                 = -1
+	recordTaskCompletionTimeout          = 5 * time.Second
+	// recordTaskCompletionConcurrency bounds the calls to matching in flight to record task completions
+	recordTaskCompletionConcurrency = 100
 )
 
 var _ workflowserviceserver.Interface = (*WorkflowHandler)(nil)
@@ -83,9 +87,12 @@ type (
 		config                    *Config
 		versionChecker            client.VersionChecker
 		domainHandler             domain.Handler
+		domainHandlerImpl         *domain.HandlerImpl
+		failoverCoordinator       domain.FailoverCoordinator
 		visibilityQueryValidator  *validator.VisibilityQueryValidator
 		searchAttributesValidator *validator.SearchAttributesValidator
 		domainReplicationQueue    persistence.DomainReplicationQueue
+		taskCompletionTokens      chan struct{}
 		service.Service
 	}
 
@@ -135,14 +142,26 @@ var (
 	errHistoryNotFound = &gen.BadRequestError{Message: "Requested workflow history not found, may have passed retention period."}
 	errURIUpdate       = &gen.BadRequestError{Message: "Cannot update existing archival URI"}
 
+	// err for task list drain
+	errInvalidDrainTarget = &gen.BadRequestError{Message: "TargetTaskList must differ from the drained TaskList."}
+
+	// err for replication DLQ
+	errDLQTypeNotSet       = &gen.BadRequestError{Message: "DLQ type is not set on request."}
+	errShardIDNotSet       = &gen.BadRequestError{Message: "ShardID is not set on request."}
+	errSourceClusterNotSet = &gen.BadRequestError{Message: "SourceCluster is not set on request."}
+	errInvalidDLQPageSize  = &gen.BadRequestError{Message: "MaximumPageSize must be positive."}
+	errTaskInfosNotSet     = &gen.BadRequestError{Message: "TaskInfos is not set on request."}
+
 	// err for string too long
-	errDomainTooLong       = &gen.BadRequestError{Message: "Domain length exceeds limit."}
-	errWorkflowTypeTooLong = &gen.BadRequestError{Message: "WorkflowType length exceeds limit."}
-	errWorkflowIDTooLong   = &gen.BadRequestError{Message: "WorkflowID length exceeds limit."}
-	errSignalNameTooLong   = &gen.BadRequestError{Message: "SignalName length exceeds limit."}
-	errTaskListTooLong     = &gen.BadRequestError{Message: "TaskList length exceeds limit."}
-	errRequestIDTooLong    = &gen.BadRequestError{Message: "RequestID length exceeds limit."}
-	errIdentityTooLong     = &gen.BadRequestError{Message: "Identity length exceeds limit."}
+	errDomainTooLong        = &gen.BadRequestError{Message: "Domain length exceeds limit."}
+	errWorkflowTypeTooLong  = &gen.BadRequestError{Message: "WorkflowType length exceeds limit."}
+	errWorkflowIDTooLong    = &gen.BadRequestError{Message: "WorkflowID length exceeds limit."}
+	errSignalNameTooLong    = &gen.BadRequestError{Message: "SignalName length exceeds limit."}
+	errTaskListTooLong      = &gen.BadRequestError{Message: "TaskList length exceeds limit."}
+	errRequestIDTooLong     = &gen.BadRequestError{Message: "RequestID length exceeds limit."}
+	errIdentityTooLong      = &gen.BadRequestError{Message: "Identity length exceeds limit."}
+	errAffinityTokenTooLong = &gen.BadRequestError{Message: "AffinityToken length exceeds limit."}
+	errInvalidAffinityToken = &gen.BadRequestError{Message: "AffinityToken must not contain '/'."}
 
 	frontendServiceRetryPolicy = common.CreateFrontendServiceRetryPolicy()
 )
@@ -158,6 +177,21 @@ func NewWorkflowHandler(
 	domainReplicationQueue persistence.DomainReplicationQueue,
 	domainCache cache.DomainCache,
 ) *WorkflowHandler {
+	domainHandler := domain.NewHandler(
+		config.MinRetentionDays(),
+		config.MaxBadBinaries,
+		sVice.GetLogger(),
+		metadataMgr,
+		sVice.GetClusterMetadata(),
+		domain.NewDomainReplicator(replicationMessageSink, sVice.GetLogger()),
+		sVice.GetArchivalMetadata(),
+		sVice.GetArchiverProvider(),
+	)
+	searchAttributes := validator.NewSearchAttributesRegistry(
+		config.ValidSearchAttributes,
+		config.SearchAttributeStatus,
+		config.SearchAttributeAliases,
+	)
 	handler := &WorkflowHandler{
 		Service:         sVice,
 		config:          config,
@@ -175,26 +209,19 @@ func NewWorkflowHandler(
 				return float64(config.DomainRPS(domain))
 			},
 		),
-		versionChecker: client.NewVersionChecker(),
-		domainHandler: domain.NewHandler(
-			config.MinRetentionDays(),
-			config.MaxBadBinaries,
-			sVice.GetLogger(),
-			metadataMgr,
-			sVice.GetClusterMetadata(),
-			domain.NewDomainReplicator(replicationMessageSink, sVice.GetLogger()),
-			sVice.GetArchivalMetadata(),
-			sVice.GetArchiverProvider(),
-		),
-		visibilityQueryValidator: validator.NewQueryValidator(config.ValidSearchAttributes),
+		versionChecker:           client.NewVersionChecker(),
+		domainHandler:            domainHandler,
+		domainHandlerImpl:        domainHandler,
+		visibilityQueryValidator: validator.NewQueryValidator(searchAttributes),
 		searchAttributesValidator: validator.NewSearchAttributesValidator(
 			sVice.GetLogger(),
-			config.ValidSearchAttributes,
+			searchAttributes,
 			config.SearchAttributesNumberOfKeysLimit,
 			config.SearchAttributesSizeOfValueLimit,
 			config.SearchAttributesTotalSizeLimit,
 		),
 		domainReplicationQueue: domainReplicationQueue,
+		taskCompletionTokens:   make(chan struct{}, recordTaskCompletionConcurrency),
 	}
 	// prevent us from trying to serve requests before handler's Start() is complete
 	handler.startWG.Add(1)
@@ -220,12 +247,33 @@ func (wh *WorkflowHandler) Start() error {
 	wh.matchingRawClient = matchingRawClient
 	wh.matching = matching.NewRetryableClient(wh.matchingRawClient, common.CreateMatchingServiceRetryPolicy(),
 		common.IsWhitelistServiceTransientError)
+
+	frontendResolver, err := wh.GetMembershipMonitor().GetResolver(common.FrontendServiceName)
+	if err != nil {
+		return err
+	}
+	wh.failoverCoordinator = domain.NewFailoverCoordinator(
+		wh.domainHandlerImpl,
+		wh.metadataMgr,
+		wh.GetClusterMetadata(),
+		wh.history,
+		wh.config.NumHistoryShards,
+		frontendResolver,
+		wh.GetHostInfo(),
+		wh.config.GracefulFailoverRefreshInterval,
+		wh.metricsClient,
+		wh.GetLogger(),
+	)
+	wh.failoverCoordinator.Start()
 	wh.startWG.Done()
 	return nil
 }
 
 // Stop stops the handler
 func (wh *WorkflowHandler) Stop() {
+	if wh.failoverCoordinator != nil {
+		wh.failoverCoordinator.Stop()
+	}
 	wh.domainReplicationQueue.Close()
 	wh.domainCache.Stop()
 	wh.metadataMgr.Close()
@@ -443,6 +491,12 @@ func (wh *WorkflowHandler) PollForActivityTask(
 	if len(pollRequest.GetIdentity()) > wh.config.MaxIDLengthLimit() {
 		return nil, wh.error(errIdentityTooLong, scope)
 	}
+	if len(pollRequest.GetAffinityToken()) > wh.config.MaxIDLengthLimit() {
+		return nil, wh.error(errAffinityTokenTooLong, scope)
+	}
+	if strings.Contains(pollRequest.GetAffinityToken(), "/") {
+		return nil, wh.error(errInvalidAffinityToken, scope)
+	}
 
 	domainID, err := wh.domainCache.GetDomainID(pollRequest.GetDomain())
 	if err != nil {
@@ -881,6 +935,7 @@ func (wh *WorkflowHandler) RespondActivityTaskCompleted(
 		if err != nil {
 			return wh.error(err, scope)
 		}
+		wh.recordTaskCompletion(domainEntry.GetInfo().Name, taskToken, gen.TaskListTypeActivity, completeRequest.GetIdentity(), true)
 	} else {
 		err = wh.history.RespondActivityTaskCompleted(ctx, &h.RespondActivityTaskCompletedRequest{
 			DomainUUID:      common.StringPtr(taskToken.DomainID),
@@ -889,6 +944,7 @@ func (wh *WorkflowHandler) RespondActivityTaskCompleted(
 		if err != nil {
 			return wh.error(err, scope)
 		}
+		wh.recordTaskCompletion(domainEntry.GetInfo().Name, taskToken, gen.TaskListTypeActivity, completeRequest.GetIdentity(), false)
 	}
 
 	return nil
@@ -1075,6 +1131,7 @@ func (wh *WorkflowHandler) RespondActivityTaskFailed(
 	if err != nil {
 		return wh.error(err, scope)
 	}
+	wh.recordTaskCompletion(domainEntry.GetInfo().Name, taskToken, gen.TaskListTypeActivity, failedRequest.GetIdentity(), true)
 	return nil
 }
 
@@ -1249,6 +1306,7 @@ func (wh *WorkflowHandler) RespondActivityTaskCanceled(
 		if err != nil {
 			return wh.error(err, scope)
 		}
+		wh.recordTaskCompletion(domainEntry.GetInfo().Name, taskToken, gen.TaskListTypeActivity, cancelRequest.GetIdentity(), true)
 	} else {
 		err = wh.history.RespondActivityTaskCanceled(ctx, &h.RespondActivityTaskCanceledRequest{
 			DomainUUID:    common.StringPtr(taskToken.DomainID),
@@ -1257,6 +1315,7 @@ func (wh *WorkflowHandler) RespondActivityTaskCanceled(
 		if err != nil {
 			return wh.error(err, scope)
 		}
+		wh.recordTaskCompletion(domainEntry.GetInfo().Name, taskToken, gen.TaskListTypeActivity, cancelRequest.GetIdentity(), false)
 	}
 
 	return nil
@@ -1424,6 +1483,7 @@ func (wh *WorkflowHandler) RespondDecisionTaskCompleted(
 	if len(completeRequest.GetIdentity()) > wh.config.MaxIDLengthLimit() {
 		return nil, wh.error(errIdentityTooLong, scope)
 	}
+	wh.recordTaskCompletion(domainEntry.GetInfo().Name, taskToken, gen.TaskListTypeDecision, completeRequest.GetIdentity(), false)
 
 	completedResp := &gen.RespondDecisionTaskCompletedResponse{}
 	if completeRequest.GetReturnNewDecisionTask() && histResp != nil && histResp.StartedResponse != nil {
@@ -1433,6 +1493,7 @@ func (wh *WorkflowHandler) RespondDecisionTaskCompleted(
 			RunID:           taskToken.RunID,
 			ScheduleID:      histResp.StartedResponse.GetScheduledEventId(),
 			ScheduleAttempt: histResp.StartedResponse.GetAttempt(),
+			TaskList:        taskToken.TaskList,
 		}
 		token, _ := wh.tokenSerializer.Serialize(taskToken)
 		workflowExecution := &gen.WorkflowExecution{
@@ -1522,9 +1583,52 @@ func (wh *WorkflowHandler) RespondDecisionTaskFailed(
 	if err != nil {
 		return wh.error(err, scope)
 	}
+	wh.recordTaskCompletion(domainEntry.GetInfo().Name, taskToken, gen.TaskListTypeDecision, failedRequest.GetIdentity(), true)
 	return nil
 }
 
+// recordTaskCompletion lets matching keep per poller statistics for the tasklist the task was dispatched from.
+// This is best effort and runs in the background so the respond call does not wait on matching, the
+// completion is dropped when too many calls are in flight already and a failure is only logged.
+func (wh *WorkflowHandler) recordTaskCompletion(
+	domainName string,
+	taskToken *common.TaskToken,
+	taskListType gen.TaskListType,
+	identity string,
+	failed bool,
+) {
+	if taskToken.TaskList == "" || !wh.config.EnablePollerTaskStats(domainName) {
+		return
+	}
+
+	request := &m.RecordTaskCompletionRequest{
+		DomainUUID:   common.StringPtr(taskToken.DomainID),
+		TaskList:     &gen.TaskList{Name: common.StringPtr(taskToken.TaskList)},
+		TaskListType: common.TaskListTypePtr(taskListType),
+		Identity:     common.StringPtr(identity),
+		Failed:       common.BoolPtr(failed),
+	}
+	select {
+	case wh.taskCompletionTokens <- struct{}{}:
+	default:
+		wh.GetThrottledLogger().Warn("Dropped task completion record as too many are in flight.",
+			tag.WorkflowDomainName(domainName),
+			tag.WorkflowTaskListName(taskToken.TaskList))
+		return
+	}
+	go func() {
+		defer func() { <-wh.taskCompletionTokens }()
+		ctx, cancel := context.WithTimeout(context.Background(), recordTaskCompletionTimeout)
+		defer cancel()
+		if err := wh.matching.RecordTaskCompletion(ctx, request); err != nil {
+			wh.GetThrottledLogger().Warn("Failed to record task completion on matching.",
+				tag.WorkflowDomainName(domainName),
+				tag.WorkflowTaskListName(taskToken.TaskList),
+				tag.Error(err))
+		}
+	}()
+}
+
 // RespondQueryTaskCompleted - response to a query task
 func (wh *WorkflowHandler) RespondQueryTaskCompleted(
 	ctx context.Context,
@@ -2798,7 +2902,11 @@ func (wh *WorkflowHandler) CountWorkflowExecutions(
 	}
 
 	resp = &gen.CountWorkflowExecutionsResponse{
-		Count: common.Int64Ptr(persistenceResp.Count),
+		Count:  common.Int64Ptr(persistenceResp.Count),
+		Groups: persistenceResp.Groups,
+	}
+	if persistenceResp.TruncatedCount > 0 {
+		resp.TruncatedCount = common.Int64Ptr(persistenceResp.TruncatedCount)
 	}
 	return resp, nil
 }
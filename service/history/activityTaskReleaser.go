@@ -0,0 +1,170 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	m "github.com/uber/cadence/.gen/go/matching"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/client/matching"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/persistence"
+)
+
+const (
+	activityTaskReleaseTimeout     = 5 * time.Second
+	activityTaskReleaseWorkerCount = 10
+	activityTaskReleaseQueueSize   = 10000
+)
+
+type (
+	// activityTaskReleaser reports closed activities to the task list partitions which dispatched
+	// them, to free their slots in the activity type limits of matching, shared by all shards of the host
+	activityTaskReleaser interface {
+		common.Daemon
+		// release queues the given requests. This is best effort, requests are dropped when the queue
+		// is full as matching also frees a slot once the start to close timeout of its task expires
+		release(requests []*m.RecordTaskCompletionRequest)
+	}
+
+	activityTaskReleaserImpl struct {
+		status         int32
+		matchingClient matching.Client
+		logger         log.Logger
+		requestCh      chan *m.RecordTaskCompletionRequest
+		shutdownCh     chan struct{}
+		shutdownWG     sync.WaitGroup
+	}
+)
+
+var _ activityTaskReleaser = (*activityTaskReleaserImpl)(nil)
+
+func newActivityTaskReleaser(
+	matchingClient matching.Client,
+	logger log.Logger,
+) *activityTaskReleaserImpl {
+
+	return &activityTaskReleaserImpl{
+		status:         common.DaemonStatusInitialized,
+		matchingClient: matchingClient,
+		logger:         logger,
+		requestCh:      make(chan *m.RecordTaskCompletionRequest, activityTaskReleaseQueueSize),
+		shutdownCh:     make(chan struct{}),
+	}
+}
+
+func (r *activityTaskReleaserImpl) Start() {
+	if !atomic.CompareAndSwapInt32(&r.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+	r.shutdownWG.Add(activityTaskReleaseWorkerCount)
+	for i := 0; i < activityTaskReleaseWorkerCount; i++ {
+		go r.processLoop()
+	}
+}
+
+func (r *activityTaskReleaserImpl) Stop() {
+	if !atomic.CompareAndSwapInt32(&r.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+	close(r.shutdownCh)
+	r.shutdownWG.Wait()
+}
+
+func (r *activityTaskReleaserImpl) release(requests []*m.RecordTaskCompletionRequest) {
+	for _, request := range requests {
+		select {
+		case r.requestCh <- request:
+		default:
+			r.logger.Warn("Dropped activity task release as the queue is full.",
+				tag.WorkflowID(request.Execution.GetWorkflowId()),
+				tag.WorkflowRunID(request.Execution.GetRunId()),
+				tag.WorkflowScheduleID(request.GetScheduleId()))
+		}
+	}
+}
+
+func (r *activityTaskReleaserImpl) processLoop() {
+	defer r.shutdownWG.Done()
+
+	for {
+		select {
+		case <-r.shutdownCh:
+			return
+		case request := <-r.requestCh:
+			ctx, cancel := context.WithTimeout(context.Background(), activityTaskReleaseTimeout)
+			err := r.matchingClient.RecordTaskCompletion(ctx, request)
+			cancel()
+			if err != nil {
+				r.logger.Warn("Failed to release activity task on matching.",
+					tag.WorkflowID(request.Execution.GetWorkflowId()),
+					tag.WorkflowRunID(request.Execution.GetRunId()),
+					tag.WorkflowScheduleID(request.GetScheduleId()),
+					tag.Error(err))
+			}
+		}
+	}
+}
+
+// newActivityTaskRelease returns the matching request which frees the slot held by the started task of
+// the given activity in the activity type limits of the partition which dispatched it, once the activity
+// is closed. Nil is returned when the activity was not started by this cluster, or when its activity type
+// has no max outstanding tasks configured, as the slot is then freed by matching on its own
+func newActivityTaskRelease(
+	config *Config,
+	mutableState mutableState,
+	ai *persistence.ActivityInfo,
+) *m.RecordTaskCompletionRequest {
+
+	if ai.StartedTaskList == "" {
+		return nil
+	}
+	activityType, _ := getActivityDispatchInfo(mutableState, ai.ScheduleID)
+	if activityType == "" {
+		return nil
+	}
+	domainName := mutableState.GetDomainEntry().GetInfo().Name
+	// the task may have been dispatched by another task list than the one it was scheduled on, when that
+	// task list is drained, so the limits are those of the task list the dispatching partition belongs to
+	startedTaskList := matching.TaskListBaseName(ai.StartedTaskList)
+	maxOutstanding := config.ActivityTypeMaxOutstanding(domainName, startedTaskList, persistence.TaskListTypeActivity)
+	if _, ok := maxOutstanding[activityType]; !ok {
+		return nil
+	}
+
+	executionInfo := mutableState.GetExecutionInfo()
+	return &m.RecordTaskCompletionRequest{
+		DomainUUID:   common.StringPtr(ai.DomainID),
+		TaskList:     &workflow.TaskList{Name: common.StringPtr(ai.StartedTaskList)},
+		TaskListType: common.TaskListTypePtr(workflow.TaskListTypeActivity),
+		Execution: &workflow.WorkflowExecution{
+			WorkflowId: common.StringPtr(executionInfo.WorkflowID),
+			RunId:      common.StringPtr(executionInfo.RunID),
+		},
+		ScheduleId: common.Int64Ptr(ai.ScheduleID),
+	}
+}
@@ -76,7 +76,11 @@ func newDecisionAttrValidator(
 		maxIDLengthLimit: config.MaxIDLengthLimit(),
 		searchAttributesValidator: validator.NewSearchAttributesValidator(
 			logger,
-			config.ValidSearchAttributes,
+			validator.NewSearchAttributesRegistry(
+				config.ValidSearchAttributes,
+				config.SearchAttributeStatus,
+				config.SearchAttributeAliases,
+			),
 			config.SearchAttributesNumberOfKeysLimit,
 			config.SearchAttributesSizeOfValueLimit,
 			config.SearchAttributesTotalSizeLimit,
@@ -156,6 +160,7 @@ func (c *workflowSizeChecker) failWorkflowSizeExceedsLimit() (bool, error) {
 			tag.WorkflowRunID(executionInfo.RunID),
 			tag.WorkflowHistorySize(historySize),
 			tag.WorkflowEventCount(historyCount))
+		c.metricsClient.IncCounter(metrics.HistoryRespondDecisionTaskCompletedScope, metrics.HistorySizeLimitExceededCounter)
 
 		attributes := &workflow.FailWorkflowExecutionDecisionAttributes{
 			Reason:  common.StringPtr(common.FailureReasonSizeExceedsLimit),
@@ -229,6 +234,14 @@ func (v *decisionAttrValidator) validateActivityScheduleAttributes(
 		return &workflow.BadRequestError{Message: "Domain exceeds length limit."}
 	}
 
+	if len(attributes.GetAffinityToken()) > v.maxIDLengthLimit {
+		return &workflow.BadRequestError{Message: "AffinityToken exceeds length limit."}
+	}
+
+	if strings.Contains(attributes.GetAffinityToken(), "/") {
+		return &workflow.BadRequestError{Message: "AffinityToken must not contain '/'."}
+	}
+
 	// Only attempt to deduce and fill in unspecified timeouts only when all timeouts are non-negative.
 	if attributes.GetScheduleToCloseTimeoutSeconds() < 0 || attributes.GetScheduleToStartTimeoutSeconds() < 0 ||
 		attributes.GetStartToCloseTimeoutSeconds() < 0 || attributes.GetHeartbeatTimeoutSeconds() < 0 {
@@ -472,15 +485,30 @@ func (v *decisionAttrValidator) validateUpsertWorkflowSearchAttributes(
 		return &workflow.BadRequestError{Message: "UpsertWorkflowSearchAttributesDecisionAttributes is not set on decision."}
 	}
 
-	if !attributes.IsSetSearchAttributes() {
-		return &workflow.BadRequestError{Message: "SearchAttributes is not set on decision."}
-	}
+	removedKeys := attributes.GetRemoveSearchAttributes()
+	if len(removedKeys) == 0 {
+		if !attributes.IsSetSearchAttributes() {
+			return &workflow.BadRequestError{Message: "SearchAttributes is not set on decision."}
+		}
 
-	if len(attributes.GetSearchAttributes().GetIndexedFields()) == 0 {
-		return &workflow.BadRequestError{Message: "IndexedFields is empty on decision."}
+		if len(attributes.GetSearchAttributes().GetIndexedFields()) == 0 {
+			return &workflow.BadRequestError{Message: "IndexedFields is empty on decision."}
+		}
 	}
 
-	return v.searchAttributesValidator.ValidateSearchAttributes(attributes.GetSearchAttributes(), domainName)
+	if err := v.searchAttributesValidator.ValidateSearchAttributes(attributes.GetSearchAttributes(), domainName); err != nil {
+		return err
+	}
+	if err := v.searchAttributesValidator.ValidateSearchAttributeKeys(removedKeys, domainName); err != nil {
+		return err
+	}
+	indexedFields := attributes.GetSearchAttributes().GetIndexedFields()
+	for _, key := range removedKeys {
+		if _, ok := indexedFields[key]; ok {
+			return &workflow.BadRequestError{Message: fmt.Sprintf("%s is both upserted and removed on decision.", key)}
+		}
+	}
+	return nil
 }
 
 func (v *decisionAttrValidator) validateContinueAsNewWorkflowExecutionAttributes(
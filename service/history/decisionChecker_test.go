@@ -74,6 +74,8 @@ func (s *decisionAttrValidatorSuite) SetupTest() {
 	config := &Config{
 		MaxIDLengthLimit:                  dynamicconfig.GetIntPropertyFn(1000),
 		ValidSearchAttributes:             dynamicconfig.GetMapPropertyFn(definition.GetDefaultIndexedKeys()),
+		SearchAttributeStatus:             dynamicconfig.GetMapPropertyFn(map[string]interface{}{}),
+		SearchAttributeAliases:            dynamicconfig.GetMapPropertyFn(map[string]interface{}{}),
 		SearchAttributesNumberOfKeysLimit: dynamicconfig.GetIntPropertyFilteredByDomain(100),
 		SearchAttributesSizeOfValueLimit:  dynamicconfig.GetIntPropertyFilteredByDomain(2 * 1024),
 		SearchAttributesTotalSizeLimit:    dynamicconfig.GetIntPropertyFilteredByDomain(40 * 1024),
@@ -152,6 +154,19 @@ func (s *decisionAttrValidatorSuite) TestValidateUpsertWorkflowSearchAttributes(
 	attributes.SearchAttributes.IndexedFields = map[string][]byte{"CustomKeywordField": []byte(`bytes`)}
 	err = s.validator.validateUpsertWorkflowSearchAttributes(domainName, attributes)
 	s.Nil(err)
+
+	attributes.RemoveSearchAttributes = []string{"CustomKeywordField"}
+	err = s.validator.validateUpsertWorkflowSearchAttributes(domainName, attributes)
+	s.EqualError(err, "BadRequestError{Message: CustomKeywordField is both upserted and removed on decision.}")
+
+	attributes.RemoveSearchAttributes = []string{"WorkflowID"}
+	err = s.validator.validateUpsertWorkflowSearchAttributes(domainName, attributes)
+	s.EqualError(err, "BadRequestError{Message: WorkflowID is read-only Cadence reservered attribute}")
+
+	attributes.SearchAttributes = nil
+	attributes.RemoveSearchAttributes = []string{"CustomKeywordField"}
+	err = s.validator.validateUpsertWorkflowSearchAttributes(domainName, attributes)
+	s.Nil(err)
 }
 
 func (s *decisionAttrValidatorSuite) TestValidateCrossDomainCall_LocalToLocal() {
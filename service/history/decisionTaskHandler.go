@@ -32,6 +32,7 @@ import (
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/service/dynamicconfig"
 )
 
 type (
@@ -207,6 +208,15 @@ func (handler *decisionTaskHandlerImpl) handleDecisionScheduleActivity(
 		return err
 	}
 
+	if handler.failDecisionIfPendingLimitExceeded(
+		len(handler.mutableState.GetPendingActivityInfos()),
+		handler.config.PendingActivitiesLimit,
+		metrics.PendingActivitiesLimitExceededCounter,
+		workflow.DecisionTaskFailedCausePendingActivitiesLimitExceeded,
+	) {
+		return nil
+	}
+
 	failWorkflow, err := handler.sizeLimitChecker.failWorkflowIfBlobSizeExceedsLimit(
 		attr.Input,
 		"ScheduleActivityTaskDecisionAttributes.Input exceeds size limit.",
@@ -301,6 +311,15 @@ func (handler *decisionTaskHandlerImpl) handleDecisionStartTimer(
 		return err
 	}
 
+	if handler.failDecisionIfPendingLimitExceeded(
+		len(handler.mutableState.GetPendingTimerInfos()),
+		handler.config.PendingTimersLimit,
+		metrics.PendingTimersLimitExceededCounter,
+		workflow.DecisionTaskFailedCausePendingTimersLimitExceeded,
+	) {
+		return nil
+	}
+
 	_, _, err := handler.mutableState.AddTimerStartedEvent(handler.decisionTaskCompletedID, attr)
 	switch err.(type) {
 	case nil:
@@ -736,6 +755,15 @@ func (handler *decisionTaskHandlerImpl) handleDecisionStartChildWorkflow(
 		return err
 	}
 
+	if handler.failDecisionIfPendingLimitExceeded(
+		len(handler.mutableState.GetPendingChildExecutionInfos()),
+		handler.config.PendingChildWorkflowsLimit,
+		metrics.PendingChildWorkflowsLimitExceededCounter,
+		workflow.DecisionTaskFailedCausePendingChildWorkflowsLimitExceeded,
+	) {
+		return nil
+	}
+
 	failWorkflow, err := handler.sizeLimitChecker.failWorkflowIfBlobSizeExceedsLimit(
 		attr.Input,
 		"StartChildWorkflowExecutionDecisionAttributes.Input exceeds size limit.",
@@ -933,6 +961,42 @@ func (handler *decisionTaskHandlerImpl) validateDecisionAttr(
 	return nil
 }
 
+// failDecisionIfPendingLimitExceeded fails the decision if the workflow already has as many pending
+// activities / child workflows / timers as the limit of the domain allows, 0 means no limit
+func (handler *decisionTaskHandlerImpl) failDecisionIfPendingLimitExceeded(
+	pendingCount int,
+	limit dynamicconfig.IntPropertyFnWithDomainFilter,
+	counter int,
+	failedCause workflow.DecisionTaskFailedCause,
+) bool {
+
+	domainName := handler.domainEntry.GetInfo().Name
+	pendingLimit := limit(domainName)
+	if pendingLimit <= 0 || pendingCount < pendingLimit {
+		return false
+	}
+
+	executionInfo := handler.mutableState.GetExecutionInfo()
+	handler.logger.Warn("Pending limit exceeded.",
+		tag.WorkflowDomainName(domainName),
+		tag.WorkflowID(executionInfo.WorkflowID),
+		tag.WorkflowRunID(executionInfo.RunID),
+		tag.WorkflowDecisionFailCause(int64(failedCause)),
+		tag.Counter(pendingCount))
+	handler.metricsClient.Scope(
+		metrics.HistoryRespondDecisionTaskCompletedScope,
+		metrics.DomainTag(domainName),
+	).IncCounter(counter)
+
+	// the decision is failed instead of the workflow, so the workflow can make progress
+	// once some pending activities / child workflows / timers are completed
+	handler.handlerFailDecision(
+		failedCause,
+		fmt.Sprintf("Pending count %v reaches the limit %v of the domain.", pendingCount, pendingLimit),
+	)
+	return true
+}
+
 func (handler *decisionTaskHandlerImpl) handlerFailDecision(
 	failedCause workflow.DecisionTaskFailedCause,
 	failMessage string,
@@ -39,6 +39,7 @@ import (
 	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/definition"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/membership"
@@ -72,6 +73,8 @@ type (
 		publisher               messaging.Producer
 		rateLimiter             quotas.Limiter
 		replicationTaskFetchers *ReplicationTaskFetchers
+		openWorkflowsCounter    openWorkflowsCounter
+		activityTaskReleaser    *activityTaskReleaserImpl
 		service.Service
 	}
 )
@@ -79,6 +82,9 @@ type (
 var _ historyserviceserver.Interface = (*Handler)(nil)
 var _ EngineFactory = (*Handler)(nil)
 
+// describeReplicationConcurrency is the max number of shards described at the same time by DescribeReplication
+const describeReplicationConcurrency = 16
+
 var (
 	errDomainNotSet            = &gen.BadRequestError{Message: "Domain not set on request."}
 	errWorkflowExecutionNotSet = &gen.BadRequestError{Message: "WorkflowExecution not set on request."}
@@ -86,6 +92,7 @@ var (
 	errWorkflowIDNotSet        = &gen.BadRequestError{Message: "WorkflowId is not set on request."}
 	errRunIDNotValid           = &gen.BadRequestError{Message: "RunID is not valid UUID."}
 	errSourceClusterNotSet     = &gen.BadRequestError{Message: "Source Cluster not set on request."}
+	errClusterNameNotSet       = &gen.BadRequestError{Message: "Cluster name not set on request."}
 	errShardIDNotSet           = &gen.BadRequestError{Message: "Shard ID not set on request."}
 	errTimestampNotSet         = &gen.BadRequestError{Message: "Timestamp not set on request."}
 	errHistoryHostThrottle     = &gen.ServiceBusyError{Message: "History host rps exceeded"}
@@ -183,6 +190,9 @@ func (h *Handler) Start() error {
 		h.domainCache, h.executionMgrFactory, h, h.config, h.GetLogger(), h.GetMetricsClient())
 	h.metricsClient = h.GetMetricsClient()
 	h.historyEventNotifier = newHistoryEventNotifier(h.Service.GetTimeSource(), h.GetMetricsClient(), h.config.GetShardID)
+	h.openWorkflowsCounter = newOpenWorkflowsCounter(h.publicClient, h.config, h.Service.GetTimeSource(), h.GetLogger())
+	h.activityTaskReleaser = newActivityTaskReleaser(h.matchingServiceClient, h.GetThrottledLogger())
+	h.activityTaskReleaser.Start()
 	// events notifier must starts before controller
 	h.historyEventNotifier.Start()
 	h.controller.Start()
@@ -196,6 +206,7 @@ func (h *Handler) Stop() {
 	h.replicationTaskFetchers.Stop()
 	h.domainCache.Stop()
 	h.controller.Stop()
+	h.activityTaskReleaser.Stop()
 	h.shardManager.Close()
 	if h.historyV2Mgr != nil {
 		h.historyV2Mgr.Close()
@@ -210,7 +221,8 @@ func (h *Handler) Stop() {
 // CreateEngine is implementation for HistoryEngineFactory used for creating the engine instance for shard
 func (h *Handler) CreateEngine(context ShardContext) Engine {
 	return NewEngineWithShardContext(context, h.visibilityMgr, h.matchingServiceClient, h.historyServiceClient,
-		h.publicClient, h.historyEventNotifier, h.publisher, h.config, h.replicationTaskFetchers, h.rawMatchingClient)
+		h.publicClient, h.historyEventNotifier, h.publisher, h.config, h.replicationTaskFetchers, h.rawMatchingClient,
+		h.openWorkflowsCounter, h.activityTaskReleaser)
 }
 
 // Health is for health check
@@ -1511,7 +1523,12 @@ func (h *Handler) GetReplicationMessages(
 				return
 			}
 
-			tasks, err := engine.GetReplicationMessages(ctx, token.GetLastRetrivedMessageId())
+			tasks, err := engine.GetReplicationMessages(
+				ctx,
+				request.GetClusterName(),
+				token.GetLastRetrivedMessageId(),
+				token.GetLastProcessedMessageId(),
+			)
 			if err != nil {
 				h.GetLogger().Warn("Failed to get replication tasks for shard", tag.Error(err))
 				return
@@ -1536,6 +1553,59 @@ func (h *Handler) GetReplicationMessages(
 	return &r.GetReplicationMessagesResponse{MessagesByShard: messagesByShard}, nil
 }
 
+// GetReplicationAckLevels is called by the domain failover coordinator to check how far a remote cluster
+// has processed the replication tasks of the given shards
+func (h *Handler) GetReplicationAckLevels(
+	ctx context.Context,
+	request *hist.GetReplicationAckLevelsRequest,
+) (resp *hist.GetReplicationAckLevelsResponse, retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryGetReplicationAckLevelsScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if request.GetClusterName() == "" {
+		return nil, h.error(errClusterNameNotSet, scope, "", "")
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(len(request.ShardIDs))
+	result := new(sync.Map)
+
+	for _, shardID := range request.ShardIDs {
+		go func(shardID int32) {
+			defer wg.Done()
+
+			engine, err := h.controller.getEngineForShard(int(shardID))
+			if err != nil {
+				h.GetLogger().Warn("History engine not found for shard", tag.Error(err))
+				return
+			}
+
+			ackLevel, err := engine.GetReplicationAckLevel(ctx, request.GetClusterName())
+			if err != nil {
+				h.GetLogger().Warn("Failed to get replication ack level for shard", tag.Error(err))
+				return
+			}
+
+			result.Store(shardID, ackLevel)
+		}(shardID)
+	}
+
+	wg.Wait()
+
+	ackLevelsByShard := make(map[int32]*hist.ReplicationAckLevel)
+	result.Range(func(key, value interface{}) bool {
+		ackLevelsByShard[key.(int32)] = value.(*hist.ReplicationAckLevel)
+		return true
+	})
+
+	return &hist.GetReplicationAckLevelsResponse{AckLevelsByShard: ackLevelsByShard}, nil
+}
+
 // ReapplyEvents applies stale events to the current workflow and the current run
 func (h *Handler) ReapplyEvents(
 	ctx context.Context,
@@ -1578,6 +1648,234 @@ func (h *Handler) ReapplyEvents(
 	return nil
 }
 
+// GetDLQReplicationMessages is called by remote peers to get replication messages for DLQ merging
+func (h *Handler) GetDLQReplicationMessages(
+	ctx context.Context,
+	request *r.GetDLQReplicationMessagesRequest,
+) (resp *r.GetDLQReplicationMessagesResponse, retError error) {
+
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryGetDLQReplicationMessagesScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	taskInfoPerExecution := map[definition.WorkflowIdentifier][]*r.ReplicationTaskInfo{}
+	// do batch based on workflow ID and run ID
+	for _, taskInfo := range request.GetTaskInfos() {
+		identity := definition.NewWorkflowIdentifier(
+			taskInfo.GetDomainID(),
+			taskInfo.GetWorkflowID(),
+			taskInfo.GetRunID(),
+		)
+		taskInfoPerExecution[identity] = append(taskInfoPerExecution[identity], taskInfo)
+	}
+
+	var replicationTasks []*r.ReplicationTask
+	for identity, taskInfos := range taskInfoPerExecution {
+		engine, err := h.controller.GetEngine(identity.WorkflowID)
+		if err != nil {
+			return nil, h.error(err, scope, identity.DomainID, identity.WorkflowID)
+		}
+
+		tasks, err := engine.GetDLQReplicationMessages(ctx, taskInfos)
+		if err != nil {
+			return nil, h.error(err, scope, identity.DomainID, identity.WorkflowID)
+		}
+		replicationTasks = append(replicationTasks, tasks...)
+	}
+
+	return &r.GetDLQReplicationMessagesResponse{
+		ReplicationTasks: replicationTasks,
+	}, nil
+}
+
+// ReadDLQMessages reads replication DLQ messages
+func (h *Handler) ReadDLQMessages(
+	ctx context.Context,
+	request *r.ReadDLQMessagesRequest,
+) (resp *r.ReadDLQMessagesResponse, retError error) {
+
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryReadDLQMessagesScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	engine, err := h.controller.getEngineForShard(int(request.GetShardID()))
+	if err != nil {
+		return nil, h.error(err, scope, "", "")
+	}
+
+	resp, err = engine.ReadDLQMessages(ctx, request)
+	if err != nil {
+		return nil, h.error(err, scope, "", "")
+	}
+	return resp, nil
+}
+
+// PurgeDLQMessages deletes replication DLQ messages
+func (h *Handler) PurgeDLQMessages(
+	ctx context.Context,
+	request *r.PurgeDLQMessagesRequest,
+) (retError error) {
+
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryPurgeDLQMessagesScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	engine, err := h.controller.getEngineForShard(int(request.GetShardID()))
+	if err != nil {
+		return h.error(err, scope, "", "")
+	}
+
+	if err := engine.PurgeDLQMessages(ctx, request); err != nil {
+		return h.error(err, scope, "", "")
+	}
+	return nil
+}
+
+// MergeDLQMessages reads and applies replication DLQ messages
+func (h *Handler) MergeDLQMessages(
+	ctx context.Context,
+	request *r.MergeDLQMessagesRequest,
+) (resp *r.MergeDLQMessagesResponse, retError error) {
+
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryMergeDLQMessagesScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	engine, err := h.controller.getEngineForShard(int(request.GetShardID()))
+	if err != nil {
+		return nil, h.error(err, scope, "", "")
+	}
+
+	resp, err = engine.MergeDLQMessages(ctx, request)
+	if err != nil {
+		return nil, h.error(err, scope, "", "")
+	}
+	return resp, nil
+}
+
+// DescribeReplication returns the replication status of the given shards for the given source cluster
+func (h *Handler) DescribeReplication(
+	ctx context.Context,
+	request *r.DescribeReplicationRequest,
+) (resp *r.DescribeReplicationResponse, retError error) {
+
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryDescribeReplicationScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if request.GetSourceCluster() == "" {
+		return nil, h.error(errSourceClusterNotSet, scope, "", "")
+	}
+
+	// each shard reads the size of its DLQ from persistence, so the
+	// number of shards described at the same time is bounded
+	shardIDs := make(chan int32, len(request.ShardIDs))
+	for _, shardID := range request.ShardIDs {
+		shardIDs <- shardID
+	}
+	close(shardIDs)
+
+	concurrency := common.MinInt(len(request.ShardIDs), describeReplicationConcurrency)
+	var wg sync.WaitGroup
+	wg.Add(concurrency)
+	result := new(sync.Map)
+	errChan := make(chan error, len(request.ShardIDs))
+
+	for i := 0; i < concurrency; i++ {
+		go func() {
+			defer wg.Done()
+
+			for shardID := range shardIDs {
+				engine, err := h.controller.getEngineForShard(int(shardID))
+				if err != nil {
+					h.GetLogger().Warn("History engine not found for shard", tag.Error(err))
+					errChan <- err
+					continue
+				}
+
+				status, err := engine.DescribeReplication(ctx, request.GetSourceCluster(), request.GetDomainID())
+				if err != nil {
+					h.GetLogger().Warn("Failed to describe replication for shard", tag.Error(err))
+					errChan <- err
+					continue
+				}
+
+				result.Store(shardID, status)
+			}
+		}()
+	}
+
+	wg.Wait()
+	close(errChan)
+
+	resp = &r.DescribeReplicationResponse{SourceCluster: request.SourceCluster}
+	result.Range(func(key, value interface{}) bool {
+		resp.Shards = append(resp.Shards, value.(*r.ShardReplicationStatus))
+		return true
+	})
+	if len(resp.Shards) == 0 {
+		if err, ok := <-errChan; ok {
+			return nil, h.error(err, scope, request.GetDomainID(), "")
+		}
+	}
+	return resp, nil
+}
+
+// BackfillReplication replicates the history of an open workflow of a domain promoted from local to global
+func (h *Handler) BackfillReplication(
+	ctx context.Context,
+	request *hist.BackfillReplicationRequest,
+) (retError error) {
+
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryBackfillReplicationScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	domainID := request.GetDomainUUID()
+	if domainID == "" {
+		return h.error(errDomainNotSet, scope, domainID, "")
+	}
+	execution := request.GetExecution()
+	if execution == nil || execution.GetWorkflowId() == "" {
+		return h.error(errWorkflowExecutionNotSet, scope, domainID, "")
+	}
+
+	workflowID := execution.GetWorkflowId()
+	engine, err := h.controller.GetEngine(workflowID)
+	if err != nil {
+		return h.error(err, scope, domainID, workflowID)
+	}
+
+	if err := engine.BackfillReplication(ctx, domainID, *execution); err != nil {
+		return h.error(err, scope, domainID, workflowID)
+	}
+	return nil
+}
+
 // convertError is a helper method to convert ShardOwnershipLostError from persistence layer returned by various
 // HistoryEngine API calls to ShardOwnershipLost error return by HistoryService for client to be redirected to the
 // correct shard.
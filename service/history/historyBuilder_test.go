@@ -779,7 +779,7 @@ func (s *historyBuilderSuite) addActivityTaskScheduledEvent(decisionCompletedID
 func (s *historyBuilderSuite) addActivityTaskStartedEvent(scheduleID int64, taskList,
 	identity string) *workflow.HistoryEvent {
 	ai, _ := s.msBuilder.GetActivityInfo(scheduleID)
-	event, err := s.msBuilder.AddActivityTaskStartedEvent(ai, scheduleID, uuid.New(), identity)
+	event, err := s.msBuilder.AddActivityTaskStartedEvent(ai, scheduleID, uuid.New(), identity, taskList)
 	s.Nil(err)
 	return event
 }
@@ -95,9 +95,16 @@ type (
 		ReplicateEventsV2(ctx ctx.Context, request *h.ReplicateEventsV2Request) error
 		SyncShardStatus(ctx ctx.Context, request *h.SyncShardStatusRequest) error
 		SyncActivity(ctx ctx.Context, request *h.SyncActivityRequest) error
-		GetReplicationMessages(ctx ctx.Context, taskID int64) (*r.ReplicationMessages, error)
+		GetReplicationMessages(ctx ctx.Context, pollingCluster string, lastReadMessageID int64, lastProcessedMessageID int64) (*r.ReplicationMessages, error)
+		GetReplicationAckLevel(ctx ctx.Context, pollingCluster string) (*h.ReplicationAckLevel, error)
 		QueryWorkflow(ctx ctx.Context, request *h.QueryWorkflowRequest) (*h.QueryWorkflowResponse, error)
 		ReapplyEvents(ctx ctx.Context, domainUUID string, workflowID string, runID string, events []*workflow.HistoryEvent) error
+		GetDLQReplicationMessages(ctx ctx.Context, taskInfos []*r.ReplicationTaskInfo) ([]*r.ReplicationTask, error)
+		ReadDLQMessages(ctx ctx.Context, messagesRequest *r.ReadDLQMessagesRequest) (*r.ReadDLQMessagesResponse, error)
+		PurgeDLQMessages(ctx ctx.Context, messagesRequest *r.PurgeDLQMessagesRequest) error
+		MergeDLQMessages(ctx ctx.Context, messagesRequest *r.MergeDLQMessagesRequest) (*r.MergeDLQMessagesResponse, error)
+		DescribeReplication(ctx ctx.Context, sourceCluster string, domainID string) (*r.ShardReplicationStatus, error)
+		BackfillReplication(ctx ctx.Context, domainUUID string, execution workflow.WorkflowExecution) error
 
 		NotifyNewHistoryEvent(event *historyEventNotification)
 		NotifyNewTransferTasks(tasks []persistence.Task)
@@ -136,6 +143,8 @@ type (
 		eventsReapplier           nDCEventsReapplier
 		matchingClient            matching.Client
 		rawMatchingClient         matching.Client
+		openWorkflowsCounter      openWorkflowsCounter
+		activityTaskReleaser      activityTaskReleaser
 	}
 )
 
@@ -178,6 +187,14 @@ var (
 	ErrConsistentQueryNotEnabled = &workflow.BadRequestError{Message: "cluster or domain does not enable strongly consistent query but strongly consistent query was requested"}
 	// ErrConsistentQueryBufferExceeded is error indicating that too many consistent queries have been buffered and until buffered queries are finished new consistent queries cannot be buffered
 	ErrConsistentQueryBufferExceeded = &workflow.InternalServiceError{Message: "consistent query buffer is full, cannot accept new consistent queries"}
+	// ErrDomainDraining is error indicating that the domain stops accepting new workflows and signals until its graceful failover is handed over
+	ErrDomainDraining = &workflow.ServiceBusyError{Message: "domain is draining for graceful failover"}
+	// ErrOpenWorkflowsLimitExceeded is the error indicating limit reached for maximum number of open workflows of the domain
+	ErrOpenWorkflowsLimitExceeded = &workflow.LimitExceededError{Message: "exceeded domain limit for open workflow executions"}
+	// ErrDomainHistorySizeLimitExceeded is the error indicating limit reached for history size of the domain on the shard
+	ErrDomainHistorySizeLimitExceeded = &workflow.LimitExceededError{Message: "exceeded domain limit for history size on the shard"}
+	// ErrOpenWorkflowsCountNotAvailable is the error indicating the open workflows limit cannot be enforced, since the domain is not counted yet
+	ErrOpenWorkflowsCountNotAvailable = &workflow.ServiceBusyError{Message: "open workflow executions of the domain are not counted yet"}
 
 	// FailedWorkflowCloseState is a set of failed workflow close states, used for start workflow policy
 	// for start workflow execution API
@@ -201,6 +218,8 @@ func NewEngineWithShardContext(
 	config *Config,
 	replicationTaskFetchers *ReplicationTaskFetchers,
 	rawMatchingClient matching.Client,
+	openWorkflowsCounter openWorkflowsCounter,
+	activityTaskReleaser activityTaskReleaser,
 ) Engine {
 	currentClusterName := shard.GetService().GetClusterMetadata().GetCurrentClusterName()
 
@@ -231,9 +250,11 @@ func NewEngineWithShardContext(
 			shard.GetConfig().ArchiveRequestRPS,
 			shard.GetService().GetArchiverProvider(),
 		),
-		publicClient:      publicClient,
-		matchingClient:    matching,
-		rawMatchingClient: rawMatchingClient,
+		publicClient:         publicClient,
+		openWorkflowsCounter: openWorkflowsCounter,
+		activityTaskReleaser: activityTaskReleaser,
+		matchingClient:       matching,
+		rawMatchingClient:    rawMatchingClient,
 	}
 
 	historyEngImpl.txProcessor = newTransferQueueProcessor(shard, historyEngImpl, visibilityMgr, matching, historyClient, logger)
@@ -487,7 +508,7 @@ func (e *historyEngineImpl) StartWorkflowExecution(
 	startRequest *h.StartWorkflowExecutionRequest,
 ) (resp *workflow.StartWorkflowExecutionResponse, retError error) {
 
-	domainEntry, err := e.getActiveDomainEntry(startRequest.DomainUUID)
+	domainEntry, err := e.getActiveDomainEntryForNewWork(startRequest.DomainUUID)
 	if err != nil {
 		return nil, err
 	}
@@ -499,6 +520,15 @@ func (e *historyEngineImpl) StartWorkflowExecution(
 		return nil, err
 	}
 	e.overrideStartWorkflowExecutionRequest(domainEntry, request, metrics.HistoryStartWorkflowExecutionScope)
+	if err := e.checkOpenWorkflowsLimit(domainEntry, metrics.HistoryStartWorkflowExecutionScope); err != nil {
+		return nil, err
+	}
+	// child workflows are not rejected, since the parent cannot record the start failure with a cause
+	if startRequest.ParentExecutionInfo == nil {
+		if err := e.checkDomainHistorySizeLimit(domainEntry, metrics.HistoryStartWorkflowExecutionScope); err != nil {
+			return nil, err
+		}
+	}
 
 	workflowID := request.GetWorkflowId()
 	// grab the current context as a lock, nothing more
@@ -606,6 +636,7 @@ func (e *historyEngineImpl) StartWorkflowExecution(
 	if err != nil {
 		return nil, err
 	}
+	e.recordOpenWorkflowStarted(domainEntry)
 	return &workflow.StartWorkflowExecutionResponse{
 		RunId: execution.RunId,
 	}, nil
@@ -1263,7 +1294,7 @@ func (e *historyEngineImpl) RecordActivityTaskStarted(
 			}
 
 			if _, err := mutableState.AddActivityTaskStartedEvent(
-				ai, scheduleID, requestID, request.PollRequest.GetIdentity(),
+				ai, scheduleID, requestID, request.PollRequest.GetIdentity(), request.PollRequest.TaskList.GetName(),
 			); err != nil {
 				return err
 			}
@@ -1340,7 +1371,8 @@ func (e *historyEngineImpl) RespondActivityTaskCompleted(
 		RunId:      common.StringPtr(token.RunID),
 	}
 
-	return e.updateWorkflowExecution(ctx, domainID, workflowExecution, true,
+	var activityRelease *m.RecordTaskCompletionRequest
+	err = e.updateWorkflowExecution(ctx, domainID, workflowExecution, true,
 		func(mutableState mutableState) error {
 			if !mutableState.IsWorkflowExecutionRunning() {
 				return ErrWorkflowCompleted
@@ -1367,12 +1399,18 @@ func (e *historyEngineImpl) RespondActivityTaskCompleted(
 				return ErrActivityTaskNotFound
 			}
 
+			// the release needs the scheduled event of the activity, which is no longer pending once completed
+			activityRelease = newActivityTaskRelease(e.config, mutableState, ai)
 			if _, err := mutableState.AddActivityTaskCompletedEvent(scheduleID, ai.StartedID, request); err != nil {
 				// Unable to add ActivityTaskCompleted event to history
 				return &workflow.InternalServiceError{Message: "Unable to add ActivityTaskCompleted event to history."}
 			}
 			return nil
 		})
+	if err == nil && activityRelease != nil {
+		e.activityTaskReleaser.release([]*m.RecordTaskCompletionRequest{activityRelease})
+	}
+	return err
 }
 
 // RespondActivityTaskFailed completes an activity task failure.
@@ -1398,7 +1436,8 @@ func (e *historyEngineImpl) RespondActivityTaskFailed(
 		RunId:      common.StringPtr(token.RunID),
 	}
 
-	return e.updateWorkflowExecutionWithAction(ctx, domainID, workflowExecution,
+	var activityRelease *m.RecordTaskCompletionRequest
+	err = e.updateWorkflowExecutionWithAction(ctx, domainID, workflowExecution,
 		func(mutableState mutableState) (*updateWorkflowAction, error) {
 			if !mutableState.IsWorkflowExecutionRunning() {
 				return nil, ErrWorkflowCompleted
@@ -1426,6 +1465,8 @@ func (e *historyEngineImpl) RespondActivityTaskFailed(
 			}
 
 			postActions := &updateWorkflowAction{}
+			// the failed attempt frees its slot whether the activity is retried or not
+			activityRelease = newActivityTaskRelease(e.config, mutableState, ai)
 			ok, err := mutableState.RetryActivity(ai, req.FailedRequest.GetReason(), req.FailedRequest.GetDetails())
 			if err != nil {
 				return nil, err
@@ -1441,6 +1482,10 @@ func (e *historyEngineImpl) RespondActivityTaskFailed(
 
 			return postActions, nil
 		})
+	if err == nil && activityRelease != nil {
+		e.activityTaskReleaser.release([]*m.RecordTaskCompletionRequest{activityRelease})
+	}
+	return err
 }
 
 // RespondActivityTaskCanceled completes an activity task failure.
@@ -1466,7 +1511,8 @@ func (e *historyEngineImpl) RespondActivityTaskCanceled(
 		RunId:      common.StringPtr(token.RunID),
 	}
 
-	return e.updateWorkflowExecution(ctx, domainID, workflowExecution, true,
+	var activityRelease *m.RecordTaskCompletionRequest
+	err = e.updateWorkflowExecution(ctx, domainID, workflowExecution, true,
 		func(mutableState mutableState) error {
 			if !mutableState.IsWorkflowExecutionRunning() {
 				return ErrWorkflowCompleted
@@ -1493,6 +1539,7 @@ func (e *historyEngineImpl) RespondActivityTaskCanceled(
 				return ErrActivityTaskNotFound
 			}
 
+			activityRelease = newActivityTaskRelease(e.config, mutableState, ai)
 			if _, err := mutableState.AddActivityTaskCanceledEvent(
 				scheduleID,
 				ai.StartedID,
@@ -1505,7 +1552,10 @@ func (e *historyEngineImpl) RespondActivityTaskCanceled(
 
 			return nil
 		})
-
+	if err == nil && activityRelease != nil {
+		e.activityTaskReleaser.release([]*m.RecordTaskCompletionRequest{activityRelease})
+	}
+	return err
 }
 
 // RecordActivityTaskHeartbeat records an hearbeat for a task.
@@ -1644,7 +1694,7 @@ func (e *historyEngineImpl) SignalWorkflowExecution(
 	signalRequest *h.SignalWorkflowExecutionRequest,
 ) error {
 
-	domainEntry, err := e.getActiveDomainEntry(signalRequest.DomainUUID)
+	domainEntry, err := e.getActiveDomainEntryForNewWork(signalRequest.DomainUUID)
 	if err != nil {
 		return err
 	}
@@ -1679,6 +1729,10 @@ func (e *historyEngineImpl) SignalWorkflowExecution(
 				tag.WorkflowID(execution.GetWorkflowId()),
 				tag.WorkflowRunID(execution.GetRunId()),
 				tag.WorkflowDomainID(domainID))
+			e.metricsClient.Scope(
+				metrics.HistorySignalWorkflowExecutionScope,
+				metrics.DomainTag(domainEntry.GetInfo().Name),
+			).IncCounter(metrics.SignalsLimitExceededCounter)
 			return nil, ErrSignalsLimitExceeded
 		}
 
@@ -1715,7 +1769,7 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 	signalWithStartRequest *h.SignalWithStartWorkflowExecutionRequest,
 ) (retResp *workflow.StartWorkflowExecutionResponse, retError error) {
 
-	domainEntry, err := e.getActiveDomainEntry(signalWithStartRequest.DomainUUID)
+	domainEntry, err := e.getActiveDomainEntryForNewWork(signalWithStartRequest.DomainUUID)
 	if err != nil {
 		return nil, err
 	}
@@ -1756,6 +1810,10 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 					tag.WorkflowID(execution.GetWorkflowId()),
 					tag.WorkflowRunID(execution.GetRunId()),
 					tag.WorkflowDomainID(domainID))
+				e.metricsClient.Scope(
+					metrics.HistorySignalWithStartWorkflowExecutionScope,
+					metrics.DomainTag(domainEntry.GetInfo().Name),
+				).IncCounter(metrics.SignalsLimitExceededCounter)
 				return nil, ErrSignalsLimitExceeded
 			}
 
@@ -1802,6 +1860,12 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 		return nil, err
 	}
 	e.overrideStartWorkflowExecutionRequest(domainEntry, request, metrics.HistorySignalWorkflowExecutionScope)
+	if err := e.checkOpenWorkflowsLimit(domainEntry, metrics.HistorySignalWithStartWorkflowExecutionScope); err != nil {
+		return nil, err
+	}
+	if err := e.checkDomainHistorySizeLimit(domainEntry, metrics.HistorySignalWithStartWorkflowExecutionScope); err != nil {
+		return nil, err
+	}
 
 	workflowID := request.GetWorkflowId()
 	// grab the current context as a lock, nothing more
@@ -1920,6 +1984,7 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 	if err != nil {
 		return nil, err
 	}
+	e.recordOpenWorkflowStarted(domainEntry)
 	return &workflow.StartWorkflowExecutionResponse{
 		RunId: execution.RunId,
 	}, nil
@@ -2463,6 +2528,70 @@ func validateStartWorkflowExecutionRequest(
 	return common.ValidateRetryPolicy(request.RetryPolicy)
 }
 
+// checkOpenWorkflowsLimit rejects starting a new workflow if the domain already has
+// as many open workflows as the limit of the domain allows, 0 means no limit.
+// Starts are rejected as well while the domain has not been counted successfully.
+func (e *historyEngineImpl) checkOpenWorkflowsLimit(
+	domainEntry *cache.DomainCacheEntry,
+	metricsScope int,
+) error {
+
+	domainName := domainEntry.GetInfo().Name
+	limit := e.config.OpenWorkflowsLimit(domainName)
+	if limit <= 0 {
+		return nil
+	}
+	count, ok := e.openWorkflowsCounter.getCount(domainName)
+	if !ok {
+		e.metricsClient.Scope(metricsScope, metrics.DomainTag(domainName)).IncCounter(metrics.OpenWorkflowsCountNotAvailableCounter)
+		return ErrOpenWorkflowsCountNotAvailable
+	}
+	if count < int64(limit) {
+		return nil
+	}
+
+	e.throttledLogger.Warn("Open workflows limit exceeded.",
+		tag.WorkflowDomainName(domainName),
+		tag.Counter(int(count)))
+	e.metricsClient.Scope(metricsScope, metrics.DomainTag(domainName)).IncCounter(metrics.OpenWorkflowsLimitExceededCounter)
+	return ErrOpenWorkflowsLimitExceeded
+}
+
+// checkDomainHistorySizeLimit rejects starting a new workflow if the history retained by the domain
+// on this shard exceeds the limit of the domain, 0 means no limit
+func (e *historyEngineImpl) checkDomainHistorySizeLimit(
+	domainEntry *cache.DomainCacheEntry,
+	metricsScope int,
+) error {
+
+	domainName := domainEntry.GetInfo().Name
+	limit := e.config.DomainHistorySizeLimitPerShard(domainName)
+	if limit <= 0 {
+		return nil
+	}
+	size := e.shard.GetDomainHistorySize(domainEntry.GetInfo().ID)
+	if size < int64(limit) {
+		return nil
+	}
+
+	e.throttledLogger.Warn("Domain history size limit exceeded.",
+		tag.WorkflowDomainName(domainName),
+		tag.ShardID(e.shard.GetShardID()),
+		tag.WorkflowHistorySizeBytes(int(size)))
+	e.metricsClient.Scope(metricsScope, metrics.DomainTag(domainName)).IncCounter(metrics.DomainHistorySizeLimitExceededCounter)
+	return ErrDomainHistorySizeLimitExceeded
+}
+
+func (e *historyEngineImpl) recordOpenWorkflowStarted(
+	domainEntry *cache.DomainCacheEntry,
+) {
+
+	domainName := domainEntry.GetInfo().Name
+	if e.config.OpenWorkflowsLimit(domainName) > 0 {
+		e.openWorkflowsCounter.recordStarted(domainName)
+	}
+}
+
 func (e *historyEngineImpl) overrideStartWorkflowExecutionRequest(
 	domainEntry *cache.DomainCacheEntry,
 	request *workflow.StartWorkflowExecutionRequest,
@@ -2524,6 +2653,23 @@ func getActiveDomainEntryFromShard(
 	return domainEntry, nil
 }
 
+// getActiveDomainEntryForNewWork is getActiveDomainEntry for the APIs which start workflows or
+// signal them. These are rejected while the domain is drained by a graceful failover, all other
+// APIs are still served so that in-flight decisions and activities complete and the drain ends
+func (e *historyEngineImpl) getActiveDomainEntryForNewWork(
+	domainUUID *string,
+) (*cache.DomainCacheEntry, error) {
+
+	domainEntry, err := e.getActiveDomainEntry(domainUUID)
+	if err != nil {
+		return nil, err
+	}
+	if domainEntry.IsDomainDraining() {
+		return nil, ErrDomainDraining
+	}
+	return domainEntry, nil
+}
+
 func getScheduleID(
 	activityID string,
 	mutableState mutableState,
@@ -2657,12 +2803,22 @@ func getWorkflowAlreadyStartedError(errMsg string, createRequestID string, workf
 	}
 }
 
-func (e *historyEngineImpl) GetReplicationMessages(ctx ctx.Context, taskID int64) (*r.ReplicationMessages, error) {
+func (e *historyEngineImpl) GetReplicationMessages(
+	ctx ctx.Context,
+	pollingCluster string,
+	lastReadMessageID int64,
+	lastProcessedMessageID int64,
+) (*r.ReplicationMessages, error) {
+
 	scope := metrics.HistoryGetReplicationMessagesScope
 	sw := e.metricsClient.StartTimer(scope, metrics.GetReplicationMessagesForShardLatency)
 	defer sw.Stop()
 
-	replicationMessages, err := e.replicatorProcessor.getTasks(ctx, taskID)
+	if pollingCluster != "" {
+		e.replicatorProcessor.updateRemoteAckLevel(pollingCluster, lastProcessedMessageID)
+	}
+
+	replicationMessages, err := e.replicatorProcessor.getTasks(ctx, lastReadMessageID)
 	if err != nil {
 		e.logger.Error("Failed to retrieve replication messages.", tag.Error(err))
 		return nil, err
@@ -2672,6 +2828,262 @@ func (e *historyEngineImpl) GetReplicationMessages(ctx ctx.Context, taskID int64
 	return replicationMessages, nil
 }
 
+func (e *historyEngineImpl) GetReplicationAckLevel(
+	ctx ctx.Context,
+	pollingCluster string,
+) (*h.ReplicationAckLevel, error) {
+
+	// the domain notification version must be loaded before the max read level, so any task
+	// created before the shard observed a domain change is covered by the max read level
+	domainNotificationVersion := e.shard.GetDomainNotificationVersion()
+	maxReadLevel := e.shard.GetTransferMaxReadLevel()
+	ackLevel, err := e.replicatorProcessor.getRemoteAckLevel(pollingCluster, maxReadLevel)
+	if err != nil {
+		return nil, err
+	}
+
+	return &h.ReplicationAckLevel{
+		DomainNotificationVersion: common.Int64Ptr(domainNotificationVersion),
+		MaxReadLevel:              common.Int64Ptr(maxReadLevel),
+		AckLevel:                  common.Int64Ptr(ackLevel),
+	}, nil
+}
+
+func (e *historyEngineImpl) GetDLQReplicationMessages(
+	ctx ctx.Context,
+	taskInfos []*r.ReplicationTaskInfo,
+) ([]*r.ReplicationTask, error) {
+
+	scope := metrics.HistoryGetDLQReplicationMessagesScope
+	sw := e.metricsClient.StartTimer(scope, metrics.GetDLQReplicationMessagesLatency)
+	defer sw.Stop()
+
+	tasks := make([]*r.ReplicationTask, 0, len(taskInfos))
+	for _, taskInfo := range taskInfos {
+		task, err := e.replicatorProcessor.getTask(ctx, taskInfo)
+		if err != nil {
+			e.logger.Error("Failed to fetch DLQ replication messages.", tag.Error(err))
+			return nil, err
+		}
+		if task != nil {
+			tasks = append(tasks, task)
+		}
+	}
+
+	return tasks, nil
+}
+
+func (e *historyEngineImpl) ReadDLQMessages(
+	ctx ctx.Context,
+	request *r.ReadDLQMessagesRequest,
+) (*r.ReadDLQMessagesResponse, error) {
+
+	processor, err := e.getReplicationTaskProcessor(request.GetType(), request.GetSourceCluster())
+	if err != nil {
+		return nil, err
+	}
+
+	tasks, token, err := processor.readDLQMessages(
+		request.GetInclusiveEndMessageID(),
+		int(request.GetMaximumPageSize()),
+		request.GetNextPageToken(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &r.ReadDLQMessagesResponse{
+		Type:             request.Type,
+		ReplicationTasks: tasks,
+		NextPageToken:    token,
+	}, nil
+}
+
+func (e *historyEngineImpl) PurgeDLQMessages(
+	ctx ctx.Context,
+	request *r.PurgeDLQMessagesRequest,
+) error {
+
+	processor, err := e.getReplicationTaskProcessor(request.GetType(), request.GetSourceCluster())
+	if err != nil {
+		return err
+	}
+
+	return processor.purgeDLQMessages(request.GetInclusiveEndMessageID())
+}
+
+func (e *historyEngineImpl) MergeDLQMessages(
+	ctx ctx.Context,
+	request *r.MergeDLQMessagesRequest,
+) (*r.MergeDLQMessagesResponse, error) {
+
+	processor, err := e.getReplicationTaskProcessor(request.GetType(), request.GetSourceCluster())
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := processor.mergeDLQMessages(
+		ctx,
+		request.GetInclusiveEndMessageID(),
+		int(request.GetMaximumPageSize()),
+		request.GetNextPageToken(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &r.MergeDLQMessagesResponse{
+		NextPageToken: token,
+	}, nil
+}
+
+func (e *historyEngineImpl) DescribeReplication(
+	ctx ctx.Context,
+	sourceCluster string,
+	domainID string,
+) (*r.ShardReplicationStatus, error) {
+
+	processor, err := e.findReplicationTaskProcessor(sourceCluster)
+	if err != nil {
+		return nil, err
+	}
+
+	return processor.describeReplication(domainID)
+}
+
+func (e *historyEngineImpl) BackfillReplication(
+	ctx ctx.Context,
+	domainUUID string,
+	execution workflow.WorkflowExecution,
+) error {
+
+	domainEntry, err := e.getActiveDomainEntry(common.StringPtr(domainUUID))
+	if err != nil {
+		return err
+	}
+	if !domainEntry.IsGlobalDomain() {
+		return &workflow.BadRequestError{Message: "Domain is not a global domain."}
+	}
+	if domainEntry.GetReplicationPolicy() != cache.ReplicationPolicyMultiCluster {
+		// nothing to replicate
+		return nil
+	}
+
+	return e.updateWorkflowExecutionWithAction(
+		ctx,
+		domainEntry.GetInfo().ID,
+		execution,
+		func(mutableState mutableState) (*updateWorkflowAction, error) {
+
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return &updateWorkflowAction{
+					noop: true,
+				}, nil
+			}
+
+			replicationTasks, err := e.getBackfillReplicationTasks(mutableState)
+			if err != nil {
+				return nil, err
+			}
+			if len(replicationTasks) == 0 {
+				return &updateWorkflowAction{
+					noop: true,
+				}, nil
+			}
+			mutableState.AddReplicationTasks(replicationTasks...)
+			return &updateWorkflowAction{}, nil
+		})
+}
+
+// getBackfillReplicationTasks returns one history replication task per event batch,
+// and one sync activity task per pending activity of the workflow.
+// Only workflows started before the domain is promoted have events of the empty version,
+// no task is returned for other workflows. The tasks carry the version recorded for the
+// events in the version history, which the events are stamped with when replicated.
+func (e *historyEngineImpl) getBackfillReplicationTasks(
+	mutableState mutableState,
+) ([]persistence.Task, error) {
+
+	versionHistories := mutableState.GetVersionHistories()
+	if versionHistories == nil {
+		return nil, nil
+	}
+	versionHistory, err := versionHistories.GetCurrentVersionHistory()
+	if err != nil {
+		return nil, err
+	}
+	branchToken := versionHistory.GetBranchToken()
+
+	var replicationTasks []persistence.Task
+	request := &persistence.ReadHistoryBranchRequest{
+		BranchToken: branchToken,
+		MinEventID:  common.FirstEventID,
+		MaxEventID:  mutableState.GetNextEventID(),
+		PageSize:    defaultHistoryPageSize,
+		ShardID:     common.IntPtr(e.shard.GetShardID()),
+	}
+	for {
+		response, err := e.historyV2Mgr.ReadHistoryBranchByBatch(request)
+		if err != nil {
+			return nil, err
+		}
+		for _, batch := range response.History {
+			firstEvent := batch.Events[0]
+			lastEvent := batch.Events[len(batch.Events)-1]
+			if firstEvent.GetEventId() == common.FirstEventID && firstEvent.GetVersion() != common.EmptyVersion {
+				// workflow is started after the domain is promoted, it is replicated already
+				return nil, nil
+			}
+			version, err := versionHistory.GetEventVersion(firstEvent.GetEventId())
+			if err != nil {
+				return nil, err
+			}
+			replicationTasks = append(replicationTasks, &persistence.HistoryReplicationTask{
+				FirstEventID: firstEvent.GetEventId(),
+				NextEventID:  lastEvent.GetEventId() + 1,
+				Version:      version,
+				BranchToken:  branchToken,
+			})
+		}
+		request.NextPageToken = response.NextPageToken
+		if len(request.NextPageToken) == 0 {
+			break
+		}
+	}
+
+	for _, activityInfo := range mutableState.GetPendingActivityInfos() {
+		replicationTasks = append(replicationTasks, &persistence.SyncActivityTask{
+			Version:     activityInfo.Version,
+			ScheduledID: activityInfo.ScheduleID,
+		})
+	}
+	return replicationTasks, nil
+}
+
+func (e *historyEngineImpl) getReplicationTaskProcessor(
+	dlqType r.DLQType,
+	sourceCluster string,
+) (*ReplicationTaskProcessor, error) {
+
+	if dlqType != r.DLQTypeReplication {
+		return nil, ErrUnknownDLQType
+	}
+
+	return e.findReplicationTaskProcessor(sourceCluster)
+}
+
+func (e *historyEngineImpl) findReplicationTaskProcessor(
+	sourceCluster string,
+) (*ReplicationTaskProcessor, error) {
+
+	for _, processor := range e.replicationTaskProcessors {
+		if processor.sourceCluster == sourceCluster {
+			return processor, nil
+		}
+	}
+	return nil, &workflow.BadRequestError{
+		Message: fmt.Sprintf("No replication task processor found for source cluster: %v", sourceCluster),
+	}
+}
+
 func (e *historyEngineImpl) ReapplyEvents(
 	ctx ctx.Context,
 	domainUUID string,
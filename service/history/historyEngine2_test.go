@@ -33,6 +33,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"github.com/uber-go/tally"
+	"go.uber.org/cadence/.gen/go/cadence/workflowservicetest"
 
 	h "github.com/uber/cadence/.gen/go/history"
 	"github.com/uber/cadence/.gen/go/history/historyservicetest"
@@ -50,6 +51,7 @@ import (
 	"github.com/uber/cadence/common/mocks"
 	p "github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/service/dynamicconfig"
 	"github.com/uber/cadence/service/worker/archiver"
 )
 
@@ -953,6 +955,54 @@ func (s *engine2Suite) TestStartWorkflowExecution_BrandNew() {
 	s.NotNil(resp.RunId)
 }
 
+func (s *engine2Suite) TestStartWorkflowExecution_OpenWorkflowsCountNotAvailable() {
+	mockPublicClient := workflowservicetest.NewMockClient(s.controller)
+	mockPublicClient.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).
+		Return(nil, errors.New("some random error")).AnyTimes()
+	s.historyEngine.openWorkflowsCounter = newOpenWorkflowsCounter(mockPublicClient, s.config, clock.NewRealTimeSource(), s.logger)
+	openWorkflowsLimit := s.config.OpenWorkflowsLimit
+	s.config.OpenWorkflowsLimit = dynamicconfig.GetIntPropertyFilteredByDomain(10)
+	defer func() { s.config.OpenWorkflowsLimit = openWorkflowsLimit }()
+
+	_, err := s.historyEngine.StartWorkflowExecution(context.Background(), s.newStartWorkflowExecutionRequest())
+	s.Equal(ErrOpenWorkflowsCountNotAvailable, err)
+}
+
+func (s *engine2Suite) TestStartWorkflowExecution_DomainHistorySizeLimitExceeded() {
+	domainHistorySizeLimit := s.config.DomainHistorySizeLimitPerShard
+	s.config.DomainHistorySizeLimitPerShard = dynamicconfig.GetIntPropertyFilteredByDomain(100)
+	defer func() { s.config.DomainHistorySizeLimitPerShard = domainHistorySizeLimit }()
+
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 100}, nil).Once()
+	s.mockExecutionMgr.On("CreateWorkflowExecution", mock.Anything).Return(&p.CreateWorkflowExecutionResponse{}, nil).Once()
+	_, err := s.historyEngine.StartWorkflowExecution(context.Background(), s.newStartWorkflowExecutionRequest())
+	s.Nil(err)
+	s.Equal(int64(100), s.historyEngine.shard.GetDomainHistorySize(testDomainID))
+
+	_, err = s.historyEngine.StartWorkflowExecution(context.Background(), s.newStartWorkflowExecutionRequest())
+	s.Equal(ErrDomainHistorySizeLimitExceeded, err)
+
+	// deleting workflows of the domain releases its history size
+	s.historyEngine.shard.ReleaseDomainHistorySize(testDomainID, 100)
+	s.Equal(int64(0), s.historyEngine.shard.GetDomainHistorySize(testDomainID))
+}
+
+func (s *engine2Suite) newStartWorkflowExecutionRequest() *h.StartWorkflowExecutionRequest {
+	return &h.StartWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(testDomainID),
+		StartRequest: &workflow.StartWorkflowExecutionRequest{
+			Domain:                              common.StringPtr(testDomainID),
+			WorkflowId:                          common.StringPtr(uuid.New()),
+			WorkflowType:                        &workflow.WorkflowType{Name: common.StringPtr("workflowType")},
+			TaskList:                            &workflow.TaskList{Name: common.StringPtr("testTaskList")},
+			ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(1),
+			TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(2),
+			Identity:                            common.StringPtr("testIdentity"),
+			RequestId:                           common.StringPtr(uuid.New()),
+		},
+	}
+}
+
 func (s *engine2Suite) TestStartWorkflowExecution_StillRunning_Dedup() {
 	domainID := testDomainID
 	workflowID := "workflowID"
@@ -45,6 +45,9 @@ type (
 	ReplicatorQueueProcessor interface {
 		queueProcessor
 		getTasks(ctx context.Context, readLevel int64) (*replicator.ReplicationMessages, error)
+		getTask(ctx context.Context, taskInfo *replicator.ReplicationTaskInfo) (*replicator.ReplicationTask, error)
+		updateRemoteAckLevel(cluster string, ackLevel int64)
+		getRemoteAckLevel(cluster string, maxReadLevel int64) (int64, error)
 	}
 
 	queueAckMgr interface {
@@ -477,18 +477,33 @@ func (mr *MockEngineMockRecorder) SyncActivity(ctx, request interface{}) *gomock
 }
 
 // GetReplicationMessages mocks base method
-func (m *MockEngine) GetReplicationMessages(ctx context.Context, taskID int64) (*replicator.ReplicationMessages, error) {
+func (m *MockEngine) GetReplicationMessages(ctx context.Context, pollingCluster string, lastReadMessageID, lastProcessedMessageID int64) (*replicator.ReplicationMessages, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetReplicationMessages", ctx, taskID)
+	ret := m.ctrl.Call(m, "GetReplicationMessages", ctx, pollingCluster, lastReadMessageID, lastProcessedMessageID)
 	ret0, _ := ret[0].(*replicator.ReplicationMessages)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetReplicationMessages indicates an expected call of GetReplicationMessages
-func (mr *MockEngineMockRecorder) GetReplicationMessages(ctx, taskID interface{}) *gomock.Call {
+func (mr *MockEngineMockRecorder) GetReplicationMessages(ctx, pollingCluster, lastReadMessageID, lastProcessedMessageID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationMessages", reflect.TypeOf((*MockEngine)(nil).GetReplicationMessages), ctx, taskID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationMessages", reflect.TypeOf((*MockEngine)(nil).GetReplicationMessages), ctx, pollingCluster, lastReadMessageID, lastProcessedMessageID)
+}
+
+// GetReplicationAckLevel mocks base method
+func (m *MockEngine) GetReplicationAckLevel(ctx context.Context, pollingCluster string) (*history.ReplicationAckLevel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReplicationAckLevel", ctx, pollingCluster)
+	ret0, _ := ret[0].(*history.ReplicationAckLevel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReplicationAckLevel indicates an expected call of GetReplicationAckLevel
+func (mr *MockEngineMockRecorder) GetReplicationAckLevel(ctx, pollingCluster interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationAckLevel", reflect.TypeOf((*MockEngine)(nil).GetReplicationAckLevel), ctx, pollingCluster)
 }
 
 // QueryWorkflow mocks base method
@@ -520,6 +535,94 @@ func (mr *MockEngineMockRecorder) ReapplyEvents(ctx, domainUUID, workflowID, run
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReapplyEvents", reflect.TypeOf((*MockEngine)(nil).ReapplyEvents), ctx, domainUUID, workflowID, runID, events)
 }
 
+// GetDLQReplicationMessages mocks base method
+func (m *MockEngine) GetDLQReplicationMessages(ctx context.Context, taskInfos []*replicator.ReplicationTaskInfo) ([]*replicator.ReplicationTask, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDLQReplicationMessages", ctx, taskInfos)
+	ret0, _ := ret[0].([]*replicator.ReplicationTask)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDLQReplicationMessages indicates an expected call of GetDLQReplicationMessages
+func (mr *MockEngineMockRecorder) GetDLQReplicationMessages(ctx, taskInfos interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDLQReplicationMessages", reflect.TypeOf((*MockEngine)(nil).GetDLQReplicationMessages), ctx, taskInfos)
+}
+
+// ReadDLQMessages mocks base method
+func (m *MockEngine) ReadDLQMessages(ctx context.Context, messagesRequest *replicator.ReadDLQMessagesRequest) (*replicator.ReadDLQMessagesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadDLQMessages", ctx, messagesRequest)
+	ret0, _ := ret[0].(*replicator.ReadDLQMessagesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadDLQMessages indicates an expected call of ReadDLQMessages
+func (mr *MockEngineMockRecorder) ReadDLQMessages(ctx, messagesRequest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadDLQMessages", reflect.TypeOf((*MockEngine)(nil).ReadDLQMessages), ctx, messagesRequest)
+}
+
+// PurgeDLQMessages mocks base method
+func (m *MockEngine) PurgeDLQMessages(ctx context.Context, messagesRequest *replicator.PurgeDLQMessagesRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDLQMessages", ctx, messagesRequest)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeDLQMessages indicates an expected call of PurgeDLQMessages
+func (mr *MockEngineMockRecorder) PurgeDLQMessages(ctx, messagesRequest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDLQMessages", reflect.TypeOf((*MockEngine)(nil).PurgeDLQMessages), ctx, messagesRequest)
+}
+
+// MergeDLQMessages mocks base method
+func (m *MockEngine) MergeDLQMessages(ctx context.Context, messagesRequest *replicator.MergeDLQMessagesRequest) (*replicator.MergeDLQMessagesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeDLQMessages", ctx, messagesRequest)
+	ret0, _ := ret[0].(*replicator.MergeDLQMessagesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MergeDLQMessages indicates an expected call of MergeDLQMessages
+func (mr *MockEngineMockRecorder) MergeDLQMessages(ctx, messagesRequest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeDLQMessages", reflect.TypeOf((*MockEngine)(nil).MergeDLQMessages), ctx, messagesRequest)
+}
+
+// DescribeReplication mocks base method
+func (m *MockEngine) DescribeReplication(ctx context.Context, sourceCluster, domainID string) (*replicator.ShardReplicationStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeReplication", ctx, sourceCluster, domainID)
+	ret0, _ := ret[0].(*replicator.ShardReplicationStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeReplication indicates an expected call of DescribeReplication
+func (mr *MockEngineMockRecorder) DescribeReplication(ctx, sourceCluster, domainID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeReplication", reflect.TypeOf((*MockEngine)(nil).DescribeReplication), ctx, sourceCluster, domainID)
+}
+
+// BackfillReplication mocks base method
+func (m *MockEngine) BackfillReplication(ctx context.Context, domainUUID string, execution shared.WorkflowExecution) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BackfillReplication", ctx, domainUUID, execution)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BackfillReplication indicates an expected call of BackfillReplication
+func (mr *MockEngineMockRecorder) BackfillReplication(ctx, domainUUID, execution interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackfillReplication", reflect.TypeOf((*MockEngine)(nil).BackfillReplication), ctx, domainUUID, execution)
+}
+
 // NotifyNewHistoryEvent mocks base method
 func (m *MockEngine) NotifyNewHistoryEvent(event *historyEventNotification) {
 	m.ctrl.T.Helper()
@@ -42,6 +42,7 @@ import (
 
 	"github.com/uber/cadence/.gen/go/history"
 	"github.com/uber/cadence/.gen/go/history/historyservicetest"
+	"github.com/uber/cadence/.gen/go/matching"
 	"github.com/uber/cadence/.gen/go/matching/matchingservicetest"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
@@ -234,6 +235,7 @@ func (s *engineSuite) SetupTest() {
 	s.mockReplicationProcessor.EXPECT().notifyNewTask().AnyTimes()
 	s.mockTimerProcessor.EXPECT().NotifyNewTimers(gomock.Any(), gomock.Any()).AnyTimes()
 	s.mockEventsCache.EXPECT().putEvent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	s.mockMatchingClient.EXPECT().RecordTaskCompletion(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
 	s.mockArchivalClient = &archiver.ClientMock{}
 	s.mockVisibilityMgr = &mocks.VisibilityManager{}
@@ -306,6 +308,7 @@ func (s *engineSuite) SetupTest() {
 		historyEventNotifier: historyEventNotifier,
 		config:               NewDynamicConfigForTest(),
 		archivalClient:       s.mockArchivalClient,
+		matchingClient:       s.mockMatchingClient,
 		txProcessor:          s.mockTxProcessor,
 		replicatorProcessor:  s.mockReplicationProcessor,
 		timerProcessor:       s.mockTimerProcessor,
@@ -1802,6 +1805,80 @@ func (s *engineSuite) TestRespondDecisionTaskCompletedSingleActivityScheduledDec
 	s.Equal(int32(5), *activity1Attributes.HeartbeatTimeoutSeconds)
 }
 
+func (s *engineSuite) TestRespondDecisionTaskCompleted_PendingActivitiesLimitExceeded() {
+
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(testRunID),
+	}
+	tl := "testTaskList"
+	identity := "testIdentity"
+
+	s.mockHistoryEngine.config.PendingActivitiesLimit = dynamicconfig.GetIntPropertyFilteredByDomain(1)
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	di1 := addDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent1 := addDecisionTaskStartedEvent(msBuilder, di1.ScheduleID, tl, identity)
+	decisionCompletedEvent1 := addDecisionTaskCompletedEvent(msBuilder, di1.ScheduleID,
+		*decisionStartedEvent1.EventId, nil, identity)
+	addActivityTaskScheduledEvent(msBuilder, *decisionCompletedEvent1.EventId, "activity1",
+		"activity_type1", tl, []byte("input1"), 100, 10, 5)
+	di2 := addDecisionTaskScheduledEvent(msBuilder)
+	addDecisionTaskStartedEvent(msBuilder, di2.ScheduleID, tl, identity)
+
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: *we.WorkflowId,
+		RunID:      *we.RunId,
+		ScheduleID: di2.ScheduleID,
+	})
+
+	decisions := []*workflow.Decision{{
+		DecisionType: common.DecisionTypePtr(workflow.DecisionTypeScheduleActivityTask),
+		ScheduleActivityTaskDecisionAttributes: &workflow.ScheduleActivityTaskDecisionAttributes{
+			ActivityId:                    common.StringPtr("activity2"),
+			ActivityType:                  &workflow.ActivityType{Name: common.StringPtr("activity_type2")},
+			TaskList:                      &workflow.TaskList{Name: &tl},
+			Input:                         []byte("input2"),
+			ScheduleToCloseTimeoutSeconds: common.Int32Ptr(100),
+			ScheduleToStartTimeoutSeconds: common.Int32Ptr(10),
+			StartToCloseTimeoutSeconds:    common.Int32Ptr(50),
+			HeartbeatTimeoutSeconds:       common.Int32Ptr(5),
+		},
+	}}
+
+	var failedCause *workflow.DecisionTaskFailedCause
+	gwmsResponse1 := &persistence.GetWorkflowExecutionResponse{State: createMutableState(msBuilder)}
+	gwmsResponse2 := &persistence.GetWorkflowExecutionResponse{State: createMutableState(msBuilder)}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse1, nil).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse2, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Run(func(arguments mock.Arguments) {
+		req := arguments.Get(0).(*persistence.AppendHistoryNodesRequest)
+		for _, event := range req.Events {
+			if event.GetEventType() == workflow.EventTypeDecisionTaskFailed {
+				failedCause = event.DecisionTaskFailedEventAttributes.Cause
+			}
+		}
+	}).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	_, err := s.mockHistoryEngine.RespondDecisionTaskCompleted(context.Background(), &history.RespondDecisionTaskCompletedRequest{
+		DomainUUID: common.StringPtr(testDomainID),
+		CompleteRequest: &workflow.RespondDecisionTaskCompletedRequest{
+			TaskToken: taskToken,
+			Decisions: decisions,
+			Identity:  &identity,
+		},
+	})
+	s.Nil(err)
+	s.Equal(workflow.DecisionTaskFailedCausePendingActivitiesLimitExceeded.Ptr(), failedCause)
+
+	executionBuilder := s.getBuilder(testDomainID, we)
+	s.Equal(persistence.WorkflowStateRunning, executionBuilder.GetExecutionInfo().State)
+	s.Equal(1, len(executionBuilder.GetPendingActivityInfos()))
+}
+
 func (s *engineSuite) TestRespondDecisionTaskCompleted_DecisionHeartbeatTimeout() {
 
 	we := workflow.WorkflowExecution{
@@ -2838,7 +2915,11 @@ func (s *engineSuite) TestRespondActivityTaskCompletedByIdSuccess() {
 		*decisionStartedEvent.EventId, nil, identity)
 	activityScheduledEvent, _ := addActivityTaskScheduledEvent(msBuilder, *decisionCompletedEvent.EventId, activityID,
 		activityType, tl, activityInput, 100, 10, 5)
-	addActivityTaskStartedEvent(msBuilder, *activityScheduledEvent.EventId, identity)
+	// the task is dispatched by a partition of the task list the scheduled task list is drained into
+	drainTarget := "drain-target-tl"
+	partition := "/__cadence_sys/" + drainTarget + "/1"
+	ai, _ := msBuilder.GetActivityInfo(*activityScheduledEvent.EventId)
+	msBuilder.AddActivityTaskStartedEvent(ai, *activityScheduledEvent.EventId, testRunID, identity, partition)
 
 	ms := createMutableState(msBuilder)
 	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
@@ -2849,6 +2930,25 @@ func (s *engineSuite) TestRespondActivityTaskCompletedByIdSuccess() {
 	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Once()
 	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
 
+	// the slot of the activity type limits is freed on the dispatching partition even when the task token has no task list
+	s.mockHistoryEngine.config.ActivityTypeMaxOutstanding = func(domain string, taskList string, taskType int) map[string]interface{} {
+		if taskList != drainTarget {
+			return nil
+		}
+		return map[string]interface{}{activityType: 10}
+	}
+	releases := make(chan *matching.RecordTaskCompletionRequest, 1)
+	mockMatchingClient := matchingservicetest.NewMockClient(s.controller)
+	mockMatchingClient.EXPECT().RecordTaskCompletion(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, request *matching.RecordTaskCompletionRequest, opts ...interface{}) error {
+			releases <- request
+			return nil
+		}).Times(1)
+	activityTaskReleaser := newActivityTaskReleaser(mockMatchingClient, s.logger)
+	activityTaskReleaser.Start()
+	defer activityTaskReleaser.Stop()
+	s.mockHistoryEngine.activityTaskReleaser = activityTaskReleaser
+
 	err := s.mockHistoryEngine.RespondActivityTaskCompleted(context.Background(), &history.RespondActivityTaskCompletedRequest{
 		DomainUUID: common.StringPtr(testDomainID),
 		CompleteRequest: &workflow.RespondActivityTaskCompletedRequest{
@@ -2869,6 +2969,16 @@ func (s *engineSuite) TestRespondActivityTaskCompletedByIdSuccess() {
 	s.Equal(int32(100), di.DecisionTimeout)
 	s.Equal(int64(8), di.ScheduleID)
 	s.Equal(common.EmptyEventID, di.StartedID)
+
+	select {
+	case request := <-releases:
+		s.Equal(partition, request.TaskList.GetName())
+		s.Equal(workflow.TaskListTypeActivity, request.GetTaskListType())
+		s.Equal(we.GetRunId(), request.Execution.GetRunId())
+		s.Equal(*activityScheduledEvent.EventId, request.GetScheduleId())
+	case <-time.After(time.Second):
+		s.Fail("activity task was not released on matching")
+	}
 }
 
 func (s *engineSuite) TestRespondActivityTaskFailedInvalidToken() {
@@ -5050,7 +5160,7 @@ func addActivityTaskScheduledEventWithRetry(
 
 func addActivityTaskStartedEvent(builder mutableState, scheduleID int64, identity string) *workflow.HistoryEvent {
 	ai, _ := builder.GetActivityInfo(scheduleID)
-	event, _ := builder.AddActivityTaskStartedEvent(ai, scheduleID, testRunID, identity)
+	event, _ := builder.AddActivityTaskStartedEvent(ai, scheduleID, testRunID, identity, ai.TaskList)
 	return event
 }
 
@@ -5383,6 +5493,7 @@ func copyActivityInfo(sourceInfo *persistence.ActivityInfo) *persistence.Activit
 		LastFailureReason:        sourceInfo.LastFailureReason,
 		LastWorkerIdentity:       sourceInfo.LastWorkerIdentity,
 		LastFailureDetails:       sourceInfo.LastFailureDetails,
+		StartedTaskList:          sourceInfo.StartedTaskList,
 		//// Not written to database - This is used only for deduping heartbeat timer creation
 		// LastHeartbeatTimeoutVisibility: sourceInfo.LastHeartbeatTimeoutVisibility,
 	}
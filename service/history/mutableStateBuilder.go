@@ -21,8 +21,10 @@
 package history
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/pborman/uuid"
@@ -125,6 +127,10 @@ type (
 		// record if a event has been applied to mutable state
 		// TODO: persist this to db
 		appliedEvents map[string]struct{}
+		// activity type names of pending activities, loaded lazily from activity scheduled events
+		pendingActivityTypes map[int64]string
+		// last failure reason of activities in this transaction, for search attribute CadenceLastFailureReason
+		activityFailureReason *string
 
 		insertTransferTasks    []persistence.Task
 		insertReplicationTasks []persistence.Task
@@ -185,6 +191,7 @@ func newMutableStateBuilder(
 		nextEventIDInDB:       0,
 		domainEntry:           domainEntry,
 		appliedEvents:         make(map[string]struct{}),
+		pendingActivityTypes:  make(map[int64]string),
 
 		queryRegistry: newQueryRegistry(),
 
@@ -1247,6 +1254,9 @@ func (e *mutableStateBuilder) ReplicateActivityInfo(
 		ai.StartedTime = time.Unix(0, request.GetStartedTime())
 	}
 	ai.Details = request.GetDetails()
+	if request.GetAttempt() != ai.Attempt && request.GetLastFailureReason() != "" {
+		e.activityFailureReason = common.StringPtr(request.GetLastFailureReason())
+	}
 	ai.Attempt = request.GetAttempt()
 	ai.LastFailureReason = request.GetLastFailureReason()
 	ai.LastWorkerIdentity = request.GetLastWorkerIdentity()
@@ -2137,6 +2147,7 @@ func (e *mutableStateBuilder) ReplicateActivityTaskScheduledEvent(
 	}
 
 	e.pendingActivityInfoIDs[scheduleEventID] = ai
+	e.pendingActivityTypes[scheduleEventID] = attributes.ActivityType.GetName()
 	e.pendingActivityIDToEventID[ai.ActivityID] = scheduleEventID
 	e.updateActivityInfos[ai] = struct{}{}
 
@@ -2166,6 +2177,7 @@ func (e *mutableStateBuilder) AddActivityTaskStartedEvent(
 	scheduleEventID int64,
 	requestID string,
 	identity string,
+	taskList string,
 ) (*workflow.HistoryEvent, error) {
 
 	opTag := tag.WorkflowActionActivityTaskStarted
@@ -2173,6 +2185,9 @@ func (e *mutableStateBuilder) AddActivityTaskStartedEvent(
 		return nil, err
 	}
 
+	// the task list partition which dispatched the task is only known to the active cluster,
+	// it is used to free the slot of the task in the activity type limits of that partition
+	ai.StartedTaskList = taskList
 	if !ai.HasRetryPolicy {
 		event := e.hBuilder.AddActivityTaskStartedEvent(scheduleEventID, ai.Attempt, requestID, identity)
 		if err := e.ReplicateActivityTaskStartedEvent(event); err != nil {
@@ -2293,6 +2308,7 @@ func (e *mutableStateBuilder) ReplicateActivityTaskFailedEvent(
 
 	attributes := event.ActivityTaskFailedEventAttributes
 	scheduleID := attributes.GetScheduledEventId()
+	e.activityFailureReason = common.StringPtr(attributes.GetReason())
 
 	return e.DeleteActivity(scheduleID)
 }
@@ -2339,6 +2355,7 @@ func (e *mutableStateBuilder) ReplicateActivityTaskTimedOutEvent(
 
 	attributes := event.ActivityTaskTimedOutEventAttributes
 	scheduleID := attributes.GetScheduledEventId()
+	e.activityFailureReason = common.StringPtr(timerTypeToReason(timerTypeFromThrift(attributes.GetTimeoutType())))
 
 	return e.DeleteActivity(scheduleID)
 }
@@ -2867,10 +2884,14 @@ func (e *mutableStateBuilder) ReplicateUpsertWorkflowSearchAttributesEvent(
 	event *workflow.HistoryEvent,
 ) {
 
-	upsertSearchAttr := event.UpsertWorkflowSearchAttributesEventAttributes.GetSearchAttributes().GetIndexedFields()
+	attributes := event.UpsertWorkflowSearchAttributesEventAttributes
+	upsertSearchAttr := attributes.GetSearchAttributes().GetIndexedFields()
 	currentSearchAttr := e.GetExecutionInfo().SearchAttributes
 
 	e.executionInfo.SearchAttributes = mergeMapOfByteArray(currentSearchAttr, upsertSearchAttr)
+	for _, key := range attributes.GetRemoveSearchAttributes() {
+		delete(e.executionInfo.SearchAttributes, key)
+	}
 }
 
 func mergeMapOfByteArray(
@@ -3754,10 +3775,12 @@ func (e *mutableStateBuilder) RetryActivity(
 	ai.StartedID = common.EmptyEventID
 	ai.RequestID = ""
 	ai.StartedTime = time.Time{}
+	ai.StartedTaskList = ""
 	ai.TimerTaskStatus = timerTaskStatusNone
 	ai.LastFailureReason = failureReason
 	ai.LastWorkerIdentity = ai.StartedIdentity
 	ai.LastFailureDetails = failureDetails
+	e.activityFailureReason = common.StringPtr(failureReason)
 
 	if err := e.taskGenerator.generateActivityRetryTasks(
 		ai.ScheduleID,
@@ -3789,6 +3812,13 @@ func (e *mutableStateBuilder) AddTimerTasks(
 	e.insertTimerTasks = append(e.insertTimerTasks, timerTasks...)
 }
 
+func (e *mutableStateBuilder) AddReplicationTasks(
+	replicationTasks ...persistence.Task,
+) {
+
+	e.insertReplicationTasks = append(e.insertReplicationTasks, replicationTasks...)
+}
+
 func (e *mutableStateBuilder) SetUpdateCondition(
 	nextEventIDInDB int64,
 ) {
@@ -3819,6 +3849,9 @@ func (e *mutableStateBuilder) StartTransaction(
 ) (bool, error) {
 
 	e.domainEntry = domainEntry
+	if err := e.backfillVersionHistories(); err != nil {
+		return false, err
+	}
 	if err := e.UpdateCurrentVersion(domainEntry.GetFailoverVersion(), false); err != nil {
 		return false, err
 	}
@@ -3831,6 +3864,68 @@ func (e *mutableStateBuilder) StartTransaction(
 	return flushBeforeReady, nil
 }
 
+// backfillVersionHistories rebuilds the version histories from the history events,
+// for workflows started before the domain is promoted from local domain to global domain.
+// Those events carry the empty version, they are recorded with the initial failover version
+// of the current cluster, which is the cluster the local domain belonged to.
+func (e *mutableStateBuilder) backfillVersionHistories() error {
+
+	if !e.domainEntry.IsGlobalDomain() ||
+		e.replicationState != nil ||
+		e.versionHistories != nil {
+		return nil
+	}
+
+	branchToken := e.executionInfo.BranchToken
+	if len(branchToken) == 0 {
+		return &workflow.InternalServiceError{
+			Message: "unable to backfill version histories of workflow without branch token",
+		}
+	}
+
+	promotedVersion := e.clusterMetadata.GetNextFailoverVersion(e.clusterMetadata.GetCurrentClusterName(), 0)
+	versionHistory := persistence.NewVersionHistory(branchToken, nil)
+	request := &persistence.ReadHistoryBranchRequest{
+		BranchToken: branchToken,
+		MinEventID:  common.FirstEventID,
+		MaxEventID:  e.GetNextEventID(),
+		PageSize:    defaultHistoryPageSize,
+		ShardID:     common.IntPtr(e.shard.GetShardID()),
+	}
+	for {
+		response, err := e.shard.GetHistoryManager().ReadHistoryBranchByBatch(request)
+		if err != nil {
+			return err
+		}
+		for _, batch := range response.History {
+			lastEvent := batch.Events[len(batch.Events)-1]
+			version := lastEvent.GetVersion()
+			if version == common.EmptyVersion {
+				version = promotedVersion
+			}
+			if err := versionHistory.AddOrUpdateItem(persistence.NewVersionHistoryItem(
+				lastEvent.GetEventId(), version,
+			)); err != nil {
+				return err
+			}
+		}
+		request.NextPageToken = response.NextPageToken
+		if len(request.NextPageToken) == 0 {
+			break
+		}
+	}
+
+	for _, ai := range e.pendingActivityInfoIDs {
+		if ai.Version == common.EmptyVersion {
+			ai.Version = promotedVersion
+			e.updateActivityInfos[ai] = struct{}{}
+		}
+	}
+
+	e.versionHistories = persistence.NewVersionHistories(versionHistory)
+	return nil
+}
+
 func (e *mutableStateBuilder) CloseTransactionAsMutation(
 	now time.Time,
 	transactionPolicy transactionPolicy,
@@ -4013,6 +4108,12 @@ func (e *mutableStateBuilder) prepareCloseTransaction(
 		}
 	}
 
+	if err := e.closeTransactionHandleStateSearchAttributes(
+		now,
+	); err != nil {
+		return err
+	}
+
 	// TODO merge active & passive task generation
 	// NOTE: this function must be the last call
 	//  since we only generate at most one activity & user timer,
@@ -4047,6 +4148,8 @@ func (e *mutableStateBuilder) cleanupTransaction(
 	e.updateSignalInfos = make(map[*persistence.SignalInfo]struct{})
 	e.deleteSignalInfo = nil
 
+	e.activityFailureReason = nil
+
 	e.updateSignalRequestedIDs = make(map[string]struct{})
 	e.deleteSignalRequestedID = ""
 
@@ -4482,6 +4585,110 @@ func (e *mutableStateBuilder) closeTransactionHandleActivityUserTimerTasks(
 	)
 }
 
+// closeTransactionHandleStateSearchAttributes updates the search attributes of pending activities and child workflows,
+// and generates the upsert task if any of them is changed while the workflow is running.
+// Values are reset when the workflow is closed, which are recorded by the close execution task.
+func (e *mutableStateBuilder) closeTransactionHandleStateSearchAttributes(
+	now time.Time,
+) error {
+
+	if e.config.AdvancedVisibilityWritingMode() == common.AdvancedVisibilityWritingModeOff ||
+		!e.config.EnableStateSearchAttributes(e.GetDomainEntry().GetInfo().Name) {
+		return nil
+	}
+
+	isRunning := e.IsWorkflowExecutionRunning()
+	if isRunning &&
+		len(e.updateActivityInfos) == 0 && len(e.deleteActivityInfos) == 0 &&
+		len(e.updateChildExecutionInfos) == 0 && e.deleteChildExecutionInfo == nil &&
+		e.activityFailureReason == nil {
+		return nil
+	}
+
+	searchAttributes, err := e.getStateSearchAttributes(isRunning)
+	if err != nil {
+		return err
+	}
+
+	if e.executionInfo.SearchAttributes == nil {
+		e.executionInfo.SearchAttributes = make(map[string][]byte)
+	}
+	updated := false
+	for key, value := range searchAttributes {
+		if !bytes.Equal(e.executionInfo.SearchAttributes[key], value) {
+			e.executionInfo.SearchAttributes[key] = value
+			updated = true
+		}
+	}
+
+	if !updated || !isRunning {
+		return nil
+	}
+	return e.taskGenerator.generateWorkflowSearchAttrTasks(
+		e.unixNanoToTime(now.UnixNano()),
+	)
+}
+
+func (e *mutableStateBuilder) getStateSearchAttributes(
+	isRunning bool,
+) (map[string][]byte, error) {
+
+	activityTypes := []string{}
+	maxActivityAttempt := int32(0)
+	pendingChildCount := 0
+	if isRunning {
+		pendingActivityTypes := make(map[int64]string, len(e.pendingActivityInfoIDs))
+		typeSet := make(map[string]struct{})
+		for scheduleID, ai := range e.pendingActivityInfoIDs {
+			if ai.Attempt > maxActivityAttempt {
+				maxActivityAttempt = ai.Attempt
+			}
+			activityType, ok := e.pendingActivityTypes[scheduleID]
+			if !ok {
+				scheduledEvent, err := e.GetActivityScheduledEvent(scheduleID)
+				if err != nil {
+					// search attributes are best effort and must not fail the transaction,
+					// the type is looked up again in the next transaction
+					e.logger.Warn("Failed to get activity scheduled event for state search attributes.",
+						tag.WorkflowScheduleID(scheduleID),
+						tag.Error(err),
+					)
+					continue
+				}
+				activityType = scheduledEvent.ActivityTaskScheduledEventAttributes.ActivityType.GetName()
+			}
+			pendingActivityTypes[scheduleID] = activityType
+
+			if _, ok := typeSet[activityType]; !ok {
+				typeSet[activityType] = struct{}{}
+				activityTypes = append(activityTypes, activityType)
+			}
+		}
+		e.pendingActivityTypes = pendingActivityTypes
+		sort.Strings(activityTypes)
+		pendingChildCount = len(e.pendingChildExecutionInfoIDs)
+	}
+
+	values := map[string]interface{}{
+		definition.CadencePendingActivityTypes: activityTypes,
+		definition.CadenceMaxActivityAttempt:   maxActivityAttempt,
+		definition.CadencePendingChildCount:    pendingChildCount,
+	}
+	if e.activityFailureReason != nil {
+		values[definition.CadenceLastFailureReason] = *e.activityFailureReason
+	}
+
+	searchAttributes := make(map[string][]byte, len(values))
+	for key, value := range values {
+		data, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		searchAttributes[key] = data
+	}
+	return searchAttributes, nil
+}
+
 func (e *mutableStateBuilder) checkMutability(
 	actionTag tag.Tag,
 ) error {
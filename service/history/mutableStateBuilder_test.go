@@ -21,11 +21,13 @@
 package history
 
 import (
+	"errors"
 	"testing"
 	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
@@ -33,10 +35,13 @@ import (
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/definition"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/mocks"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/dynamicconfig"
 )
 
 type (
@@ -399,6 +404,102 @@ func (s *mutableStateSuite) TestMergeMapOfByteArray() {
 	s.Equal(2, len(resultMap))
 }
 
+func (s *mutableStateSuite) TestReplicateUpsertWorkflowSearchAttributesEvent() {
+	s.msBuilder.GetExecutionInfo().SearchAttributes = map[string][]byte{
+		"CustomKeywordField": []byte(`"keyword"`),
+		"CustomIntField":     []byte("1"),
+	}
+	event := &workflow.HistoryEvent{
+		EventType: workflow.EventTypeUpsertWorkflowSearchAttributes.Ptr(),
+		UpsertWorkflowSearchAttributesEventAttributes: &workflow.UpsertWorkflowSearchAttributesEventAttributes{
+			SearchAttributes: &workflow.SearchAttributes{
+				IndexedFields: map[string][]byte{"CustomBoolField": []byte("true")},
+			},
+			RemoveSearchAttributes: []string{"CustomIntField"},
+		},
+	}
+	s.msBuilder.ReplicateUpsertWorkflowSearchAttributesEvent(event)
+	s.Equal(map[string][]byte{
+		"CustomKeywordField": []byte(`"keyword"`),
+		"CustomBoolField":    []byte("true"),
+	}, s.msBuilder.GetExecutionInfo().SearchAttributes)
+}
+
+func (s *mutableStateSuite) TestCloseTransactionHandleStateSearchAttributes() {
+	s.mockShard.config.AdvancedVisibilityWritingMode = dynamicconfig.GetStringPropertyFn(common.AdvancedVisibilityWritingModeOn)
+	s.mockShard.config.EnableStateSearchAttributes = dynamicconfig.GetBoolPropertyFnFilteredByDomain(true)
+	now := time.Now()
+
+	ai1 := &persistence.ActivityInfo{ScheduleID: 5, ActivityID: "1", Attempt: 3}
+	ai2 := &persistence.ActivityInfo{ScheduleID: 6, ActivityID: "2", Attempt: 1,
+		ScheduledEvent: &workflow.HistoryEvent{
+			ActivityTaskScheduledEventAttributes: &workflow.ActivityTaskScheduledEventAttributes{
+				ActivityType: &workflow.ActivityType{Name: common.StringPtr("activity-a")},
+			},
+		},
+	}
+	s.msBuilder.pendingActivityInfoIDs = map[int64]*persistence.ActivityInfo{5: ai1, 6: ai2}
+	s.msBuilder.pendingActivityTypes = map[int64]string{5: "activity-b"}
+	s.msBuilder.pendingChildExecutionInfoIDs = map[int64]*persistence.ChildExecutionInfo{7: {InitiatedID: 7}}
+	s.msBuilder.updateActivityInfos[ai1] = struct{}{}
+	s.msBuilder.activityFailureReason = common.StringPtr("some reason")
+
+	s.NoError(s.msBuilder.closeTransactionHandleStateSearchAttributes(now))
+	s.Equal(map[string][]byte{
+		definition.CadencePendingActivityTypes: []byte(`["activity-a","activity-b"]`),
+		definition.CadenceMaxActivityAttempt:   []byte("3"),
+		definition.CadencePendingChildCount:    []byte("1"),
+		definition.CadenceLastFailureReason:    []byte(`"some reason"`),
+	}, s.msBuilder.GetExecutionInfo().SearchAttributes)
+	s.Equal(1, len(s.msBuilder.insertTransferTasks))
+	s.IsType(&persistence.UpsertWorkflowSearchAttributesTask{}, s.msBuilder.insertTransferTasks[0])
+
+	// unchanged values do not generate task
+	s.msBuilder.activityFailureReason = nil
+	s.NoError(s.msBuilder.closeTransactionHandleStateSearchAttributes(now))
+	s.Equal(1, len(s.msBuilder.insertTransferTasks))
+
+	// values of closed workflow are reset without task, and last failure reason is kept
+	s.msBuilder.GetExecutionInfo().State = persistence.WorkflowStateCompleted
+	s.NoError(s.msBuilder.closeTransactionHandleStateSearchAttributes(now))
+	s.Equal(map[string][]byte{
+		definition.CadencePendingActivityTypes: []byte(`[]`),
+		definition.CadenceMaxActivityAttempt:   []byte("0"),
+		definition.CadencePendingChildCount:    []byte("0"),
+		definition.CadenceLastFailureReason:    []byte(`"some reason"`),
+	}, s.msBuilder.GetExecutionInfo().SearchAttributes)
+	s.Equal(1, len(s.msBuilder.insertTransferTasks))
+}
+
+func (s *mutableStateSuite) TestCloseTransactionHandleStateSearchAttributes_MissingScheduledEvent() {
+	s.mockShard.config.AdvancedVisibilityWritingMode = dynamicconfig.GetStringPropertyFn(common.AdvancedVisibilityWritingModeOn)
+	s.mockShard.config.EnableStateSearchAttributes = dynamicconfig.GetBoolPropertyFnFilteredByDomain(true)
+	s.mockEventsCache.EXPECT().getEvent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil, errors.New("some error")).AnyTimes()
+
+	ai1 := &persistence.ActivityInfo{ScheduleID: 5, ActivityID: "1", Attempt: 2}
+	ai2 := &persistence.ActivityInfo{ScheduleID: 6, ActivityID: "2", Attempt: 1}
+	s.msBuilder.pendingActivityInfoIDs = map[int64]*persistence.ActivityInfo{5: ai1, 6: ai2}
+	s.msBuilder.pendingActivityTypes = map[int64]string{6: "activity-a"}
+	s.msBuilder.updateActivityInfos[ai1] = struct{}{}
+
+	// the activity without scheduled event is skipped instead of failing the transaction
+	s.NoError(s.msBuilder.closeTransactionHandleStateSearchAttributes(time.Now()))
+	s.Equal([]byte(`["activity-a"]`), s.msBuilder.GetExecutionInfo().SearchAttributes[definition.CadencePendingActivityTypes])
+	s.Equal([]byte("2"), s.msBuilder.GetExecutionInfo().SearchAttributes[definition.CadenceMaxActivityAttempt])
+	s.NotContains(s.msBuilder.pendingActivityTypes, int64(5))
+}
+
+func (s *mutableStateSuite) TestCloseTransactionHandleStateSearchAttributes_Disabled() {
+	s.mockShard.config.AdvancedVisibilityWritingMode = dynamicconfig.GetStringPropertyFn(common.AdvancedVisibilityWritingModeOn)
+	s.mockShard.config.EnableStateSearchAttributes = dynamicconfig.GetBoolPropertyFnFilteredByDomain(false)
+
+	s.msBuilder.activityFailureReason = common.StringPtr("some reason")
+	s.NoError(s.msBuilder.closeTransactionHandleStateSearchAttributes(time.Now()))
+	s.Empty(s.msBuilder.GetExecutionInfo().SearchAttributes)
+	s.Empty(s.msBuilder.insertTransferTasks)
+}
+
 func (s *mutableStateSuite) TestEventReapplied() {
 	runID := uuid.New()
 	eventID := int64(1)
@@ -411,6 +512,45 @@ func (s *mutableStateSuite) TestEventReapplied() {
 	s.True(isReapplied)
 }
 
+func (s *mutableStateSuite) TestBackfillVersionHistories() {
+	mockHistoryV2Mgr := &mocks.HistoryV2Manager{}
+	defer mockHistoryV2Mgr.AssertExpectations(s.T())
+	s.mockShard.historyV2Mgr = mockHistoryV2Mgr
+	s.mockShard.clusterMetadata = cluster.GetTestClusterMetadata(true, true)
+
+	// workflow started before the domain is promoted to a global domain
+	s.msBuilder = newMutableStateBuilder(s.mockShard, s.mockEventsCache, s.logger, testLocalDomainEntry)
+	s.msBuilder.domainEntry = testGlobalDomainEntry
+	s.msBuilder.executionInfo.BranchToken = []byte("some random branch token")
+	s.msBuilder.executionInfo.NextEventID = 6
+	activityInfo := &persistence.ActivityInfo{ScheduleID: 5, Version: common.EmptyVersion}
+	s.msBuilder.pendingActivityInfoIDs[activityInfo.ScheduleID] = activityInfo
+
+	newEvent := func(eventID int64) *shared.HistoryEvent {
+		return &shared.HistoryEvent{EventId: common.Int64Ptr(eventID), Version: common.Int64Ptr(common.EmptyVersion)}
+	}
+	mockHistoryV2Mgr.On("ReadHistoryBranchByBatch", mock.Anything).Return(&persistence.ReadHistoryBranchByBatchResponse{
+		History: []*shared.History{
+			{Events: []*shared.HistoryEvent{newEvent(1), newEvent(2)}},
+			{Events: []*shared.HistoryEvent{newEvent(3)}},
+			{Events: []*shared.HistoryEvent{newEvent(4), newEvent(5)}},
+		},
+	}, nil).Once()
+
+	s.NoError(s.msBuilder.backfillVersionHistories())
+	versionHistory, err := s.msBuilder.versionHistories.GetCurrentVersionHistory()
+	s.NoError(err)
+	s.Equal([]byte("some random branch token"), versionHistory.GetBranchToken())
+	s.Equal([]*persistence.VersionHistoryItem{
+		persistence.NewVersionHistoryItem(5, cluster.TestCurrentClusterInitialFailoverVersion),
+	}, versionHistory.Items)
+	s.Equal(cluster.TestCurrentClusterInitialFailoverVersion, activityInfo.Version)
+	s.Contains(s.msBuilder.updateActivityInfos, activityInfo)
+
+	// version histories are only backfilled once
+	s.NoError(s.msBuilder.backfillVersionHistories())
+}
+
 func (s *mutableStateSuite) prepareTransientDecisionCompletionFirstBatchReplicated(version int64, runID string) (*shared.HistoryEvent, *shared.HistoryEvent) {
 	domainID := testDomainID
 	execution := shared.WorkflowExecution{
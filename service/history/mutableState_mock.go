@@ -141,18 +141,18 @@ func (mr *MockmutableStateMockRecorder) AddActivityTaskScheduledEvent(arg0, arg1
 }
 
 // AddActivityTaskStartedEvent mocks base method
-func (m *MockmutableState) AddActivityTaskStartedEvent(arg0 *persistence.ActivityInfo, arg1 int64, arg2, arg3 string) (*shared.HistoryEvent, error) {
+func (m *MockmutableState) AddActivityTaskStartedEvent(arg0 *persistence.ActivityInfo, arg1 int64, arg2, arg3, arg4 string) (*shared.HistoryEvent, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddActivityTaskStartedEvent", arg0, arg1, arg2, arg3)
+	ret := m.ctrl.Call(m, "AddActivityTaskStartedEvent", arg0, arg1, arg2, arg3, arg4)
 	ret0, _ := ret[0].(*shared.HistoryEvent)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AddActivityTaskStartedEvent indicates an expected call of AddActivityTaskStartedEvent
-func (mr *MockmutableStateMockRecorder) AddActivityTaskStartedEvent(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+func (mr *MockmutableStateMockRecorder) AddActivityTaskStartedEvent(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddActivityTaskStartedEvent", reflect.TypeOf((*MockmutableState)(nil).AddActivityTaskStartedEvent), arg0, arg1, arg2, arg3)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddActivityTaskStartedEvent", reflect.TypeOf((*MockmutableState)(nil).AddActivityTaskStartedEvent), arg0, arg1, arg2, arg3, arg4)
 }
 
 // AddActivityTaskTimedOutEvent mocks base method
@@ -2377,6 +2377,22 @@ func (mr *MockmutableStateMockRecorder) AddTimerTasks(timerTasks ...interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTimerTasks", reflect.TypeOf((*MockmutableState)(nil).AddTimerTasks), timerTasks...)
 }
 
+// AddReplicationTasks mocks base method
+func (m *MockmutableState) AddReplicationTasks(replicationTasks ...persistence.Task) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range replicationTasks {
+		varargs = append(varargs, a)
+	}
+	m.ctrl.Call(m, "AddReplicationTasks", varargs...)
+}
+
+// AddReplicationTasks indicates an expected call of AddReplicationTasks
+func (mr *MockmutableStateMockRecorder) AddReplicationTasks(replicationTasks ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddReplicationTasks", reflect.TypeOf((*MockmutableState)(nil).AddReplicationTasks), replicationTasks...)
+}
+
 // SetUpdateCondition mocks base method
 func (m *MockmutableState) SetUpdateCondition(arg0 int64) {
 	m.ctrl.T.Helper()
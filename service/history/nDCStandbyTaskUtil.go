@@ -111,6 +111,8 @@ type (
 
 	pushActivityToMatchingInfo struct {
 		activityScheduleToStartTimeout int32
+		activityType                   string
+		affinityToken                  string
 	}
 
 	pushDecisionToMatchingInfo struct {
@@ -140,10 +142,14 @@ func newHistoryResendInfoFor2DC(
 
 func newPushActivityToMatchingInfo(
 	activityScheduleToStartTimeout int32,
+	activityType string,
+	affinityToken string,
 ) *pushActivityToMatchingInfo {
 
 	return &pushActivityToMatchingInfo{
 		activityScheduleToStartTimeout: activityScheduleToStartTimeout,
+		activityType:                   activityType,
+		affinityToken:                  affinityToken,
 	}
 }
 
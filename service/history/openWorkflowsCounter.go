@@ -0,0 +1,151 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
+	"go.uber.org/cadence/.gen/go/shared"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+const (
+	openWorkflowsCountTimeout = 5 * time.Second
+)
+
+var (
+	openWorkflowsQuery = definition.CloseTime + " = missing"
+)
+
+type (
+	// openWorkflowsCounter counts the open workflow executions of a domain, shared by all shards of the host
+	openWorkflowsCounter interface {
+		// getCount returns the cached open workflow count of the domain, and refreshes it in background once expired,
+		// false if the count is not available, i.e. the domain has not been counted successfully yet
+		getCount(domainName string) (int64, bool)
+		// recordStarted adds a started workflow to the cached count of the domain, until the count is refreshed
+		recordStarted(domainName string)
+	}
+
+	openWorkflowsCounterImpl struct {
+		sync.Mutex
+		publicClient workflowserviceclient.Interface
+		cacheTTL     dynamicconfig.DurationPropertyFn
+		timeSource   clock.TimeSource
+		logger       log.Logger
+		counts       map[string]*openWorkflowsCount
+	}
+
+	openWorkflowsCount struct {
+		count      int64
+		available  bool
+		expiry     time.Time
+		refreshing bool
+	}
+)
+
+var _ openWorkflowsCounter = (*openWorkflowsCounterImpl)(nil)
+
+func newOpenWorkflowsCounter(
+	publicClient workflowserviceclient.Interface,
+	config *Config,
+	timeSource clock.TimeSource,
+	logger log.Logger,
+) *openWorkflowsCounterImpl {
+
+	return &openWorkflowsCounterImpl{
+		publicClient: publicClient,
+		cacheTTL:     config.OpenWorkflowsCountCacheTTL,
+		timeSource:   timeSource,
+		logger:       logger,
+		counts:       make(map[string]*openWorkflowsCount),
+	}
+}
+
+func (c *openWorkflowsCounterImpl) getCount(
+	domainName string,
+) (int64, bool) {
+
+	c.Lock()
+	defer c.Unlock()
+
+	cached, ok := c.counts[domainName]
+	if !ok {
+		cached = &openWorkflowsCount{}
+		c.counts[domainName] = cached
+	}
+	// only one refresh per domain is in flight, so concurrent starts of the host
+	// do not query visibility more than once per TTL, and starts are not blocked by it
+	if !cached.refreshing && !c.timeSource.Now().Before(cached.expiry) {
+		cached.refreshing = true
+		go c.refresh(domainName, cached)
+	}
+	return cached.count, cached.available
+}
+
+func (c *openWorkflowsCounterImpl) refresh(
+	domainName string,
+	cached *openWorkflowsCount,
+) {
+
+	ctx, cancel := context.WithTimeout(context.Background(), openWorkflowsCountTimeout)
+	defer cancel()
+	resp, err := c.publicClient.CountWorkflowExecutions(ctx, &shared.CountWorkflowExecutionsRequest{
+		Domain: common.StringPtr(domainName),
+		Query:  common.StringPtr(openWorkflowsQuery),
+	})
+
+	c.Lock()
+	defer c.Unlock()
+
+	cached.refreshing = false
+	cached.expiry = c.timeSource.Now().Add(c.cacheTTL())
+	if err != nil {
+		// the last successful count, if any, stays in use until the next refresh
+		c.logger.Warn("Failed to count open workflows.",
+			tag.WorkflowDomainName(domainName),
+			tag.Error(err))
+		return
+	}
+	cached.count = resp.GetCount()
+	cached.available = true
+}
+
+func (c *openWorkflowsCounterImpl) recordStarted(
+	domainName string,
+) {
+
+	c.Lock()
+	defer c.Unlock()
+
+	if cached, ok := c.counts[domainName]; ok {
+		cached.count++
+	}
+}
@@ -0,0 +1,177 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/cadence/.gen/go/cadence/workflowservicetest"
+	"go.uber.org/cadence/.gen/go/shared"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	openWorkflowsCounterSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller       *gomock.Controller
+		mockPublicClient *workflowservicetest.MockClient
+		timeSource       *clock.EventTimeSource
+		counter          *openWorkflowsCounterImpl
+	}
+)
+
+func TestOpenWorkflowsCounterSuite(t *testing.T) {
+	s := new(openWorkflowsCounterSuite)
+	suite.Run(t, s)
+}
+
+func (s *openWorkflowsCounterSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.controller = gomock.NewController(s.T())
+	s.mockPublicClient = workflowservicetest.NewMockClient(s.controller)
+	s.timeSource = clock.NewEventTimeSource().Update(time.Now())
+
+	config := NewDynamicConfigForTest()
+	config.OpenWorkflowsCountCacheTTL = dynamicconfig.GetDurationPropertyFn(time.Minute)
+	s.counter = newOpenWorkflowsCounter(s.mockPublicClient, config, s.timeSource, loggerimpl.NewNopLogger())
+}
+
+func (s *openWorkflowsCounterSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *openWorkflowsCounterSuite) TestGetCount_Cached() {
+	s.mockPublicClient.EXPECT().CountWorkflowExecutions(gomock.Any(), &shared.CountWorkflowExecutionsRequest{
+		Domain: common.StringPtr(testDomainName),
+		Query:  common.StringPtr(openWorkflowsQuery),
+	}).Return(&shared.CountWorkflowExecutionsResponse{Count: common.Int64Ptr(10)}, nil).Times(1)
+
+	// the count is not available until the first refresh completes
+	_, ok := s.counter.getCount(testDomainName)
+	s.False(ok)
+	s.waitForRefresh(testDomainName)
+	count, ok := s.counter.getCount(testDomainName)
+	s.True(ok)
+	s.Equal(int64(10), count)
+
+	s.counter.recordStarted(testDomainName)
+	count, ok = s.counter.getCount(testDomainName)
+	s.True(ok)
+	s.Equal(int64(11), count)
+}
+
+func (s *openWorkflowsCounterSuite) TestGetCount_Refreshed() {
+	s.mockPublicClient.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).
+		Return(&shared.CountWorkflowExecutionsResponse{Count: common.Int64Ptr(10)}, nil).Times(1)
+	s.mockPublicClient.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).
+		Return(&shared.CountWorkflowExecutionsResponse{Count: common.Int64Ptr(5)}, nil).Times(1)
+
+	s.counter.getCount(testDomainName)
+	s.waitForRefresh(testDomainName)
+
+	// the expired count is returned until the refresh completes
+	s.timeSource.Update(s.timeSource.Now().Add(2 * time.Minute))
+	count, ok := s.counter.getCount(testDomainName)
+	s.True(ok)
+	s.Equal(int64(10), count)
+	s.waitForRefresh(testDomainName)
+	count, ok = s.counter.getCount(testDomainName)
+	s.True(ok)
+	s.Equal(int64(5), count)
+}
+
+func (s *openWorkflowsCounterSuite) TestGetCount_NotAvailable() {
+	s.mockPublicClient.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).
+		Return(nil, &shared.BadRequestError{Message: "Operation not support. Please use on ElasticSearch"}).Times(1)
+
+	s.counter.getCount(testDomainName)
+	s.waitForRefresh(testDomainName)
+	_, ok := s.counter.getCount(testDomainName)
+	s.False(ok)
+
+	// the failure is cached as well, to not query visibility on every start
+	_, ok = s.counter.getCount(testDomainName)
+	s.False(ok)
+}
+
+func (s *openWorkflowsCounterSuite) TestGetCount_RefreshFailed() {
+	s.mockPublicClient.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).
+		Return(&shared.CountWorkflowExecutionsResponse{Count: common.Int64Ptr(10)}, nil).Times(1)
+	s.mockPublicClient.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).
+		Return(nil, &shared.InternalServiceError{Message: "some random error"}).Times(1)
+
+	s.counter.getCount(testDomainName)
+	s.waitForRefresh(testDomainName)
+
+	// the last successful count is kept if a refresh fails
+	s.timeSource.Update(s.timeSource.Now().Add(2 * time.Minute))
+	s.counter.getCount(testDomainName)
+	s.waitForRefresh(testDomainName)
+	count, ok := s.counter.getCount(testDomainName)
+	s.True(ok)
+	s.Equal(int64(10), count)
+}
+
+func (s *openWorkflowsCounterSuite) TestGetCount_NotBlockedByRefresh() {
+	unblockCh := make(chan struct{})
+	s.mockPublicClient.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ interface{}, _ interface{}, _ ...interface{}) (*shared.CountWorkflowExecutionsResponse, error) {
+			<-unblockCh
+			return &shared.CountWorkflowExecutionsResponse{Count: common.Int64Ptr(10)}, nil
+		}).Times(2)
+
+	// a slow count of one domain neither blocks the domain nor other domains,
+	// and only one count per domain is in flight
+	s.counter.getCount(testDomainName)
+	s.counter.getCount(testDomainName)
+	s.counter.getCount("some other domain")
+	s.counter.recordStarted(testDomainName)
+	close(unblockCh)
+
+	s.waitForRefresh(testDomainName)
+	s.waitForRefresh("some other domain")
+	count, ok := s.counter.getCount("some other domain")
+	s.True(ok)
+	s.Equal(int64(10), count)
+}
+
+func (s *openWorkflowsCounterSuite) waitForRefresh(
+	domainName string,
+) {
+
+	s.Eventually(func() bool {
+		s.counter.Lock()
+		defer s.counter.Unlock()
+		cached, ok := s.counter.counts[domainName]
+		return ok && !cached.refreshing
+	}, 5*time.Second, 10*time.Millisecond)
+}
@@ -0,0 +1,221 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"sync"
+	"time"
+
+	r "github.com/uber/cadence/.gen/go/replicator"
+	"github.com/uber/cadence/common"
+)
+
+type (
+	// replicationStatusTracker keeps the replication lag and health of a shard for one source cluster
+	replicationStatusTracker struct {
+		sync.RWMutex
+
+		lastRetrievedMessageID int64
+		lastProcessedMessageID int64
+		sourceMaxReadLevel     int64
+		caughtUp               bool
+		lastCaughtUpTime       time.Time
+		lastError              *r.ReplicationError
+		domains                map[string]*r.DomainReplicationStatus
+	}
+)
+
+func newReplicationStatusTracker() *replicationStatusTracker {
+	return &replicationStatusTracker{
+		lastRetrievedMessageID: defaultBeginningMessageID,
+		lastProcessedMessageID: defaultBeginningMessageID,
+		sourceMaxReadLevel:     defaultBeginningMessageID,
+		domains:                make(map[string]*r.DomainReplicationStatus),
+	}
+}
+
+// recordResponse records the progress after a fetched batch of replication tasks is processed
+func (t *replicationStatusTracker) recordResponse(
+	lastRetrievedMessageID int64,
+	lastProcessedMessageID int64,
+	sourceMaxReadLevel int64,
+	caughtUp bool,
+	now time.Time,
+) {
+
+	t.Lock()
+	defer t.Unlock()
+
+	t.lastRetrievedMessageID = lastRetrievedMessageID
+	t.lastProcessedMessageID = lastProcessedMessageID
+	if sourceMaxReadLevel > t.sourceMaxReadLevel {
+		t.sourceMaxReadLevel = sourceMaxReadLevel
+	}
+	t.caughtUp = caughtUp
+	if caughtUp {
+		t.lastCaughtUpTime = now
+	}
+}
+
+// recordTaskApplied records a replication task which is applied, errors recorded for this task
+// or for earlier tasks are cleared as replication recovered from them
+func (t *replicationStatusTracker) recordTaskApplied(
+	task *r.ReplicationTask,
+	now time.Time,
+) {
+
+	domainID, _, _ := getReplicationTaskExecution(task)
+
+	t.Lock()
+	defer t.Unlock()
+
+	if t.lastError != nil && t.lastError.GetTaskID() <= task.GetSourceTaskId() {
+		t.lastError = nil
+	}
+	if domainID == "" {
+		return
+	}
+
+	status := t.getDomainStatusLocked(domainID)
+	if status.LastError != nil && status.LastError.GetTaskID() <= task.GetSourceTaskId() {
+		status.LastError = nil
+	}
+	status.LastAppliedTaskID = common.Int64Ptr(task.GetSourceTaskId())
+	status.LastAppliedTimestamp = common.Int64Ptr(now.UnixNano())
+	status.AppliedTaskCount = common.Int64Ptr(status.GetAppliedTaskCount() + 1)
+}
+
+// recordTaskError records an error of a single attempt to apply a replication task
+func (t *replicationStatusTracker) recordTaskError(
+	task *r.ReplicationTask,
+	err error,
+	now time.Time,
+) {
+
+	domainID, workflowID, runID := getReplicationTaskExecution(task)
+	replicationError := &r.ReplicationError{
+		TaskID:     common.Int64Ptr(task.GetSourceTaskId()),
+		DomainID:   common.StringPtr(domainID),
+		WorkflowID: common.StringPtr(workflowID),
+		RunID:      common.StringPtr(runID),
+		Message:    common.StringPtr(err.Error()),
+		Timestamp:  common.Int64Ptr(now.UnixNano()),
+	}
+
+	t.Lock()
+	defer t.Unlock()
+
+	t.lastError = replicationError
+	if domainID != "" {
+		t.getDomainStatusLocked(domainID).LastError = replicationError
+	}
+}
+
+// recordTaskFailed records a replication task which failed after retries and is put into DLQ
+func (t *replicationStatusTracker) recordTaskFailed(
+	task *r.ReplicationTask,
+) {
+
+	domainID, _, _ := getReplicationTaskExecution(task)
+	if domainID == "" {
+		return
+	}
+
+	t.Lock()
+	defer t.Unlock()
+
+	status := t.getDomainStatusLocked(domainID)
+	status.FailedTaskCount = common.Int64Ptr(status.GetFailedTaskCount() + 1)
+}
+
+// describe returns the replication status of the shard, per domain status is only included for the given domain
+func (t *replicationStatusTracker) describe(
+	shardID int32,
+	domainID string,
+	now time.Time,
+) *r.ShardReplicationStatus {
+
+	t.RLock()
+	defer t.RUnlock()
+
+	status := &r.ShardReplicationStatus{
+		ShardID:                common.Int32Ptr(shardID),
+		LastRetrievedMessageID: common.Int64Ptr(t.lastRetrievedMessageID),
+		LastProcessedMessageID: common.Int64Ptr(t.lastProcessedMessageID),
+		SourceMaxReadLevel:     common.Int64Ptr(t.sourceMaxReadLevel),
+		LastError:              t.lastError,
+	}
+
+	taskIDLag := int64(0)
+	if !t.caughtUp && t.sourceMaxReadLevel > t.lastProcessedMessageID {
+		taskIDLag = t.sourceMaxReadLevel - t.lastProcessedMessageID
+	}
+	status.TaskIDLag = common.Int64Ptr(taskIDLag)
+
+	if !t.lastCaughtUpTime.IsZero() {
+		status.LastCaughtUpTimestamp = common.Int64Ptr(t.lastCaughtUpTime.UnixNano())
+		wallClockLag := time.Duration(0)
+		if !t.caughtUp {
+			wallClockLag = now.Sub(t.lastCaughtUpTime)
+		}
+		status.WallClockLagInMillis = common.Int64Ptr(int64(wallClockLag / time.Millisecond))
+	}
+
+	if domainID != "" {
+		domainStatus := &r.DomainReplicationStatus{DomainID: common.StringPtr(domainID)}
+		if recorded, ok := t.domains[domainID]; ok {
+			*domainStatus = *recorded
+		}
+		status.Domains = []*r.DomainReplicationStatus{domainStatus}
+	}
+	return status
+}
+
+func (t *replicationStatusTracker) getDomainStatusLocked(
+	domainID string,
+) *r.DomainReplicationStatus {
+
+	status, ok := t.domains[domainID]
+	if !ok {
+		status = &r.DomainReplicationStatus{DomainID: common.StringPtr(domainID)}
+		t.domains[domainID] = status
+	}
+	return status
+}
+
+func getReplicationTaskExecution(
+	task *r.ReplicationTask,
+) (domainID string, workflowID string, runID string) {
+
+	switch task.GetTaskType() {
+	case r.ReplicationTaskTypeSyncActivity:
+		attr := task.GetSyncActicvityTaskAttributes()
+		return attr.GetDomainId(), attr.GetWorkflowId(), attr.GetRunId()
+	case r.ReplicationTaskTypeHistory:
+		attr := task.GetHistoryTaskAttributes()
+		return attr.GetDomainId(), attr.GetWorkflowId(), attr.GetRunId()
+	case r.ReplicationTaskTypeHistoryV2:
+		attr := task.GetHistoryTaskV2Attributes()
+		return attr.GetDomainId(), attr.GetWorkflowId(), attr.GetRunId()
+	default:
+		return "", "", ""
+	}
+}
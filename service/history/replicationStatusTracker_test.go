@@ -0,0 +1,98 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	r "github.com/uber/cadence/.gen/go/replicator"
+	"github.com/uber/cadence/common"
+)
+
+func TestReplicationStatusTracker_Lag(t *testing.T) {
+	tracker := newReplicationStatusTracker()
+	now := time.Now()
+
+	status := tracker.describe(1, "", now)
+	require.Equal(t, int64(0), status.GetTaskIDLag())
+	require.Nil(t, status.WallClockLagInMillis)
+
+	tracker.recordResponse(100, 100, 100, true, now)
+	status = tracker.describe(1, "", now.Add(time.Minute))
+	require.Equal(t, int64(0), status.GetTaskIDLag())
+	require.Equal(t, int64(0), status.GetWallClockLagInMillis())
+
+	tracker.recordResponse(150, 150, 200, false, now.Add(time.Second))
+	status = tracker.describe(1, "", now.Add(time.Minute))
+	require.Equal(t, int64(50), status.GetTaskIDLag())
+	require.Equal(t, int64(time.Minute/time.Millisecond), status.GetWallClockLagInMillis())
+	require.Equal(t, now.UnixNano(), status.GetLastCaughtUpTimestamp())
+
+	// an older max read level from a stale response does not move the source backwards
+	tracker.recordResponse(160, 160, 180, false, now.Add(2*time.Second))
+	require.Equal(t, int64(40), tracker.describe(1, "", now).GetTaskIDLag())
+}
+
+func TestReplicationStatusTracker_DomainStatus(t *testing.T) {
+	tracker := newReplicationStatusTracker()
+	now := time.Now()
+	task := &r.ReplicationTask{
+		TaskType:     r.ReplicationTaskTypeHistoryV2.Ptr(),
+		SourceTaskId: common.Int64Ptr(7),
+		HistoryTaskV2Attributes: &r.HistoryTaskV2Attributes{
+			DomainId:   common.StringPtr("some random domain ID"),
+			WorkflowId: common.StringPtr("some random workflow ID"),
+			RunId:      common.StringPtr("some random run ID"),
+		},
+	}
+
+	tracker.recordTaskError(task, errors.New("some random error"), now)
+	tracker.recordTaskFailed(task)
+
+	status := tracker.describe(1, "some random domain ID", now)
+	require.Equal(t, int64(7), status.LastError.GetTaskID())
+	require.Equal(t, "some random workflow ID", status.LastError.GetWorkflowID())
+	require.Equal(t, "some random error", status.LastError.GetMessage())
+	require.Len(t, status.Domains, 1)
+	require.Equal(t, int64(7), status.Domains[0].LastError.GetTaskID())
+	require.Equal(t, int64(1), status.Domains[0].GetFailedTaskCount())
+
+	// errors are cleared once a later task is applied
+	task.SourceTaskId = common.Int64Ptr(8)
+	tracker.recordTaskApplied(task, now)
+
+	status = tracker.describe(1, "some random domain ID", now)
+	require.Nil(t, status.LastError)
+	require.Len(t, status.Domains, 1)
+	require.Nil(t, status.Domains[0].LastError)
+	require.Equal(t, int64(1), status.Domains[0].GetAppliedTaskCount())
+	require.Equal(t, int64(1), status.Domains[0].GetFailedTaskCount())
+	require.Equal(t, int64(8), status.Domains[0].GetLastAppliedTaskID())
+
+	status = tracker.describe(1, "some other domain ID", now)
+	require.Len(t, status.Domains, 1)
+	require.Equal(t, int64(0), status.Domains[0].GetAppliedTaskCount())
+	require.Nil(t, status.Domains[0].LastError)
+}
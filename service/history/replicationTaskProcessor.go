@@ -23,6 +23,8 @@ package history
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -45,11 +47,14 @@ const (
 	replicationTimeout               = 30 * time.Second
 	taskErrorRetryBackoffCoefficient = 1.2
 	dlqErrorRetryWait                = time.Second
+	defaultBeginningMessageID        = -1
 )
 
 var (
 	// ErrUnknownReplicationTask is the error to indicate unknown replication task type
 	ErrUnknownReplicationTask = &shared.BadRequestError{Message: "unknown replication task"}
+	// ErrUnknownDLQType is the error to indicate unknown replication DLQ type
+	ErrUnknownDLQType = &shared.BadRequestError{Message: "unknown DLQ type"}
 )
 
 type (
@@ -72,6 +77,13 @@ type (
 
 		lastProcessedMessageID int64
 		lastRetrievedMessageID int64
+		replicationStatus      *replicationStatusTracker
+
+		// the DLQ is only counted when the processor starts or after it is purged or merged,
+		// the size is otherwise updated as tasks are put to the DLQ
+		dlqSizeLock   sync.Mutex
+		dlqSizeLoaded bool
+		dlqSize       int64
 
 		requestChan chan<- *request
 		done        chan struct{}
@@ -114,7 +126,9 @@ func NewReplicationTaskProcessor(
 		metricsClient:     metricsClient,
 		logger:            shard.GetLogger(),
 		taskRetryPolicy:   taskRetryPolicy,
+		dlqRetryPolicy:    dlqRetryPolicy,
 		noTaskRetrier:     noTaskRetrier,
+		replicationStatus: newReplicationStatusTracker(),
 		requestChan:       replicationTaskFetcher.GetRequestChan(),
 		done:              make(chan struct{}),
 	}
@@ -127,6 +141,7 @@ func (p *ReplicationTaskProcessor) Start() {
 	}
 
 	go p.processorLoop()
+	go p.refreshDLQSize()
 	p.logger.Info("ReplicationTaskProcessor started.")
 }
 
@@ -179,6 +194,62 @@ Loop:
 	}
 }
 
+// refreshDLQSize counts the tasks in the DLQ of the shard and emits the size
+func (p *ReplicationTaskProcessor) refreshDLQSize() (int64, error) {
+	p.dlqSizeLock.Lock()
+	defer p.dlqSizeLock.Unlock()
+
+	return p.refreshDLQSizeLocked()
+}
+
+func (p *ReplicationTaskProcessor) refreshDLQSizeLocked() (int64, error) {
+	resp, err := p.shard.GetExecutionManager().GetReplicationDLQSize(
+		&persistence.GetReplicationDLQSizeRequest{
+			SourceClusterName: p.sourceCluster,
+		},
+	)
+	if err != nil {
+		p.logger.Warn("Failed to get replication DLQ size.", tag.Error(err))
+		p.dlqSizeLoaded = false
+		return 0, err
+	}
+	p.dlqSizeLoaded = true
+	p.dlqSize = resp.Size
+	p.emitDLQSize()
+	return p.dlqSize, nil
+}
+
+// getDLQSize returns the size of the DLQ of the shard, which is only counted when it is not known yet
+func (p *ReplicationTaskProcessor) getDLQSize() (int64, error) {
+	p.dlqSizeLock.Lock()
+	defer p.dlqSizeLock.Unlock()
+
+	if p.dlqSizeLoaded {
+		return p.dlqSize, nil
+	}
+	return p.refreshDLQSizeLocked()
+}
+
+// addDLQSize updates the size of the DLQ after tasks were put to it, unless the size is not known yet
+func (p *ReplicationTaskProcessor) addDLQSize(delta int64) {
+	p.dlqSizeLock.Lock()
+	defer p.dlqSizeLock.Unlock()
+
+	if !p.dlqSizeLoaded {
+		return
+	}
+	p.dlqSize += delta
+	p.emitDLQSize()
+}
+
+func (p *ReplicationTaskProcessor) emitDLQSize() {
+	p.metricsClient.Scope(
+		metrics.ReplicationDLQStatsScope,
+		metrics.TargetClusterTag(p.sourceCluster),
+		metrics.InstanceTag(strconv.Itoa(p.shard.GetShardID())),
+	).UpdateGauge(metrics.ReplicationDLQSize, float64(p.dlqSize))
+}
+
 func (p *ReplicationTaskProcessor) sendFetchMessageRequest() <-chan *r.ReplicationMessages {
 	respChan := make(chan *r.ReplicationMessages, 1)
 	// TODO: when we support prefetching, LastRetrivedMessageId can be different than LastProcessedMessageId
@@ -198,6 +269,13 @@ func (p *ReplicationTaskProcessor) processResponse(response *r.ReplicationMessag
 	// we will receive replication tasks but hasMore is false (meaning that we are always catching up).
 	// So hasMore might not be a good indicator for additional wait.
 	if len(response.ReplicationTasks) == 0 {
+		p.replicationStatus.recordResponse(
+			p.lastRetrievedMessageID,
+			p.lastProcessedMessageID,
+			response.GetMaxReadLevel(),
+			!response.GetHasMore(),
+			time.Now(),
+		)
 		backoffDuration := p.noTaskRetrier.NextBackOff()
 		time.Sleep(backoffDuration)
 		return
@@ -217,6 +295,13 @@ func (p *ReplicationTaskProcessor) processResponse(response *r.ReplicationMessag
 	if err != nil {
 		p.logger.Error("Error updating replication level for shard", tag.Error(err), tag.OperationFailed)
 	}
+	p.replicationStatus.recordResponse(
+		p.lastRetrievedMessageID,
+		p.lastProcessedMessageID,
+		response.GetMaxReadLevel(),
+		!response.GetHasMore(),
+		time.Now(),
+	)
 
 	scope := p.metricsClient.Scope(metrics.ReplicationTaskFetcherScope, metrics.TargetClusterTag(p.sourceCluster))
 	scope.UpdateGauge(metrics.LastRetrievedMessageID, float64(p.lastRetrievedMessageID))
@@ -235,6 +320,7 @@ func (p *ReplicationTaskProcessor) processSingleTask(replicationTask *r.Replicat
 			tag.Error(err),
 		)
 
+		p.replicationStatus.recordTaskFailed(replicationTask)
 		return p.putReplicationTaskToDLQ(replicationTask)
 	}
 
@@ -270,7 +356,9 @@ func (p *ReplicationTaskProcessor) processTaskOnce(replicationTask *r.Replicatio
 
 	if err != nil {
 		p.updateFailureMetric(scope, err)
+		p.replicationStatus.recordTaskError(replicationTask, err, time.Now())
 	} else {
+		p.replicationStatus.recordTaskApplied(replicationTask, time.Now())
 		p.logger.Debug("Successfully applied replication task.", tag.TaskID(replicationTask.GetSourceTaskId()))
 		p.metricsClient.Scope(
 			metrics.ReplicationTaskFetcherScope,
@@ -294,11 +382,144 @@ func (p *ReplicationTaskProcessor) putReplicationTaskToDLQ(replicationTask *r.Re
 		err := p.shard.GetExecutionManager().PutReplicationTaskToDLQ(request)
 		if err != nil {
 			p.logger.Error("Failed to put replication task to DLQ.", tag.Error(err))
+			return err
 		}
-		return err
+		p.metricsClient.Scope(
+			metrics.ReplicationDLQStatsScope,
+			metrics.TargetClusterTag(p.sourceCluster),
+		).IncCounter(metrics.ReplicationTasksEnqueuedToDLQ)
+		p.addDLQSize(1)
+		return nil
 	}, p.dlqRetryPolicy, p.shouldRetryDLQ)
 }
 
+func (p *ReplicationTaskProcessor) describeReplication(
+	domainID string,
+) (*r.ShardReplicationStatus, error) {
+
+	status := p.replicationStatus.describe(int32(p.shard.GetShardID()), domainID, time.Now())
+	dlqSize, err := p.getDLQSize()
+	if err != nil {
+		return nil, err
+	}
+	status.DlqSize = common.Int64Ptr(dlqSize)
+	return status, nil
+}
+
+func (p *ReplicationTaskProcessor) readDLQMessages(
+	lastMessageID int64,
+	pageSize int,
+	pageToken []byte,
+) ([]*r.ReplicationTaskInfo, []byte, error) {
+
+	resp, err := p.shard.GetExecutionManager().GetReplicationTasksFromDLQ(
+		persistence.NewGetReplicationTasksFromDLQRequest(
+			p.sourceCluster,
+			defaultBeginningMessageID,
+			lastMessageID,
+			pageSize,
+			pageToken,
+		),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var taskInfos []*r.ReplicationTaskInfo
+	for _, task := range resp.Tasks {
+		// the max read level is not a strict upper bound for all persistence implementations
+		if task.GetTaskID() > lastMessageID {
+			return taskInfos, nil, nil
+		}
+		taskInfos = append(taskInfos, &r.ReplicationTaskInfo{
+			DomainID:     common.StringPtr(task.GetDomainID()),
+			WorkflowID:   common.StringPtr(task.GetWorkflowID()),
+			RunID:        common.StringPtr(task.GetRunID()),
+			TaskType:     common.Int16Ptr(int16(task.GetTaskType())),
+			TaskID:       common.Int64Ptr(task.GetTaskID()),
+			Version:      common.Int64Ptr(task.GetVersion()),
+			FirstEventID: common.Int64Ptr(task.FirstEventID),
+			NextEventID:  common.Int64Ptr(task.NextEventID),
+			ScheduledID:  common.Int64Ptr(task.ScheduledID),
+		})
+	}
+	return taskInfos, resp.NextPageToken, nil
+}
+
+func (p *ReplicationTaskProcessor) purgeDLQMessages(
+	lastMessageID int64,
+) error {
+
+	err := p.shard.GetExecutionManager().RangeDeleteReplicationTaskFromDLQ(
+		&persistence.RangeDeleteReplicationTaskFromDLQRequest{
+			SourceClusterName:    p.sourceCluster,
+			ExclusiveBeginTaskID: defaultBeginningMessageID,
+			InclusiveEndTaskID:   lastMessageID,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	p.metricsClient.Scope(
+		metrics.ReplicationDLQStatsScope,
+		metrics.TargetClusterTag(p.sourceCluster),
+	).IncCounter(metrics.ReplicationDLQMessagesPurged)
+	p.refreshDLQSize()
+	return nil
+}
+
+func (p *ReplicationTaskProcessor) mergeDLQMessages(
+	ctx context.Context,
+	lastMessageID int64,
+	pageSize int,
+	pageToken []byte,
+) ([]byte, error) {
+
+	taskInfos, nextPageToken, err := p.readDLQMessages(lastMessageID, pageSize, pageToken)
+	if err != nil {
+		return nil, err
+	}
+	if len(taskInfos) == 0 {
+		return nextPageToken, nil
+	}
+
+	// the DLQ only stores task metadata, the full tasks are regenerated by the source cluster
+	remoteAdminClient := p.shard.GetService().GetClientBean().GetRemoteAdminClient(p.sourceCluster)
+	resp, err := remoteAdminClient.GetDLQReplicationMessages(
+		ctx,
+		&r.GetDLQReplicationMessagesRequest{
+			TaskInfos: taskInfos,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, replicationTask := range resp.GetReplicationTasks() {
+		if err := p.processTaskOnce(replicationTask); err != nil {
+			return nil, err
+		}
+	}
+
+	lastMergedMessageID := taskInfos[len(taskInfos)-1].GetTaskID()
+	err = p.shard.GetExecutionManager().RangeDeleteReplicationTaskFromDLQ(
+		&persistence.RangeDeleteReplicationTaskFromDLQRequest{
+			SourceClusterName:    p.sourceCluster,
+			ExclusiveBeginTaskID: defaultBeginningMessageID,
+			InclusiveEndTaskID:   lastMergedMessageID,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	p.metricsClient.Scope(
+		metrics.ReplicationDLQStatsScope,
+		metrics.TargetClusterTag(p.sourceCluster),
+	).AddCounter(metrics.ReplicationDLQMessagesMerged, int64(len(resp.GetReplicationTasks())))
+	p.refreshDLQSize()
+	return nextPageToken, nil
+}
+
 func (p *ReplicationTaskProcessor) generateDLQRequest(
 	replicationTask *r.ReplicationTask,
 ) (*persistence.PutReplicationTaskToDLQRequest, error) {
@@ -348,6 +569,9 @@ func (p *ReplicationTaskProcessor) generateDLQRequest(
 			return nil, fmt.Errorf("corrupted history event batch, empty events")
 		}
 
+		// the next event id is exclusive, as the events in [FirstEventID, NextEventID)
+		// are read back from history when the task is merged
+
 		return &persistence.PutReplicationTaskToDLQRequest{
 			SourceClusterName: p.sourceCluster,
 			TaskInfo: &persistence.ReplicationTaskInfo{
@@ -357,7 +581,7 @@ func (p *ReplicationTaskProcessor) generateDLQRequest(
 				TaskID:       replicationTask.GetSourceTaskId(),
 				TaskType:     persistence.ReplicationTaskTypeHistory,
 				FirstEventID: events[0].GetEventId(),
-				NextEventID:  events[len(events)-1].GetEventId(),
+				NextEventID:  events[len(events)-1].GetEventId() + 1,
 				Version:      events[0].GetVersion(),
 			},
 		}, nil
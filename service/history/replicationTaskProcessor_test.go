@@ -0,0 +1,294 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+
+	"github.com/uber/cadence/.gen/go/admin/adminservicetest"
+	r "github.com/uber/cadence/.gen/go/replicator"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/client"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service"
+)
+
+type (
+	replicationTaskProcessorSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller          *gomock.Controller
+		mockDomainCache     *cache.MockDomainCache
+		mockEngine          *MockEngine
+		mockClientBean      *client.MockBean
+		mockAdminClient     *adminservicetest.MockClient
+		mockExecutionMgr    *mocks.ExecutionManager
+		mockClusterMetadata *mocks.ClusterMetadata
+
+		sourceCluster string
+		processor     *ReplicationTaskProcessor
+	}
+)
+
+func TestReplicationTaskProcessorSuite(t *testing.T) {
+	s := new(replicationTaskProcessorSuite)
+	suite.Run(t, s)
+}
+
+func (s *replicationTaskProcessorSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.controller = gomock.NewController(s.T())
+	s.mockDomainCache = cache.NewMockDomainCache(s.controller)
+	s.mockEngine = NewMockEngine(s.controller)
+	s.mockClientBean = client.NewMockBean(s.controller)
+	s.mockAdminClient = adminservicetest.NewMockClient(s.controller)
+	s.mockExecutionMgr = &mocks.ExecutionManager{}
+	s.mockClusterMetadata = &mocks.ClusterMetadata{}
+	s.mockClusterMetadata.On("GetCurrentClusterName").Return(cluster.TestCurrentClusterName)
+	s.sourceCluster = cluster.TestAlternativeClusterName
+
+	metricsClient := metrics.NewClient(tally.NoopScope, metrics.History)
+	logger := loggerimpl.NewDevelopmentForTest(s.Suite)
+	mockService := service.NewTestService(s.mockClusterMetadata, nil, metricsClient, s.mockClientBean, nil, nil, nil)
+	shard := &shardContextImpl{
+		service:                   mockService,
+		shardInfo:                 &persistence.ShardInfo{ShardID: 0, RangeID: 1},
+		clusterMetadata:           s.mockClusterMetadata,
+		closeCh:                   make(chan int, 100),
+		config:                    NewDynamicConfigForTest(),
+		logger:                    logger,
+		metricsClient:             metricsClient,
+		domainCache:               s.mockDomainCache,
+		executionManager:          s.mockExecutionMgr,
+		standbyClusterCurrentTime: make(map[string]time.Time),
+		timeSource:                clock.NewRealTimeSource(),
+	}
+
+	s.processor = &ReplicationTaskProcessor{
+		currentCluster:    cluster.TestCurrentClusterName,
+		sourceCluster:     s.sourceCluster,
+		status:            common.DaemonStatusInitialized,
+		shard:             shard,
+		historyEngine:     s.mockEngine,
+		historySerializer: persistence.NewPayloadSerializer(),
+		domainCache:       s.mockDomainCache,
+		metricsClient:     metricsClient,
+		logger:            logger,
+		done:              make(chan struct{}),
+		replicationStatus: newReplicationStatusTracker(),
+	}
+}
+
+func (s *replicationTaskProcessorSuite) TearDownTest() {
+	s.mockExecutionMgr.AssertExpectations(s.T())
+	s.controller.Finish()
+}
+
+func (s *replicationTaskProcessorSuite) TestReadDLQMessages() {
+	taskInfo := &persistence.ReplicationTaskInfo{
+		DomainID:    uuid.New(),
+		WorkflowID:  "some random workflow ID",
+		RunID:       uuid.New(),
+		TaskID:      10,
+		TaskType:    persistence.ReplicationTaskTypeSyncActivity,
+		ScheduledID: 5,
+	}
+	s.mockExecutionMgr.On("GetReplicationTasksFromDLQ", persistence.NewGetReplicationTasksFromDLQRequest(
+		s.sourceCluster, defaultBeginningMessageID, 10, 100, nil,
+	)).Return(&persistence.GetReplicationTasksFromDLQResponse{
+		Tasks: []*persistence.ReplicationTaskInfo{
+			taskInfo,
+			{TaskID: 11},
+		},
+		NextPageToken: []byte{1},
+	}, nil).Once()
+
+	taskInfos, token, err := s.processor.readDLQMessages(10, 100, nil)
+	s.NoError(err)
+	s.Nil(token)
+	s.Equal([]*r.ReplicationTaskInfo{
+		{
+			DomainID:     common.StringPtr(taskInfo.DomainID),
+			WorkflowID:   common.StringPtr(taskInfo.WorkflowID),
+			RunID:        common.StringPtr(taskInfo.RunID),
+			TaskType:     common.Int16Ptr(int16(taskInfo.TaskType)),
+			TaskID:       common.Int64Ptr(taskInfo.TaskID),
+			Version:      common.Int64Ptr(0),
+			FirstEventID: common.Int64Ptr(0),
+			NextEventID:  common.Int64Ptr(0),
+			ScheduledID:  common.Int64Ptr(taskInfo.ScheduledID),
+		},
+	}, taskInfos)
+}
+
+func (s *replicationTaskProcessorSuite) TestPurgeDLQMessages() {
+	s.mockExecutionMgr.On("RangeDeleteReplicationTaskFromDLQ", &persistence.RangeDeleteReplicationTaskFromDLQRequest{
+		SourceClusterName:    s.sourceCluster,
+		ExclusiveBeginTaskID: defaultBeginningMessageID,
+		InclusiveEndTaskID:   10,
+	}).Return(nil).Once()
+	s.mockExecutionMgr.On("GetReplicationDLQSize", &persistence.GetReplicationDLQSizeRequest{
+		SourceClusterName: s.sourceCluster,
+	}).Return(&persistence.GetReplicationDLQSizeResponse{Size: 2}, nil).Once()
+
+	s.NoError(s.processor.purgeDLQMessages(10))
+	dlqSize, err := s.processor.getDLQSize()
+	s.NoError(err)
+	s.Equal(int64(2), dlqSize)
+}
+
+func (s *replicationTaskProcessorSuite) TestMergeDLQMessages() {
+	domainID := uuid.New()
+	workflowID := "some random workflow ID"
+	runID := uuid.New()
+	s.mockExecutionMgr.On("GetReplicationTasksFromDLQ", mock.Anything).Return(&persistence.GetReplicationTasksFromDLQResponse{
+		Tasks: []*persistence.ReplicationTaskInfo{
+			{
+				DomainID:    domainID,
+				WorkflowID:  workflowID,
+				RunID:       runID,
+				TaskID:      10,
+				TaskType:    persistence.ReplicationTaskTypeSyncActivity,
+				ScheduledID: 5,
+			},
+		},
+	}, nil).Once()
+	s.mockClientBean.EXPECT().GetRemoteAdminClient(s.sourceCluster).Return(s.mockAdminClient).Times(1)
+	s.mockAdminClient.EXPECT().GetDLQReplicationMessages(gomock.Any(), gomock.Any()).Return(&r.GetDLQReplicationMessagesResponse{
+		ReplicationTasks: []*r.ReplicationTask{
+			{
+				TaskType:     r.ReplicationTaskTypeSyncActivity.Ptr(),
+				SourceTaskId: common.Int64Ptr(10),
+				SyncActicvityTaskAttributes: &r.SyncActicvityTaskAttributes{
+					DomainId:    common.StringPtr(domainID),
+					WorkflowId:  common.StringPtr(workflowID),
+					RunId:       common.StringPtr(runID),
+					ScheduledId: common.Int64Ptr(5),
+				},
+			},
+		},
+	}, nil).Times(1)
+	s.mockDomainCache.EXPECT().GetDomainByID(domainID).Return(cache.NewGlobalDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: domainID},
+		&persistence.DomainConfig{},
+		&persistence.DomainReplicationConfig{
+			Clusters: []*persistence.ClusterReplicationConfig{
+				{ClusterName: cluster.TestCurrentClusterName},
+				{ClusterName: cluster.TestAlternativeClusterName},
+			},
+		},
+		0,
+		nil,
+	), nil).Times(1)
+	s.mockEngine.EXPECT().SyncActivity(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	s.mockExecutionMgr.On("RangeDeleteReplicationTaskFromDLQ", &persistence.RangeDeleteReplicationTaskFromDLQRequest{
+		SourceClusterName:    s.sourceCluster,
+		ExclusiveBeginTaskID: defaultBeginningMessageID,
+		InclusiveEndTaskID:   10,
+	}).Return(nil).Once()
+	s.mockExecutionMgr.On("GetReplicationDLQSize", &persistence.GetReplicationDLQSizeRequest{
+		SourceClusterName: s.sourceCluster,
+	}).Return(&persistence.GetReplicationDLQSizeResponse{Size: 0}, nil).Once()
+
+	token, err := s.processor.mergeDLQMessages(context.Background(), 10, 100, nil)
+	s.NoError(err)
+	s.Nil(token)
+}
+
+func (s *replicationTaskProcessorSuite) TestDescribeReplication() {
+	domainID := uuid.New()
+	task := &r.ReplicationTask{
+		TaskType:     r.ReplicationTaskTypeSyncActivity.Ptr(),
+		SourceTaskId: common.Int64Ptr(10),
+		SyncActicvityTaskAttributes: &r.SyncActicvityTaskAttributes{
+			DomainId:   common.StringPtr(domainID),
+			WorkflowId: common.StringPtr("some random workflow ID"),
+			RunId:      common.StringPtr(uuid.New()),
+		},
+	}
+	s.processor.replicationStatus.recordTaskApplied(task, time.Now())
+	s.processor.replicationStatus.recordResponse(10, 10, 20, false, time.Now())
+	s.mockExecutionMgr.On("GetReplicationDLQSize", &persistence.GetReplicationDLQSizeRequest{
+		SourceClusterName: s.sourceCluster,
+	}).Return(&persistence.GetReplicationDLQSizeResponse{Size: 3}, nil).Once()
+
+	status, err := s.processor.describeReplication(domainID)
+	s.NoError(err)
+	s.Equal(int32(0), status.GetShardID())
+	s.Equal(int64(10), status.GetTaskIDLag())
+	s.Equal(int64(3), status.GetDlqSize())
+	s.Len(status.Domains, 1)
+	s.Equal(int64(1), status.Domains[0].GetAppliedTaskCount())
+	s.Equal(int64(10), status.Domains[0].GetLastAppliedTaskID())
+
+	// the DLQ is not counted again once its size is known
+	s.mockExecutionMgr.On("PutReplicationTaskToDLQ", mock.Anything).Return(nil).Once()
+	s.NoError(s.processor.putReplicationTaskToDLQ(task))
+	status, err = s.processor.describeReplication(domainID)
+	s.NoError(err)
+	s.Equal(int64(4), status.GetDlqSize())
+}
+
+func (s *replicationTaskProcessorSuite) TestGenerateDLQRequest_HistoryV2() {
+	events := []*shared.HistoryEvent{
+		{EventId: common.Int64Ptr(5), Version: common.Int64Ptr(2)},
+		{EventId: common.Int64Ptr(6), Version: common.Int64Ptr(2)},
+		{EventId: common.Int64Ptr(7), Version: common.Int64Ptr(2)},
+	}
+	eventsBlob, err := s.processor.historySerializer.SerializeBatchEvents(events, common.EncodingTypeThriftRW)
+	s.NoError(err)
+	task := &r.ReplicationTask{
+		TaskType:     r.ReplicationTaskTypeHistoryV2.Ptr(),
+		SourceTaskId: common.Int64Ptr(10),
+		HistoryTaskV2Attributes: &r.HistoryTaskV2Attributes{
+			DomainId:   common.StringPtr(uuid.New()),
+			WorkflowId: common.StringPtr("some random workflow ID"),
+			RunId:      common.StringPtr(uuid.New()),
+			Events:     eventsBlob.ToThrift(),
+		},
+	}
+
+	request, err := s.processor.generateDLQRequest(task)
+	s.NoError(err)
+	s.Equal(int64(10), request.TaskInfo.TaskID)
+	s.Equal(int64(5), request.TaskInfo.FirstEventID)
+	// the replicator queue reads the events in [FirstEventID, NextEventID) when the task is merged
+	s.Equal(int64(8), request.TaskInfo.NextEventID)
+	s.Equal(int64(2), request.TaskInfo.Version)
+}
@@ -23,6 +23,7 @@ package history
 import (
 	ctx "context"
 	"errors"
+	"sync"
 	"time"
 
 	"github.com/uber/cadence/.gen/go/replicator"
@@ -30,6 +31,7 @@ import (
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/domain"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/messaging"
@@ -50,12 +52,17 @@ type (
 		options               *QueueProcessorOptions
 		logger                log.Logger
 		retryPolicy           backoff.RetryPolicy
+		historySerializer     persistence.PayloadSerializer
 		// This is the batch size used by pull based RPC replicator.
 		fetchTasksBatchSize int
 		*queueProcessorBase
 		queueAckMgr
 
 		lastShardSyncTimestamp time.Time
+
+		sync.RWMutex
+		// cluster -> last replication task ID processed by the remote cluster, exist only in memory
+		remoteAckLevels map[string]int64
 	}
 )
 
@@ -111,7 +118,9 @@ func newReplicatorQueueProcessor(
 		options:               options,
 		logger:                logger,
 		retryPolicy:           retryPolicy,
+		historySerializer:     persistence.NewPayloadSerializer(),
 		fetchTasksBatchSize:   config.ReplicatorProcessorFetchTasksBatchSize(),
+		remoteAckLevels:       make(map[string]int64),
 	}
 
 	queueAckMgr := newQueueAckMgr(shard, options, processor, shard.GetReplicatorAckLevel(), logger)
@@ -453,10 +462,11 @@ func (p *replicatorQueueProcessorImpl) getTasks(
 	}
 
 	// Note this is a very rough indicator of how much the remote DC is behind on this shard.
+	maxReadLevel := p.shard.GetTransferMaxReadLevel()
 	p.metricsClient.RecordTimer(
 		metrics.ReplicatorQueueProcessorScope,
 		metrics.ReplicationTasksLag,
-		time.Duration(p.shard.GetTransferMaxReadLevel()-readLevel),
+		time.Duration(maxReadLevel-readLevel),
 	)
 
 	p.metricsClient.RecordTimer(
@@ -475,9 +485,73 @@ func (p *replicatorQueueProcessorImpl) getTasks(
 		ReplicationTasks:      replicationTasks,
 		HasMore:               common.BoolPtr(hasMore),
 		LastRetrivedMessageId: common.Int64Ptr(readLevel),
+		MaxReadLevel:          common.Int64Ptr(maxReadLevel),
 	}, nil
 }
 
+func (p *replicatorQueueProcessorImpl) updateRemoteAckLevel(
+	cluster string,
+	ackLevel int64,
+) {
+
+	p.Lock()
+	defer p.Unlock()
+
+	p.remoteAckLevels[cluster] = ackLevel
+}
+
+// getRemoteAckLevel returns the task ID up to which all replication tasks of the shard are processed by the remote cluster
+func (p *replicatorQueueProcessorImpl) getRemoteAckLevel(
+	cluster string,
+	maxReadLevel int64,
+) (int64, error) {
+
+	p.RLock()
+	ackLevel, ok := p.remoteAckLevels[cluster]
+	p.RUnlock()
+	if !ok {
+		// the remote cluster has not polled this shard since the shard is loaded
+		return defaultBeginningMessageID, nil
+	}
+	if ackLevel >= maxReadLevel {
+		return ackLevel, nil
+	}
+
+	// task IDs are shared with other task types, so the ack level can move up to
+	// the task ID right before the first replication task not processed yet
+	response, err := p.executionMgr.GetReplicationTasks(&persistence.GetReplicationTasksRequest{
+		ReadLevel:    ackLevel,
+		MaxReadLevel: maxReadLevel,
+		BatchSize:    1,
+	})
+	if err != nil {
+		return 0, err
+	}
+	if len(response.Tasks) == 0 {
+		return maxReadLevel, nil
+	}
+	return response.Tasks[0].GetTaskID() - 1, nil
+}
+
+func (p *replicatorQueueProcessorImpl) getTask(
+	ctx ctx.Context,
+	taskInfo *replicator.ReplicationTaskInfo,
+) (*replicator.ReplicationTask, error) {
+
+	task := &persistence.ReplicationTaskInfo{
+		DomainID:     taskInfo.GetDomainID(),
+		WorkflowID:   taskInfo.GetWorkflowID(),
+		RunID:        taskInfo.GetRunID(),
+		TaskID:       taskInfo.GetTaskID(),
+		TaskType:     int(taskInfo.GetTaskType()),
+		FirstEventID: taskInfo.GetFirstEventID(),
+		NextEventID:  taskInfo.GetNextEventID(),
+		Version:      taskInfo.GetVersion(),
+		ScheduledID:  taskInfo.GetScheduledID(),
+	}
+	return p.toReplicationTask(ctx, task)
+}
+
 func (p *replicatorQueueProcessorImpl) readTasksWithBatchSize(readLevel int64, batchSize int) ([]queueTaskInfo, bool, error) {
 	response, err := p.executionMgr.GetReplicationTasks(&persistence.GetReplicationTasksRequest{
 		ReadLevel:    readLevel,
@@ -632,8 +706,17 @@ func (p *replicatorQueueProcessorImpl) generateHistoryReplicationTask(
 			}
 
 			// NDC workflow
+			branchToken := task.BranchToken
+			if len(branchToken) == 0 {
+				// tasks regenerated from DLQ do not carry the branch token
+				var err error
+				branchToken, err = p.getBranchToken(mutableState, task.FirstEventID, task.Version)
+				if err != nil {
+					return nil, err
+				}
+			}
 			eventsBlob, err := p.getEventsBlob(
-				task.BranchToken,
+				branchToken,
 				task.FirstEventID,
 				task.NextEventID,
 			)
@@ -663,6 +746,19 @@ func (p *replicatorQueueProcessorImpl) generateHistoryReplicationTask(
 				return nil, err
 			}
 
+			clusterMetadata := p.shard.GetClusterMetadata()
+			if task.Version == clusterMetadata.GetNextFailoverVersion(clusterMetadata.GetCurrentClusterName(), 0) {
+				// events written before the domain is promoted to a global domain carry the empty version
+				versionHistory := persistence.NewVersionHistoryFromThrift(&shared.VersionHistory{
+					BranchToken: branchToken,
+					Items:       versionHistoryItems,
+				})
+				eventsBlob, err = domain.StampEmptyEventVersions(eventsBlob, versionHistory, p.historySerializer)
+				if err != nil {
+					return nil, err
+				}
+			}
+
 			replicationTask := &replicator.ReplicationTask{
 				TaskType: replicator.ReplicationTaskType.Ptr(replicator.ReplicationTaskTypeHistoryV2),
 				HistoryTaskV2Attributes: &replicator.HistoryTaskV2Attributes{
@@ -751,6 +847,30 @@ func (p *replicatorQueueProcessorImpl) getVersionHistoryItems(
 	return versionHistory.ToThrift().Items, nil
 }
 
+func (p *replicatorQueueProcessorImpl) getBranchToken(
+	mutableState mutableState,
+	eventID int64,
+	version int64,
+) ([]byte, error) {
+
+	versionHistories := mutableState.GetVersionHistories()
+	versionHistoryIndex, err := versionHistories.FindFirstVersionHistoryIndexByItem(
+		persistence.NewVersionHistoryItem(
+			eventID,
+			version,
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	versionHistory, err := versionHistories.GetVersionHistory(versionHistoryIndex)
+	if err != nil {
+		return nil, err
+	}
+	return versionHistory.GetBranchToken(), nil
+}
+
 func (p *replicatorQueueProcessorImpl) processReplication(
 	ctx ctx.Context,
 	processTaskIfClosed bool,
@@ -777,7 +897,25 @@ func (p *replicatorQueueProcessorImpl) processReplication(
 			// workflow already finished, no need to process the replication task
 			return nil, nil
 		}
-		return action(msBuilder)
+		domainEntry, err := p.shard.GetDomainCache().GetDomainByID(domainID)
+		if err != nil {
+			return nil, err
+		}
+		replicationFilter := domainEntry.GetReplicationConfig().Filter
+		filter := domain.NewReplicationFilter(replicationFilter, p.historySerializer)
+		if len(replicationFilter.GetWorkflowTypes()) > 0 &&
+			!filter.ShouldReplicate(msBuilder.GetExecutionInfo().WorkflowTypeName) {
+			// workflow is excluded by the domain replication filter
+			return nil, nil
+		}
+		task, err := action(msBuilder)
+		if err != nil {
+			return nil, err
+		}
+		if err := filter.Apply(task); err != nil {
+			return nil, err
+		}
+		return task, nil
 	case *shared.EntityNotExistsError:
 		return nil, nil
 	default:
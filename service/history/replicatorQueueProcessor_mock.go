@@ -95,6 +95,21 @@ func (mr *MockReplicatorQueueProcessorMockRecorder) getTasks(arg0 interface{}, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "getTasks", reflect.TypeOf((*MockReplicatorQueueProcessor)(nil).getTasks), arg0, arg1)
 }
 
+// getTask mocks base method
+func (m *MockReplicatorQueueProcessor) getTask(arg0 context.Context, arg1 *replicator.ReplicationTaskInfo) (*replicator.ReplicationTask, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "getTask", arg0, arg1)
+	ret0, _ := ret[0].(*replicator.ReplicationTask)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// getTask indicates an expected call of getTask
+func (mr *MockReplicatorQueueProcessorMockRecorder) getTask(arg0 interface{}, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "getTask", reflect.TypeOf((*MockReplicatorQueueProcessor)(nil).getTask), arg0, arg1)
+}
+
 // notifyNewTask mocks base method
 func (m *MockReplicatorQueueProcessor) notifyNewTask() {
 	m.ctrl.T.Helper()
@@ -106,3 +121,30 @@ func (mr *MockReplicatorQueueProcessorMockRecorder) notifyNewTask() *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "notifyNewTask", reflect.TypeOf((*MockReplicatorQueueProcessor)(nil).notifyNewTask))
 }
+
+// updateRemoteAckLevel mocks base method
+func (m *MockReplicatorQueueProcessor) updateRemoteAckLevel(arg0 string, arg1 int64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "updateRemoteAckLevel", arg0, arg1)
+}
+
+// updateRemoteAckLevel indicates an expected call of updateRemoteAckLevel
+func (mr *MockReplicatorQueueProcessorMockRecorder) updateRemoteAckLevel(arg0 interface{}, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "updateRemoteAckLevel", reflect.TypeOf((*MockReplicatorQueueProcessor)(nil).updateRemoteAckLevel), arg0, arg1)
+}
+
+// getRemoteAckLevel mocks base method
+func (m *MockReplicatorQueueProcessor) getRemoteAckLevel(arg0 string, arg1 int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "getRemoteAckLevel", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// getRemoteAckLevel indicates an expected call of getRemoteAckLevel
+func (mr *MockReplicatorQueueProcessorMockRecorder) getRemoteAckLevel(arg0 interface{}, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "getRemoteAckLevel", reflect.TypeOf((*MockReplicatorQueueProcessor)(nil).getRemoteAckLevel), arg0, arg1)
+}
@@ -516,3 +516,37 @@ func (s *replicatorQueueProcessorSuite) TestPaginateHistoryWithShardID() {
 	s.Equal(1, size)
 	s.NoError(err)
 }
+
+func (s *replicatorQueueProcessorSuite) TestGetRemoteAckLevel_ClusterNotPolled() {
+	ackLevel, err := s.replicatorQueueProcessor.getRemoteAckLevel(cluster.TestAlternativeClusterName, 100)
+	s.NoError(err)
+	s.Equal(int64(defaultBeginningMessageID), ackLevel)
+}
+
+func (s *replicatorQueueProcessorSuite) TestGetRemoteAckLevel_PendingTask() {
+	s.replicatorQueueProcessor.updateRemoteAckLevel(cluster.TestAlternativeClusterName, 10)
+	s.mockExecutionMgr.On("GetReplicationTasks", &persistence.GetReplicationTasksRequest{
+		ReadLevel:    10,
+		MaxReadLevel: 100,
+		BatchSize:    1,
+	}).Return(&persistence.GetReplicationTasksResponse{
+		Tasks: []*persistence.ReplicationTaskInfo{{TaskID: 42}},
+	}, nil).Once()
+
+	ackLevel, err := s.replicatorQueueProcessor.getRemoteAckLevel(cluster.TestAlternativeClusterName, 100)
+	s.NoError(err)
+	s.Equal(int64(41), ackLevel)
+}
+
+func (s *replicatorQueueProcessorSuite) TestGetRemoteAckLevel_NoPendingTask() {
+	s.replicatorQueueProcessor.updateRemoteAckLevel(cluster.TestAlternativeClusterName, 10)
+	s.mockExecutionMgr.On("GetReplicationTasks", &persistence.GetReplicationTasksRequest{
+		ReadLevel:    10,
+		MaxReadLevel: 100,
+		BatchSize:    1,
+	}).Return(&persistence.GetReplicationTasksResponse{}, nil).Once()
+
+	ackLevel, err := s.replicatorQueueProcessor.getRemoteAckLevel(cluster.TestAlternativeClusterName, 100)
+	s.NoError(err)
+	s.Equal(int64(100), ackLevel)
+}
@@ -53,6 +53,7 @@ type Config struct {
 	AdvancedVisibilityWritingMode   dynamicconfig.StringPropertyFn
 	EmitShardDiffLog                dynamicconfig.BoolPropertyFn
 	MaxAutoResetPoints              dynamicconfig.IntPropertyFnWithDomainFilter
+	EnableStateSearchAttributes     dynamicconfig.BoolPropertyFnWithDomainFilter
 	ThrottledLogRPS                 dynamicconfig.IntPropertyFn
 
 	// HistoryCache settings
@@ -94,9 +95,12 @@ type Config struct {
 	TimerProcessorArchivalTimeLimit                  dynamicconfig.DurationPropertyFn
 
 	// TransferQueueProcessor settings
-	TransferTaskBatchSize                               dynamicconfig.IntPropertyFn
-	TransferTaskWorkerCount                             dynamicconfig.IntPropertyFn
-	TransferTaskMaxRetryCount                           dynamicconfig.IntPropertyFn
+	TransferTaskBatchSize                    dynamicconfig.IntPropertyFn
+	TransferTaskWorkerCount                  dynamicconfig.IntPropertyFn
+	TransferTaskMaxRetryCount                dynamicconfig.IntPropertyFn
+	TransferCrossClusterTaskMaxRetryDuration dynamicconfig.DurationPropertyFnWithDomainFilter
+	// AdminOperationToken is passed to the admin APIs of the remote clusters called by cross cluster transfer tasks
+	AdminOperationToken                                 dynamicconfig.StringPropertyFn
 	TransferProcessorCompleteTransferFailureRetryCount  dynamicconfig.IntPropertyFn
 	TransferProcessorFailoverMaxPollRPS                 dynamicconfig.IntPropertyFn
 	TransferProcessorMaxPollRPS                         dynamicconfig.IntPropertyFn
@@ -159,8 +163,21 @@ type Config struct {
 	HistoryCountLimitError dynamicconfig.IntPropertyFnWithDomainFilter
 	HistoryCountLimitWarn  dynamicconfig.IntPropertyFnWithDomainFilter
 
+	// Multi-tenancy limit related settings
+	OpenWorkflowsLimit             dynamicconfig.IntPropertyFnWithDomainFilter
+	OpenWorkflowsCountCacheTTL     dynamicconfig.DurationPropertyFn
+	DomainHistorySizeLimitPerShard dynamicconfig.IntPropertyFnWithDomainFilter
+	PendingActivitiesLimit         dynamicconfig.IntPropertyFnWithDomainFilter
+	PendingChildWorkflowsLimit     dynamicconfig.IntPropertyFnWithDomainFilter
+	PendingTimersLimit             dynamicconfig.IntPropertyFnWithDomainFilter
+	// ActivityTypeMaxOutstanding is the max outstanding tasks per activity type enforced by matching,
+	// closed activities are only reported to matching for the activity types limited by it
+	ActivityTypeMaxOutstanding dynamicconfig.MapPropertyFnWithTaskListInfoFilters
+
 	// ValidSearchAttributes is legal indexed keys that can be used in list APIs
 	ValidSearchAttributes             dynamicconfig.MapPropertyFn
+	SearchAttributeStatus             dynamicconfig.MapPropertyFn
+	SearchAttributeAliases            dynamicconfig.MapPropertyFn
 	SearchAttributesNumberOfKeysLimit dynamicconfig.IntPropertyFnWithDomainFilter
 	SearchAttributesSizeOfValueLimit  dynamicconfig.IntPropertyFnWithDomainFilter
 	SearchAttributesTotalSizeLimit    dynamicconfig.IntPropertyFnWithDomainFilter
@@ -207,6 +224,7 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int, storeType strin
 		VisibilityOpenMaxQPS:                                  dc.GetIntPropertyFilteredByDomain(dynamicconfig.HistoryVisibilityOpenMaxQPS, 300),
 		VisibilityClosedMaxQPS:                                dc.GetIntPropertyFilteredByDomain(dynamicconfig.HistoryVisibilityClosedMaxQPS, 300),
 		MaxAutoResetPoints:                                    dc.GetIntPropertyFilteredByDomain(dynamicconfig.HistoryMaxAutoResetPoints, defaultHistoryMaxAutoResetPoints),
+		EnableStateSearchAttributes:                           dc.GetBoolPropertyFnWithDomainFilter(dynamicconfig.EnableStateSearchAttributes, false),
 		MaxDecisionStartToCloseSeconds:                        dc.GetIntPropertyFilteredByDomain(dynamicconfig.MaxDecisionStartToCloseSeconds, 240),
 		AdvancedVisibilityWritingMode:                         dc.GetStringProperty(dynamicconfig.AdvancedVisibilityWritingMode, common.GetDefaultAdvancedVisibilityWritingMode(isAdvancedVisConfigExist)),
 		EmitShardDiffLog:                                      dc.GetBoolProperty(dynamicconfig.EmitShardDiffLog, false),
@@ -241,6 +259,8 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int, storeType strin
 		TransferProcessorMaxPollRPS:                           dc.GetIntProperty(dynamicconfig.TransferProcessorMaxPollRPS, 20),
 		TransferTaskWorkerCount:                               dc.GetIntProperty(dynamicconfig.TransferTaskWorkerCount, 10),
 		TransferTaskMaxRetryCount:                             dc.GetIntProperty(dynamicconfig.TransferTaskMaxRetryCount, 100),
+		TransferCrossClusterTaskMaxRetryDuration:              dc.GetDurationPropertyFilteredByDomain(dynamicconfig.TransferCrossClusterTaskMaxRetryDuration, time.Hour),
+		AdminOperationToken:                                   dc.GetStringProperty(dynamicconfig.AdminOperationToken, common.DefaultAdminOperationToken),
 		TransferProcessorCompleteTransferFailureRetryCount:    dc.GetIntProperty(dynamicconfig.TransferProcessorCompleteTransferFailureRetryCount, 10),
 		TransferProcessorMaxPollInterval:                      dc.GetDurationProperty(dynamicconfig.TransferProcessorMaxPollInterval, 1*time.Minute),
 		TransferProcessorMaxPollIntervalJitterCoefficient:     dc.GetFloat64Property(dynamicconfig.TransferProcessorMaxPollIntervalJitterCoefficient, 0.15),
@@ -282,9 +302,19 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int, storeType strin
 		HistoryCountLimitError: dc.GetIntPropertyFilteredByDomain(dynamicconfig.HistoryCountLimitError, 200*1024),
 		HistoryCountLimitWarn:  dc.GetIntPropertyFilteredByDomain(dynamicconfig.HistoryCountLimitWarn, 50*1024),
 
+		OpenWorkflowsLimit:             dc.GetIntPropertyFilteredByDomain(dynamicconfig.OpenWorkflowsLimit, 0),
+		OpenWorkflowsCountCacheTTL:     dc.GetDurationProperty(dynamicconfig.OpenWorkflowsCountCacheTTL, 10*time.Second),
+		DomainHistorySizeLimitPerShard: dc.GetIntPropertyFilteredByDomain(dynamicconfig.DomainHistorySizeLimitPerShard, 0),
+		PendingActivitiesLimit:         dc.GetIntPropertyFilteredByDomain(dynamicconfig.PendingActivitiesLimit, 0),
+		PendingChildWorkflowsLimit:     dc.GetIntPropertyFilteredByDomain(dynamicconfig.PendingChildWorkflowsLimit, 0),
+		PendingTimersLimit:             dc.GetIntPropertyFilteredByDomain(dynamicconfig.PendingTimersLimit, 0),
+		ActivityTypeMaxOutstanding:     dc.GetMapPropertyFilteredByTaskListInfo(dynamicconfig.MatchingActivityTypeMaxOutstanding, nil),
+
 		ThrottledLogRPS: dc.GetIntProperty(dynamicconfig.HistoryThrottledLogRPS, 4),
 
 		ValidSearchAttributes:             dc.GetMapProperty(dynamicconfig.ValidSearchAttributes, definition.GetDefaultIndexedKeys()),
+		SearchAttributeStatus:             dc.GetMapProperty(dynamicconfig.SearchAttributeStatus, map[string]interface{}{}),
+		SearchAttributeAliases:            dc.GetMapProperty(dynamicconfig.SearchAttributeAliases, map[string]interface{}{}),
 		SearchAttributesNumberOfKeysLimit: dc.GetIntPropertyFilteredByDomain(dynamicconfig.SearchAttributesNumberOfKeysLimit, 100),
 		SearchAttributesSizeOfValueLimit:  dc.GetIntPropertyFilteredByDomain(dynamicconfig.SearchAttributesSizeOfValueLimit, 2*1024),
 		SearchAttributesTotalSizeLimit:    dc.GetIntPropertyFilteredByDomain(dynamicconfig.SearchAttributesTotalSizeLimit, 40*1024),
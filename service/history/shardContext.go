@@ -100,6 +100,9 @@ type (
 		ConflictResolveWorkflowExecution(request *persistence.ConflictResolveWorkflowExecutionRequest) error
 		ResetWorkflowExecution(request *persistence.ResetWorkflowExecutionRequest) error
 		AppendHistoryV2Events(request *persistence.AppendHistoryNodesRequest, domainID string, execution shared.WorkflowExecution) (int, error)
+
+		GetDomainHistorySize(domainID string) int64
+		ReleaseDomainHistorySize(domainID string, size int64)
 	}
 
 	shardContextImpl struct {
@@ -132,6 +135,9 @@ type (
 
 		// exist only in memory
 		standbyClusterCurrentTime map[string]time.Time
+		// domainHistorySizes is the history size appended by each domain since the shard is loaded,
+		// until released by deleting the workflows
+		domainHistorySizes map[string]int64
 	}
 )
 
@@ -803,9 +809,53 @@ func (s *shardContextImpl) AppendHistoryV2Events(
 	if resp != nil {
 		size = resp.Size
 	}
+	if err0 == nil {
+		s.addDomainHistorySize(domainID, int64(size))
+	}
 	return size, err0
 }
 
+// GetDomainHistorySize returns the history size of the domain on this shard, counted since the shard is loaded
+func (s *shardContextImpl) GetDomainHistorySize(
+	domainID string,
+) int64 {
+
+	s.RLock()
+	defer s.RUnlock()
+	return s.domainHistorySizes[domainID]
+}
+
+// ReleaseDomainHistorySize subtracts the history size of a deleted workflow from the domain
+func (s *shardContextImpl) ReleaseDomainHistorySize(
+	domainID string,
+	size int64,
+) {
+
+	s.Lock()
+	defer s.Unlock()
+	// workflows appended before the shard was loaded are not counted
+	if current, ok := s.domainHistorySizes[domainID]; ok {
+		if current <= size {
+			delete(s.domainHistorySizes, domainID)
+		} else {
+			s.domainHistorySizes[domainID] = current - size
+		}
+	}
+}
+
+func (s *shardContextImpl) addDomainHistorySize(
+	domainID string,
+	size int64,
+) {
+
+	s.Lock()
+	defer s.Unlock()
+	if s.domainHistorySizes == nil {
+		s.domainHistorySizes = make(map[string]int64)
+	}
+	s.domainHistorySizes[domainID] += size
+}
+
 func (s *shardContextImpl) GetConfig() *Config {
 	return s.config
 }
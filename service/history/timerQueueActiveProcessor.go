@@ -376,6 +376,7 @@ func (t *timerQueueActiveProcessorImpl) processActivityTimeout(
 	referenceTime := t.shard.GetTimeSource().Now()
 	updateMutableState := false
 	scheduleDecision := false
+	var activityReleases []*m.RecordTaskCompletionRequest
 
 	// need to clear activity heartbeat timer task mask for new activity timer task creation
 	isHeartBeatTask := task.TimeoutType == int(workflow.TimeoutTypeHeartbeat)
@@ -406,6 +407,9 @@ Loop:
 			break Loop
 		}
 
+		if activityRelease := newActivityTaskRelease(t.config, mutableState, activityInfo); activityRelease != nil {
+			activityReleases = append(activityReleases, activityRelease)
+		}
 		if timerSequenceID.timerType != timerTypeScheduleToStart {
 			// schedule to start timeout is not retriable
 			// customer should set larger schedule to start timeout if necessary
@@ -441,7 +445,13 @@ Loop:
 	if !updateMutableState {
 		return nil
 	}
-	return t.updateWorkflowExecution(context, mutableState, scheduleDecision)
+	if err := t.updateWorkflowExecution(context, mutableState, scheduleDecision); err != nil {
+		return err
+	}
+	if len(activityReleases) > 0 {
+		t.historyService.activityTaskReleaser.release(activityReleases)
+	}
+	return nil
 }
 
 func (t *timerQueueActiveProcessorImpl) processDecisionTimeout(
@@ -622,6 +632,7 @@ func (t *timerQueueActiveProcessorImpl) processActivityRetryTimer(
 		Name: common.StringPtr(activityInfo.TaskList),
 	}
 	scheduleToStartTimeout := activityInfo.ScheduleToStartTimeout
+	activityType, affinityToken := getActivityDispatchInfo(mutableState, scheduledID)
 
 	release(nil) // release earlier as we don't need the lock anymore
 
@@ -632,6 +643,8 @@ func (t *timerQueueActiveProcessorImpl) processActivityRetryTimer(
 		TaskList:                      taskList,
 		ScheduleId:                    common.Int64Ptr(scheduledID),
 		ScheduleToStartTimeoutSeconds: common.Int32Ptr(scheduleToStartTimeout),
+		ActivityType:                  common.StringPtr(activityType),
+		AffinityToken:                 common.StringPtr(affinityToken),
 	})
 }
 
@@ -106,6 +106,7 @@ func (s *timerQueueActiveProcessorSuite) SetupTest() {
 	s.mockEventsCache = NewMockeventsCache(s.controller)
 	s.mockDomainCache = cache.NewMockDomainCache(s.controller)
 	s.mockMatchingClient = matchingservicetest.NewMockClient(s.controller)
+	s.mockMatchingClient.EXPECT().RecordTaskCompletion(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	s.mockTxProcessor.EXPECT().NotifyNewTask(gomock.Any(), gomock.Any()).AnyTimes()
 	s.mockReplicationProcessor.EXPECT().notifyNewTask().AnyTimes()
 	s.mockTimerProcessor.EXPECT().NotifyNewTimers(gomock.Any(), gomock.Any()).AnyTimes()
@@ -1016,6 +1017,8 @@ func (s *timerQueueActiveProcessorSuite) TestActivityRetryTimer_Fire() {
 			},
 			ScheduleId:                    common.Int64Ptr(activityInfo.ScheduleID),
 			ScheduleToStartTimeoutSeconds: common.Int32Ptr(activityInfo.ScheduleToStartTimeout),
+			ActivityType:                  common.StringPtr(activityType),
+			AffinityToken:                 common.StringPtr(""),
 		},
 	).Return(nil).Times(1)
 
@@ -453,6 +453,7 @@ func (t *timerQueueProcessorBase) deleteWorkflow(
 	if err := t.deleteWorkflowExecution(task); err != nil {
 		return err
 	}
+	t.shard.ReleaseDomainHistorySize(task.DomainID, context.getHistorySize())
 
 	if err := t.deleteWorkflowHistory(task, msBuilder); err != nil {
 		return err
@@ -516,6 +517,7 @@ func (t *timerQueueProcessorBase) archiveWorkflow(
 	if err := t.deleteWorkflowExecution(task); err != nil {
 		return err
 	}
+	t.shard.ReleaseDomainHistorySize(task.DomainID, workflowContext.getHistorySize())
 	// delete workflow history if history archival is not needed or history as been archived inline
 	if resp.HistoryArchivedInline {
 		t.metricsClient.IncCounter(metrics.HistoryProcessDeleteHistoryEventScope, metrics.WorkflowCleanupDeleteHistoryInlineCount)
@@ -26,9 +26,12 @@ import (
 	"time"
 
 	"github.com/pborman/uuid"
+	"go.uber.org/yarpc/yarpcerrors"
 
+	a "github.com/uber/cadence/.gen/go/admin"
 	h "github.com/uber/cadence/.gen/go/history"
 	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/client/admin"
 	"github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common"
@@ -45,6 +48,9 @@ const identityHistoryService = "history-service"
 
 const transferActiveTaskDefaultTimeout = 30 * time.Second
 
+// errTargetClusterUnreachable indicates the cluster where the target domain is active cannot be called
+var errTargetClusterUnreachable = &workflow.InternalServiceError{Message: "Target cluster is unreachable."}
+
 type (
 	transferQueueActiveProcessorImpl struct {
 		currentClusterName      string
@@ -357,10 +363,11 @@ func (t *transferQueueActiveProcessorImpl) processActivityTask(
 	}
 
 	timeout := common.MinInt32(ai.ScheduleToStartTimeout, common.MaxTaskTimeout)
+	activityType, affinityToken := getActivityDispatchInfo(mutableState, ai.ScheduleID)
 	// release the context lock since we no longer need mutable state builder and
 	// the rest of logic is making RPC call, which takes time.
 	release(nil)
-	return t.pushActivity(task, timeout)
+	return t.pushActivity(task, timeout, activityType, affinityToken)
 }
 
 func (t *transferQueueActiveProcessorImpl) processDecisionTask(
@@ -499,9 +506,7 @@ func (t *transferQueueActiveProcessorImpl) processCloseExecution(
 
 	// Communicate the result to parent execution if this is Child Workflow execution
 	if replyToParentWorkflow {
-		ctx, cancel := ctx.WithTimeout(ctx.Background(), transferActiveTaskDefaultTimeout)
-		defer cancel()
-		err = t.historyClient.RecordChildExecutionCompleted(ctx, &h.RecordChildExecutionCompletedRequest{
+		err = t.recordChildExecutionCompleted(&h.RecordChildExecutionCompletedRequest{
 			DomainUUID: common.StringPtr(parentDomainID),
 			WorkflowExecution: &workflow.WorkflowExecution{
 				WorkflowId: common.StringPtr(parentWorkflowID),
@@ -663,19 +668,25 @@ func (t *transferQueueActiveProcessorImpl) processSignalExecution(
 			task.TargetWorkflowID,
 			task.TargetRunID,
 			signalInfo.Control,
+			workflow.SignalExternalWorkflowExecutionFailedCauseUnknownExternalWorkflowExecution,
 		)
 	}
 
+	targetCluster := t.getTargetCluster(targetDomainEntry)
 	if err = t.signalExternalExecutionWithRetry(
 		task,
 		targetDomain,
+		targetCluster,
 		signalInfo,
 	); err != nil {
 		t.logger.Debug(fmt.Sprintf("Failed to signal external workflow execution. Error: %v", err))
 
 		// Check to see if the error is non-transient, in which case add SignalFailed
 		// event and complete transfer task by setting the err = nil
-		if !common.IsServiceNonRetryableError(err) {
+		cause := workflow.SignalExternalWorkflowExecutionFailedCauseUnknownExternalWorkflowExecution
+		if t.isTargetClusterUnreachable(task, targetDomain, targetCluster, err) {
+			cause = workflow.SignalExternalWorkflowExecutionFailedCauseTargetClusterUnreachable
+		} else if !common.IsServiceNonRetryableError(err) {
 			// for retryable error just return
 			return err
 		}
@@ -686,6 +697,7 @@ func (t *transferQueueActiveProcessorImpl) processSignalExecution(
 			task.TargetWorkflowID,
 			task.TargetRunID,
 			signalInfo.Control,
+			cause,
 		)
 	}
 
@@ -713,14 +725,28 @@ func (t *transferQueueActiveProcessorImpl) processSignalExecution(
 	// remove signalRequestedID from target workflow, after Signal detail is removed from source workflow
 	ctx, cancel := ctx.WithTimeout(ctx.Background(), transferActiveTaskDefaultTimeout)
 	defer cancel()
-	return t.historyClient.RemoveSignalMutableState(ctx, &h.RemoveSignalMutableStateRequest{
+	request := &h.RemoveSignalMutableStateRequest{
 		DomainUUID: common.StringPtr(task.TargetDomainID),
 		WorkflowExecution: &workflow.WorkflowExecution{
 			WorkflowId: common.StringPtr(task.TargetWorkflowID),
 			RunId:      common.StringPtr(task.TargetRunID),
 		},
 		RequestId: common.StringPtr(signalInfo.SignalRequestID),
-	})
+	}
+	if targetCluster != t.currentClusterName {
+		adminClient, err := t.getTargetClusterAdminClient(targetCluster)
+		if err != nil {
+			return err
+		}
+		return t.convertTargetClusterError(
+			targetCluster,
+			adminClient.RemoveSignalMutableState(ctx, &a.RemoveSignalMutableStateRequest{
+				RemoveRequest: request,
+				SecurityToken: common.StringPtr(t.shard.GetConfig().AdminOperationToken()),
+			}),
+		)
+	}
+	return t.historyClient.RemoveSignalMutableState(ctx, request)
 }
 
 func (t *transferQueueActiveProcessorImpl) processStartChildExecution(
@@ -755,16 +781,20 @@ func (t *transferQueueActiveProcessorImpl) processStartChildExecution(
 		domain = domainEntry.GetInfo().Name
 	}
 
-	// Get target domain name
+	// Get target domain name and the cluster where the target domain is active
 	var targetDomain string
-	if domainEntry, err := t.shard.GetDomainCache().GetDomainByID(task.TargetDomainID); err != nil {
+	targetCluster := t.currentClusterName
+	targetDomainEntry, err := t.shard.GetDomainCache().GetDomainByID(task.TargetDomainID)
+	if err != nil {
 		if _, ok := err.(*workflow.EntityNotExistsError); !ok {
 			return err
 		}
 		// it is possible that the domain got deleted. Use domainID instead as this is only needed for the history event
 		targetDomain = task.TargetDomainID
+		targetDomainEntry = nil
 	} else {
-		targetDomain = domainEntry.GetInfo().Name
+		targetDomain = targetDomainEntry.GetInfo().Name
+		targetCluster = t.getTargetCluster(targetDomainEntry)
 	}
 
 	initiatedEventID := task.ScheduleID
@@ -784,6 +814,10 @@ func (t *transferQueueActiveProcessorImpl) processStartChildExecution(
 
 	// ChildExecution already started, just create DecisionTask and complete transfer task
 	if childInfo.StartedID != common.EmptyEventID {
+		if targetCluster != t.currentClusterName {
+			// first decision task is scheduled by the remote cluster when the child is started
+			return nil
+		}
 		childExecution := &workflow.WorkflowExecution{
 			WorkflowId: common.StringPtr(childInfo.StartedWorkflowID),
 			RunId:      common.StringPtr(childInfo.StartedRunID),
@@ -792,10 +826,26 @@ func (t *transferQueueActiveProcessorImpl) processStartChildExecution(
 	}
 
 	attributes := initiatedEvent.StartChildWorkflowExecutionInitiatedEventAttributes
+	if targetDomainEntry != nil && targetCluster == t.currentClusterName {
+		err := t.historyService.checkOpenWorkflowsLimit(targetDomainEntry, metrics.TransferActiveTaskStartChildExecutionScope)
+		if err == ErrOpenWorkflowsLimitExceeded {
+			// retrying would block the transfer queue until open workflows of the target domain are closed
+			return t.recordStartChildExecutionFailed(
+				task,
+				context,
+				attributes,
+				workflow.ChildWorkflowExecutionFailedCauseOpenWorkflowsLimitExceeded,
+			)
+		}
+		if err != nil {
+			return err
+		}
+	}
 	childRunID, err := t.startWorkflowWithRetry(
 		task,
 		domain,
 		targetDomain,
+		targetCluster,
 		childInfo,
 		attributes,
 	)
@@ -804,9 +854,21 @@ func (t *transferQueueActiveProcessorImpl) processStartChildExecution(
 
 		// Check to see if the error is non-transient, in which case add StartChildWorkflowExecutionFailed
 		// event and complete transfer task by setting the err = nil
-		switch err.(type) {
-		case *workflow.WorkflowExecutionAlreadyStartedError:
-			err = t.recordStartChildExecutionFailed(task, context, attributes)
+		if _, ok := err.(*workflow.WorkflowExecutionAlreadyStartedError); ok {
+			return t.recordStartChildExecutionFailed(
+				task,
+				context,
+				attributes,
+				workflow.ChildWorkflowExecutionFailedCauseWorkflowAlreadyRunning,
+			)
+		}
+		if t.isTargetClusterUnreachable(task, targetDomain, targetCluster, err) {
+			return t.recordStartChildExecutionFailed(
+				task,
+				context,
+				attributes,
+				workflow.ChildWorkflowExecutionFailedCauseTargetClusterUnreachable,
+			)
 		}
 		return err
 	}
@@ -820,6 +882,10 @@ func (t *transferQueueActiveProcessorImpl) processStartChildExecution(
 	if err != nil {
 		return err
 	}
+	if targetCluster != t.currentClusterName {
+		// first decision task is scheduled by the remote cluster when the child is started
+		return nil
+	}
 	// Finally create first decision task for Child execution so it is really started
 	return t.createFirstDecisionTask(task.TargetDomainID, &workflow.WorkflowExecution{
 		WorkflowId: common.StringPtr(task.TargetWorkflowID),
@@ -1079,6 +1145,7 @@ func (t *transferQueueActiveProcessorImpl) recordStartChildExecutionFailed(
 	task *persistence.TransferTaskInfo,
 	context workflowExecutionContext,
 	initiatedAttributes *workflow.StartChildWorkflowExecutionInitiatedEventAttributes,
+	cause workflow.ChildWorkflowExecutionFailedCause,
 ) error {
 
 	return t.updateWorkflowExecution(context, true,
@@ -1093,8 +1160,7 @@ func (t *transferQueueActiveProcessorImpl) recordStartChildExecutionFailed(
 				return &workflow.EntityNotExistsError{Message: "Pending child execution not found."}
 			}
 
-			_, err := mutableState.AddStartChildWorkflowExecutionFailedEvent(initiatedEventID,
-				workflow.ChildWorkflowExecutionFailedCauseWorkflowAlreadyRunning, initiatedAttributes)
+			_, err := mutableState.AddStartChildWorkflowExecutionFailedEvent(initiatedEventID, cause, initiatedAttributes)
 
 			return err
 		})
@@ -1248,6 +1314,7 @@ func (t *transferQueueActiveProcessorImpl) signalExternalExecutionFailed(
 	targetWorkflowID string,
 	targetRunID string,
 	control []byte,
+	cause workflow.SignalExternalWorkflowExecutionFailedCause,
 ) error {
 
 	err := t.updateWorkflowExecution(context, true,
@@ -1269,7 +1336,7 @@ func (t *transferQueueActiveProcessorImpl) signalExternalExecutionFailed(
 				targetWorkflowID,
 				targetRunID,
 				control,
-				workflow.SignalExternalWorkflowExecutionFailedCauseUnknownExternalWorkflowExecution,
+				cause,
 			)
 			return err
 		})
@@ -1354,6 +1421,7 @@ func (t *transferQueueActiveProcessorImpl) requestCancelExternalExecutionWithRet
 func (t *transferQueueActiveProcessorImpl) signalExternalExecutionWithRetry(
 	task *persistence.TransferTaskInfo,
 	targetDomain string,
+	targetCluster string,
 	signalInfo *persistence.SignalInfo,
 ) error {
 
@@ -1381,17 +1449,32 @@ func (t *transferQueueActiveProcessorImpl) signalExternalExecutionWithRetry(
 
 	ctx, cancel := ctx.WithTimeout(ctx.Background(), transferActiveTaskDefaultTimeout)
 	defer cancel()
+	if targetCluster != t.currentClusterName {
+		adminClient, err := t.getTargetClusterAdminClient(targetCluster)
+		if err != nil {
+			return err
+		}
+		return t.convertTargetClusterError(
+			targetCluster,
+			adminClient.SignalExternalWorkflowExecution(ctx, &a.SignalExternalWorkflowExecutionRequest{
+				SignalRequest: request,
+				SecurityToken: common.StringPtr(t.shard.GetConfig().AdminOperationToken()),
+			}),
+		)
+	}
 	op := func() error {
 		return t.historyClient.SignalWorkflowExecution(ctx, request)
 	}
 
-	return backoff.Retry(op, persistenceOperationRetryPolicy, common.IsPersistenceTransientError)
+	err := backoff.Retry(op, persistenceOperationRetryPolicy, common.IsPersistenceTransientError)
+	return t.convertTargetClusterError(targetCluster, err)
 }
 
 func (t *transferQueueActiveProcessorImpl) startWorkflowWithRetry(
 	task *persistence.TransferTaskInfo,
 	domain string,
 	targetDomain string,
+	targetCluster string,
 	childInfo *persistence.ChildExecutionInfo,
 	attributes *workflow.StartChildWorkflowExecutionInitiatedEventAttributes,
 ) (string, error) {
@@ -1438,6 +1521,20 @@ func (t *transferQueueActiveProcessorImpl) startWorkflowWithRetry(
 	defer cancel()
 	var response *workflow.StartWorkflowExecutionResponse
 	var err error
+	if targetCluster != t.currentClusterName {
+		adminClient, err := t.getTargetClusterAdminClient(targetCluster)
+		if err != nil {
+			return "", err
+		}
+		response, err = adminClient.StartChildWorkflowExecution(ctx, &a.StartChildWorkflowExecutionRequest{
+			StartRequest:  request,
+			SecurityToken: common.StringPtr(t.shard.GetConfig().AdminOperationToken()),
+		})
+		if err != nil {
+			return "", t.convertTargetClusterError(targetCluster, err)
+		}
+		return response.GetRunId(), nil
+	}
 	op := func() error {
 		response, err = t.historyClient.StartWorkflowExecution(ctx, request)
 		return err
@@ -1445,11 +1542,107 @@ func (t *transferQueueActiveProcessorImpl) startWorkflowWithRetry(
 
 	err = backoff.Retry(op, persistenceOperationRetryPolicy, common.IsPersistenceTransientError)
 	if err != nil {
-		return "", err
+		return "", t.convertTargetClusterError(targetCluster, err)
 	}
 	return response.GetRunId(), nil
 }
 
+// recordChildExecutionCompleted records the child completion to the parent workflow,
+// in the cluster where the parent domain is active
+func (t *transferQueueActiveProcessorImpl) recordChildExecutionCompleted(
+	request *h.RecordChildExecutionCompletedRequest,
+) error {
+
+	parentCluster := t.currentClusterName
+	if domainEntry, err := t.domainCache.GetDomainByID(request.GetDomainUUID()); err == nil {
+		parentCluster = t.getTargetCluster(domainEntry)
+	}
+
+	ctx, cancel := ctx.WithTimeout(ctx.Background(), transferActiveTaskDefaultTimeout)
+	defer cancel()
+	if parentCluster != t.currentClusterName {
+		adminClient, err := t.getTargetClusterAdminClient(parentCluster)
+		if err != nil {
+			// parent workflow cannot be failed from this cluster, keep retrying
+			return err
+		}
+		return t.convertTargetClusterError(
+			parentCluster,
+			adminClient.RecordChildExecutionCompleted(ctx, &a.RecordChildExecutionCompletedRequest{
+				CompletionRequest: request,
+				SecurityToken:     common.StringPtr(t.shard.GetConfig().AdminOperationToken()),
+			}),
+		)
+	}
+	return t.convertTargetClusterError(
+		parentCluster,
+		t.historyClient.RecordChildExecutionCompleted(ctx, request),
+	)
+}
+
+// getTargetCluster returns the cluster where the target domain of a transfer task is active
+func (t *transferQueueActiveProcessorImpl) getTargetCluster(
+	domainEntry *cache.DomainCacheEntry,
+) string {
+
+	if !domainEntry.IsGlobalDomain() {
+		return t.currentClusterName
+	}
+	return domainEntry.GetReplicationConfig().ActiveClusterName
+}
+
+func (t *transferQueueActiveProcessorImpl) getTargetClusterAdminClient(
+	targetCluster string,
+) (admin.Client, error) {
+
+	clusterInfo, ok := t.shard.GetService().GetClusterMetadata().GetAllClusterInfo()[targetCluster]
+	if !ok || !clusterInfo.Enabled {
+		return nil, errTargetClusterUnreachable
+	}
+	return t.shard.GetService().GetClientBean().GetRemoteAdminClient(targetCluster), nil
+}
+
+// convertTargetClusterError converts the transient errors of calling the target cluster
+// to an error retried by the transfer task, instead of failing or dropping the task
+func (t *transferQueueActiveProcessorImpl) convertTargetClusterError(
+	targetCluster string,
+	err error,
+) error {
+
+	switch err := err.(type) {
+	case *workflow.DomainNotActiveError:
+		// target domain is being failed over, the task is retried
+		// until the domain cache is refreshed and the call is routed to the new active cluster
+		return &workflow.InternalServiceError{Message: fmt.Sprintf("Target domain is not active: %v", err.Message)}
+	case *yarpcerrors.Status:
+		if targetCluster != t.currentClusterName {
+			return &workflow.InternalServiceError{
+				Message: fmt.Sprintf("Failed to call target cluster %v: %v", targetCluster, err.Message()),
+			}
+		}
+	}
+	return err
+}
+
+// isTargetClusterUnreachable returns whether the failure of calling the target cluster should be recorded
+// in the source workflow, after the transfer task is retried longer than the max retry duration
+func (t *transferQueueActiveProcessorImpl) isTargetClusterUnreachable(
+	task *persistence.TransferTaskInfo,
+	targetDomain string,
+	targetCluster string,
+	err error,
+) bool {
+
+	if err == errTargetClusterUnreachable {
+		return true
+	}
+	if targetCluster == t.currentClusterName || common.IsServiceNonRetryableError(err) {
+		return false
+	}
+	maxRetryDuration := t.shard.GetConfig().TransferCrossClusterTaskMaxRetryDuration(targetDomain)
+	return t.shard.GetTimeSource().Now().Sub(task.VisibilityTimestamp) > maxRetryDuration
+}
+
 func (t *transferQueueActiveProcessorImpl) resetWorkflow(
 	task *persistence.TransferTaskInfo,
 	domain string,
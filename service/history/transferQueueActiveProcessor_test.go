@@ -30,12 +30,16 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"github.com/uber-go/tally"
+	"go.uber.org/yarpc/yarpcerrors"
 
+	"github.com/uber/cadence/.gen/go/admin"
+	"github.com/uber/cadence/.gen/go/admin/adminservicetest"
 	"github.com/uber/cadence/.gen/go/history"
 	"github.com/uber/cadence/.gen/go/history/historyservicetest"
 	"github.com/uber/cadence/.gen/go/matching"
 	"github.com/uber/cadence/.gen/go/matching/matchingservicetest"
 	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/client"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/archiver"
 	"github.com/uber/cadence/common/archiver/provider"
@@ -68,6 +72,8 @@ type (
 		mockDomainCache          *cache.MockDomainCache
 		mockMatchingClient       *matchingservicetest.MockClient
 		mockHistoryClient        *historyservicetest.MockClient
+		mockClientBean           *client.MockBean
+		mockRemoteAdminClient    *adminservicetest.MockClient
 
 		mockShardManager            *mocks.ShardManager
 		mockHistoryEngine           *historyEngineImpl
@@ -102,6 +108,22 @@ type (
 	}
 )
 
+var testRemoteDomainID = "deadbeef-0123-4567-890a-bcdef0123460"
+var testRemoteDomainName = "some random remote domain name"
+var testGlobalRemoteDomainEntry = cache.NewGlobalDomainCacheEntryForTest(
+	&persistence.DomainInfo{ID: testRemoteDomainID, Name: testRemoteDomainName},
+	&p.DomainConfig{Retention: 1},
+	&persistence.DomainReplicationConfig{
+		ActiveClusterName: cluster.TestAlternativeClusterName,
+		Clusters: []*persistence.ClusterReplicationConfig{
+			{ClusterName: cluster.TestCurrentClusterName},
+			{ClusterName: cluster.TestAlternativeClusterName},
+		},
+	},
+	testVersion,
+	nil,
+)
+
 func TestTransferQueueActiveProcessorSuite(t *testing.T) {
 	s := new(transferQueueActiveProcessorSuite)
 	suite.Run(t, s)
@@ -125,6 +147,9 @@ func (s *transferQueueActiveProcessorSuite) SetupTest() {
 	s.mockDomainCache = cache.NewMockDomainCache(s.controller)
 	s.mockMatchingClient = matchingservicetest.NewMockClient(s.controller)
 	s.mockHistoryClient = historyservicetest.NewMockClient(s.controller)
+	s.mockClientBean = client.NewMockBean(s.controller)
+	s.mockRemoteAdminClient = adminservicetest.NewMockClient(s.controller)
+	s.mockClientBean.EXPECT().GetRemoteAdminClient(cluster.TestAlternativeClusterName).Return(s.mockRemoteAdminClient).AnyTimes()
 	s.mockTxProcessor.EXPECT().NotifyNewTask(gomock.Any(), gomock.Any()).AnyTimes()
 	s.mockReplicationProcessor.EXPECT().notifyNewTask().AnyTimes()
 	s.mockTimerProcessor.EXPECT().NotifyNewTimers(gomock.Any(), gomock.Any()).AnyTimes()
@@ -136,6 +161,8 @@ func (s *transferQueueActiveProcessorSuite) SetupTest() {
 	s.mockDomainCache.EXPECT().GetDomain(testParentDomainName).Return(testGlobalParentDomainEntry, nil).AnyTimes()
 	s.mockDomainCache.EXPECT().GetDomainByID(testChildDomainID).Return(testGlobalChildDomainEntry, nil).AnyTimes()
 	s.mockDomainCache.EXPECT().GetDomain(testChildDomainName).Return(testGlobalChildDomainEntry, nil).AnyTimes()
+	s.mockDomainCache.EXPECT().GetDomainByID(testRemoteDomainID).Return(testGlobalRemoteDomainEntry, nil).AnyTimes()
+	s.mockDomainCache.EXPECT().GetDomain(testRemoteDomainName).Return(testGlobalRemoteDomainEntry, nil).AnyTimes()
 
 	config := NewDynamicConfigForTest()
 	s.domainID = testDomainID
@@ -167,7 +194,7 @@ func (s *transferQueueActiveProcessorSuite) SetupTest() {
 		s.mockClusterMetadata,
 		s.mockMessagingClient,
 		metricsClient,
-		nil,
+		s.mockClientBean,
 		s.mockArchivalMetadata,
 		s.mockArchiverProvider,
 		nil,
@@ -206,8 +233,11 @@ func (s *transferQueueActiveProcessorSuite) SetupTest() {
 		executionManager:     s.mockExecutionMgr,
 		historyCache:         historyCache,
 		logger:               s.logger,
+		throttledLogger:      s.logger,
 		tokenSerializer:      common.NewJSONTaskTokenSerializer(),
 		metricsClient:        s.mockShard.GetMetricsClient(),
+		config:               s.mockShard.GetConfig(),
+		openWorkflowsCounter: newOpenWorkflowsCounter(nil, s.mockShard.GetConfig(), clock.NewRealTimeSource(), s.logger),
 		historyEventNotifier: newHistoryEventNotifier(clock.NewRealTimeSource(), metrics.NewClient(tally.NoopScope, metrics.History), func(string) int { return 0 }),
 		txProcessor:          s.mockTxProcessor,
 		replicatorProcessor:  s.mockReplicationProcessor,
@@ -294,7 +324,7 @@ func (s *transferQueueActiveProcessorSuite) TestProcessActivityTask_Success() {
 
 	persistenceMutableState := s.createPersistenceMutableState(mutableState, event.GetEventId(), event.GetVersion())
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
-	s.mockMatchingClient.EXPECT().AddActivityTask(gomock.Any(), s.createAddActivityTaskRequest(transferTask, ai)).Return(nil).Times(1)
+	s.mockMatchingClient.EXPECT().AddActivityTask(gomock.Any(), s.createAddActivityTaskRequest(transferTask, ai, activityType)).Return(nil).Times(1)
 
 	_, err = s.transferQueueActiveProcessor.process(newTaskInfo(nil, transferTask, s.logger))
 	s.Nil(err)
@@ -628,7 +658,7 @@ func (s *transferQueueActiveProcessorSuite) TestProcessCloseExecution_HasParent(
 	workflowType := "some random workflow type"
 	taskListName := "some random task list"
 
-	parentDomainID := "some random parent domain ID"
+	parentDomainID := testParentDomainID
 	parentInitiatedID := int64(3222)
 	parentDomainName := "some random parent domain Name"
 	parentExecution := workflow.WorkflowExecution{
@@ -1385,6 +1415,154 @@ func (s *transferQueueActiveProcessorSuite) TestProcessSignalExecution_Failure()
 	s.Nil(err)
 }
 
+func (s *transferQueueActiveProcessorSuite) TestProcessSignalExecution_RemoteCluster_Success() {
+
+	execution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("some random workflow ID"),
+		RunId:      common.StringPtr(uuid.New()),
+	}
+	workflowType := "some random workflow type"
+	taskListName := "some random task list"
+
+	targetExecution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("some random target workflow ID"),
+		RunId:      common.StringPtr(uuid.New()),
+	}
+	signalName := "some random signal name"
+	signalInput := []byte("some random signal input")
+	signalControl := []byte("some random signal control")
+
+	mutableState := newMutableStateBuilderWithReplicationStateWithEventV2(s.mockShard, s.mockShard.GetEventsCache(), s.logger, s.version, execution.GetRunId())
+	_, err := mutableState.AddWorkflowExecutionStartedEvent(
+		execution,
+		&history.StartWorkflowExecutionRequest{
+			DomainUUID: common.StringPtr(s.domainID),
+			StartRequest: &workflow.StartWorkflowExecutionRequest{
+				WorkflowType:                        &workflow.WorkflowType{Name: common.StringPtr(workflowType)},
+				TaskList:                            &workflow.TaskList{Name: common.StringPtr(taskListName)},
+				ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(2),
+				TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+			},
+		},
+	)
+	s.Nil(err)
+
+	di := addDecisionTaskScheduledEvent(mutableState)
+	event := addDecisionTaskStartedEvent(mutableState, di.ScheduleID, taskListName, uuid.New())
+	di.StartedID = event.GetEventId()
+	event = addDecisionTaskCompletedEvent(mutableState, di.ScheduleID, di.StartedID, nil, "some random identity")
+
+	taskID := int64(59)
+	event, si := addRequestSignalInitiatedEvent(mutableState, event.GetEventId(), uuid.New(),
+		testRemoteDomainName, targetExecution.GetWorkflowId(), targetExecution.GetRunId(), signalName, signalInput, signalControl)
+
+	transferTask := &persistence.TransferTaskInfo{
+		Version:             s.version,
+		DomainID:            s.domainID,
+		WorkflowID:          execution.GetWorkflowId(),
+		RunID:               execution.GetRunId(),
+		TargetDomainID:      testRemoteDomainID,
+		TargetWorkflowID:    targetExecution.GetWorkflowId(),
+		TargetRunID:         targetExecution.GetRunId(),
+		TaskID:              taskID,
+		TaskList:            taskListName,
+		TaskType:            persistence.TransferTaskTypeSignalExecution,
+		ScheduleID:          event.GetEventId(),
+		VisibilityTimestamp: time.Now(),
+	}
+
+	persistenceMutableState := s.createPersistenceMutableState(mutableState, event.GetEventId(), event.GetVersion())
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+	s.mockRemoteAdminClient.EXPECT().SignalExternalWorkflowExecution(gomock.Any(), &admin.SignalExternalWorkflowExecutionRequest{
+		SignalRequest: s.createSignalWorkflowExecutionRequest(testRemoteDomainName, transferTask, si),
+		SecurityToken: common.StringPtr(common.DefaultAdminOperationToken),
+	}).Return(nil).Times(1)
+	// signal request ID is removed from the target workflow in the remote cluster
+	s.mockRemoteAdminClient.EXPECT().RemoveSignalMutableState(gomock.Any(), &admin.RemoveSignalMutableStateRequest{
+		RemoveRequest: &history.RemoveSignalMutableStateRequest{
+			DomainUUID:        common.StringPtr(transferTask.TargetDomainID),
+			WorkflowExecution: &targetExecution,
+			RequestId:         common.StringPtr(si.SignalRequestID),
+		},
+		SecurityToken: common.StringPtr(common.DefaultAdminOperationToken),
+	}).Return(nil).Times(1)
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
+	s.mockClusterMetadata.On("ClusterNameForFailoverVersion", s.version).Return(cluster.TestCurrentClusterName)
+
+	_, err = s.transferQueueActiveProcessor.process(newTaskInfo(nil, transferTask, s.logger))
+	s.Nil(err)
+}
+
+func (s *transferQueueActiveProcessorSuite) TestProcessSignalExecution_RemoteCluster_Unreachable() {
+
+	execution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("some random workflow ID"),
+		RunId:      common.StringPtr(uuid.New()),
+	}
+	workflowType := "some random workflow type"
+	taskListName := "some random task list"
+
+	targetExecution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("some random target workflow ID"),
+		RunId:      common.StringPtr(uuid.New()),
+	}
+	signalName := "some random signal name"
+	signalInput := []byte("some random signal input")
+	signalControl := []byte("some random signal control")
+
+	mutableState := newMutableStateBuilderWithReplicationStateWithEventV2(s.mockShard, s.mockShard.GetEventsCache(), s.logger, s.version, execution.GetRunId())
+	_, err := mutableState.AddWorkflowExecutionStartedEvent(
+		execution,
+		&history.StartWorkflowExecutionRequest{
+			DomainUUID: common.StringPtr(s.domainID),
+			StartRequest: &workflow.StartWorkflowExecutionRequest{
+				WorkflowType:                        &workflow.WorkflowType{Name: common.StringPtr(workflowType)},
+				TaskList:                            &workflow.TaskList{Name: common.StringPtr(taskListName)},
+				ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(2),
+				TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+			},
+		},
+	)
+	s.Nil(err)
+
+	di := addDecisionTaskScheduledEvent(mutableState)
+	event := addDecisionTaskStartedEvent(mutableState, di.ScheduleID, taskListName, uuid.New())
+	di.StartedID = event.GetEventId()
+	event = addDecisionTaskCompletedEvent(mutableState, di.ScheduleID, di.StartedID, nil, "some random identity")
+
+	taskID := int64(59)
+	event, _ = addRequestSignalInitiatedEvent(mutableState, event.GetEventId(), uuid.New(),
+		testRemoteDomainName, targetExecution.GetWorkflowId(), targetExecution.GetRunId(), signalName, signalInput, signalControl)
+
+	// the task is retried longer than the max retry duration of cross cluster transfer tasks
+	transferTask := &persistence.TransferTaskInfo{
+		Version:             s.version,
+		DomainID:            s.domainID,
+		WorkflowID:          execution.GetWorkflowId(),
+		RunID:               execution.GetRunId(),
+		TargetDomainID:      testRemoteDomainID,
+		TargetWorkflowID:    targetExecution.GetWorkflowId(),
+		TargetRunID:         targetExecution.GetRunId(),
+		TaskID:              taskID,
+		TaskList:            taskListName,
+		TaskType:            persistence.TransferTaskTypeSignalExecution,
+		ScheduleID:          event.GetEventId(),
+		VisibilityTimestamp: time.Now().Add(-2 * time.Hour),
+	}
+
+	persistenceMutableState := s.createPersistenceMutableState(mutableState, event.GetEventId(), event.GetVersion())
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+	s.mockRemoteAdminClient.EXPECT().SignalExternalWorkflowExecution(gomock.Any(), gomock.Any()).
+		Return(yarpcerrors.UnavailableErrorf("remote cluster unavailable")).Times(1)
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
+	s.mockClusterMetadata.On("ClusterNameForFailoverVersion", s.version).Return(cluster.TestCurrentClusterName)
+
+	_, err = s.transferQueueActiveProcessor.process(newTaskInfo(nil, transferTask, s.logger))
+	s.Nil(err)
+}
+
 func (s *transferQueueActiveProcessorSuite) TestProcessSignalExecution_Duplication() {
 
 	execution := workflow.WorkflowExecution{
@@ -1606,6 +1784,298 @@ func (s *transferQueueActiveProcessorSuite) TestProcessStartChildExecution_Failu
 	s.Nil(err)
 }
 
+func (s *transferQueueActiveProcessorSuite) TestProcessStartChildExecution_OpenWorkflowsLimitExceeded() {
+
+	execution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("some random workflow ID"),
+		RunId:      common.StringPtr(uuid.New()),
+	}
+	workflowType := "some random workflow type"
+	taskListName := "some random task list"
+
+	childWorkflowID := "some random child workflow ID"
+	childWorkflowType := "some random child workflow type"
+	childTaskListName := "some random child task list"
+
+	mutableState := newMutableStateBuilderWithReplicationStateWithEventV2(s.mockShard, s.mockShard.GetEventsCache(), s.logger, s.version, execution.GetRunId())
+	_, err := mutableState.AddWorkflowExecutionStartedEvent(
+		execution,
+		&history.StartWorkflowExecutionRequest{
+			DomainUUID: common.StringPtr(s.domainID),
+			StartRequest: &workflow.StartWorkflowExecutionRequest{
+				WorkflowType:                        &workflow.WorkflowType{Name: common.StringPtr(workflowType)},
+				TaskList:                            &workflow.TaskList{Name: common.StringPtr(taskListName)},
+				ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(2),
+				TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+			},
+		},
+	)
+	s.Nil(err)
+
+	di := addDecisionTaskScheduledEvent(mutableState)
+	event := addDecisionTaskStartedEvent(mutableState, di.ScheduleID, taskListName, uuid.New())
+	di.StartedID = event.GetEventId()
+	event = addDecisionTaskCompletedEvent(mutableState, di.ScheduleID, di.StartedID, nil, "some random identity")
+
+	taskID := int64(59)
+
+	event, _ = addStartChildWorkflowExecutionInitiatedEvent(
+		mutableState,
+		event.GetEventId(),
+		uuid.New(),
+		s.childDomainName,
+		childWorkflowID,
+		childWorkflowType,
+		childTaskListName,
+		nil,
+		1,
+		1,
+	)
+
+	transferTask := &persistence.TransferTaskInfo{
+		Version:          s.version,
+		DomainID:         s.domainID,
+		WorkflowID:       execution.GetWorkflowId(),
+		RunID:            execution.GetRunId(),
+		TargetDomainID:   testChildDomainID,
+		TargetWorkflowID: childWorkflowID,
+		TargetRunID:      "",
+		TaskID:           taskID,
+		TaskList:         taskListName,
+		TaskType:         persistence.TransferTaskTypeStartChildExecution,
+		ScheduleID:       event.GetEventId(),
+	}
+
+	persistenceMutableState := s.createPersistenceMutableState(mutableState, event.GetEventId(), event.GetVersion())
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+	s.mockHistoryEngine.openWorkflowsCounter.(*openWorkflowsCounterImpl).counts[s.childDomainName] = &openWorkflowsCount{
+		count:     10,
+		available: true,
+		expiry:    time.Now().Add(time.Hour),
+	}
+	openWorkflowsLimit := s.mockHistoryEngine.config.OpenWorkflowsLimit
+	s.mockHistoryEngine.config.OpenWorkflowsLimit = dc.GetIntPropertyFilteredByDomain(10)
+	defer func() { s.mockHistoryEngine.config.OpenWorkflowsLimit = openWorkflowsLimit }()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.MatchedBy(func(request *p.AppendHistoryNodesRequest) bool {
+		for _, event := range request.Events {
+			if event.GetEventType() == workflow.EventTypeStartChildWorkflowExecutionFailed {
+				return event.StartChildWorkflowExecutionFailedEventAttributes.GetCause() ==
+					workflow.ChildWorkflowExecutionFailedCauseOpenWorkflowsLimitExceeded
+			}
+		}
+		return false
+	})).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
+	s.mockClusterMetadata.On("ClusterNameForFailoverVersion", s.version).Return(cluster.TestCurrentClusterName)
+
+	_, err = s.transferQueueActiveProcessor.process(newTaskInfo(nil, transferTask, s.logger))
+	s.Nil(err)
+}
+
+func (s *transferQueueActiveProcessorSuite) TestProcessStartChildExecution_RemoteCluster_Success() {
+
+	execution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("some random workflow ID"),
+		RunId:      common.StringPtr(uuid.New()),
+	}
+	workflowType := "some random workflow type"
+	taskListName := "some random task list"
+
+	childWorkflowID := "some random child workflow ID"
+	childRunID := uuid.New()
+	childWorkflowType := "some random child workflow type"
+	childTaskListName := "some random child task list"
+
+	mutableState := newMutableStateBuilderWithReplicationStateWithEventV2(s.mockShard, s.mockShard.GetEventsCache(), s.logger, s.version, execution.GetRunId())
+	_, err := mutableState.AddWorkflowExecutionStartedEvent(
+		execution,
+		&history.StartWorkflowExecutionRequest{
+			DomainUUID: common.StringPtr(s.domainID),
+			StartRequest: &workflow.StartWorkflowExecutionRequest{
+				WorkflowType:                        &workflow.WorkflowType{Name: common.StringPtr(workflowType)},
+				TaskList:                            &workflow.TaskList{Name: common.StringPtr(taskListName)},
+				ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(2),
+				TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+			},
+		},
+	)
+	s.Nil(err)
+
+	di := addDecisionTaskScheduledEvent(mutableState)
+	event := addDecisionTaskStartedEvent(mutableState, di.ScheduleID, taskListName, uuid.New())
+	di.StartedID = event.GetEventId()
+	event = addDecisionTaskCompletedEvent(mutableState, di.ScheduleID, di.StartedID, nil, "some random identity")
+
+	taskID := int64(59)
+
+	event, ci := addStartChildWorkflowExecutionInitiatedEvent(mutableState, event.GetEventId(), uuid.New(),
+		testRemoteDomainName, childWorkflowID, childWorkflowType, childTaskListName, nil, 1, 1)
+
+	transferTask := &persistence.TransferTaskInfo{
+		Version:             s.version,
+		DomainID:            s.domainID,
+		WorkflowID:          execution.GetWorkflowId(),
+		RunID:               execution.GetRunId(),
+		TargetDomainID:      testRemoteDomainID,
+		TargetWorkflowID:    childWorkflowID,
+		TargetRunID:         "",
+		TaskID:              taskID,
+		TaskList:            taskListName,
+		TaskType:            persistence.TransferTaskTypeStartChildExecution,
+		ScheduleID:          event.GetEventId(),
+		VisibilityTimestamp: time.Now(),
+	}
+
+	persistenceMutableState := s.createPersistenceMutableState(mutableState, event.GetEventId(), event.GetVersion())
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+	// first decision task of the child is scheduled by the remote cluster
+	s.mockRemoteAdminClient.EXPECT().StartChildWorkflowExecution(gomock.Any(), &admin.StartChildWorkflowExecutionRequest{
+		StartRequest: s.createChildWorkflowExecutionRequest(
+			s.domainName,
+			testRemoteDomainName,
+			transferTask,
+			mutableState,
+			ci,
+		),
+		SecurityToken: common.StringPtr(common.DefaultAdminOperationToken),
+	}).Return(&workflow.StartWorkflowExecutionResponse{RunId: common.StringPtr(childRunID)}, nil).Times(1)
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
+	s.mockClusterMetadata.On("ClusterNameForFailoverVersion", s.version).Return(cluster.TestCurrentClusterName)
+
+	_, err = s.transferQueueActiveProcessor.process(newTaskInfo(nil, transferTask, s.logger))
+	s.Nil(err)
+}
+
+func (s *transferQueueActiveProcessorSuite) TestProcessStartChildExecution_RemoteCluster_Retry() {
+
+	execution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("some random workflow ID"),
+		RunId:      common.StringPtr(uuid.New()),
+	}
+	workflowType := "some random workflow type"
+	taskListName := "some random task list"
+
+	childWorkflowID := "some random child workflow ID"
+	childWorkflowType := "some random child workflow type"
+	childTaskListName := "some random child task list"
+
+	mutableState := newMutableStateBuilderWithReplicationStateWithEventV2(s.mockShard, s.mockShard.GetEventsCache(), s.logger, s.version, execution.GetRunId())
+	_, err := mutableState.AddWorkflowExecutionStartedEvent(
+		execution,
+		&history.StartWorkflowExecutionRequest{
+			DomainUUID: common.StringPtr(s.domainID),
+			StartRequest: &workflow.StartWorkflowExecutionRequest{
+				WorkflowType:                        &workflow.WorkflowType{Name: common.StringPtr(workflowType)},
+				TaskList:                            &workflow.TaskList{Name: common.StringPtr(taskListName)},
+				ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(2),
+				TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+			},
+		},
+	)
+	s.Nil(err)
+
+	di := addDecisionTaskScheduledEvent(mutableState)
+	event := addDecisionTaskStartedEvent(mutableState, di.ScheduleID, taskListName, uuid.New())
+	di.StartedID = event.GetEventId()
+	event = addDecisionTaskCompletedEvent(mutableState, di.ScheduleID, di.StartedID, nil, "some random identity")
+
+	taskID := int64(59)
+
+	event, _ = addStartChildWorkflowExecutionInitiatedEvent(mutableState, event.GetEventId(), uuid.New(),
+		testRemoteDomainName, childWorkflowID, childWorkflowType, childTaskListName, nil, 1, 1)
+
+	transferTask := &persistence.TransferTaskInfo{
+		Version:             s.version,
+		DomainID:            s.domainID,
+		WorkflowID:          execution.GetWorkflowId(),
+		RunID:               execution.GetRunId(),
+		TargetDomainID:      testRemoteDomainID,
+		TargetWorkflowID:    childWorkflowID,
+		TargetRunID:         "",
+		TaskID:              taskID,
+		TaskList:            taskListName,
+		TaskType:            persistence.TransferTaskTypeStartChildExecution,
+		ScheduleID:          event.GetEventId(),
+		VisibilityTimestamp: time.Now(),
+	}
+
+	persistenceMutableState := s.createPersistenceMutableState(mutableState, event.GetEventId(), event.GetVersion())
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+	s.mockRemoteAdminClient.EXPECT().StartChildWorkflowExecution(gomock.Any(), gomock.Any()).
+		Return(nil, yarpcerrors.UnavailableErrorf("remote cluster unavailable")).Times(1)
+	s.mockClusterMetadata.On("ClusterNameForFailoverVersion", s.version).Return(cluster.TestCurrentClusterName)
+
+	_, err = s.transferQueueActiveProcessor.process(newTaskInfo(nil, transferTask, s.logger))
+	s.IsType(&workflow.InternalServiceError{}, err)
+}
+
+func (s *transferQueueActiveProcessorSuite) TestProcessStartChildExecution_RemoteCluster_Unreachable() {
+
+	execution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("some random workflow ID"),
+		RunId:      common.StringPtr(uuid.New()),
+	}
+	workflowType := "some random workflow type"
+	taskListName := "some random task list"
+
+	childWorkflowID := "some random child workflow ID"
+	childWorkflowType := "some random child workflow type"
+	childTaskListName := "some random child task list"
+
+	mutableState := newMutableStateBuilderWithReplicationStateWithEventV2(s.mockShard, s.mockShard.GetEventsCache(), s.logger, s.version, execution.GetRunId())
+	_, err := mutableState.AddWorkflowExecutionStartedEvent(
+		execution,
+		&history.StartWorkflowExecutionRequest{
+			DomainUUID: common.StringPtr(s.domainID),
+			StartRequest: &workflow.StartWorkflowExecutionRequest{
+				WorkflowType:                        &workflow.WorkflowType{Name: common.StringPtr(workflowType)},
+				TaskList:                            &workflow.TaskList{Name: common.StringPtr(taskListName)},
+				ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(2),
+				TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+			},
+		},
+	)
+	s.Nil(err)
+
+	di := addDecisionTaskScheduledEvent(mutableState)
+	event := addDecisionTaskStartedEvent(mutableState, di.ScheduleID, taskListName, uuid.New())
+	di.StartedID = event.GetEventId()
+	event = addDecisionTaskCompletedEvent(mutableState, di.ScheduleID, di.StartedID, nil, "some random identity")
+
+	taskID := int64(59)
+
+	event, _ = addStartChildWorkflowExecutionInitiatedEvent(mutableState, event.GetEventId(), uuid.New(),
+		testRemoteDomainName, childWorkflowID, childWorkflowType, childTaskListName, nil, 1, 1)
+
+	// the task is retried longer than the max retry duration of cross cluster transfer tasks
+	transferTask := &persistence.TransferTaskInfo{
+		Version:             s.version,
+		DomainID:            s.domainID,
+		WorkflowID:          execution.GetWorkflowId(),
+		RunID:               execution.GetRunId(),
+		TargetDomainID:      testRemoteDomainID,
+		TargetWorkflowID:    childWorkflowID,
+		TargetRunID:         "",
+		TaskID:              taskID,
+		TaskList:            taskListName,
+		TaskType:            persistence.TransferTaskTypeStartChildExecution,
+		ScheduleID:          event.GetEventId(),
+		VisibilityTimestamp: time.Now().Add(-2 * time.Hour),
+	}
+
+	persistenceMutableState := s.createPersistenceMutableState(mutableState, event.GetEventId(), event.GetVersion())
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+	s.mockRemoteAdminClient.EXPECT().StartChildWorkflowExecution(gomock.Any(), gomock.Any()).
+		Return(nil, yarpcerrors.UnavailableErrorf("remote cluster unavailable")).Times(1)
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
+	s.mockClusterMetadata.On("ClusterNameForFailoverVersion", s.version).Return(cluster.TestCurrentClusterName)
+
+	_, err = s.transferQueueActiveProcessor.process(newTaskInfo(nil, transferTask, s.logger))
+	s.Nil(err)
+}
+
 func (s *transferQueueActiveProcessorSuite) TestProcessStartChildExecution_Success_Dup() {
 
 	execution := workflow.WorkflowExecution{
@@ -1882,6 +2352,7 @@ func (s *transferQueueActiveProcessorSuite) TestCopySearchAttributes() {
 func (s *transferQueueActiveProcessorSuite) createAddActivityTaskRequest(
 	task *persistence.TransferTaskInfo,
 	ai *persistence.ActivityInfo,
+	activityType string,
 ) *matching.AddActivityTaskRequest {
 
 	execution := workflow.WorkflowExecution{
@@ -1897,6 +2368,8 @@ func (s *transferQueueActiveProcessorSuite) createAddActivityTaskRequest(
 		TaskList:                      taskList,
 		ScheduleId:                    &task.ScheduleID,
 		ScheduleToStartTimeoutSeconds: common.Int32Ptr(ai.ScheduleToStartTimeout),
+		ActivityType:                  common.StringPtr(activityType),
+		AffinityToken:                 common.StringPtr(""),
 	}
 }
 
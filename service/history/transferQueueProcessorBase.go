@@ -135,6 +135,8 @@ func (t *transferQueueProcessorBase) getDomainIDAndWorkflowExecution(
 func (t *transferQueueProcessorBase) pushActivity(
 	task *persistence.TransferTaskInfo,
 	activityScheduleToStartTimeout int32,
+	activityType string,
+	affinityToken string,
 ) error {
 
 	ctx, cancel := ctx.WithTimeout(ctx.Background(), transferActiveTaskDefaultTimeout)
@@ -154,11 +156,31 @@ func (t *transferQueueProcessorBase) pushActivity(
 		TaskList:                      &workflow.TaskList{Name: &task.TaskList},
 		ScheduleId:                    &task.ScheduleID,
 		ScheduleToStartTimeoutSeconds: common.Int32Ptr(activityScheduleToStartTimeout),
+		ActivityType:                  common.StringPtr(activityType),
+		AffinityToken:                 common.StringPtr(affinityToken),
 	})
 
 	return err
 }
 
+// getActivityDispatchInfo returns the activity type and the affinity token of a pending
+// activity. Matching uses the activity type to enforce the dispatch limits configured per
+// activity type, and the affinity token to dispatch the task only to the pollers which
+// registered that token. Empty values are returned when the scheduled event cannot be
+// loaded, the task is then pushed without them
+func getActivityDispatchInfo(
+	mutableState mutableState,
+	scheduleID int64,
+) (activityType string, affinityToken string) {
+
+	scheduledEvent, err := mutableState.GetActivityScheduledEvent(scheduleID)
+	if err != nil {
+		return "", ""
+	}
+	attributes := scheduledEvent.GetActivityTaskScheduledEventAttributes()
+	return attributes.GetActivityType().GetName(), attributes.GetAffinityToken()
+}
+
 func (t *transferQueueProcessorBase) pushDecision(
 	task *persistence.TransferTaskInfo,
 	tasklist *workflow.TaskList,
@@ -234,8 +234,11 @@ func (t *transferQueueStandbyProcessorImpl) processActivityTask(
 		}
 
 		if activityInfo.StartedID == common.EmptyEventID {
+			activityType, affinityToken := getActivityDispatchInfo(mutableState, activityInfo.ScheduleID)
 			return newPushActivityToMatchingInfo(
 				activityInfo.ScheduleToStartTimeout,
+				activityType,
+				affinityToken,
 			), nil
 		}
 
@@ -640,6 +643,8 @@ func (t *transferQueueStandbyProcessorImpl) pushActivity(
 	return t.transferQueueProcessorBase.pushActivity(
 		task.task.(*persistence.TransferTaskInfo),
 		timeout,
+		pushActivityInfo.activityType,
+		pushActivityInfo.affinityToken,
 	)
 }
 
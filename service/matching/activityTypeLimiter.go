@@ -0,0 +1,284 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+)
+
+const (
+	// activityTaskPendingTimeout bounds the time a slot is held by a task which is
+	// offered to pollers but not yet started, in case the start is never reported
+	activityTaskPendingTimeout = 5 * time.Minute
+	// activityTypePurgeInterval is the min interval between scans for expired slots
+	activityTypePurgeInterval = time.Second
+)
+
+type (
+	// activityTypeLimiter enforces the dispatch rate and the max number of outstanding
+	// tasks which are configured per activity type for an activity task list. A task
+	// holds a slot from the time it is offered to a poller until history reports the
+	// activity as closed, the task is handed over to a parent partition, or the start
+	// to close timeout of the activity expires
+	activityTypeLimiter struct {
+		sync.Mutex
+		dispatchRPS    func() map[string]interface{}
+		maxOutstanding func() map[string]interface{}
+		numPartitions  func() int
+
+		limiters    map[string]*rate.Limiter // activity type -> dispatch rate limiter
+		outstanding map[string]int           // activity type -> number of slots held
+		tasks       map[activityTaskKey]*activityTaskSlot
+		lastPurge   time.Time
+	}
+
+	activityTaskKey struct {
+		workflowID string
+		runID      string
+		scheduleID int64
+	}
+
+	activityTaskSlot struct {
+		activityType string
+		reservation  *rate.Reservation
+		started      bool
+		expiry       time.Time
+	}
+)
+
+func newActivityTypeLimiter(config *taskListConfig) *activityTypeLimiter {
+	return &activityTypeLimiter{
+		dispatchRPS:    config.ActivityTypeDispatchRPS,
+		maxOutstanding: config.ActivityTypeMaxOutstanding,
+		numPartitions:  config.NumReadPartitions,
+		limiters:       make(map[string]*rate.Limiter),
+		outstanding:    make(map[string]int),
+		tasks:          make(map[activityTaskKey]*activityTaskSlot),
+	}
+}
+
+func newActivityTaskKey(workflowID string, runID string, scheduleID int64) activityTaskKey {
+	return activityTaskKey{workflowID: workflowID, runID: runID, scheduleID: scheduleID}
+}
+
+// tryAcquire returns true when the given task can be dispatched right away. Tasks
+// without an activity type, or whose type has no limits configured, are always allowed
+func (l *activityTypeLimiter) tryAcquire(task *persistence.TaskInfo) bool {
+	if task.ActivityType == "" {
+		return true
+	}
+	rps, hasRPS := l.getDispatchRPS(task.ActivityType)
+	maxOutstanding, hasMaxOutstanding := l.getMaxOutstanding(task.ActivityType)
+	if !hasRPS && !hasMaxOutstanding {
+		return true
+	}
+
+	l.Lock()
+	defer l.Unlock()
+
+	now := time.Now()
+	l.purgeLocked(now)
+	// a task is only redelivered by history once its previous attempt is gone
+	key := newActivityTaskKey(task.WorkflowID, task.RunID, task.ScheduleID)
+	l.removeLocked(key)
+
+	if hasMaxOutstanding && l.outstanding[task.ActivityType] >= maxOutstanding {
+		return false
+	}
+
+	var reservation *rate.Reservation
+	if hasRPS {
+		reservation = l.getLimiterLocked(task.ActivityType, rps).ReserveN(now, 1)
+		if !reservation.OK() || reservation.DelayFrom(now) > 0 {
+			reservation.CancelAt(now)
+			return false
+		}
+	}
+
+	l.tasks[key] = &activityTaskSlot{
+		activityType: task.ActivityType,
+		reservation:  reservation,
+		expiry:       now.Add(activityTaskPendingTimeout),
+	}
+	l.outstanding[task.ActivityType]++
+	return true
+}
+
+// started marks the slot of the given task as held until the activity completes,
+// or until its start to close timeout expires
+func (l *activityTypeLimiter) started(task *persistence.TaskInfo, startToCloseTimeout time.Duration) {
+	if task.ActivityType == "" {
+		return
+	}
+
+	l.Lock()
+	defer l.Unlock()
+
+	key := newActivityTaskKey(task.WorkflowID, task.RunID, task.ScheduleID)
+	slot, ok := l.tasks[key]
+	if !ok {
+		// the pending slot expired before the task was started, keep counting it
+		slot = &activityTaskSlot{activityType: task.ActivityType}
+		l.tasks[key] = slot
+		l.outstanding[task.ActivityType]++
+	}
+	slot.started = true
+	slot.expiry = time.Now().Add(startToCloseTimeout)
+}
+
+// release frees the slot held by the given task. If the task was never
+// started, its dispatch is also returned to the rate limiter when possible
+func (l *activityTypeLimiter) release(key activityTaskKey) {
+	l.Lock()
+	defer l.Unlock()
+
+	slot, ok := l.tasks[key]
+	if ok && !slot.started && slot.reservation != nil {
+		slot.reservation.Cancel()
+	}
+	l.removeLocked(key)
+}
+
+// describe returns the limits configured for this task list and
+// the number of outstanding tasks of each activity type
+func (l *activityTypeLimiter) describe() []*shared.ActivityTypeLimitInfo {
+	activityTypes := make(map[string]struct{})
+	for activityType := range l.dispatchRPS() {
+		activityTypes[activityType] = struct{}{}
+	}
+	for activityType := range l.maxOutstanding() {
+		activityTypes[activityType] = struct{}{}
+	}
+
+	l.Lock()
+	l.purgeLocked(time.Now())
+	outstanding := make(map[string]int, len(l.outstanding))
+	for activityType, count := range l.outstanding {
+		activityTypes[activityType] = struct{}{}
+		outstanding[activityType] = count
+	}
+	l.Unlock()
+
+	var result []*shared.ActivityTypeLimitInfo
+	for activityType := range activityTypes {
+		info := &shared.ActivityTypeLimitInfo{
+			ActivityType: common.StringPtr(activityType),
+			Outstanding:  common.Int32Ptr(int32(outstanding[activityType])),
+		}
+		if rps, ok := l.getDispatchRPS(activityType); ok {
+			info.RatePerSecond = common.Float64Ptr(rps)
+		}
+		if maxOutstanding, ok := l.getMaxOutstanding(activityType); ok {
+			info.MaxOutstanding = common.Int32Ptr(int32(maxOutstanding))
+		}
+		result = append(result, info)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].GetActivityType() < result[j].GetActivityType()
+	})
+	return result
+}
+
+// getDispatchRPS returns the dispatch rate of this partition for the given activity type,
+// the configured rate is divided equally across all partitions as done by the matcher
+func (l *activityTypeLimiter) getDispatchRPS(activityType string) (float64, bool) {
+	rps, ok := toFloat64(l.dispatchRPS()[activityType])
+	if !ok || rps < 0 {
+		return 0, false
+	}
+	nPartitions := float64(l.numPartitions())
+	if rps > nPartitions {
+		rps = rps / nPartitions
+	}
+	return rps, true
+}
+
+// getMaxOutstanding returns the max number of outstanding tasks of this partition for the given
+// activity type, the configured value is divided across all partitions and rounded up
+func (l *activityTypeLimiter) getMaxOutstanding(activityType string) (int, bool) {
+	value, ok := toFloat64(l.maxOutstanding()[activityType])
+	if !ok || value < 0 {
+		return 0, false
+	}
+	return int(math.Ceil(value / float64(l.numPartitions()))), true
+}
+
+func (l *activityTypeLimiter) getLimiterLocked(activityType string, rps float64) *rate.Limiter {
+	burst := common.MaxInt(1, int(math.Ceil(rps)))
+	limiter, ok := l.limiters[activityType]
+	if !ok || limiter.Limit() != rate.Limit(rps) {
+		limiter = rate.NewLimiter(rate.Limit(rps), burst)
+		l.limiters[activityType] = limiter
+	}
+	return limiter
+}
+
+func (l *activityTypeLimiter) purgeLocked(now time.Time) {
+	if now.Sub(l.lastPurge) < activityTypePurgeInterval {
+		return
+	}
+	l.lastPurge = now
+	for key, slot := range l.tasks {
+		if now.After(slot.expiry) {
+			l.removeLocked(key)
+		}
+	}
+}
+
+func (l *activityTypeLimiter) removeLocked(key activityTaskKey) {
+	slot, ok := l.tasks[key]
+	if !ok {
+		return
+	}
+	delete(l.tasks, key)
+	l.outstanding[slot.activityType]--
+	if l.outstanding[slot.activityType] <= 0 {
+		delete(l.outstanding, slot.activityType)
+	}
+}
+
+// toFloat64 converts a numeric dynamic config value, different
+// dynamic config clients may return different numeric types
+func toFloat64(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case int:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case float32:
+		return float64(v), true
+	case float64:
+		return v, true
+	default:
+		return 0, false
+	}
+}
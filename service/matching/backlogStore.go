@@ -0,0 +1,212 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"math"
+	"sync/atomic"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+)
+
+const (
+	backlogLayoutRows     = "rows"
+	backlogLayoutSegments = "segments"
+)
+
+type (
+	// backlogStore persists the backlog of a task list, i.e. the tasks which could not
+	// be sync matched, and reads them back in the order of their task ids
+	backlogStore interface {
+		CreateTasks(tasks []*persistence.CreateTaskInfo) (*persistence.CreateTasksResponse, error)
+		GetTasks(minTaskID int64, maxTaskID int64, batchSize int) (*persistence.GetTasksResponse, error)
+		CompleteTasksLessThan(taskID int64, limit int) (int, error)
+	}
+
+	// segmentBacklogStore stores each batch of tasks written by the taskWriter as a single
+	// segment, instead of one row per task. For large backlogs, this cuts the number of
+	// rows which are written, read back and range deleted by orders of magnitude
+	segmentBacklogStore struct {
+		db *taskListDB
+	}
+
+	// layeredBacklogStore switches the layout of the backlog of a task list between rows
+	// and segments based on dynamic config. While segments are on or being drained, tasks
+	// are read and deleted from both layouts so a task list does not lose its backlog when
+	// the layout is switched, until the segments of a drained task list are known to be
+	// empty. It also emits metrics per layout to compare their throughput
+	layeredBacklogStore struct {
+		rows     backlogStore
+		segments backlogStore
+		mode     func() string
+		scope    func() metrics.Scope
+		// segmentsDrained is set to 1 once no segment is left while segments are drained
+		segmentsDrained int32
+	}
+)
+
+var _ backlogStore = (*taskListDB)(nil)
+var _ backlogStore = (*segmentBacklogStore)(nil)
+var _ backlogStore = (*layeredBacklogStore)(nil)
+
+func newBacklogStore(db *taskListDB, taskListKind int, config *taskListConfig, scope func() metrics.Scope) backlogStore {
+	store := &layeredBacklogStore{
+		rows:     db,
+		segments: &segmentBacklogStore{db: db},
+		mode:     config.BacklogSegmentMode,
+		scope:    scope,
+	}
+	if taskListKind == persistence.TaskListKindSticky {
+		// backlogs of sticky task lists are short lived and never large
+		store.mode = func() string { return common.BacklogSegmentModeOff }
+	}
+	return store
+}
+
+func (s *segmentBacklogStore) CreateTasks(tasks []*persistence.CreateTaskInfo) (*persistence.CreateTasksResponse, error) {
+	return s.db.CreateTaskSegment(tasks)
+}
+
+func (s *segmentBacklogStore) GetTasks(minTaskID int64, maxTaskID int64, batchSize int) (*persistence.GetTasksResponse, error) {
+	return s.db.GetTaskSegments(minTaskID, maxTaskID, batchSize)
+}
+
+func (s *segmentBacklogStore) CompleteTasksLessThan(taskID int64, limit int) (int, error) {
+	return s.db.CompleteTaskSegmentsLessThan(taskID, limit)
+}
+
+func (s *layeredBacklogStore) CreateTasks(tasks []*persistence.CreateTaskInfo) (*persistence.CreateTasksResponse, error) {
+	layout, store := backlogLayoutRows, s.rows
+	if s.mode() == common.BacklogSegmentModeOn {
+		layout, store = backlogLayoutSegments, s.segments
+	}
+	scope := s.layoutScope(layout)
+	sw := scope.StartTimer(metrics.BacklogWriteLatency)
+	resp, err := store.CreateTasks(tasks)
+	sw.Stop()
+	if err == nil {
+		scope.AddCounter(metrics.BacklogTasksWritten, int64(len(tasks)))
+	}
+	return resp, err
+}
+
+func (s *layeredBacklogStore) GetTasks(minTaskID int64, maxTaskID int64, batchSize int) (*persistence.GetTasksResponse, error) {
+	rows, err := s.getTasks(backlogLayoutRows, s.rows, minTaskID, maxTaskID, batchSize)
+	if err != nil || !s.readSegments() {
+		return rows, err
+	}
+	segments, err := s.getTasks(backlogLayoutSegments, s.segments, minTaskID, maxTaskID, batchSize)
+	if err != nil {
+		return nil, err
+	}
+	return &persistence.GetTasksResponse{
+		Tasks: mergeTasks(rows.Tasks, segments.Tasks, batchSize),
+	}, nil
+}
+
+func (s *layeredBacklogStore) CompleteTasksLessThan(taskID int64, limit int) (int, error) {
+	n, err := s.completeTasksLessThan(backlogLayoutRows, s.rows, taskID, limit)
+	if err != nil || !s.readSegments() {
+		return n, err
+	}
+	nSegments, err := s.completeTasksLessThan(backlogLayoutSegments, s.segments, taskID, limit)
+	if err != nil {
+		return 0, err
+	}
+	if nSegments < limit && s.mode() == common.BacklogSegmentModeDrain {
+		// segments are only deleted once all their tasks are acked, so when
+		// none is left above the ack level, segments are fully drained
+		left, err := s.segments.GetTasks(taskID, math.MaxInt64, 1)
+		if err != nil {
+			return 0, err
+		}
+		if len(left.Tasks) == 0 {
+			atomic.StoreInt32(&s.segmentsDrained, 1)
+		}
+	}
+	// the caller keeps deleting until both layouts report less than limit
+	return common.MaxInt(n, nSegments), nil
+}
+
+// readSegments returns true while segments are on, or are being drained
+// and the segments of the task list are not known to be empty yet
+func (s *layeredBacklogStore) readSegments() bool {
+	switch s.mode() {
+	case common.BacklogSegmentModeOn:
+		atomic.StoreInt32(&s.segmentsDrained, 0)
+		return true
+	case common.BacklogSegmentModeDrain:
+		return atomic.LoadInt32(&s.segmentsDrained) == 0
+	default:
+		return false
+	}
+}
+
+func (s *layeredBacklogStore) getTasks(
+	layout string,
+	store backlogStore,
+	minTaskID int64,
+	maxTaskID int64,
+	batchSize int,
+) (*persistence.GetTasksResponse, error) {
+	scope := s.layoutScope(layout)
+	sw := scope.StartTimer(metrics.BacklogReadLatency)
+	resp, err := store.GetTasks(minTaskID, maxTaskID, batchSize)
+	sw.Stop()
+	if err == nil {
+		scope.AddCounter(metrics.BacklogTasksRead, int64(len(resp.Tasks)))
+	}
+	return resp, err
+}
+
+func (s *layeredBacklogStore) completeTasksLessThan(layout string, store backlogStore, taskID int64, limit int) (int, error) {
+	sw := s.layoutScope(layout).StartTimer(metrics.BacklogDeleteLatency)
+	defer sw.Stop()
+	return store.CompleteTasksLessThan(taskID, limit)
+}
+
+func (s *layeredBacklogStore) layoutScope(layout string) metrics.Scope {
+	return s.scope().Tagged(metrics.BacklogLayoutTag(layout))
+}
+
+// mergeTasks merges two lists of tasks sorted by task id, into a
+// single sorted list holding at most batchSize tasks
+func mergeTasks(a []*persistence.TaskInfo, b []*persistence.TaskInfo, batchSize int) []*persistence.TaskInfo {
+	if len(b) == 0 {
+		return a
+	}
+	if len(a) == 0 {
+		return b
+	}
+	result := make([]*persistence.TaskInfo, 0, common.MinInt(len(a)+len(b), batchSize))
+	for len(result) < batchSize && (len(a) > 0 || len(b) > 0) {
+		if len(b) == 0 || (len(a) > 0 && a[0].TaskID < b[0].TaskID) {
+			result = append(result, a[0])
+			a = a[1:]
+		} else {
+			result = append(result, b[0])
+			b = b[1:]
+		}
+	}
+	return result
+}
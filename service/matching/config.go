@@ -56,6 +56,14 @@ type (
 		// taskWriter configuration
 		OutstandingTaskAppendsThreshold dynamicconfig.IntPropertyFnWithTaskListInfoFilters
 		MaxTaskBatchSize                dynamicconfig.IntPropertyFnWithTaskListInfoFilters
+		BacklogSegmentMode              dynamicconfig.StringPropertyFnWithTaskListInfoFilters
+
+		// max time an activity task waits for a poller with its affinity token
+		ActivityAffinityTimeout dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
+
+		// server side limits per activity type, keyed by activity type name
+		ActivityTypeDispatchRPS    dynamicconfig.MapPropertyFnWithTaskListInfoFilters
+		ActivityTypeMaxOutstanding dynamicconfig.MapPropertyFnWithTaskListInfoFilters
 
 		ThrottledLogRPS dynamicconfig.IntPropertyFn
 	}
@@ -84,6 +92,11 @@ type (
 		MaxTaskBatchSize                func() int
 		NumWritePartitions              func() int
 		NumReadPartitions               func() int
+		BacklogSegmentMode              func() string
+		ActivityAffinityTimeout         func() time.Duration
+		// activity type limits
+		ActivityTypeDispatchRPS    func() map[string]interface{}
+		ActivityTypeMaxOutstanding func() map[string]interface{}
 	}
 )
 
@@ -103,6 +116,7 @@ func NewConfig(dc *dynamicconfig.Collection) *Config {
 		MaxTaskDeleteBatchSize:          dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingMaxTaskDeleteBatchSize, 100),
 		OutstandingTaskAppendsThreshold: dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingOutstandingTaskAppendsThreshold, 250),
 		MaxTaskBatchSize:                dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingMaxTaskBatchSize, 100),
+		BacklogSegmentMode:              dc.GetStringPropertyFilteredByTaskListInfo(dynamicconfig.MatchingBacklogSegmentMode, common.BacklogSegmentModeOff),
 		ThrottledLogRPS:                 dc.GetIntProperty(dynamicconfig.MatchingThrottledLogRPS, 20),
 		NumTasklistWritePartitions:      dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingNumTasklistWritePartitions, 1),
 		NumTasklistReadPartitions:       dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingNumTasklistReadPartitions, 1),
@@ -110,6 +124,9 @@ func NewConfig(dc *dynamicconfig.Collection) *Config {
 		ForwarderMaxOutstandingTasks:    dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingForwarderMaxOutstandingTasks, 1),
 		ForwarderMaxRatePerSecond:       dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingForwarderMaxRatePerSecond, 10),
 		ForwarderMaxChildrenPerNode:     dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingForwarderMaxChildrenPerNode, 20),
+		ActivityTypeDispatchRPS:         dc.GetMapPropertyFilteredByTaskListInfo(dynamicconfig.MatchingActivityTypeDispatchRPS, nil),
+		ActivityTypeMaxOutstanding:      dc.GetMapPropertyFilteredByTaskListInfo(dynamicconfig.MatchingActivityTypeMaxOutstanding, nil),
+		ActivityAffinityTimeout:         dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingActivityAffinityTimeout, 10*time.Second),
 	}
 }
 
@@ -160,6 +177,20 @@ func newTaskListConfig(id *taskListID, config *Config, domainCache cache.DomainC
 		NumReadPartitions: func() int {
 			return common.MaxInt(1, config.NumTasklistReadPartitions(domain, taskListName, taskType))
 		},
+		BacklogSegmentMode: func() string {
+			return config.BacklogSegmentMode(domain, taskListName, taskType)
+		},
+		// activity type limits are configured for the task list as a whole and
+		// divided across its partitions, so they are looked up by the base name
+		ActivityTypeDispatchRPS: func() map[string]interface{} {
+			return config.ActivityTypeDispatchRPS(domain, id.baseName, taskType)
+		},
+		ActivityTypeMaxOutstanding: func() map[string]interface{} {
+			return config.ActivityTypeMaxOutstanding(domain, id.baseName, taskType)
+		},
+		ActivityAffinityTimeout: func() time.Duration {
+			return config.ActivityAffinityTimeout(domain, id.baseName, taskType)
+		},
 		forwarderConfig: forwarderConfig{
 			ForwarderMaxOutstandingPolls: func() int {
 				return config.ForwarderMaxOutstandingPolls(domain, taskListName, taskType)
@@ -38,12 +38,16 @@ type (
 		taskType     int
 		rangeID      int64
 		ackLevel     int64
+		draining     bool
+		drainTarget  string
 		store        persistence.TaskManager
 		logger       log.Logger
 	}
 	taskListState struct {
-		rangeID  int64
-		ackLevel int64
+		rangeID     int64
+		ackLevel    int64
+		draining    bool
+		drainTarget string
 	}
 )
 
@@ -92,7 +96,21 @@ func (db *taskListDB) RenewLease() (taskListState, error) {
 	}
 	db.ackLevel = resp.TaskListInfo.AckLevel
 	db.rangeID = resp.TaskListInfo.RangeID
-	return taskListState{rangeID: db.rangeID, ackLevel: db.ackLevel}, nil
+	db.draining = resp.TaskListInfo.Draining
+	db.drainTarget = resp.TaskListInfo.DrainTarget
+	return taskListState{
+		rangeID:     db.rangeID,
+		ackLevel:    db.ackLevel,
+		draining:    db.draining,
+		drainTarget: db.drainTarget,
+	}, nil
+}
+
+// AckLevel returns the current persistence view of ackLevel
+func (db *taskListDB) AckLevel() int64 {
+	db.Lock()
+	defer db.Unlock()
+	return db.ackLevel
 }
 
 // UpdateState updates the taskList state with the given value
@@ -101,12 +119,14 @@ func (db *taskListDB) UpdateState(ackLevel int64) error {
 	defer db.Unlock()
 	_, err := db.store.UpdateTaskList(&persistence.UpdateTaskListRequest{
 		TaskListInfo: &persistence.TaskListInfo{
-			DomainID: db.domainID,
-			Name:     db.taskListName,
-			TaskType: db.taskType,
-			AckLevel: ackLevel,
-			RangeID:  db.rangeID,
-			Kind:     db.taskListKind,
+			DomainID:    db.domainID,
+			Name:        db.taskListName,
+			TaskType:    db.taskType,
+			AckLevel:    ackLevel,
+			RangeID:     db.rangeID,
+			Kind:        db.taskListKind,
+			Draining:    db.draining,
+			DrainTarget: db.drainTarget,
 		},
 	})
 	if err == nil {
@@ -115,18 +135,50 @@ func (db *taskListDB) UpdateState(ackLevel int64) error {
 	return err
 }
 
+// DrainState returns the current persistence view of the drain state
+func (db *taskListDB) DrainState() (bool, string) {
+	db.Lock()
+	defer db.Unlock()
+	return db.draining, db.drainTarget
+}
+
+// UpdateDrainState persists the drain state of the taskList
+func (db *taskListDB) UpdateDrainState(draining bool, drainTarget string) error {
+	db.Lock()
+	defer db.Unlock()
+	_, err := db.store.UpdateTaskList(&persistence.UpdateTaskListRequest{
+		TaskListInfo: &persistence.TaskListInfo{
+			DomainID:    db.domainID,
+			Name:        db.taskListName,
+			TaskType:    db.taskType,
+			AckLevel:    db.ackLevel,
+			RangeID:     db.rangeID,
+			Kind:        db.taskListKind,
+			Draining:    draining,
+			DrainTarget: drainTarget,
+		},
+	})
+	if err == nil {
+		db.draining = draining
+		db.drainTarget = drainTarget
+	}
+	return err
+}
+
 // CreateTasks creates a batch of given tasks for this task list
 func (db *taskListDB) CreateTasks(tasks []*persistence.CreateTaskInfo) (*persistence.CreateTasksResponse, error) {
 	db.Lock()
 	defer db.Unlock()
 	return db.store.CreateTasks(&persistence.CreateTasksRequest{
 		TaskListInfo: &persistence.TaskListInfo{
-			DomainID: db.domainID,
-			Name:     db.taskListName,
-			TaskType: db.taskType,
-			AckLevel: db.ackLevel,
-			RangeID:  db.rangeID,
-			Kind:     db.taskListKind,
+			DomainID:    db.domainID,
+			Name:        db.taskListName,
+			TaskType:    db.taskType,
+			AckLevel:    db.ackLevel,
+			RangeID:     db.rangeID,
+			Kind:        db.taskListKind,
+			Draining:    db.draining,
+			DrainTarget: db.drainTarget,
 		},
 		Tasks: tasks,
 	})
@@ -144,6 +196,37 @@ func (db *taskListDB) GetTasks(minTaskID int64, maxTaskID int64, batchSize int)
 	})
 }
 
+// CreateTaskSegment creates a segment holding the given batch of tasks for this task list
+func (db *taskListDB) CreateTaskSegment(tasks []*persistence.CreateTaskInfo) (*persistence.CreateTasksResponse, error) {
+	db.Lock()
+	defer db.Unlock()
+	return db.store.CreateTaskSegment(&persistence.CreateTasksRequest{
+		TaskListInfo: &persistence.TaskListInfo{
+			DomainID:    db.domainID,
+			Name:        db.taskListName,
+			TaskType:    db.taskType,
+			AckLevel:    db.ackLevel,
+			RangeID:     db.rangeID,
+			Kind:        db.taskListKind,
+			Draining:    db.draining,
+			DrainTarget: db.drainTarget,
+		},
+		Tasks: tasks,
+	})
+}
+
+// GetTaskSegments returns a batch of tasks stored in segments between the given range
+func (db *taskListDB) GetTaskSegments(minTaskID int64, maxTaskID int64, batchSize int) (*persistence.GetTasksResponse, error) {
+	return db.store.GetTaskSegments(&persistence.GetTasksRequest{
+		DomainID:     db.domainID,
+		TaskList:     db.taskListName,
+		TaskType:     db.taskType,
+		BatchSize:    batchSize,
+		ReadLevel:    minTaskID,  // exclusive
+		MaxReadLevel: &maxTaskID, // inclusive
+	})
+}
+
 // CompleteTask deletes a single task from this task list
 func (db *taskListDB) CompleteTask(taskID int64) error {
 	err := db.store.CompleteTask(&persistence.CompleteTaskRequest{
@@ -186,3 +269,25 @@ func (db *taskListDB) CompleteTasksLessThan(taskID int64, limit int) (int, error
 	}
 	return n, err
 }
+
+// CompleteTaskSegmentsLessThan deletes segments whose tasks are all less than or equal
+// to the given taskID. Limit is the upper bound of number of segments that can be deleted
+// by this method. It may or may not be honored
+func (db *taskListDB) CompleteTaskSegmentsLessThan(taskID int64, limit int) (int, error) {
+	n, err := db.store.CompleteTaskSegmentsLessThan(&persistence.CompleteTasksLessThanRequest{
+		DomainID:     db.domainID,
+		TaskListName: db.taskListName,
+		TaskType:     db.taskType,
+		TaskID:       taskID,
+		Limit:        limit,
+	})
+	if err != nil {
+		db.logger.Error("Persistent store operation failure",
+			tag.StoreOperationCompleteTaskSegments,
+			tag.Error(err),
+			tag.TaskID(taskID),
+			tag.TaskType(db.taskType),
+			tag.WorkflowTaskListName(db.taskListName))
+	}
+	return n, err
+}
@@ -161,6 +161,7 @@ func (fwdr *Forwarder) ForwardTask(ctx context.Context, task *internalTask) erro
 			ScheduleId:                    &task.event.ScheduleID,
 			ScheduleToStartTimeoutSeconds: &task.event.ScheduleToStartTimeout,
 			ForwardedFrom:                 &fwdr.taskListID.name,
+			ActivityType:                  &task.event.ActivityType,
 		})
 	default:
 		return errInvalidTaskListType
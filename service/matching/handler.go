@@ -284,6 +284,36 @@ func (h *Handler) DescribeTaskList(ctx context.Context, request *m.DescribeTaskL
 	return response, h.handleErr(err, scope)
 }
 
+// RecordTaskCompletion updates the poller statistics of the tasklist after a worker responded to a task
+func (h *Handler) RecordTaskCompletion(ctx context.Context, request *m.RecordTaskCompletionRequest) (retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	scope := metrics.MatchingRecordTaskCompletionScope
+	sw := h.startRequestProfile("RecordTaskCompletion", scope)
+	defer sw.Stop()
+
+	// Count the request in the RPS, but we still accept it even if RPS is exceeded
+	h.rateLimiter.Allow()
+
+	err := h.engine.RecordTaskCompletion(ctx, request)
+	return h.handleErr(err, scope)
+}
+
+// DrainTaskList updates or returns the drain state of a tasklist, new tasks of a draining tasklist
+// are forwarded to the drain target or rejected when there is none
+func (h *Handler) DrainTaskList(ctx context.Context, request *m.DrainTaskListRequest) (resp *m.DrainTaskListResponse, retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	scope := metrics.MatchingDrainTaskListScope
+	sw := h.startRequestProfile("DrainTaskList", scope)
+	defer sw.Stop()
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return nil, h.handleErr(errMatchingHostThrottle, scope)
+	}
+
+	response, err := h.engine.DrainTaskList(ctx, request)
+	return response, h.handleErr(err, scope)
+}
+
 func (h *Handler) handleErr(err error, scope int) error {
 
 	if err == nil {
@@ -114,6 +114,7 @@ func (tm *TaskMatcher) Offer(ctx context.Context, task *internalTask) (bool, err
 			if err := tm.fwdr.ForwardTask(ctx, task); err == nil {
 				// task was remotely sync matched on the parent partition
 				token.release()
+				task.remoteMatched = true
 				return true, nil
 			}
 			token.release()
@@ -209,6 +210,7 @@ forLoop:
 				continue forLoop
 			}
 			cancel()
+			task.remoteMatched = true
 			return nil
 		case <-ctx.Done():
 			return ctx.Err()
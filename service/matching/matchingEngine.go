@@ -91,6 +91,8 @@ var (
 	ErrNoTasks    = errors.New("No tasks")
 	errPumpClosed = errors.New("Task list pump closed its channel")
 
+	errTaskListDraining = &workflow.ServiceBusyError{Message: "Task list is draining and does not accept new tasks"}
+
 	pollerIDKey pollerIDCtxKey = "pollerID"
 	identityKey identityCtxKey = "identity"
 )
@@ -193,6 +195,14 @@ func (e *matchingEngineImpl) getTaskListManager(taskList *taskListID,
 	return mgr, nil
 }
 
+// Returns taskListManager for a task list if it is already loaded, it never loads a new task list
+func (e *matchingEngineImpl) getLoadedTaskListManager(taskList *taskListID) (taskListManager, bool) {
+	e.taskListsLock.RLock()
+	defer e.taskListsLock.RUnlock()
+	result, ok := e.taskLists[*taskList]
+	return result, ok
+}
+
 // For use in tests
 func (e *matchingEngineImpl) updateTaskList(taskList *taskListID, mgr taskListManager) {
 	e.taskListsLock.Lock()
@@ -237,6 +247,9 @@ func (e *matchingEngineImpl) AddDecisionTask(ctx context.Context, addRequest *m.
 		ScheduleToStartTimeout: addRequest.GetScheduleToStartTimeoutSeconds(),
 		CreatedTime:            time.Now(),
 	}
+	if draining, drainTarget := tlMgr.GetDrainState(); draining {
+		return false, e.redirectDrainedDecisionTask(ctx, addRequest, drainTarget)
+	}
 	return tlMgr.AddTask(ctx, addTaskParams{
 		execution:     addRequest.Execution,
 		taskInfo:      taskInfo,
@@ -262,11 +275,6 @@ func (e *matchingEngineImpl) AddActivityTask(ctx context.Context, addRequest *m.
 		return false, err
 	}
 
-	tlMgr, err := e.getTaskListManager(taskList, taskListKind)
-	if err != nil {
-		return false, err
-	}
-
 	taskInfo := &persistence.TaskInfo{
 		DomainID:               sourceDomainID,
 		RunID:                  addRequest.Execution.GetRunId(),
@@ -274,6 +282,21 @@ func (e *matchingEngineImpl) AddActivityTask(ctx context.Context, addRequest *m.
 		ScheduleID:             addRequest.GetScheduleId(),
 		ScheduleToStartTimeout: addRequest.GetScheduleToStartTimeoutSeconds(),
 		CreatedTime:            time.Now(),
+		ActivityType:           addRequest.GetActivityType(),
+	}
+
+	if taskList.IsAffinity() && !e.hasAffinityPoller(taskList) {
+		// no poller registered the affinity token recently, the host is likely gone
+		e.metricsClient.IncCounter(metrics.MatchingAddActivityTaskScope, metrics.AffinityNoPollerFallbackCounter)
+		return false, e.redirectAffinityTask(ctx, taskList, taskInfo)
+	}
+
+	tlMgr, err := e.getTaskListManager(taskList, taskListKind)
+	if err != nil {
+		return false, err
+	}
+	if draining, drainTarget := tlMgr.GetDrainState(); draining {
+		return false, e.redirectDrainedActivityTask(ctx, addRequest, drainTarget)
 	}
 	return tlMgr.AddTask(ctx, addTaskParams{
 		execution:     addRequest.Execution,
@@ -344,7 +367,7 @@ pollLoop:
 				WorkflowExecutionTaskList: mutableStateResp.TaskList,
 				BranchToken:               mutableStateResp.CurrentBranchToken,
 			}
-			return e.createPollForDecisionTaskResponse(task, resp), nil
+			return e.createPollForDecisionTaskResponse(task, resp, taskListName), nil
 		}
 
 		resp, err := e.recordDecisionTaskStarted(ctx, request, task)
@@ -361,7 +384,9 @@ pollLoop:
 			continue pollLoop
 		}
 		task.finish(nil)
-		return e.createPollForDecisionTaskResponse(task, resp), nil
+		isSticky := request.TaskList.GetKind() == workflow.TaskListKindSticky
+		e.recordPollerTaskDispatched(taskList, request.GetIdentity(), isSticky, !isSticky && resp.GetPreviousStartedEventId() > 0)
+		return e.createPollForDecisionTaskResponse(task, resp, taskListName), nil
 	}
 }
 
@@ -414,6 +439,7 @@ pollLoop:
 
 		resp, err := e.recordActivityTaskStarted(ctx, request, task)
 		if err != nil {
+			e.releaseActivityTask(taskList, task.event.TaskInfo)
 			switch err.(type) {
 			case *workflow.EntityNotExistsError, *h.EventAlreadyStartedError:
 				e.logger.Debug(fmt.Sprintf("Duplicated activity task taskList=%v, taskID=%v",
@@ -425,8 +451,10 @@ pollLoop:
 
 			continue pollLoop
 		}
+		e.activityTaskStarted(taskList, task.event.TaskInfo, resp)
 		task.finish(nil)
-		return e.createPollForActivityTaskResponse(task, resp), nil
+		e.recordPollerTaskDispatched(taskList, request.GetIdentity(), false, false)
+		return e.createPollForActivityTaskResponse(task, resp, taskListName), nil
 	}
 }
 
@@ -551,6 +579,209 @@ func (e *matchingEngineImpl) DescribeTaskList(ctx context.Context, request *m.De
 	return tlMgr.DescribeTaskList(request.DescRequest.GetIncludeTaskListStatus()), nil
 }
 
+// RecordTaskCompletion updates the poller statistics of a loaded tasklist after a worker responded to a task.
+// Requests which carry the execution of an activity task are sent by history once the activity is closed, to
+// the partition which dispatched the task, they free the slot held by the task in the activity type limits
+func (e *matchingEngineImpl) RecordTaskCompletion(ctx context.Context, request *m.RecordTaskCompletionRequest) error {
+	taskListType := persistence.TaskListTypeDecision
+	if request.GetTaskListType() == workflow.TaskListTypeActivity {
+		taskListType = persistence.TaskListTypeActivity
+	}
+	taskList, err := newTaskListID(request.GetDomainUUID(), request.TaskList.GetName(), taskListType)
+	if err != nil {
+		return err
+	}
+	tlMgr, loaded := e.getLoadedTaskListManager(taskList)
+	if loaded && request.GetIdentity() != "" {
+		tlMgr.RecordPollerTaskCompleted(request.GetIdentity(), request.GetFailed())
+	}
+	if loaded && taskListType == persistence.TaskListTypeActivity && request.Execution != nil {
+		tlMgr.ReleaseActivityTask(
+			request.Execution.GetWorkflowId(),
+			request.Execution.GetRunId(),
+			request.GetScheduleId(),
+		)
+	}
+	return nil
+}
+
+// DrainTaskList updates the drain state of a task list when requested and returns it along with
+// the size of its backlog. Requests to the root partition are also sent to the other partitions
+// and their backlogs are added up
+func (e *matchingEngineImpl) DrainTaskList(ctx context.Context, request *m.DrainTaskListRequest) (*m.DrainTaskListResponse, error) {
+	domainID := request.GetDomainUUID()
+	taskListType := persistence.TaskListTypeDecision
+	if request.GetTaskListType() == workflow.TaskListTypeActivity {
+		taskListType = persistence.TaskListTypeActivity
+	}
+	taskList, err := newTaskListID(domainID, request.TaskList.GetName(), taskListType)
+	if err != nil {
+		return nil, err
+	}
+	tlMgr, err := e.getTaskListManager(taskList, common.TaskListKindPtr(workflow.TaskListKindNormal))
+	if err != nil {
+		return nil, err
+	}
+	if request.Drain != nil {
+		drainTarget := ""
+		if request.GetDrain() {
+			drainTarget = request.GetTargetTaskList()
+		}
+		if drainTarget != "" && request.GetForwardedFrom() == "" {
+			if err := e.validateDrainTarget(ctx, request, taskList); err != nil {
+				return nil, err
+			}
+		}
+		if err := tlMgr.SetDrainState(request.GetDrain(), drainTarget); err != nil {
+			return nil, err
+		}
+	}
+
+	draining, drainTarget := tlMgr.GetDrainState()
+	backlogCountHint, err := tlMgr.GetBacklogCountHint()
+	if err != nil {
+		return nil, err
+	}
+	if taskList.IsRoot() && request.GetForwardedFrom() == "" {
+		domainName, err := e.domainCache.GetDomainName(domainID)
+		if err != nil {
+			return nil, err
+		}
+		nPartitions := common.MaxInt(
+			e.config.NumTasklistReadPartitions(domainName, taskList.baseName, taskListType),
+			e.config.NumTasklistWritePartitions(domainName, taskList.baseName, taskListType),
+		)
+		for partition := 1; partition < nPartitions; partition++ {
+			resp, err := e.matchingClient.DrainTaskList(ctx, &m.DrainTaskListRequest{
+				DomainUUID:     common.StringPtr(domainID),
+				TaskList:       &workflow.TaskList{Name: common.StringPtr(taskList.mkName(partition))},
+				TaskListType:   request.TaskListType,
+				Drain:          request.Drain,
+				TargetTaskList: request.TargetTaskList,
+				ForwardedFrom:  common.StringPtr(taskList.name),
+			})
+			if err != nil {
+				return nil, err
+			}
+			backlogCountHint += resp.GetBacklogCountHint()
+		}
+	}
+
+	return &m.DrainTaskListResponse{
+		Draining:         common.BoolPtr(draining),
+		TargetTaskList:   common.StringPtr(drainTarget),
+		BacklogCountHint: common.Int64Ptr(backlogCountHint),
+	}, nil
+}
+
+// validateDrainTarget rejects a drain into the task list itself, or into a task list which is
+// draining too, as tasks would then be redirected in a cycle or through a chain of task lists
+func (e *matchingEngineImpl) validateDrainTarget(
+	ctx context.Context,
+	request *m.DrainTaskListRequest,
+	taskList *taskListID,
+) error {
+	target := request.GetTargetTaskList()
+	if target == taskList.baseName {
+		return &workflow.BadRequestError{Message: "Task list cannot be drained into itself."}
+	}
+	// only the drain state of the root partition of the target is needed, so the
+	// request is marked as forwarded to skip the fan out to the other partitions
+	resp, err := e.matchingClient.DrainTaskList(ctx, &m.DrainTaskListRequest{
+		DomainUUID:    request.DomainUUID,
+		TaskList:      &workflow.TaskList{Name: common.StringPtr(target)},
+		TaskListType:  request.TaskListType,
+		ForwardedFrom: common.StringPtr(taskList.name),
+	})
+	if err != nil {
+		return err
+	}
+	if resp.GetDraining() {
+		return &workflow.BadRequestError{Message: fmt.Sprintf(
+			"Drain target %v is itself draining into %q.", target, resp.GetTargetTaskList())}
+	}
+	return nil
+}
+
+// redirectDrainedDecisionTask adds a decision task received by a draining task list to its
+// drain target, or rejects it when the task list is drained without a target
+func (e *matchingEngineImpl) redirectDrainedDecisionTask(
+	ctx context.Context,
+	request *m.AddDecisionTaskRequest,
+	drainTarget string,
+) error {
+	if drainTarget == "" {
+		e.metricsClient.IncCounter(metrics.MatchingAddDecisionTaskScope, metrics.DrainedTaskRejectCounter)
+		return errTaskListDraining
+	}
+	e.metricsClient.IncCounter(metrics.MatchingAddDecisionTaskScope, metrics.DrainedTaskRedirectCounter)
+	redirected := *request
+	redirected.TaskList = &workflow.TaskList{Name: common.StringPtr(drainTarget)}
+	redirected.ForwardedFrom = nil
+	return e.matchingClient.AddDecisionTask(ctx, &redirected)
+}
+
+// redirectDrainedActivityTask adds an activity task received by a draining task list to its
+// drain target, or rejects it when the task list is drained without a target
+func (e *matchingEngineImpl) redirectDrainedActivityTask(
+	ctx context.Context,
+	request *m.AddActivityTaskRequest,
+	drainTarget string,
+) error {
+	if drainTarget == "" {
+		e.metricsClient.IncCounter(metrics.MatchingAddActivityTaskScope, metrics.DrainedTaskRejectCounter)
+		return errTaskListDraining
+	}
+	e.metricsClient.IncCounter(metrics.MatchingAddActivityTaskScope, metrics.DrainedTaskRedirectCounter)
+	redirected := *request
+	redirected.TaskList = &workflow.TaskList{Name: common.StringPtr(drainTarget)}
+	redirected.ForwardedFrom = nil
+	// the workers holding the affinity token are not part of the target fleet
+	redirected.AffinityToken = nil
+	return e.matchingClient.AddActivityTask(ctx, &redirected)
+}
+
+// hasAffinityPoller returns true if a poller with the affinity token of the given
+// affinity task list polled it within the affinity timeout
+func (e *matchingEngineImpl) hasAffinityPoller(taskList *taskListID) bool {
+	tlMgr, ok := e.getLoadedTaskListManager(taskList)
+	if !ok {
+		return false
+	}
+	domainName, err := e.domainCache.GetDomainName(taskList.domainID)
+	if err != nil {
+		return false
+	}
+	timeout := e.config.ActivityAffinityTimeout(domainName, taskList.baseName, taskList.taskType)
+	return tlMgr.HasPollerAfter(time.Now().Add(-timeout))
+}
+
+// redirectAffinityTask adds an activity task of an affinity task list to the task list
+// it was scheduled on, without its affinity token so that any poller can pick it up
+func (e *matchingEngineImpl) redirectAffinityTask(
+	ctx context.Context,
+	taskList *taskListID,
+	task *persistence.TaskInfo,
+) error {
+	scheduleToStartTimeout := task.ScheduleToStartTimeout
+	if !task.Expiry.IsZero() {
+		// tasks loaded from persistence only keep their expiry time
+		scheduleToStartTimeout = int32(common.MaxInt64(1, int64(time.Until(task.Expiry)/time.Second)))
+	}
+	return e.matchingClient.AddActivityTask(ctx, &m.AddActivityTaskRequest{
+		DomainUUID:       common.StringPtr(taskList.domainID),
+		SourceDomainUUID: common.StringPtr(task.DomainID),
+		Execution: &workflow.WorkflowExecution{
+			WorkflowId: common.StringPtr(task.WorkflowID),
+			RunId:      common.StringPtr(task.RunID),
+		},
+		TaskList:                      &workflow.TaskList{Name: common.StringPtr(taskList.baseName)},
+		ScheduleId:                    common.Int64Ptr(task.ScheduleID),
+		ScheduleToStartTimeoutSeconds: common.Int32Ptr(scheduleToStartTimeout),
+		ActivityType:                  common.StringPtr(task.ActivityType),
+	})
+}
+
 // Loads a task from persistence and wraps it in a task context
 func (e *matchingEngineImpl) getTask(
 	ctx context.Context, taskList *taskListID, maxDispatchPerSecond *float64, taskListKind *workflow.TaskListKind,
@@ -578,6 +809,7 @@ func (e *matchingEngineImpl) unloadTaskList(id *taskListID) {
 func (e *matchingEngineImpl) createPollForDecisionTaskResponse(
 	task *internalTask,
 	historyResponse *h.RecordDecisionTaskStartedResponse,
+	taskListName string,
 ) *m.PollForDecisionTaskResponse {
 
 	var token []byte
@@ -597,6 +829,7 @@ func (e *matchingEngineImpl) createPollForDecisionTaskResponse(
 			RunID:           task.event.RunID,
 			ScheduleID:      historyResponse.GetScheduledEventId(),
 			ScheduleAttempt: historyResponse.GetAttempt(),
+			TaskList:        taskListName,
 		}
 		token, _ = e.tokenSerializer.Serialize(taskoken)
 		if task.responseC == nil {
@@ -617,6 +850,7 @@ func (e *matchingEngineImpl) createPollForDecisionTaskResponse(
 func (e *matchingEngineImpl) createPollForActivityTaskResponse(
 	task *internalTask,
 	historyResponse *h.RecordActivityTaskStartedResponse,
+	taskListName string,
 ) *workflow.PollForActivityTaskResponse {
 
 	scheduledEvent := historyResponse.ScheduledEvent
@@ -651,6 +885,7 @@ func (e *matchingEngineImpl) createPollForActivityTaskResponse(
 		RunID:           task.event.RunID,
 		ScheduleID:      task.event.ScheduleID,
 		ScheduleAttempt: historyResponse.GetAttempt(),
+		TaskList:        taskListName,
 	}
 
 	response.TaskToken, _ = e.tokenSerializer.Serialize(token)
@@ -719,6 +954,46 @@ func (e *matchingEngineImpl) recordActivityTaskStarted(
 	return resp, err
 }
 
+func (e *matchingEngineImpl) recordPollerTaskDispatched(
+	taskList *taskListID,
+	identity string,
+	stickyCacheHit bool,
+	stickyCacheMiss bool,
+) {
+	if identity == "" {
+		return
+	}
+	if tlMgr, ok := e.getLoadedTaskListManager(taskList); ok {
+		tlMgr.RecordPollerTaskDispatched(identity, stickyCacheHit, stickyCacheMiss)
+	}
+}
+
+// activityTaskStarted holds the activity type slot of a started activity task
+// until the task completes or its start to close timeout expires
+func (e *matchingEngineImpl) activityTaskStarted(
+	taskList *taskListID,
+	task *persistence.TaskInfo,
+	resp *h.RecordActivityTaskStartedResponse,
+) {
+	if task.ActivityType == "" {
+		return
+	}
+	if tlMgr, ok := e.getLoadedTaskListManager(taskList); ok {
+		attributes := resp.ScheduledEvent.GetActivityTaskScheduledEventAttributes()
+		timeout := time.Duration(attributes.GetStartToCloseTimeoutSeconds()) * time.Second
+		tlMgr.ActivityTaskStarted(task, timeout)
+	}
+}
+
+func (e *matchingEngineImpl) releaseActivityTask(taskList *taskListID, task *persistence.TaskInfo) {
+	if task.ActivityType == "" {
+		return
+	}
+	if tlMgr, ok := e.getLoadedTaskListManager(taskList); ok {
+		tlMgr.ReleaseActivityTask(task.WorkflowID, task.RunID, task.ScheduleID)
+	}
+}
+
 func (e *matchingEngineImpl) emitForwardedFromStats(scope int, isTaskForwarded bool, pollForwardedFrom string) {
 	isPollForwarded := len(pollForwardedFrom) > 0
 	switch {
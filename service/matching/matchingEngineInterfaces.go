@@ -39,5 +39,7 @@ type (
 		RespondQueryTaskCompleted(ctx context.Context, request *m.RespondQueryTaskCompletedRequest) error
 		CancelOutstandingPoll(ctx context.Context, request *m.CancelOutstandingPollRequest) error
 		DescribeTaskList(ctx context.Context, request *m.DescribeTaskListRequest) (*workflow.DescribeTaskListResponse, error)
+		RecordTaskCompletion(ctx context.Context, request *m.RecordTaskCompletionRequest) error
+		DrainTaskList(ctx context.Context, request *m.DrainTaskListRequest) (*m.DrainTaskListResponse, error)
 	}
 )
@@ -35,8 +35,10 @@ import (
 	gohistory "github.com/uber/cadence/.gen/go/history"
 	"github.com/uber/cadence/.gen/go/history/historyservicetest"
 	"github.com/uber/cadence/.gen/go/matching"
+	"github.com/uber/cadence/.gen/go/matching/matchingservicetest"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/client/history"
+	matchingclient "github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/loggerimpl"
@@ -464,7 +466,16 @@ func (s *matchingEngineSuite) TestTaskWriterShutdown() {
 }
 
 func (s *matchingEngineSuite) TestAddThenConsumeActivities() {
+	s.addThenConsumeActivitiesTest(common.BacklogSegmentModeOff)
+}
+
+func (s *matchingEngineSuite) TestAddThenConsumeActivities_BacklogSegments() {
+	s.addThenConsumeActivitiesTest(common.BacklogSegmentModeOn)
+}
+
+func (s *matchingEngineSuite) addThenConsumeActivitiesTest(backlogSegmentMode string) {
 	s.matchingEngine.config.LongPollExpirationInterval = dynamicconfig.GetDurationPropertyFnFilteredByTaskListInfo(10 * time.Millisecond)
+	s.matchingEngine.config.BacklogSegmentMode = dynamicconfig.GetStringPropertyFnFilteredByTaskListInfo(backlogSegmentMode)
 
 	runID := "run1"
 	workflowID := "workflow1"
@@ -499,6 +510,11 @@ func (s *matchingEngineSuite) TestAddThenConsumeActivities() {
 		s.NoError(err)
 	}
 	s.EqualValues(taskCount, s.taskManager.getTaskCount(tlID))
+	if backlogSegmentMode == common.BacklogSegmentModeOn {
+		s.True(s.taskManager.getSegmentCount(tlID) > 0)
+	} else {
+		s.Zero(s.taskManager.getSegmentCount(tlID))
+	}
 
 	activityTypeName := "activity1"
 	activityID := "activityId1"
@@ -558,6 +574,7 @@ func (s *matchingEngineSuite) TestAddThenConsumeActivities() {
 			WorkflowID: workflowID,
 			RunID:      runID,
 			ScheduleID: scheduleID,
+			TaskList:   tl,
 		}
 
 		taskToken, _ := s.matchingEngine.tokenSerializer.Serialize(token)
@@ -565,6 +582,7 @@ func (s *matchingEngineSuite) TestAddThenConsumeActivities() {
 		i++
 	}
 	s.EqualValues(0, s.taskManager.getTaskCount(tlID))
+	s.Zero(s.taskManager.getSegmentCount(tlID))
 	expectedRange := int64(initialRangeID + taskCount/rangeSize)
 	if taskCount%rangeSize > 0 {
 		expectedRange++
@@ -573,6 +591,342 @@ func (s *matchingEngineSuite) TestAddThenConsumeActivities() {
 	s.True(expectedRange <= s.taskManager.getTaskListManager(tlID).rangeID)
 }
 
+func (s *matchingEngineSuite) TestAffinityActivityTask_FallbackWithoutPoller() {
+	s.mockDomainCache.EXPECT().GetDomainName(gomock.Any()).Return("domainName", nil).AnyTimes()
+	mockMatchingClient := matchingservicetest.NewMockClient(s.controller)
+	s.matchingEngine.matchingClient = mockMatchingClient
+
+	domainID := "domainId"
+	tl := "makeToast"
+	affinityTaskList := matchingclient.AffinityTaskListName(tl, "host1")
+	tlID := newTestTaskListID(domainID, affinityTaskList, persistence.TaskListTypeActivity)
+
+	mockMatchingClient.EXPECT().AddActivityTask(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, request *matching.AddActivityTaskRequest, opts ...interface{}) error {
+			s.Equal(tl, request.TaskList.GetName())
+			s.Equal("", request.GetAffinityToken())
+			s.Equal(int64(3), request.GetScheduleId())
+			return nil
+		}).Times(1)
+
+	syncMatch, err := s.matchingEngine.AddActivityTask(context.Background(), s.newAffinityAddActivityTaskRequest(domainID, tl, "host1", 3))
+	s.NoError(err)
+	s.False(syncMatch)
+	s.EqualValues(0, s.taskManager.getTaskCount(tlID))
+}
+
+func (s *matchingEngineSuite) TestAffinityActivityTask_DispatchToAffinityPoller() {
+	s.mockDomainCache.EXPECT().GetDomainName(gomock.Any()).Return("domainName", nil).AnyTimes()
+	s.matchingEngine.config.LongPollExpirationInterval = dynamicconfig.GetDurationPropertyFnFilteredByTaskListInfo(10 * time.Millisecond)
+	s.matchingEngine.matchingClient = matchingservicetest.NewMockClient(s.controller)
+
+	domainID := "domainId"
+	tl := "makeToast"
+	activityID := "activityId1"
+	affinityTaskList := matchingclient.AffinityTaskListName(tl, "host1")
+	tlID := newTestTaskListID(domainID, affinityTaskList, persistence.TaskListTypeActivity)
+
+	s.mockHistoryClient.EXPECT().RecordActivityTaskStarted(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, taskRequest *gohistory.RecordActivityTaskStartedRequest) (*gohistory.RecordActivityTaskStartedResponse, error) {
+			return &gohistory.RecordActivityTaskStartedResponse{
+				ScheduledEvent: newActivityTaskScheduledEvent(*taskRequest.ScheduleId, 0,
+					&workflow.ScheduleActivityTaskDecisionAttributes{
+						ActivityId:                    &activityID,
+						TaskList:                      &workflow.TaskList{Name: &tl},
+						ActivityType:                  &workflow.ActivityType{Name: common.StringPtr("activity1")},
+						ScheduleToCloseTimeoutSeconds: common.Int32Ptr(100),
+						ScheduleToStartTimeoutSeconds: common.Int32Ptr(50),
+						StartToCloseTimeoutSeconds:    common.Int32Ptr(50),
+						HeartbeatTimeoutSeconds:       common.Int32Ptr(10),
+						AffinityToken:                 common.StringPtr("host1"),
+					}),
+				StartedTimestamp: common.Int64Ptr(time.Now().UnixNano()),
+			}, nil
+		}).AnyTimes()
+
+	poll := func() *workflow.PollForActivityTaskResponse {
+		result, err := s.matchingEngine.PollForActivityTask(s.callContext, &matching.PollForActivityTaskRequest{
+			DomainUUID: common.StringPtr(domainID),
+			PollRequest: &workflow.PollForActivityTaskRequest{
+				TaskList: &workflow.TaskList{
+					Name: common.StringPtr(affinityTaskList),
+					Kind: common.TaskListKindPtr(workflow.TaskListKindSticky),
+				},
+				Identity:      common.StringPtr("host1-worker"),
+				AffinityToken: common.StringPtr("host1"),
+			},
+		})
+		s.NoError(err)
+		return result
+	}
+
+	// the first poll registers the affinity token, there is no task for it yet
+	s.Empty(poll().TaskToken)
+
+	_, err := s.matchingEngine.AddActivityTask(context.Background(), s.newAffinityAddActivityTaskRequest(domainID, tl, "host1", 3))
+	s.NoError(err)
+	s.EqualValues(1, s.taskManager.getTaskCount(tlID))
+
+	result := poll()
+	for i := 0; i < 100 && len(result.TaskToken) == 0; i++ {
+		result = poll()
+	}
+	s.Equal(activityID, result.GetActivityId())
+	token, err := s.matchingEngine.tokenSerializer.Deserialize(result.TaskToken)
+	s.NoError(err)
+	s.Equal(affinityTaskList, token.TaskList)
+}
+
+func (s *matchingEngineSuite) TestAffinityActivityTask_FallbackAfterTimeout() {
+	s.mockDomainCache.EXPECT().GetDomainName(gomock.Any()).Return("domainName", nil).AnyTimes()
+	s.matchingEngine.config.LongPollExpirationInterval = dynamicconfig.GetDurationPropertyFnFilteredByTaskListInfo(10 * time.Millisecond)
+	s.matchingEngine.config.ActivityAffinityTimeout = dynamicconfig.GetDurationPropertyFnFilteredByTaskListInfo(100 * time.Millisecond)
+	mockMatchingClient := matchingservicetest.NewMockClient(s.controller)
+	s.matchingEngine.matchingClient = mockMatchingClient
+
+	domainID := "domainId"
+	tl := "makeToast"
+	affinityTaskList := matchingclient.AffinityTaskListName(tl, "host1")
+	tlID := newTestTaskListID(domainID, affinityTaskList, persistence.TaskListTypeActivity)
+
+	result, err := s.matchingEngine.PollForActivityTask(s.callContext, &matching.PollForActivityTaskRequest{
+		DomainUUID: common.StringPtr(domainID),
+		PollRequest: &workflow.PollForActivityTaskRequest{
+			TaskList: &workflow.TaskList{
+				Name: common.StringPtr(affinityTaskList),
+				Kind: common.TaskListKindPtr(workflow.TaskListKindSticky),
+			},
+			Identity:      common.StringPtr("host1-worker"),
+			AffinityToken: common.StringPtr("host1"),
+		},
+	})
+	s.NoError(err)
+	s.Empty(result.TaskToken)
+
+	redirected := make(chan *matching.AddActivityTaskRequest, 1)
+	mockMatchingClient.EXPECT().AddActivityTask(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, request *matching.AddActivityTaskRequest, opts ...interface{}) error {
+			redirected <- request
+			return nil
+		}).Times(1)
+
+	// the poller of the affinity token is gone, the task is redirected once the affinity timeout expires
+	_, err = s.matchingEngine.AddActivityTask(context.Background(), s.newAffinityAddActivityTaskRequest(domainID, tl, "host1", 3))
+	s.NoError(err)
+
+	select {
+	case request := <-redirected:
+		s.Equal(tl, request.TaskList.GetName())
+		s.Equal("", request.GetAffinityToken())
+		s.Equal(int64(3), request.GetScheduleId())
+	case <-time.After(5 * time.Second):
+		s.Fail("affinity task was not redirected")
+	}
+	for i := 0; i < 100 && s.taskManager.getTaskCount(tlID) > 0; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	s.EqualValues(0, s.taskManager.getTaskCount(tlID))
+}
+
+func (s *matchingEngineSuite) newAffinityAddActivityTaskRequest(
+	domainID string,
+	taskList string,
+	affinityToken string,
+	scheduleID int64,
+) *matching.AddActivityTaskRequest {
+	return &matching.AddActivityTaskRequest{
+		SourceDomainUUID: common.StringPtr(domainID),
+		DomainUUID:       common.StringPtr(domainID),
+		Execution: &workflow.WorkflowExecution{
+			WorkflowId: common.StringPtr("workflow1"),
+			RunId:      common.StringPtr("run1"),
+		},
+		ScheduleId: common.Int64Ptr(scheduleID),
+		// the matching client routes tasks with an affinity token to the affinity task list
+		TaskList: &workflow.TaskList{
+			Name: common.StringPtr(matchingclient.AffinityTaskListName(taskList, affinityToken)),
+			Kind: common.TaskListKindPtr(workflow.TaskListKindSticky),
+		},
+		ScheduleToStartTimeoutSeconds: common.Int32Ptr(100),
+		AffinityToken:                 common.StringPtr(affinityToken),
+	}
+}
+
+func (s *matchingEngineSuite) TestDrainTaskList_RedirectToTarget() {
+	s.mockDomainCache.EXPECT().GetDomainName(gomock.Any()).Return("domainName", nil).AnyTimes()
+	mockMatchingClient := matchingservicetest.NewMockClient(s.controller)
+	s.matchingEngine.matchingClient = mockMatchingClient
+
+	domainID := "domainId"
+	tl := "makeToast"
+	target := "makeToast-v2"
+	tlID := newTestTaskListID(domainID, tl, persistence.TaskListTypeActivity)
+
+	mockMatchingClient.EXPECT().DrainTaskList(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, request *matching.DrainTaskListRequest, opts ...interface{}) (*matching.DrainTaskListResponse, error) {
+			s.Equal(target, request.TaskList.GetName())
+			s.Nil(request.Drain)
+			return &matching.DrainTaskListResponse{Draining: common.BoolPtr(false)}, nil
+		}).Times(1)
+	resp, err := s.matchingEngine.DrainTaskList(context.Background(), s.newDrainTaskListRequest(domainID, tl, common.BoolPtr(true), target))
+	s.NoError(err)
+	s.True(resp.GetDraining())
+	s.Equal(target, resp.GetTargetTaskList())
+	s.EqualValues(0, resp.GetBacklogCountHint())
+
+	mockMatchingClient.EXPECT().AddActivityTask(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, request *matching.AddActivityTaskRequest, opts ...interface{}) error {
+			s.Equal(target, request.TaskList.GetName())
+			s.Equal("", request.GetForwardedFrom())
+			s.Equal(int64(3), request.GetScheduleId())
+			return nil
+		}).Times(1)
+
+	syncMatch, err := s.matchingEngine.AddActivityTask(context.Background(), &matching.AddActivityTaskRequest{
+		SourceDomainUUID: common.StringPtr(domainID),
+		DomainUUID:       common.StringPtr(domainID),
+		Execution: &workflow.WorkflowExecution{
+			WorkflowId: common.StringPtr("workflow1"),
+			RunId:      common.StringPtr("run1"),
+		},
+		ScheduleId:                    common.Int64Ptr(3),
+		TaskList:                      &workflow.TaskList{Name: common.StringPtr(tl)},
+		ScheduleToStartTimeoutSeconds: common.Int32Ptr(100),
+	})
+	s.NoError(err)
+	s.False(syncMatch)
+	s.EqualValues(0, s.taskManager.getTaskCount(tlID))
+
+	// the drain state is persisted and survives a reload of the task list
+	s.matchingEngine.unloadTaskList(tlID)
+	resp, err = s.matchingEngine.DrainTaskList(context.Background(), s.newDrainTaskListRequest(domainID, tl, nil, ""))
+	s.NoError(err)
+	s.True(resp.GetDraining())
+	s.Equal(target, resp.GetTargetTaskList())
+
+	resp, err = s.matchingEngine.DrainTaskList(context.Background(), s.newDrainTaskListRequest(domainID, tl, common.BoolPtr(false), ""))
+	s.NoError(err)
+	s.False(resp.GetDraining())
+	s.Equal("", resp.GetTargetTaskList())
+}
+
+func (s *matchingEngineSuite) TestDrainTaskList_RejectCycle() {
+	s.mockDomainCache.EXPECT().GetDomainName(gomock.Any()).Return("domainName", nil).AnyTimes()
+	mockMatchingClient := matchingservicetest.NewMockClient(s.controller)
+	s.matchingEngine.matchingClient = mockMatchingClient
+
+	domainID := "domainId"
+	tl := "makeToast"
+	target := "makeToast-v2"
+
+	_, err := s.matchingEngine.DrainTaskList(context.Background(), s.newDrainTaskListRequest(domainID, tl, common.BoolPtr(true), tl))
+	s.IsType(&workflow.BadRequestError{}, err)
+
+	// the target is draining back into the task list
+	mockMatchingClient.EXPECT().DrainTaskList(gomock.Any(), gomock.Any()).Return(&matching.DrainTaskListResponse{
+		Draining:       common.BoolPtr(true),
+		TargetTaskList: common.StringPtr(tl),
+	}, nil).Times(1)
+	_, err = s.matchingEngine.DrainTaskList(context.Background(), s.newDrainTaskListRequest(domainID, tl, common.BoolPtr(true), target))
+	s.IsType(&workflow.BadRequestError{}, err)
+
+	resp, err := s.matchingEngine.DrainTaskList(context.Background(), s.newDrainTaskListRequest(domainID, tl, nil, ""))
+	s.NoError(err)
+	s.False(resp.GetDraining())
+}
+
+func (s *matchingEngineSuite) TestDrainTaskList_RejectWithoutTarget() {
+	s.mockDomainCache.EXPECT().GetDomainName(gomock.Any()).Return("domainName", nil).AnyTimes()
+	s.matchingEngine.matchingClient = matchingservicetest.NewMockClient(s.controller)
+
+	domainID := "domainId"
+	tl := "makeToast"
+	tlID := newTestTaskListID(domainID, tl, persistence.TaskListTypeDecision)
+
+	request := s.newDrainTaskListRequest(domainID, tl, common.BoolPtr(true), "")
+	request.TaskListType = common.TaskListTypePtr(workflow.TaskListTypeDecision)
+	_, err := s.matchingEngine.DrainTaskList(context.Background(), request)
+	s.NoError(err)
+
+	_, err = s.matchingEngine.AddDecisionTask(context.Background(), &matching.AddDecisionTaskRequest{
+		DomainUUID: common.StringPtr(domainID),
+		Execution: &workflow.WorkflowExecution{
+			WorkflowId: common.StringPtr("workflow1"),
+			RunId:      common.StringPtr("run1"),
+		},
+		ScheduleId:                    common.Int64Ptr(2),
+		TaskList:                      &workflow.TaskList{Name: common.StringPtr(tl)},
+		ScheduleToStartTimeoutSeconds: common.Int32Ptr(100),
+	})
+	s.Equal(errTaskListDraining, err)
+	s.EqualValues(0, s.taskManager.getTaskCount(tlID))
+}
+
+func (s *matchingEngineSuite) TestDrainTaskList_FanOutToPartitions() {
+	s.mockDomainCache.EXPECT().GetDomainName(gomock.Any()).Return("domainName", nil).AnyTimes()
+	s.matchingEngine.config.NumTasklistWritePartitions = dynamicconfig.GetIntPropertyFilteredByTaskListInfo(3)
+	mockMatchingClient := matchingservicetest.NewMockClient(s.controller)
+	s.matchingEngine.matchingClient = mockMatchingClient
+
+	domainID := "domainId"
+	tl := "makeToast"
+	tlID := newTestTaskListID(domainID, tl, persistence.TaskListTypeActivity)
+
+	var partitions []string
+	mockMatchingClient.EXPECT().DrainTaskList(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, request *matching.DrainTaskListRequest, opts ...interface{}) (*matching.DrainTaskListResponse, error) {
+			s.Equal(tl, request.GetForwardedFrom())
+			s.True(request.GetDrain())
+			partitions = append(partitions, request.TaskList.GetName())
+			return &matching.DrainTaskListResponse{
+				Draining:         common.BoolPtr(true),
+				BacklogCountHint: common.Int64Ptr(5),
+			}, nil
+		}).Times(2)
+
+	resp, err := s.matchingEngine.DrainTaskList(context.Background(), s.newDrainTaskListRequest(domainID, tl, common.BoolPtr(true), ""))
+	s.NoError(err)
+	s.True(resp.GetDraining())
+	s.EqualValues(10, resp.GetBacklogCountHint())
+	s.Equal([]string{tlID.mkName(1), tlID.mkName(2)}, partitions)
+}
+
+func (s *matchingEngineSuite) TestRecordTaskCompletion_ReleaseOnPartitionOnly() {
+	s.mockDomainCache.EXPECT().GetDomainName(gomock.Any()).Return("domainName", nil).AnyTimes()
+	s.matchingEngine.config.NumTasklistWritePartitions = dynamicconfig.GetIntPropertyFilteredByTaskListInfo(3)
+	// history sends the release to the partition which dispatched the task, it is not sent to other partitions
+	mockMatchingClient := matchingservicetest.NewMockClient(s.controller)
+	mockMatchingClient.EXPECT().RecordTaskCompletion(gomock.Any(), gomock.Any()).Times(0)
+	s.matchingEngine.matchingClient = mockMatchingClient
+
+	err := s.matchingEngine.RecordTaskCompletion(context.Background(), &matching.RecordTaskCompletionRequest{
+		DomainUUID:   common.StringPtr("domainId"),
+		TaskList:     &workflow.TaskList{Name: common.StringPtr("makeToast")},
+		TaskListType: common.TaskListTypePtr(workflow.TaskListTypeActivity),
+		Execution: &workflow.WorkflowExecution{
+			WorkflowId: common.StringPtr("workflowID"),
+			RunId:      common.StringPtr("runID"),
+		},
+		ScheduleId: common.Int64Ptr(5),
+	})
+	s.NoError(err)
+}
+
+func (s *matchingEngineSuite) newDrainTaskListRequest(
+	domainID string,
+	taskList string,
+	drain *bool,
+	target string,
+) *matching.DrainTaskListRequest {
+	return &matching.DrainTaskListRequest{
+		DomainUUID:     common.StringPtr(domainID),
+		TaskList:       &workflow.TaskList{Name: common.StringPtr(taskList)},
+		TaskListType:   common.TaskListTypePtr(workflow.TaskListTypeActivity),
+		Drain:          drain,
+		TargetTaskList: common.StringPtr(target),
+	}
+}
+
 func (s *matchingEngineSuite) TestSyncMatchActivities() {
 	// Set a short long poll expiration so we don't have to wait too long for 0 throttling cases
 	s.matchingEngine.config.LongPollExpirationInterval = dynamicconfig.GetDurationPropertyFnFilteredByTaskListInfo(50 * time.Millisecond)
@@ -705,6 +1059,7 @@ func (s *matchingEngineSuite) TestSyncMatchActivities() {
 			WorkflowID: workflowID,
 			RunID:      runID,
 			ScheduleID: scheduleID,
+			TaskList:   tl,
 		}
 
 		taskToken, _ := s.matchingEngine.tokenSerializer.Serialize(token)
@@ -887,6 +1242,7 @@ func (s *matchingEngineSuite) concurrentPublishConsumeActivities(
 					WorkflowID: workflowID,
 					RunID:      runID,
 					ScheduleID: scheduleID,
+					TaskList:   tl,
 				}
 				resultToken, err := s.matchingEngine.tokenSerializer.Deserialize(result.TaskToken)
 				s.NoError(err)
@@ -1007,6 +1363,7 @@ func (s *matchingEngineSuite) TestConcurrentPublishConsumeDecisions() {
 					WorkflowID: workflowID,
 					RunID:      runID,
 					ScheduleID: scheduleID,
+					TaskList:   tl,
 				}
 				resultToken, err := s.matchingEngine.tokenSerializer.Deserialize(result.TaskToken)
 				if err != nil {
@@ -1184,6 +1541,7 @@ func (s *matchingEngineSuite) TestMultipleEnginesActivitiesRangeStealing() {
 					WorkflowID: workflowID,
 					RunID:      runID,
 					ScheduleID: scheduleID,
+					TaskList:   tl,
 				}
 				resultToken, err := engine.tokenSerializer.Deserialize(result.TaskToken)
 				if err != nil {
@@ -1320,6 +1678,7 @@ func (s *matchingEngineSuite) TestMultipleEnginesDecisionsRangeStealing() {
 					WorkflowID: workflowID,
 					RunID:      runID,
 					ScheduleID: scheduleID,
+					TaskList:   tl,
 				}
 				resultToken, err := engine.tokenSerializer.Deserialize(result.TaskToken)
 				if err != nil {
@@ -1720,8 +2079,11 @@ type testTaskListManager struct {
 	sync.Mutex
 	rangeID         int64
 	ackLevel        int64
+	draining        bool
+	drainTarget     string
 	createTaskCount int
 	tasks           *treemap.Map
+	segments        *treemap.Map // id of last task -> tasks of the segment
 }
 
 func Int64Comparator(a, b interface{}) int {
@@ -1738,7 +2100,7 @@ func Int64Comparator(a, b interface{}) int {
 }
 
 func newTestTaskListManager() *testTaskListManager {
-	return &testTaskListManager{tasks: treemap.NewWith(Int64Comparator)}
+	return &testTaskListManager{tasks: treemap.NewWith(Int64Comparator), segments: treemap.NewWith(Int64Comparator)}
 }
 
 func newTestTaskListID(domainID string, name string, taskType int) *taskListID {
@@ -1759,12 +2121,14 @@ func (m *testTaskManager) LeaseTaskList(request *persistence.LeaseTaskListReques
 
 	return &persistence.LeaseTaskListResponse{
 		TaskListInfo: &persistence.TaskListInfo{
-			AckLevel: tlm.ackLevel,
-			DomainID: request.DomainID,
-			Name:     request.TaskList,
-			TaskType: request.TaskType,
-			RangeID:  tlm.rangeID,
-			Kind:     request.TaskListKind,
+			AckLevel:    tlm.ackLevel,
+			DomainID:    request.DomainID,
+			Name:        request.TaskList,
+			TaskType:    request.TaskType,
+			RangeID:     tlm.rangeID,
+			Kind:        request.TaskListKind,
+			Draining:    tlm.draining,
+			DrainTarget: tlm.drainTarget,
 		},
 	}, nil
 }
@@ -1784,6 +2148,8 @@ func (m *testTaskManager) UpdateTaskList(request *persistence.UpdateTaskListRequ
 		}
 	}
 	tlm.ackLevel = tli.AckLevel
+	tlm.draining = tli.Draining
+	tlm.drainTarget = tli.DrainTarget
 	return &persistence.UpdateTaskListResponse{}, nil
 }
 
@@ -1866,24 +2232,55 @@ func (m *testTaskManager) CreateTasks(request *persistence.CreateTasksRequest) (
 
 	// Then insert all tasks if no errors
 	for _, task := range request.Tasks {
-		scheduleID := task.Data.ScheduleID
-		info := &persistence.TaskInfo{
-			DomainID:   domainID,
-			RunID:      *task.Execution.RunId,
-			ScheduleID: scheduleID,
-			TaskID:     task.TaskID,
-			WorkflowID: *task.Execution.WorkflowId,
-		}
-		if task.Data.ScheduleToStartTimeout != 0 {
-			info.Expiry = time.Now().Add(time.Duration(task.Data.ScheduleToStartTimeout) * time.Second)
-		}
-		tlm.tasks.Put(task.TaskID, info)
+		tlm.tasks.Put(task.TaskID, newTestTaskInfo(domainID, task))
 		tlm.createTaskCount++
 	}
 
 	return &persistence.CreateTasksResponse{}, nil
 }
 
+// CreateTaskSegment provides a mock function with given fields: request
+func (m *testTaskManager) CreateTaskSegment(request *persistence.CreateTasksRequest) (*persistence.CreateTasksResponse, error) {
+	domainID := request.TaskListInfo.DomainID
+	taskList := request.TaskListInfo.Name
+	taskType := request.TaskListInfo.TaskType
+	rangeID := request.TaskListInfo.RangeID
+
+	tlm := m.getTaskListManager(newTestTaskListID(domainID, taskList, taskType))
+	tlm.Lock()
+	defer tlm.Unlock()
+
+	if tlm.rangeID != rangeID {
+		return nil, &persistence.ConditionFailedError{
+			Msg: fmt.Sprintf("testTaskManager.CreateTaskSegment failed. TaskList: %v, taskType: %v, rangeID: %v, db rangeID: %v",
+				taskList, taskType, rangeID, tlm.rangeID),
+		}
+	}
+	var segment []*persistence.TaskInfo
+	for _, task := range request.Tasks {
+		segment = append(segment, newTestTaskInfo(domainID, task))
+		tlm.createTaskCount++
+	}
+	tlm.segments.Put(request.Tasks[len(request.Tasks)-1].TaskID, segment)
+	return &persistence.CreateTasksResponse{}, nil
+}
+
+func newTestTaskInfo(domainID string, task *persistence.CreateTaskInfo) *persistence.TaskInfo {
+	info := &persistence.TaskInfo{
+		DomainID:     domainID,
+		RunID:        *task.Execution.RunId,
+		ScheduleID:   task.Data.ScheduleID,
+		TaskID:       task.TaskID,
+		WorkflowID:   *task.Execution.WorkflowId,
+		ActivityType: task.Data.ActivityType,
+		CreatedTime:  time.Now(),
+	}
+	if task.Data.ScheduleToStartTimeout != 0 {
+		info.Expiry = time.Now().Add(time.Duration(task.Data.ScheduleToStartTimeout) * time.Second)
+	}
+	return info
+}
+
 // GetTasks provides a mock function with given fields: request
 func (m *testTaskManager) GetTasks(request *persistence.GetTasksRequest) (*persistence.GetTasksResponse, error) {
 	m.logger.Debug(fmt.Sprintf("testTaskManager.GetTasks readLevel=%v, maxReadLevel=%v", request.ReadLevel, request.MaxReadLevel))
@@ -1909,12 +2306,64 @@ func (m *testTaskManager) GetTasks(request *persistence.GetTasksRequest) (*persi
 	}, nil
 }
 
+// GetTaskSegments provides a mock function with given fields: request
+func (m *testTaskManager) GetTaskSegments(request *persistence.GetTasksRequest) (*persistence.GetTasksResponse, error) {
+	tlm := m.getTaskListManager(newTestTaskListID(request.DomainID, request.TaskList, request.TaskType))
+	tlm.Lock()
+	defer tlm.Unlock()
+	var tasks []*persistence.TaskInfo
+
+	it := tlm.segments.Iterator()
+PopulateTasks:
+	for it.Next() {
+		if it.Key().(int64) <= request.ReadLevel {
+			continue
+		}
+		for _, task := range it.Value().([]*persistence.TaskInfo) {
+			if task.TaskID <= request.ReadLevel {
+				continue
+			}
+			if task.TaskID > *request.MaxReadLevel || len(tasks) == request.BatchSize {
+				break PopulateTasks
+			}
+			tasks = append(tasks, task)
+		}
+	}
+	return &persistence.GetTasksResponse{
+		Tasks: tasks,
+	}, nil
+}
+
+func (m *testTaskManager) CompleteTaskSegmentsLessThan(request *persistence.CompleteTasksLessThanRequest) (int, error) {
+	tlm := m.getTaskListManager(newTestTaskListID(request.DomainID, request.TaskListName, request.TaskType))
+	tlm.Lock()
+	defer tlm.Unlock()
+	for _, key := range tlm.segments.Keys() {
+		if key.(int64) <= request.TaskID {
+			tlm.segments.Remove(key)
+		}
+	}
+	return persistence.UnknownNumRowsAffected, nil
+}
+
 // getTaskCount returns number of tasks in a task list
 func (m *testTaskManager) getTaskCount(taskList *taskListID) int {
 	tlm := m.getTaskListManager(taskList)
 	tlm.Lock()
 	defer tlm.Unlock()
-	return tlm.tasks.Size()
+	count := tlm.tasks.Size()
+	for _, segment := range tlm.segments.Values() {
+		count += len(segment.([]*persistence.TaskInfo))
+	}
+	return count
+}
+
+// getSegmentCount returns number of segments in a task list
+func (m *testTaskManager) getSegmentCount(taskList *taskListID) int {
+	tlm := m.getTaskListManager(taskList)
+	tlm.Lock()
+	defer tlm.Unlock()
+	return tlm.segments.Size()
 }
 
 // getCreateTaskCount returns how many times CreateTask was called
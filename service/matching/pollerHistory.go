@@ -21,8 +21,12 @@
 package matching
 
 import (
+	"context"
+	"sync"
 	"time"
 
+	"go.uber.org/yarpc"
+
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
@@ -32,13 +36,33 @@ const (
 	pollerHistoryInitSize    = 0
 	pollerHistoryInitMaxSize = 1000
 	pollerHistoryTTL         = 5 * time.Minute
+	// pollers which have not polled for this long, and have no outstanding poll,
+	// are reported as inactive by DescribeTaskList
+	pollerInactiveThreshold = 2 * time.Minute
 )
 
 type (
 	pollerIdentity string
 
+	// pollerClientInfo is the client implementation and version, as sent by the
+	// worker in the request headers
+	pollerClientInfo struct {
+		impl           string
+		featureVersion string
+		libraryVersion string
+	}
+
 	pollerInfo struct {
-		ratePerSecond float64
+		sync.Mutex
+		ratePerSecond     float64
+		clientInfo        pollerClientInfo
+		lastAccessTime    time.Time
+		outstandingPolls  int32
+		tasksDispatched   int64
+		tasksCompleted    int64
+		tasksFailed       int64
+		stickyCacheHits   int64
+		stickyCacheMisses int64
 	}
 )
 
@@ -60,16 +84,86 @@ func newPollerHistory() *pollerHistory {
 	}
 }
 
+func newPollerClientInfo(ctx context.Context) pollerClientInfo {
+	call := yarpc.CallFromContext(ctx)
+	return pollerClientInfo{
+		impl:           call.Header(common.ClientImplHeaderName),
+		featureVersion: call.Header(common.FeatureVersionHeaderName),
+		libraryVersion: call.Header(common.LibraryVersionHeaderName),
+	}
+}
+
 func (pollers *pollerHistory) updatePollerInfo(id pollerIdentity, ratePerSecond *float64) {
 	rps := _defaultTaskDispatchRPS
 	if ratePerSecond != nil {
 		rps = *ratePerSecond
 	}
-	pollers.history.Put(id, &pollerInfo{ratePerSecond: rps})
+	info := pollers.getOrCreate(id)
+	info.Lock()
+	info.ratePerSecond = rps
+	info.lastAccessTime = time.Now()
+	info.Unlock()
+	// put the entry back to refresh its TTL
+	pollers.history.Put(id, info)
+}
+
+// pollStarted records the start of a poll from the given identity, the returned
+// function must be called once the poll returns
+func (pollers *pollerHistory) pollStarted(id pollerIdentity, clientInfo pollerClientInfo) func() {
+	info := pollers.getOrCreate(id)
+	info.Lock()
+	info.outstandingPolls++
+	if clientInfo.impl != "" {
+		info.clientInfo = clientInfo
+	}
+	info.Unlock()
+	return func() {
+		info.Lock()
+		info.outstandingPolls--
+		info.Unlock()
+	}
+}
+
+func (pollers *pollerHistory) recordTaskDispatched(id pollerIdentity, stickyCacheHit bool, stickyCacheMiss bool) {
+	info := pollers.get(id)
+	if info == nil {
+		return
+	}
+	info.Lock()
+	defer info.Unlock()
+	info.tasksDispatched++
+	if stickyCacheHit {
+		info.stickyCacheHits++
+	}
+	if stickyCacheMiss {
+		info.stickyCacheMisses++
+	}
+}
+
+func (pollers *pollerHistory) recordTaskCompleted(id pollerIdentity, failed bool) {
+	info := pollers.get(id)
+	if info == nil {
+		return
+	}
+	info.Lock()
+	defer info.Unlock()
+	if failed {
+		info.tasksFailed++
+	} else {
+		info.tasksCompleted++
+	}
 }
 
 func (pollers *pollerHistory) getAllPollerInfo() []*shared.PollerInfo {
-	var result []*shared.PollerInfo
+	active, inactive := pollers.getPollerInfo(time.Time{})
+	return append(active, inactive...)
+}
+
+// getPollerInfo returns the pollers which polled after the inactiveBefore time or which are
+// still polling, and separately the ones which stopped polling before that time
+func (pollers *pollerHistory) getPollerInfo(inactiveBefore time.Time) ([]*shared.PollerInfo, []*shared.PollerInfo) {
+	var active []*shared.PollerInfo
+	var inactive []*shared.PollerInfo
 
 	ite := pollers.history.Iterator()
 	defer ite.Close()
@@ -78,13 +172,50 @@ func (pollers *pollerHistory) getAllPollerInfo() []*shared.PollerInfo {
 		key := entry.Key().(pollerIdentity)
 		value := entry.Value().(*pollerInfo)
 		// TODO add IP, T1396795
-		lastAccessTime := entry.CreateTime()
-		result = append(result, &shared.PollerInfo{
-			Identity:       common.StringPtr(string(key)),
-			LastAccessTime: common.Int64Ptr(lastAccessTime.UnixNano()),
-			RatePerSecond:  common.Float64Ptr(value.ratePerSecond),
-		})
+		value.Lock()
+		lastAccessTime := value.lastAccessTime
+		isActive := value.outstandingPolls > 0 || !lastAccessTime.Before(inactiveBefore)
+		info := &shared.PollerInfo{
+			Identity:             common.StringPtr(string(key)),
+			LastAccessTime:       common.Int64Ptr(lastAccessTime.UnixNano()),
+			RatePerSecond:        common.Float64Ptr(value.ratePerSecond),
+			ClientImpl:           common.StringPtr(value.clientInfo.impl),
+			ClientFeatureVersion: common.StringPtr(value.clientInfo.featureVersion),
+			ClientLibraryVersion: common.StringPtr(value.clientInfo.libraryVersion),
+			OutstandingPollCount: common.Int32Ptr(value.outstandingPolls),
+			TasksDispatched:      common.Int64Ptr(value.tasksDispatched),
+			TasksCompleted:       common.Int64Ptr(value.tasksCompleted),
+			TasksFailed:          common.Int64Ptr(value.tasksFailed),
+			StickyCacheHits:      common.Int64Ptr(value.stickyCacheHits),
+			StickyCacheMisses:    common.Int64Ptr(value.stickyCacheMisses),
+		}
+		value.Unlock()
+		if isActive {
+			active = append(active, info)
+		} else {
+			inactive = append(inactive, info)
+		}
 	}
 
-	return result
+	return active, inactive
+}
+
+func (pollers *pollerHistory) get(id pollerIdentity) *pollerInfo {
+	value := pollers.history.Get(id)
+	if value == nil {
+		return nil
+	}
+	return value.(*pollerInfo)
+}
+
+func (pollers *pollerHistory) getOrCreate(id pollerIdentity) *pollerInfo {
+	value, err := pollers.history.PutIfNotExist(id, &pollerInfo{
+		ratePerSecond:  _defaultTaskDispatchRPS,
+		lastAccessTime: time.Now(),
+	})
+	if err != nil {
+		// only possible when the cache is full of pinned entries, which is never the case here
+		return &pollerInfo{ratePerSecond: _defaultTaskDispatchRPS, lastAccessTime: time.Now()}
+	}
+	return value.(*pollerInfo)
 }
@@ -54,6 +54,7 @@ type (
 		forwardedFrom    string     // name of the child partition this task is forwarded from (empty if not forwarded)
 		responseC        chan error // non-nil only where there is a caller waiting for response (sync-match)
 		backlogCountHint int64
+		remoteMatched    bool // true when the task was handed over to the parent partition
 	}
 )
 
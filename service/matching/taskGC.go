@@ -27,7 +27,7 @@ import (
 
 type taskGC struct {
 	lock           int64
-	db             *taskListDB
+	store          backlogStore
 	ackLevel       int64
 	lastDeleteTime time.Time
 	config         *taskListConfig
@@ -46,8 +46,8 @@ var maxTimeBetweenTaskDeletes = time.Second
 //
 // Finally, the Run() method is safe to be called from multiple threads. The underlying
 // implementation will make sure only one caller executes Run() and others simply bail out
-func newTaskGC(db *taskListDB, config *taskListConfig) *taskGC {
-	return &taskGC{db: db, config: config}
+func newTaskGC(store backlogStore, config *taskListConfig) *taskGC {
+	return &taskGC{store: store, config: config}
 }
 
 // Run deletes a batch of completed tasks, if its possible to do so
@@ -72,7 +72,7 @@ func (tgc *taskGC) tryDeleteNextBatch(ackLevel int64, ignoreTimeCond bool) {
 		return
 	}
 	tgc.lastDeleteTime = time.Now()
-	n, err := tgc.db.CompleteTasksLessThan(ackLevel, batchSize)
+	n, err := tgc.store.CompleteTasksLessThan(ackLevel, batchSize)
 	switch {
 	case err != nil:
 		return
@@ -71,8 +71,32 @@ type (
 		DispatchQueryTask(ctx context.Context, taskID string, request *matching.QueryWorkflowRequest) (*s.QueryWorkflowResponse, error)
 		CancelPoller(pollerID string)
 		GetAllPollerInfo() []*s.PollerInfo
+		// HasPollerAfter returns true if a poller is polling this task list
+		// or if the last poll returned after the given time
+		HasPollerAfter(accessTime time.Time) bool
+		// RecordPollerTaskDispatched updates the statistics of the poller with the given
+		// identity after a task was dispatched to it
+		RecordPollerTaskDispatched(identity string, stickyCacheHit bool, stickyCacheMiss bool)
+		// RecordPollerTaskCompleted updates the statistics of the poller with the given
+		// identity after it responded to a task
+		RecordPollerTaskCompleted(identity string, failed bool)
+		// ActivityTaskStarted is called after an activity task dispatched from this task list
+		// is marked as started, it then counts against the limits of its activity type until
+		// it completes or its start to close timeout expires
+		ActivityTaskStarted(task *persistence.TaskInfo, startToCloseTimeout time.Duration)
+		// ReleaseActivityTask stops counting the given activity task against the limits
+		// of its activity type, after it completed or failed to start
+		ReleaseActivityTask(workflowID string, runID string, scheduleID int64)
 		// DescribeTaskList returns information about the target tasklist
 		DescribeTaskList(includeTaskListStatus bool) *s.DescribeTaskListResponse
+		// GetDrainState returns whether new tasks of this task list are rejected, or forwarded
+		// to the returned target task list when it is not empty
+		GetDrainState() (draining bool, drainTarget string)
+		// SetDrainState persists the drain state of this task list
+		SetDrainState(draining bool, drainTarget string) error
+		// GetBacklogCountHint returns the number of tasks persisted above the persisted ack level,
+		// up to a batch of tasks, which are not known to be completed yet
+		GetBacklogCountHint() (int64, error)
 		String() string
 	}
 
@@ -82,6 +106,7 @@ type (
 		taskListKind     int // sticky taskList has different process in persistence
 		config           *taskListConfig
 		db               *taskListDB
+		backlog          backlogStore // persistence layout of tasks which could not be sync matched
 		engine           *matchingEngineImpl
 		taskWriter       *taskWriter
 		taskReader       *taskReader // reads tasks from db and async matches it with poller
@@ -93,6 +118,8 @@ type (
 		metricsClient    metrics.Client
 		domainNameValue  atomic.Value
 		domainScopeValue atomic.Value // domain tagged metric scope
+		// activityTypeLimiter enforces the server side limits configured per activity type
+		activityTypeLimiter *activityTypeLimiter
 		// pollerHistory stores poller which poll from this tasklist in last few minutes
 		pollerHistory *pollerHistory
 		// outstandingPollsMap is needed to keep track of all outstanding pollers for a
@@ -102,6 +129,12 @@ type (
 		// prevent tasks being dispatched to zombie pollers.
 		outstandingPollsLock sync.Mutex
 		outstandingPollsMap  map[string]context.CancelFunc
+		// number of polls in progress and the time in unix nanos at which the last poll returned,
+		// used to tell if the pollers of an affinity task list are still around
+		pollsInProgress int32
+		lastPollTime    int64
+		// set once the backlog of a draining task list has been reported as empty
+		drainCompleted int32
 
 		shutdownCh chan struct{}  // Delivers stop to the pump that populates taskBuffer
 		startWG    sync.WaitGroup // ensures that background processes do not start until setup is ready
@@ -143,7 +176,7 @@ func newTaskListManager(
 			tag.WorkflowTaskListType(taskList.taskType)),
 		db:                  db,
 		taskAckManager:      newAckManager(e.logger),
-		taskGC:              newTaskGC(db, taskListConfig),
+		activityTypeLimiter: newActivityTypeLimiter(taskListConfig),
 		config:              taskListConfig,
 		pollerHistory:       newPollerHistory(),
 		outstandingPollsMap: make(map[string]context.CancelFunc),
@@ -152,6 +185,8 @@ func newTaskListManager(
 	tlMgr.domainNameValue.Store("")
 	tlMgr.domainScopeValue.Store(e.metricsClient.Scope(metrics.MatchingTaskListMgrScope, metrics.DomainUnknownTag()))
 	tlMgr.tryInitDomainNameAndScope()
+	tlMgr.backlog = newBacklogStore(db, int(*taskListKind), taskListConfig, tlMgr.domainScope)
+	tlMgr.taskGC = newTaskGC(tlMgr.backlog, taskListConfig)
 	tlMgr.taskWriter = newTaskWriter(tlMgr)
 	tlMgr.taskReader = newTaskReader(tlMgr)
 	var fwdr *Forwarder
@@ -233,7 +268,12 @@ func (c *taskListManagerImpl) AddTask(ctx context.Context, params addTaskParams)
 // up the task or if rate limit is exceeded, this method will return error. Task
 // *will not* be persisted to db
 func (c *taskListManagerImpl) DispatchTask(ctx context.Context, task *internalTask) error {
-	return c.matcher.MustOffer(ctx, task)
+	err := c.matcher.MustOffer(ctx, task)
+	if err == nil && task.remoteMatched {
+		// the parent partition owns the task from now on
+		c.releaseActivityTask(task.event.TaskInfo)
+	}
+	return err
 }
 
 // DispatchQueryTask will dispatch query to local or remote poller. If forwarded then result or error is returned,
@@ -287,9 +327,17 @@ func (c *taskListManagerImpl) getTask(ctx context.Context, maxDispatchPerSecond
 		}()
 	}
 
+	atomic.AddInt32(&c.pollsInProgress, 1)
+	defer func() {
+		atomic.StoreInt64(&c.lastPollTime, time.Now().UnixNano())
+		atomic.AddInt32(&c.pollsInProgress, -1)
+	}()
+
 	identity, ok := ctx.Value(identityKey).(string)
 	if ok && identity != "" {
 		c.pollerHistory.updatePollerInfo(pollerIdentity(identity), maxDispatchPerSecond)
+		pollCompleted := c.pollerHistory.pollStarted(pollerIdentity(identity), newPollerClientInfo(ctx))
+		defer pollCompleted()
 	}
 
 	domainEntry, err := c.domainCache.GetDomainByID(c.taskListID.domainID)
@@ -316,6 +364,41 @@ func (c *taskListManagerImpl) GetAllPollerInfo() []*s.PollerInfo {
 	return c.pollerHistory.getAllPollerInfo()
 }
 
+// HasPollerAfter returns true if a poller is polling this task list
+// or if the last poll returned after the given time
+func (c *taskListManagerImpl) HasPollerAfter(accessTime time.Time) bool {
+	return atomic.LoadInt32(&c.pollsInProgress) > 0 || atomic.LoadInt64(&c.lastPollTime) >= accessTime.UnixNano()
+}
+
+// RecordPollerTaskDispatched updates the statistics of the poller with the given identity
+// after a task was dispatched to it
+func (c *taskListManagerImpl) RecordPollerTaskDispatched(identity string, stickyCacheHit bool, stickyCacheMiss bool) {
+	c.pollerHistory.recordTaskDispatched(pollerIdentity(identity), stickyCacheHit, stickyCacheMiss)
+}
+
+// RecordPollerTaskCompleted updates the statistics of the poller with the given identity
+// after it responded to a task
+func (c *taskListManagerImpl) RecordPollerTaskCompleted(identity string, failed bool) {
+	c.pollerHistory.recordTaskCompleted(pollerIdentity(identity), failed)
+}
+
+// ActivityTaskStarted is called after an activity task dispatched from this task list is
+// marked as started, it then counts against the limits of its activity type until it
+// completes or its start to close timeout expires
+func (c *taskListManagerImpl) ActivityTaskStarted(task *persistence.TaskInfo, startToCloseTimeout time.Duration) {
+	c.activityTypeLimiter.started(task, startToCloseTimeout)
+}
+
+// ReleaseActivityTask stops counting the given activity task against the limits of its
+// activity type, after it completed or failed to start
+func (c *taskListManagerImpl) ReleaseActivityTask(workflowID string, runID string, scheduleID int64) {
+	c.activityTypeLimiter.release(newActivityTaskKey(workflowID, runID, scheduleID))
+}
+
+func (c *taskListManagerImpl) releaseActivityTask(task *persistence.TaskInfo) {
+	c.ReleaseActivityTask(task.WorkflowID, task.RunID, task.ScheduleID)
+}
+
 func (c *taskListManagerImpl) CancelPoller(pollerID string) {
 	c.outstandingPollsLock.Lock()
 	cancel, ok := c.outstandingPollsMap[pollerID]
@@ -327,10 +410,18 @@ func (c *taskListManagerImpl) CancelPoller(pollerID string) {
 }
 
 // DescribeTaskList returns information about the target tasklist, right now this API returns the
-// pollers which polled this tasklist in last few minutes and status of tasklist's ackManager
-// (readLevel, ackLevel, backlogCountHint and taskIDBlock).
+// pollers which polled this tasklist in last few minutes, the pollers which stopped polling recently,
+// the limits configured per activity type and status of tasklist's ackManager (readLevel, ackLevel,
+// backlogCountHint and taskIDBlock).
 func (c *taskListManagerImpl) DescribeTaskList(includeTaskListStatus bool) *s.DescribeTaskListResponse {
-	response := &s.DescribeTaskListResponse{Pollers: c.GetAllPollerInfo()}
+	pollers, inactivePollers := c.pollerHistory.getPollerInfo(time.Now().Add(-pollerInactiveThreshold))
+	response := &s.DescribeTaskListResponse{
+		Pollers:         pollers,
+		InactivePollers: inactivePollers,
+	}
+	if c.taskListID.taskType == persistence.TaskListTypeActivity {
+		response.ActivityTypeLimits = c.activityTypeLimiter.describe()
+	}
 	if !includeTaskListStatus {
 		return response
 	}
@@ -350,6 +441,62 @@ func (c *taskListManagerImpl) DescribeTaskList(includeTaskListStatus bool) *s.De
 	return response
 }
 
+func (c *taskListManagerImpl) GetDrainState() (bool, string) {
+	return c.db.DrainState()
+}
+
+func (c *taskListManagerImpl) SetDrainState(draining bool, drainTarget string) error {
+	c.startWG.Wait()
+	_, err := c.executeWithRetry(func() (interface{}, error) {
+		return nil, c.db.UpdateDrainState(draining, drainTarget)
+	})
+	if err != nil {
+		return err
+	}
+	atomic.StoreInt32(&c.drainCompleted, 0)
+	c.logger.Info(fmt.Sprintf("Updated task list drain state, draining=%v, target=%v", draining, drainTarget))
+	c.checkDrainCompleted()
+	return nil
+}
+
+// GetBacklogCountHint reads the backlog from persistence rather than from the ack manager, which
+// only knows about the tasks read so far and the completions not persisted yet
+func (c *taskListManagerImpl) GetBacklogCountHint() (int64, error) {
+	c.startWG.Wait()
+	ackLevel := c.db.AckLevel()
+	maxReadLevel := c.taskWriter.GetMaxReadLevel()
+	if ackLevel >= maxReadLevel {
+		return 0, nil
+	}
+	resp, err := c.executeWithRetry(func() (interface{}, error) {
+		return c.backlog.GetTasks(ackLevel, maxReadLevel, c.config.GetTasksBatchSize())
+	})
+	if err != nil {
+		return 0, err
+	}
+	return int64(len(resp.(*persistence.GetTasksResponse).Tasks)), nil
+}
+
+// checkDrainCompleted logs once when the persisted backlog of a draining task list reaches zero,
+// after which the task list no longer holds any tasks and its workers can be stopped. It is called
+// whenever the ack level is persisted, as the persisted backlog cannot shrink in between
+func (c *taskListManagerImpl) checkDrainCompleted() {
+	if draining, _ := c.db.DrainState(); !draining || atomic.LoadInt32(&c.drainCompleted) == 1 {
+		return
+	}
+	if c.taskAckManager.getBacklogCountHint() > 0 {
+		// tasks read from the backlog are still outstanding
+		return
+	}
+	backlogCount, err := c.GetBacklogCountHint()
+	if err != nil || backlogCount > 0 {
+		return
+	}
+	if atomic.CompareAndSwapInt32(&c.drainCompleted, 0, 1) {
+		c.logger.Info("Backlog of draining task list reached zero")
+	}
+}
+
 func (c *taskListManagerImpl) String() string {
 	buf := new(bytes.Buffer)
 	if c.taskListID.taskType == persistence.TaskListTypeActivity {
@@ -476,10 +623,18 @@ func (c *taskListManagerImpl) executeWithRetry(
 }
 
 func (c *taskListManagerImpl) trySyncMatch(ctx context.Context, params addTaskParams) (bool, error) {
+	if !c.activityTypeLimiter.tryAcquire(params.taskInfo) {
+		// activity type is throttled, the task is dispatched later from the backlog
+		c.domainScope().IncCounter(metrics.ActivityTypeThrottleCounter)
+		return false, nil
+	}
 	childCtx, cancel := c.newChildContext(ctx, maxSyncMatchWaitTime, time.Second)
 	task := newInternalTask(params.taskInfo, c.completeTask, params.forwardedFrom, true)
 	matched, err := c.matcher.Offer(childCtx, task)
 	cancel()
+	if !matched || err != nil || task.remoteMatched {
+		c.releaseActivityTask(params.taskInfo)
+	}
 	return matched, err
 }
 
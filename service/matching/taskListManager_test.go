@@ -182,6 +182,164 @@ func TestDescribeTaskList(t *testing.T) {
 	require.Zero(t, taskListStatus.GetBacklogCountHint())
 }
 
+func TestDescribeTaskListPollerStats(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tlm := createTestTaskListManager(controller)
+	activePoller := "active-poller"
+	inactivePoller := "inactive-poller"
+
+	pollCompleted := tlm.pollerHistory.pollStarted(pollerIdentity(activePoller), pollerClientInfo{impl: "uber-go", featureVersion: "1.5.0"})
+	tlm.pollerHistory.updatePollerInfo(pollerIdentity(activePoller), nil)
+	tlm.RecordPollerTaskDispatched(activePoller, true, false)
+	tlm.RecordPollerTaskDispatched(activePoller, false, true)
+	tlm.RecordPollerTaskCompleted(activePoller, false)
+	tlm.RecordPollerTaskCompleted(activePoller, true)
+	// unknown pollers are ignored
+	tlm.RecordPollerTaskCompleted("unknown-poller", false)
+
+	tlm.pollerHistory.updatePollerInfo(pollerIdentity(inactivePoller), nil)
+	tlm.pollerHistory.get(pollerIdentity(inactivePoller)).lastAccessTime = time.Now().Add(-2 * pollerInactiveThreshold)
+
+	descResp := tlm.DescribeTaskList(false)
+	require.Equal(t, 1, len(descResp.GetPollers()))
+	poller := descResp.GetPollers()[0]
+	require.Equal(t, activePoller, poller.GetIdentity())
+	require.Equal(t, "uber-go", poller.GetClientImpl())
+	require.Equal(t, "1.5.0", poller.GetClientFeatureVersion())
+	require.Equal(t, int32(1), poller.GetOutstandingPollCount())
+	require.Equal(t, int64(2), poller.GetTasksDispatched())
+	require.Equal(t, int64(1), poller.GetTasksCompleted())
+	require.Equal(t, int64(1), poller.GetTasksFailed())
+	require.Equal(t, int64(1), poller.GetStickyCacheHits())
+	require.Equal(t, int64(1), poller.GetStickyCacheMisses())
+
+	require.Equal(t, 1, len(descResp.GetInactivePollers()))
+	require.Equal(t, inactivePoller, descResp.GetInactivePollers()[0].GetIdentity())
+	require.Equal(t, 2, len(tlm.GetAllPollerInfo()))
+
+	// an outstanding poll keeps the poller active regardless of its last access time
+	tlm.pollerHistory.get(pollerIdentity(activePoller)).lastAccessTime = time.Now().Add(-2 * pollerInactiveThreshold)
+	require.Equal(t, 1, len(tlm.DescribeTaskList(false).GetPollers()))
+	pollCompleted()
+	descResp = tlm.DescribeTaskList(false)
+	require.Equal(t, 0, len(descResp.GetPollers()))
+	require.Equal(t, 2, len(descResp.GetInactivePollers()))
+}
+
+func TestActivityTypeLimits(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	cfg := defaultTestConfig()
+	cfg.ActivityTypeMaxOutstanding = func(domain string, taskList string, taskType int) map[string]interface{} {
+		return map[string]interface{}{"limited": 2}
+	}
+	cfg.ActivityTypeDispatchRPS = func(domain string, taskList string, taskType int) map[string]interface{} {
+		return map[string]interface{}{"slow": 1.0}
+	}
+	tlm := createTestTaskListManagerWithConfig(controller, cfg)
+	newTask := func(activityType string, scheduleID int64) *persistence.TaskInfo {
+		return &persistence.TaskInfo{WorkflowID: "wid", RunID: "rid", ScheduleID: scheduleID, ActivityType: activityType}
+	}
+
+	limiter := tlm.activityTypeLimiter
+	require.True(t, limiter.tryAcquire(newTask("", 1)))
+	require.True(t, limiter.tryAcquire(newTask("unlimited", 2)))
+	require.True(t, limiter.tryAcquire(newTask("slow", 3)))
+	require.False(t, limiter.tryAcquire(newTask("slow", 4)))
+
+	started := newTask("limited", 5)
+	require.True(t, limiter.tryAcquire(started))
+	require.True(t, limiter.tryAcquire(newTask("limited", 6)))
+	require.False(t, limiter.tryAcquire(newTask("limited", 7)))
+	tlm.ActivityTaskStarted(started, time.Minute)
+
+	limits := tlm.DescribeTaskList(false).GetActivityTypeLimits()
+	require.Equal(t, 2, len(limits))
+	require.Equal(t, "limited", limits[0].GetActivityType())
+	require.Equal(t, int32(2), limits[0].GetMaxOutstanding())
+	require.Equal(t, int32(2), limits[0].GetOutstanding())
+	require.Nil(t, limits[0].RatePerSecond)
+	require.Equal(t, "slow", limits[1].GetActivityType())
+	require.Equal(t, 1.0, limits[1].GetRatePerSecond())
+	require.Equal(t, int32(1), limits[1].GetOutstanding())
+
+	// completing a task frees its slot
+	tlm.ReleaseActivityTask("wid", "rid", 6)
+	require.True(t, limiter.tryAcquire(newTask("limited", 7)))
+	require.False(t, limiter.tryAcquire(newTask("limited", 8)))
+
+	// started tasks stop counting once their start to close timeout expires
+	limiter.tasks[newActivityTaskKey("wid", "rid", 5)].expiry = time.Now().Add(-time.Second)
+	limiter.lastPurge = time.Time{}
+	require.True(t, limiter.tryAcquire(newTask("limited", 8)))
+}
+
+func TestDeliverBufferTasks_ActivityTypeThrottled(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	cfg := defaultTestConfig()
+	cfg.ActivityTypeMaxOutstanding = func(domain string, taskList string, taskType int) map[string]interface{} {
+		return map[string]interface{}{"limited": 0}
+	}
+	tlm := createTestTaskListManagerWithConfig(controller, cfg)
+	tlm.taskReader.taskBuffer <- &persistence.TaskInfo{ScheduleID: 1, ActivityType: "limited"}
+	tlm.taskReader.taskBuffer <- &persistence.TaskInfo{ScheduleID: 2, ActivityType: "unlimited"}
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		tlm.taskReader.dispatchBufferedTasks()
+		wg.Done()
+	}()
+
+	// the throttled task does not block the tasks behind it
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	task, err := tlm.matcher.Poll(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), task.event.ScheduleID)
+
+	close(tlm.taskReader.dispatcherShutdownC)
+	wg.Wait()
+}
+
+func TestDeliverBufferTasks_ThrottledTaskExpired(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	cfg := defaultTestConfig()
+	cfg.ActivityTypeMaxOutstanding = func(domain string, taskList string, taskType int) map[string]interface{} {
+		return map[string]interface{}{"limited": 0}
+	}
+	tlm := createTestTaskListManagerWithConfig(controller, cfg)
+	tlm.taskAckManager.addTask(1)
+	tlm.taskReader.taskBuffer <- &persistence.TaskInfo{
+		TaskID:       1,
+		ScheduleID:   1,
+		ActivityType: "limited",
+		Expiry:       time.Now().Add(throttledTaskRetryInterval),
+	}
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		tlm.taskReader.dispatchBufferedTasks()
+		wg.Done()
+	}()
+
+	// the throttled task is completed once it expires
+	deadline := time.Now().Add(time.Second)
+	for tlm.taskAckManager.getAckLevel() < 1 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	require.Equal(t, int64(1), tlm.taskAckManager.getAckLevel())
+
+	close(tlm.taskReader.dispatcherShutdownC)
+	wg.Wait()
+}
+
 func tlMgrStartWithoutNotifyEvent(tlm *taskListManagerImpl) {
 	// mimic tlm.Start() but avoid calling notifyEvent
 	tlm.startWG.Done()
@@ -189,6 +347,124 @@ func tlMgrStartWithoutNotifyEvent(tlm *taskListManagerImpl) {
 	go tlm.taskReader.getTasksPump()
 }
 
+func TestBacklogSegmentModeSwitch(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	mode := common.BacklogSegmentModeOn
+	cfg := defaultTestConfig()
+	cfg.BacklogSegmentMode = func(string, string, int) string { return mode }
+	tlm := createTestTaskListManagerWithConfig(controller, cfg)
+	tm := tlm.engine.taskManager.(*testTaskManager)
+	_, err := tlm.db.RenewLease()
+	require.NoError(t, err)
+
+	createTasks := func(taskIDs ...int64) {
+		var tasks []*persistence.CreateTaskInfo
+		for _, taskID := range taskIDs {
+			tasks = append(tasks, &persistence.CreateTaskInfo{
+				TaskID:    taskID,
+				Execution: workflow.WorkflowExecution{WorkflowId: common.StringPtr("wid"), RunId: common.StringPtr("rid")},
+				Data:      &persistence.TaskInfo{ScheduleID: taskID},
+			})
+		}
+		_, err := tlm.backlog.CreateTasks(tasks)
+		require.NoError(t, err)
+	}
+	getTaskIDs := func(minTaskID int64, maxTaskID int64, batchSize int) []int64 {
+		resp, err := tlm.backlog.GetTasks(minTaskID, maxTaskID, batchSize)
+		require.NoError(t, err)
+		var taskIDs []int64
+		for _, task := range resp.Tasks {
+			taskIDs = append(taskIDs, task.TaskID)
+		}
+		return taskIDs
+	}
+
+	createTasks(1, 2, 3)
+	mode = common.BacklogSegmentModeDrain
+	createTasks(4, 5)
+	mode = common.BacklogSegmentModeOn
+	createTasks(6)
+	require.Equal(t, 6, tm.getTaskCount(tlm.taskListID))
+	require.Equal(t, 2, tm.getSegmentCount(tlm.taskListID))
+
+	// tasks are read in order from both layouts
+	require.Equal(t, []int64{1, 2, 3, 4}, getTaskIDs(0, 6, 4))
+	require.Equal(t, []int64{5, 6}, getTaskIDs(4, 6, 10))
+
+	mode = common.BacklogSegmentModeDrain
+	_, err = tlm.backlog.CompleteTasksLessThan(5, 10)
+	require.NoError(t, err)
+	require.Equal(t, 1, tm.getTaskCount(tlm.taskListID))
+	require.Equal(t, []int64{6}, getTaskIDs(0, 6, 10))
+
+	// segments are not read at all once the layout is switched off
+	mode = common.BacklogSegmentModeOff
+	require.Empty(t, getTaskIDs(0, 6, 10))
+	createTasks(7)
+	require.Equal(t, []int64{7}, getTaskIDs(0, 7, 10))
+
+	// segments are read again while they are drained
+	mode = common.BacklogSegmentModeDrain
+	require.Equal(t, []int64{6, 7}, getTaskIDs(0, 7, 10))
+
+	// segments are ignored once none is left
+	backlog := tlm.backlog.(*layeredBacklogStore)
+	_, err = tlm.backlog.CompleteTasksLessThan(6, 10)
+	require.NoError(t, err)
+	require.Equal(t, 0, tm.getSegmentCount(tlm.taskListID))
+	require.EqualValues(t, 1, backlog.segmentsDrained)
+	require.Equal(t, []int64{7}, getTaskIDs(0, 7, 10))
+
+	mode = common.BacklogSegmentModeOn
+	require.True(t, backlog.readSegments())
+	require.EqualValues(t, 0, backlog.segmentsDrained)
+}
+
+func TestGetBacklogCountHint_ReadsPersistedBacklog(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tlm := createTestTaskListManager(controller)
+	_, err := tlm.db.RenewLease()
+	require.NoError(t, err)
+	tlm.startWG.Done()
+
+	var tasks []*persistence.CreateTaskInfo
+	for taskID := int64(1); taskID <= 3; taskID++ {
+		tasks = append(tasks, &persistence.CreateTaskInfo{
+			TaskID:    taskID,
+			Execution: workflow.WorkflowExecution{WorkflowId: common.StringPtr("wid"), RunId: common.StringPtr("rid")},
+			Data:      &persistence.TaskInfo{ScheduleID: taskID},
+		})
+	}
+	_, err = tlm.backlog.CreateTasks(tasks)
+	require.NoError(t, err)
+	atomic.StoreInt64(&tlm.taskWriter.maxReadLevel, 3)
+	require.NoError(t, tlm.db.UpdateDrainState(true, ""))
+
+	// none of the tasks was read yet, so the ack manager does not know about them
+	require.Zero(t, tlm.taskAckManager.getBacklogCountHint())
+	backlogCount, err := tlm.GetBacklogCountHint()
+	require.NoError(t, err)
+	require.EqualValues(t, 3, backlogCount)
+	tlm.checkDrainCompleted()
+	require.Zero(t, atomic.LoadInt32(&tlm.drainCompleted))
+
+	require.NoError(t, tlm.db.UpdateState(2))
+	backlogCount, err = tlm.GetBacklogCountHint()
+	require.NoError(t, err)
+	require.EqualValues(t, 1, backlogCount)
+
+	require.NoError(t, tlm.db.UpdateState(3))
+	backlogCount, err = tlm.GetBacklogCountHint()
+	require.NoError(t, err)
+	require.Zero(t, backlogCount)
+	tlm.checkDrainCompleted()
+	require.EqualValues(t, 1, atomic.LoadInt32(&tlm.drainCompleted))
+}
+
 func TestCheckIdleTaskList(t *testing.T) {
 	controller := gomock.NewController(t)
 	defer controller.Finish()
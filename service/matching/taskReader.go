@@ -33,6 +33,16 @@ import (
 
 var epochStartTime = time.Unix(0, 0)
 
+const (
+	// throttledTaskRetryInterval is the interval at which backlog tasks held back
+	// by the limits of their activity type are retried
+	throttledTaskRetryInterval = 100 * time.Millisecond
+	// affinityRedirectTimeout and affinityRedirectRetryInterval bound the calls which redirect
+	// the tasks of an affinity task list to their original task list after the affinity timeout
+	affinityRedirectTimeout       = 5 * time.Second
+	affinityRedirectRetryInterval = time.Second
+)
+
 type (
 	taskReader struct {
 		taskBuffer chan *persistence.TaskInfo // tasks loaded from persistence
@@ -85,34 +95,140 @@ func (tr *taskReader) Signal() {
 }
 
 func (tr *taskReader) dispatchBufferedTasks() {
+	// tasks held back by the limits of their activity type, they are set aside so that
+	// tasks of other activity types are not blocked behind them
+	var throttled []*persistence.TaskInfo
+	throttledTypes := make(map[string]int)
+	var retryC <-chan time.Time
 dispatchLoop:
 	for {
+		taskBufferC := tr.taskBuffer
+		if len(throttled) >= tr.tlMgr.config.GetTasksBatchSize() {
+			// stop reading the backlog until some of the throttled tasks are dispatched
+			taskBufferC = nil
+		}
+		if len(throttled) > 0 && retryC == nil {
+			retryC = time.After(throttledTaskRetryInterval)
+		}
+
 		select {
-		case taskInfo, ok := <-tr.taskBuffer:
+		case taskInfo, ok := <-taskBufferC:
 			if !ok { // Task list getTasks pump is shutdown
 				break dispatchLoop
 			}
-			task := newInternalTask(taskInfo, tr.tlMgr.completeTask, "", false)
-			for {
-				err := tr.tlMgr.DispatchTask(tr.cancelCtx, task)
-				if err == nil {
-					break
+			if tr.completeExpiredTask(taskInfo) {
+				continue dispatchLoop
+			}
+			// tasks of a throttled activity type are dispatched in order
+			if throttledTypes[taskInfo.ActivityType] > 0 || !tr.tlMgr.activityTypeLimiter.tryAcquire(taskInfo) {
+				tr.scope().IncCounter(metrics.ActivityTypeThrottleCounter)
+				throttled = append(throttled, taskInfo)
+				throttledTypes[taskInfo.ActivityType]++
+				continue dispatchLoop
+			}
+			if !tr.dispatchTask(taskInfo) {
+				break dispatchLoop
+			}
+		case <-retryC:
+			retryC = nil
+			remaining := throttled[:0]
+			blockedTypes := make(map[string]struct{})
+			for _, taskInfo := range throttled {
+				// expired tasks are dropped so that they do not hold up the backlog
+				expired := tr.completeExpiredTask(taskInfo)
+				if !expired {
+					if _, ok := blockedTypes[taskInfo.ActivityType]; ok || !tr.tlMgr.activityTypeLimiter.tryAcquire(taskInfo) {
+						blockedTypes[taskInfo.ActivityType] = struct{}{}
+						remaining = append(remaining, taskInfo)
+						continue
+					}
 				}
-				if err == context.Canceled {
-					tr.tlMgr.logger.Info("Tasklist manager context is cancelled, shutting down")
+				if throttledTypes[taskInfo.ActivityType]--; throttledTypes[taskInfo.ActivityType] == 0 {
+					delete(throttledTypes, taskInfo.ActivityType)
+				}
+				if expired {
+					continue
+				}
+				if !tr.dispatchTask(taskInfo) {
 					break dispatchLoop
 				}
-				// this should never happen unless there is a bug - don't drop the task
-				tr.scope().IncCounter(metrics.BufferThrottleCounter)
-				tr.logger().Error("taskReader: unexpected error dispatching task", tag.Error(err))
-				runtime.Gosched()
 			}
+			throttled = remaining
 		case <-tr.dispatcherShutdownC:
 			break dispatchLoop
 		}
 	}
 }
 
+// completeExpiredTask completes the given task without dispatching it when it expired
+// after it was read from the backlog, it returns false when the task is not expired
+func (tr *taskReader) completeExpiredTask(taskInfo *persistence.TaskInfo) bool {
+	if !tr.isTaskExpired(taskInfo, time.Now()) {
+		return false
+	}
+	tr.scope().IncCounter(metrics.ExpiredTasksCounter)
+	tr.tlMgr.completeTask(taskInfo, nil)
+	return true
+}
+
+// dispatchTask blocks until the task is dispatched to a poller, it
+// returns false when the task list manager is shutting down
+func (tr *taskReader) dispatchTask(taskInfo *persistence.TaskInfo) bool {
+	task := newInternalTask(taskInfo, tr.tlMgr.completeTask, "", false)
+	for {
+		err := tr.dispatch(task)
+		if err == nil {
+			return true
+		}
+		if err == context.Canceled || tr.cancelCtx.Err() != nil {
+			tr.tlMgr.logger.Info("Tasklist manager context is cancelled, shutting down")
+			return false
+		}
+		if tr.tlMgr.taskListID.IsAffinity() {
+			// no poller with the affinity token picked up the task within the affinity timeout
+			return tr.redirectAffinityTask(task)
+		}
+		// this should never happen unless there is a bug - don't drop the task
+		tr.scope().IncCounter(metrics.BufferThrottleCounter)
+		tr.logger().Error("taskReader: unexpected error dispatching task", tag.Error(err))
+		runtime.Gosched()
+	}
+}
+
+// dispatch blocks until the task is dispatched to a poller. Tasks of affinity task lists
+// are only dispatched until the affinity timeout expires after the task was created
+func (tr *taskReader) dispatch(task *internalTask) error {
+	if !tr.tlMgr.taskListID.IsAffinity() {
+		return tr.tlMgr.DispatchTask(tr.cancelCtx, task)
+	}
+	deadline := task.event.CreatedTime.Add(tr.tlMgr.config.ActivityAffinityTimeout())
+	ctx, cancel := context.WithDeadline(tr.cancelCtx, deadline)
+	defer cancel()
+	return tr.tlMgr.DispatchTask(ctx, task)
+}
+
+// redirectAffinityTask moves a task of an affinity task list to the task list it was
+// scheduled on, it returns false when the task list manager is shutting down
+func (tr *taskReader) redirectAffinityTask(task *internalTask) bool {
+	for {
+		ctx, cancel := context.WithTimeout(tr.cancelCtx, affinityRedirectTimeout)
+		err := tr.tlMgr.engine.redirectAffinityTask(ctx, tr.tlMgr.taskListID, task.event.TaskInfo)
+		cancel()
+		if err == nil {
+			tr.scope().IncCounter(metrics.AffinityTimeoutFallbackCounter)
+			tr.tlMgr.releaseActivityTask(task.event.TaskInfo)
+			task.finish(nil)
+			return true
+		}
+		tr.logger().Error("taskReader: failed to redirect affinity task", tag.Error(err))
+		select {
+		case <-time.After(affinityRedirectRetryInterval):
+		case <-tr.dispatcherShutdownC:
+			return false
+		}
+	}
+}
+
 func (tr *taskReader) getTasksPump() {
 	tr.tlMgr.startWG.Wait()
 	defer close(tr.taskBuffer)
@@ -163,6 +279,8 @@ getTasksPumpLoop:
 							tag.Error(err))
 					}
 					// keep going as saving ack is not critical
+				} else {
+					tr.tlMgr.checkDrainCompleted()
 				}
 				tr.Signal() // periodically signal pump to check persistence for tasks
 				updateAckTimer = time.NewTimer(tr.tlMgr.config.UpdateAckInterval())
@@ -184,7 +302,7 @@ getTasksPumpLoop:
 
 func (tr *taskReader) getTaskBatchWithRange(readLevel int64, maxReadLevel int64) ([]*persistence.TaskInfo, error) {
 	response, err := tr.tlMgr.executeWithRetry(func() (interface{}, error) {
-		return tr.tlMgr.db.GetTasks(readLevel, maxReadLevel, tr.tlMgr.config.GetTasksBatchSize())
+		return tr.tlMgr.backlog.GetTasks(readLevel, maxReadLevel, tr.tlMgr.config.GetTasksBatchSize())
 	})
 	if err != nil {
 		return nil, err
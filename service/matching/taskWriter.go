@@ -171,7 +171,7 @@ writerLoop:
 					maxReadLevel = taskIDs[i]
 				}
 
-				r, err := w.tlMgr.db.CreateTasks(tasks)
+				r, err := w.tlMgr.backlog.CreateTasks(tasks)
 				if err != nil {
 					w.logger.Error("Persistent store operation failure",
 						tag.StoreOperationCreateTask,
@@ -38,15 +38,19 @@ type (
 	}
 	// qualifiedTaskListName refers to the fully qualified task list name
 	qualifiedTaskListName struct {
-		name      string // internal name of the tasks list
-		baseName  string // original name of the task list as specified by user
-		partition int    // partitionID of task list
+		name          string // internal name of the tasks list
+		baseName      string // original name of the task list as specified by user
+		partition     int    // partitionID of task list
+		affinityToken string // affinity token of the activity tasks held by an affinity task list
 	}
 )
 
 const (
 	// taskListPartitionPrefix is the required naming prefix for any task list partition other than partition 0
 	taskListPartitionPrefix = "/__cadence_sys/"
+	// affinityTaskListPrefix is the naming prefix of the task lists which hold activity tasks
+	// scheduled with an affinity token, it must match the prefix used by the matching client
+	affinityTaskListPrefix = "/__cadence_affinity/"
 )
 
 // newTaskListName returns a fully qualified task list name.
@@ -77,6 +81,12 @@ func newTaskListName(name string) (qualifiedTaskListName, error) {
 	return tn, nil
 }
 
+// IsAffinity returns true if this task list holds the activity tasks of its base task list
+// which are scheduled with an affinity token, which only pollers with that token receive
+func (tn *qualifiedTaskListName) IsAffinity() bool {
+	return tn.affinityToken != ""
+}
+
 // IsRoot returns true if this task list is a root partition
 func (tn *qualifiedTaskListName) IsRoot() bool {
 	return tn.partition == 0
@@ -102,6 +112,17 @@ func (tn *qualifiedTaskListName) mkName(partition int) string {
 }
 
 func (tn *qualifiedTaskListName) init() error {
+	if strings.HasPrefix(tn.name, affinityTaskListPrefix) {
+		// affinity task lists are named /__cadence_affinity/[original-name]/[affinityToken]
+		suffixOff := strings.LastIndex(tn.name, "/")
+		if suffixOff <= len(affinityTaskListPrefix) || suffixOff == len(tn.name)-1 {
+			return fmt.Errorf("invalid affinity task list name %v", tn.name)
+		}
+		tn.baseName = tn.name[len(affinityTaskListPrefix):suffixOff]
+		tn.affinityToken = tn.name[suffixOff+1:]
+		return nil
+	}
+
 	if !strings.HasPrefix(tn.name, taskListPartitionPrefix) {
 		return nil
 	}
@@ -57,6 +57,30 @@ func TestValidTaskListNames(t *testing.T) {
 	}
 }
 
+func TestAffinityTaskListNames(t *testing.T) {
+	testCases := []struct {
+		input         string
+		baseName      string
+		affinityToken string
+	}{
+		{"/__cadence_affinity/list0/host0", "list0", "host0"},
+		{"/__cadence_affinity//list0//host0", "/list0/", "host0"},
+		{"/__cadence_affinity//__cadence_sys/list0/1/host0", "/__cadence_sys/list0/1", "host0"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			tn, err := newTaskListName(tc.input)
+			require.NoError(t, err)
+			require.True(t, tn.IsAffinity())
+			require.True(t, tn.IsRoot())
+			require.Equal(t, tc.baseName, tn.baseName)
+			require.Equal(t, tc.affinityToken, tn.affinityToken)
+			require.Equal(t, tc.input, tn.name)
+		})
+	}
+}
+
 func TestTaskListParentName(t *testing.T) {
 	testCases := []struct {
 		name   string
@@ -106,6 +130,9 @@ func TestInvalidTasklistNames(t *testing.T) {
 		"/__cadence_sys/list0",
 		"/__cadence_sys/list0/0",
 		"/__cadence_sys/list0/-1",
+		"/__cadence_affinity/",
+		"/__cadence_affinity/list0",
+		"/__cadence_affinity/list0/",
 	}
 	for _, name := range inputs {
 		t.Run(name, func(t *testing.T) {
@@ -0,0 +1,237 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package failovermanager
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/workflow"
+
+	h "github.com/uber/cadence/.gen/go/history"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log/tag"
+)
+
+const (
+	// PromotionWorkflowTypeName is the workflow type of the domain promotion workflow
+	PromotionWorkflowTypeName = "cadence-sys-domainPromotion-workflow"
+	// PromotionWorkflowIDPrefix is the prefix of the domain promotion workflow ID, followed by the domain name
+	PromotionWorkflowIDPrefix = "cadence-domain-promotion-"
+	// PromotionWorkflowTimeout is the timeout of the domain promotion workflow
+	PromotionWorkflowTimeout = 24 * time.Hour
+
+	promoteDomainActivityName       = "cadence-sys-promoteDomainActivity"
+	backfillReplicationActivityName = "cadence-sys-backfillReplicationActivity"
+
+	listWorkflowsPageSize = 1000
+)
+
+type (
+	// PromotionParams is the parameters for the domain promotion workflow
+	PromotionParams struct {
+		// Domain is the local domain promoted to a global domain
+		Domain string
+		// Clusters the domain is replicated to, including the current cluster
+		Clusters []string
+	}
+
+	// PromotionResult is the result of the domain promotion workflow
+	PromotionResult struct {
+		DomainID            string
+		BackfilledWorkflows int
+		FailedWorkflows     int
+	}
+
+	// BackfillReplicationActivityParams is the parameters for the backfill replication activity
+	BackfillReplicationActivityParams struct {
+		Domain   string
+		DomainID string
+	}
+
+	// BackfillReplicationActivityResult is the result of the backfill replication activity
+	BackfillReplicationActivityResult struct {
+		BackfilledWorkflows int
+		FailedWorkflows     int
+	}
+
+	// backfillReplicationProgress is recorded in the heartbeat details of the backfill replication activity
+	backfillReplicationProgress struct {
+		NextPageToken []byte
+		BackfillReplicationActivityResult
+	}
+)
+
+var (
+	errDomainNotSet = errors.New("domain is not set")
+
+	backfillActivityRetryPolicy = cadence.RetryPolicy{
+		InitialInterval:    time.Second,
+		BackoffCoefficient: 2,
+		MaximumInterval:    time.Minute,
+		ExpirationInterval: PromotionWorkflowTimeout,
+	}
+
+	backfillActivityOptions = workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    PromotionWorkflowTimeout,
+		HeartbeatTimeout:       time.Minute,
+		RetryPolicy:            &backfillActivityRetryPolicy,
+	}
+)
+
+func init() {
+	workflow.RegisterWithOptions(PromotionWorkflow, workflow.RegisterOptions{Name: PromotionWorkflowTypeName})
+	activity.RegisterWithOptions(PromoteDomainActivity, activity.RegisterOptions{Name: promoteDomainActivityName})
+	activity.RegisterWithOptions(BackfillReplicationActivity, activity.RegisterOptions{Name: backfillReplicationActivityName})
+}
+
+// PromotionWorkflow promotes a local domain to a global domain, then replicates the open workflows
+// started before the promotion to the other clusters of the domain.
+// Running the workflow again for an already promoted domain only replicates the open workflows.
+func PromotionWorkflow(ctx workflow.Context, params PromotionParams) (PromotionResult, error) {
+	if params.Domain == "" {
+		return PromotionResult{}, errDomainNotSet
+	}
+
+	var domainID string
+	if err := workflow.ExecuteActivity(
+		workflow.WithActivityOptions(ctx, activityOptions),
+		promoteDomainActivityName,
+		params,
+	).Get(ctx, &domainID); err != nil {
+		return PromotionResult{}, err
+	}
+
+	var result BackfillReplicationActivityResult
+	if err := workflow.ExecuteActivity(
+		workflow.WithActivityOptions(ctx, backfillActivityOptions),
+		backfillReplicationActivityName,
+		BackfillReplicationActivityParams{
+			Domain:   params.Domain,
+			DomainID: domainID,
+		},
+	).Get(ctx, &result); err != nil {
+		return PromotionResult{}, err
+	}
+
+	return PromotionResult{
+		DomainID:            domainID,
+		BackfilledWorkflows: result.BackfilledWorkflows,
+		FailedWorkflows:     result.FailedWorkflows,
+	}, nil
+}
+
+// PromoteDomainActivity promotes the local domain to a global domain replicated to the given clusters,
+// returns the domain ID
+func PromoteDomainActivity(ctx context.Context, params PromotionParams) (string, error) {
+	failoverManager := ctx.Value(failoverManagerContextKey).(*FailoverManager)
+	client := failoverManager.clientBean.GetFrontendClient()
+
+	resp, err := client.DescribeDomain(ctx, &shared.DescribeDomainRequest{
+		Name: common.StringPtr(params.Domain),
+	})
+	if err != nil {
+		return "", err
+	}
+	if resp.GetIsGlobalDomain() {
+		// domain is already promoted by a previous attempt or run
+		return resp.DomainInfo.GetUUID(), nil
+	}
+
+	request := &shared.UpdateDomainRequest{
+		Name:                  common.StringPtr(params.Domain),
+		PromoteToGlobalDomain: common.BoolPtr(true),
+	}
+	if len(params.Clusters) > 0 {
+		var clusters []*shared.ClusterReplicationConfiguration
+		for _, cluster := range params.Clusters {
+			clusters = append(clusters, &shared.ClusterReplicationConfiguration{
+				ClusterName: common.StringPtr(cluster),
+			})
+		}
+		request.ReplicationConfiguration = &shared.DomainReplicationConfiguration{
+			Clusters: clusters,
+		}
+	}
+	if _, err := client.UpdateDomain(ctx, request); err != nil {
+		return "", err
+	}
+	getActivityLogger(ctx).Info("Domain promoted to global domain", tag.WorkflowDomainName(params.Domain))
+	return resp.DomainInfo.GetUUID(), nil
+}
+
+// BackfillReplicationActivity replicates the open workflows of the promoted domain to the other clusters,
+// workflows which fail to be replicated are counted in the result instead of failing the activity
+func BackfillReplicationActivity(ctx context.Context, params BackfillReplicationActivityParams) (BackfillReplicationActivityResult, error) {
+	failoverManager := ctx.Value(failoverManagerContextKey).(*FailoverManager)
+	frontendClient := failoverManager.clientBean.GetFrontendClient()
+	historyClient := failoverManager.clientBean.GetHistoryClient()
+	logger := getActivityLogger(ctx)
+
+	var progress backfillReplicationProgress
+	if activity.HasHeartbeatDetails(ctx) {
+		if err := activity.GetHeartbeatDetails(ctx, &progress); err != nil {
+			logger.Error("Failed to recover backfill replication progress", tag.Error(err))
+		}
+	}
+
+	for {
+		resp, err := frontendClient.ListOpenWorkflowExecutions(ctx, &shared.ListOpenWorkflowExecutionsRequest{
+			Domain:          common.StringPtr(params.Domain),
+			MaximumPageSize: common.Int32Ptr(listWorkflowsPageSize),
+			NextPageToken:   progress.NextPageToken,
+			StartTimeFilter: &shared.StartTimeFilter{
+				EarliestTime: common.Int64Ptr(0),
+				LatestTime:   common.Int64Ptr(time.Now().UnixNano()),
+			},
+		})
+		if err != nil {
+			return progress.BackfillReplicationActivityResult, err
+		}
+
+		for _, execution := range resp.Executions {
+			if err := historyClient.BackfillReplication(ctx, &h.BackfillReplicationRequest{
+				DomainUUID: common.StringPtr(params.DomainID),
+				Execution:  execution.Execution,
+			}); err != nil {
+				logger.Error("Failed to backfill workflow replication",
+					tag.WorkflowID(execution.Execution.GetWorkflowId()),
+					tag.WorkflowRunID(execution.Execution.GetRunId()),
+					tag.Error(err),
+				)
+				progress.FailedWorkflows++
+				continue
+			}
+			progress.BackfilledWorkflows++
+		}
+
+		progress.NextPageToken = resp.NextPageToken
+		activity.RecordHeartbeat(ctx, progress)
+		if len(progress.NextPageToken) == 0 {
+			return progress.BackfillReplicationActivityResult, nil
+		}
+	}
+}
@@ -0,0 +1,418 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package failovermanager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/workflow"
+
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+)
+
+const (
+	failoverManagerContextKey = "failoverManagerContext"
+	// TaskListName is the tasklist name
+	TaskListName = "cadence-sys-failoverManager-tasklist"
+	// WorkflowTypeName is the workflow type
+	WorkflowTypeName = "cadence-sys-failoverManager-workflow"
+	// WorkflowID is reused by every failover workflow, so that only one bulk failover runs at a time
+	WorkflowID = "cadence-failover-manager"
+	// PauseSignal pauses the failover workflow before the next batch
+	PauseSignal = "pause"
+	// ResumeSignal resumes a paused failover workflow
+	ResumeSignal = "resume"
+	// QueryType is the query type of the failover workflow progress
+	QueryType = "state"
+
+	getDomainsActivityName = "cadence-sys-getDomainsActivity"
+	failoverActivityName   = "cadence-sys-failoverActivity"
+
+	// DefaultBatchFailoverSize is the default number of domains failed over in one batch
+	DefaultBatchFailoverSize = 20
+	// DefaultBatchFailoverWaitTime is the default wait time between two batches
+	DefaultBatchFailoverWaitTime = 30 * time.Second
+	// WorkflowTimeout is the timeout of the failover workflow
+	WorkflowTimeout = 24 * time.Hour
+
+	listDomainsPageSize = 200
+)
+
+const (
+	// WorkflowRunning is the state of a running failover workflow
+	WorkflowRunning = "running"
+	// WorkflowPaused is the state of a paused failover workflow
+	WorkflowPaused = "paused"
+	// WorkflowCompleted is the state of a completed failover workflow
+	WorkflowCompleted = "completed"
+)
+
+type (
+	// FailoverParams is the parameters for the failover workflow
+	FailoverParams struct {
+		// SourceCluster is the cluster the domains are currently active in
+		SourceCluster string
+		// TargetCluster is the cluster the domains are failed over to
+		TargetCluster string
+		// Domains optionally restricts the failover to the given domains, all eligible domains are failed over when empty
+		Domains []string
+		// Reason is recorded in the failover history of every domain
+		Reason string
+		// BatchFailoverSize is the number of domains failed over in one batch. Default to DefaultBatchFailoverSize
+		BatchFailoverSize int
+		// BatchFailoverWaitTime is the wait time between two batches. Default to DefaultBatchFailoverWaitTime
+		BatchFailoverWaitTime time.Duration
+		// GracefulFailoverTimeout drains every domain before handing it over when set,
+		// graceful failover is only allowed when the workflow runs in the source cluster
+		GracefulFailoverTimeout time.Duration
+	}
+
+	// FailoverResult is the result of the failover workflow
+	FailoverResult struct {
+		SuccessDomains []string
+		FailedDomains  []string
+	}
+
+	// QueryResult is the progress of the failover workflow
+	QueryResult struct {
+		State          string
+		SourceCluster  string
+		TargetCluster  string
+		TotalDomains   int
+		SuccessDomains []string
+		FailedDomains  []string
+	}
+
+	// GetDomainsActivityParams is the parameters for the get domains activity
+	GetDomainsActivityParams struct {
+		SourceCluster string
+		TargetCluster string
+		Domains       []string
+	}
+
+	// FailoverActivityParams is the parameters for the failover activity
+	FailoverActivityParams struct {
+		Domains                 []string
+		TargetCluster           string
+		Reason                  string
+		GracefulFailoverTimeout time.Duration
+	}
+
+	// FailoverActivityResult is the result of the failover activity
+	FailoverActivityResult struct {
+		SuccessDomains []string
+		FailedDomains  []string
+	}
+)
+
+var (
+	errSourceClusterNotSet = errors.New("source cluster is not set")
+	errTargetClusterNotSet = errors.New("target cluster is not set")
+	errSameCluster         = errors.New("source cluster and target cluster are the same")
+
+	activityRetryPolicy = cadence.RetryPolicy{
+		InitialInterval:    time.Second,
+		BackoffCoefficient: 2,
+		MaximumInterval:    time.Minute,
+		ExpirationInterval: 10 * time.Minute,
+	}
+
+	activityOptions = workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    5 * time.Minute,
+		RetryPolicy:            &activityRetryPolicy,
+	}
+)
+
+func init() {
+	workflow.RegisterWithOptions(FailoverWorkflow, workflow.RegisterOptions{Name: WorkflowTypeName})
+	activity.RegisterWithOptions(GetDomainsActivity, activity.RegisterOptions{Name: getDomainsActivityName})
+	activity.RegisterWithOptions(FailoverActivity, activity.RegisterOptions{Name: failoverActivityName})
+}
+
+// FailoverWorkflow fails over the global domains active in the source cluster to the target cluster batch by batch.
+// The workflow can be paused and resumed by signals; if it is terminated, starting it again only fails over
+// the domains which are still active in the source cluster.
+func FailoverWorkflow(ctx workflow.Context, params FailoverParams) (FailoverResult, error) {
+	if err := validateParams(params); err != nil {
+		return FailoverResult{}, err
+	}
+	params = setDefaultParams(params)
+
+	state := &QueryResult{
+		State:         WorkflowRunning,
+		SourceCluster: params.SourceCluster,
+		TargetCluster: params.TargetCluster,
+	}
+	if err := workflow.SetQueryHandler(ctx, QueryType, func() (QueryResult, error) {
+		return *state, nil
+	}); err != nil {
+		return FailoverResult{}, err
+	}
+
+	ctx = workflow.WithActivityOptions(ctx, activityOptions)
+	var domains []string
+	if err := workflow.ExecuteActivity(ctx, getDomainsActivityName, GetDomainsActivityParams{
+		SourceCluster: params.SourceCluster,
+		TargetCluster: params.TargetCluster,
+		Domains:       params.Domains,
+	}).Get(ctx, &domains); err != nil {
+		return FailoverResult{}, err
+	}
+	state.TotalDomains = len(domains)
+
+	pauseCh := workflow.GetSignalChannel(ctx, PauseSignal)
+	resumeCh := workflow.GetSignalChannel(ctx, ResumeSignal)
+	for start := 0; start < len(domains); start += params.BatchFailoverSize {
+		if start > 0 {
+			if err := workflow.Sleep(ctx, params.BatchFailoverWaitTime); err != nil {
+				return FailoverResult{}, err
+			}
+		}
+		handleSignals(ctx, pauseCh, resumeCh, state)
+
+		end := start + params.BatchFailoverSize
+		if end > len(domains) {
+			end = len(domains)
+		}
+		var result FailoverActivityResult
+		if err := workflow.ExecuteActivity(ctx, failoverActivityName, FailoverActivityParams{
+			Domains:                 domains[start:end],
+			TargetCluster:           params.TargetCluster,
+			Reason:                  params.Reason,
+			GracefulFailoverTimeout: params.GracefulFailoverTimeout,
+		}).Get(ctx, &result); err != nil {
+			result = FailoverActivityResult{FailedDomains: domains[start:end]}
+		}
+		state.SuccessDomains = append(state.SuccessDomains, result.SuccessDomains...)
+		state.FailedDomains = append(state.FailedDomains, result.FailedDomains...)
+	}
+
+	state.State = WorkflowCompleted
+	return FailoverResult{
+		SuccessDomains: state.SuccessDomains,
+		FailedDomains:  state.FailedDomains,
+	}, nil
+}
+
+// handleSignals applies the pause and resume signals received so far and blocks while the workflow is paused
+func handleSignals(
+	ctx workflow.Context,
+	pauseCh workflow.Channel,
+	resumeCh workflow.Channel,
+	state *QueryResult,
+) {
+
+	for {
+		if pauseCh.ReceiveAsync(nil) {
+			state.State = WorkflowPaused
+			continue
+		}
+		if resumeCh.ReceiveAsync(nil) {
+			state.State = WorkflowRunning
+			continue
+		}
+		if state.State != WorkflowPaused {
+			return
+		}
+
+		selector := workflow.NewSelector(ctx)
+		selector.AddReceive(pauseCh, func(c workflow.Channel, more bool) {
+			c.Receive(ctx, nil)
+		})
+		selector.AddReceive(resumeCh, func(c workflow.Channel, more bool) {
+			c.Receive(ctx, nil)
+			state.State = WorkflowRunning
+		})
+		selector.Select(ctx)
+	}
+}
+
+func validateParams(params FailoverParams) error {
+	if params.SourceCluster == "" {
+		return errSourceClusterNotSet
+	}
+	if params.TargetCluster == "" {
+		return errTargetClusterNotSet
+	}
+	if params.SourceCluster == params.TargetCluster {
+		return errSameCluster
+	}
+	return nil
+}
+
+func setDefaultParams(params FailoverParams) FailoverParams {
+	if params.BatchFailoverSize <= 0 {
+		params.BatchFailoverSize = DefaultBatchFailoverSize
+	}
+	if params.BatchFailoverWaitTime <= 0 {
+		params.BatchFailoverWaitTime = DefaultBatchFailoverWaitTime
+	}
+	return params
+}
+
+// GetDomainsActivity returns the global domains which are active in the source cluster and replicated to the target cluster
+func GetDomainsActivity(ctx context.Context, params GetDomainsActivityParams) ([]string, error) {
+	failoverManager := ctx.Value(failoverManagerContextKey).(*FailoverManager)
+	client := failoverManager.clientBean.GetFrontendClient()
+
+	filter := make(map[string]struct{}, len(params.Domains))
+	for _, domain := range params.Domains {
+		filter[domain] = struct{}{}
+	}
+
+	var domains []string
+	var token []byte
+	for {
+		resp, err := client.ListDomains(ctx, &shared.ListDomainsRequest{
+			PageSize:      common.Int32Ptr(listDomainsPageSize),
+			NextPageToken: token,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, domain := range resp.Domains {
+			if len(filter) > 0 {
+				if _, ok := filter[domain.DomainInfo.GetName()]; !ok {
+					continue
+				}
+			}
+			if shouldFailover(domain, params.SourceCluster, params.TargetCluster) {
+				domains = append(domains, domain.DomainInfo.GetName())
+			}
+		}
+
+		token = resp.NextPageToken
+		if len(token) == 0 {
+			return domains, nil
+		}
+	}
+}
+
+func shouldFailover(
+	domain *shared.DescribeDomainResponse,
+	sourceCluster string,
+	targetCluster string,
+) bool {
+
+	if !domain.GetIsGlobalDomain() ||
+		domain.DomainInfo.GetStatus() != shared.DomainStatusRegistered ||
+		domain.ReplicationConfiguration.GetActiveClusterName() != sourceCluster {
+		return false
+	}
+	if domain.FailoverInfo != nil && domain.FailoverInfo.State != nil &&
+		domain.FailoverInfo.GetState() == shared.DomainFailoverStateDraining {
+		// a graceful failover is already in progress
+		return false
+	}
+	for _, cluster := range domain.ReplicationConfiguration.Clusters {
+		if cluster.GetClusterName() == targetCluster {
+			return true
+		}
+	}
+	return false
+}
+
+// FailoverActivity fails over the given domains to the target cluster,
+// domains which fail to be failed over are returned in the result instead of failing the activity
+func FailoverActivity(ctx context.Context, params FailoverActivityParams) (FailoverActivityResult, error) {
+	failoverManager := ctx.Value(failoverManagerContextKey).(*FailoverManager)
+	client := failoverManager.clientBean.GetFrontendClient()
+	logger := getActivityLogger(ctx)
+
+	info := activity.GetInfo(ctx)
+	reason := fmt.Sprintf("bulk failover %v", info.WorkflowExecution.RunID)
+	if params.Reason != "" {
+		reason = fmt.Sprintf("%v: %v", reason, params.Reason)
+	}
+
+	var result FailoverActivityResult
+	for _, domain := range params.Domains {
+		failedOver, err := isFailedOver(ctx, client, domain, params.TargetCluster)
+		if err == nil && failedOver {
+			// domain is already failed over by a previous attempt or run
+			result.SuccessDomains = append(result.SuccessDomains, domain)
+			continue
+		}
+
+		request := &shared.UpdateDomainRequest{
+			Name: common.StringPtr(domain),
+			ReplicationConfiguration: &shared.DomainReplicationConfiguration{
+				ActiveClusterName: common.StringPtr(params.TargetCluster),
+			},
+			FailoverReason: common.StringPtr(reason),
+		}
+		if params.GracefulFailoverTimeout > 0 {
+			request.FailoverTimeoutInSeconds = common.Int32Ptr(int32(params.GracefulFailoverTimeout / time.Second))
+		}
+
+		if _, err := client.UpdateDomain(ctx, request); err != nil {
+			failoverManager.metricsClient.IncCounter(metrics.FailoverManagerScope, metrics.FailoverManagerDomainFailoverFailures)
+			logger.Error("Failed to fail over domain", tag.WorkflowDomainName(domain), tag.Error(err))
+			result.FailedDomains = append(result.FailedDomains, domain)
+			continue
+		}
+		failoverManager.metricsClient.IncCounter(metrics.FailoverManagerScope, metrics.FailoverManagerDomainFailoverSuccess)
+		result.SuccessDomains = append(result.SuccessDomains, domain)
+	}
+	return result, nil
+}
+
+func isFailedOver(
+	ctx context.Context,
+	client frontend.Client,
+	domain string,
+	targetCluster string,
+) (bool, error) {
+
+	resp, err := client.DescribeDomain(ctx, &shared.DescribeDomainRequest{
+		Name: common.StringPtr(domain),
+	})
+	if err != nil {
+		return false, err
+	}
+	if resp.ReplicationConfiguration.GetActiveClusterName() == targetCluster {
+		return true, nil
+	}
+	// a graceful failover to the target cluster is started already
+	return resp.FailoverInfo != nil && resp.FailoverInfo.State != nil &&
+		resp.FailoverInfo.GetState() == shared.DomainFailoverStateDraining &&
+		resp.FailoverInfo.GetTargetCluster() == targetCluster, nil
+}
+
+func getActivityLogger(ctx context.Context) log.Logger {
+	failoverManager := ctx.Value(failoverManagerContextKey).(*FailoverManager)
+	wfInfo := activity.GetInfo(ctx)
+	return failoverManager.logger.WithTags(
+		tag.WorkflowID(wfInfo.WorkflowExecution.ID),
+		tag.WorkflowRunID(wfInfo.WorkflowExecution.RunID),
+	)
+}
@@ -0,0 +1,174 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package failovermanager
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/cadence/testsuite"
+
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+)
+
+type failoverWorkflowTestSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+}
+
+func TestFailoverWorkflowTestSuite(t *testing.T) {
+	suite.Run(t, new(failoverWorkflowTestSuite))
+}
+
+func (s *failoverWorkflowTestSuite) TestWorkflow_InvalidParams() {
+	env := s.NewTestWorkflowEnvironment()
+	env.ExecuteWorkflow(WorkflowTypeName, FailoverParams{SourceCluster: "a", TargetCluster: "a"})
+	s.True(env.IsWorkflowCompleted())
+	s.Error(env.GetWorkflowError())
+}
+
+func (s *failoverWorkflowTestSuite) TestWorkflow_Batches() {
+	env := s.NewTestWorkflowEnvironment()
+	domains := []string{"d1", "d2", "d3"}
+	env.OnActivity(getDomainsActivityName, mock.Anything, GetDomainsActivityParams{
+		SourceCluster: "a",
+		TargetCluster: "b",
+	}).Return(domains, nil).Once()
+	env.OnActivity(failoverActivityName, mock.Anything, mock.MatchedBy(func(params FailoverActivityParams) bool {
+		return len(params.Domains) == 2 && params.TargetCluster == "b"
+	})).Return(FailoverActivityResult{SuccessDomains: []string{"d1"}, FailedDomains: []string{"d2"}}, nil).Once()
+	env.OnActivity(failoverActivityName, mock.Anything, mock.MatchedBy(func(params FailoverActivityParams) bool {
+		return len(params.Domains) == 1
+	})).Return(FailoverActivityResult{}, errors.New("some random error")).Once()
+
+	env.ExecuteWorkflow(WorkflowTypeName, FailoverParams{
+		SourceCluster:     "a",
+		TargetCluster:     "b",
+		BatchFailoverSize: 2,
+	})
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+	var result FailoverResult
+	s.NoError(env.GetWorkflowResult(&result))
+	s.Equal([]string{"d1"}, result.SuccessDomains)
+	s.Equal([]string{"d2", "d3"}, result.FailedDomains)
+	env.AssertExpectations(s.T())
+}
+
+func (s *failoverWorkflowTestSuite) TestWorkflow_PauseAndResume() {
+	env := s.NewTestWorkflowEnvironment()
+	env.OnActivity(getDomainsActivityName, mock.Anything, mock.Anything).Return([]string{"d1", "d2"}, nil).Once()
+	env.OnActivity(failoverActivityName, mock.Anything, mock.Anything).Return(
+		func(ctx context.Context, params FailoverActivityParams) (FailoverActivityResult, error) {
+			return FailoverActivityResult{SuccessDomains: params.Domains}, nil
+		},
+	).Twice()
+
+	env.RegisterDelayedCallback(func() {
+		env.SignalWorkflow(PauseSignal, nil)
+	}, time.Second)
+	env.RegisterDelayedCallback(func() {
+		resp, err := env.QueryWorkflow(QueryType)
+		s.NoError(err)
+		var state QueryResult
+		s.NoError(resp.Get(&state))
+		s.Equal(WorkflowPaused, state.State)
+		s.Equal(2, state.TotalDomains)
+		s.Equal([]string{"d1"}, state.SuccessDomains)
+		env.SignalWorkflow(ResumeSignal, nil)
+	}, DefaultBatchFailoverWaitTime+time.Minute)
+
+	env.ExecuteWorkflow(WorkflowTypeName, FailoverParams{
+		SourceCluster:     "a",
+		TargetCluster:     "b",
+		BatchFailoverSize: 1,
+	})
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+	var result FailoverResult
+	s.NoError(env.GetWorkflowResult(&result))
+	s.Equal([]string{"d1", "d2"}, result.SuccessDomains)
+	env.AssertExpectations(s.T())
+}
+
+func (s *failoverWorkflowTestSuite) TestShouldFailover() {
+	domain := &shared.DescribeDomainResponse{
+		DomainInfo:     &shared.DomainInfo{Status: shared.DomainStatusRegistered.Ptr()},
+		IsGlobalDomain: common.BoolPtr(true),
+		ReplicationConfiguration: &shared.DomainReplicationConfiguration{
+			ActiveClusterName: common.StringPtr("a"),
+			Clusters: []*shared.ClusterReplicationConfiguration{
+				{ClusterName: common.StringPtr("a")},
+				{ClusterName: common.StringPtr("b")},
+			},
+		},
+	}
+	s.True(shouldFailover(domain, "a", "b"))
+	s.False(shouldFailover(domain, "b", "a"))
+	s.False(shouldFailover(domain, "a", "c"))
+
+	domain.FailoverInfo = &shared.DomainFailoverInfo{State: shared.DomainFailoverStateDraining.Ptr()}
+	s.False(shouldFailover(domain, "a", "b"))
+	domain.FailoverInfo = &shared.DomainFailoverInfo{State: shared.DomainFailoverStateCompleted.Ptr()}
+	s.True(shouldFailover(domain, "a", "b"))
+
+	domain.IsGlobalDomain = common.BoolPtr(false)
+	s.False(shouldFailover(domain, "a", "b"))
+}
+
+func (s *failoverWorkflowTestSuite) TestPromotionWorkflow_InvalidParams() {
+	env := s.NewTestWorkflowEnvironment()
+	env.ExecuteWorkflow(PromotionWorkflowTypeName, PromotionParams{})
+	s.True(env.IsWorkflowCompleted())
+	s.Error(env.GetWorkflowError())
+}
+
+func (s *failoverWorkflowTestSuite) TestPromotionWorkflow() {
+	env := s.NewTestWorkflowEnvironment()
+	params := PromotionParams{Domain: "d1", Clusters: []string{"a", "b"}}
+	env.OnActivity(promoteDomainActivityName, mock.Anything, params).Return("d1-id", nil).Once()
+	env.OnActivity(backfillReplicationActivityName, mock.Anything, BackfillReplicationActivityParams{
+		Domain:   "d1",
+		DomainID: "d1-id",
+	}).Return(BackfillReplicationActivityResult{BackfilledWorkflows: 10, FailedWorkflows: 1}, nil).Once()
+
+	env.ExecuteWorkflow(PromotionWorkflowTypeName, params)
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+	var result PromotionResult
+	s.NoError(env.GetWorkflowResult(&result))
+	s.Equal(PromotionResult{DomainID: "d1-id", BackfilledWorkflows: 10, FailedWorkflows: 1}, result)
+	env.AssertExpectations(s.T())
+}
+
+func (s *failoverWorkflowTestSuite) TestPromotionWorkflow_PromoteFailed() {
+	env := s.NewTestWorkflowEnvironment()
+	env.OnActivity(promoteDomainActivityName, mock.Anything, mock.Anything).Return("", errors.New("some random error"))
+
+	env.ExecuteWorkflow(PromotionWorkflowTypeName, PromotionParams{Domain: "d1"})
+	s.True(env.IsWorkflowCompleted())
+	s.Error(env.GetWorkflowError())
+}
@@ -47,20 +47,9 @@ type (
 		Add(request elastic.BulkableRequest, key string, kafkaMsg messaging.Message)
 	}
 
-	// ElasticBulkProcessor is interface for elastic.BulkProcessor
-	// (elastic package doesn't provide such interface that tests can mock)
-	ElasticBulkProcessor interface {
-		Start(ctx context.Context) error
-		Stop() error
-		Close() error
-		Stats() elastic.BulkProcessorStats
-		Add(request elastic.BulkableRequest)
-		Flush() error
-	}
-
 	// esProcessorImpl implements ESProcessor, it's an agent of elastic.BulkProcessor
 	esProcessorImpl struct {
-		processor     ElasticBulkProcessor
+		processor     es.BulkProcessor
 		mapToKafkaMsg collection.ConcurrentTxMap // used to map ES request to kafka message
 		config        *Config
 		logger        log.Logger
@@ -74,7 +63,7 @@ type (
 )
 
 var _ ESProcessor = (*esProcessorImpl)(nil)
-var _ ElasticBulkProcessor = (*elastic.BulkProcessor)(nil)
+var _ es.BulkProcessor = (*elastic.BulkProcessor)(nil)
 
 const (
 	// retry configs for es bulk processor
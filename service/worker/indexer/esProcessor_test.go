@@ -40,13 +40,12 @@ import (
 	"github.com/uber/cadence/common/metrics"
 	mmocks "github.com/uber/cadence/common/metrics/mocks"
 	"github.com/uber/cadence/common/service/dynamicconfig"
-	"github.com/uber/cadence/service/worker/indexer/mocks"
 )
 
 type esProcessorSuite struct {
 	suite.Suite
 	esProcessor       *esProcessorImpl
-	mockBulkProcessor *mocks.ElasticBulkProcessor
+	mockBulkProcessor *esMocks.BulkProcessor
 	mockMetricClient  *mmocks.Client
 	mockESClient      *esMocks.Client
 }
@@ -77,7 +76,7 @@ func (s *esProcessorSuite) SetupTest() {
 		ESProcessorFlushInterval: dynamicconfig.GetDurationPropertyFn(1 * time.Minute),
 	}
 	s.mockMetricClient = &mmocks.Client{}
-	s.mockBulkProcessor = &mocks.ElasticBulkProcessor{}
+	s.mockBulkProcessor = &esMocks.BulkProcessor{}
 
 	zapLogger, err := zap.NewDevelopment()
 	s.Require().NoError(err)
@@ -0,0 +1,212 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package indexer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/olivere/elastic"
+
+	"github.com/uber/cadence/.gen/go/indexer"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/definition"
+	es "github.com/uber/cadence/common/elasticsearch"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/messaging"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/quotas"
+)
+
+// historySearchFields is the distinct values of history search fields of a workflow
+type historySearchFields map[string][]string
+
+const (
+	historyProcessorName = "history-processor"
+
+	historyReadTimeout = 10 * time.Second
+)
+
+// newHistoryIndexProcessor creates a processor consuming visibility messages, which indexes
+// the search fields of history events into the history search index when workflows are closed
+func newHistoryIndexProcessor(consumerName string, kafkaClient messaging.Client, esClient es.Client,
+	esIndexName string, frontendClient frontend.Client, config *Config, domainCache cache.DomainCache,
+	logger log.Logger, metricsClient metrics.Client) *indexProcessor {
+	p := newIndexProcessor(common.VisibilityAppName, consumerName, kafkaClient, esClient, historyProcessorName,
+		esIndexName, config, domainCache, logger, metricsClient)
+	p.frontendClient = frontendClient
+	p.rateLimiter = quotas.NewDynamicRateLimiter(
+		func() float64 {
+			return float64(config.HistoryIndexerMaxQPS())
+		},
+	)
+	p.retryPolicy = common.CreateFrontendServiceRetryPolicy()
+	p.addToES = p.addHistoryToES
+	return p
+}
+
+func (p *indexProcessor) addHistoryToES(indexMsg *indexer.Message, kafkaMsg messaging.Message, logger log.Logger) error {
+	docID := indexMsg.GetWorkflowID() + esDocIDDelimiter + indexMsg.GetRunID()
+
+	var keyToKafkaMsg string
+	var req elastic.BulkableRequest
+	switch indexMsg.GetMessageType() {
+	case indexer.MessageTypeIndex:
+		if _, ok := indexMsg.Fields[definition.CloseStatus]; !ok {
+			// history of open workflows is indexed when they are closed
+			kafkaMsg.Ack()
+			return nil
+		}
+		fields, err := p.readHistorySearchFields(indexMsg)
+		if err != nil {
+			if _, ok := err.(*shared.EntityNotExistsError); ok {
+				// history is deleted by retention
+				kafkaMsg.Ack()
+				return nil
+			}
+			logger.Error("Failed to read history for history search.", tag.Error(err),
+				tag.WorkflowID(indexMsg.GetWorkflowID()), tag.WorkflowRunID(indexMsg.GetRunID()))
+			return err
+		}
+
+		keyToKafkaMsg = fmt.Sprintf("%v-%v", kafkaMsg.Partition(), kafkaMsg.Offset())
+		doc := make(map[string]interface{}, len(fields)+4)
+		for k, v := range fields {
+			doc[k] = v
+		}
+		fulfillDoc(doc, indexMsg, keyToKafkaMsg)
+		req = elastic.NewBulkIndexRequest().
+			Index(p.esIndexName).
+			Type(esDocType).
+			Id(docID).
+			VersionType(versionTypeExternal).
+			Version(indexMsg.GetVersion()).
+			Doc(doc)
+	case indexer.MessageTypeDelete:
+		keyToKafkaMsg = docID
+		req = elastic.NewBulkDeleteRequest().
+			Index(p.esIndexName).
+			Type(esDocType).
+			Id(docID).
+			VersionType(versionTypeExternal).
+			Version(indexMsg.GetVersion())
+	default:
+		logger.Error("Unknown message type")
+		p.metricsClient.IncCounter(metrics.IndexProcessorScope, metrics.IndexProcessorCorruptedData)
+		return errUnknownMessageType
+	}
+
+	p.esProcessor.Add(req, keyToKafkaMsg, kafkaMsg)
+	return nil
+}
+
+// readHistorySearchFields reads the history of the workflow page by page, and returns its history search fields
+func (p *indexProcessor) readHistorySearchFields(indexMsg *indexer.Message) (historySearchFields, error) {
+	domainName, err := p.domainCache.GetDomainName(indexMsg.GetDomainID())
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make(historySearchFields)
+	var nextPageToken []byte
+	for {
+		// transient errors are retried, so that the message is not nacked on a busy or slow frontend
+		op := func() error {
+			return p.readHistoryPage(domainName, indexMsg, fields, &nextPageToken)
+		}
+		if err := backoff.Retry(op, p.retryPolicy, common.IsWhitelistServiceTransientError); err != nil {
+			return nil, err
+		}
+		if len(nextPageToken) == 0 {
+			return fields, nil
+		}
+	}
+}
+
+func (p *indexProcessor) readHistoryPage(
+	domainName string,
+	indexMsg *indexer.Message,
+	fields historySearchFields,
+	nextPageToken *[]byte,
+) error {
+	ctx, cancel := context.WithTimeout(context.Background(), historyReadTimeout)
+	defer cancel()
+
+	if err := p.rateLimiter.Wait(ctx); err != nil {
+		return err
+	}
+	resp, err := p.frontendClient.GetWorkflowExecutionHistory(ctx, &shared.GetWorkflowExecutionHistoryRequest{
+		Domain: common.StringPtr(domainName),
+		Execution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(indexMsg.GetWorkflowID()),
+			RunId:      common.StringPtr(indexMsg.GetRunID()),
+		},
+		MaximumPageSize: common.Int32Ptr(common.GetHistoryMaxPageSize),
+		NextPageToken:   *nextPageToken,
+	})
+	if err != nil {
+		return err
+	}
+	fields.addEvents(resp.GetHistory().GetEvents())
+	*nextPageToken = resp.NextPageToken
+	return nil
+}
+
+func (f historySearchFields) addEvents(events []*shared.HistoryEvent) {
+	for _, event := range events {
+		switch event.GetEventType() {
+		case shared.EventTypeActivityTaskScheduled:
+			f.add(definition.ActivityType, event.ActivityTaskScheduledEventAttributes.GetActivityType().GetName())
+		case shared.EventTypeActivityTaskFailed:
+			f.add(definition.FailureReason, event.ActivityTaskFailedEventAttributes.GetReason())
+		case shared.EventTypeActivityTaskTimedOut:
+			attributes := event.ActivityTaskTimedOutEventAttributes
+			f.add(definition.FailureReason, fmt.Sprintf("cadenceInternal:Timeout %v", attributes.GetTimeoutType()))
+			f.add(definition.FailureReason, attributes.GetLastFailureReason())
+		case shared.EventTypeChildWorkflowExecutionFailed:
+			f.add(definition.FailureReason, event.ChildWorkflowExecutionFailedEventAttributes.GetReason())
+		case shared.EventTypeWorkflowExecutionFailed:
+			f.add(definition.FailureReason, event.WorkflowExecutionFailedEventAttributes.GetReason())
+		case shared.EventTypeWorkflowExecutionSignaled:
+			f.add(definition.SignalName, event.WorkflowExecutionSignaledEventAttributes.GetSignalName())
+		case shared.EventTypeMarkerRecorded:
+			f.add(definition.MarkerName, event.MarkerRecordedEventAttributes.GetMarkerName())
+		}
+	}
+}
+
+func (f historySearchFields) add(key, value string) {
+	if value == "" {
+		return
+	}
+	for _, v := range f[key] {
+		if v == value {
+			return
+		}
+	}
+	f[key] = append(f[key], value)
+}
@@ -0,0 +1,231 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package indexer
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/olivere/elastic"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+
+	"github.com/uber/cadence/.gen/go/cadence/workflowservicetest"
+	"github.com/uber/cadence/.gen/go/indexer"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/messaging"
+	msgMocks "github.com/uber/cadence/common/messaging/mocks"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/quotas"
+)
+
+type testESProcessor struct {
+	requests []elastic.BulkableRequest
+	keys     []string
+}
+
+func (p *testESProcessor) Stop() {}
+
+func (p *testESProcessor) Add(request elastic.BulkableRequest, key string, kafkaMsg messaging.Message) {
+	p.requests = append(p.requests, request)
+	p.keys = append(p.keys, key)
+}
+
+func TestHistorySearchFields(t *testing.T) {
+	fields := make(historySearchFields)
+	fields.addEvents([]*shared.HistoryEvent{
+		{
+			EventType: shared.EventTypeActivityTaskScheduled.Ptr(),
+			ActivityTaskScheduledEventAttributes: &shared.ActivityTaskScheduledEventAttributes{
+				ActivityType: &shared.ActivityType{Name: common.StringPtr("activity")},
+			},
+		},
+		{
+			EventType: shared.EventTypeActivityTaskFailed.Ptr(),
+			ActivityTaskFailedEventAttributes: &shared.ActivityTaskFailedEventAttributes{
+				Reason: common.StringPtr("connection refused"),
+			},
+		},
+		{
+			EventType: shared.EventTypeActivityTaskScheduled.Ptr(),
+			ActivityTaskScheduledEventAttributes: &shared.ActivityTaskScheduledEventAttributes{
+				ActivityType: &shared.ActivityType{Name: common.StringPtr("activity")},
+			},
+		},
+		{
+			EventType: shared.EventTypeActivityTaskTimedOut.Ptr(),
+			ActivityTaskTimedOutEventAttributes: &shared.ActivityTaskTimedOutEventAttributes{
+				TimeoutType:       shared.TimeoutTypeStartToClose.Ptr(),
+				LastFailureReason: common.StringPtr("connection refused"),
+			},
+		},
+		{
+			EventType: shared.EventTypeWorkflowExecutionSignaled.Ptr(),
+			WorkflowExecutionSignaledEventAttributes: &shared.WorkflowExecutionSignaledEventAttributes{
+				SignalName: common.StringPtr("signal"),
+			},
+		},
+		{
+			EventType: shared.EventTypeMarkerRecorded.Ptr(),
+			MarkerRecordedEventAttributes: &shared.MarkerRecordedEventAttributes{
+				MarkerName: common.StringPtr("Version"),
+			},
+		},
+		{
+			EventType: shared.EventTypeChildWorkflowExecutionFailed.Ptr(),
+			ChildWorkflowExecutionFailedEventAttributes: &shared.ChildWorkflowExecutionFailedEventAttributes{
+				Reason: common.StringPtr("child failed"),
+			},
+		},
+		{
+			EventType: shared.EventTypeWorkflowExecutionFailed.Ptr(),
+			WorkflowExecutionFailedEventAttributes: &shared.WorkflowExecutionFailedEventAttributes{
+				Reason: common.StringPtr("workflow failed"),
+			},
+		},
+	})
+
+	require.Equal(t, historySearchFields{
+		definition.ActivityType:  []string{"activity"},
+		definition.FailureReason: []string{"connection refused", "cadenceInternal:Timeout START_TO_CLOSE", "child failed", "workflow failed"},
+		definition.SignalName:    []string{"signal"},
+		definition.MarkerName:    []string{"Version"},
+	}, fields)
+}
+
+func TestAddHistoryToES(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	mockDomainCache := cache.NewMockDomainCache(controller)
+	mockFrontendClient := workflowservicetest.NewMockClient(controller)
+	esProcessor := &testESProcessor{}
+	p := &indexProcessor{
+		esProcessor:    esProcessor,
+		esIndexName:    testIndex,
+		domainCache:    mockDomainCache,
+		logger:         loggerimpl.NewNopLogger(),
+		metricsClient:  metrics.NewClient(tally.NoopScope, metrics.Worker),
+		frontendClient: mockFrontendClient,
+		rateLimiter:    quotas.NewSimpleRateLimiter(1000),
+		retryPolicy:    backoff.NewExponentialRetryPolicy(time.Millisecond),
+	}
+
+	msg := &indexer.Message{
+		MessageType: indexer.MessageTypeIndex.Ptr(),
+		DomainID:    common.StringPtr("domainID"),
+		WorkflowID:  common.StringPtr("wid"),
+		RunID:       common.StringPtr("rid"),
+		Version:     common.Int64Ptr(10),
+		Fields:      map[string]*indexer.Field{},
+	}
+
+	// open workflow is skipped
+	kafkaMsg := &msgMocks.Message{}
+	kafkaMsg.On("Ack").Return(nil).Once()
+	require.NoError(t, p.addHistoryToES(msg, kafkaMsg, p.logger))
+	require.Empty(t, esProcessor.requests)
+	kafkaMsg.AssertExpectations(t)
+
+	// history of closed workflow is read page by page
+	msg.Fields[definition.CloseStatus] = &indexer.Field{Type: indexer.FieldTypeInt.Ptr(), IntData: common.Int64Ptr(1)}
+	mockDomainCache.EXPECT().GetDomainName("domainID").Return("domain", nil)
+	mockFrontendClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), &shared.GetWorkflowExecutionHistoryRequest{
+		Domain:          common.StringPtr("domain"),
+		Execution:       &shared.WorkflowExecution{WorkflowId: common.StringPtr("wid"), RunId: common.StringPtr("rid")},
+		MaximumPageSize: common.Int32Ptr(common.GetHistoryMaxPageSize),
+	}).Return(&shared.GetWorkflowExecutionHistoryResponse{
+		History: &shared.History{Events: []*shared.HistoryEvent{{
+			EventType: shared.EventTypeWorkflowExecutionSignaled.Ptr(),
+			WorkflowExecutionSignaledEventAttributes: &shared.WorkflowExecutionSignaledEventAttributes{
+				SignalName: common.StringPtr("signal"),
+			},
+		}}},
+		NextPageToken: []byte("token"),
+	}, nil)
+	mockFrontendClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), &shared.GetWorkflowExecutionHistoryRequest{
+		Domain:          common.StringPtr("domain"),
+		Execution:       &shared.WorkflowExecution{WorkflowId: common.StringPtr("wid"), RunId: common.StringPtr("rid")},
+		MaximumPageSize: common.Int32Ptr(common.GetHistoryMaxPageSize),
+		NextPageToken:   []byte("token"),
+	}).Return(&shared.GetWorkflowExecutionHistoryResponse{
+		History: &shared.History{Events: []*shared.HistoryEvent{{
+			EventType: shared.EventTypeWorkflowExecutionFailed.Ptr(),
+			WorkflowExecutionFailedEventAttributes: &shared.WorkflowExecutionFailedEventAttributes{
+				Reason: common.StringPtr("workflow failed"),
+			},
+		}}},
+	}, nil)
+	kafkaMsg = &msgMocks.Message{}
+	kafkaMsg.On("Partition").Return(int32(1))
+	kafkaMsg.On("Offset").Return(int64(2))
+	require.NoError(t, p.addHistoryToES(msg, kafkaMsg, p.logger))
+	require.Equal(t, []string{"1-2"}, esProcessor.keys)
+	source, err := esProcessor.requests[0].Source()
+	require.NoError(t, err)
+	require.Equal(t, `{"index":{"_index":"test-index","_id":"wid~rid","_type":"_doc","version":10,"version_type":"external"}}`, source[0])
+	var doc map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(source[1]), &doc))
+	require.Equal(t, map[string]interface{}{
+		definition.DomainID:      "domainID",
+		definition.WorkflowID:    "wid",
+		definition.RunID:         "rid",
+		definition.KafkaKey:      "1-2",
+		definition.SignalName:    []interface{}{"signal"},
+		definition.FailureReason: []interface{}{"workflow failed"},
+	}, doc)
+
+	// transient errors are retried
+	mockDomainCache.EXPECT().GetDomainName("domainID").Return("domain", nil)
+	mockFrontendClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).
+		Return(nil, &shared.ServiceBusyError{})
+	mockFrontendClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).
+		Return(&shared.GetWorkflowExecutionHistoryResponse{History: &shared.History{}}, nil)
+	kafkaMsg = &msgMocks.Message{}
+	kafkaMsg.On("Partition").Return(int32(1))
+	kafkaMsg.On("Offset").Return(int64(3))
+	require.NoError(t, p.addHistoryToES(msg, kafkaMsg, p.logger))
+	require.Equal(t, []string{"1-2", "1-3"}, esProcessor.keys)
+
+	// history deleted by retention is skipped
+	mockDomainCache.EXPECT().GetDomainName("domainID").Return("domain", nil)
+	mockFrontendClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).
+		Return(nil, &shared.EntityNotExistsError{})
+	kafkaMsg = &msgMocks.Message{}
+	kafkaMsg.On("Ack").Return(nil).Once()
+	require.NoError(t, p.addHistoryToES(msg, kafkaMsg, p.logger))
+	require.Len(t, esProcessor.requests, 2)
+	kafkaMsg.AssertExpectations(t)
+
+	// deletion
+	msg.MessageType = indexer.MessageTypeDelete.Ptr()
+	require.NoError(t, p.addHistoryToES(msg, &msgMocks.Message{}, p.logger))
+	require.Equal(t, []string{"1-2", "1-3", "wid~rid"}, esProcessor.keys)
+	source, err = esProcessor.requests[2].Source()
+	require.NoError(t, err)
+	require.Equal(t, `{"delete":{"_index":"test-index","_type":"_doc","_id":"wid~rid","version":10,"version_type":"external"}}`, source[0])
+}
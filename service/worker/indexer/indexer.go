@@ -21,9 +21,9 @@
 package indexer
 
 import (
-	"fmt"
-
+	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
 	es "github.com/uber/cadence/common/elasticsearch"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
@@ -38,11 +38,15 @@ type (
 		config              *Config
 		kafkaClient         messaging.Client
 		esClient            es.Client
+		domainCache         cache.DomainCache
 		logger              log.Logger
 		metricsClient       metrics.Client
 		dynamicCollection   *dynamicconfig.Collection
 		visibilityProcessor *indexProcessor
 		visibilityIndexName string
+		frontendClient      frontend.Client
+		historyProcessor    *indexProcessor
+		historyIndexName    string
 	}
 
 	// Config contains all configs for indexer
@@ -53,6 +57,7 @@ type (
 		ESProcessorBulkSize      dynamicconfig.IntPropertyFn // max total size of bytes in bulk
 		ESProcessorFlushInterval dynamicconfig.DurationPropertyFn
 		ValidSearchAttributes    dynamicconfig.MapPropertyFn
+		HistoryIndexerMaxQPS     dynamicconfig.IntPropertyFn // max rate of history pages read for history search
 	}
 )
 
@@ -60,35 +65,48 @@ const (
 	visibilityProcessorName = "visibility-processor"
 )
 
-// NewIndexer create a new Indexer
+// NewIndexer create a new Indexer, history events are also indexed for history search
+// if the history search index is configured
 func NewIndexer(config *Config, client messaging.Client, esClient es.Client, esConfig *es.Config,
-	logger log.Logger, metricsClient metrics.Client) *Indexer {
+	frontendClient frontend.Client, domainCache cache.DomainCache, logger log.Logger, metricsClient metrics.Client) *Indexer {
 	logger = logger.WithTags(tag.ComponentIndexer)
 
 	return &Indexer{
 		config:              config,
 		kafkaClient:         client,
 		esClient:            esClient,
+		domainCache:         domainCache,
 		logger:              logger,
 		metricsClient:       metricsClient,
 		visibilityIndexName: esConfig.Indices[common.VisibilityAppName],
+		frontendClient:      frontendClient,
+		historyIndexName:    esConfig.GetHistoryIndex(),
 	}
 }
 
 // Start indexer
-func (x Indexer) Start() error {
+func (x *Indexer) Start() error {
 	visibilityApp := common.VisibilityAppName
-	visConsumerName := getConsumerName(x.visibilityIndexName)
+	visConsumerName := es.GetConsumerName(x.visibilityIndexName)
 	x.visibilityProcessor = newIndexProcessor(visibilityApp, visConsumerName, x.kafkaClient, x.esClient,
-		visibilityProcessorName, x.visibilityIndexName, x.config, x.logger, x.metricsClient)
-	return x.visibilityProcessor.Start()
+		visibilityProcessorName, x.visibilityIndexName, x.config, x.domainCache, x.logger, x.metricsClient)
+	if err := x.visibilityProcessor.Start(); err != nil {
+		return err
+	}
+
+	if x.historyIndexName == "" {
+		return nil
+	}
+	historyConsumerName := es.GetConsumerName(x.historyIndexName)
+	x.historyProcessor = newHistoryIndexProcessor(historyConsumerName, x.kafkaClient, x.esClient,
+		x.historyIndexName, x.frontendClient, x.config, x.domainCache, x.logger, x.metricsClient)
+	return x.historyProcessor.Start()
 }
 
 // Stop indexer
-func (x Indexer) Stop() {
+func (x *Indexer) Stop() {
 	x.visibilityProcessor.Stop()
-}
-
-func getConsumerName(topic string) string {
-	return fmt.Sprintf("%s-consumer", topic)
+	if x.historyProcessor != nil {
+		x.historyProcessor.Stop()
+	}
 }
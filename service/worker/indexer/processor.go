@@ -31,7 +31,10 @@ import (
 
 	"github.com/uber/cadence/.gen/go/indexer"
 	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/codec"
 	"github.com/uber/cadence/common/definition"
 	es "github.com/uber/cadence/common/elasticsearch"
@@ -39,6 +42,8 @@ import (
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/quotas"
+	"github.com/uber/cadence/common/service/dynamicconfig"
 )
 
 type indexProcessor struct {
@@ -51,6 +56,7 @@ type indexProcessor struct {
 	esProcessorName string
 	esIndexName     string
 	config          *Config
+	domainCache     cache.DomainCache
 	logger          log.Logger
 	metricsClient   metrics.Client
 	isStarted       int32
@@ -58,6 +64,12 @@ type indexProcessor struct {
 	shutdownWG      sync.WaitGroup
 	shutdownCh      chan struct{}
 	msgEncoder      codec.BinaryEncoder
+	addToES         func(*indexer.Message, messaging.Message, log.Logger) error
+
+	// used by history search processor to read history events
+	frontendClient frontend.Client
+	rateLimiter    quotas.Limiter
+	retryPolicy    backoff.RetryPolicy
 }
 
 const (
@@ -72,8 +84,9 @@ var (
 )
 
 func newIndexProcessor(appName, consumerName string, kafkaClient messaging.Client, esClient es.Client,
-	esProcessorName, esIndexName string, config *Config, logger log.Logger, metricsClient metrics.Client) *indexProcessor {
-	return &indexProcessor{
+	esProcessorName, esIndexName string, config *Config, domainCache cache.DomainCache, logger log.Logger,
+	metricsClient metrics.Client) *indexProcessor {
+	p := &indexProcessor{
 		appName:         appName,
 		consumerName:    consumerName,
 		kafkaClient:     kafkaClient,
@@ -81,11 +94,14 @@ func newIndexProcessor(appName, consumerName string, kafkaClient messaging.Clien
 		esProcessorName: esProcessorName,
 		esIndexName:     esIndexName,
 		config:          config,
+		domainCache:     domainCache,
 		logger:          logger.WithTags(tag.ComponentIndexerProcessor),
 		metricsClient:   metricsClient,
 		shutdownCh:      make(chan struct{}),
 		msgEncoder:      codec.NewThriftRWEncoder(),
 	}
+	p.addToES = p.addMessageToES
+	return p
 }
 
 func (p *indexProcessor) Start() error {
@@ -189,7 +205,7 @@ func (p *indexProcessor) process(kafkaMsg messaging.Message) error {
 		return err
 	}
 
-	return p.addMessageToES(indexMsg, kafkaMsg, logger)
+	return p.addToES(indexMsg, kafkaMsg, logger)
 }
 
 func (p *indexProcessor) deserialize(payload []byte) (*indexer.Message, error) {
@@ -235,7 +251,7 @@ func (p *indexProcessor) addMessageToES(indexMsg *indexer.Message, kafkaMsg mess
 }
 
 func (p *indexProcessor) generateESDoc(msg *indexer.Message, keyToKafkaMsg string) map[string]interface{} {
-	doc := p.dumpFieldsToMap(msg.Fields)
+	doc := p.dumpFieldsToMap(msg.Fields, msg.GetDomainID())
 	fulfillDoc(doc, msg, keyToKafkaMsg)
 	return doc
 }
@@ -250,11 +266,12 @@ func (p *indexProcessor) decodeSearchAttrBinary(bytes []byte, key string) interf
 	return val
 }
 
-func (p *indexProcessor) dumpFieldsToMap(fields map[string]*indexer.Field) map[string]interface{} {
+func (p *indexProcessor) dumpFieldsToMap(fields map[string]*indexer.Field, domainID string) map[string]interface{} {
 	doc := make(map[string]interface{})
 	attr := make(map[string]interface{})
+	validSearchAttributes := p.getValidSearchAttributes(domainID)
 	for k, v := range fields {
-		if !p.isValidFieldToES(k) {
+		if !isValidFieldToES(k, validSearchAttributes) {
 			p.logger.Error("Unregistered field.", tag.ESField(k))
 			p.metricsClient.IncCounter(metrics.IndexProcessorScope, metrics.IndexProcessorCorruptedData)
 			continue
@@ -282,8 +299,22 @@ func (p *indexProcessor) dumpFieldsToMap(fields map[string]*indexer.Field) map[s
 	return doc
 }
 
-func (p *indexProcessor) isValidFieldToES(field string) bool {
-	if _, ok := p.config.ValidSearchAttributes()[field]; ok {
+// getValidSearchAttributes returns the search attributes registered for the domain on top of the cluster registry,
+// or the cluster registry if the domain name is unknown
+func (p *indexProcessor) getValidSearchAttributes(domainID string) map[string]interface{} {
+	domainName, err := p.domainCache.GetDomainName(domainID)
+	if err != nil {
+		p.logger.Warn("Failed to get domain name", tag.WorkflowDomainID(domainID), tag.Error(err))
+		return p.config.ValidSearchAttributes()
+	}
+	return definition.MergeValidSearchAttributes(
+		p.config.ValidSearchAttributes(),
+		p.config.ValidSearchAttributes(dynamicconfig.DomainFilter(domainName)),
+	)
+}
+
+func isValidFieldToES(field string, validSearchAttributes map[string]interface{}) bool {
+	if _, ok := validSearchAttributes[field]; ok {
 		return true
 	}
 	if field == definition.Memo || field == definition.KafkaKey || field == definition.Encoding {
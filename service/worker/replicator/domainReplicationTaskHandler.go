@@ -21,8 +21,11 @@
 package replicator
 
 import (
+	"time"
+
 	"github.com/uber/cadence/.gen/go/replicator"
 	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/persistence"
 )
@@ -116,6 +119,7 @@ func (domainReplicator *domainReplicatorImpl) handleDomainCreationReplicationTas
 		ReplicationConfig: &persistence.DomainReplicationConfig{
 			ActiveClusterName: task.ReplicationConfig.GetActiveClusterName(),
 			Clusters:          domainReplicator.convertClusterReplicationConfigFromThrift(task.ReplicationConfig.Clusters),
+			Filter:            task.ReplicationConfig.ReplicationFilter,
 		},
 		IsGlobalDomain:  true, // local domain will not be replicated
 		ConfigVersion:   task.GetConfigVersion(),
@@ -205,10 +209,13 @@ func (domainReplicator *domainReplicatorImpl) handleDomainUpdateReplicationTask(
 		Info:                        resp.Info,
 		Config:                      resp.Config,
 		ReplicationConfig:           resp.ReplicationConfig,
+		IsGlobalDomain:              resp.IsGlobalDomain,
 		ConfigVersion:               resp.ConfigVersion,
 		FailoverVersion:             resp.FailoverVersion,
 		FailoverNotificationVersion: resp.FailoverNotificationVersion,
 		NotificationVersion:         notificationVersion,
+		FailoverInfo:                resp.FailoverInfo,
+		FailoverHistory:             resp.FailoverHistory,
 	}
 
 	if resp.ConfigVersion < task.GetConfigVersion() {
@@ -233,13 +240,26 @@ func (domainReplicator *domainReplicatorImpl) handleDomainUpdateReplicationTask(
 			request.Config.BadBinaries = *task.Config.GetBadBinaries()
 		}
 		request.ReplicationConfig.Clusters = domainReplicator.convertClusterReplicationConfigFromThrift(task.ReplicationConfig.Clusters)
+		request.ReplicationConfig.Filter = task.ReplicationConfig.ReplicationFilter
 		request.ConfigVersion = task.GetConfigVersion()
 	}
 	if resp.FailoverVersion < task.GetFailoverVersion() {
 		recordUpdated = true
+		if resp.ReplicationConfig.ActiveClusterName != task.ReplicationConfig.GetActiveClusterName() {
+			request.FailoverHistory = persistence.AppendDomainFailoverRecord(request.FailoverHistory, &shared.DomainFailoverRecord{
+				FailoverVersion: common.Int64Ptr(task.GetFailoverVersion()),
+				FromCluster:     common.StringPtr(resp.ReplicationConfig.ActiveClusterName),
+				ToCluster:       common.StringPtr(task.ReplicationConfig.GetActiveClusterName()),
+				Timestamp:       common.Int64Ptr(time.Now().UnixNano()),
+			})
+		}
 		request.ReplicationConfig.ActiveClusterName = task.ReplicationConfig.GetActiveClusterName()
 		request.FailoverVersion = task.GetFailoverVersion()
 		request.FailoverNotificationVersion = notificationVersion
+		if request.FailoverInfo.GetState() == shared.DomainFailoverStateDraining {
+			// the failover from the remote cluster supersedes the local graceful failover
+			request.FailoverInfo = nil
+		}
 	}
 
 	if !recordUpdated {
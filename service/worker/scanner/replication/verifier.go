@@ -0,0 +1,530 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package replication
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"go.uber.org/cadence/activity"
+	"golang.org/x/time/rate"
+
+	"github.com/uber/cadence/.gen/go/admin"
+	"github.com/uber/cadence/.gen/go/shared"
+	adminClient "github.com/uber/cadence/client/admin"
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	p "github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/xdc"
+)
+
+type (
+	// MismatchType is the type of a replication mismatch between two clusters
+	MismatchType string
+
+	// Mismatch is a workflow run whose history differs between the local and the remote cluster
+	Mismatch struct {
+		WorkflowID             string
+		RunID                  string
+		Type                   MismatchType
+		LocalLastEventID       int64
+		LocalLastEventVersion  int64
+		RemoteLastEventID      int64
+		RemoteLastEventVersion int64
+		Repaired               bool
+	}
+
+	// LaggingRun is a run whose current branch in one cluster was behind the other cluster,
+	// it is rechecked after a grace period as replication may still be in flight
+	LaggingRun struct {
+		Mismatch
+		// LocalBehind is whether the local cluster or the remote cluster was behind
+		LocalBehind  bool
+		DetectedTime time.Time
+	}
+
+	// VerifierParams is the parameters of the replication verifier
+	VerifierParams struct {
+		// Domain is the name of the global domain to verify
+		Domain string
+		// RemoteCluster is the cluster the local cluster is compared with
+		RemoteCluster string
+		// Repair rereplicates the events missing in the local cluster from the remote cluster
+		Repair bool
+	}
+
+	// VerifierHeartbeatDetails is the heartbeat detail and the result of the replication verifier
+	VerifierHeartbeatDetails struct {
+		Phase         int
+		NextPageToken []byte
+		VerifiedCount int
+		MismatchCount int
+		RepairedCount int
+		ErrorCount    int
+		// Mismatches holds the first maxReportedMismatches mismatches
+		Mismatches []Mismatch
+		// LaggingRuns holds the runs to recheck after the grace period, at most maxReportedMismatches
+		LaggingRuns []LaggingRun
+	}
+
+	// Verifier compares the version histories of the executions of a global domain
+	// between the local cluster and a remote cluster
+	Verifier struct {
+		params         VerifierParams
+		domainID       string
+		localFrontend  frontend.Client
+		remoteFrontend frontend.Client
+		localAdmin     adminClient.Client
+		remoteAdmin    adminClient.Client
+		resender       xdc.NDCHistoryResender
+		hbd            VerifierHeartbeatDetails
+		limiter        *rate.Limiter
+		metrics        metrics.Client
+		logger         log.Logger
+		gracePeriod    time.Duration
+		isInTest       bool
+	}
+
+	// describedMutableState is the part of the described mutable state used by the verifier
+	describedMutableState struct {
+		VersionHistories *p.VersionHistories
+	}
+)
+
+const (
+	// MismatchTypeMissingLocalRun means the run exists in the remote cluster only
+	MismatchTypeMissingLocalRun MismatchType = "MissingLocalRun"
+	// MismatchTypeMissingRemoteRun means the run exists in the local cluster only
+	MismatchTypeMissingRemoteRun MismatchType = "MissingRemoteRun"
+	// MismatchTypeDivergedBranch means the current branches of the run diverged in the two clusters
+	MismatchTypeDivergedBranch MismatchType = "DivergedBranch"
+	// MismatchTypeLastEventIDMismatch means the current branch of the run in one cluster is behind the other
+	MismatchTypeLastEventIDMismatch MismatchType = "LastEventIDMismatch"
+)
+
+const (
+	// executions listed from the remote cluster are fully compared with the local cluster,
+	// executions listed from the local cluster are only checked for existence in the remote cluster
+	phaseRemoteOpen = iota
+	phaseRemoteClosed
+	phaseRecheckLagging
+	phaseLocalOpen
+	phaseLocalClosed
+	phaseDone
+)
+
+const (
+	pageSize              = 1000
+	maxReportedMismatches = 1000
+	// lagGracePeriod is the time given to a lagging run to catch up before it is reported
+	lagGracePeriod = 2 * time.Minute
+)
+
+// NewVerifier returns an instance of the replication verifier
+// The verifier can be started by calling the Run() method on the
+// returned object. Calling the Run() method will result in one
+// complete iteration over the open and closed executions of the
+// domain in both clusters. For each execution, the verifier will
+//  - compare the current version history in the two clusters
+//  - recheck the runs lagging behind after a grace period
+//  - rereplicate the missing events from the remote cluster, if repair is enabled
+func NewVerifier(
+	params VerifierParams,
+	domainID string,
+	localFrontend frontend.Client,
+	remoteFrontend frontend.Client,
+	localAdmin adminClient.Client,
+	remoteAdmin adminClient.Client,
+	resender xdc.NDCHistoryResender,
+	rps int,
+	hbd VerifierHeartbeatDetails,
+	metricsClient metrics.Client,
+	logger log.Logger,
+) *Verifier {
+
+	return &Verifier{
+		params:         params,
+		domainID:       domainID,
+		localFrontend:  localFrontend,
+		remoteFrontend: remoteFrontend,
+		localAdmin:     localAdmin,
+		remoteAdmin:    remoteAdmin,
+		resender:       resender,
+		hbd:            hbd,
+		limiter:        rate.NewLimiter(rate.Limit(rps), rps),
+		metrics:        metricsClient,
+		logger:         logger.WithTags(tag.WorkflowDomainName(params.Domain), tag.ClusterName(params.RemoteCluster)),
+		gracePeriod:    lagGracePeriod,
+	}
+}
+
+// Run runs the verifier
+func (v *Verifier) Run(ctx context.Context) (VerifierHeartbeatDetails, error) {
+	for v.hbd.Phase < phaseDone {
+		if v.hbd.Phase == phaseRecheckLagging {
+			if err := v.recheckLaggingRuns(ctx); err != nil {
+				return v.hbd, err
+			}
+			v.hbd.Phase++
+			continue
+		}
+
+		executions, nextPageToken, err := v.listExecutions(ctx)
+		if err != nil {
+			return v.hbd, err
+		}
+
+		for _, execution := range executions {
+			if err := v.limiter.Wait(ctx); err != nil {
+				return v.hbd, err
+			}
+			if err := v.verifyExecution(ctx, execution); err != nil {
+				v.metrics.IncCounter(metrics.ReplicationVerifierScope, metrics.ReplicationVerifierErrorCount)
+				v.logger.Error("failed to verify workflow execution",
+					tag.WorkflowID(execution.GetWorkflowId()),
+					tag.WorkflowRunID(execution.GetRunId()),
+					tag.Error(err))
+				v.hbd.ErrorCount++
+				continue
+			}
+			v.metrics.IncCounter(metrics.ReplicationVerifierScope, metrics.ReplicationVerifierVerifiedCount)
+			v.hbd.VerifiedCount++
+		}
+
+		v.hbd.NextPageToken = nextPageToken
+		if len(nextPageToken) == 0 {
+			v.hbd.Phase++
+		}
+		if !v.isInTest {
+			activity.RecordHeartbeat(ctx, v.hbd)
+		}
+	}
+	return v.hbd, nil
+}
+
+func (v *Verifier) listExecutions(
+	ctx context.Context,
+) ([]*shared.WorkflowExecution, []byte, error) {
+
+	client := v.localFrontend
+	if v.hbd.Phase == phaseRemoteOpen || v.hbd.Phase == phaseRemoteClosed {
+		client = v.remoteFrontend
+	}
+	startTimeFilter := &shared.StartTimeFilter{
+		EarliestTime: common.Int64Ptr(0),
+		LatestTime:   common.Int64Ptr(time.Now().UnixNano()),
+	}
+
+	var infos []*shared.WorkflowExecutionInfo
+	var nextPageToken []byte
+	if v.hbd.Phase == phaseRemoteOpen || v.hbd.Phase == phaseLocalOpen {
+		resp, err := client.ListOpenWorkflowExecutions(ctx, &shared.ListOpenWorkflowExecutionsRequest{
+			Domain:          common.StringPtr(v.params.Domain),
+			MaximumPageSize: common.Int32Ptr(pageSize),
+			NextPageToken:   v.hbd.NextPageToken,
+			StartTimeFilter: startTimeFilter,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		infos, nextPageToken = resp.Executions, resp.NextPageToken
+	} else {
+		resp, err := client.ListClosedWorkflowExecutions(ctx, &shared.ListClosedWorkflowExecutionsRequest{
+			Domain:          common.StringPtr(v.params.Domain),
+			MaximumPageSize: common.Int32Ptr(pageSize),
+			NextPageToken:   v.hbd.NextPageToken,
+			StartTimeFilter: startTimeFilter,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		infos, nextPageToken = resp.Executions, resp.NextPageToken
+	}
+
+	executions := make([]*shared.WorkflowExecution, 0, len(infos))
+	for _, info := range infos {
+		executions = append(executions, info.Execution)
+	}
+	return executions, nextPageToken, nil
+}
+
+func (v *Verifier) verifyExecution(
+	ctx context.Context,
+	execution *shared.WorkflowExecution,
+) error {
+
+	remoteHistory, err := v.getVersionHistory(ctx, v.remoteAdmin, execution)
+	if err != nil {
+		return err
+	}
+	localHistory, err := v.getVersionHistory(ctx, v.localAdmin, execution)
+	if err != nil {
+		return err
+	}
+
+	if v.hbd.Phase == phaseLocalOpen || v.hbd.Phase == phaseLocalClosed {
+		// runs existing in both clusters are already compared when walking the remote cluster
+		if localHistory != nil && remoteHistory == nil {
+			localLastItem, err := localHistory.GetLastItem()
+			if err != nil {
+				return err
+			}
+			v.reportMismatch(Mismatch{
+				WorkflowID:            execution.GetWorkflowId(),
+				RunID:                 execution.GetRunId(),
+				Type:                  MismatchTypeMissingRemoteRun,
+				LocalLastEventID:      localLastItem.GetEventID(),
+				LocalLastEventVersion: localLastItem.GetVersion(),
+			})
+		}
+		return nil
+	}
+
+	if remoteHistory == nil {
+		// run is deleted from the remote cluster after being listed
+		return nil
+	}
+	remoteLastItem, err := remoteHistory.GetLastItem()
+	if err != nil {
+		return err
+	}
+	mismatch := Mismatch{
+		WorkflowID:             execution.GetWorkflowId(),
+		RunID:                  execution.GetRunId(),
+		RemoteLastEventID:      remoteLastItem.GetEventID(),
+		RemoteLastEventVersion: remoteLastItem.GetVersion(),
+	}
+
+	if localHistory == nil {
+		mismatch.Type = MismatchTypeMissingLocalRun
+		mismatch.Repaired = v.repair(execution, nil)
+		v.reportMismatch(mismatch)
+		return nil
+	}
+
+	localLastItem, err := localHistory.GetLastItem()
+	if err != nil {
+		return err
+	}
+	if localLastItem.Equals(remoteLastItem) {
+		return nil
+	}
+	mismatch.LocalLastEventID = localLastItem.GetEventID()
+	mismatch.LocalLastEventVersion = localLastItem.GetVersion()
+
+	lcaItem, err := localHistory.FindLCAItem(remoteHistory)
+	if err != nil {
+		return err
+	}
+	switch {
+	case lcaItem.Equals(localLastItem):
+		// local cluster is behind the remote cluster
+		mismatch.Type = MismatchTypeLastEventIDMismatch
+		v.addLaggingRun(LaggingRun{Mismatch: mismatch, LocalBehind: true, DetectedTime: time.Now()})
+	case lcaItem.Equals(remoteLastItem):
+		// remote cluster is behind the local cluster
+		mismatch.Type = MismatchTypeLastEventIDMismatch
+		v.addLaggingRun(LaggingRun{Mismatch: mismatch, LocalBehind: false, DetectedTime: time.Now()})
+	default:
+		mismatch.Type = MismatchTypeDivergedBranch
+		mismatch.Repaired = v.repair(execution, lcaItem)
+		v.reportMismatch(mismatch)
+	}
+	return nil
+}
+
+func (v *Verifier) addLaggingRun(
+	laggingRun LaggingRun,
+) {
+
+	if len(v.hbd.LaggingRuns) >= maxReportedMismatches {
+		// too many runs to recheck, the lag is reported right away
+		v.reportLaggingRun(laggingRun)
+		return
+	}
+	v.hbd.LaggingRuns = append(v.hbd.LaggingRuns, laggingRun)
+}
+
+// recheckLaggingRuns reports the lagging runs which did not catch up within the grace period
+func (v *Verifier) recheckLaggingRuns(
+	ctx context.Context,
+) error {
+
+	for len(v.hbd.LaggingRuns) > 0 {
+		laggingRun := v.hbd.LaggingRuns[0]
+		if wait := time.Until(laggingRun.DetectedTime.Add(v.gracePeriod)); wait > 0 {
+			if !v.isInTest {
+				activity.RecordHeartbeat(ctx, v.hbd)
+			}
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(wait):
+			}
+		}
+		if err := v.limiter.Wait(ctx); err != nil {
+			return err
+		}
+		if err := v.recheckLaggingRun(ctx, laggingRun); err != nil {
+			v.metrics.IncCounter(metrics.ReplicationVerifierScope, metrics.ReplicationVerifierErrorCount)
+			v.logger.Error("failed to recheck lagging workflow execution",
+				tag.WorkflowID(laggingRun.WorkflowID),
+				tag.WorkflowRunID(laggingRun.RunID),
+				tag.Error(err))
+			v.hbd.ErrorCount++
+		}
+		v.hbd.LaggingRuns = v.hbd.LaggingRuns[1:]
+	}
+	return nil
+}
+
+func (v *Verifier) recheckLaggingRun(
+	ctx context.Context,
+	laggingRun LaggingRun,
+) error {
+
+	execution := &shared.WorkflowExecution{
+		WorkflowId: common.StringPtr(laggingRun.WorkflowID),
+		RunId:      common.StringPtr(laggingRun.RunID),
+	}
+	client := v.remoteAdmin
+	aheadItem := p.NewVersionHistoryItem(laggingRun.LocalLastEventID, laggingRun.LocalLastEventVersion)
+	if laggingRun.LocalBehind {
+		client = v.localAdmin
+		aheadItem = p.NewVersionHistoryItem(laggingRun.RemoteLastEventID, laggingRun.RemoteLastEventVersion)
+	}
+
+	history, err := v.getVersionHistory(ctx, client, execution)
+	if err != nil {
+		return err
+	}
+	if history != nil && history.ContainsItem(aheadItem) {
+		// the run caught up within the grace period
+		return nil
+	}
+	v.reportLaggingRun(laggingRun)
+	return nil
+}
+
+func (v *Verifier) reportLaggingRun(
+	laggingRun LaggingRun,
+) {
+
+	mismatch := laggingRun.Mismatch
+	if laggingRun.LocalBehind {
+		mismatch.Repaired = v.repair(
+			&shared.WorkflowExecution{
+				WorkflowId: common.StringPtr(mismatch.WorkflowID),
+				RunId:      common.StringPtr(mismatch.RunID),
+			},
+			p.NewVersionHistoryItem(mismatch.LocalLastEventID, mismatch.LocalLastEventVersion),
+		)
+	}
+	// a remote cluster behind the local cluster can only be repaired by
+	// running the verifier in the remote cluster
+	v.reportMismatch(mismatch)
+}
+
+// getVersionHistory returns the current version history of the execution, or nil if the execution does not exist
+func (v *Verifier) getVersionHistory(
+	ctx context.Context,
+	client adminClient.Client,
+	execution *shared.WorkflowExecution,
+) (*p.VersionHistory, error) {
+
+	resp, err := client.DescribeWorkflowExecution(ctx, &admin.DescribeWorkflowExecutionRequest{
+		Domain:    common.StringPtr(v.params.Domain),
+		Execution: execution,
+	})
+	if err != nil {
+		if _, ok := err.(*shared.EntityNotExistsError); ok {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var mutableState describedMutableState
+	if err := json.Unmarshal([]byte(resp.GetMutableStateInDatabase()), &mutableState); err != nil {
+		return nil, err
+	}
+	if mutableState.VersionHistories == nil {
+		return nil, &shared.InternalServiceError{Message: "workflow execution does not have version histories"}
+	}
+	return mutableState.VersionHistories.GetCurrentVersionHistory()
+}
+
+// repair rereplicates the events after the start item from the remote cluster,
+// the whole history is rereplicated if the start item is nil
+func (v *Verifier) repair(
+	execution *shared.WorkflowExecution,
+	startItem *p.VersionHistoryItem,
+) bool {
+
+	if !v.params.Repair {
+		return false
+	}
+
+	var startEventID, startEventVersion *int64
+	if startItem != nil {
+		startEventID = common.Int64Ptr(startItem.GetEventID())
+		startEventVersion = common.Int64Ptr(startItem.GetVersion())
+	}
+	if err := v.resender.SendSingleWorkflowHistory(
+		v.domainID,
+		execution.GetWorkflowId(),
+		execution.GetRunId(),
+		startEventID,
+		startEventVersion,
+		nil,
+		nil,
+	); err != nil {
+		v.logger.Error("failed to repair workflow execution",
+			tag.WorkflowID(execution.GetWorkflowId()),
+			tag.WorkflowRunID(execution.GetRunId()),
+			tag.Error(err))
+		return false
+	}
+	return true
+}
+
+func (v *Verifier) reportMismatch(
+	mismatch Mismatch,
+) {
+
+	v.metrics.IncCounter(metrics.ReplicationVerifierScope, metrics.ReplicationVerifierMismatchCount)
+	v.hbd.MismatchCount++
+	if mismatch.Repaired {
+		v.metrics.IncCounter(metrics.ReplicationVerifierScope, metrics.ReplicationVerifierRepairedCount)
+		v.hbd.RepairedCount++
+	}
+	if len(v.hbd.Mismatches) < maxReportedMismatches {
+		v.hbd.Mismatches = append(v.hbd.Mismatches, mismatch)
+	}
+	v.logger.Warn("replication mismatch",
+		tag.WorkflowID(mismatch.WorkflowID),
+		tag.WorkflowRunID(mismatch.RunID),
+		tag.Value(mismatch.Type),
+		tag.Bool(mismatch.Repaired))
+}
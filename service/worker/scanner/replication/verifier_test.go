@@ -0,0 +1,260 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package replication
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+
+	"github.com/uber/cadence/.gen/go/admin"
+	"github.com/uber/cadence/.gen/go/admin/adminservicetest"
+	"github.com/uber/cadence/.gen/go/cadence/workflowservicetest"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/xdc"
+)
+
+type (
+	verifierSuite struct {
+		suite.Suite
+		controller     *gomock.Controller
+		localFrontend  *workflowservicetest.MockClient
+		remoteFrontend *workflowservicetest.MockClient
+		localAdmin     *adminservicetest.MockClient
+		remoteAdmin    *adminservicetest.MockClient
+		resender       *xdc.MockNDCHistoryResender
+	}
+)
+
+const (
+	testDomain   = "test-domain"
+	testDomainID = "test-domain-id"
+)
+
+func TestVerifierSuite(t *testing.T) {
+	suite.Run(t, new(verifierSuite))
+}
+
+func (s *verifierSuite) SetupTest() {
+	s.controller = gomock.NewController(s.T())
+	s.localFrontend = workflowservicetest.NewMockClient(s.controller)
+	s.remoteFrontend = workflowservicetest.NewMockClient(s.controller)
+	s.localAdmin = adminservicetest.NewMockClient(s.controller)
+	s.remoteAdmin = adminservicetest.NewMockClient(s.controller)
+	s.resender = xdc.NewMockNDCHistoryResender(s.controller)
+}
+
+func (s *verifierSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *verifierSuite) newVerifier(repair bool) *Verifier {
+	verifier := NewVerifier(
+		VerifierParams{Domain: testDomain, RemoteCluster: "remote", Repair: repair},
+		testDomainID,
+		s.localFrontend,
+		s.remoteFrontend,
+		s.localAdmin,
+		s.remoteAdmin,
+		s.resender,
+		1000,
+		VerifierHeartbeatDetails{},
+		metrics.NewClient(tally.NoopScope, metrics.Worker),
+		loggerimpl.NewNopLogger(),
+	)
+	verifier.gracePeriod = 0
+	verifier.isInTest = true
+	return verifier
+}
+
+func (s *verifierSuite) TestRun_Mismatches() {
+	consistent := s.execution("consistent")
+	missingLocal := s.execution("missing-local")
+	lagging := s.execution("lagging")
+	ahead := s.execution("ahead")
+	diverged := s.execution("diverged")
+	missingRemote := s.execution("missing-remote")
+
+	s.expectList(s.remoteFrontend, true, consistent, missingLocal, lagging)
+	s.expectList(s.remoteFrontend, false, ahead, diverged)
+	s.expectList(s.localFrontend, true, consistent, lagging, missingRemote)
+	s.expectList(s.localFrontend, false, ahead, diverged)
+
+	s.expectVersionHistory(s.remoteAdmin, consistent, item(10, 1)).Times(2)
+	s.expectVersionHistory(s.localAdmin, consistent, item(10, 1)).Times(2)
+	s.expectVersionHistory(s.remoteAdmin, missingLocal, item(5, 1))
+	s.expectVersionHistory(s.localAdmin, missingLocal)
+	// lagging runs are rechecked in the cluster which is behind
+	s.expectVersionHistory(s.remoteAdmin, lagging, item(3, 1), item(8, 2)).Times(2)
+	s.expectVersionHistory(s.localAdmin, lagging, item(3, 1)).Times(3)
+	s.expectVersionHistory(s.remoteAdmin, ahead, item(3, 1)).Times(3)
+	s.expectVersionHistory(s.localAdmin, ahead, item(3, 1), item(8, 2)).Times(2)
+	s.expectVersionHistory(s.remoteAdmin, diverged, item(3, 1), item(8, 2)).Times(2)
+	s.expectVersionHistory(s.localAdmin, diverged, item(3, 1), item(6, 3)).Times(2)
+	s.expectVersionHistory(s.remoteAdmin, missingRemote)
+	s.expectVersionHistory(s.localAdmin, missingRemote, item(4, 1))
+
+	s.resender.EXPECT().SendSingleWorkflowHistory(testDomainID, "missing-local", "missing-local-run", nil, nil, nil, nil).Return(nil)
+	s.resender.EXPECT().SendSingleWorkflowHistory(
+		testDomainID, "lagging", "lagging-run", common.Int64Ptr(3), common.Int64Ptr(1), nil, nil,
+	).Return(nil)
+	s.resender.EXPECT().SendSingleWorkflowHistory(
+		testDomainID, "diverged", "diverged-run", common.Int64Ptr(3), common.Int64Ptr(1), nil, nil,
+	).Return(&shared.InternalServiceError{})
+
+	result, err := s.newVerifier(true).Run(context.Background())
+	s.NoError(err)
+	s.Equal(phaseDone, result.Phase)
+	s.Equal(10, result.VerifiedCount)
+	s.Equal(0, result.ErrorCount)
+	s.Equal(5, result.MismatchCount)
+	s.Equal(2, result.RepairedCount)
+	s.Empty(result.LaggingRuns)
+	s.Equal([]Mismatch{
+		{WorkflowID: "missing-local", RunID: "missing-local-run", Type: MismatchTypeMissingLocalRun,
+			RemoteLastEventID: 5, RemoteLastEventVersion: 1, Repaired: true},
+		{WorkflowID: "diverged", RunID: "diverged-run", Type: MismatchTypeDivergedBranch,
+			LocalLastEventID: 6, LocalLastEventVersion: 3, RemoteLastEventID: 8, RemoteLastEventVersion: 2},
+		{WorkflowID: "lagging", RunID: "lagging-run", Type: MismatchTypeLastEventIDMismatch,
+			LocalLastEventID: 3, LocalLastEventVersion: 1, RemoteLastEventID: 8, RemoteLastEventVersion: 2, Repaired: true},
+		{WorkflowID: "ahead", RunID: "ahead-run", Type: MismatchTypeLastEventIDMismatch,
+			LocalLastEventID: 8, LocalLastEventVersion: 2, RemoteLastEventID: 3, RemoteLastEventVersion: 1},
+		{WorkflowID: "missing-remote", RunID: "missing-remote-run", Type: MismatchTypeMissingRemoteRun,
+			LocalLastEventID: 4, LocalLastEventVersion: 1},
+	}, result.Mismatches)
+}
+
+func (s *verifierSuite) TestRun_LaggingRunCatchesUp() {
+	lagging := s.execution("lagging")
+	s.expectList(s.remoteFrontend, true, lagging)
+	s.expectList(s.remoteFrontend, false)
+	s.expectList(s.localFrontend, true)
+	s.expectList(s.localFrontend, false)
+	s.expectVersionHistory(s.remoteAdmin, lagging, item(3, 1), item(8, 2))
+	gomock.InOrder(
+		s.expectVersionHistory(s.localAdmin, lagging, item(3, 1)),
+		// replication caught up within the grace period, and the run kept making progress
+		s.expectVersionHistory(s.localAdmin, lagging, item(3, 1), item(9, 2)),
+	)
+
+	result, err := s.newVerifier(true).Run(context.Background())
+	s.NoError(err)
+	s.Equal(phaseDone, result.Phase)
+	s.Equal(1, result.VerifiedCount)
+	s.Equal(0, result.MismatchCount)
+	s.Empty(result.LaggingRuns)
+}
+
+func (s *verifierSuite) TestRun_NoRepair() {
+	missingLocal := s.execution("missing-local")
+	s.expectList(s.remoteFrontend, true, missingLocal)
+	s.expectList(s.remoteFrontend, false)
+	s.expectList(s.localFrontend, true)
+	s.expectList(s.localFrontend, false)
+	s.expectVersionHistory(s.remoteAdmin, missingLocal, item(5, 1))
+	s.expectVersionHistory(s.localAdmin, missingLocal)
+
+	result, err := s.newVerifier(false).Run(context.Background())
+	s.NoError(err)
+	s.Equal(1, result.MismatchCount)
+	s.Equal(0, result.RepairedCount)
+	s.False(result.Mismatches[0].Repaired)
+}
+
+func (s *verifierSuite) TestRun_VerifyError() {
+	execution := s.execution("error")
+	s.expectList(s.remoteFrontend, true, execution)
+	s.expectList(s.remoteFrontend, false)
+	s.expectList(s.localFrontend, true)
+	s.expectList(s.localFrontend, false)
+	s.remoteAdmin.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).
+		Return(nil, &shared.InternalServiceError{})
+
+	result, err := s.newVerifier(false).Run(context.Background())
+	s.NoError(err)
+	s.Equal(0, result.VerifiedCount)
+	s.Equal(1, result.ErrorCount)
+}
+
+func (s *verifierSuite) execution(workflowID string) *shared.WorkflowExecution {
+	return &shared.WorkflowExecution{
+		WorkflowId: common.StringPtr(workflowID),
+		RunId:      common.StringPtr(workflowID + "-run"),
+	}
+}
+
+func (s *verifierSuite) expectList(
+	client *workflowservicetest.MockClient,
+	open bool,
+	executions ...*shared.WorkflowExecution,
+) {
+
+	var infos []*shared.WorkflowExecutionInfo
+	for _, execution := range executions {
+		infos = append(infos, &shared.WorkflowExecutionInfo{Execution: execution})
+	}
+	if open {
+		client.EXPECT().ListOpenWorkflowExecutions(gomock.Any(), gomock.Any()).
+			Return(&shared.ListOpenWorkflowExecutionsResponse{Executions: infos}, nil)
+	} else {
+		client.EXPECT().ListClosedWorkflowExecutions(gomock.Any(), gomock.Any()).
+			Return(&shared.ListClosedWorkflowExecutionsResponse{Executions: infos}, nil)
+	}
+}
+
+func (s *verifierSuite) expectVersionHistory(
+	client *adminservicetest.MockClient,
+	execution *shared.WorkflowExecution,
+	items ...*shared.VersionHistoryItem,
+) *gomock.Call {
+
+	call := client.EXPECT().DescribeWorkflowExecution(gomock.Any(), &admin.DescribeWorkflowExecutionRequest{
+		Domain:    common.StringPtr(testDomain),
+		Execution: execution,
+	})
+	if len(items) == 0 {
+		return call.Return(nil, &shared.EntityNotExistsError{})
+	}
+	versionHistory := persistence.NewVersionHistoryFromThrift(&shared.VersionHistory{Items: items})
+	mutableState, err := json.Marshal(&persistence.WorkflowMutableState{
+		ExecutionInfo:    &persistence.WorkflowExecutionInfo{WorkflowID: execution.GetWorkflowId()},
+		VersionHistories: persistence.NewVersionHistories(versionHistory),
+	})
+	s.NoError(err)
+	return call.Return(&admin.DescribeWorkflowExecutionResponse{
+		MutableStateInDatabase: common.StringPtr(string(mutableState)),
+	}, nil)
+}
+
+func item(eventID int64, version int64) *shared.VersionHistoryItem {
+	return &shared.VersionHistoryItem{
+		EventID: common.Int64Ptr(eventID),
+		Version: common.Int64Ptr(version),
+	}
+}
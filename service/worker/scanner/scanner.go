@@ -126,6 +126,14 @@ func (s *Scanner) Start() error {
 		workerTaskListName = historyScannerTaskListName
 	}
 
+	if s.context.GetClusterMetadata().IsGlobalDomainEnabled() {
+		// replication verifier is started on demand, its worker polls a dedicated task list
+		err := worker.New(s.context.GetSDKClient(), common.SystemLocalDomainName, ReplicationVerifierTaskListName, workerOpts).Start()
+		if err != nil {
+			return err
+		}
+	}
+
 	return worker.New(s.context.GetSDKClient(), common.SystemLocalDomainName, workerTaskListName, workerOpts).Start()
 }
 
@@ -29,8 +29,12 @@ import (
 	cclient "go.uber.org/cadence/client"
 	"go.uber.org/cadence/workflow"
 
+	h "github.com/uber/cadence/.gen/go/history"
+	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/xdc"
 	"github.com/uber/cadence/service/worker/scanner/history"
+	"github.com/uber/cadence/service/worker/scanner/replication"
 	"github.com/uber/cadence/service/worker/scanner/tasklist"
 )
 
@@ -54,6 +58,23 @@ const (
 	historyScannerWFTypeName     = "cadence-sys-history-scanner-workflow"
 	historyScannerTaskListName   = "cadence-sys-history-scanner-tasklist-0"
 	historyScavengerActivityName = "cadence-sys-history-scanner-scvg-activity"
+
+	// ReplicationVerifierWFIDPrefix is the prefix of the replication verifier workflow ID, followed by the domain name
+	ReplicationVerifierWFIDPrefix = "cadence-sys-replication-verifier-"
+	// ReplicationVerifierWFTypeName is the workflow type of the replication verifier workflow
+	ReplicationVerifierWFTypeName = "cadence-sys-replication-verifier-workflow"
+	// ReplicationVerifierTaskListName is the task list of the replication verifier workflow
+	ReplicationVerifierTaskListName = "cadence-sys-replication-verifier-tasklist-0"
+	// ReplicationVerifierWFTimeout is the timeout of the replication verifier workflow
+	ReplicationVerifierWFTimeout = 5 * 24 * time.Hour
+
+	replicationVerifierActivityName = "cadence-sys-replication-verifier-activity"
+)
+
+const (
+	errInvalidRemoteCluster = "invalid remote cluster"
+	errDomainNotExists      = "domain does not exist"
+	errNotGlobalDomain      = "domain is not a global domain"
 )
 
 var (
@@ -71,6 +92,18 @@ var (
 		HeartbeatTimeout:       5 * time.Minute,
 		RetryPolicy:            &activityRetryPolicy,
 	}
+	replicationVerifierActivityOptions = workflow.ActivityOptions{
+		ScheduleToStartTimeout: 5 * time.Minute,
+		StartToCloseTimeout:    ReplicationVerifierWFTimeout,
+		HeartbeatTimeout:       5 * time.Minute,
+		RetryPolicy: &cadence.RetryPolicy{
+			InitialInterval:          10 * time.Second,
+			BackoffCoefficient:       1.7,
+			MaximumInterval:          5 * time.Minute,
+			ExpirationInterval:       ReplicationVerifierWFTimeout,
+			NonRetriableErrorReasons: []string{errInvalidRemoteCluster, errDomainNotExists, errNotGlobalDomain},
+		},
+	}
 	tlScannerWFStartOptions = cclient.StartWorkflowOptions{
 		ID:                           tlScannerWFID,
 		TaskList:                     tlScannerTaskListName,
@@ -92,6 +125,8 @@ func init() {
 	workflow.RegisterWithOptions(HistoryScannerWorkflow, workflow.RegisterOptions{Name: historyScannerWFTypeName})
 	activity.RegisterWithOptions(TaskListScavengerActivity, activity.RegisterOptions{Name: taskListScavengerActivityName})
 	activity.RegisterWithOptions(HistoryScavengerActivity, activity.RegisterOptions{Name: historyScavengerActivityName})
+	workflow.RegisterWithOptions(ReplicationVerifierWorkflow, workflow.RegisterOptions{Name: ReplicationVerifierWFTypeName})
+	activity.RegisterWithOptions(ReplicationVerifierActivity, activity.RegisterOptions{Name: replicationVerifierActivityName})
 }
 
 // TaskListScannerWorkflow is the workflow that runs the task-list scanner background daemon
@@ -141,6 +176,80 @@ func HistoryScavengerActivity(
 	return scavenger.Run(activityCtx)
 }
 
+// ReplicationVerifierWorkflow is the workflow that compares the executions of a global domain
+// between the current cluster and a remote cluster
+func ReplicationVerifierWorkflow(
+	ctx workflow.Context,
+	params replication.VerifierParams,
+) (replication.VerifierHeartbeatDetails, error) {
+
+	var result replication.VerifierHeartbeatDetails
+	future := workflow.ExecuteActivity(
+		workflow.WithActivityOptions(ctx, replicationVerifierActivityOptions),
+		replicationVerifierActivityName,
+		params,
+	)
+	err := future.Get(ctx, &result)
+	return result, err
+}
+
+// ReplicationVerifierActivity is the activity that runs replication verifier
+func ReplicationVerifierActivity(
+	activityCtx context.Context,
+	params replication.VerifierParams,
+) (replication.VerifierHeartbeatDetails, error) {
+
+	ctx := activityCtx.Value(scannerContextKey).(scannerContext)
+	clusterMetadata := ctx.GetClusterMetadata()
+	if _, ok := clusterMetadata.GetAllClusterInfo()[params.RemoteCluster]; !ok ||
+		params.RemoteCluster == clusterMetadata.GetCurrentClusterName() {
+		return replication.VerifierHeartbeatDetails{}, cadence.NewCustomError(errInvalidRemoteCluster)
+	}
+	domainEntry, err := ctx.GetDomainCache().GetDomain(params.Domain)
+	if err != nil {
+		if _, ok := err.(*shared.EntityNotExistsError); ok {
+			return replication.VerifierHeartbeatDetails{}, cadence.NewCustomError(errDomainNotExists)
+		}
+		return replication.VerifierHeartbeatDetails{}, err
+	}
+	if !domainEntry.IsGlobalDomain() {
+		return replication.VerifierHeartbeatDetails{}, cadence.NewCustomError(errNotGlobalDomain)
+	}
+
+	hbd := replication.VerifierHeartbeatDetails{}
+	if activity.HasHeartbeatDetails(activityCtx) {
+		if err := activity.GetHeartbeatDetails(activityCtx, &hbd); err != nil {
+			ctx.GetLogger().Error("Failed to recover from last heartbeat, start over from beginning", tag.Error(err))
+		}
+	}
+
+	remoteAdmin := ctx.GetRemoteAdminClient(params.RemoteCluster)
+	historyClient := ctx.GetHistoryClient()
+	resender := xdc.NewNDCHistoryResender(
+		ctx.GetDomainCache(),
+		remoteAdmin,
+		func(ctx context.Context, request *h.ReplicateEventsV2Request) error {
+			return historyClient.ReplicateEventsV2(ctx, request)
+		},
+		ctx.GetPayloadSerializer(),
+		ctx.GetLogger(),
+	)
+	verifier := replication.NewVerifier(
+		params,
+		domainEntry.GetInfo().ID,
+		ctx.GetFrontendClient(),
+		ctx.GetRemoteFrontendClient(params.RemoteCluster),
+		ctx.GetRemoteAdminClient(clusterMetadata.GetCurrentClusterName()),
+		remoteAdmin,
+		resender,
+		ctx.cfg.PersistenceMaxQPS(),
+		hbd,
+		ctx.GetMetricsClient(),
+		ctx.GetLogger(),
+	)
+	return verifier.Run(activityCtx)
+}
+
 // TaskListScavengerActivity is the activity that runs task list scavenger
 func TaskListScavengerActivity(
 	activityCtx context.Context,
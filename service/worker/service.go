@@ -36,10 +36,13 @@ import (
 	"github.com/uber/cadence/common/service/dynamicconfig"
 	"github.com/uber/cadence/service/worker/archiver"
 	"github.com/uber/cadence/service/worker/batcher"
+	"github.com/uber/cadence/service/worker/failovermanager"
 	"github.com/uber/cadence/service/worker/indexer"
 	"github.com/uber/cadence/service/worker/parentclosepolicy"
 	"github.com/uber/cadence/service/worker/replicator"
 	"github.com/uber/cadence/service/worker/scanner"
+	"github.com/uber/cadence/service/worker/visibilityexport"
+	"github.com/uber/cadence/service/worker/visibilitymigration"
 )
 
 type (
@@ -63,9 +66,15 @@ type (
 		IndexerCfg                    *indexer.Config
 		ScannerCfg                    *scanner.Config
 		BatcherCfg                    *batcher.Config
+		FailoverManagerCfg            *failovermanager.Config
+		VisibilityMigrationCfg        *visibilitymigration.Config
+		VisibilityExportCfg           *visibilityexport.Config
 		ThrottledLogRPS               dynamicconfig.IntPropertyFn
 		EnableBatcher                 dynamicconfig.BoolPropertyFn
 		EnableParentClosePolicyWorker dynamicconfig.BoolPropertyFn
+		EnableFailoverManager         dynamicconfig.BoolPropertyFn
+		EnableVisibilityMigration     dynamicconfig.BoolPropertyFn
+		EnableVisibilityExport        dynamicconfig.BoolPropertyFn
 	}
 )
 
@@ -129,8 +138,19 @@ func NewConfig(params *service.BootstrapParams) *Config {
 			AdminOperationToken: dc.GetStringProperty(dynamicconfig.AdminOperationToken, common.DefaultAdminOperationToken),
 			ClusterMetadata:     params.ClusterMetadata,
 		},
+		FailoverManagerCfg: &failovermanager.Config{
+			ClusterMetadata: params.ClusterMetadata,
+		},
+		VisibilityExportCfg: &visibilityexport.Config{
+			MaxQPS:           dc.GetIntProperty(dynamicconfig.WorkerVisibilityExportMaxQPS, 10),
+			EnabledForDomain: dc.GetBoolPropertyFnWithDomainFilter(dynamicconfig.WorkerVisibilityExportEnabledForDomain, false),
+			BaseDir:          params.VisibilityExportConfig.BaseDir,
+		},
 		EnableBatcher:                 dc.GetBoolProperty(dynamicconfig.EnableBatcher, false),
 		EnableParentClosePolicyWorker: dc.GetBoolProperty(dynamicconfig.EnableParentClosePolicyWorker, true),
+		EnableFailoverManager:         dc.GetBoolProperty(dynamicconfig.EnableFailoverManager, true),
+		EnableVisibilityMigration:     dc.GetBoolProperty(dynamicconfig.EnableVisibilityMigration, true),
+		EnableVisibilityExport:        dc.GetBoolProperty(dynamicconfig.EnableVisibilityExport, false),
 		ThrottledLogRPS:               dc.GetIntProperty(dynamicconfig.WorkerThrottledLogRPS, 20),
 	}
 	advancedVisWritingMode := dc.GetStringProperty(
@@ -145,6 +165,12 @@ func NewConfig(params *service.BootstrapParams) *Config {
 			ESProcessorBulkSize:      dc.GetIntProperty(dynamicconfig.WorkerESProcessorBulkSize, 2<<24), // 16MB
 			ESProcessorFlushInterval: dc.GetDurationProperty(dynamicconfig.WorkerESProcessorFlushInterval, 1*time.Second),
 			ValidSearchAttributes:    dc.GetMapProperty(dynamicconfig.ValidSearchAttributes, definition.GetDefaultIndexedKeys()),
+			HistoryIndexerMaxQPS:     dc.GetIntProperty(dynamicconfig.WorkerHistoryIndexerMaxQPS, 100),
+		}
+	}
+	if params.PersistenceConfig.IsVisibilityMigrationConfigExist() {
+		config.VisibilityMigrationCfg = &visibilitymigration.Config{
+			MaxQPS: dc.GetIntProperty(dynamicconfig.WorkerVisibilityMigrationMaxQPS, 100),
 		}
 	}
 	return config
@@ -166,6 +192,9 @@ func (s *Service) Start() {
 
 	if s.GetClusterMetadata().IsGlobalDomainEnabled() {
 		s.startReplicator()
+		if s.config.EnableFailoverManager() {
+			s.startFailoverManager()
+		}
 	}
 	if s.GetArchivalMetadata().GetHistoryConfig().ClusterConfiguredForArchival() {
 		s.startArchiver()
@@ -176,6 +205,12 @@ func (s *Service) Start() {
 	if s.config.EnableParentClosePolicyWorker() {
 		s.startParentClosePolicyProcessor()
 	}
+	if s.config.VisibilityMigrationCfg != nil && s.config.EnableVisibilityMigration() {
+		s.startVisibilityMigrator()
+	}
+	if s.config.EnableVisibilityExport() {
+		s.startVisibilityExporter()
+	}
 
 	logger.Info("service started", tag.ComponentWorker)
 	<-s.stopC
@@ -216,6 +251,65 @@ func (s *Service) startBatcher() {
 	}
 }
 
+func (s *Service) startFailoverManager() {
+	params := &failovermanager.BootstrapParams{
+		Config:        *s.config.FailoverManagerCfg,
+		ServiceClient: s.params.PublicClient,
+		MetricsClient: s.GetMetricsClient(),
+		Logger:        s.GetLogger(),
+		TallyScope:    s.params.MetricScope,
+		ClientBean:    s.GetClientBean(),
+	}
+	if err := failovermanager.New(params).Start(); err != nil {
+		s.GetLogger().Fatal("error starting failover manager", tag.Error(err))
+	}
+}
+
+func (s *Service) startVisibilityMigrator() {
+	pFactory := persistenceClient.NewFactory(
+		&s.params.PersistenceConfig,
+		s.GetClusterMetadata().GetCurrentClusterName(),
+		s.GetMetricsClient(),
+		s.GetLogger(),
+	)
+	sourceManager, targetManager, err := pFactory.NewVisibilityMigrationManagers()
+	if err != nil {
+		s.GetLogger().Fatal("error creating visibility managers for visibility migrator", tag.Error(err))
+	}
+	repairQueue, err := pFactory.NewQueue(common.VisibilityMigrationRepairQueueType)
+	if err != nil {
+		s.GetLogger().Fatal("error creating repair queue for visibility migrator", tag.Error(err))
+	}
+	params := &visibilitymigration.BootstrapParams{
+		Config:        *s.config.VisibilityMigrationCfg,
+		ServiceClient: s.params.PublicClient,
+		MetricsClient: s.GetMetricsClient(),
+		Logger:        s.GetLogger(),
+		TallyScope:    s.params.MetricScope,
+		DomainCache:   s.GetDomainCache(),
+		SourceManager: sourceManager,
+		TargetManager: targetManager,
+		RepairQueue:   repairQueue,
+	}
+	if err := visibilitymigration.New(params).Start(); err != nil {
+		s.GetLogger().Fatal("error starting visibility migrator", tag.Error(err))
+	}
+}
+
+func (s *Service) startVisibilityExporter() {
+	params := &visibilityexport.BootstrapParams{
+		Config:        *s.config.VisibilityExportCfg,
+		ServiceClient: s.params.PublicClient,
+		MetricsClient: s.GetMetricsClient(),
+		Logger:        s.GetLogger(),
+		TallyScope:    s.params.MetricScope,
+		ClientBean:    s.GetClientBean(),
+	}
+	if err := visibilityexport.New(params).Start(); err != nil {
+		s.GetLogger().Fatal("error starting visibility exporter", tag.Error(err))
+	}
+}
+
 func (s *Service) startScanner() {
 	params := &scanner.BootstrapParams{
 		Config:     *s.config.ScannerCfg,
@@ -251,11 +345,23 @@ func (s *Service) startReplicator() {
 }
 
 func (s *Service) startIndexer() {
+	hostInfo, err := s.GetHostInfo()
+	if err != nil {
+		s.GetLogger().Fatal("failed to get host info", tag.Error(err))
+	}
+	// partitions of a queue based messaging client are shared between the worker hosts
+	messagingClient := persistence.WithQueuePartitionOwner(s.GetMessagingClient(), func(partitionKey string) bool {
+		owner, err := s.GetWorkerServiceResolver().Lookup(partitionKey)
+		return err == nil && owner.Identity() == hostInfo.Identity()
+	})
+
 	visibilityIndexer := indexer.NewIndexer(
 		s.config.IndexerCfg,
-		s.GetMessagingClient(),
+		messagingClient,
 		s.params.ESClient,
 		s.params.ESConfig,
+		s.GetClientBean().GetFrontendClient(),
+		s.GetDomainCache(),
 		s.GetLogger(),
 		s.GetMetricsClient(),
 	)
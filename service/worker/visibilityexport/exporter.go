@@ -0,0 +1,109 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibilityexport
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/uber-go/tally"
+	"go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
+	"go.uber.org/cadence/worker"
+
+	"github.com/uber/cadence/client"
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/quotas"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	// Config defines the configuration for visibility exporter
+	Config struct {
+		// MaxQPS is the max rate of visibility scan requests made by the export
+		MaxQPS dynamicconfig.IntPropertyFn
+		// EnabledForDomain decides whether or not the visibility records of the domain can be exported
+		EnabledForDomain dynamicconfig.BoolPropertyFnWithDomainFilter
+		// BaseDir is the directory which the exported files are written to, export is rejected if empty
+		BaseDir string
+	}
+
+	// BootstrapParams contains the set of params needed to bootstrap
+	// the visibility exporter sub-system
+	BootstrapParams struct {
+		// Config contains the configuration for visibility exporter
+		Config Config
+		// ServiceClient is an instance of cadence service client
+		ServiceClient workflowserviceclient.Interface
+		// MetricsClient is an instance of metrics object for emitting stats
+		MetricsClient metrics.Client
+		Logger        log.Logger
+		// TallyScope is an instance of tally metrics scope
+		TallyScope tally.Scope
+		// ClientBean is an instance of client.Bean for a collection of clients
+		ClientBean client.Bean
+	}
+
+	// Exporter is the background sub-system that executes the visibility export workflows
+	// It is also the context object that gets passed around within the export workflows / activities
+	Exporter struct {
+		cfg            Config
+		svcClient      workflowserviceclient.Interface
+		frontendClient frontend.Client
+		rateLimiter    quotas.Limiter
+		metricsClient  metrics.Client
+		tallyScope     tally.Scope
+		logger         log.Logger
+	}
+)
+
+// New returns a new instance of visibility exporter
+func New(params *BootstrapParams) *Exporter {
+	cfg := params.Config
+	return &Exporter{
+		cfg:            cfg,
+		svcClient:      params.ServiceClient,
+		frontendClient: params.ClientBean.GetFrontendClient(),
+		rateLimiter: quotas.NewDynamicRateLimiter(func() float64 {
+			return float64(cfg.MaxQPS())
+		}),
+		metricsClient: params.MetricsClient,
+		tallyScope:    params.TallyScope,
+		logger:        params.Logger.WithTags(tag.ComponentVisibilityExport),
+	}
+}
+
+// Start starts the worker for the visibility export workflows
+func (e *Exporter) Start() error {
+	ctx := context.WithValue(context.Background(), exporterContextKey, e)
+	workerOpts := worker.Options{
+		MetricsScope:              e.tallyScope,
+		BackgroundActivityContext: ctx,
+		Tracer:                    opentracing.GlobalTracer(),
+		// the activities of an export run in a session, so that the file is written on one host
+		EnableSessionWorker: true,
+	}
+	exportWorker := worker.New(e.svcClient, common.SystemLocalDomainName, TaskListName, workerOpts)
+	return exportWorker.Start()
+}
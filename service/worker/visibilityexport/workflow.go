@@ -0,0 +1,341 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibilityexport
+
+import (
+	"context"
+	"errors"
+	"os"
+	"time"
+
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/workflow"
+
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+)
+
+const (
+	exporterContextKey = "visibilityExporterContext"
+	// TaskListName is the tasklist name
+	TaskListName = "cadence-sys-visibilityExport-tasklist"
+	// WorkflowTypeName is the workflow type
+	WorkflowTypeName = "cadence-sys-visibilityExport-workflow"
+	// QueryType is the query type of the export workflow progress
+	QueryType = "state"
+	// WorkflowTimeout is the timeout of the export workflow
+	WorkflowTimeout = 7 * 24 * time.Hour
+
+	exportActivityName = "cadence-sys-exportVisibilityActivity"
+
+	// DefaultPageSize is the default number of visibility records scanned in one page
+	DefaultPageSize = 1000
+	// pagesPerActivity is the number of pages exported by one activity,
+	// the progress of the workflow is updated after each activity
+	pagesPerActivity = 100
+)
+
+const (
+	// WorkflowRunning is the state of a running export workflow
+	WorkflowRunning = "running"
+	// WorkflowCompleted is the state of a completed export workflow
+	WorkflowCompleted = "completed"
+)
+
+type (
+	// ExportParams is the parameters for the export workflow
+	ExportParams struct {
+		// Domain is the name of the domain whose visibility records are exported
+		Domain string
+		// Query is the visibility query of the exported records, all records of the domain are exported if empty
+		Query string
+		// URI is the destination of the export, e.g. file:///tmp/export.json, only file:// is supported.
+		// The file must be in the export directory of the worker hosts and must not exist
+		URI string
+		// Format is the format of the exported records, FormatJSON or FormatCSV. Default to FormatJSON
+		Format string
+		// PageSize is the number of records scanned in one page. Default to DefaultPageSize
+		PageSize int
+	}
+
+	// ExportResult is the result of the export workflow
+	ExportResult struct {
+		// Host is the worker host which the destination file is written on
+		Host              string
+		ExportedWorkflows int
+		// TotalEstimate is the count of the workflows matching the query when the export starts
+		TotalEstimate int64
+	}
+
+	// QueryResult is the progress of the export workflow
+	QueryResult struct {
+		State string
+		ExportResult
+	}
+
+	// ExportActivityParams is the parameters for the export activity
+	ExportActivityParams struct {
+		ExportParams
+		Progress ExportProgress
+	}
+
+	// ExportProgress is the progress of the export, it is recorded in the heartbeat details of the export activity
+	// and returned by the activity, so that the export is resumed from the last flushed page
+	ExportProgress struct {
+		Started       bool
+		Completed     bool
+		NextPageToken []byte
+		// Size is the size of the destination after the last flushed page
+		Size int64
+		ExportResult
+	}
+)
+
+const (
+	errDomainNotAllowed    = "visibility export is not enabled for the domain"
+	errInvalidDestination  = "invalid export destination"
+	errDestinationNotFound = "exported records are not found in the export destination"
+	errDestinationConflict = "export destination already exists"
+)
+
+var (
+	errDomainNotSet = errors.New("domain is not set")
+
+	activityRetryPolicy = cadence.RetryPolicy{
+		InitialInterval:          time.Second,
+		BackoffCoefficient:       2,
+		MaximumInterval:          time.Minute,
+		ExpirationInterval:       WorkflowTimeout,
+		NonRetriableErrorReasons: []string{errDomainNotAllowed, errInvalidDestination, errDestinationNotFound, errDestinationConflict},
+	}
+
+	activityOptions = workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    time.Hour,
+		HeartbeatTimeout:       time.Minute,
+		RetryPolicy:            &activityRetryPolicy,
+	}
+
+	sessionOptions = workflow.SessionOptions{
+		CreationTimeout:  time.Minute,
+		ExecutionTimeout: WorkflowTimeout,
+	}
+)
+
+func init() {
+	workflow.RegisterWithOptions(ExportWorkflow, workflow.RegisterOptions{Name: WorkflowTypeName})
+	activity.RegisterWithOptions(ExportActivity, activity.RegisterOptions{Name: exportActivityName})
+}
+
+// ExportWorkflow writes the visibility records matching the query to the destination URI.
+// The records are scanned page by page and exported by a sequence of activities,
+// each of which continues from the progress returned by the previous one.
+// The activities run in a session, so that the file is written and resumed on one worker host,
+// which is reported in the progress. The export restarts from the beginning on another host if
+// the host of the session is lost. Only file:// destinations in JSON or CSV format are supported.
+func ExportWorkflow(ctx workflow.Context, params ExportParams) (ExportResult, error) {
+	params = setDefaultParams(params)
+	if err := validateParams(params); err != nil {
+		return ExportResult{}, err
+	}
+
+	state := &QueryResult{
+		State: WorkflowRunning,
+	}
+	if err := workflow.SetQueryHandler(ctx, QueryType, func() (QueryResult, error) {
+		return *state, nil
+	}); err != nil {
+		return ExportResult{}, err
+	}
+
+	ctx = workflow.WithActivityOptions(ctx, activityOptions)
+	for {
+		sessionFailed, err := exportInSession(ctx, params, state)
+		if err != nil {
+			return state.ExportResult, err
+		}
+		if !sessionFailed {
+			break
+		}
+		workflow.GetLogger(ctx).Warn("Visibility export session failed, restarting the export on another host")
+		state.ExportResult = ExportResult{}
+	}
+
+	state.State = WorkflowCompleted
+	return state.ExportResult, nil
+}
+
+// exportInSession runs the export activities in a session from the beginning,
+// and returns true if the session failed before the export is completed
+func exportInSession(ctx workflow.Context, params ExportParams, state *QueryResult) (bool, error) {
+	sessionCtx, err := workflow.CreateSession(ctx, &sessionOptions)
+	if err != nil {
+		return false, err
+	}
+	defer workflow.CompleteSession(sessionCtx)
+
+	var progress ExportProgress
+	for !progress.Completed {
+		var result ExportProgress
+		if err := workflow.ExecuteActivity(sessionCtx, exportActivityName, ExportActivityParams{
+			ExportParams: params,
+			Progress:     progress,
+		}).Get(sessionCtx, &result); err != nil {
+			// the session context is canceled if the worker of the session is lost
+			if ctx.Err() == nil && (err == workflow.ErrSessionFailed || sessionCtx.Err() != nil) {
+				return true, nil
+			}
+			return false, err
+		}
+		progress = result
+		state.ExportResult = progress.ExportResult
+	}
+	return false, nil
+}
+
+// ExportActivity scans the visibility records from the given progress, and writes them to the destination.
+// It returns the progress after at most pagesPerActivity pages, the progress is also recorded in heartbeat
+// after each page so that retries resume from the last flushed page.
+func ExportActivity(ctx context.Context, params ExportActivityParams) (ExportProgress, error) {
+	exporter := ctx.Value(exporterContextKey).(*Exporter)
+	logger := getActivityLogger(ctx).WithTags(tag.WorkflowDomainName(params.Domain))
+
+	if !exporter.cfg.EnabledForDomain(params.Domain) {
+		return params.Progress, cadence.NewCustomError(errDomainNotAllowed, params.Domain)
+	}
+	path, err := getExportPath(params.URI, params.Format, exporter.cfg.BaseDir)
+	if err != nil {
+		return params.Progress, cadence.NewCustomError(errInvalidDestination, err.Error())
+	}
+
+	progress := params.Progress
+	if activity.HasHeartbeatDetails(ctx) {
+		if err := activity.GetHeartbeatDetails(ctx, &progress); err != nil {
+			logger.Error("Failed to recover visibility export progress", tag.Error(err))
+			progress = params.Progress
+		}
+	}
+	if host, err := os.Hostname(); err == nil {
+		progress.Host = host
+	}
+
+	// the records are written to a part file of this run, which is only moved
+	// to the destination once completed, so that no existing file is overwritten
+	partPath := getPartPath(path, activity.GetInfo(ctx).WorkflowExecution.RunID)
+	if !progress.Started {
+		if err := checkDestinationNotExists(path); err == errDestinationExists {
+			return progress, cadence.NewCustomError(errDestinationConflict, path)
+		} else if err != nil {
+			return progress, err
+		}
+		resp, err := exporter.frontendClient.CountWorkflowExecutions(ctx, &shared.CountWorkflowExecutionsRequest{
+			Domain: common.StringPtr(params.Domain),
+			Query:  common.StringPtr(params.Query),
+		})
+		if err != nil {
+			return progress, err
+		}
+		progress.TotalEstimate = resp.GetCount()
+	}
+
+	writer, err := newExportWriter(partPath, params.Format, progress.Size)
+	if err == errDestinationTruncated {
+		return progress, cadence.NewCustomError(errDestinationNotFound, err.Error())
+	}
+	if err != nil {
+		return progress, err
+	}
+	defer writer.Close()
+
+	for page := 0; page < pagesPerActivity; page++ {
+		if err := exporter.rateLimiter.Wait(ctx); err != nil {
+			return progress, err
+		}
+		resp, err := exporter.frontendClient.ScanWorkflowExecutions(ctx, &shared.ListWorkflowExecutionsRequest{
+			Domain:        common.StringPtr(params.Domain),
+			PageSize:      common.Int32Ptr(int32(params.PageSize)),
+			NextPageToken: progress.NextPageToken,
+			Query:         common.StringPtr(params.Query),
+		})
+		if err != nil {
+			return progress, err
+		}
+
+		for _, execution := range resp.Executions {
+			if err := writer.Write(newExportRecord(execution)); err != nil {
+				return progress, err
+			}
+		}
+		size, err := writer.Flush()
+		if err != nil {
+			return progress, err
+		}
+
+		progress.Started = true
+		progress.Size = size
+		progress.NextPageToken = resp.NextPageToken
+		progress.ExportedWorkflows += len(resp.Executions)
+		if len(progress.NextPageToken) == 0 {
+			if err := writer.Close(); err != nil {
+				return progress, err
+			}
+			if err := publishExport(partPath, path); err == errDestinationExists {
+				return progress, cadence.NewCustomError(errDestinationConflict, path)
+			} else if err != nil {
+				return progress, err
+			}
+			progress.Completed = true
+			logger.Info("Visibility records exported", tag.NumberProcessed(progress.ExportedWorkflows))
+			break
+		}
+		activity.RecordHeartbeat(ctx, progress)
+	}
+	return progress, nil
+}
+
+func validateParams(params ExportParams) error {
+	if len(params.Domain) == 0 {
+		return errDomainNotSet
+	}
+	return validateDestination(params.URI, params.Format)
+}
+
+func setDefaultParams(params ExportParams) ExportParams {
+	if len(params.Format) == 0 {
+		params.Format = FormatJSON
+	}
+	if params.PageSize <= 0 {
+		params.PageSize = DefaultPageSize
+	}
+	return params
+}
+
+func getActivityLogger(ctx context.Context) log.Logger {
+	exporter := ctx.Value(exporterContextKey).(*Exporter)
+	wfInfo := activity.GetInfo(ctx)
+	return exporter.logger.WithTags(
+		tag.WorkflowID(wfInfo.WorkflowExecution.ID),
+		tag.WorkflowRunID(wfInfo.WorkflowExecution.RunID),
+	)
+}
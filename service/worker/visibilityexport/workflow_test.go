@@ -0,0 +1,287 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibilityexport
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/testsuite"
+	"go.uber.org/cadence/worker"
+
+	"github.com/uber/cadence/.gen/go/cadence/workflowservicetest"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/quotas"
+)
+
+type exportWorkflowTestSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+}
+
+func TestExportWorkflowTestSuite(t *testing.T) {
+	suite.Run(t, new(exportWorkflowTestSuite))
+}
+
+func (s *exportWorkflowTestSuite) TestWorkflow_InvalidParams() {
+	for _, params := range []ExportParams{
+		{URI: "file:///tmp/export.json"},
+		{Domain: "d1"},
+		{Domain: "d1", URI: "s3://bucket/export.json"},
+		{Domain: "d1", URI: "file:///tmp/export.parquet", Format: "parquet"},
+		{Domain: "d1", URI: "file:///tmp/export.xml", Format: "xml"},
+	} {
+		env := s.NewTestWorkflowEnvironment()
+		env.ExecuteWorkflow(WorkflowTypeName, params)
+		s.True(env.IsWorkflowCompleted())
+		s.Error(env.GetWorkflowError())
+	}
+}
+
+func (s *exportWorkflowTestSuite) TestWorkflow() {
+	env := s.NewTestWorkflowEnvironment()
+	env.SetWorkerOptions(worker.Options{EnableSessionWorker: true})
+	env.OnActivity(exportActivityName, mock.Anything, mock.MatchedBy(func(params ExportActivityParams) bool {
+		return params.Domain == "d1" && params.Format == FormatJSON && params.PageSize == DefaultPageSize &&
+			!params.Progress.Started
+	})).Return(ExportProgress{
+		Started:       true,
+		NextPageToken: []byte("token"),
+		Size:          10,
+		ExportResult:  ExportResult{ExportedWorkflows: 2, TotalEstimate: 3},
+	}, nil).Once()
+	env.OnActivity(exportActivityName, mock.Anything, mock.MatchedBy(func(params ExportActivityParams) bool {
+		return string(params.Progress.NextPageToken) == "token" && params.Progress.Size == 10
+	})).Return(ExportProgress{
+		Started:      true,
+		Completed:    true,
+		Size:         15,
+		ExportResult: ExportResult{ExportedWorkflows: 3, TotalEstimate: 3},
+	}, nil).Once()
+
+	env.ExecuteWorkflow(WorkflowTypeName, ExportParams{Domain: "d1", URI: "file:///tmp/export.json"})
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+	var result ExportResult
+	s.NoError(env.GetWorkflowResult(&result))
+	s.Equal(ExportResult{ExportedWorkflows: 3, TotalEstimate: 3}, result)
+
+	resp, err := env.QueryWorkflow(QueryType)
+	s.NoError(err)
+	var state QueryResult
+	s.NoError(resp.Get(&state))
+	s.Equal(WorkflowCompleted, state.State)
+	s.Equal(3, state.ExportedWorkflows)
+	env.AssertExpectations(s.T())
+}
+
+func (s *exportWorkflowTestSuite) TestExportActivity() {
+	controller := gomock.NewController(s.T())
+	defer controller.Finish()
+	frontendClient := workflowservicetest.NewMockClient(controller)
+
+	dir, err := ioutil.TempDir("", "TestExportActivity")
+	s.NoError(err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "export", "result.json")
+
+	closed := newWorkflowExecutionInfo("wf-1", "run-1")
+	closed.CloseTime = common.Int64Ptr(2000000000)
+	closed.CloseStatus = shared.WorkflowExecutionCloseStatusFailed.Ptr()
+	closed.HistoryLength = common.Int64Ptr(10)
+	closed.SearchAttributes = &shared.SearchAttributes{
+		IndexedFields: map[string][]byte{"CustomKeywordField": []byte(`"keyword"`)},
+	}
+	frontendClient.EXPECT().CountWorkflowExecutions(gomock.Any(), &shared.CountWorkflowExecutionsRequest{
+		Domain: common.StringPtr("d1"),
+		Query:  common.StringPtr("CloseTime = missing"),
+	}).Return(&shared.CountWorkflowExecutionsResponse{Count: common.Int64Ptr(2)}, nil)
+	frontendClient.EXPECT().ScanWorkflowExecutions(gomock.Any(), &shared.ListWorkflowExecutionsRequest{
+		Domain:   common.StringPtr("d1"),
+		PageSize: common.Int32Ptr(1),
+		Query:    common.StringPtr("CloseTime = missing"),
+	}).Return(&shared.ListWorkflowExecutionsResponse{
+		Executions:    []*shared.WorkflowExecutionInfo{closed},
+		NextPageToken: []byte("token"),
+	}, nil)
+	frontendClient.EXPECT().ScanWorkflowExecutions(gomock.Any(), &shared.ListWorkflowExecutionsRequest{
+		Domain:        common.StringPtr("d1"),
+		PageSize:      common.Int32Ptr(1),
+		NextPageToken: []byte("token"),
+		Query:         common.StringPtr("CloseTime = missing"),
+	}).Return(&shared.ListWorkflowExecutionsResponse{
+		Executions: []*shared.WorkflowExecutionInfo{newWorkflowExecutionInfo("wf-2", "run-2")},
+	}, nil)
+
+	env := s.newTestActivityEnvironment(frontendClient, dir)
+	resp, err := env.ExecuteActivity(exportActivityName, ExportActivityParams{
+		ExportParams: ExportParams{
+			Domain:   "d1",
+			Query:    "CloseTime = missing",
+			URI:      "file://" + path,
+			Format:   FormatJSON,
+			PageSize: 1,
+		},
+	})
+	s.NoError(err)
+	var progress ExportProgress
+	s.NoError(resp.Get(&progress))
+	s.True(progress.Completed)
+	host, err := os.Hostname()
+	s.NoError(err)
+	s.Equal(ExportResult{Host: host, ExportedWorkflows: 2, TotalEstimate: 2}, progress.ExportResult)
+
+	data, err := ioutil.ReadFile(path)
+	s.NoError(err)
+	s.Equal(`{"WorkflowID":"wf-1","RunID":"run-1","WorkflowType":"type","StartTime":"1970-01-01T00:00:01Z",`+
+		`"CloseTime":"1970-01-01T00:00:02Z","CloseStatus":"FAILED","HistoryLength":10,"SearchAttributes":{"CustomKeywordField":"keyword"}}`+"\n"+
+		`{"WorkflowID":"wf-2","RunID":"run-2","WorkflowType":"type","StartTime":"1970-01-01T00:00:01Z"}`+"\n", string(data))
+	s.Equal(int64(len(data)), progress.Size)
+	// only the destination is left once the export completes
+	files, err := ioutil.ReadDir(filepath.Dir(path))
+	s.NoError(err)
+	s.Len(files, 1)
+}
+
+func (s *exportWorkflowTestSuite) TestExportActivity_ScanError() {
+	controller := gomock.NewController(s.T())
+	defer controller.Finish()
+	frontendClient := workflowservicetest.NewMockClient(controller)
+	frontendClient.EXPECT().ScanWorkflowExecutions(gomock.Any(), gomock.Any()).Return(nil, errors.New("some random error"))
+
+	dir, err := ioutil.TempDir("", "TestExportActivity_ScanError")
+	s.NoError(err)
+	defer os.RemoveAll(dir)
+
+	env := s.newTestActivityEnvironment(frontendClient, dir)
+	_, err = env.ExecuteActivity(exportActivityName, ExportActivityParams{
+		ExportParams: ExportParams{
+			Domain:   "d1",
+			URI:      "file://" + filepath.Join(dir, "result.csv"),
+			Format:   FormatCSV,
+			PageSize: 1,
+		},
+		Progress: ExportProgress{Started: true},
+	})
+	s.Error(err)
+}
+
+func (s *exportWorkflowTestSuite) TestExportActivity_Rejected() {
+	controller := gomock.NewController(s.T())
+	defer controller.Finish()
+	frontendClient := workflowservicetest.NewMockClient(controller)
+
+	dir, err := ioutil.TempDir("", "TestExportActivity_Rejected")
+	s.NoError(err)
+	defer os.RemoveAll(dir)
+	existingPath := filepath.Join(dir, "existing.json")
+	s.NoError(ioutil.WriteFile(existingPath, []byte("existing"), 0644))
+
+	env := s.newTestActivityEnvironment(frontendClient, dir)
+	testCases := []struct {
+		params ExportActivityParams
+		reason string
+	}{
+		{
+			params: ExportActivityParams{ExportParams: ExportParams{
+				Domain: "d2", URI: "file://" + filepath.Join(dir, "result.json"), Format: FormatJSON,
+			}},
+			reason: errDomainNotAllowed,
+		},
+		{
+			params: ExportActivityParams{ExportParams: ExportParams{
+				Domain: "d1", URI: "file:///tmp/result.json", Format: FormatJSON,
+			}},
+			reason: errInvalidDestination,
+		},
+		{
+			params: ExportActivityParams{ExportParams: ExportParams{
+				Domain: "d1", URI: "file://" + filepath.Join(dir, "..", "result.json"), Format: FormatJSON,
+			}},
+			reason: errInvalidDestination,
+		},
+		{
+			// the destination is missing the records exported before
+			params: ExportActivityParams{
+				ExportParams: ExportParams{Domain: "d1", URI: "file://" + filepath.Join(dir, "result.json"), Format: FormatJSON},
+				Progress:     ExportProgress{Started: true, Size: 10},
+			},
+			reason: errDestinationNotFound,
+		},
+		{
+			// an existing file is never overwritten
+			params: ExportActivityParams{ExportParams: ExportParams{
+				Domain: "d1", URI: "file://" + existingPath, Format: FormatJSON,
+			}},
+			reason: errDestinationConflict,
+		},
+	}
+	for _, testCase := range testCases {
+		_, err = env.ExecuteActivity(exportActivityName, testCase.params)
+		customErr, ok := err.(*cadence.CustomError)
+		s.True(ok, testCase.reason)
+		s.Equal(testCase.reason, customErr.Reason())
+	}
+	data, err := ioutil.ReadFile(existingPath)
+	s.NoError(err)
+	s.Equal("existing", string(data))
+}
+
+func (s *exportWorkflowTestSuite) newTestActivityEnvironment(
+	frontendClient *workflowservicetest.MockClient,
+	baseDir string,
+) *testsuite.TestActivityEnvironment {
+	exporter := &Exporter{
+		cfg: Config{
+			EnabledForDomain: func(domain string) bool { return domain == "d1" },
+			BaseDir:          baseDir,
+		},
+		frontendClient: frontendClient,
+		rateLimiter:    quotas.NewSimpleRateLimiter(1000),
+		logger:         loggerimpl.NewNopLogger(),
+	}
+	env := s.NewTestActivityEnvironment()
+	env.SetWorkerOptions(worker.Options{
+		BackgroundActivityContext: context.WithValue(context.Background(), exporterContextKey, exporter),
+	})
+	return env
+}
+
+func newWorkflowExecutionInfo(workflowID, runID string) *shared.WorkflowExecutionInfo {
+	return &shared.WorkflowExecutionInfo{
+		Execution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(workflowID),
+			RunId:      common.StringPtr(runID),
+		},
+		Type:      &shared.WorkflowType{Name: common.StringPtr("type")},
+		StartTime: common.Int64Ptr(1000000000),
+	}
+}
@@ -0,0 +1,345 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibilityexport
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/archiver"
+)
+
+const (
+	// FormatJSON exports each visibility record as a JSON object in one line
+	FormatJSON = "json"
+	// FormatCSV exports visibility records as CSV with a header line
+	FormatCSV = "csv"
+
+	// URISchemeFile is the scheme of the export destination on the file system of the worker hosts
+	URISchemeFile = "file"
+
+	fileMode = os.FileMode(0644)
+	dirMode  = os.FileMode(0755)
+
+	// partFileSuffix is the suffix of the file which the records are written to until the export completes
+	partFileSuffix = ".part"
+)
+
+var (
+	errBaseDirNotSet = errors.New("export directory is not configured on the worker hosts")
+	// errDestinationTruncated is returned if the destination is missing records exported before,
+	// e.g. the export is resumed on another host
+	errDestinationTruncated = errors.New("export destination is shorter than the exported records")
+	// errDestinationExists is returned if the destination already exists, export never overwrites a file
+	errDestinationExists = errors.New("export destination already exists")
+)
+
+var csvHeader = []string{
+	"WorkflowID",
+	"RunID",
+	"WorkflowType",
+	"StartTime",
+	"ExecutionTime",
+	"CloseTime",
+	"CloseStatus",
+	"HistoryLength",
+	"SearchAttributes",
+	"Memo",
+}
+
+type (
+	// exportRecord is the exported visibility record
+	exportRecord struct {
+		WorkflowID       string
+		RunID            string
+		WorkflowType     string
+		StartTime        string
+		ExecutionTime    string                     `json:",omitempty"`
+		CloseTime        string                     `json:",omitempty"`
+		CloseStatus      string                     `json:",omitempty"`
+		HistoryLength    int64                      `json:",omitempty"`
+		SearchAttributes map[string]json.RawMessage `json:",omitempty"`
+		Memo             map[string][]byte          `json:",omitempty"`
+	}
+
+	// exportWriter writes visibility records to the export destination
+	exportWriter interface {
+		Write(record *exportRecord) error
+		// Flush writes the buffered records to the destination,
+		// and returns the size of the destination from which the export can be resumed
+		Flush() (int64, error)
+		Close() error
+	}
+
+	fileWriter struct {
+		file      *os.File
+		buffer    *bufio.Writer
+		csvWriter *csv.Writer
+	}
+)
+
+// validateDestination checks the export destination and format are supported
+func validateDestination(uri string, format string) error {
+	parsedURI, err := archiver.NewURI(uri)
+	if err != nil {
+		return fmt.Errorf("invalid export URI %v: %v", uri, err)
+	}
+	if parsedURI.Scheme() != URISchemeFile {
+		return fmt.Errorf("export URI scheme %v is not supported", parsedURI.Scheme())
+	}
+	switch format {
+	case FormatJSON, FormatCSV:
+		return nil
+	default:
+		return fmt.Errorf("unknown export format %v", format)
+	}
+}
+
+// getExportPath returns the file path of the export destination, which must be in the export directory
+func getExportPath(uri string, format string, baseDir string) (string, error) {
+	if err := validateDestination(uri, format); err != nil {
+		return "", err
+	}
+	if len(baseDir) == 0 {
+		return "", errBaseDirNotSet
+	}
+	parsedURI, err := archiver.NewURI(uri)
+	if err != nil {
+		return "", err
+	}
+	path := parsedURI.Path()
+	if !isInDir(path, baseDir) {
+		return "", fmt.Errorf("export path %v is not in the export directory %v", path, baseDir)
+	}
+	return filepath.Clean(path), nil
+}
+
+// isInDir returns true if the path is a file in the directory or its sub directories
+func isInDir(path string, dir string) bool {
+	rel, err := filepath.Rel(dir, path)
+	if err != nil {
+		return false
+	}
+	return rel != "." && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// getPartPath returns the path of the file which the records are written to until the export completes,
+// it is unique to the export run so that only the files written by the run are truncated when resumed
+func getPartPath(path string, runID string) string {
+	return path + "." + runID + partFileSuffix
+}
+
+// checkDestinationNotExists returns errDestinationExists if the destination already exists
+func checkDestinationNotExists(path string) error {
+	_, err := os.Lstat(path)
+	if err == nil {
+		return errDestinationExists
+	}
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+// publishExport moves the completed part file to the destination, it fails with
+// errDestinationExists instead of replacing a file created after the export started
+func publishExport(partPath string, path string) error {
+	if err := os.Link(partPath, path); err != nil {
+		if !os.IsExist(err) {
+			return err
+		}
+		// the part file was published by a previous attempt which failed before it was removed
+		partInfo, partErr := os.Stat(partPath)
+		info, statErr := os.Stat(path)
+		if partErr != nil || statErr != nil || !os.SameFile(partInfo, info) {
+			return errDestinationExists
+		}
+	}
+	return os.Remove(partPath)
+}
+
+// newExportWriter creates the writer of the part file of the export,
+// which truncates the file to the given size to resume the export
+func newExportWriter(partPath string, format string, size int64) (exportWriter, error) {
+	return newFileWriter(partPath, format, size)
+}
+
+func newFileWriter(path string, format string, size int64) (*fileWriter, error) {
+	if err := os.MkdirAll(filepath.Dir(path), dirMode); err != nil {
+		return nil, err
+	}
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE, fileMode)
+	if err != nil {
+		return nil, err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	// truncating a shorter file would pad it, instead of resuming from the records exported before
+	if info.Size() < size {
+		file.Close()
+		return nil, errDestinationTruncated
+	}
+	if err := file.Truncate(size); err != nil {
+		file.Close()
+		return nil, err
+	}
+	if _, err := file.Seek(size, io.SeekStart); err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	w := &fileWriter{
+		file:   file,
+		buffer: bufio.NewWriter(file),
+	}
+	if format == FormatCSV {
+		w.csvWriter = csv.NewWriter(w.buffer)
+		if size == 0 {
+			if err := w.csvWriter.Write(csvHeader); err != nil {
+				file.Close()
+				return nil, err
+			}
+		}
+	}
+	return w, nil
+}
+
+func (w *fileWriter) Write(record *exportRecord) error {
+	if w.csvWriter != nil {
+		row, err := record.toCSVRow()
+		if err != nil {
+			return err
+		}
+		return w.csvWriter.Write(row)
+	}
+
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	if _, err := w.buffer.Write(data); err != nil {
+		return err
+	}
+	return w.buffer.WriteByte('\n')
+}
+
+func (w *fileWriter) Flush() (int64, error) {
+	if w.csvWriter != nil {
+		w.csvWriter.Flush()
+		if err := w.csvWriter.Error(); err != nil {
+			return 0, err
+		}
+	}
+	if err := w.buffer.Flush(); err != nil {
+		return 0, err
+	}
+	if err := w.file.Sync(); err != nil {
+		return 0, err
+	}
+	return w.file.Seek(0, io.SeekCurrent)
+}
+
+func (w *fileWriter) Close() error {
+	return w.file.Close()
+}
+
+func newExportRecord(execution *shared.WorkflowExecutionInfo) *exportRecord {
+	record := &exportRecord{
+		WorkflowID:    execution.Execution.GetWorkflowId(),
+		RunID:         execution.Execution.GetRunId(),
+		WorkflowType:  execution.Type.GetName(),
+		StartTime:     formatTime(execution.GetStartTime()),
+		ExecutionTime: formatTime(execution.GetExecutionTime()),
+		HistoryLength: execution.GetHistoryLength(),
+		Memo:          execution.Memo.GetFields(),
+	}
+	if execution.CloseStatus != nil {
+		record.CloseTime = formatTime(execution.GetCloseTime())
+		record.CloseStatus = execution.CloseStatus.String()
+	}
+	if fields := execution.SearchAttributes.GetIndexedFields(); len(fields) > 0 {
+		record.SearchAttributes = make(map[string]json.RawMessage, len(fields))
+		for key, value := range fields {
+			if !json.Valid(value) {
+				// keep the raw value as string, so that the record is still valid JSON
+				value, _ = json.Marshal(string(value))
+			}
+			record.SearchAttributes[key] = value
+		}
+	}
+	return record
+}
+
+func (r *exportRecord) toCSVRow() ([]string, error) {
+	searchAttributes, err := marshalNonEmpty(len(r.SearchAttributes), r.SearchAttributes)
+	if err != nil {
+		return nil, err
+	}
+	memo, err := marshalNonEmpty(len(r.Memo), r.Memo)
+	if err != nil {
+		return nil, err
+	}
+	historyLength := ""
+	if r.HistoryLength > 0 {
+		historyLength = strconv.FormatInt(r.HistoryLength, 10)
+	}
+	return []string{
+		r.WorkflowID,
+		r.RunID,
+		r.WorkflowType,
+		r.StartTime,
+		r.ExecutionTime,
+		r.CloseTime,
+		r.CloseStatus,
+		historyLength,
+		searchAttributes,
+		memo,
+	}, nil
+}
+
+// marshalNonEmpty marshals a map, or returns empty string for an empty map
+func marshalNonEmpty(length int, v interface{}) (string, error) {
+	if length == 0 {
+		return "", nil
+	}
+	data, err := json.Marshal(v)
+	return string(data), err
+}
+
+func formatTime(unixNano int64) string {
+	if unixNano <= 0 {
+		return ""
+	}
+	return time.Unix(0, unixNano).UTC().Format(time.RFC3339Nano)
+}
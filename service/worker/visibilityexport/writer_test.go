@@ -0,0 +1,134 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibilityexport
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileWriter_CSV(t *testing.T) {
+	dir, err := ioutil.TempDir("", "TestFileWriter_CSV")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "result.csv")
+
+	writer, err := newExportWriter(path, FormatCSV, 0)
+	require.NoError(t, err)
+	require.NoError(t, writer.Write(&exportRecord{
+		WorkflowID:    "wf-1",
+		RunID:         "run-1",
+		WorkflowType:  "type",
+		StartTime:     "1970-01-01T00:00:01Z",
+		CloseTime:     "1970-01-01T00:00:02Z",
+		CloseStatus:   "COMPLETED",
+		HistoryLength: 10,
+		Memo:          map[string][]byte{"key": []byte("value")},
+	}))
+	size, err := writer.Flush()
+	require.NoError(t, err)
+	require.NoError(t, writer.Write(&exportRecord{WorkflowID: "wf-2"}))
+	_, err = writer.Flush()
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+
+	// resume from the size of the first flush, records written after it are overwritten
+	writer, err = newExportWriter(path, FormatCSV, size)
+	require.NoError(t, err)
+	require.NoError(t, writer.Write(&exportRecord{WorkflowID: "wf-3", RunID: "run-3", WorkflowType: "type"}))
+	_, err = writer.Flush()
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+
+	data, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t,
+		"WorkflowID,RunID,WorkflowType,StartTime,ExecutionTime,CloseTime,CloseStatus,HistoryLength,SearchAttributes,Memo\n"+
+			"wf-1,run-1,type,1970-01-01T00:00:01Z,,1970-01-01T00:00:02Z,COMPLETED,10,,\"{\"\"key\"\":\"\"dmFsdWU=\"\"}\"\n"+
+			"wf-3,run-3,type,,,,,,,\n",
+		string(data))
+}
+
+func TestValidateDestination(t *testing.T) {
+	require.NoError(t, validateDestination("file:///tmp/result.json", FormatJSON))
+	require.NoError(t, validateDestination("file:///tmp/result.csv", FormatCSV))
+	require.EqualError(t, validateDestination("s3://bucket/result.json", FormatJSON), "export URI scheme s3 is not supported")
+	require.EqualError(t, validateDestination("file:///tmp/result", "parquet"), "unknown export format parquet")
+	require.EqualError(t, validateDestination("file:///tmp/result", "xml"), "unknown export format xml")
+	require.Error(t, validateDestination("result.json", FormatJSON))
+}
+
+func TestFileWriter_DestinationTruncated(t *testing.T) {
+	dir, err := ioutil.TempDir("", "TestFileWriter_DestinationTruncated")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	// the file is missing on the host resuming the export
+	_, err = newExportWriter(filepath.Join(dir, "result.json"), FormatJSON, 10)
+	require.Equal(t, errDestinationTruncated, err)
+}
+
+func TestPublishExport(t *testing.T) {
+	dir, err := ioutil.TempDir("", "TestPublishExport")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "result.json")
+	partPath := getPartPath(path, "run-1")
+
+	require.NoError(t, checkDestinationNotExists(path))
+	require.NoError(t, ioutil.WriteFile(partPath, []byte("exported"), fileMode))
+	require.NoError(t, publishExport(partPath, path))
+	data, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, "exported", string(data))
+	_, err = os.Stat(partPath)
+	require.True(t, os.IsNotExist(err))
+	require.Equal(t, errDestinationExists, checkDestinationNotExists(path))
+
+	// a file created at the destination while exporting is not overwritten
+	partPath = getPartPath(path, "run-2")
+	require.NoError(t, ioutil.WriteFile(partPath, []byte("exported again"), fileMode))
+	require.Equal(t, errDestinationExists, publishExport(partPath, path))
+	data, err = ioutil.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, "exported", string(data))
+}
+
+func TestGetExportPath(t *testing.T) {
+	path, err := getExportPath("file:///export/domain/result.json", FormatJSON, "/export")
+	require.NoError(t, err)
+	require.Equal(t, "/export/domain/result.json", path)
+
+	_, err = getExportPath("file:///export/result.json", FormatJSON, "")
+	require.Equal(t, errBaseDirNotSet, err)
+	_, err = getExportPath("file:///tmp/result.json", FormatJSON, "/export")
+	require.EqualError(t, err, "export path /tmp/result.json is not in the export directory /export")
+	_, err = getExportPath("file:///export/../etc/result.json", FormatJSON, "/export")
+	require.Error(t, err)
+	_, err = getExportPath("file:///export", FormatJSON, "/export")
+	require.Error(t, err)
+	_, err = getExportPath("s3://bucket/result.json", FormatJSON, "/export")
+	require.EqualError(t, err, "export URI scheme s3 is not supported")
+}
@@ -0,0 +1,118 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibilitymigration
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/uber-go/tally"
+	"go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
+	"go.uber.org/cadence/worker"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/quotas"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	// Config defines the configuration for visibility migrator
+	Config struct {
+		// MaxQPS is the max rate of visibility records written to the target store by the migration
+		MaxQPS dynamicconfig.IntPropertyFn
+	}
+
+	// BootstrapParams contains the set of params needed to bootstrap
+	// the visibility migrator sub-system
+	BootstrapParams struct {
+		// Config contains the configuration for visibility migrator
+		Config Config
+		// ServiceClient is an instance of cadence service client
+		ServiceClient workflowserviceclient.Interface
+		// MetricsClient is an instance of metrics object for emitting stats
+		MetricsClient metrics.Client
+		Logger        log.Logger
+		// TallyScope is an instance of tally metrics scope
+		TallyScope tally.Scope
+		// DomainCache is used to look up the domain ID and retention of the migrated domains
+		DomainCache cache.DomainCache
+		// SourceManager is the visibility manager of the store records are copied from
+		SourceManager persistence.VisibilityManager
+		// TargetManager is the visibility manager of the store records are copied to
+		TargetManager persistence.VisibilityManager
+		// RepairQueue holds the records whose dual write to the target store failed
+		RepairQueue persistence.Queue
+	}
+
+	// Migrator is the background sub-system that executes the visibility migration workflows
+	// It is also the context object that gets passed around within the migration workflows / activities
+	Migrator struct {
+		cfg           Config
+		svcClient     workflowserviceclient.Interface
+		domainCache   cache.DomainCache
+		sourceManager persistence.VisibilityManager
+		targetManager persistence.VisibilityManager
+		repairQueue   persistence.Queue
+		rateLimiter   quotas.Limiter
+		timeSource    clock.TimeSource
+		metricsClient metrics.Client
+		tallyScope    tally.Scope
+		logger        log.Logger
+	}
+)
+
+// New returns a new instance of visibility migrator
+func New(params *BootstrapParams) *Migrator {
+	cfg := params.Config
+	return &Migrator{
+		cfg:           cfg,
+		svcClient:     params.ServiceClient,
+		domainCache:   params.DomainCache,
+		sourceManager: params.SourceManager,
+		targetManager: params.TargetManager,
+		repairQueue:   params.RepairQueue,
+		rateLimiter: quotas.NewDynamicRateLimiter(func() float64 {
+			return float64(cfg.MaxQPS())
+		}),
+		timeSource:    clock.NewRealTimeSource(),
+		metricsClient: params.MetricsClient,
+		tallyScope:    params.TallyScope,
+		logger:        params.Logger.WithTags(tag.ComponentVisibilityMigration),
+	}
+}
+
+// Start starts the worker for the visibility migration workflows
+func (m *Migrator) Start() error {
+	ctx := context.WithValue(context.Background(), migratorContextKey, m)
+	workerOpts := worker.Options{
+		MetricsScope:              m.tallyScope,
+		BackgroundActivityContext: ctx,
+		Tracer:                    opentracing.GlobalTracer(),
+	}
+	migrationWorker := worker.New(m.svcClient, common.SystemLocalDomainName, TaskListName, workerOpts)
+	return migrationWorker.Start()
+}
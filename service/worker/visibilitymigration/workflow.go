@@ -0,0 +1,503 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibilitymigration
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/workflow"
+
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/persistence"
+)
+
+const (
+	migratorContextKey = "visibilityMigratorContext"
+	// TaskListName is the tasklist name
+	TaskListName = "cadence-sys-visibilityMigration-tasklist"
+	// WorkflowTypeName is the workflow type
+	WorkflowTypeName = "cadence-sys-visibilityMigration-workflow"
+	// WorkflowID is reused by every migration workflow, so that only one migration runs at a time
+	WorkflowID = "cadence-visibility-migration"
+	// QueryType is the query type of the migration workflow progress
+	QueryType = "state"
+	// WorkflowTimeout is the timeout of the migration workflow
+	WorkflowTimeout = 7 * 24 * time.Hour
+
+	migrateDomainActivityName = "cadence-sys-migrateVisibilityDomainActivity"
+	repairActivityName        = "cadence-sys-repairVisibilityMigrationActivity"
+
+	// repairConsumerName is the name the ack level of the repair queue is stored under
+	repairConsumerName  = "visibility-migration"
+	emptyQueueMessageID = -1
+
+	// DefaultPageSize is the default number of visibility records read from the source store in one page
+	DefaultPageSize = 1000
+
+	secondsInDay = int64(24 * time.Hour / time.Second)
+	// open workflow records are copied without TTL, they are replaced by the closed records when the workflows close
+	openWorkflowTimeoutInSeconds = 100 * 365 * secondsInDay
+)
+
+const (
+	// WorkflowRunning is the state of a running migration workflow
+	WorkflowRunning = "running"
+	// WorkflowCompleted is the state of a completed migration workflow
+	WorkflowCompleted = "completed"
+)
+
+type (
+	// MigrationParams is the parameters for the migration workflow
+	MigrationParams struct {
+		// Domains are the names of the domains whose visibility records are migrated
+		Domains []string
+		// EarliestStartTime is the earliest start time in unix nano of the migrated workflows
+		EarliestStartTime int64
+		// LatestStartTime is the latest start time in unix nano of the migrated workflows.
+		// Default to the start time of the migration workflow
+		LatestStartTime int64
+		// PageSize is the number of records read from the source store in one page. Default to DefaultPageSize
+		PageSize int
+	}
+
+	// MigrationResult is the result of the migration workflow
+	MigrationResult struct {
+		SuccessDomains    []string
+		FailedDomains     []string
+		MigratedWorkflows int
+		SkippedWorkflows  int
+		FailedWorkflows   int
+		RepairedWorkflows int
+	}
+
+	// QueryResult is the progress of the migration workflow
+	QueryResult struct {
+		State         string
+		TotalDomains  int
+		CurrentDomain string
+		MigrationResult
+	}
+
+	// MigrateDomainActivityParams is the parameters for the migrate domain activity
+	MigrateDomainActivityParams struct {
+		Domain            string
+		EarliestStartTime int64
+		LatestStartTime   int64
+		PageSize          int
+	}
+
+	// MigrateDomainActivityResult is the result of the migrate domain activity
+	MigrateDomainActivityResult struct {
+		MigratedWorkflows int
+		SkippedWorkflows  int
+		FailedWorkflows   int
+	}
+
+	// RepairActivityResult is the result of the repair activity
+	RepairActivityResult struct {
+		RepairedWorkflows int
+	}
+
+	// migrateDomainProgress is recorded in the heartbeat details of the migrate domain activity
+	migrateDomainProgress struct {
+		OpenWorkflowsMigrated bool
+		NextPageToken         []byte
+		MigrateDomainActivityResult
+	}
+)
+
+var (
+	errDomainsNotSet    = errors.New("domains are not set")
+	errInvalidTimeRange = errors.New("earliest start time is after latest start time")
+
+	activityRetryPolicy = cadence.RetryPolicy{
+		InitialInterval:    time.Second,
+		BackoffCoefficient: 2,
+		MaximumInterval:    time.Minute,
+		ExpirationInterval: WorkflowTimeout,
+	}
+
+	activityOptions = workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    WorkflowTimeout,
+		HeartbeatTimeout:       time.Minute,
+		RetryPolicy:            &activityRetryPolicy,
+	}
+)
+
+func init() {
+	workflow.RegisterWithOptions(MigrationWorkflow, workflow.RegisterOptions{Name: WorkflowTypeName})
+	activity.RegisterWithOptions(MigrateDomainActivity, activity.RegisterOptions{Name: migrateDomainActivityName})
+	activity.RegisterWithOptions(RepairActivity, activity.RegisterOptions{Name: repairActivityName})
+}
+
+// MigrationWorkflow copies the visibility records of the given domains from the source store to the target store
+// domain by domain, then copies again the records whose dual write to the target store failed. It is expected to run
+// while the visibility records are dual written to both stores, so that the target store is complete once the workflow
+// completes and reads can be switched to it by dynamic config. The workflow fails if the failed writes can not be repaired.
+func MigrationWorkflow(ctx workflow.Context, params MigrationParams) (MigrationResult, error) {
+	if err := validateParams(params); err != nil {
+		return MigrationResult{}, err
+	}
+	params = setDefaultParams(ctx, params)
+	if params.EarliestStartTime > params.LatestStartTime {
+		return MigrationResult{}, errInvalidTimeRange
+	}
+
+	state := &QueryResult{
+		State:        WorkflowRunning,
+		TotalDomains: len(params.Domains),
+	}
+	if err := workflow.SetQueryHandler(ctx, QueryType, func() (QueryResult, error) {
+		return *state, nil
+	}); err != nil {
+		return MigrationResult{}, err
+	}
+
+	ctx = workflow.WithActivityOptions(ctx, activityOptions)
+	for _, domain := range params.Domains {
+		state.CurrentDomain = domain
+		var result MigrateDomainActivityResult
+		err := workflow.ExecuteActivity(ctx, migrateDomainActivityName, MigrateDomainActivityParams{
+			Domain:            domain,
+			EarliestStartTime: params.EarliestStartTime,
+			LatestStartTime:   params.LatestStartTime,
+			PageSize:          params.PageSize,
+		}).Get(ctx, &result)
+		if err != nil {
+			state.FailedDomains = append(state.FailedDomains, domain)
+		} else {
+			state.SuccessDomains = append(state.SuccessDomains, domain)
+		}
+		state.MigratedWorkflows += result.MigratedWorkflows
+		state.SkippedWorkflows += result.SkippedWorkflows
+		state.FailedWorkflows += result.FailedWorkflows
+	}
+
+	state.CurrentDomain = ""
+	var repairResult RepairActivityResult
+	err := workflow.ExecuteActivity(ctx, repairActivityName, params.PageSize).Get(ctx, &repairResult)
+	state.RepairedWorkflows = repairResult.RepairedWorkflows
+	if err != nil {
+		return state.MigrationResult, err
+	}
+
+	state.State = WorkflowCompleted
+	return state.MigrationResult, nil
+}
+
+// RepairActivity copies again from the source store the records queued by the dual write after failing to be
+// written to the target store. The queue is read from the last acked message, so retries resume from the last page.
+func RepairActivity(ctx context.Context, pageSize int) (RepairActivityResult, error) {
+	migrator := ctx.Value(migratorContextKey).(*Migrator)
+	logger := getActivityLogger(ctx)
+
+	var result RepairActivityResult
+	if activity.HasHeartbeatDetails(ctx) {
+		if err := activity.GetHeartbeatDetails(ctx, &result); err != nil {
+			logger.Error("Failed to recover visibility migration repair progress", tag.Error(err))
+		}
+	}
+
+	ackLevels, err := migrator.repairQueue.GetAckLevels()
+	if err != nil {
+		return result, err
+	}
+	lastMessageID, ok := ackLevels[repairConsumerName]
+	if !ok {
+		lastMessageID = emptyQueueMessageID
+	}
+
+	for {
+		messages, err := migrator.repairQueue.ReadMessages(lastMessageID, pageSize)
+		if err != nil {
+			return result, err
+		}
+		if len(messages) == 0 {
+			logger.Info("Failed visibility migration writes repaired", tag.NumberProcessed(result.RepairedWorkflows))
+			return result, nil
+		}
+
+		for _, message := range messages {
+			if err := migrator.rateLimiter.Wait(ctx); err != nil {
+				return result, err
+			}
+
+			var repairMessage persistence.VisibilityMigrationRepairMessage
+			if err := json.Unmarshal(message.Payload, &repairMessage); err != nil {
+				logger.Error("Failed to decode visibility migration repair message", tag.TaskID(int64(message.ID)), tag.Error(err))
+			} else {
+				if err := migrator.repairWorkflow(&repairMessage); err != nil {
+					logger.Error("Failed to repair workflow visibility record",
+						tag.WorkflowID(repairMessage.WorkflowID),
+						tag.WorkflowRunID(repairMessage.RunID),
+						tag.Error(err),
+					)
+					return result, err
+				}
+				result.RepairedWorkflows++
+			}
+			lastMessageID = message.ID
+		}
+
+		if err := migrator.repairQueue.UpdateAckLevel(lastMessageID, repairConsumerName); err != nil {
+			return result, err
+		}
+		if err := migrator.repairQueue.DeleteMessagesBefore(lastMessageID + 1); err != nil {
+			logger.Warn("Failed to delete repaired visibility migration messages", tag.Error(err))
+		}
+		activity.RecordHeartbeat(ctx, result)
+	}
+}
+
+// MigrateDomainActivity copies the open visibility records of the domain, then the closed ones,
+// from the source store to the target store. Records which fail to be copied are counted in the result
+// instead of failing the activity, the progress is recorded in heartbeat so that retries resume from the last page.
+func MigrateDomainActivity(ctx context.Context, params MigrateDomainActivityParams) (MigrateDomainActivityResult, error) {
+	migrator := ctx.Value(migratorContextKey).(*Migrator)
+	logger := getActivityLogger(ctx).WithTags(tag.WorkflowDomainName(params.Domain))
+
+	domainEntry, err := migrator.domainCache.GetDomain(params.Domain)
+	if err != nil {
+		return MigrateDomainActivityResult{}, err
+	}
+
+	var progress migrateDomainProgress
+	if activity.HasHeartbeatDetails(ctx) {
+		if err := activity.GetHeartbeatDetails(ctx, &progress); err != nil {
+			logger.Error("Failed to recover visibility migration progress", tag.Error(err))
+		}
+	}
+
+	for {
+		request := &persistence.ListWorkflowExecutionsRequest{
+			DomainUUID:        domainEntry.GetInfo().ID,
+			Domain:            params.Domain,
+			EarliestStartTime: params.EarliestStartTime,
+			LatestStartTime:   params.LatestStartTime,
+			PageSize:          params.PageSize,
+			NextPageToken:     progress.NextPageToken,
+		}
+		var resp *persistence.ListWorkflowExecutionsResponse
+		if progress.OpenWorkflowsMigrated {
+			resp, err = migrator.sourceManager.ListClosedWorkflowExecutions(request)
+		} else {
+			resp, err = migrator.sourceManager.ListOpenWorkflowExecutions(request)
+		}
+		if err != nil {
+			return progress.MigrateDomainActivityResult, err
+		}
+
+		for _, execution := range resp.Executions {
+			if err := migrator.rateLimiter.Wait(ctx); err != nil {
+				return progress.MigrateDomainActivityResult, err
+			}
+
+			var migrated bool
+			if progress.OpenWorkflowsMigrated {
+				migrated, err = migrator.migrateClosedWorkflow(domainEntry, execution)
+			} else {
+				migrated, err = migrator.migrateOpenWorkflow(domainEntry, execution)
+			}
+			switch {
+			case err != nil:
+				logger.Error("Failed to migrate workflow visibility record",
+					tag.WorkflowID(execution.Execution.GetWorkflowId()),
+					tag.WorkflowRunID(execution.Execution.GetRunId()),
+					tag.Error(err),
+				)
+				progress.FailedWorkflows++
+			case migrated:
+				progress.MigratedWorkflows++
+			default:
+				progress.SkippedWorkflows++
+			}
+		}
+
+		progress.NextPageToken = resp.NextPageToken
+		if len(progress.NextPageToken) == 0 {
+			if progress.OpenWorkflowsMigrated {
+				logger.Info("Visibility records of domain migrated",
+					tag.NumberProcessed(progress.MigratedWorkflows),
+					tag.Counter(progress.FailedWorkflows),
+				)
+				return progress.MigrateDomainActivityResult, nil
+			}
+			progress.OpenWorkflowsMigrated = true
+		}
+		activity.RecordHeartbeat(ctx, progress)
+	}
+}
+
+// migrateOpenWorkflow copies the open record of the workflow to the target store,
+// returns false if the workflow is already recorded as closed in the target store
+func (m *Migrator) migrateOpenWorkflow(
+	domainEntry *cache.DomainCacheEntry,
+	execution *shared.WorkflowExecutionInfo,
+) (bool, error) {
+
+	// the workflow may be closed after it is listed from the source store, in which case the closed record
+	// is dual written to the target store and must not be overwritten by the stale open record
+	_, err := m.targetManager.GetClosedWorkflowExecution(&persistence.GetClosedWorkflowExecutionRequest{
+		DomainUUID: domainEntry.GetInfo().ID,
+		Domain:     domainEntry.GetInfo().Name,
+		Execution:  *execution.Execution,
+	})
+	if err == nil {
+		return false, nil
+	}
+	if _, ok := err.(*shared.EntityNotExistsError); !ok {
+		return false, err
+	}
+
+	return true, m.targetManager.RecordWorkflowExecutionStarted(&persistence.RecordWorkflowExecutionStartedRequest{
+		DomainUUID:         domainEntry.GetInfo().ID,
+		Domain:             domainEntry.GetInfo().Name,
+		Execution:          *execution.Execution,
+		WorkflowTypeName:   execution.Type.GetName(),
+		StartTimestamp:     execution.GetStartTime(),
+		ExecutionTimestamp: execution.GetExecutionTime(),
+		WorkflowTimeout:    openWorkflowTimeoutInSeconds,
+		Memo:               execution.Memo,
+		SearchAttributes:   execution.SearchAttributes.GetIndexedFields(),
+	})
+}
+
+// migrateClosedWorkflow copies the closed record of the workflow to the target store with the remaining retention,
+// returns false if the retention of the workflow has already expired
+func (m *Migrator) migrateClosedWorkflow(
+	domainEntry *cache.DomainCacheEntry,
+	execution *shared.WorkflowExecutionInfo,
+) (bool, error) {
+
+	workflowID := execution.Execution.GetWorkflowId()
+	retention := time.Duration(domainEntry.GetRetentionDays(workflowID)) * time.Hour * 24
+	expiry := time.Unix(0, execution.GetCloseTime()).Add(retention)
+	remainingRetention := expiry.Sub(m.timeSource.Now())
+	if remainingRetention < time.Second {
+		return false, nil
+	}
+
+	return true, m.targetManager.RecordWorkflowExecutionClosed(&persistence.RecordWorkflowExecutionClosedRequest{
+		DomainUUID:         domainEntry.GetInfo().ID,
+		Domain:             domainEntry.GetInfo().Name,
+		Execution:          *execution.Execution,
+		WorkflowTypeName:   execution.Type.GetName(),
+		StartTimestamp:     execution.GetStartTime(),
+		ExecutionTimestamp: execution.GetExecutionTime(),
+		CloseTimestamp:     execution.GetCloseTime(),
+		Status:             execution.GetCloseStatus(),
+		HistoryLength:      execution.GetHistoryLength(),
+		RetentionSeconds:   int64(remainingRetention / time.Second),
+		Memo:               execution.Memo,
+		SearchAttributes:   execution.SearchAttributes.GetIndexedFields(),
+	})
+}
+
+// repairWorkflow copies the current record of the workflow in the source store to the target store,
+// or deletes the record from the target store if it no longer exists in the source store
+func (m *Migrator) repairWorkflow(message *persistence.VisibilityMigrationRepairMessage) error {
+	domainEntry, err := m.domainCache.GetDomainByID(message.DomainID)
+	if err != nil {
+		if _, ok := err.(*shared.EntityNotExistsError); ok {
+			return nil
+		}
+		return err
+	}
+	execution := shared.WorkflowExecution{
+		WorkflowId: common.StringPtr(message.WorkflowID),
+		RunId:      common.StringPtr(message.RunID),
+	}
+
+	closedResp, err := m.sourceManager.GetClosedWorkflowExecution(&persistence.GetClosedWorkflowExecutionRequest{
+		DomainUUID: domainEntry.GetInfo().ID,
+		Domain:     domainEntry.GetInfo().Name,
+		Execution:  execution,
+	})
+	switch err.(type) {
+	case nil:
+		_, err = m.migrateClosedWorkflow(domainEntry, closedResp.Execution)
+		return err
+	case *shared.EntityNotExistsError:
+	default:
+		return err
+	}
+
+	openResp, err := m.sourceManager.ListOpenWorkflowExecutionsByWorkflowID(&persistence.ListWorkflowExecutionsByWorkflowIDRequest{
+		ListWorkflowExecutionsRequest: persistence.ListWorkflowExecutionsRequest{
+			DomainUUID:      domainEntry.GetInfo().ID,
+			Domain:          domainEntry.GetInfo().Name,
+			LatestStartTime: m.timeSource.Now().UnixNano(),
+			PageSize:        DefaultPageSize,
+		},
+		WorkflowID: message.WorkflowID,
+	})
+	if err != nil {
+		return err
+	}
+	for _, info := range openResp.Executions {
+		if info.Execution.GetRunId() == message.RunID {
+			_, err = m.migrateOpenWorkflow(domainEntry, info)
+			return err
+		}
+	}
+
+	return m.targetManager.DeleteWorkflowExecution(&persistence.VisibilityDeleteWorkflowExecutionRequest{
+		DomainID:   message.DomainID,
+		WorkflowID: message.WorkflowID,
+		RunID:      message.RunID,
+	})
+}
+
+func validateParams(params MigrationParams) error {
+	if len(params.Domains) == 0 {
+		return errDomainsNotSet
+	}
+	return nil
+}
+
+func setDefaultParams(ctx workflow.Context, params MigrationParams) MigrationParams {
+	if params.LatestStartTime <= 0 {
+		params.LatestStartTime = workflow.Now(ctx).UnixNano()
+	}
+	if params.PageSize <= 0 {
+		params.PageSize = DefaultPageSize
+	}
+	return params
+}
+
+func getActivityLogger(ctx context.Context) log.Logger {
+	migrator := ctx.Value(migratorContextKey).(*Migrator)
+	wfInfo := activity.GetInfo(ctx)
+	return migrator.logger.WithTags(
+		tag.WorkflowID(wfInfo.WorkflowExecution.ID),
+		tag.WorkflowRunID(wfInfo.WorkflowExecution.RunID),
+	)
+}
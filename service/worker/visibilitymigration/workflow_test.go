@@ -0,0 +1,329 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibilitymigration
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/cadence/testsuite"
+	"go.uber.org/cadence/worker"
+
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/quotas"
+)
+
+type (
+	migrationWorkflowTestSuite struct {
+		suite.Suite
+		testsuite.WorkflowTestSuite
+	}
+
+	// testRepairQueue is an in memory queue holding the messages of the repair queue
+	testRepairQueue struct {
+		persistence.Queue
+
+		messages  []*persistence.QueueMessage
+		ackLevels map[string]int
+	}
+)
+
+func TestMigrationWorkflowTestSuite(t *testing.T) {
+	suite.Run(t, new(migrationWorkflowTestSuite))
+}
+
+func (s *migrationWorkflowTestSuite) TestWorkflow_InvalidParams() {
+	env := s.NewTestWorkflowEnvironment()
+	env.ExecuteWorkflow(WorkflowTypeName, MigrationParams{})
+	s.True(env.IsWorkflowCompleted())
+	s.Error(env.GetWorkflowError())
+
+	env = s.NewTestWorkflowEnvironment()
+	env.ExecuteWorkflow(WorkflowTypeName, MigrationParams{
+		Domains:           []string{"d1"},
+		EarliestStartTime: 2,
+		LatestStartTime:   1,
+	})
+	s.True(env.IsWorkflowCompleted())
+	s.Error(env.GetWorkflowError())
+}
+
+func (s *migrationWorkflowTestSuite) TestWorkflow() {
+	env := s.NewTestWorkflowEnvironment()
+	env.OnActivity(migrateDomainActivityName, mock.Anything, mock.MatchedBy(func(params MigrateDomainActivityParams) bool {
+		return params.Domain == "d1" && params.PageSize == DefaultPageSize && params.LatestStartTime > 0
+	})).Return(MigrateDomainActivityResult{MigratedWorkflows: 3, SkippedWorkflows: 1, FailedWorkflows: 1}, nil).Once()
+	env.OnActivity(migrateDomainActivityName, mock.Anything, mock.MatchedBy(func(params MigrateDomainActivityParams) bool {
+		return params.Domain == "d2"
+	})).Return(MigrateDomainActivityResult{}, errors.New("some random error")).Once()
+	env.OnActivity(repairActivityName, mock.Anything, DefaultPageSize).Return(RepairActivityResult{RepairedWorkflows: 2}, nil).Once()
+
+	env.ExecuteWorkflow(WorkflowTypeName, MigrationParams{Domains: []string{"d1", "d2"}})
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+	var result MigrationResult
+	s.NoError(env.GetWorkflowResult(&result))
+	s.Equal([]string{"d1"}, result.SuccessDomains)
+	s.Equal([]string{"d2"}, result.FailedDomains)
+	s.Equal(3, result.MigratedWorkflows)
+	s.Equal(1, result.SkippedWorkflows)
+	s.Equal(1, result.FailedWorkflows)
+	s.Equal(2, result.RepairedWorkflows)
+
+	resp, err := env.QueryWorkflow(QueryType)
+	s.NoError(err)
+	var state QueryResult
+	s.NoError(resp.Get(&state))
+	s.Equal(WorkflowCompleted, state.State)
+	s.Equal(2, state.TotalDomains)
+	env.AssertExpectations(s.T())
+}
+
+func (s *migrationWorkflowTestSuite) TestMigrateDomainActivity() {
+	controller := gomock.NewController(s.T())
+	defer controller.Finish()
+	domainCache := cache.NewMockDomainCache(controller)
+	domainCache.EXPECT().GetDomain("d1").Return(cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: "d1-id", Name: "d1"},
+		&persistence.DomainConfig{Retention: 1},
+		cluster.TestCurrentClusterName,
+		nil,
+	), nil)
+
+	sourceManager := &mocks.VisibilityManager{}
+	targetManager := &mocks.VisibilityManager{}
+	defer sourceManager.AssertExpectations(s.T())
+	defer targetManager.AssertExpectations(s.T())
+
+	now := time.Now()
+	openClosed := newWorkflowExecutionInfo("wf-1", "run-1")
+	open := newWorkflowExecutionInfo("wf-2", "run-2")
+	closed := newWorkflowExecutionInfo("wf-3", "run-3")
+	closed.CloseStatus = shared.WorkflowExecutionCloseStatusCompleted.Ptr()
+	closed.CloseTime = common.Int64Ptr(now.Add(-time.Hour).UnixNano())
+	expired := newWorkflowExecutionInfo("wf-4", "run-4")
+	expired.CloseStatus = shared.WorkflowExecutionCloseStatusCompleted.Ptr()
+	expired.CloseTime = common.Int64Ptr(now.Add(-25 * time.Hour).UnixNano())
+	sourceManager.On("ListOpenWorkflowExecutions", mock.MatchedBy(func(request *persistence.ListWorkflowExecutionsRequest) bool {
+		return request.DomainUUID == "d1-id" && request.NextPageToken == nil
+	})).Return(&persistence.ListWorkflowExecutionsResponse{
+		Executions:    []*shared.WorkflowExecutionInfo{openClosed},
+		NextPageToken: []byte("token"),
+	}, nil).Once()
+	sourceManager.On("ListOpenWorkflowExecutions", mock.MatchedBy(func(request *persistence.ListWorkflowExecutionsRequest) bool {
+		return string(request.NextPageToken) == "token"
+	})).Return(&persistence.ListWorkflowExecutionsResponse{
+		Executions: []*shared.WorkflowExecutionInfo{open},
+	}, nil).Once()
+	sourceManager.On("ListClosedWorkflowExecutions", mock.Anything).Return(&persistence.ListWorkflowExecutionsResponse{
+		Executions: []*shared.WorkflowExecutionInfo{closed, expired},
+	}, nil).Once()
+
+	targetManager.On("GetClosedWorkflowExecution", mock.MatchedBy(func(request *persistence.GetClosedWorkflowExecutionRequest) bool {
+		return request.Execution.GetWorkflowId() == "wf-1"
+	})).Return(&persistence.GetClosedWorkflowExecutionResponse{}, nil).Once()
+	targetManager.On("GetClosedWorkflowExecution", mock.MatchedBy(func(request *persistence.GetClosedWorkflowExecutionRequest) bool {
+		return request.Execution.GetWorkflowId() == "wf-2"
+	})).Return(nil, &shared.EntityNotExistsError{}).Once()
+	targetManager.On("RecordWorkflowExecutionStarted", mock.MatchedBy(func(request *persistence.RecordWorkflowExecutionStartedRequest) bool {
+		return request.DomainUUID == "d1-id" && request.Execution.GetWorkflowId() == "wf-2" && request.WorkflowTypeName == "type"
+	})).Return(nil).Once()
+	targetManager.On("RecordWorkflowExecutionClosed", mock.MatchedBy(func(request *persistence.RecordWorkflowExecutionClosedRequest) bool {
+		return request.Execution.GetWorkflowId() == "wf-3" &&
+			request.Status == shared.WorkflowExecutionCloseStatusCompleted &&
+			request.RetentionSeconds == int64(23*time.Hour/time.Second)
+	})).Return(errors.New("some random error")).Once()
+
+	migrator := &Migrator{
+		domainCache:   domainCache,
+		sourceManager: sourceManager,
+		targetManager: targetManager,
+		rateLimiter:   quotas.NewSimpleRateLimiter(1000),
+		timeSource:    clock.NewEventTimeSource().Update(now),
+		logger:        loggerimpl.NewNopLogger(),
+	}
+	env := s.NewTestActivityEnvironment()
+	env.SetWorkerOptions(worker.Options{
+		BackgroundActivityContext: context.WithValue(context.Background(), migratorContextKey, migrator),
+	})
+	resp, err := env.ExecuteActivity(migrateDomainActivityName, MigrateDomainActivityParams{
+		Domain:          "d1",
+		LatestStartTime: 1,
+		PageSize:        1,
+	})
+	s.NoError(err)
+	var result MigrateDomainActivityResult
+	s.NoError(resp.Get(&result))
+	s.Equal(MigrateDomainActivityResult{MigratedWorkflows: 1, SkippedWorkflows: 2, FailedWorkflows: 1}, result)
+}
+
+func (s *migrationWorkflowTestSuite) TestWorkflow_RepairFailed() {
+	env := s.NewTestWorkflowEnvironment()
+	env.OnActivity(migrateDomainActivityName, mock.Anything, mock.Anything).Return(MigrateDomainActivityResult{}, nil).Once()
+	env.OnActivity(repairActivityName, mock.Anything, mock.Anything).Return(RepairActivityResult{}, errors.New("some random error")).Once()
+
+	env.ExecuteWorkflow(WorkflowTypeName, MigrationParams{Domains: []string{"d1"}})
+	s.True(env.IsWorkflowCompleted())
+	s.Error(env.GetWorkflowError())
+
+	resp, err := env.QueryWorkflow(QueryType)
+	s.NoError(err)
+	var state QueryResult
+	s.NoError(resp.Get(&state))
+	s.Equal(WorkflowRunning, state.State)
+}
+
+func (s *migrationWorkflowTestSuite) TestRepairActivity() {
+	controller := gomock.NewController(s.T())
+	defer controller.Finish()
+	domainCache := cache.NewMockDomainCache(controller)
+	domainCache.EXPECT().GetDomainByID("d1-id").Return(cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: "d1-id", Name: "d1"},
+		&persistence.DomainConfig{Retention: 1},
+		cluster.TestCurrentClusterName,
+		nil,
+	), nil).Times(3)
+
+	sourceManager := &mocks.VisibilityManager{}
+	targetManager := &mocks.VisibilityManager{}
+	defer sourceManager.AssertExpectations(s.T())
+	defer targetManager.AssertExpectations(s.T())
+
+	now := time.Now()
+	closed := newWorkflowExecutionInfo("wf-1", "run-1")
+	closed.CloseStatus = shared.WorkflowExecutionCloseStatusCompleted.Ptr()
+	closed.CloseTime = common.Int64Ptr(now.Add(-time.Hour).UnixNano())
+	open := newWorkflowExecutionInfo("wf-2", "run-2")
+	sourceManager.On("GetClosedWorkflowExecution", mock.MatchedBy(func(request *persistence.GetClosedWorkflowExecutionRequest) bool {
+		return request.Execution.GetWorkflowId() == "wf-1"
+	})).Return(&persistence.GetClosedWorkflowExecutionResponse{Execution: closed}, nil).Once()
+	sourceManager.On("GetClosedWorkflowExecution", mock.Anything).Return(nil, &shared.EntityNotExistsError{}).Twice()
+	sourceManager.On("ListOpenWorkflowExecutionsByWorkflowID", mock.MatchedBy(func(request *persistence.ListWorkflowExecutionsByWorkflowIDRequest) bool {
+		return request.WorkflowID == "wf-2"
+	})).Return(&persistence.ListWorkflowExecutionsResponse{
+		Executions: []*shared.WorkflowExecutionInfo{open},
+	}, nil).Once()
+	sourceManager.On("ListOpenWorkflowExecutionsByWorkflowID", mock.Anything).Return(&persistence.ListWorkflowExecutionsResponse{}, nil).Once()
+
+	targetManager.On("RecordWorkflowExecutionClosed", mock.MatchedBy(func(request *persistence.RecordWorkflowExecutionClosedRequest) bool {
+		return request.Execution.GetWorkflowId() == "wf-1" && request.RetentionSeconds == int64(23*time.Hour/time.Second)
+	})).Return(nil).Once()
+	targetManager.On("GetClosedWorkflowExecution", mock.Anything).Return(nil, &shared.EntityNotExistsError{}).Once()
+	targetManager.On("RecordWorkflowExecutionStarted", mock.MatchedBy(func(request *persistence.RecordWorkflowExecutionStartedRequest) bool {
+		return request.Execution.GetWorkflowId() == "wf-2"
+	})).Return(nil).Once()
+	targetManager.On("DeleteWorkflowExecution", &persistence.VisibilityDeleteWorkflowExecutionRequest{
+		DomainID:   "d1-id",
+		WorkflowID: "wf-3",
+		RunID:      "run-3",
+	}).Return(nil).Once()
+
+	repairQueue := &testRepairQueue{ackLevels: map[string]int{repairConsumerName: 0}}
+	repairQueue.enqueue(s, "wf-0", "run-0")
+	repairQueue.enqueue(s, "wf-1", "run-1")
+	repairQueue.enqueue(s, "wf-2", "run-2")
+	repairQueue.enqueue(s, "wf-3", "run-3")
+
+	migrator := &Migrator{
+		domainCache:   domainCache,
+		sourceManager: sourceManager,
+		targetManager: targetManager,
+		repairQueue:   repairQueue,
+		rateLimiter:   quotas.NewSimpleRateLimiter(1000),
+		timeSource:    clock.NewEventTimeSource().Update(now),
+		logger:        loggerimpl.NewNopLogger(),
+	}
+	env := s.NewTestActivityEnvironment()
+	env.SetWorkerOptions(worker.Options{
+		BackgroundActivityContext: context.WithValue(context.Background(), migratorContextKey, migrator),
+	})
+	resp, err := env.ExecuteActivity(repairActivityName, 2)
+	s.NoError(err)
+	var result RepairActivityResult
+	s.NoError(resp.Get(&result))
+	s.Equal(RepairActivityResult{RepairedWorkflows: 3}, result)
+	s.Equal(3, repairQueue.ackLevels[repairConsumerName])
+	s.Empty(repairQueue.messages)
+}
+
+func (q *testRepairQueue) enqueue(s *migrationWorkflowTestSuite, workflowID, runID string) {
+	payload, err := json.Marshal(&persistence.VisibilityMigrationRepairMessage{
+		DomainID:   "d1-id",
+		WorkflowID: workflowID,
+		RunID:      runID,
+	})
+	s.NoError(err)
+	q.messages = append(q.messages, &persistence.QueueMessage{ID: len(q.messages), Payload: payload})
+}
+
+func (q *testRepairQueue) ReadMessages(lastMessageID int, maxCount int) ([]*persistence.QueueMessage, error) {
+	var result []*persistence.QueueMessage
+	for _, message := range q.messages {
+		if message.ID > lastMessageID && len(result) < maxCount {
+			result = append(result, message)
+		}
+	}
+	return result, nil
+}
+
+func (q *testRepairQueue) DeleteMessagesBefore(messageID int) error {
+	var remaining []*persistence.QueueMessage
+	for _, message := range q.messages {
+		if message.ID >= messageID {
+			remaining = append(remaining, message)
+		}
+	}
+	q.messages = remaining
+	return nil
+}
+
+func (q *testRepairQueue) UpdateAckLevel(messageID int, clusterName string) error {
+	q.ackLevels[clusterName] = messageID
+	return nil
+}
+
+func (q *testRepairQueue) GetAckLevels() (map[string]int, error) {
+	return q.ackLevels, nil
+}
+
+func newWorkflowExecutionInfo(workflowID, runID string) *shared.WorkflowExecutionInfo {
+	return &shared.WorkflowExecutionInfo{
+		Execution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(workflowID),
+			RunId:      common.StringPtr(runID),
+		},
+		Type:      &shared.WorkflowType{Name: common.StringPtr("type")},
+		StartTime: common.Int64Ptr(1),
+	}
+}
@@ -20,7 +20,14 @@
 
 package cli
 
-import "github.com/urfave/cli"
+import (
+	"time"
+
+	"github.com/urfave/cli"
+
+	"github.com/uber/cadence/service/worker/failovermanager"
+	"github.com/uber/cadence/service/worker/visibilitymigration"
+)
 
 func newAdminWorkflowCommands() []cli.Command {
 	return []cli.Command{
@@ -303,6 +310,20 @@ func newAdminDomainCommands() []cli.Command {
 				newDomainCLI(c, true).DescribeDomain(c)
 			},
 		},
+		{
+			Name:    "promote",
+			Aliases: []string{"pr"},
+			Usage:   "Start a job to promote the local domain to a global domain and replicate its open workflows",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagClustersWithAlias,
+					Usage: "Optional clusters the domain is replicated to, all clusters are used if not set (format: --clusters <cluster1> <cluster2>)",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminPromoteDomain(c)
+			},
+		},
 		{
 			Name:    "getdomainidorname",
 			Aliases: []string{"getdn"},
@@ -757,6 +778,73 @@ func newAdminTaskListCommands() []cli.Command {
 				AdminDescribeTaskList(c)
 			},
 		},
+		{
+			Name:  "drain",
+			Usage: "Forward new tasks of a tasklist to a target tasklist, or reject them when no target is given, until undrained",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagTaskListWithAlias,
+					Usage: "TaskList to drain",
+				},
+				cli.StringFlag{
+					Name:  FlagTaskListTypeWithAlias,
+					Value: "decision",
+					Usage: "Optional TaskList type [decision|activity]",
+				},
+				cli.StringFlag{
+					Name:  FlagTargetTaskListWithAlias,
+					Usage: "Optional TaskList receiving the new tasks, new tasks are rejected when not set",
+				},
+				cli.BoolFlag{
+					Name:  FlagWaitForDrain,
+					Usage: "Wait until the backlog of the drained tasklist reaches zero",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminDrainTaskList(c)
+			},
+		},
+		{
+			Name:  "undrain",
+			Usage: "Stop draining a tasklist so that it accepts new tasks again",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagTaskListWithAlias,
+					Usage: "TaskList to undrain",
+				},
+				cli.StringFlag{
+					Name:  FlagTaskListTypeWithAlias,
+					Value: "decision",
+					Usage: "Optional TaskList type [decision|activity]",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminUndrainTaskList(c)
+			},
+		},
+		{
+			Name:    "drain-status",
+			Aliases: []string{"ds"},
+			Usage:   "Show whether a tasklist is draining and the size of its remaining backlog",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagTaskListWithAlias,
+					Usage: "TaskList description",
+				},
+				cli.StringFlag{
+					Name:  FlagTaskListTypeWithAlias,
+					Value: "decision",
+					Usage: "Optional TaskList type [decision|activity]",
+				},
+				cli.BoolFlag{
+					Name:  FlagWaitForDrain,
+					Usage: "Wait until the backlog of the drained tasklist reaches zero",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminDrainTaskListStatus(c)
+			},
+		},
 	}
 }
 
@@ -774,7 +862,7 @@ func newAdminClusterCommands() []cli.Command {
 				cli.IntFlag{
 					Name:  FlagSearchAttributesType,
 					Value: -1,
-					Usage: "Search Attribute value type. [0:String, 1:Keyword, 2:Int, 3:Double, 4:Bool, 5:Datetime]",
+					Usage: "Search Attribute value type. [0:String, 1:Keyword, 2:Int, 3:Double, 4:Bool, 5:Datetime, 6:KeywordList, 7:DatetimeList]",
 				},
 				cli.StringFlag{
 					Name:  FlagSecurityTokenWithAlias,
@@ -785,5 +873,347 @@ func newAdminClusterCommands() []cli.Command {
 				AdminAddSearchAttribute(c)
 			},
 		},
+		{
+			Name:    "update-search-attr",
+			Aliases: []string{"usa"},
+			Usage:   "deprecate or remove search attributes, and add or remove their aliases",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagDeprecateSearchAttributes,
+					Usage: "Search Attribute keys to be deprecated, in the format of key1,key2",
+				},
+				cli.StringFlag{
+					Name:  FlagRemoveSearchAttributes,
+					Usage: "Search Attribute keys to be removed, in the format of key1,key2",
+				},
+				cli.StringFlag{
+					Name:  FlagAddSearchAttributeAliases,
+					Usage: "Aliases to be added, in the format of alias1:key1,alias2:key2",
+				},
+				cli.StringFlag{
+					Name:  FlagRemoveSearchAttributeAliases,
+					Usage: "Aliases to be removed, in the format of alias1,alias2",
+				},
+				cli.StringFlag{
+					Name:  FlagSecurityTokenWithAlias,
+					Usage: "Optional token for security check",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminUpdateSearchAttributes(c)
+			},
+		},
+		{
+			Name:    "describe-search-attr",
+			Aliases: []string{"dsa"},
+			Usage:   "describe whitelisted search attributes with their status and aliases",
+			Action: func(c *cli.Context) {
+				AdminDescribeSearchAttributes(c)
+			},
+		},
+		{
+			Name:    "replication-status",
+			Aliases: []string{"rs"},
+			Usage:   "Describe the replication lag and health of this cluster for the tasks from a source cluster",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagSourceClusterWithAlias,
+					Usage: "The cluster the replication tasks are fetched from",
+				},
+				cli.IntFlag{
+					Name:  FlagShardIDWithAlias,
+					Usage: "Optional ShardID, all shards are described when not set",
+				},
+				cli.BoolFlag{
+					Name:  FlagShowDetailWithAlias,
+					Usage: "Optional show all shards instead of only the lagging or unhealthy ones",
+				},
+				cli.BoolFlag{
+					Name:  FlagPrintJSONWithAlias,
+					Usage: "Optional print the raw status in json format",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminDescribeReplication(c)
+			},
+		},
+		{
+			Name:    "verify-replication",
+			Aliases: []string{"vr"},
+			Usage:   "Start a job to compare the executions of a global domain between this cluster and a remote cluster",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagRemoteClusterWithAlias,
+					Usage: "The cluster this cluster is compared with",
+				},
+				cli.BoolFlag{
+					Name:  FlagRepair,
+					Usage: "Optional rereplicate the events missing in this cluster from the remote cluster",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminVerifyReplication(c)
+			},
+		},
+	}
+}
+
+func newAdminDLQCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:    "read",
+			Aliases: []string{"r"},
+			Usage:   "Read DLQ Messages",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagDLQTypeWithAlias,
+					Value: "replication",
+					Usage: "Optional type of DLQ to manage, options: replication",
+				},
+				cli.StringFlag{
+					Name:  FlagSourceClusterWithAlias,
+					Usage: "The cluster the failed replication tasks were fetched from",
+				},
+				cli.IntFlag{
+					Name:  FlagShardIDWithAlias,
+					Usage: "ShardID",
+				},
+				cli.IntFlag{
+					Name:  FlagMaxMessageCountWithAlias,
+					Usage: "Optional max message count to read, all messages up to last message ID are read when not set",
+				},
+				cli.Int64Flag{
+					Name:  FlagLastMessageIDWithAlias,
+					Usage: "Optional inclusive last message ID, the end of the DLQ is used when not set",
+				},
+				cli.IntFlag{
+					Name:  FlagPageSizeWithAlias,
+					Value: defaultDLQPageSize,
+					Usage: "Optional page size of each read",
+				},
+				cli.StringFlag{
+					Name:  FlagOutputFilenameWithAlias,
+					Usage: "Optional output file name, stdout is used when not set",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminGetDLQMessages(c)
+			},
+		},
+		{
+			Name:    "purge",
+			Aliases: []string{"p"},
+			Usage:   "Delete DLQ messages with equal or smaller ids than the provided task id",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagDLQTypeWithAlias,
+					Value: "replication",
+					Usage: "Optional type of DLQ to manage, options: replication",
+				},
+				cli.StringFlag{
+					Name:  FlagSourceClusterWithAlias,
+					Usage: "The cluster the failed replication tasks were fetched from",
+				},
+				cli.IntFlag{
+					Name:  FlagShardIDWithAlias,
+					Usage: "ShardID",
+				},
+				cli.Int64Flag{
+					Name:  FlagLastMessageIDWithAlias,
+					Usage: "Optional inclusive last message ID, the end of the DLQ is used when not set",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminPurgeDLQMessages(c)
+			},
+		},
+		{
+			Name:    "merge",
+			Aliases: []string{"m"},
+			Usage:   "Merge DLQ messages with equal or smaller ids than the provided task id",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagDLQTypeWithAlias,
+					Value: "replication",
+					Usage: "Optional type of DLQ to manage, options: replication",
+				},
+				cli.StringFlag{
+					Name:  FlagSourceClusterWithAlias,
+					Usage: "The cluster the failed replication tasks were fetched from",
+				},
+				cli.IntFlag{
+					Name:  FlagShardIDWithAlias,
+					Usage: "ShardID",
+				},
+				cli.Int64Flag{
+					Name:  FlagLastMessageIDWithAlias,
+					Usage: "Optional inclusive last message ID, the end of the DLQ is used when not set",
+				},
+				cli.IntFlag{
+					Name:  FlagPageSizeWithAlias,
+					Value: defaultDLQPageSize,
+					Usage: "Optional page size of each merge",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminMergeDLQMessages(c)
+			},
+		},
+	}
+}
+
+func newAdminFailoverCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:    "start",
+			Aliases: []string{"s"},
+			Usage:   "Start a job to fail over the global domains active in the source cluster to the target cluster",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagSourceClusterWithAlias,
+					Usage: "The cluster the domains are currently active in",
+				},
+				cli.StringFlag{
+					Name:  FlagTargetClusterWithAlias,
+					Usage: "The cluster the domains are failed over to",
+				},
+				cli.StringFlag{
+					Name:  FlagDomainListWithAlias,
+					Usage: "Optional comma separated names of the domains to fail over, all eligible domains are failed over if not set",
+				},
+				cli.StringFlag{
+					Name:  FlagReasonWithAlias,
+					Usage: "Optional reason of the failover, recorded in the failover history of every domain",
+				},
+				cli.IntFlag{
+					Name:  FlagBatchSizeWithAlias,
+					Value: failovermanager.DefaultBatchFailoverSize,
+					Usage: "Optional number of domains failed over in one batch",
+				},
+				cli.IntFlag{
+					Name:  FlagBatchWaitTimeWithAlias,
+					Value: int(failovermanager.DefaultBatchFailoverWaitTime / time.Second),
+					Usage: "Optional wait time in seconds between two batches",
+				},
+				cli.BoolFlag{
+					Name:  FlagGracefulWithAlias,
+					Usage: "Drain every domain before handing it over, only allowed when the job runs in the source cluster",
+				},
+				cli.IntFlag{
+					Name:  FlagFailoverTimeoutWithAlias,
+					Value: defaultGracefulFailoverTimeoutInSeconds,
+					Usage: "Optional timeout of the graceful failover of every domain",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminFailoverStart(c)
+			},
+		},
+		{
+			Name:    "query",
+			Aliases: []string{"q"},
+			Usage:   "Query the progress of the failover job",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "Optional RunID of the failover job, the latest job is queried if not set",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminFailoverQuery(c)
+			},
+		},
+		{
+			Name:    "pause",
+			Aliases: []string{"p"},
+			Usage:   "Pause the failover job before its next batch",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "Optional RunID of the failover job",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminFailoverSignal(c, failovermanager.PauseSignal)
+			},
+		},
+		{
+			Name:    "resume",
+			Aliases: []string{"r"},
+			Usage:   "Resume the paused failover job",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "Optional RunID of the failover job",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminFailoverSignal(c, failovermanager.ResumeSignal)
+			},
+		},
+		{
+			Name:    "abort",
+			Aliases: []string{"a"},
+			Usage:   "Abort the failover job, domains already failed over are not failed back",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "Optional RunID of the failover job",
+				},
+				cli.StringFlag{
+					Name:  FlagReasonWithAlias,
+					Usage: "Reason to abort the failover job",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminFailoverAbort(c)
+			},
+		},
+	}
+}
+
+func newAdminVisibilityMigrationCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:    "start",
+			Aliases: []string{"s"},
+			Usage:   "Start a job to copy the visibility records of the domains to the visibility migration target store",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagDomainListWithAlias,
+					Usage: "Comma separated names of the domains to migrate",
+				},
+				cli.StringFlag{
+					Name:  FlagEarliestTimeWithAlias,
+					Usage: "Optional earliest start time of the migrated workflows, supported formats are '2006-01-02T15:04:05+07:00' and raw UnixNano",
+				},
+				cli.StringFlag{
+					Name:  FlagLatestTimeWithAlias,
+					Usage: "Optional latest start time of the migrated workflows, default to the start time of the job",
+				},
+				cli.IntFlag{
+					Name:  FlagPageSizeWithAlias,
+					Value: visibilitymigration.DefaultPageSize,
+					Usage: "Optional number of visibility records read from the source store in one page",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminVisibilityMigrationStart(c)
+			},
+		},
+		{
+			Name:    "query",
+			Aliases: []string{"q"},
+			Usage:   "Query the progress of the visibility migration job",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "Optional RunID of the visibility migration job, the latest job is queried if not set",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminVisibilityMigrationQuery(c)
+			},
+		},
 	}
 }
@@ -24,28 +24,41 @@ import (
 	"bufio"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/fatih/color"
+	"github.com/olekukonko/tablewriter"
 	"github.com/urfave/cli"
+	cclient "go.uber.org/cadence/client"
 
 	"github.com/uber/cadence/.gen/go/admin"
+	"github.com/uber/cadence/.gen/go/replicator"
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/service/worker/scanner"
+	"github.com/uber/cadence/service/worker/scanner/replication"
 )
 
 // AdminAddSearchAttribute to whitelist search attribute
 func AdminAddSearchAttribute(c *cli.Context) {
 	key := getRequiredOption(c, FlagSearchAttributesKey)
 	valType := getRequiredIntOption(c, FlagSearchAttributesType)
-	if valType < 0 || valType >= 5 {
+	if valType < 0 || valType > 7 {
 		ErrorAndExit("Unknown Search Attributes value type.", nil)
 	}
 
+	domain := c.GlobalString(FlagDomain)
+	registry := "cluster"
+	if domain != "" {
+		registry = fmt.Sprintf("domain %s", domain)
+	}
+
 	// ask user for confirmation
 	reader := bufio.NewReader(os.Stdin)
-	fmt.Printf("Are you trying to add key [%s] with Type [%s]? Y/N\n",
-		color.YellowString(key), color.YellowString(intValTypeToString(valType)))
+	fmt.Printf("Are you trying to add key [%s] with Type [%s] to %s? Y/N\n",
+		color.YellowString(key), color.YellowString(intValTypeToString(valType)), color.YellowString(registry))
 	text, _ := reader.ReadString('\n')
 	textLower := strings.ToLower(strings.TrimRight(text, "\n"))
 	if textLower != "y" && textLower != "yes" {
@@ -61,6 +74,9 @@ func AdminAddSearchAttribute(c *cli.Context) {
 		},
 		SecurityToken: common.StringPtr(c.String(FlagSecurityToken)),
 	}
+	if domain != "" {
+		request.Domain = common.StringPtr(domain)
+	}
 
 	err := adminClient.AddSearchAttribute(ctx, request)
 	if err != nil {
@@ -69,6 +85,78 @@ func AdminAddSearchAttribute(c *cli.Context) {
 	fmt.Println("Success")
 }
 
+// AdminUpdateSearchAttributes deprecates or removes search attributes, and adds or removes their aliases
+func AdminUpdateSearchAttributes(c *cli.Context) {
+	request := &admin.UpdateSearchAttributesRequest{
+		DeprecateKeys: splitSearchAttributeKeys(c.String(FlagDeprecateSearchAttributes)),
+		RemoveKeys:    splitSearchAttributeKeys(c.String(FlagRemoveSearchAttributes)),
+		RemoveAliases: splitSearchAttributeKeys(c.String(FlagRemoveSearchAttributeAliases)),
+		SecurityToken: common.StringPtr(c.String(FlagSecurityToken)),
+	}
+	if c.IsSet(FlagAddSearchAttributeAliases) {
+		aliases, err := parseSearchAttributeAliases(c.String(FlagAddSearchAttributeAliases))
+		if err != nil {
+			ErrorAndExit("Invalid search attribute aliases.", err)
+		}
+		request.AddAliases = aliases
+	}
+	if len(request.DeprecateKeys) == 0 && len(request.RemoveKeys) == 0 &&
+		len(request.AddAliases) == 0 && len(request.RemoveAliases) == 0 {
+		ErrorAndExit("No search attribute update is provided.", nil)
+	}
+	if domain := c.GlobalString(FlagDomain); domain != "" {
+		request.Domain = common.StringPtr(domain)
+	}
+
+	adminClient := cFactory.ServerAdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	err := adminClient.UpdateSearchAttributes(ctx, request)
+	if err != nil {
+		ErrorAndExit("Update search attributes failed.", err)
+	}
+	fmt.Println("Success")
+}
+
+// AdminDescribeSearchAttributes describes the whitelisted search attributes with their status and aliases
+func AdminDescribeSearchAttributes(c *cli.Context) {
+	request := &admin.DescribeSearchAttributesRequest{}
+	if domain := c.GlobalString(FlagDomain); domain != "" {
+		request.Domain = common.StringPtr(domain)
+	}
+
+	adminClient := cFactory.ServerAdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := adminClient.DescribeSearchAttributes(ctx, request)
+	if err != nil {
+		ErrorAndExit("Describe search attributes failed.", err)
+	}
+	prettyPrintJSONObject(resp)
+}
+
+func splitSearchAttributeKeys(keys string) []string {
+	var result []string
+	for _, key := range strings.Split(keys, ",") {
+		if key = strings.TrimSpace(key); key != "" {
+			result = append(result, key)
+		}
+	}
+	return result
+}
+
+func parseSearchAttributeAliases(aliases string) (map[string]string, error) {
+	result := make(map[string]string)
+	for _, kv := range splitSearchAttributeKeys(aliases) {
+		parts := strings.Split(kv, ":")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("aliases format error. It must be alias1:key1,alias2:key2,...,aliasn:keyn")
+		}
+		result[strings.TrimSpace(parts[0])] = strings.TrimSpace(parts[1])
+	}
+	return result, nil
+}
+
 func intValTypeToString(valType int) string {
 	switch valType {
 	case 0:
@@ -83,7 +171,188 @@ func intValTypeToString(valType int) string {
 		return "Bool"
 	case 5:
 		return "Datetime"
+	case 6:
+		return "KeywordList"
+	case 7:
+		return "DatetimeList"
 	default:
 		return ""
 	}
 }
+
+// AdminDescribeReplication describes the replication lag and health of this cluster
+func AdminDescribeReplication(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	sourceCluster := getRequiredOption(c, FlagSourceCluster)
+	domain := c.GlobalString(FlagDomain)
+
+	request := &replicator.DescribeReplicationRequest{
+		SourceCluster: common.StringPtr(sourceCluster),
+	}
+	if c.IsSet(FlagShardID) {
+		request.ShardIDs = []int32{int32(c.Int(FlagShardID))}
+	}
+	if domain != "" {
+		request.Domain = common.StringPtr(domain)
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := adminClient.DescribeReplication(ctx, request)
+	if err != nil {
+		ErrorAndExit("Failed to describe replication.", err)
+	}
+
+	if c.Bool(FlagPrintJSON) {
+		prettyPrintJSONObject(resp)
+		return
+	}
+
+	var maxTaskIDLag, maxWallClockLag, totalDLQSize int64
+	var laggingShards, unhealthyShards []*replicator.ShardReplicationStatus
+	var lastError *replicator.ReplicationError
+	for _, shard := range resp.Shards {
+		if shard.GetTaskIDLag() > maxTaskIDLag {
+			maxTaskIDLag = shard.GetTaskIDLag()
+		}
+		if shard.GetWallClockLagInMillis() > maxWallClockLag {
+			maxWallClockLag = shard.GetWallClockLagInMillis()
+		}
+		totalDLQSize += shard.GetDlqSize()
+		if shard.GetTaskIDLag() > 0 {
+			laggingShards = append(laggingShards, shard)
+		}
+		if shard.GetDlqSize() > 0 || shard.LastError != nil {
+			unhealthyShards = append(unhealthyShards, shard)
+		}
+		if shard.LastError != nil && shard.LastError.GetTimestamp() > lastError.GetTimestamp() {
+			lastError = shard.LastError
+		}
+	}
+
+	fmt.Printf("SourceCluster: %v\n", resp.GetSourceCluster())
+	fmt.Printf("Shards: %v described, %v missing %v\n", len(resp.Shards), len(resp.MissingShardIDs), resp.MissingShardIDs)
+	fmt.Printf("LaggingShards: %v\n", len(laggingShards))
+	fmt.Printf("MaxTaskIDLag: %v\n", maxTaskIDLag)
+	fmt.Printf("MaxWallClockLag: %v\n", time.Duration(maxWallClockLag)*time.Millisecond)
+	fmt.Printf("DLQSize: %v\n", totalDLQSize)
+	if lastError != nil {
+		fmt.Printf("LastError: %v\n", replicationErrorToString(lastError))
+	}
+	if domain != "" {
+		printDomainReplicationStatus(domain, resp.Shards, maxWallClockLag)
+	}
+
+	shards := resp.Shards
+	if !c.Bool(FlagShowDetail) {
+		shards = nil
+		for _, shard := range resp.Shards {
+			if shard.GetTaskIDLag() > 0 || shard.GetDlqSize() > 0 || shard.LastError != nil {
+				shards = append(shards, shard)
+			}
+		}
+	}
+	if len(shards) == 0 {
+		return
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	header := []string{"Shard", "Processed", "SourceMaxReadLevel", "TaskIDLag", "WallClockLag", "DLQSize", "LastError"}
+	headerColor := []tablewriter.Colors{tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue}
+	table.SetHeader(header)
+	table.SetHeaderColor(headerColor...)
+	table.SetHeaderLine(false)
+	for _, shard := range shards {
+		table.Append([]string{
+			strconv.Itoa(int(shard.GetShardID())),
+			strconv.FormatInt(shard.GetLastProcessedMessageID(), 10),
+			strconv.FormatInt(shard.GetSourceMaxReadLevel(), 10),
+			strconv.FormatInt(shard.GetTaskIDLag(), 10),
+			(time.Duration(shard.GetWallClockLagInMillis()) * time.Millisecond).String(),
+			strconv.FormatInt(shard.GetDlqSize(), 10),
+			replicationErrorToString(shard.LastError),
+		})
+	}
+	table.Render()
+}
+
+// printDomainReplicationStatus prints the domain status aggregated over shards, the lag of a domain
+// is bounded by the lag of the shards since tasks of all domains share the same per shard queue
+func printDomainReplicationStatus(
+	domain string,
+	shards []*replicator.ShardReplicationStatus,
+	maxWallClockLag int64,
+) {
+
+	var appliedTaskCount, failedTaskCount, lastAppliedTimestamp int64
+	var lastError *replicator.ReplicationError
+	for _, shard := range shards {
+		for _, status := range shard.Domains {
+			appliedTaskCount += status.GetAppliedTaskCount()
+			failedTaskCount += status.GetFailedTaskCount()
+			if status.GetLastAppliedTimestamp() > lastAppliedTimestamp {
+				lastAppliedTimestamp = status.GetLastAppliedTimestamp()
+			}
+			if status.LastError != nil && status.LastError.GetTimestamp() > lastError.GetTimestamp() {
+				lastError = status.LastError
+			}
+		}
+	}
+
+	fmt.Printf("Domain: %v\n", domain)
+	fmt.Printf("  WallClockLagUpperBound: %v\n", time.Duration(maxWallClockLag)*time.Millisecond)
+	fmt.Printf("  AppliedTasks: %v\n", appliedTaskCount)
+	fmt.Printf("  FailedTasks: %v\n", failedTaskCount)
+	if lastAppliedTimestamp != 0 {
+		fmt.Printf("  LastAppliedTime: %v\n", convertTime(lastAppliedTimestamp, false))
+	}
+	if lastError != nil {
+		fmt.Printf("  LastError: %v\n", replicationErrorToString(lastError))
+	}
+}
+
+func replicationErrorToString(replicationError *replicator.ReplicationError) string {
+	if replicationError == nil {
+		return ""
+	}
+	return fmt.Sprintf("%v task %v of %v/%v/%v: %v",
+		convertTime(replicationError.GetTimestamp(), false),
+		replicationError.GetTaskID(),
+		replicationError.GetDomainID(),
+		replicationError.GetWorkflowID(),
+		replicationError.GetRunID(),
+		replicationError.GetMessage(),
+	)
+}
+
+// AdminVerifyReplication starts a replication verifier job for a global domain
+func AdminVerifyReplication(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	params := replication.VerifierParams{
+		Domain:        domain,
+		RemoteCluster: getRequiredOption(c, FlagRemoteCluster),
+		Repair:        c.Bool(FlagRepair),
+	}
+
+	client := cclient.NewClient(cFactory.ClientFrontendClient(c), common.SystemLocalDomainName, &cclient.Options{})
+	ctx, cancel := newContext(c)
+	defer cancel()
+	options := cclient.StartWorkflowOptions{
+		ID:                           scanner.ReplicationVerifierWFIDPrefix + domain,
+		TaskList:                     scanner.ReplicationVerifierTaskListName,
+		ExecutionStartToCloseTimeout: scanner.ReplicationVerifierWFTimeout,
+		WorkflowIDReusePolicy:        cclient.WorkflowIDReusePolicyAllowDuplicate,
+	}
+	wf, err := client.StartWorkflow(ctx, options, scanner.ReplicationVerifierWFTypeName, params)
+	if err != nil {
+		ErrorAndExit("Failed to start replication verifier job", err)
+	}
+	prettyPrintJSONObject(map[string]interface{}{
+		"msg":        "replication verifier job is started, the mismatches are reported in the workflow result",
+		"domain":     common.SystemLocalDomainName,
+		"workflowID": wf.ID,
+		"runID":      wf.RunID,
+	})
+}
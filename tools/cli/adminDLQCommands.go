@@ -0,0 +1,155 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/urfave/cli"
+
+	"github.com/uber/cadence/.gen/go/replicator"
+	"github.com/uber/cadence/common"
+)
+
+// AdminGetDLQMessages gets DLQ metadata
+func AdminGetDLQMessages(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	dlqType := getRequiredDLQType(c)
+	sourceCluster := getRequiredOption(c, FlagSourceCluster)
+	shardID := getRequiredIntOption(c, FlagShardID)
+	lastMessageID := getLastMessageID(c)
+	remainingMessageCount := common.EndMessageID
+	if c.IsSet(FlagMaxMessageCount) {
+		remainingMessageCount = int64(c.Int(FlagMaxMessageCount))
+	}
+
+	outputFile := getOutputFile(c.String(FlagOutputFilename))
+	defer outputFile.Close()
+
+	var pageToken []byte
+	for remainingMessageCount > 0 {
+		ctx, cancel := newContext(c)
+		resp, err := adminClient.ReadDLQMessages(ctx, &replicator.ReadDLQMessagesRequest{
+			Type:                  dlqType,
+			SourceCluster:         common.StringPtr(sourceCluster),
+			ShardID:               common.Int32Ptr(int32(shardID)),
+			InclusiveEndMessageID: common.Int64Ptr(lastMessageID),
+			MaximumPageSize:       common.Int32Ptr(int32(c.Int(FlagPageSize))),
+			NextPageToken:         pageToken,
+		})
+		cancel()
+		if err != nil {
+			ErrorAndExit("Failed to read dlq messages.", err)
+		}
+
+		for _, task := range resp.GetReplicationTasks() {
+			if remainingMessageCount <= 0 {
+				break
+			}
+			data, err := json.Marshal(task)
+			if err != nil {
+				ErrorAndExit("Failed to encode dlq message.", err)
+			}
+			if _, err := outputFile.WriteString(fmt.Sprintf("%v\n", string(data))); err != nil {
+				ErrorAndExit("Failed to write to output file.", err)
+			}
+			remainingMessageCount--
+		}
+
+		pageToken = resp.GetNextPageToken()
+		if len(pageToken) == 0 {
+			break
+		}
+	}
+}
+
+// AdminPurgeDLQMessages deletes messages from DLQ
+func AdminPurgeDLQMessages(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	dlqType := getRequiredDLQType(c)
+	sourceCluster := getRequiredOption(c, FlagSourceCluster)
+	shardID := getRequiredIntOption(c, FlagShardID)
+	lastMessageID := getLastMessageID(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	if err := adminClient.PurgeDLQMessages(ctx, &replicator.PurgeDLQMessagesRequest{
+		Type:                  dlqType,
+		SourceCluster:         common.StringPtr(sourceCluster),
+		ShardID:               common.Int32Ptr(int32(shardID)),
+		InclusiveEndMessageID: common.Int64Ptr(lastMessageID),
+	}); err != nil {
+		ErrorAndExit("Failed to purge dlq messages.", err)
+	}
+	fmt.Println("Successfully purged DLQ Messages.")
+}
+
+// AdminMergeDLQMessages merges message from DLQ
+func AdminMergeDLQMessages(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	dlqType := getRequiredDLQType(c)
+	sourceCluster := getRequiredOption(c, FlagSourceCluster)
+	shardID := getRequiredIntOption(c, FlagShardID)
+	lastMessageID := getLastMessageID(c)
+
+	request := &replicator.MergeDLQMessagesRequest{
+		Type:                  dlqType,
+		SourceCluster:         common.StringPtr(sourceCluster),
+		ShardID:               common.Int32Ptr(int32(shardID)),
+		InclusiveEndMessageID: common.Int64Ptr(lastMessageID),
+		MaximumPageSize:       common.Int32Ptr(int32(c.Int(FlagPageSize))),
+	}
+
+	for {
+		ctx, cancel := newContext(c)
+		response, err := adminClient.MergeDLQMessages(ctx, request)
+		cancel()
+		if err != nil {
+			ErrorAndExit("Failed to merge dlq messages.", err)
+		}
+
+		if len(response.NextPageToken) == 0 {
+			break
+		}
+		request.NextPageToken = response.NextPageToken
+	}
+	fmt.Println("Successfully merged all messages.")
+}
+
+func getRequiredDLQType(c *cli.Context) *replicator.DLQType {
+	value := getRequiredOption(c, FlagDLQType)
+	switch strings.ToLower(value) {
+	case "replication":
+		return replicator.DLQTypeReplication.Ptr()
+	default:
+		ErrorAndExit("The DLQ type is not supported.", nil)
+	}
+	return nil
+}
+
+func getLastMessageID(c *cli.Context) int64 {
+	if c.IsSet(FlagLastMessageID) {
+		return c.Int64(FlagLastMessageID)
+	}
+	return common.EndMessageID
+}
@@ -0,0 +1,165 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"strings"
+	"time"
+
+	"github.com/urfave/cli"
+	cclient "go.uber.org/cadence/client"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/service/worker/failovermanager"
+)
+
+// AdminFailoverStart starts a bulk failover job
+func AdminFailoverStart(c *cli.Context) {
+	sourceCluster := getRequiredOption(c, FlagSourceCluster)
+	targetCluster := getRequiredOption(c, FlagTargetCluster)
+	reason := c.String(FlagReason)
+
+	var domains []string
+	if c.IsSet(FlagDomainList) {
+		for _, domain := range strings.Split(c.String(FlagDomainList), ",") {
+			if domain = strings.TrimSpace(domain); domain != "" {
+				domains = append(domains, domain)
+			}
+		}
+	}
+	params := failovermanager.FailoverParams{
+		SourceCluster:         sourceCluster,
+		TargetCluster:         targetCluster,
+		Domains:               domains,
+		Reason:                reason,
+		BatchFailoverSize:     c.Int(FlagBatchSize),
+		BatchFailoverWaitTime: time.Duration(c.Int(FlagBatchWaitTime)) * time.Second,
+	}
+	if c.Bool(FlagGraceful) {
+		params.GracefulFailoverTimeout = time.Duration(c.Int(FlagFailoverTimeout)) * time.Second
+	}
+
+	client := getFailoverManagerClient(c)
+	tcCtx, cancel := newContext(c)
+	defer cancel()
+	options := cclient.StartWorkflowOptions{
+		ID:                           failovermanager.WorkflowID,
+		TaskList:                     failovermanager.TaskListName,
+		ExecutionStartToCloseTimeout: failovermanager.WorkflowTimeout,
+		WorkflowIDReusePolicy:        cclient.WorkflowIDReusePolicyAllowDuplicate,
+		Memo: map[string]interface{}{
+			"Reason":   reason,
+			"Operator": getCurrentUserFromEnv(),
+		},
+	}
+	wf, err := client.StartWorkflow(tcCtx, options, failovermanager.WorkflowTypeName, params)
+	if err != nil {
+		ErrorAndExit("Failed to start failover job", err)
+	}
+	prettyPrintJSONObject(map[string]interface{}{
+		"msg":   "failover job is started",
+		"runID": wf.RunID,
+	})
+}
+
+// AdminFailoverQuery prints the progress of a failover job
+func AdminFailoverQuery(c *cli.Context) {
+	client := getFailoverManagerClient(c)
+	tcCtx, cancel := newContext(c)
+	defer cancel()
+	resp, err := client.QueryWorkflow(tcCtx, failovermanager.WorkflowID, c.String(FlagRunID), failovermanager.QueryType)
+	if err != nil {
+		ErrorAndExit("Failed to query failover job", err)
+	}
+	var result failovermanager.QueryResult
+	if err := resp.Get(&result); err != nil {
+		ErrorAndExit("Failed to decode failover job progress", err)
+	}
+	prettyPrintJSONObject(result)
+}
+
+// AdminFailoverSignal pauses or resumes a failover job
+func AdminFailoverSignal(c *cli.Context, signalName string) {
+	client := getFailoverManagerClient(c)
+	tcCtx, cancel := newContext(c)
+	defer cancel()
+	if err := client.SignalWorkflow(tcCtx, failovermanager.WorkflowID, c.String(FlagRunID), signalName, nil); err != nil {
+		ErrorAndExit("Failed to signal failover job", err)
+	}
+	prettyPrintJSONObject(map[string]interface{}{
+		"msg": "failover job is signaled with " + signalName,
+	})
+}
+
+// AdminFailoverAbort terminates a failover job
+func AdminFailoverAbort(c *cli.Context) {
+	client := getFailoverManagerClient(c)
+	tcCtx, cancel := newContext(c)
+	defer cancel()
+	if err := client.TerminateWorkflow(tcCtx, failovermanager.WorkflowID, c.String(FlagRunID), c.String(FlagReason), nil); err != nil {
+		ErrorAndExit("Failed to abort failover job", err)
+	}
+	prettyPrintJSONObject(map[string]interface{}{
+		"msg": "failover job is aborted",
+	})
+}
+
+// AdminPromoteDomain starts a job to promote a local domain to a global domain
+func AdminPromoteDomain(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+
+	var clusters []string
+	if c.IsSet(FlagClusters) {
+		clusters = append(clusters, c.String(FlagClusters))
+		clusters = append(clusters, c.Args()...)
+	}
+	params := failovermanager.PromotionParams{
+		Domain:   domain,
+		Clusters: clusters,
+	}
+
+	client := getFailoverManagerClient(c)
+	tcCtx, cancel := newContext(c)
+	defer cancel()
+	options := cclient.StartWorkflowOptions{
+		ID:                           failovermanager.PromotionWorkflowIDPrefix + domain,
+		TaskList:                     failovermanager.TaskListName,
+		ExecutionStartToCloseTimeout: failovermanager.PromotionWorkflowTimeout,
+		WorkflowIDReusePolicy:        cclient.WorkflowIDReusePolicyAllowDuplicate,
+		Memo: map[string]interface{}{
+			"Operator": getCurrentUserFromEnv(),
+		},
+	}
+	wf, err := client.StartWorkflow(tcCtx, options, failovermanager.PromotionWorkflowTypeName, params)
+	if err != nil {
+		ErrorAndExit("Failed to start domain promotion job", err)
+	}
+	prettyPrintJSONObject(map[string]interface{}{
+		"msg":        "domain promotion job is started",
+		"workflowID": wf.ID,
+		"runID":      wf.RunID,
+	})
+}
+
+func getFailoverManagerClient(c *cli.Context) cclient.Client {
+	svcClient := cFactory.ClientFrontendClient(c)
+	return cclient.NewClient(svcClient, common.SystemLocalDomainName, &cclient.Options{})
+}
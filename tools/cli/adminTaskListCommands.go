@@ -25,10 +25,12 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/olekukonko/tablewriter"
 	"github.com/urfave/cli"
 
+	"github.com/uber/cadence/.gen/go/admin"
 	s "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 )
@@ -64,11 +66,101 @@ func AdminDescribeTaskList(c *cli.Context) {
 	printTaskListStatus(taskListStatus)
 	fmt.Printf("\n")
 
+	if len(response.ActivityTypeLimits) > 0 {
+		printActivityTypeLimits(response.ActivityTypeLimits)
+		fmt.Printf("\n")
+	}
+
 	pollers := response.Pollers
-	if len(pollers) == 0 {
+	inactivePollers := response.InactivePollers
+	if len(pollers) == 0 && len(inactivePollers) == 0 {
 		ErrorAndExit(colorMagenta("No poller for tasklist: "+taskList), nil)
 	}
-	printPollerInfo(pollers, taskListType)
+	if len(pollers) > 0 {
+		printPollerInfo(pollers, taskListType)
+	}
+	if len(inactivePollers) > 0 {
+		fmt.Printf("\n%s\n", colorMagenta("Pollers which stopped polling recently:"))
+		printPollerInfo(inactivePollers, taskListType)
+	}
+}
+
+// AdminDrainTaskList starts draining a task list, new tasks are forwarded to the target task list or rejected
+func AdminDrainTaskList(c *cli.Context) {
+	targetTaskList := c.String(FlagTargetTaskList)
+	response := drainTaskList(c, common.BoolPtr(true), targetTaskList)
+	printDrainTaskListStatus(response)
+	if c.Bool(FlagWaitForDrain) {
+		waitForTaskListDrained(c, response)
+	}
+}
+
+// AdminUndrainTaskList stops draining a task list
+func AdminUndrainTaskList(c *cli.Context) {
+	response := drainTaskList(c, common.BoolPtr(false), "")
+	printDrainTaskListStatus(response)
+}
+
+// AdminDrainTaskListStatus displays the drain state of a task list
+func AdminDrainTaskListStatus(c *cli.Context) {
+	response := drainTaskList(c, nil, "")
+	printDrainTaskListStatus(response)
+	if c.Bool(FlagWaitForDrain) {
+		waitForTaskListDrained(c, response)
+	}
+}
+
+func drainTaskList(c *cli.Context, drain *bool, targetTaskList string) *admin.DrainTaskListResponse {
+	adminClient := cFactory.ServerAdminClient(c)
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	taskList := getRequiredOption(c, FlagTaskList)
+	taskListType := s.TaskListTypeDecision
+	if strings.ToLower(c.String(FlagTaskListType)) == "activity" {
+		taskListType = s.TaskListTypeActivity
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	request := &admin.DrainTaskListRequest{
+		Domain:         common.StringPtr(domain),
+		TaskList:       &s.TaskList{Name: common.StringPtr(taskList)},
+		TaskListType:   &taskListType,
+		Drain:          drain,
+		TargetTaskList: common.StringPtr(targetTaskList),
+	}
+
+	response, err := adminClient.DrainTaskList(ctx, request)
+	if err != nil {
+		ErrorAndExit("Operation DrainTaskList failed.", err)
+	}
+	return response
+}
+
+func waitForTaskListDrained(c *cli.Context, response *admin.DrainTaskListResponse) {
+	if !response.GetDraining() {
+		ErrorAndExit(colorMagenta("Tasklist is not draining."), nil)
+	}
+	for response.GetBacklogCountHint() > 0 {
+		time.Sleep(drainStatusPollInterval)
+		response = drainTaskList(c, nil, "")
+		fmt.Printf("Remaining backlog: %v\n", response.GetBacklogCountHint())
+	}
+	fmt.Println(colorGreen("Backlog of the tasklist reached zero."))
+}
+
+func printDrainTaskListStatus(response *admin.DrainTaskListResponse) {
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	table.SetHeader([]string{"Draining", "Target TaskList", "Backlog Count Hint"})
+	table.SetHeaderLine(false)
+	table.SetHeaderColor(tableHeaderBlue, tableHeaderBlue, tableHeaderBlue)
+	table.Append([]string{
+		strconv.FormatBool(response.GetDraining()),
+		response.GetTargetTaskList(),
+		strconv.FormatInt(response.GetBacklogCountHint(), 10),
+	})
+	table.Render()
 }
 
 func printTaskListStatus(taskListStatus *s.TaskListStatus) {
@@ -88,19 +180,71 @@ func printTaskListStatus(taskListStatus *s.TaskListStatus) {
 	table.Render()
 }
 
+func printActivityTypeLimits(limits []*s.ActivityTypeLimitInfo) {
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	table.SetHeader([]string{"Activity Type", "Dispatch RPS", "Max Outstanding", "Outstanding"})
+	table.SetHeaderLine(false)
+	table.SetHeaderColor(tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue)
+	for _, limit := range limits {
+		rps := "-"
+		if limit.RatePerSecond != nil {
+			rps = strconv.FormatFloat(limit.GetRatePerSecond(), 'f', -1, 64)
+		}
+		maxOutstanding := "-"
+		if limit.MaxOutstanding != nil {
+			maxOutstanding = strconv.Itoa(int(limit.GetMaxOutstanding()))
+		}
+		table.Append([]string{
+			limit.GetActivityType(),
+			rps,
+			maxOutstanding,
+			strconv.Itoa(int(limit.GetOutstanding())),
+		})
+	}
+	table.Render()
+}
+
 func printPollerInfo(pollers []*s.PollerInfo, taskListType s.TaskListType) {
 	table := tablewriter.NewWriter(os.Stdout)
 	table.SetBorder(false)
 	table.SetColumnSeparator("|")
+	header := []string{"Decision Poller Identity"}
 	if taskListType == s.TaskListTypeActivity {
-		table.SetHeader([]string{"Activity Poller Identity", "Last Access Time"})
-	} else {
-		table.SetHeader([]string{"Decision Poller Identity", "Last Access Time"})
+		header = []string{"Activity Poller Identity"}
+	}
+	header = append(header, "Last Access Time", "Client", "Outstanding Polls", "Dispatched", "Completed", "Failed")
+	if taskListType == s.TaskListTypeDecision {
+		header = append(header, "Sticky Hits", "Sticky Misses")
 	}
+	table.SetHeader(header)
 	table.SetHeaderLine(false)
-	table.SetHeaderColor(tableHeaderBlue, tableHeaderBlue)
+	headerColors := make([]tablewriter.Colors, len(header))
+	for i := range headerColors {
+		headerColors[i] = tableHeaderBlue
+	}
+	table.SetHeaderColor(headerColors...)
 	for _, poller := range pollers {
-		table.Append([]string{poller.GetIdentity(), convertTime(poller.GetLastAccessTime(), false)})
+		client := ""
+		if poller.GetClientImpl() != "" {
+			client = fmt.Sprintf("%v@%v", poller.GetClientImpl(), poller.GetClientFeatureVersion())
+		}
+		row := []string{
+			poller.GetIdentity(),
+			convertTime(poller.GetLastAccessTime(), false),
+			client,
+			strconv.Itoa(int(poller.GetOutstandingPollCount())),
+			strconv.FormatInt(poller.GetTasksDispatched(), 10),
+			strconv.FormatInt(poller.GetTasksCompleted(), 10),
+			strconv.FormatInt(poller.GetTasksFailed(), 10),
+		}
+		if taskListType == s.TaskListTypeDecision {
+			row = append(row,
+				strconv.FormatInt(poller.GetStickyCacheHits(), 10),
+				strconv.FormatInt(poller.GetStickyCacheMisses(), 10))
+		}
+		table.Append(row)
 	}
 	table.Render()
 }
@@ -0,0 +1,89 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"strings"
+
+	"github.com/urfave/cli"
+	cclient "go.uber.org/cadence/client"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/service/worker/visibilitymigration"
+)
+
+// AdminVisibilityMigrationStart starts a job to migrate visibility records to the visibility migration target store
+func AdminVisibilityMigrationStart(c *cli.Context) {
+	var domains []string
+	for _, domain := range strings.Split(getRequiredOption(c, FlagDomainList), ",") {
+		if domain = strings.TrimSpace(domain); domain != "" {
+			domains = append(domains, domain)
+		}
+	}
+	params := visibilitymigration.MigrationParams{
+		Domains:           domains,
+		EarliestStartTime: parseTime(c.String(FlagEarliestTime), 0),
+		LatestStartTime:   parseTime(c.String(FlagLatestTime), 0),
+		PageSize:          c.Int(FlagPageSize),
+	}
+
+	client := getVisibilityMigrationClient(c)
+	tcCtx, cancel := newContext(c)
+	defer cancel()
+	options := cclient.StartWorkflowOptions{
+		ID:                           visibilitymigration.WorkflowID,
+		TaskList:                     visibilitymigration.TaskListName,
+		ExecutionStartToCloseTimeout: visibilitymigration.WorkflowTimeout,
+		WorkflowIDReusePolicy:        cclient.WorkflowIDReusePolicyAllowDuplicate,
+		Memo: map[string]interface{}{
+			"Operator": getCurrentUserFromEnv(),
+		},
+	}
+	wf, err := client.StartWorkflow(tcCtx, options, visibilitymigration.WorkflowTypeName, params)
+	if err != nil {
+		ErrorAndExit("Failed to start visibility migration job", err)
+	}
+	prettyPrintJSONObject(map[string]interface{}{
+		"msg":   "visibility migration job is started",
+		"runID": wf.RunID,
+	})
+}
+
+// AdminVisibilityMigrationQuery prints the progress of a visibility migration job
+func AdminVisibilityMigrationQuery(c *cli.Context) {
+	client := getVisibilityMigrationClient(c)
+	tcCtx, cancel := newContext(c)
+	defer cancel()
+	resp, err := client.QueryWorkflow(tcCtx, visibilitymigration.WorkflowID, c.String(FlagRunID), visibilitymigration.QueryType)
+	if err != nil {
+		ErrorAndExit("Failed to query visibility migration job", err)
+	}
+	var result visibilitymigration.QueryResult
+	if err := resp.Get(&result); err != nil {
+		ErrorAndExit("Failed to decode visibility migration job progress", err)
+	}
+	prettyPrintJSONObject(result)
+}
+
+func getVisibilityMigrationClient(c *cli.Context) cclient.Client {
+	svcClient := cFactory.ClientFrontendClient(c)
+	return cclient.NewClient(svcClient, common.SystemLocalDomainName, &cclient.Options{})
+}
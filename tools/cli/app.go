@@ -132,6 +132,23 @@ func NewCliApp() *cli.App {
 					Usage:       "Run admin operation on cluster",
 					Subcommands: newAdminClusterCommands(),
 				},
+				{
+					Name:        "dlq",
+					Usage:       "Run admin operation on DLQ",
+					Subcommands: newAdminDLQCommands(),
+				},
+				{
+					Name:        "failover",
+					Aliases:     []string{"fo"},
+					Usage:       "Run bulk failover of global domains",
+					Subcommands: newAdminFailoverCommands(),
+				},
+				{
+					Name:        "visibility_migration",
+					Aliases:     []string{"vm"},
+					Usage:       "Run migration of visibility records between visibility stores",
+					Subcommands: newAdminVisibilityMigrationCommands(),
+				},
 			},
 		},
 		{
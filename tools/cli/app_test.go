@@ -380,6 +380,22 @@ func (s *cliAppSuite) TestQueryWorkflow_Failed() {
 	s.Equal(1, errorCode)
 }
 
+func (s *cliAppSuite) TestStartExportJob() {
+	resp := &shared.StartWorkflowExecutionResponse{RunId: common.StringPtr(uuid.New())}
+	s.clientFrontendClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any(), callOptions...).Return(resp, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "export", "start", "-q", "WorkflowType = 'test'", "--export_uri", "file:///tmp/export.csv", "--export_format", "csv"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestDescribeExportJob() {
+	resp := &shared.QueryWorkflowResponse{
+		QueryResult: []byte(`{"State":"running","ExportedWorkflows":10,"TotalEstimate":20}`),
+	}
+	s.clientFrontendClient.EXPECT().QueryWorkflow(gomock.Any(), gomock.Any(), callOptions...).Return(resp, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "export", "describe", "--jid", "job-id"})
+	s.Nil(err)
+}
+
 var (
 	closeStatus = shared.WorkflowExecutionCloseStatusCompleted
 
@@ -483,14 +499,32 @@ func (s *cliAppSuite) TestListArchivedWorkflow() {
 }
 
 func (s *cliAppSuite) TestCountWorkflow() {
-	resp := &shared.CountWorkflowExecutionsResponse{}
-	s.clientFrontendClient.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any(), callOptions...).Return(resp, nil)
+	resp := &serverShared.CountWorkflowExecutionsResponse{}
+	s.serverFrontendClient.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).Return(resp, nil)
 	err := s.app.Run([]string{"", "--do", domainName, "workflow", "count"})
 	s.Nil(err)
 
-	s.clientFrontendClient.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any(), callOptions...).Return(resp, nil)
+	s.serverFrontendClient.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).Return(resp, nil)
 	err = s.app.Run([]string{"", "--do", domainName, "workflow", "count", "-q", "'CloseTime = missing'"})
 	s.Nil(err)
+
+	resp = &serverShared.CountWorkflowExecutionsResponse{
+		Count: common.Int64Ptr(3),
+		Groups: []*serverShared.CountWorkflowExecutionsGroup{
+			{GroupValues: map[string][]byte{"WorkflowType": []byte(`"wt1"`)}, Count: common.Int64Ptr(2)},
+			{GroupValues: map[string][]byte{"WorkflowType": []byte(`"wt2"`)}, Count: common.Int64Ptr(1)},
+		},
+	}
+	s.serverFrontendClient.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).Return(resp, nil)
+	err = s.app.Run([]string{"", "--do", domainName, "workflow", "count", "-q", "group by WorkflowType"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestFormatCountGroupValues() {
+	s.Equal(`CloseStatus=1, WorkflowType="wt"`, formatCountGroupValues(map[string][]byte{
+		"WorkflowType": []byte(`"wt"`),
+		"CloseStatus":  []byte(`1`),
+	}))
 }
 
 var describeTaskListResponse = &shared.DescribeTaskListResponse{
@@ -525,6 +559,25 @@ func (s *cliAppSuite) TestAdminAddSearchAttribute() {
 	s.Nil(err)
 }
 
+func (s *cliAppSuite) TestAdminUpdateSearchAttributes() {
+	s.serverAdminClient.EXPECT().UpdateSearchAttributes(gomock.Any(), &admin.UpdateSearchAttributesRequest{
+		Domain:        common.StringPtr(domainName),
+		DeprecateKeys: []string{"key1", "key2"},
+		AddAliases:    map[string]string{"alias": "key3"},
+		SecurityToken: common.StringPtr(""),
+	}).Return(nil)
+	err := s.app.Run([]string{"", "--do", domainName, "admin", "cl", "usa", "--deprecate_search_attr", "key1, key2", "--add_search_attr_alias", "alias:key3"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminDescribeSearchAttributes() {
+	s.serverAdminClient.EXPECT().DescribeSearchAttributes(gomock.Any(), &admin.DescribeSearchAttributesRequest{
+		Domain: common.StringPtr(domainName),
+	}).Return(&admin.DescribeSearchAttributesResponse{}, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "admin", "cl", "dsa"})
+	s.Nil(err)
+}
+
 func (s *cliAppSuite) TestDescribeTaskList() {
 	resp := describeTaskListResponse
 	s.clientFrontendClient.EXPECT().DescribeTaskList(gomock.Any(), gomock.Any(), callOptions...).Return(resp, nil)
@@ -44,11 +44,14 @@ const (
 	defaultContextTimeout                        = defaultContextTimeoutInSeconds * time.Second
 	defaultContextTimeoutForLongPoll             = 2 * time.Minute
 	defaultContextTimeoutForListArchivedWorkflow = 3 * time.Minute
-
-	defaultDecisionTimeoutInSeconds = 10
-	defaultPageSizeForList          = 500
-	defaultPageSizeForScan          = 2000
-	defaultWorkflowIDReusePolicy    = s.WorkflowIdReusePolicyAllowDuplicateFailedOnly
+	drainStatusPollInterval                      = 5 * time.Second
+
+	defaultDecisionTimeoutInSeconds         = 10
+	defaultPageSizeForList                  = 500
+	defaultPageSizeForScan                  = 2000
+	defaultDLQPageSize                      = 1000
+	defaultGracefulFailoverTimeoutInSeconds = 300
+	defaultWorkflowIDReusePolicy            = s.WorkflowIdReusePolicyAllowDuplicateFailedOnly
 
 	workflowStatusNotSet = -1
 	showErrorStackEnv    = `CADENCE_CLI_SHOW_STACKS`
@@ -26,6 +26,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/olekukonko/tablewriter"
@@ -144,6 +145,7 @@ func (d *domainCLIImpl) RegisterDomain(c *cli.Context) {
 		VisibilityArchivalStatus:               archivalStatus(c, FlagVisibilityArchivalStatus),
 		VisibilityArchivalURI:                  common.StringPtr(c.String(FlagVisibilityArchivalURI)),
 		IsGlobalDomain:                         isGlobalDomainPtr,
+		ReplicationFilter:                      replicationFilter(c),
 	}
 
 	ctx, cancel := newContext(c)
@@ -270,7 +272,8 @@ func (d *domainCLIImpl) UpdateDomain(c *cli.Context) {
 			BadBinaries:                            binBinaries,
 		}
 		replicationConfig := &shared.DomainReplicationConfiguration{
-			Clusters: clusters,
+			Clusters:          clusters,
+			ReplicationFilter: replicationFilter(c),
 		}
 		updateRequest = &shared.UpdateDomainRequest{
 			Name:                     common.StringPtr(domainName),
@@ -295,6 +298,44 @@ func (d *domainCLIImpl) UpdateDomain(c *cli.Context) {
 	}
 }
 
+// FailoverDomain fails over a domain to another cluster
+func (d *domainCLIImpl) FailoverDomain(c *cli.Context) {
+	domainName := getRequiredGlobalOption(c, FlagDomain)
+	activeCluster := getRequiredOption(c, FlagActiveClusterName)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	updateRequest := &shared.UpdateDomainRequest{
+		Name: common.StringPtr(domainName),
+		ReplicationConfiguration: &shared.DomainReplicationConfiguration{
+			ActiveClusterName: common.StringPtr(activeCluster),
+		},
+		SecurityToken: common.StringPtr(c.String(FlagSecurityToken)),
+	}
+	if c.IsSet(FlagReason) {
+		updateRequest.FailoverReason = common.StringPtr(c.String(FlagReason))
+	}
+	if c.Bool(FlagGraceful) {
+		updateRequest.FailoverTimeoutInSeconds = common.Int32Ptr(int32(c.Int(FlagFailoverTimeout)))
+	}
+
+	resp, err := d.updateDomain(ctx, updateRequest)
+	if err != nil {
+		if _, ok := err.(*s.EntityNotExistsError); !ok {
+			ErrorAndExit("Operation FailoverDomain failed.", err)
+		} else {
+			ErrorAndExit(fmt.Sprintf("Domain %s does not exist.", domainName), err)
+		}
+	}
+	if resp.FailoverInfo != nil && resp.FailoverInfo.State != nil &&
+		resp.FailoverInfo.GetState() == shared.DomainFailoverStateDraining {
+		fmt.Printf("Domain %s is draining, will fail over to %s.\n", domainName, activeCluster)
+		return
+	}
+	fmt.Printf("Domain %s successfully failed over to %s.\n", domainName, activeCluster)
+}
+
 // DescribeDomain updates a domain
 func (d *domainCLIImpl) DescribeDomain(c *cli.Context) {
 	domainName := c.GlobalString(FlagDomain)
@@ -341,6 +382,24 @@ func (d *domainCLIImpl) DescribeDomain(c *cli.Context) {
 		formatStr = formatStr + "VisibilityArchivalURI: %v\n"
 		descValues = append(descValues, resp.Configuration.GetVisibilityArchivalURI())
 	}
+	if filter := resp.ReplicationConfiguration.ReplicationFilter; filter != nil {
+		formatStr = formatStr + "ReplicationWorkflowTypes: %v\nSkipVisibilityPayloads: %v\n"
+		descValues = append(descValues, strings.Join(filter.WorkflowTypes, ", "), filter.GetSkipVisibilityPayloads())
+	}
+	if resp.FailoverInfo != nil {
+		formatStr = formatStr + "FailoverState: %v\nFailoverSourceCluster: %v\nFailoverTargetCluster: %v\nFailoverStartTime: %v\nFailoverExpireTime: %v\n"
+		descValues = append(descValues,
+			resp.FailoverInfo.GetState().String(),
+			resp.FailoverInfo.GetSourceCluster(),
+			resp.FailoverInfo.GetTargetCluster(),
+			time.Unix(0, resp.FailoverInfo.GetStartTimestamp()).String(),
+			time.Unix(0, resp.FailoverInfo.GetExpireTimestamp()).String(),
+		)
+		if resp.FailoverInfo.EndTimestamp != nil {
+			formatStr = formatStr + "FailoverEndTime: %v\n"
+			descValues = append(descValues, time.Unix(0, resp.FailoverInfo.GetEndTimestamp()).String())
+		}
+	}
 	fmt.Printf(formatStr, descValues...)
 	if resp.Configuration.BadBinaries != nil {
 		fmt.Println("Bad binaries to reset:")
@@ -360,6 +419,31 @@ func (d *domainCLIImpl) DescribeDomain(c *cli.Context) {
 		}
 		table.Render()
 	}
+	if len(resp.FailoverHistory) > 0 {
+		fmt.Println("Failover history:")
+		table := tablewriter.NewWriter(os.Stdout)
+		table.SetBorder(true)
+		table.SetColumnSeparator("|")
+		header := []string{"Time", "From", "To", "Failover Version", "Graceful", "Reason"}
+		headerColor := []tablewriter.Colors{tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue}
+		table.SetHeader(header)
+		table.SetHeaderColor(headerColor...)
+		for _, record := range resp.FailoverHistory {
+			graceful := ""
+			if record.Graceful != nil {
+				graceful = strconv.FormatBool(record.GetGraceful())
+			}
+			table.Append([]string{
+				time.Unix(0, record.GetTimestamp()).String(),
+				record.GetFromCluster(),
+				record.GetToCluster(),
+				strconv.FormatInt(record.GetFailoverVersion(), 10),
+				graceful,
+				record.GetReason(),
+			})
+		}
+		table.Render()
+	}
 }
 
 func (d *domainCLIImpl) registerDomain(
@@ -412,6 +496,29 @@ func archivalStatus(c *cli.Context, statusFlagName string) *shared.ArchivalStatu
 	return nil
 }
 
+func replicationFilter(c *cli.Context) *shared.DomainReplicationFilter {
+	if !c.IsSet(FlagReplicationWorkflowTypes) && !c.IsSet(FlagSkipVisibilityPayloads) {
+		return nil
+	}
+	// an empty filter clears the replication filter of the domain
+	filter := &shared.DomainReplicationFilter{}
+	if c.IsSet(FlagReplicationWorkflowTypes) {
+		for _, workflowType := range strings.Split(c.String(FlagReplicationWorkflowTypes), ",") {
+			if workflowType = strings.TrimSpace(workflowType); len(workflowType) > 0 {
+				filter.WorkflowTypes = append(filter.WorkflowTypes, workflowType)
+			}
+		}
+	}
+	if c.IsSet(FlagSkipVisibilityPayloads) {
+		skipVisibilityPayloads, err := strconv.ParseBool(c.String(FlagSkipVisibilityPayloads))
+		if err != nil {
+			ErrorAndExit(fmt.Sprintf("Option %s format is invalid.", FlagSkipVisibilityPayloads), err)
+		}
+		filter.SkipVisibilityPayloads = common.BoolPtr(skipVisibilityPayloads)
+	}
+	return filter
+}
+
 func clustersToString(clusters []*shared.ClusterReplicationConfiguration) string {
 	var res string
 	for i, cluster := range clusters {
@@ -76,6 +76,14 @@ var (
 			Name:  FlagClustersWithAlias,
 			Usage: "Clusters",
 		},
+		cli.StringFlag{
+			Name:  FlagReplicationWorkflowTypesWithAlias,
+			Usage: "Optionally only replicate workflows of these types to other clusters, in format of type1,type2,type3",
+		},
+		cli.StringFlag{
+			Name:  FlagSkipVisibilityPayloadsWithAlias,
+			Usage: "Optionally do not replicate memo and search attributes to other clusters",
+		},
 		cli.StringFlag{
 			Name:  FlagIsGlobalDomainWithAlias,
 			Usage: "Flag to indicate whether domain is a global domain",
@@ -138,6 +146,14 @@ var (
 			Name:  FlagDomainDataWithAlias,
 			Usage: "Domain data of key value pairs, in format of k1:v1,k2:v2,k3:v3 ",
 		},
+		cli.StringFlag{
+			Name:  FlagReplicationWorkflowTypesWithAlias,
+			Usage: "Optionally only replicate workflows of these types to other clusters, in format of type1,type2,type3",
+		},
+		cli.StringFlag{
+			Name:  FlagSkipVisibilityPayloadsWithAlias,
+			Usage: "Optionally do not replicate memo and search attributes to other clusters",
+		},
 		cli.StringFlag{
 			Name:  FlagSecurityTokenWithAlias,
 			Usage: "Optional token for security check",
@@ -172,6 +188,30 @@ var (
 		},
 	}
 
+	failoverDomainFlags = []cli.Flag{
+		cli.StringFlag{
+			Name:  FlagActiveClusterNameWithAlias,
+			Usage: "Target active cluster name",
+		},
+		cli.BoolFlag{
+			Name:  FlagGracefulWithAlias,
+			Usage: "Drain the domain in the current active cluster and hand over once replication caught up",
+		},
+		cli.IntFlag{
+			Name:  FlagFailoverTimeoutWithAlias,
+			Value: defaultGracefulFailoverTimeoutInSeconds,
+			Usage: "Optional timeout of graceful failover, the domain is handed over anyway once it expires",
+		},
+		cli.StringFlag{
+			Name:  FlagReasonWithAlias,
+			Usage: "Optional reason of the failover, recorded in the failover history of the domain",
+		},
+		cli.StringFlag{
+			Name:  FlagSecurityTokenWithAlias,
+			Usage: "Optional token for security check",
+		},
+	}
+
 	describeDomainFlags = []cli.Flag{
 		cli.StringFlag{
 			Name:  FlagDomainID,
@@ -52,6 +52,9 @@ const (
 	FlagTaskListWithAlias                 = FlagTaskList + ", tl"
 	FlagTaskListType                      = "tasklisttype"
 	FlagTaskListTypeWithAlias             = FlagTaskListType + ", tlt"
+	FlagTargetTaskList                    = "target_tasklist"
+	FlagTargetTaskListWithAlias           = FlagTargetTaskList + ", ttl"
+	FlagWaitForDrain                      = "wait"
 	FlagWorkflowIDReusePolicy             = "workflowidreusepolicy"
 	FlagWorkflowIDReusePolicyAlias        = FlagWorkflowIDReusePolicy + ", wrp"
 	FlagCronSchedule                      = "cron"
@@ -177,6 +180,10 @@ const (
 	FlagSearchAttributesKey               = "search_attr_key"
 	FlagSearchAttributesVal               = "search_attr_value"
 	FlagSearchAttributesType              = "search_attr_type"
+	FlagDeprecateSearchAttributes         = "deprecate_search_attr"
+	FlagRemoveSearchAttributes            = "remove_search_attr"
+	FlagAddSearchAttributeAliases         = "add_search_attr_alias"
+	FlagRemoveSearchAttributeAliases      = "remove_search_attr_alias"
 	FlagAddBadBinary                      = "add_bad_binary"
 	FlagRemoveBadBinary                   = "remove_bad_binary"
 	FlagResetType                         = "reset_type"
@@ -194,6 +201,8 @@ const (
 	FlagJobID                             = "job_id"
 	FlagJobIDWithAlias                    = FlagJobID + ", jid"
 	FlagYes                               = "yes"
+	FlagExportURI                         = "export_uri"
+	FlagExportFormat                      = "export_format"
 	FlagServiceConfigDir                  = "service_config_dir"
 	FlagServiceConfigDirWithAlias         = FlagServiceConfigDir + ", scd"
 	FlagServiceEnv                        = "service_env"
@@ -205,6 +214,30 @@ const (
 	FlagTLSKeyPath                        = "tls_key_path"
 	FlagTLSCaPath                         = "tls_ca_path"
 	FlagTLSEnableHostVerification         = "tls_enable_host_verification"
+	FlagDLQType                           = "dlq_type"
+	FlagDLQTypeWithAlias                  = FlagDLQType + ", dq"
+	FlagSourceCluster                     = "source_cluster"
+	FlagSourceClusterWithAlias            = FlagSourceCluster + ", sc"
+	FlagLastMessageID                     = "last_message_id"
+	FlagLastMessageIDWithAlias            = FlagLastMessageID + ", lm"
+	FlagMaxMessageCount                   = "max_message_count"
+	FlagMaxMessageCountWithAlias          = FlagMaxMessageCount + ", mmc"
+	FlagGraceful                          = "graceful"
+	FlagGracefulWithAlias                 = FlagGraceful + ", g"
+	FlagFailoverTimeout                   = "failover_timeout_seconds"
+	FlagFailoverTimeoutWithAlias          = FlagFailoverTimeout + ", fts"
+	FlagTargetClusterWithAlias            = FlagTargetCluster + ", tc"
+	FlagDomainList                        = "domains"
+	FlagDomainListWithAlias               = FlagDomainList + ", ds"
+	FlagBatchWaitTime                     = "batch_wait_time_seconds"
+	FlagBatchWaitTimeWithAlias            = FlagBatchWaitTime + ", bwt"
+	FlagReplicationWorkflowTypes          = "replication_workflow_types"
+	FlagReplicationWorkflowTypesWithAlias = FlagReplicationWorkflowTypes + ", rwt"
+	FlagSkipVisibilityPayloads            = "skip_visibility_payloads"
+	FlagSkipVisibilityPayloadsWithAlias   = FlagSkipVisibilityPayloads + ", svp"
+	FlagRemoteCluster                     = "remote_cluster"
+	FlagRemoteClusterWithAlias            = FlagRemoteCluster + ", rc"
+	FlagRepair                            = "repair"
 )
 
 var flagsForExecution = []cli.Flag{
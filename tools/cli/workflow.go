@@ -26,6 +26,7 @@ import (
 	"github.com/urfave/cli"
 
 	"github.com/uber/cadence/service/worker/batcher"
+	"github.com/uber/cadence/service/worker/visibilityexport"
 )
 
 func newWorkflowCommands() []cli.Command {
@@ -317,6 +318,11 @@ func newWorkflowCommands() []cli.Command {
 			Usage:       "batch operation on a list of workflows from query.",
 			Subcommands: newBatchCommands(),
 		},
+		{
+			Name:        "export",
+			Usage:       "export the visibility records of workflows from query to a file.",
+			Subcommands: newExportCommands(),
+		},
 	}
 }
 
@@ -388,6 +394,52 @@ func newActivityCommands() []cli.Command {
 	}
 }
 
+func newExportCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:  "start",
+			Usage: "Start an export job",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagListQueryWithAlias,
+					Usage: "Query to get workflows for being exported by this job",
+				},
+				cli.StringFlag{
+					Name:  FlagExportURI,
+					Usage: "URI of the file to write to, which must be in the export directory of the worker hosts, e.g. file:///tmp/cadence_export/workflows.json",
+				},
+				cli.StringFlag{
+					Name:  FlagExportFormat,
+					Value: visibilityexport.FormatJSON,
+					Usage: "Format of the exported file, supported: " + visibilityexport.FormatJSON + "," + visibilityexport.FormatCSV,
+				},
+				cli.IntFlag{
+					Name:  FlagPageSizeWithAlias,
+					Value: visibilityexport.DefaultPageSize,
+					Usage: "Page size of the scan query",
+				},
+			},
+			Action: func(c *cli.Context) {
+				StartExportJob(c)
+			},
+		},
+		{
+			Name:    "describe",
+			Aliases: []string{"desc"},
+			Usage:   "Describe the progress of an export job",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagJobIDWithAlias,
+					Usage: "Export Job ID",
+				},
+			},
+			Action: func(c *cli.Context) {
+				DescribeExportJob(c)
+			},
+		},
+	}
+}
+
 func newBatchCommands() []cli.Command {
 	return []cli.Command{
 		{
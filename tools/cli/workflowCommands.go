@@ -31,6 +31,7 @@ import (
 	"math/rand"
 	"os"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -661,21 +662,44 @@ func isQueryOpen(query string) bool {
 
 // CountWorkflow count number of workflows
 func CountWorkflow(c *cli.Context) {
-	wfClient := getWorkflowClient(c)
+	frontendClient := cFactory.ServerFrontendClient(c)
+	domain := getRequiredGlobalOption(c, FlagDomain)
 
 	query := c.String(FlagListQuery)
-	request := &s.CountWorkflowExecutionsRequest{
-		Query: common.StringPtr(query),
+	request := &shared.CountWorkflowExecutionsRequest{
+		Domain: common.StringPtr(domain),
+		Query:  common.StringPtr(query),
 	}
 
 	ctx, cancel := newContextForLongPoll(c)
 	defer cancel()
-	response, err := wfClient.CountWorkflow(ctx, request)
+	response, err := frontendClient.CountWorkflowExecutions(ctx, request)
 	if err != nil {
 		ErrorAndExit("Failed to count workflow.", err)
 	}
 
 	fmt.Println(response.GetCount())
+	for _, group := range response.GetGroups() {
+		fmt.Printf("%s: %d\n", formatCountGroupValues(group.GetGroupValues()), group.GetCount())
+	}
+	if response.GetTruncatedCount() > 0 {
+		fmt.Printf("%d workflows are not in the groups above, as the number of groups exceeds the limit\n", response.GetTruncatedCount())
+	}
+}
+
+// formatCountGroupValues formats the group by values of a count group as key=value pairs sorted by key
+func formatCountGroupValues(groupValues map[string][]byte) string {
+	keys := make([]string, 0, len(groupValues))
+	for key := range groupValues {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	values := make([]string, 0, len(keys))
+	for _, key := range keys {
+		values = append(values, fmt.Sprintf("%s=%s", key, groupValues[key]))
+	}
+	return strings.Join(values, ", ")
 }
 
 // ListArchivedWorkflow lists archived workflow executions based on filters
@@ -993,6 +1017,14 @@ func convertSearchAttributesToMapOfInterface(searchAttributes *shared.SearchAttr
 			var val time.Time
 			json.Unmarshal(v, &val)
 			result[k] = val
+		case shared.IndexedValueTypeKeywordList:
+			var val []string
+			json.Unmarshal(v, &val)
+			result[k] = val
+		case shared.IndexedValueTypeDatetimeList:
+			var val []time.Time
+			json.Unmarshal(v, &val)
+			result[k] = val
 		default:
 			ErrorAndExit(fmt.Sprintf("Error unknown index value type [%v]", valueType), nil)
 		}
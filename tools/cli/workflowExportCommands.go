@@ -0,0 +1,83 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"github.com/urfave/cli"
+	cclient "go.uber.org/cadence/client"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/service/worker/visibilityexport"
+)
+
+// StartExportJob starts a job exporting the visibility records of workflows from query
+func StartExportJob(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	params := visibilityexport.ExportParams{
+		Domain:   domain,
+		Query:    c.String(FlagListQuery),
+		URI:      getRequiredOption(c, FlagExportURI),
+		Format:   c.String(FlagExportFormat),
+		PageSize: c.Int(FlagPageSize),
+	}
+
+	client := getExportClient(c)
+	tcCtx, cancel := newContext(c)
+	defer cancel()
+	options := cclient.StartWorkflowOptions{
+		TaskList:                     visibilityexport.TaskListName,
+		ExecutionStartToCloseTimeout: visibilityexport.WorkflowTimeout,
+		Memo: map[string]interface{}{
+			"Operator": getCurrentUserFromEnv(),
+		},
+	}
+	wf, err := client.StartWorkflow(tcCtx, options, visibilityexport.WorkflowTypeName, params)
+	if err != nil {
+		ErrorAndExit("Failed to start export job", err)
+	}
+	prettyPrintJSONObject(map[string]interface{}{
+		"msg":   "export job is started",
+		"jobID": wf.ID,
+	})
+}
+
+// DescribeExportJob prints the progress of an export job
+func DescribeExportJob(c *cli.Context) {
+	jobID := getRequiredOption(c, FlagJobID)
+
+	client := getExportClient(c)
+	tcCtx, cancel := newContext(c)
+	defer cancel()
+	resp, err := client.QueryWorkflow(tcCtx, jobID, "", visibilityexport.QueryType)
+	if err != nil {
+		ErrorAndExit("Failed to describe export job", err)
+	}
+	var result visibilityexport.QueryResult
+	if err := resp.Get(&result); err != nil {
+		ErrorAndExit("Failed to decode export job progress", err)
+	}
+	prettyPrintJSONObject(result)
+}
+
+func getExportClient(c *cli.Context) cclient.Client {
+	svcClient := cFactory.ClientFrontendClient(c)
+	return cclient.NewClient(svcClient, common.SystemLocalDomainName, &cclient.Options{})
+}